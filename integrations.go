@@ -25,7 +25,9 @@ import (
 	"github.com/wakflo/extensions/internal/integrations/dropbox"
 	"github.com/wakflo/extensions/internal/integrations/easyship"
 	"github.com/wakflo/extensions/internal/integrations/facebookpages"
+	"github.com/wakflo/extensions/internal/integrations/flexport"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk"
+	"github.com/wakflo/extensions/internal/integrations/fulfillment"
 	"github.com/wakflo/extensions/internal/integrations/github"
 	"github.com/wakflo/extensions/internal/integrations/googlecalendar"
 	"github.com/wakflo/extensions/internal/integrations/googledocs"
@@ -34,6 +36,7 @@ import (
 	"github.com/wakflo/extensions/internal/integrations/gumroad"
 	"github.com/wakflo/extensions/internal/integrations/harvest"
 	"github.com/wakflo/extensions/internal/integrations/hubspot"
+	"github.com/wakflo/extensions/internal/integrations/inventorysync"
 	"github.com/wakflo/extensions/internal/integrations/jiracloudsoftware"
 	"github.com/wakflo/extensions/internal/integrations/jsonconverter"
 	"github.com/wakflo/extensions/internal/integrations/keapcrm"
@@ -45,10 +48,15 @@ import (
 	"github.com/wakflo/extensions/internal/integrations/openai"
 	"github.com/wakflo/extensions/internal/integrations/pinterest"
 	"github.com/wakflo/extensions/internal/integrations/prisync"
+	"github.com/wakflo/extensions/internal/integrations/prompttemplates"
+	"github.com/wakflo/extensions/internal/integrations/sendgrid"
 	"github.com/wakflo/extensions/internal/integrations/sendowl"
+	"github.com/wakflo/extensions/internal/integrations/shippo"
 	"github.com/wakflo/extensions/internal/integrations/shopify"
 	"github.com/wakflo/extensions/internal/integrations/smartsheet"
+	"github.com/wakflo/extensions/internal/integrations/smtp"
 	"github.com/wakflo/extensions/internal/integrations/square"
+	"github.com/wakflo/extensions/internal/integrations/stripe"
 	surveyMonkey "github.com/wakflo/extensions/internal/integrations/surveymonkey"
 	"github.com/wakflo/extensions/internal/integrations/todoist"
 	"github.com/wakflo/extensions/internal/integrations/toggl"
@@ -84,8 +92,11 @@ func RegisterIntegrations() sdk.IntegrationsRegistrar {
 		jsonconverter.Integration, // JsonConverter
 		toggl.Integration,         // Toggl
 		square.Integration,        // Square
+		stripe.Integration,        // Stripe
 		trackingmore.Integration,  // TrackingMore
 		easyship.Integration,      // EasyShip
+		shippo.Integration,        // Shippo
+		flexport.Integration,      // Flexport
 		airtable.Integration,      // Airtable
 		calendly.Integration,      // Calendly
 		calculator.Integration,    // Calculator
@@ -119,6 +130,8 @@ func RegisterIntegrations() sdk.IntegrationsRegistrar {
 		convertkit.Integration,        // ConvertKit
 		campaignmonitor.Integration,   // Campaign Monitor
 		mailjet.Integration,           // Mailjet
+		sendgrid.Integration,          // SendGrid
+		smtp.Integration,              // SMTP
 		clickup.Integration,           // ClickUp
 		wrike.Integration,             // Wrike
 		jiracloudsoftware.Integration, // Jira Cloud Software
@@ -126,6 +139,8 @@ func RegisterIntegrations() sdk.IntegrationsRegistrar {
 		typeform.Integration,          // Typeform Software
 		dropbox.Integration,           // Dropbox
 		cin7.Integration,              // Cin7
+		inventorysync.Integration,     // Inventory Sync
+		fulfillment.Integration,       // Order Fulfillment
 		facebookpages.Integration,     // Facebook Pages
 		zendeskapp.Integration,        // Zendesk
 		sendowl.Integration,           // SendOwl
@@ -142,6 +157,7 @@ func RegisterIntegrations() sdk.IntegrationsRegistrar {
 		captionDownloader.Integration, // Youtube caption downloader
 		ghostcms.Integration,          // Ghost CMS
 		socialKit.Integration,         // SocialKit
+		prompttemplates.Integration,   // Prompt Templates
 	}
 
 	// 🛑Do-Not-Edit
@@ -0,0 +1,65 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aiguard builds the prompts and parses the responses behind the
+// moderation, classification and PII actions of the AI connectors, so every
+// provider returns the same output shape.
+package aiguard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrInvalidResponse is returned when a model answer cannot be read as the
+// JSON the prompt asked for.
+var ErrInvalidResponse = errors.New("model returned an invalid response")
+
+// decodeJSON reads the first JSON object in a model answer, tolerating
+// markdown fences and prose around it.
+func decodeJSON(raw string, v interface{}) error {
+	start := strings.Index(raw, "{")
+	end := strings.LastIndex(raw, "}")
+	if start < 0 || end < start {
+		return fmt.Errorf("%w: no JSON object found", ErrInvalidResponse)
+	}
+
+	if err := json.Unmarshal([]byte(raw[start:end+1]), v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	return nil
+}
+
+// clamp keeps scores reported by a model within [0, 1].
+func clamp(score float64) float64 {
+	if math.IsNaN(score) {
+		return 0
+	}
+	return math.Max(0, math.Min(1, score))
+}
+
+// SplitList splits a comma or newline separated form value, dropping blanks.
+func SplitList(value string) []string {
+	var items []string
+	for _, item := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
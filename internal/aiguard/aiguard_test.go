@@ -0,0 +1,74 @@
+package aiguard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wakflo/extensions/internal/pii"
+)
+
+func TestParseModeration(t *testing.T) {
+	raw := "```json\n{\"category_scores\": {\"harassment\": 0.8, \"violence\": 0.4, \"made-up\": 1}, \"reasoning\": \"insulting\"}\n```"
+
+	m, err := ParseModeration(raw, 0)
+	if err != nil {
+		t.Fatalf("ParseModeration() error = %v", err)
+	}
+	if !m.Flagged || !m.Categories["harassment"] || m.Categories["violence"] {
+		t.Errorf("unexpected categories %v", m.Categories)
+	}
+	if _, ok := m.Scores["made-up"]; ok {
+		t.Errorf("unknown category should be dropped")
+	}
+	if got := m.FlaggedCategories(); len(got) != 1 || got[0] != "harassment" {
+		t.Errorf("FlaggedCategories() = %v", got)
+	}
+
+	if _, err := ParseModeration("I cannot help with that.", 0); !errors.Is(err, ErrInvalidResponse) {
+		t.Errorf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestParseClassification(t *testing.T) {
+	req := ClassificationRequest{Labels: []string{"Billing", "Bug Report", "Feature Request"}}
+	raw := `{"labels": [{"label": "bug report", "confidence": 0.3}, {"label": "billing", "confidence": 0.65}, {"label": "spam", "confidence": 0.05}]}`
+
+	c, err := ParseClassification(raw, req, DefaultClassificationThreshold)
+	if err != nil {
+		t.Fatalf("ParseClassification() error = %v", err)
+	}
+	if c.Label != "Billing" || c.Confidence != 0.65 || len(c.Labels) != 2 {
+		t.Errorf("unexpected classification %+v", c)
+	}
+
+	req.MultiLabel = true
+	c, _ = ParseClassification(`{"labels": [{"label": "Billing", "confidence": 0.2}]}`, req, DefaultClassificationThreshold)
+	if c.Label != "" || len(c.Selected(DefaultClassificationThreshold)) != 0 {
+		t.Errorf("expected no label above the threshold, got %+v", c)
+	}
+}
+
+func TestParseExamples(t *testing.T) {
+	examples := ParseExamples("I was charged twice ||LABEL|| Billing ||EXAMPLE|| App crashes on login ||LABEL|| Bug Report ||EXAMPLE|| no label")
+	if len(examples) != 2 || examples[1].Label != "Bug Report" {
+		t.Errorf("unexpected examples %+v", examples)
+	}
+}
+
+func TestRedactionOutputWithLLMSpans(t *testing.T) {
+	text := "Hi, I'm Jane Doe, reach me at jane@example.com."
+
+	llm, err := ParsePII(`{"entities": [{"type": "person_name", "text": "Jane Doe"}, {"type": "email", "text": "jane@example.com"}]}`, text)
+	if err != nil {
+		t.Fatalf("ParsePII() error = %v", err)
+	}
+
+	out := RedactionOutput(text, append(pii.Detect(text), llm...), pii.StyleLabel, false)
+
+	if want := "Hi, I'm [PERSON_NAME_1], reach me at [EMAIL_1]."; out["redacted_text"] != want {
+		t.Errorf("redacted_text = %q, want %q", out["redacted_text"], want)
+	}
+	if out["entity_count"] != 2 {
+		t.Errorf("entity_count = %v, want 2", out["entity_count"])
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aiguard
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultClassificationThreshold is the confidence a label needs to be
+// selected in multi-label mode when the action does not set one.
+const DefaultClassificationThreshold = 0.5
+
+// Example is a labelled sample used for few-shot classification.
+type Example struct {
+	Text  string
+	Label string
+}
+
+// ParseExamples reads examples written as "text ||LABEL|| label" and
+// separated by ||EXAMPLE||, the same convention as the extraction examples.
+func ParseExamples(value string) []Example {
+	var examples []Example
+	for _, pair := range strings.Split(value, "||EXAMPLE||") {
+		parts := strings.Split(pair, "||LABEL||")
+		if len(parts) != 2 {
+			continue
+		}
+		text, label := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if text != "" && label != "" {
+			examples = append(examples, Example{Text: text, Label: label})
+		}
+	}
+	return examples
+}
+
+// Classification is the normalized result of a classification.
+type Classification struct {
+	// Label is the best label, or empty when nothing reached the threshold.
+	Label      string
+	Confidence float64
+	// Labels holds every scored label, highest confidence first.
+	Labels    []LabelScore
+	Reasoning string
+}
+
+// LabelScore is the confidence of a single label.
+type LabelScore struct {
+	Label      string  `json:"label"`
+	Confidence float64 `json:"confidence"`
+}
+
+// ClassificationRequest describes a classification task.
+type ClassificationRequest struct {
+	Text         string
+	Labels       []string
+	Examples     []Example
+	MultiLabel   bool
+	Instructions string
+}
+
+// ClassificationPrompt returns the system and user prompts for req.
+func ClassificationPrompt(req ClassificationRequest) (string, string) {
+	system := "You are a precise text classifier. You only use the labels you are given and answer with JSON only. " +
+		"Confidence is your probability from 0.0 to 1.0 that a label applies."
+
+	var b strings.Builder
+	b.WriteString("Labels:\n- ")
+	b.WriteString(strings.Join(req.Labels, "\n- "))
+	b.WriteString("\n\n")
+
+	if req.MultiLabel {
+		b.WriteString("Any number of labels may apply. Score every label independently.\n")
+	} else {
+		b.WriteString("Exactly one label applies. Scores across labels should add up to about 1.0.\n")
+	}
+
+	if req.Instructions != "" {
+		b.WriteString("\nGuidelines:\n")
+		b.WriteString(req.Instructions)
+		b.WriteString("\n")
+	}
+
+	if len(req.Examples) > 0 {
+		b.WriteString("\nExamples:\n")
+		for _, example := range req.Examples {
+			fmt.Fprintf(&b, "Text: %q\nLabel: %s\n\n", example.Text, example.Label)
+		}
+	}
+
+	b.WriteString(`
+Return a JSON object of this shape, scoring every label:
+{"labels": [{"label": "<label>", "confidence": 0.0}], "reasoning": "one sentence"}
+
+Text:
+"""
+`)
+	b.WriteString(req.Text)
+	b.WriteString("\n\"\"\"")
+
+	return system, b.String()
+}
+
+// ParseClassification reads the answer to ClassificationPrompt. Labels the
+// model invented are dropped and the rest are mapped back to the spelling
+// the user configured. In single-label mode the top label always wins; in
+// multi-label mode only labels reaching threshold are selected.
+func ParseClassification(raw string, req ClassificationRequest, threshold float64) (Classification, error) {
+	var answer struct {
+		Labels    []LabelScore `json:"labels"`
+		Reasoning string       `json:"reasoning"`
+	}
+	if err := decodeJSON(raw, &answer); err != nil {
+		return Classification{}, err
+	}
+
+	known := map[string]string{}
+	for _, label := range req.Labels {
+		known[strings.ToLower(strings.TrimSpace(label))] = label
+	}
+
+	seen := map[string]bool{}
+	result := Classification{Reasoning: answer.Reasoning, Labels: []LabelScore{}}
+	for _, score := range answer.Labels {
+		label, ok := known[strings.ToLower(strings.TrimSpace(score.Label))]
+		if !ok || seen[label] {
+			continue
+		}
+		seen[label] = true
+		result.Labels = append(result.Labels, LabelScore{Label: label, Confidence: clamp(score.Confidence)})
+	}
+
+	if len(result.Labels) == 0 {
+		return Classification{}, fmt.Errorf("%w: none of the configured labels were returned", ErrInvalidResponse)
+	}
+
+	sort.SliceStable(result.Labels, func(i, j int) bool {
+		return result.Labels[i].Confidence > result.Labels[j].Confidence
+	})
+
+	top := result.Labels[0]
+	if !req.MultiLabel || top.Confidence >= threshold {
+		result.Label = top.Label
+		result.Confidence = top.Confidence
+	}
+
+	return result, nil
+}
+
+// Selected returns the labels at or above threshold.
+func (c Classification) Selected(threshold float64) []string {
+	selected := []string{}
+	for _, score := range c.Labels {
+		if score.Confidence >= threshold {
+			selected = append(selected, score.Label)
+		}
+	}
+	return selected
+}
+
+// Output renders the result as action output.
+func (c Classification) Output(multiLabel bool, threshold float64) map[string]interface{} {
+	out := map[string]interface{}{
+		"label":      c.Label,
+		"confidence": c.Confidence,
+		"scores":     c.Labels,
+		"reasoning":  c.Reasoning,
+	}
+	if multiLabel {
+		out["labels"] = c.Selected(threshold)
+	}
+	return out
+}
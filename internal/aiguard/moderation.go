@@ -0,0 +1,144 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aiguard
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultModerationThreshold is the score at or above which a category is
+// flagged when the action does not set one.
+const DefaultModerationThreshold = 0.5
+
+// ModerationCategories follows the OpenAI moderation taxonomy so results
+// look the same whether they come from the moderations endpoint or an LLM.
+var ModerationCategories = []string{
+	"harassment",
+	"harassment/threatening",
+	"hate",
+	"hate/threatening",
+	"illicit",
+	"illicit/violent",
+	"self-harm",
+	"self-harm/intent",
+	"self-harm/instructions",
+	"sexual",
+	"sexual/minors",
+	"violence",
+	"violence/graphic",
+}
+
+// Moderation is the normalized result of a moderation check.
+type Moderation struct {
+	Flagged    bool
+	Categories map[string]bool
+	Scores     map[string]float64
+	Reasoning  string
+}
+
+// NewModeration flags every category whose score reaches threshold.
+func NewModeration(scores map[string]float64, threshold float64) Moderation {
+	if threshold <= 0 {
+		threshold = DefaultModerationThreshold
+	}
+
+	m := Moderation{
+		Categories: map[string]bool{},
+		Scores:     map[string]float64{},
+	}
+	for _, category := range ModerationCategories {
+		score := clamp(scores[category])
+		m.Scores[category] = score
+		m.Categories[category] = score >= threshold
+		m.Flagged = m.Flagged || m.Categories[category]
+	}
+
+	return m
+}
+
+// FlaggedCategories returns the flagged category names, highest score first.
+func (m Moderation) FlaggedCategories() []string {
+	flagged := []string{}
+	for category, hit := range m.Categories {
+		if hit {
+			flagged = append(flagged, category)
+		}
+	}
+
+	sort.Slice(flagged, func(i, j int) bool {
+		if m.Scores[flagged[i]] != m.Scores[flagged[j]] {
+			return m.Scores[flagged[i]] > m.Scores[flagged[j]]
+		}
+		return flagged[i] < flagged[j]
+	})
+
+	return flagged
+}
+
+// Output renders the result as action output.
+func (m Moderation) Output() map[string]interface{} {
+	out := map[string]interface{}{
+		"flagged":            m.Flagged,
+		"flagged_categories": m.FlaggedCategories(),
+		"categories":         m.Categories,
+		"category_scores":    m.Scores,
+	}
+	if m.Reasoning != "" {
+		out["reasoning"] = m.Reasoning
+	}
+	return out
+}
+
+// ModerationPrompt returns the system and user prompts that ask a chat model
+// to score text against ModerationCategories.
+func ModerationPrompt(text string) (string, string) {
+	system := "You are a content moderation classifier. You score text for policy violations and answer with JSON only. " +
+		"Score how likely the text belongs to each category from 0.0 (certainly not) to 1.0 (certainly). " +
+		"Judge the text itself, not the topics it mentions: news reporting, education and fiction are not violations on their own."
+
+	user := fmt.Sprintf(`Categories:
+%s
+
+Return a JSON object of this shape, with a score for every category:
+{"category_scores": {"harassment": 0.0, ...}, "reasoning": "one sentence"}
+
+Text:
+"""
+%s
+"""`, "- "+strings.Join(ModerationCategories, "\n- "), text)
+
+	return system, user
+}
+
+// ParseModeration reads the answer to ModerationPrompt.
+func ParseModeration(raw string, threshold float64) (Moderation, error) {
+	var answer struct {
+		CategoryScores map[string]float64 `json:"category_scores"`
+		Reasoning      string             `json:"reasoning"`
+	}
+	if err := decodeJSON(raw, &answer); err != nil {
+		return Moderation{}, err
+	}
+	if len(answer.CategoryScores) == 0 {
+		return Moderation{}, fmt.Errorf("%w: no category scores", ErrInvalidResponse)
+	}
+
+	m := NewModeration(answer.CategoryScores, threshold)
+	m.Reasoning = answer.Reasoning
+
+	return m, nil
+}
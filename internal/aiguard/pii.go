@@ -0,0 +1,193 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aiguard
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/pii"
+)
+
+// LLMPIIKinds are the kinds of personal data only a model can find reliably.
+var LLMPIIKinds = []pii.Kind{
+	"person_name",
+	"street_address",
+	"date_of_birth",
+	"national_id",
+	"passport_number",
+	"ip_address",
+}
+
+// RedactionProps are the provider-independent inputs of a redaction action.
+type RedactionProps struct {
+	Text             string   `json:"text"`
+	EntityTypes      []string `json:"entity_types"`
+	Style            string   `json:"style"`
+	UseLLM           bool     `json:"use_llm"`
+	IncludeOriginals bool     `json:"include_originals"`
+}
+
+// RegisterRedactionProps adds the redaction fields to a PII action form.
+// The provider adds its own model field for LLM assistance.
+func RegisterRedactionProps(form *smartform.FormBuilder) {
+	form.TextareaField("text", "Text").
+		Placeholder("Paste the text to redact...").
+		HelpText("The text to scan for personal data").
+		Required(true)
+
+	form.MultiSelectField("entity_types", "Entity Types").
+		Required(false).
+		AddOptions([]*smartform.Option{
+			{Value: string(pii.KindEmail), Label: "Email Addresses"},
+			{Value: string(pii.KindPhone), Label: "Phone Numbers"},
+			{Value: string(pii.KindCreditCard), Label: "Credit Card Numbers"},
+			{Value: string(pii.KindIBAN), Label: "IBANs"},
+		}...).
+		HelpText("Personal data detected with patterns and checksums. Leave empty to detect all of them.")
+
+	form.SelectField("style", "Redaction Style").
+		Required(false).
+		AddOptions([]*smartform.Option{
+			{Value: string(pii.StyleLabel), Label: "Label (e.g. [EMAIL_1])"},
+			{Value: string(pii.StyleMask), Label: "Mask (e.g. ********)"},
+			{Value: string(pii.StylePartial), Label: "Partial (e.g. **** 1111)"},
+		}...).
+		DefaultValue(string(pii.StyleLabel)).
+		HelpText("How redacted values are replaced in the text")
+
+	form.CheckboxField("use_llm", "Use LLM Assistance").
+		DefaultValue(false).
+		HelpText("Also ask the model for names, street addresses, dates of birth, national IDs, passport numbers and IP addresses. Sends the text to the model.").
+		Required(false)
+
+	form.CheckboxField("include_originals", "Include Original Values").
+		DefaultValue(false).
+		HelpText("Include the original values in the entity list. Leave off when the output is stored or forwarded.").
+		Required(false)
+}
+
+// Kinds returns the pattern kinds selected on the action.
+func (p RedactionProps) Kinds() []pii.Kind {
+	kinds := make([]pii.Kind, 0, len(p.EntityTypes))
+	for _, kind := range p.EntityTypes {
+		kinds = append(kinds, pii.Kind(kind))
+	}
+	return kinds
+}
+
+// Validate checks the inputs shared by every provider.
+func (p RedactionProps) Validate() error {
+	if p.Text == "" {
+		return errors.New("text to redact cannot be empty")
+	}
+
+	switch pii.Style(p.Style) {
+	case "", pii.StyleLabel, pii.StyleMask, pii.StylePartial:
+		return nil
+	default:
+		return fmt.Errorf("unknown redaction style %q", p.Style)
+	}
+}
+
+// Output redacts the pattern matches and the spans found by the model, if
+// any, from the text.
+func (p RedactionProps) Output(llmSpans []pii.Span) map[string]interface{} {
+	style := pii.Style(p.Style)
+	if style == "" {
+		style = pii.StyleLabel
+	}
+
+	spans := append(pii.Detect(p.Text, p.Kinds()...), llmSpans...)
+
+	return RedactionOutput(p.Text, spans, style, p.IncludeOriginals)
+}
+
+// PIIPrompt returns the system and user prompts asking a chat model to list
+// the personal data in text that the pattern engine cannot detect.
+func PIIPrompt(text string) (string, string) {
+	system := "You find personal data in text for redaction and answer with JSON only. " +
+		"Copy every value exactly as it appears in the text, character for character."
+
+	kinds := make([]string, 0, len(LLMPIIKinds))
+	for _, kind := range LLMPIIKinds {
+		kinds = append(kinds, string(kind))
+	}
+
+	user := fmt.Sprintf(`Find personal data of these types:
+- %s
+
+Do not report company or product names, or public figures mentioned in passing.
+Return a JSON object of this shape, with an empty list when nothing is found:
+{"entities": [{"type": "<type>", "text": "<exact text>"}]}
+
+Text:
+"""
+%s
+"""`, strings.Join(kinds, "\n- "), text)
+
+	return system, user
+}
+
+// ParsePII reads the answer to PIIPrompt and locates every reported value in
+// text. Values that do not occur verbatim are ignored.
+func ParsePII(raw, text string) ([]pii.Span, error) {
+	var answer struct {
+		Entities []struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"entities"`
+	}
+	if err := decodeJSON(raw, &answer); err != nil {
+		return nil, err
+	}
+
+	allowed := map[pii.Kind]bool{}
+	for _, kind := range LLMPIIKinds {
+		allowed[kind] = true
+	}
+
+	var spans []pii.Span
+	for _, entity := range answer.Entities {
+		kind := pii.Kind(strings.ToLower(strings.TrimSpace(entity.Type)))
+		if !allowed[kind] {
+			continue
+		}
+		spans = append(spans, pii.Locate(text, kind, entity.Text, pii.SourceLLM)...)
+	}
+
+	return spans, nil
+}
+
+// RedactionOutput redacts spans from text and renders the action output:
+// the redacted text, the span map and a count per kind.
+func RedactionOutput(text string, spans []pii.Span, style pii.Style, includeOriginals bool) map[string]interface{} {
+	redacted, entities := pii.Redact(text, pii.Merge(spans), style, includeOriginals)
+
+	counts := map[string]int{}
+	for _, entity := range entities {
+		counts[string(entity.Kind)]++
+	}
+
+	return map[string]interface{}{
+		"redacted_text": redacted,
+		"entities":      entities,
+		"counts":        counts,
+		"entity_count":  len(entities),
+		"contains_pii":  len(entities) > 0,
+	}
+}
@@ -0,0 +1,183 @@
+package aiusage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type fakeContext struct {
+	input    core.JSONObject
+	workflow map[string]interface{}
+}
+
+func (f *fakeContext) Input() core.JSONObject { return f.input }
+
+func (f *fakeContext) Logger() core.Logger { return core.NewNoopLogger() }
+
+func (f *fakeContext) WorkflowContextData() (map[string]interface{}, error) {
+	return f.workflow, nil
+}
+
+func (f *fakeContext) UpdateWorkflowContext(data map[string]interface{}) error {
+	if f.workflow == nil {
+		f.workflow = map[string]interface{}{}
+	}
+	for k, v := range data {
+		f.workflow[k] = v
+	}
+	return nil
+}
+
+func TestLookupPrice(t *testing.T) {
+	tests := []struct {
+		model  string
+		want   Price
+		wantOK bool
+	}{
+		{"gpt-4o-mini-2024-07-18", prices["gpt-4o-mini"], true},
+		{"gpt-4o-2024-08-06", prices["gpt-4o"], true},
+		{"gpt-4-0613", prices["gpt-4"], true},
+		{"claude-sonnet-4-5-20250929", prices["claude-sonnet-4"], true},
+		{"models/gemini-1.5-flash", prices["gemini-1.5-flash"], true},
+		{"llama3:8b", Price{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			got, ok := LookupPrice(tt.model)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("LookupPrice(%q) = %+v, %v; want %+v, %v", tt.model, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	cost := EstimateCost("gpt-4o", NewUsage(1_000_000, 100_000, 200_000))
+
+	// 800k uncached at $2.50, 200k cached at $1.25, 100k output at $10.
+	if cost.Input != 2 || cost.Cached != 0.25 || cost.Output != 1 || cost.Total != 3.25 {
+		t.Errorf("unexpected cost %+v", cost)
+	}
+
+	if unknown := EstimateCost("my-local-model", NewUsage(10, 10, 0)); unknown.Priced || unknown.Total != 0 {
+		t.Errorf("expected unpriced zero cost, got %+v", unknown)
+	}
+}
+
+func TestEstimateCostCacheWrites(t *testing.T) {
+	usage := NewUsage(1_000_000, 0, 200_000)
+	usage.CacheWriteTokens = 400_000
+	cost := EstimateCost("claude-sonnet-4-5-20250929", usage)
+
+	// 400k uncached at $3, 200k cache reads at $0.30 and 400k cache
+	// writes at $3.75.
+	if cost.Input != 1.2 || cost.Cached != 0.06 || cost.CacheWrite != 1.5 || cost.Total != 2.76 {
+		t.Errorf("unexpected cost %+v", cost)
+	}
+
+	// Models without a cache write price bill writes at the input rate.
+	if cost := EstimateCost("gpt-4o", usage); cost.CacheWrite != 1 || cost.Total != 2.25 {
+		t.Errorf("unexpected cost %+v", cost)
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		min, max int64
+	}{
+		{"empty", "", 0, 0},
+		{"short sentence", "The quick brown fox jumps over the lazy dog.", 9, 14},
+		{"cjk counts per rune", "你好世界", 4, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EstimateTokens(tt.text)
+			if got < tt.min || got > tt.max {
+				t.Errorf("EstimateTokens(%q) = %d, want between %d and %d", tt.text, got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    core.JSONObject
+		workflow map[string]interface{}
+		model    string
+		wantErr  bool
+	}{
+		{"no limits", core.JSONObject{}, nil, "gpt-4o", false},
+		{"under run limit", core.JSONObject{"max_cost_per_run": 1.0}, nil, "gpt-4o", false},
+		{"over run limit", core.JSONObject{"max_cost_per_run": 0.001}, nil, "gpt-4o", true},
+		{"over workflow limit", core.JSONObject{"max_cost_per_workflow": 0.5}, map[string]interface{}{"ai_spend_usd": 0.495}, "gpt-4o", true},
+		{"unknown model not blocked", core.JSONObject{"max_cost_per_run": 0.000001}, nil, "llama3", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &fakeContext{input: tt.input, workflow: tt.workflow}
+			err := Check(ctx, tt.model, 1000, "Summarize the attached report in three bullet points.")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrBudgetExceeded) {
+				t.Errorf("expected ErrBudgetExceeded, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFinishAccumulatesSpend(t *testing.T) {
+	ctx := &fakeContext{input: core.JSONObject{}}
+
+	Finish(ctx, "openai", "gpt-4o-mini", NewUsage(1_000_000, 0, 0))
+	out := Finish(ctx, "openai", "gpt-4o-mini", NewUsage(1_000_000, 0, 0))
+
+	if got := Spent(ctx); got != 0.3 {
+		t.Errorf("Spent() = %v, want 0.3", got)
+	}
+	if out["total_tokens"] != int64(1_000_000) {
+		t.Errorf("unexpected usage output %v", out)
+	}
+	if ctx.workflow[workflowTokensKey] != int64(2_000_000) {
+		t.Errorf("unexpected token total %v", ctx.workflow[workflowTokensKey])
+	}
+}
+
+func TestUnitPricing(t *testing.T) {
+	tests := []struct {
+		model string
+		usage Usage
+		want  float64
+	}{
+		{"tts-1", NewUnitUsage(1000, UnitCharacter), 0.015},
+		{"whisper-1", NewUnitUsage(2.5, UnitMinute), 0.015},
+		{ImageModel("dall-e-3", "hd", "1792x1024"), NewUnitUsage(1, UnitImage), 0.12},
+		{ImageModel("dall-e-3", "standard", "1024x1024"), NewUnitUsage(1, UnitImage), 0.04},
+		{ImageModel("dall-e-2", "", "256x256"), NewUnitUsage(3, UnitImage), 0.048},
+	}
+	for _, tt := range tests {
+		if got := EstimateCost(tt.model, tt.usage); !got.Priced || got.Total != tt.want {
+			t.Errorf("EstimateCost(%s) = %+v, want %v", tt.model, got, tt.want)
+		}
+	}
+
+	if price, _ := LookupPrice("gpt-4o-mini-tts"); price.Output != 12 {
+		t.Errorf("gpt-4o-mini-tts priced as %+v", price)
+	}
+
+	ctx := &fakeContext{input: core.JSONObject{"max_cost_per_run": 0.05}}
+	if err := CheckUnits(ctx, ImageModel("dall-e-3", "hd", ""), 1, UnitImage); !errors.Is(err, ErrBudgetExceeded) {
+		t.Errorf("CheckUnits() error = %v, want ErrBudgetExceeded", err)
+	}
+	if out := Finish(ctx, "openai", "tts-1", NewUnitUsage(100, UnitCharacter)); out["unit"] != UnitCharacter || out["units"] != 100.0 {
+		t.Errorf("unexpected unit output %v", out)
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aiusage
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+const (
+	runLimitField      = "max_cost_per_run"
+	workflowLimitField = "max_cost_per_workflow"
+
+	// Keys under which cumulative spend is kept in the workflow context so
+	// every AI step of a run sees what earlier steps consumed.
+	workflowSpendKey  = "ai_spend_usd"
+	workflowTokensKey = "ai_tokens"
+)
+
+// DefaultOutputTokens is the output size assumed by budget checks when an
+// action does not cap the response length.
+const DefaultOutputTokens = 1024
+
+// ErrBudgetExceeded is returned when a call would break a spend limit.
+var ErrBudgetExceeded = errors.New("ai budget exceeded")
+
+// Context is the subset of the action perform context the meter needs.
+type Context interface {
+	Input() core.JSONObject
+	Logger() core.Logger
+	WorkflowContextData() (map[string]interface{}, error)
+	UpdateWorkflowContext(data map[string]interface{}) error
+}
+
+// Budget holds the optional USD spend limits of an action. Zero means
+// unlimited.
+type Budget struct {
+	MaxCostPerRun      float64
+	MaxCostPerWorkflow float64
+}
+
+// RegisterBudgetProps adds the budget fields to an AI action form.
+func RegisterBudgetProps(form *smartform.FormBuilder) {
+	form.NumberField(runLimitField, "Max Cost per Run (USD)").
+		Required(false).
+		HelpText("Fail before calling the model if this step's estimated worst-case cost is above this amount. Leave empty for no limit.")
+
+	form.NumberField(workflowLimitField, "Max Cost per Workflow (USD)").
+		Required(false).
+		HelpText("Fail before calling the model if the AI spend of this workflow run, including this step, would go above this amount. Leave empty for no limit.")
+}
+
+// BudgetFromInput reads the budget fields from raw action input.
+func BudgetFromInput(input map[string]interface{}) Budget {
+	return Budget{
+		MaxCostPerRun:      toFloat(input[runLimitField]),
+		MaxCostPerWorkflow: toFloat(input[workflowLimitField]),
+	}
+}
+
+// Check estimates the worst-case cost of a call from the prompt text and
+// the output token cap and fails fast when it would break the budget set
+// on the action. Models missing from the price table are never blocked.
+func Check(ctx Context, model string, maxOutputTokens int64, prompt ...string) error {
+	usage := NewUsage(EstimateTokens(prompt...), maxOutputTokens, 0)
+	return enforce(ctx, model, usage)
+}
+
+// CheckUnits is Check for models billed per unit, with the units the call
+// may consume at most.
+func CheckUnits(ctx Context, model string, units float64, unit string) error {
+	return enforce(ctx, model, NewUnitUsage(units, unit))
+}
+
+func enforce(ctx Context, model string, usage Usage) error {
+	budget := BudgetFromInput(ctx.Input())
+	if budget.MaxCostPerRun <= 0 && budget.MaxCostPerWorkflow <= 0 {
+		return nil
+	}
+	usage.Estimated = true
+
+	cost := EstimateCost(model, usage)
+	if !cost.Priced {
+		ctx.Logger().Warn("no price known for model, budget not enforced", "model", model)
+		return nil
+	}
+
+	if budget.MaxCostPerRun > 0 && cost.Total > budget.MaxCostPerRun {
+		return fmt.Errorf("%w: estimated cost $%.6f (%s) is above the per-run limit of $%.6f",
+			ErrBudgetExceeded, cost.Total, describe(usage), budget.MaxCostPerRun)
+	}
+
+	if budget.MaxCostPerWorkflow > 0 {
+		spent := Spent(ctx)
+		if spent+cost.Total > budget.MaxCostPerWorkflow {
+			return fmt.Errorf("%w: workflow has spent $%.6f and this step may add $%.6f, above the per-workflow limit of $%.6f",
+				ErrBudgetExceeded, spent, cost.Total, budget.MaxCostPerWorkflow)
+		}
+	}
+
+	return nil
+}
+
+func describe(usage Usage) string {
+	if usage.Unit != "" {
+		return fmt.Sprintf("%g %ss", usage.Units, usage.Unit)
+	}
+	return fmt.Sprintf("%d input, %d output tokens", usage.InputTokens, usage.OutputTokens)
+}
+
+// Spent returns the AI spend recorded so far in the workflow run.
+func Spent(ctx Context) float64 {
+	data, err := ctx.WorkflowContextData()
+	if err != nil {
+		return 0
+	}
+	return toFloat(data[workflowSpendKey])
+}
+
+// Finish prices the usage of a completed call, adds it to the workflow
+// spend and returns the normalized usage output. Failing to record spend
+// is logged rather than returned since the call has already been billed.
+func Finish(ctx Context, provider, model string, usage Usage) map[string]interface{} {
+	report := NewReport(provider, model, usage)
+
+	if err := record(ctx, report); err != nil {
+		ctx.Logger().Warn("failed to record ai spend", "model", model, "error", err)
+	}
+
+	return report.Output()
+}
+
+func record(ctx Context, report Report) error {
+	data, err := ctx.WorkflowContextData()
+	if err != nil {
+		return err
+	}
+
+	tokens := int64(toFloat(data[workflowTokensKey]))
+
+	return ctx.UpdateWorkflowContext(map[string]interface{}{
+		workflowSpendKey:  round(toFloat(data[workflowSpendKey]) + report.Cost.Total),
+		workflowTokensKey: tokens + report.Usage.TotalTokens,
+	})
+}
+
+func toFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case float32:
+		return float64(n)
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case string:
+		f, _ := strconv.ParseFloat(n, 64)
+		return f
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aiusage
+
+import (
+	"math"
+	"strings"
+)
+
+// Price is the list price of a model in USD per million tokens.
+type Price struct {
+	Input  float64
+	Output float64
+	// Cached is the price of prompt tokens served from the provider cache.
+	// Zero means the model has no cache discount and cached tokens are
+	// billed at the input rate.
+	Cached float64
+	// CacheWrite is the price of prompt tokens written to the provider
+	// cache. Zero means writes are billed at the input rate.
+	CacheWrite float64
+	// PerUnit is the price of one Unit, for models billed per character,
+	// minute or image rather than per token.
+	PerUnit float64
+	Unit    string
+}
+
+// Units of the models billed per unit.
+const (
+	UnitCharacter = "character"
+	UnitMinute    = "minute"
+	UnitImage     = "image"
+)
+
+// Anthropic bills cache reads at a tenth of the input rate and cache
+// writes, with the default five minute lifetime, at a quarter more.
+const (
+	claudeCacheRead  = 0.1
+	claudeCacheWrite = 1.25
+)
+
+// claude prices an Anthropic model with prompt caching.
+func claude(input, output float64) Price {
+	return Price{Input: input, Output: output, Cached: input * claudeCacheRead, CacheWrite: input * claudeCacheWrite}
+}
+
+// prices is keyed by model prefix so dated snapshots ("gpt-4o-2024-08-06",
+// "claude-sonnet-4-5-20250929") resolve to their family. The longest
+// matching prefix wins.
+var prices = map[string]Price{
+	// OpenAI
+	"gpt-5":                  {Input: 1.25, Output: 10, Cached: 0.125},
+	"gpt-5-mini":             {Input: 0.25, Output: 2, Cached: 0.025},
+	"gpt-5-nano":             {Input: 0.05, Output: 0.4, Cached: 0.005},
+	"gpt-4.1":                {Input: 2, Output: 8, Cached: 0.5},
+	"gpt-4.1-mini":           {Input: 0.4, Output: 1.6, Cached: 0.1},
+	"gpt-4.1-nano":           {Input: 0.1, Output: 0.4, Cached: 0.025},
+	"gpt-4o":                 {Input: 2.5, Output: 10, Cached: 1.25},
+	"gpt-4o-mini":            {Input: 0.15, Output: 0.6, Cached: 0.075},
+	"gpt-4-turbo":            {Input: 10, Output: 30},
+	"gpt-4":                  {Input: 30, Output: 60},
+	"gpt-3.5-turbo":          {Input: 0.5, Output: 1.5},
+	"o1":                     {Input: 15, Output: 60, Cached: 7.5},
+	"o1-mini":                {Input: 1.1, Output: 4.4, Cached: 0.55},
+	"o3":                     {Input: 2, Output: 8, Cached: 0.5},
+	"o3-mini":                {Input: 1.1, Output: 4.4, Cached: 0.55},
+	"o4-mini":                {Input: 1.1, Output: 4.4, Cached: 0.275},
+	"text-embedding-3-small": {Input: 0.02},
+	"text-embedding-3-large": {Input: 0.13},
+	"text-embedding-ada-002": {Input: 0.1},
+	"gpt-4o-mini-tts":        {Input: 0.6, Output: 12},
+	"gpt-4o-transcribe":      {Input: 6, Output: 10},
+	"gpt-4o-mini-transcribe": {Input: 3, Output: 5},
+	"tts-1":                  {PerUnit: 0.000015, Unit: UnitCharacter},
+	"tts-1-hd":               {PerUnit: 0.00003, Unit: UnitCharacter},
+	"whisper-1":              {PerUnit: 0.006, Unit: UnitMinute},
+	// Images are priced by model, then quality and size, as ImageModel
+	// names them.
+	"dall-e-3":              {PerUnit: 0.04, Unit: UnitImage},
+	"dall-e-3-1024x1792":    {PerUnit: 0.08, Unit: UnitImage},
+	"dall-e-3-1792x1024":    {PerUnit: 0.08, Unit: UnitImage},
+	"dall-e-3-hd":           {PerUnit: 0.08, Unit: UnitImage},
+	"dall-e-3-hd-1024x1792": {PerUnit: 0.12, Unit: UnitImage},
+	"dall-e-3-hd-1792x1024": {PerUnit: 0.12, Unit: UnitImage},
+	"dall-e-2":              {PerUnit: 0.02, Unit: UnitImage},
+	"dall-e-2-512x512":      {PerUnit: 0.018, Unit: UnitImage},
+	"dall-e-2-256x256":      {PerUnit: 0.016, Unit: UnitImage},
+
+	// Anthropic
+	"claude-opus-4":     claude(15, 75),
+	"claude-sonnet-4":   claude(3, 15),
+	"claude-3-7-sonnet": claude(3, 15),
+	"claude-3-5-sonnet": claude(3, 15),
+	"claude-3-5-haiku":  claude(0.8, 4),
+	"claude-3-opus":     claude(15, 75),
+	"claude-3-haiku":    {Input: 0.25, Output: 1.25, Cached: 0.03, CacheWrite: 0.3},
+	"claude-2":          {Input: 8, Output: 24},
+	"claude-instant":    {Input: 0.8, Output: 2.4},
+
+	// Google
+	"gemini-2.5-pro":               {Input: 1.25, Output: 10, Cached: 0.31},
+	"gemini-2.5-flash":             {Input: 0.3, Output: 2.5, Cached: 0.075},
+	"gemini-2.5-flash-lite":        {Input: 0.1, Output: 0.4, Cached: 0.025},
+	"gemini-2.5-flash-preview-tts": {Input: 0.5, Output: 10},
+	"gemini-2.5-pro-preview-tts":   {Input: 1, Output: 20},
+	"gemini-2.0-flash":             {Input: 0.1, Output: 0.4, Cached: 0.025},
+	"gemini-2.0-flash-lite":        {Input: 0.075, Output: 0.3},
+	"gemini-1.5-pro":               {Input: 1.25, Output: 5, Cached: 0.3125},
+	"gemini-1.5-flash":             {Input: 0.075, Output: 0.3, Cached: 0.01875},
+	"gemini-embedding-001":         {Input: 0.15},
+	"text-embedding-004":           {Input: 0},
+	"embedding-001":                {Input: 0},
+}
+
+// LookupPrice returns the price for a model, matching the longest known
+// prefix. Provider path prefixes such as "models/" are ignored.
+func LookupPrice(model string) (Price, bool) {
+	name := strings.ToLower(strings.TrimSpace(model))
+	name = strings.TrimPrefix(name, "models/")
+
+	best := ""
+	for prefix := range prices {
+		if strings.HasPrefix(name, prefix) && len(prefix) > len(best) {
+			best = prefix
+		}
+	}
+
+	if best == "" {
+		return Price{}, false
+	}
+
+	return prices[best], true
+}
+
+// EstimateCost prices usage for the given model. Unknown models yield a
+// zero cost with Priced set to false.
+func EstimateCost(model string, usage Usage) Cost {
+	cost := Cost{Currency: "USD"}
+
+	price, ok := LookupPrice(model)
+	if !ok {
+		return cost
+	}
+
+	cachedRate := price.Cached
+	if cachedRate == 0 {
+		cachedRate = price.Input
+	}
+	writeRate := price.CacheWrite
+	if writeRate == 0 {
+		writeRate = price.Input
+	}
+
+	// Providers count cached tokens as part of the prompt, so only the
+	// uncached remainder is billed at the full input rate.
+	uncached := usage.InputTokens - usage.CachedTokens - usage.CacheWriteTokens
+	if uncached < 0 {
+		uncached = 0
+	}
+
+	cost.Priced = true
+	cost.Input = perMillion(uncached, price.Input)
+	cost.Cached = perMillion(usage.CachedTokens, cachedRate)
+	cost.CacheWrite = perMillion(usage.CacheWriteTokens, writeRate)
+	cost.Output = perMillion(usage.OutputTokens, price.Output)
+	cost.Units = round(usage.Units * price.PerUnit)
+	cost.Total = round(cost.Input + cost.Cached + cost.CacheWrite + cost.Output + cost.Units)
+
+	return cost
+}
+
+// ImageModel names an image model with the quality and size it is priced
+// by, such as "dall-e-3-hd-1792x1024". Standard quality is left out.
+func ImageModel(model, quality, size string) string {
+	name := model
+	if quality != "" && quality != "standard" {
+		name += "-" + quality
+	}
+	if size != "" {
+		name += "-" + size
+	}
+	return name
+}
+
+func perMillion(tokens int64, rate float64) float64 {
+	return round(float64(tokens) * rate / 1_000_000)
+}
+
+// round keeps costs to a millionth of a dollar so sums stay readable.
+func round(v float64) float64 {
+	return math.Round(v*1_000_000) / 1_000_000
+}
@@ -0,0 +1,72 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aiusage
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// EstimateTokens approximates the number of tokens a BPE tokenizer produces
+// for text. It is deliberately conservative: it takes the larger of a
+// character-based estimate (about four bytes of English per token) and a
+// word-based one, and counts every non-Latin rune as a token of its own
+// since CJK and similar scripts rarely merge.
+func EstimateTokens(texts ...string) int64 {
+	var total int64
+	for _, text := range texts {
+		total += estimate(text)
+	}
+	return total
+}
+
+func estimate(text string) int64 {
+	if text == "" {
+		return 0
+	}
+
+	var (
+		latinBytes int64
+		wide       int64
+		words      int64
+		punct      int64
+		inWord     bool
+	)
+
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r):
+			inWord = false
+			latinBytes++
+		case r < unicode.MaxLatin1:
+			if unicode.IsPunct(r) || unicode.IsSymbol(r) {
+				punct++
+				inWord = false
+			} else if !inWord {
+				words++
+				inWord = true
+			}
+			latinBytes += int64(utf8.RuneLen(r))
+		default:
+			wide++
+			inWord = false
+		}
+	}
+
+	byChars := (latinBytes + 3) / 4
+	byWords := (words*4+2)/3 + punct
+
+	return max(byChars, byWords) + wide
+}
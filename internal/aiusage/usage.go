@@ -0,0 +1,106 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aiusage normalizes token usage reported by the AI connectors,
+// prices it against a per-model table and enforces spend budgets.
+package aiusage
+
+// Usage is the provider-neutral token count of a single AI call.
+type Usage struct {
+	InputTokens  int64 `json:"input_tokens"`
+	OutputTokens int64 `json:"output_tokens"`
+	CachedTokens int64 `json:"cached_tokens"`
+	TotalTokens  int64 `json:"total_tokens"`
+	// CacheWriteTokens are the prompt tokens written to the provider
+	// cache, which some providers bill above the input rate. Like cached
+	// tokens they are part of InputTokens.
+	CacheWriteTokens int64 `json:"cache_write_tokens,omitempty"`
+	// Units count what models billed per unit consumed, such as the
+	// characters spoken or the images generated.
+	Units float64 `json:"units,omitempty"`
+	Unit  string  `json:"unit,omitempty"`
+	// Estimated is true when the provider did not report usage and the
+	// counts come from the local tokenizer estimate.
+	Estimated bool `json:"estimated"`
+}
+
+// NewUsage builds a Usage and fills in the total.
+func NewUsage(input, output, cached int64) Usage {
+	return Usage{
+		InputTokens:  input,
+		OutputTokens: output,
+		CachedTokens: cached,
+		TotalTokens:  input + output,
+	}
+}
+
+// NewUnitUsage builds the Usage of a model billed per unit.
+func NewUnitUsage(units float64, unit string) Usage {
+	return Usage{Units: units, Unit: unit}
+}
+
+// Cost is the estimated USD cost of a call, split by token class.
+type Cost struct {
+	Input  float64 `json:"input"`
+	Output float64 `json:"output"`
+	Cached float64 `json:"cached"`
+	// CacheWrite is the cost of the tokens written to the provider cache.
+	CacheWrite float64 `json:"cache_write,omitempty"`
+	Units      float64 `json:"units,omitempty"`
+	Total      float64 `json:"total"`
+	Currency   string  `json:"currency"`
+	// Priced is false when the model is missing from the price table, in
+	// which case all amounts are zero.
+	Priced bool `json:"priced"`
+}
+
+// Report bundles usage and cost for inclusion in an action's output.
+type Report struct {
+	Provider string `json:"provider"`
+	Model    string `json:"model"`
+	Usage    Usage  `json:"usage"`
+	Cost     Cost   `json:"cost"`
+}
+
+// NewReport prices usage for the given model.
+func NewReport(provider, model string, usage Usage) Report {
+	return Report{
+		Provider: provider,
+		Model:    model,
+		Usage:    usage,
+		Cost:     EstimateCost(model, usage),
+	}
+}
+
+// Output returns the report as the map shape shared by every AI action.
+func (r Report) Output() map[string]interface{} {
+	out := map[string]interface{}{
+		"input_tokens":  r.Usage.InputTokens,
+		"output_tokens": r.Usage.OutputTokens,
+		"cached_tokens": r.Usage.CachedTokens,
+		"total_tokens":  r.Usage.TotalTokens,
+		"estimated":     r.Usage.Estimated,
+		"provider":      r.Provider,
+		"model":         r.Model,
+		"cost":          r.Cost,
+	}
+	if r.Usage.CacheWriteTokens != 0 {
+		out["cache_write_tokens"] = r.Usage.CacheWriteTokens
+	}
+	if r.Usage.Unit != "" {
+		out["units"] = r.Usage.Units
+		out["unit"] = r.Usage.Unit
+	}
+	return out
+}
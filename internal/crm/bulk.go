@@ -0,0 +1,309 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crm
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/media"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+const (
+	recordsField = "records"
+	fileField    = "file"
+)
+
+// RegisterBulkProps adds the fields bulk actions take their records
+// from: a list or CSV text, or a CSV or JSON file.
+func RegisterBulkProps(form *smartform.FormBuilder) {
+	form.TextareaField(recordsField, "Records").
+		Required(false).
+		HelpText("A JSON array of records, or CSV text with a header row. Each record is an object of field names and values.")
+
+	form.FileField(fileField, "File").
+		Required(false).
+		HelpText("A CSV file with a header row or a JSON file with an array of records, used when no records are given.")
+}
+
+// LoadRecords reads the records of a bulk action from the fields added by
+// RegisterBulkProps: the records given inline, else the file.
+func LoadRecords(ctx context.Context, files sdkcontext.FileResource, input map[string]interface{}) ([]map[string]interface{}, error) {
+	records, err := RecordsFrom(input[recordsField])
+	if err != nil || len(records) > 0 {
+		return records, err
+	}
+	if file := input[fileField]; file != nil && file != "" {
+		m, err := media.Load(ctx, files, file)
+		if err != nil {
+			return nil, err
+		}
+		if records, err = ParseRecords(m.Data); err != nil {
+			return nil, fmt.Errorf("%s: %w", m.Name, err)
+		}
+	}
+	if len(records) == 0 {
+		return nil, errors.New("no records given")
+	}
+	return records, nil
+}
+
+// RecordsFrom reads records given inline: a list from a previous step,
+// or JSON or CSV text.
+func RecordsFrom(v interface{}) ([]map[string]interface{}, error) {
+	switch value := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return ParseRecords([]byte(value))
+	case []interface{}:
+		records := make([]map[string]interface{}, 0, len(value))
+		for i, item := range value {
+			record, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("record %d is not an object", i+1)
+			}
+			records = append(records, record)
+		}
+		return records, nil
+	case []map[string]interface{}:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("records must be a list or text, got %T", v)
+	}
+}
+
+// ParseRecords reads a JSON array of objects, or CSV with a header row.
+// CSV values are kept as text and empty cells are left out.
+func ParseRecords(data []byte) ([]map[string]interface{}, error) {
+	data = bytes.TrimPrefix(bytes.TrimSpace(data), []byte("\xef\xbb\xbf"))
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	if data[0] == '[' {
+		var records []map[string]interface{}
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("records must be a JSON array of objects: %w", err)
+		}
+		return records, nil
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading the CSV header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+		if header[i] == "" {
+			return nil, fmt.Errorf("column %d of the CSV header is empty", i+1)
+		}
+	}
+
+	var records []map[string]interface{}
+	for line := 2; ; line++ {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading CSV line %d: %w", line, err)
+		}
+		if len(row) > len(header) {
+			return nil, fmt.Errorf("CSV line %d has %d values for %d columns", line, len(row), len(header))
+		}
+		record := map[string]interface{}{}
+		for i, value := range row {
+			if value = strings.TrimSpace(value); value != "" {
+				record[header[i]] = value
+			}
+		}
+		if len(record) > 0 {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// ContactFromRecord reads a contact from a bulk record. Columns other
+// than the standard contact fields are custom fields.
+func ContactFromRecord(record map[string]interface{}) (Contact, error) {
+	input := map[string]interface{}{}
+	fields := map[string]interface{}{}
+	for key, value := range record {
+		switch key {
+		case FieldEmail, FieldPhone, FieldFirstName, FieldLastName, FieldCompany, FieldTags, customFieldsField:
+			input[key] = value
+		default:
+			fields[key] = value
+		}
+	}
+	c, err := ContactFrom(input)
+	if err != nil {
+		return Contact{}, err
+	}
+	for key, value := range fields {
+		c.Fields[key] = value
+	}
+	return c, nil
+}
+
+// WriteCSV writes records as CSV under a header of every field any
+// record has: the first fields in order, then the rest sorted. It
+// returns the header with the data.
+func WriteCSV(records []map[string]interface{}, first ...string) ([]byte, []string, error) {
+	header := append([]string{}, first...)
+	seen := map[string]bool{}
+	for _, name := range first {
+		seen[name] = true
+	}
+	var rest []string
+	for _, record := range records {
+		for name := range record {
+			if !seen[name] {
+				seen[name] = true
+				rest = append(rest, name)
+			}
+		}
+	}
+	sort.Strings(rest)
+	header = append(header, rest...)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, nil, err
+	}
+	row := make([]string, len(header))
+	for _, record := range records {
+		for i, name := range header {
+			row[i] = stringValue(record[name])
+		}
+		if err := w.Write(row); err != nil {
+			return nil, nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), header, w.Error()
+}
+
+// Chunk splits items into batches of at most size, the most a vendor
+// takes per call.
+func Chunk[T any](items []T, size int) [][]T {
+	if size <= 0 {
+		size = len(items)
+	}
+	var chunks [][]T
+	for start := 0; start < len(items); start += size {
+		end := min(start+size, len(items))
+		chunks = append(chunks, items[start:end])
+	}
+	return chunks
+}
+
+// The outcomes of a row of a bulk action. A queued row was accepted by
+// an asynchronous job that hasn't written it yet.
+const (
+	RowCreated   = "created"
+	RowUpdated   = "updated"
+	RowSucceeded = "succeeded"
+	RowQueued    = "queued"
+	RowFailed    = "failed"
+)
+
+// RowResult is the outcome of one input record, numbered from 1 in input
+// order.
+type RowResult struct {
+	Row    int    `json:"row"`
+	Key    string `json:"key,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Failed reports whether the row wasn't written.
+func (r RowResult) Failed() bool {
+	return r.Status == RowFailed
+}
+
+// BulkOutput summarizes row results as action output.
+func BulkOutput(results []RowResult) map[string]interface{} {
+	if results == nil {
+		results = []RowResult{}
+	}
+	failed := 0
+	for _, r := range results {
+		if r.Failed() {
+			failed++
+		}
+	}
+	return map[string]interface{}{
+		"total":     len(results),
+		"succeeded": len(results) - failed,
+		"failed":    failed,
+		"results":   results,
+	}
+}
+
+// The states of an asynchronous vendor job.
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobCompleted = "completed"
+	JobFailed    = "failed"
+)
+
+// Job is the status of an asynchronous vendor job.
+type Job struct {
+	ID    string
+	State string
+	// Detail is the job as the vendor returned it.
+	Detail interface{}
+}
+
+// Done reports whether the job has stopped running.
+func (j Job) Done() bool {
+	return j.State == JobCompleted || j.State == JobFailed
+}
+
+// WaitForJob checks a job every interval until it is done or the wait is
+// over, and returns its last status. Running out of time isn't an error;
+// the job is returned as it was.
+func WaitForJob(ctx context.Context, wait, interval time.Duration, check func() (Job, error)) (Job, error) {
+	deadline := time.Now().Add(wait)
+	for {
+		job, err := check()
+		if err != nil || job.Done() || time.Now().Add(interval).After(deadline) {
+			return job, err
+		}
+		select {
+		case <-ctx.Done():
+			return job, nil
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package crm
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseRecordsCSV(t *testing.T) {
+	records, err := ParseRecords([]byte("\xef\xbb\xbfemail, first_name ,tags\njane@example.com,Jane,\"vip,lead\"\n,,\nbob@example.com,,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{
+		{"email": "jane@example.com", "first_name": "Jane", "tags": "vip,lead"},
+		{"email": "bob@example.com"},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("records = %v, want %v", records, want)
+	}
+
+	if _, err := ParseRecords([]byte("email\na@b.co,extra")); err == nil {
+		t.Error("expected a row wider than the header to fail")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	data, header, err := WriteCSV([]map[string]interface{}{
+		{"Last_Name": "Doe", "Email": "jane@example.com", "Score": float64(3)},
+		{"Email": "bob@example.com", "City": "Paris, TX"},
+	}, "Email")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(header, []string{"Email", "City", "Last_Name", "Score"}) {
+		t.Errorf("header = %v", header)
+	}
+	want := "Email,City,Last_Name,Score\njane@example.com,,Doe,3\nbob@example.com,\"Paris, TX\",,\n"
+	if string(data) != want {
+		t.Errorf("csv = %q, want %q", data, want)
+	}
+}
+
+func TestRecordsFrom(t *testing.T) {
+	records, err := RecordsFrom(`[{"email": "a@b.co", "score": 3}]`)
+	if err != nil || len(records) != 1 || records[0]["score"] != float64(3) {
+		t.Errorf("JSON records = %v, %v", records, err)
+	}
+	records, err = RecordsFrom([]interface{}{map[string]interface{}{"email": "a@b.co"}})
+	if err != nil || len(records) != 1 {
+		t.Errorf("list records = %v, %v", records, err)
+	}
+	if _, err := RecordsFrom([]interface{}{"a@b.co"}); err == nil {
+		t.Error("expected a list of strings to fail")
+	}
+}
+
+func TestContactFromRecord(t *testing.T) {
+	c, err := ContactFromRecord(map[string]interface{}{
+		"email":      " Jane@Example.com ",
+		"first_name": "Jane",
+		"tags":       "vip",
+		"industry":   "Retail",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Email != "jane@example.com" || c.FirstName != "Jane" || c.Fields["industry"] != "Retail" || len(c.Tags) != 1 {
+		t.Errorf("contact = %+v", c)
+	}
+	if _, err := ContactFromRecord(map[string]interface{}{"first_name": "Jane"}); err == nil {
+		t.Error("expected a record without email to fail")
+	}
+}
+
+func TestChunk(t *testing.T) {
+	chunks := Chunk([]int{1, 2, 3, 4, 5}, 2)
+	if !reflect.DeepEqual(chunks, [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Errorf("chunks = %v", chunks)
+	}
+	if Chunk([]int{}, 2) != nil {
+		t.Error("expected no chunks for no items")
+	}
+}
+
+func TestBulkOutput(t *testing.T) {
+	out := BulkOutput([]RowResult{
+		{Row: 1, Status: RowCreated},
+		{Row: 2, Status: RowFailed, Error: "invalid email"},
+		{Row: 3, Status: RowUpdated},
+	})
+	if out["total"] != 3 || out["succeeded"] != 2 || out["failed"] != 1 {
+		t.Errorf("output = %v", out)
+	}
+}
+
+func TestWaitForJob(t *testing.T) {
+	calls := 0
+	job, err := WaitForJob(context.Background(), time.Second, time.Millisecond, func() (Job, error) {
+		calls++
+		if calls == 3 {
+			return Job{ID: "1", State: JobCompleted}, nil
+		}
+		return Job{ID: "1", State: JobRunning}, nil
+	})
+	if err != nil || !job.Done() || calls != 3 {
+		t.Errorf("job = %+v, %v after %d calls", job, err, calls)
+	}
+
+	job, err = WaitForJob(context.Background(), 0, time.Second, func() (Job, error) {
+		return Job{State: JobQueued}, nil
+	})
+	if err != nil || job.Done() {
+		t.Errorf("job without wait = %+v, %v", job, err)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crm is the contact model shared by the CRM and email marketing
+// connectors, so each can offer the same "create or update by email"
+// action and map it onto its own field names.
+package crm
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+)
+
+// The standard contact fields, named as on the upsert form.
+const (
+	FieldEmail     = "email"
+	FieldPhone     = "phone"
+	FieldFirstName = "first_name"
+	FieldLastName  = "last_name"
+	FieldCompany   = "company"
+	FieldTags      = "tags"
+)
+
+// ErrNoEmail is returned for a contact without an email address, which
+// every upsert matches on.
+var ErrNoEmail = errors.New("an email address is required to find or create the contact")
+
+// Contact is a person as every CRM knows them. Fields holds custom
+// fields by the name or ID the CRM uses for them.
+type Contact struct {
+	Email     string
+	Phone     string
+	FirstName string
+	LastName  string
+	Company   string
+	Tags      []string
+	Fields    map[string]interface{}
+}
+
+// Normalize trims the contact, lowercases its email and checks that the
+// email is valid.
+func (c *Contact) Normalize() error {
+	c.Email = strings.ToLower(strings.TrimSpace(c.Email))
+	c.Phone = strings.TrimSpace(c.Phone)
+	c.FirstName = strings.TrimSpace(c.FirstName)
+	c.LastName = strings.TrimSpace(c.LastName)
+	c.Company = strings.TrimSpace(c.Company)
+	c.Tags = uniqueTags(c.Tags)
+
+	if c.Email == "" {
+		return ErrNoEmail
+	}
+	if addr, err := mail.ParseAddress(c.Email); err != nil || addr.Address != c.Email {
+		return fmt.Errorf("%q is not a valid email address", c.Email)
+	}
+	return nil
+}
+
+// Name returns the full name of the contact.
+func (c Contact) Name() string {
+	return strings.TrimSpace(c.FirstName + " " + c.LastName)
+}
+
+// standard returns the standard fields that are set, by field name.
+func (c Contact) standard() map[string]string {
+	values := map[string]string{
+		FieldEmail:     c.Email,
+		FieldPhone:     c.Phone,
+		FieldFirstName: c.FirstName,
+		FieldLastName:  c.LastName,
+		FieldCompany:   c.Company,
+	}
+	for k, v := range values {
+		if v == "" {
+			delete(values, k)
+		}
+	}
+	return values
+}
+
+// FieldMap names the CRM field each standard field is stored in. A
+// standard field missing from the map has no place in that CRM.
+type FieldMap map[string]string
+
+// Map returns the CRM fields of a contact: the standard fields that are
+// set under their CRM names, then the custom fields, which win over a
+// standard field of the same name. Empty fields are left out, so an
+// update never blanks a value. The standard fields that are set but
+// can't be stored are returned by name.
+func (m FieldMap) Map(c Contact) (map[string]interface{}, []string) {
+	fields, ignored := m.Standard(c)
+	for name, value := range c.Fields {
+		if value == nil || value == "" {
+			continue
+		}
+		fields[name] = value
+	}
+	return fields, ignored
+}
+
+// Standard is Map without the custom fields, for CRMs that keep custom
+// fields apart from the standard ones.
+func (m FieldMap) Standard(c Contact) (map[string]interface{}, []string) {
+	fields := map[string]interface{}{}
+	var ignored []string
+	for name, value := range c.standard() {
+		target, ok := m[name]
+		if !ok || target == "" {
+			ignored = append(ignored, name)
+			continue
+		}
+		fields[target] = value
+	}
+	sort.Strings(ignored)
+	return fields, ignored
+}
+
+// Result is the outcome of an upsert.
+type Result struct {
+	ID      string
+	Created bool
+	Contact interface{}
+	// Ignored lists the standard fields the CRM had no place for.
+	Ignored []string
+}
+
+// Output returns the result as action output.
+func (r Result) Output() map[string]interface{} {
+	action := "updated"
+	if r.Created {
+		action = "created"
+	}
+	ignored := r.Ignored
+	if ignored == nil {
+		ignored = []string{}
+	}
+	return map[string]interface{}{
+		"id":      r.ID,
+		"created": r.Created,
+		"action":  action,
+		"contact": r.Contact,
+		"ignored": ignored,
+	}
+}
+
+// SampleOutput is the output of an upsert for a contact record.
+func SampleOutput(contact map[string]any) map[string]any {
+	return map[string]any{
+		"id":      "51",
+		"created": true,
+		"action":  "created",
+		"contact": contact,
+		"ignored": []string{},
+	}
+}
+
+// MissingTags returns the tags that aren't known yet, matching names
+// without regard to case, so a connector can create them before tagging.
+func MissingTags(tags []string, known map[string]string) []string {
+	var missing []string
+	for _, tag := range tags {
+		if _, ok := known[strings.ToLower(tag)]; !ok {
+			missing = append(missing, tag)
+		}
+	}
+	return missing
+}
+
+func uniqueTags(tags []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, tag)
+	}
+	return out
+}
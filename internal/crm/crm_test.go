@@ -0,0 +1,96 @@
+package crm
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestContactFrom(t *testing.T) {
+	c, err := ContactFrom(map[string]interface{}{
+		"email":         " Jane@Example.com ",
+		"first_name":    "Jane",
+		"last_name":     "Doe",
+		"tags":          "vip, lead,VIP,, ",
+		"custom_fields": "industry=Retail\n# comment\nscore = 42",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Email != "jane@example.com" || c.Name() != "Jane Doe" {
+		t.Errorf("contact = %+v", c)
+	}
+	if !reflect.DeepEqual(c.Tags, []string{"vip", "lead"}) {
+		t.Errorf("tags = %q", c.Tags)
+	}
+	if c.Fields["industry"] != "Retail" || c.Fields["score"] != "42" {
+		t.Errorf("fields = %v", c.Fields)
+	}
+
+	if _, err := ContactFrom(map[string]interface{}{"first_name": "Jane"}); !errors.Is(err, ErrNoEmail) {
+		t.Errorf("missing email: %v", err)
+	}
+	if _, err := ContactFrom(map[string]interface{}{"email": "jane"}); err == nil {
+		t.Error("expected an invalid email to fail")
+	}
+	if _, err := ContactFrom(map[string]interface{}{"email": "a@b.co", "custom_fields": "industry"}); err == nil {
+		t.Error("expected a custom field line without = to fail")
+	}
+}
+
+func TestSplitTags(t *testing.T) {
+	if tags := SplitTags(" vip, lead,VIP,, "); !reflect.DeepEqual(tags, []string{"vip", "lead"}) {
+		t.Errorf("tags = %q", tags)
+	}
+	if tags := SplitTags(""); len(tags) != 0 {
+		t.Errorf("empty tags = %q", tags)
+	}
+}
+
+func TestParseFieldsJSON(t *testing.T) {
+	fields, err := ParseFields(`{"cf_score": 42, "cf_vip": true}`)
+	if err != nil || fields["cf_score"] != float64(42) || fields["cf_vip"] != true {
+		t.Errorf("fields = %v, %v", fields, err)
+	}
+	if _, err := ParseFields(`{"cf_score": }`); err == nil {
+		t.Error("expected invalid JSON to fail")
+	}
+}
+
+func TestFieldMapMap(t *testing.T) {
+	m := FieldMap{
+		FieldEmail:     "email",
+		FieldFirstName: "firstName",
+		FieldLastName:  "lastName",
+		FieldPhone:     "phone",
+	}
+	c := Contact{
+		Email:     "jane@example.com",
+		FirstName: "Jane",
+		Company:   "Acme",
+		Fields:    map[string]interface{}{"phone": "+1 555 0100", "empty": ""},
+	}
+
+	fields, ignored := m.Map(c)
+	want := map[string]interface{}{
+		"email":     "jane@example.com",
+		"firstName": "Jane",
+		"phone":     "+1 555 0100",
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("fields = %v, want %v", fields, want)
+	}
+	if !reflect.DeepEqual(ignored, []string{"company"}) {
+		t.Errorf("ignored = %q", ignored)
+	}
+}
+
+func TestResultOutput(t *testing.T) {
+	out := Result{ID: "7", Created: false}.Output()
+	if out["action"] != "updated" || out["created"] != false || out["ignored"] == nil {
+		t.Errorf("output = %v", out)
+	}
+	if got := MissingTags([]string{"VIP", "new"}, map[string]string{"vip": "1"}); !reflect.DeepEqual(got, []string{"new"}) {
+		t.Errorf("missing tags = %q", got)
+	}
+}
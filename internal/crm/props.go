@@ -0,0 +1,133 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+const customFieldsField = "custom_fields"
+
+// RegisterContactProps adds the standard contact fields, so every upsert
+// action takes a contact the same way.
+func RegisterContactProps(form *smartform.FormBuilder) {
+	form.TextField(FieldEmail, "Email").
+		Required(true).
+		HelpText("The contact is found by this email address, and created when there is none.")
+
+	form.TextField(FieldFirstName, "First Name").
+		Required(false)
+
+	form.TextField(FieldLastName, "Last Name").
+		Required(false)
+
+	form.TextField(FieldPhone, "Phone").
+		Required(false)
+
+	form.TextField(FieldCompany, "Company").
+		Required(false)
+
+	form.TextField(FieldTags, "Tags").
+		Required(false).
+		HelpText("Comma-separated tags to add to the contact. Existing tags are kept.")
+
+	form.TextareaField(customFieldsField, "Custom Fields").
+		Required(false).
+		HelpText(`Custom fields by the name or ID the CRM uses, one "field=value" per line or a JSON object.`)
+}
+
+// ContactFrom reads the contact registered by RegisterContactProps from
+// raw action input, and normalizes it.
+func ContactFrom(input map[string]interface{}) (Contact, error) {
+	fields, err := ParseFields(stringValue(input[customFieldsField]))
+	if err != nil {
+		return Contact{}, err
+	}
+
+	c := Contact{
+		Email:     stringValue(input[FieldEmail]),
+		Phone:     stringValue(input[FieldPhone]),
+		FirstName: stringValue(input[FieldFirstName]),
+		LastName:  stringValue(input[FieldLastName]),
+		Company:   stringValue(input[FieldCompany]),
+		Tags:      strings.Split(stringValue(input[FieldTags]), ","),
+		Fields:    fields,
+	}
+	if err := c.Normalize(); err != nil {
+		return Contact{}, err
+	}
+	return c, nil
+}
+
+// ParseFields reads custom fields given as a JSON object or as one
+// "field=value" per line.
+func ParseFields(text string) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return fields, nil
+	}
+
+	if strings.HasPrefix(text, "{") {
+		if err := json.Unmarshal([]byte(text), &fields); err != nil {
+			return nil, fmt.Errorf(`custom fields must be a JSON object like {"industry": "Retail"}: %w`, err)
+		}
+		return fields, nil
+	}
+
+	for n, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("line %d of the custom fields has %q, expected field=value", n+1, line)
+		}
+		fields[name] = strings.TrimSpace(value)
+	}
+	return fields, nil
+}
+
+// SplitTags reads comma-separated tags, dropping blanks and repeats
+// that differ only in case.
+func SplitTags(text string) []string {
+	return uniqueTags(strings.Split(text, ","))
+}
+
+func stringValue(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(s)
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, 0, len(s))
+		for _, item := range s {
+			parts = append(parts, stringValue(item))
+		}
+		return strings.Join(parts, ",")
+	default:
+		return strings.TrimSpace(fmt.Sprint(s))
+	}
+}
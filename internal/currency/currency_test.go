@@ -0,0 +1,23 @@
+package currency
+
+import "testing"
+
+func TestPlaces(t *testing.T) {
+	cases := map[string]int32{
+		"USD": 2,
+		"eur": 2,
+		"JPY": 0,
+		"krw": 0,
+		"BHD": 3,
+		"KWD": 3,
+		"JOD": 3,
+		"OMR": 3,
+		"tnd": 3,
+		"":    2,
+	}
+	for code, want := range cases {
+		if got := Places(code); got != want {
+			t.Errorf("Places(%q) = %d, want %d", code, got, want)
+		}
+	}
+}
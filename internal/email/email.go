@@ -0,0 +1,276 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package email holds the provider-neutral shape of a transactional
+// email and its delivery events, so the email connectors take the same
+// fields and report bounces and opens the same way. Providers implement
+// Sender; SMTP and SendGrid-compatible APIs are implemented here.
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// Address is a mailbox with an optional display name.
+type Address struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email"`
+}
+
+// String formats the address for a message header.
+func (a Address) String() string {
+	return (&mail.Address{Name: a.Name, Address: a.Email}).String()
+}
+
+// ParseAddress reads an address such as jane@example.com or
+// "Jane Doe <jane@example.com>".
+func ParseAddress(s string) (Address, error) {
+	parsed, err := mail.ParseAddress(strings.TrimSpace(s))
+	if err != nil {
+		return Address{}, fmt.Errorf("invalid email address %q", strings.TrimSpace(s))
+	}
+	return Address{Name: parsed.Name, Email: parsed.Address}, nil
+}
+
+// ParseAddressList reads addresses separated by commas, semicolons or
+// new lines. An empty string is no addresses.
+func ParseAddressList(s string) ([]Address, error) {
+	var list []Address
+	for _, part := range splitAddresses(s) {
+		a, err := ParseAddress(part)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	return list, nil
+}
+
+// splitAddresses splits a list of addresses, leaving separators inside
+// quoted display names alone.
+func splitAddresses(s string) []string {
+	var parts []string
+	var current strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			current.WriteRune(r)
+		case !quoted && (r == ',' || r == ';' || r == '\n'):
+			if p := strings.TrimSpace(current.String()); p != "" {
+				parts = append(parts, p)
+			}
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if p := strings.TrimSpace(current.String()); p != "" {
+		parts = append(parts, p)
+	}
+	return parts
+}
+
+// Attachment is a file attached to a message. With a ContentID, it is
+// shown inline where the HTML body references cid:ContentID.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+	ContentID   string
+}
+
+// Message is a transactional email.
+type Message struct {
+	From    Address
+	To      []Address
+	CC      []Address
+	BCC     []Address
+	ReplyTo []Address
+	Subject string
+	Text    string
+	HTML    string
+	// Headers are extra message headers, such as List-Unsubscribe.
+	Headers     map[string]string
+	Attachments []Attachment
+	// TemplateID names a template stored with the provider. Providers
+	// without stored templates reject it.
+	TemplateID string
+	// Variables fill the template, or the {{name}} placeholders of the
+	// subject and bodies when there is no template.
+	Variables map[string]interface{}
+	// Tags label the message for the provider's reports and events.
+	Tags []string
+}
+
+// Recipients returns every recipient, blind copies included.
+func (m *Message) Recipients() []Address {
+	all := make([]Address, 0, len(m.To)+len(m.CC)+len(m.BCC))
+	all = append(all, m.To...)
+	all = append(all, m.CC...)
+	return append(all, m.BCC...)
+}
+
+// reservedHeaders are set from the message fields and can't be given as
+// extra headers.
+var reservedHeaders = map[string]bool{
+	"from": true, "to": true, "cc": true, "bcc": true, "reply-to": true,
+	"subject": true, "date": true, "mime-version": true, "content-type": true,
+	"content-transfer-encoding": true,
+}
+
+// Validate checks that the message can be sent: a sender, a recipient, a
+// subject and a body or template, and headers that can't inject others.
+func (m *Message) Validate() error {
+	if m.From.Email == "" {
+		return errors.New("a sender address is required")
+	}
+	if len(m.To) == 0 {
+		return errors.New("at least one recipient is required")
+	}
+	if m.TemplateID == "" {
+		if strings.TrimSpace(m.Subject) == "" {
+			return errors.New("a subject is required")
+		}
+		if m.Text == "" && m.HTML == "" {
+			return errors.New("a text or HTML body is required")
+		}
+	}
+	if strings.ContainsAny(m.Subject, "\r\n") {
+		return errors.New("the subject must be a single line")
+	}
+	for name, value := range m.Headers {
+		if !validHeaderName(name) {
+			return fmt.Errorf("invalid header name %q", name)
+		}
+		if reservedHeaders[strings.ToLower(name)] {
+			return fmt.Errorf("header %s is set from the message fields", name)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("header %s must be a single line", name)
+		}
+	}
+	for _, a := range m.Attachments {
+		if a.Filename == "" {
+			return errors.New("every attachment needs a file name")
+		}
+	}
+	return nil
+}
+
+var headerNamePattern = regexp.MustCompile(`^[!-9;-~]+$`)
+
+func validHeaderName(name string) bool {
+	return headerNamePattern.MatchString(name)
+}
+
+// Result is what a provider reports for an accepted message.
+type Result struct {
+	Provider  string   `json:"provider"`
+	MessageID string   `json:"message_id,omitempty"`
+	Accepted  []string `json:"accepted"`
+	// Raw is the provider's response, kept in the output next to the
+	// common fields for steps that read it.
+	Raw map[string]interface{} `json:"-"`
+}
+
+// Output returns the result as action output.
+func (r *Result) Output() map[string]interface{} {
+	out := make(map[string]interface{}, len(r.Raw)+4)
+	for k, v := range r.Raw {
+		out[k] = v
+	}
+	out["provider"] = r.Provider
+	out["message_id"] = r.MessageID
+	out["accepted"] = r.Accepted
+	out["sent"] = true
+	return out
+}
+
+// Sender sends messages through a provider.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) (*Result, error)
+}
+
+// emails returns the bare addresses of a list.
+func emails(list []Address) []string {
+	out := make([]string, 0, len(list))
+	for _, a := range list {
+		out = append(out, a.Email)
+	}
+	return out
+}
+
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.\-]+)\s*\}\}`)
+
+// Render replaces the {{name}} placeholders of a text with variables.
+// Dotted names read nested objects, and placeholders without a value
+// are left as they are so missing data shows in tests. With escape,
+// values are HTML-escaped.
+func Render(text string, vars map[string]interface{}, escape bool) string {
+	if len(vars) == 0 {
+		return text
+	}
+	return placeholderPattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := placeholderPattern.FindStringSubmatch(match)[1]
+		value, ok := lookup(vars, name)
+		if !ok {
+			return match
+		}
+		s := fmt.Sprint(value)
+		if escape {
+			s = html.EscapeString(s)
+		}
+		return s
+	})
+}
+
+func lookup(vars map[string]interface{}, name string) (interface{}, bool) {
+	if v, ok := vars[name]; ok {
+		return v, v != nil
+	}
+	current := vars
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		v, ok := current[part]
+		if !ok || v == nil {
+			return nil, false
+		}
+		if i == len(parts)-1 {
+			return v, true
+		}
+		if current, ok = v.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// ApplyVariables renders the variables into the subject and bodies of a
+// message without a provider template, for providers that have none.
+func (m *Message) ApplyVariables() {
+	if m.TemplateID != "" || len(m.Variables) == 0 {
+		return
+	}
+	m.Subject = Render(m.Subject, m.Variables, false)
+	m.Text = Render(m.Text, m.Variables, false)
+	m.HTML = Render(m.HTML, m.Variables, true)
+}
@@ -0,0 +1,306 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseAddressList(t *testing.T) {
+	list, err := ParseAddressList(`jane@example.com; "Doe, John" <john@example.com>` + "\nops@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Address{
+		{Email: "jane@example.com"},
+		{Name: "Doe, John", Email: "john@example.com"},
+		{Email: "ops@example.com"},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("list = %+v", list)
+	}
+	if list, err := ParseAddressList(" "); err != nil || list != nil {
+		t.Errorf("empty list = %v, %v", list, err)
+	}
+	if _, err := ParseAddressList("jane@example.com, not an address"); err == nil {
+		t.Error("expected an invalid address to fail")
+	}
+}
+
+func TestRender(t *testing.T) {
+	vars := map[string]interface{}{
+		"first_name": "Jane",
+		"order":      map[string]interface{}{"id": 42.0},
+		"note":       "<b>fragile</b>",
+	}
+	got := Render("Hi {{first_name}}, order {{ order.id }} {{missing}}", vars, false)
+	if got != "Hi Jane, order 42 {{missing}}" {
+		t.Errorf("text = %q", got)
+	}
+	if got := Render("<p>{{note}}</p>", vars, true); got != "<p>&lt;b&gt;fragile&lt;/b&gt;</p>" {
+		t.Errorf("html = %q", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	base := func() *Message {
+		return &Message{
+			From:    Address{Email: "shop@example.com"},
+			To:      []Address{{Email: "jane@example.com"}},
+			Subject: "Your order",
+			Text:    "Thanks!",
+		}
+	}
+	if err := base().Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]func(m *Message){
+		"no sender":        func(m *Message) { m.From = Address{} },
+		"no recipient":     func(m *Message) { m.To = nil },
+		"no body":          func(m *Message) { m.Text = "" },
+		"injected subject": func(m *Message) { m.Subject = "Hi\r\nBcc: evil@example.com" },
+		"injected header":  func(m *Message) { m.Headers = map[string]string{"X-Tag": "a\nBcc: evil@example.com"} },
+		"reserved header":  func(m *Message) { m.Headers = map[string]string{"bcc": "evil@example.com"} },
+		"bad header name":  func(m *Message) { m.Headers = map[string]string{"X Tag": "a"} },
+	}
+	for name, change := range cases {
+		m := base()
+		change(m)
+		if err := m.Validate(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	m := base()
+	m.Subject, m.Text, m.TemplateID = "", "", "d-123"
+	if err := m.Validate(); err != nil {
+		t.Errorf("template message: %v", err)
+	}
+}
+
+func TestMIME(t *testing.T) {
+	msg := &Message{
+		From:        Address{Name: "Acme Shop", Email: "shop@example.com"},
+		To:          []Address{{Name: "Jane", Email: "jane@example.com"}},
+		BCC:         []Address{{Email: "audit@example.com"}},
+		ReplyTo:     []Address{{Email: "support@example.com"}},
+		Subject:     "Ihre Bestellung ist unterwegs",
+		Text:        "Hello Jane",
+		HTML:        "<p>Hello Jane</p>",
+		Headers:     map[string]string{"x-campaign": "spring"},
+		Attachments: []Attachment{{Filename: "invoice.pdf", Content: []byte("%PDF-1.4")}},
+	}
+	data, err := msg.MIME(MIMEOptions{MessageID: "<1@example.com>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := parsed.Header
+	if h.Get("Bcc") != "" {
+		t.Error("Bcc must not be written by default")
+	}
+	if h.Get("Reply-To") != "<support@example.com>" || h.Get("X-Campaign") != "spring" || h.Get("Message-Id") != "<1@example.com>" {
+		t.Errorf("headers = %v", h)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("content type = %q, %v", mediaType, err)
+	}
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	body, err := reader.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(body.Header.Get("Content-Type"), "multipart/alternative") {
+		t.Errorf("body part = %q", body.Header.Get("Content-Type"))
+	}
+	file, err := reader.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.FileName() != "invoice.pdf" || file.Header.Get("Content-Type") != `application/pdf; name=invoice.pdf` {
+		t.Errorf("attachment header = %v", file.Header)
+	}
+	encoded, _ := io.ReadAll(file)
+	decoded, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if string(decoded) != "%PDF-1.4" {
+		t.Errorf("attachment = %q", decoded)
+	}
+
+	data, err = msg.MIME(MIMEOptions{IncludeBCC: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed, _ := mail.ReadMessage(bytes.NewReader(data)); parsed.Header.Get("Bcc") != "<audit@example.com>" {
+		t.Errorf("Bcc = %q", parsed.Header.Get("Bcc"))
+	}
+}
+
+func TestParseHeaders(t *testing.T) {
+	headers, err := ParseHeaders("List-Unsubscribe: <https://example.com/u>\n\nX-Priority: 1")
+	if err != nil || headers["List-Unsubscribe"] != "<https://example.com/u>" || headers["X-Priority"] != "1" {
+		t.Errorf("headers = %v, %v", headers, err)
+	}
+	if headers, err := ParseHeaders(`{"X-Tag": "a"}`); err != nil || headers["X-Tag"] != "a" {
+		t.Errorf("JSON headers = %v, %v", headers, err)
+	}
+	if _, err := ParseHeaders("no colon"); err == nil {
+		t.Error("expected a header without a colon to fail")
+	}
+}
+
+func TestResultOutput(t *testing.T) {
+	r := &Result{Provider: "mailjet", MessageID: "1", Accepted: []string{"a@example.com"},
+		Raw: map[string]interface{}{"Messages": []interface{}{}, "provider": "raw"}}
+	out := r.Output()
+	if out["provider"] != "mailjet" || out["sent"] != true {
+		t.Errorf("common fields = %v", out)
+	}
+	if _, ok := out["Messages"]; !ok {
+		t.Error("the raw response should be kept")
+	}
+}
+
+func TestSendGridSend(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/mail/send" || r.Header.Get("Authorization") != "Bearer key" {
+			t.Errorf("request = %s %s", r.URL.Path, r.Header.Get("Authorization"))
+		}
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.Header().Set("X-Message-Id", "abc123")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := &SendGridSender{APIKey: "key", BaseURL: server.URL}
+	result, err := sender.Send(context.Background(), &Message{
+		From:        Address{Email: "shop@example.com"},
+		To:          []Address{{Email: "jane@example.com"}},
+		ReplyTo:     []Address{{Email: "support@example.com"}},
+		Subject:     "Hi {{name}}",
+		HTML:        "<p>Hi {{name}}</p>",
+		Text:        "Hi {{name}}",
+		Variables:   map[string]interface{}{"name": "Jane"},
+		Attachments: []Attachment{{Filename: "a.txt", Content: []byte("x")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.MessageID != "abc123" || !reflect.DeepEqual(result.Accepted, []string{"jane@example.com"}) {
+		t.Errorf("result = %+v", result)
+	}
+	if payload["subject"] != "Hi Jane" {
+		t.Errorf("subject = %v", payload["subject"])
+	}
+	content := payload["content"].([]interface{})
+	if content[0].(map[string]interface{})["type"] != "text/plain" {
+		t.Errorf("content = %v", content)
+	}
+	if payload["reply_to"].(map[string]interface{})["email"] != "support@example.com" {
+		t.Errorf("reply_to = %v", payload["reply_to"])
+	}
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"The from address does not match a verified Sender Identity.","field":"from"}]}`))
+	})
+	_, err = sender.Send(context.Background(), &Message{From: Address{Email: "x@example.com"}, To: []Address{{Email: "jane@example.com"}}, Subject: "s", Text: "t"})
+	if err == nil || !strings.Contains(err.Error(), "from: The from address") {
+		t.Errorf("error = %v", err)
+	}
+}
+
+func TestParseSendGridEvents(t *testing.T) {
+	body := []byte(`[
+		{"email":"jane@example.com","timestamp":1700000000,"event":"bounce","type":"bounce","reason":"550 unknown user","sg_message_id":"abc123.filter0001","category":["welcome"]},
+		{"email":"jane@example.com","timestamp":1700000100,"event":"click","url":"https://example.com","sg_message_id":"abc123.filter0002"},
+		{"email":"jane@example.com","event":"group_resubscribe"}
+	]`)
+	events, err := ParseSendGridEvents(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("events = %+v", events)
+	}
+	bounce := events[0]
+	if bounce.Type != EventBounced || !bounce.Permanent || bounce.MessageID != "abc123" || bounce.Reason != "550 unknown user" ||
+		bounce.Timestamp != "2023-11-14T22:13:20Z" || !reflect.DeepEqual(bounce.Tags, []string{"welcome"}) {
+		t.Errorf("bounce = %+v", bounce)
+	}
+	if events[1].Type != EventClicked || events[1].URL != "https://example.com" {
+		t.Errorf("click = %+v", events[1])
+	}
+	if got := FilterEvents(events, []EventType{EventClicked}); len(got) != 1 || got[0].Type != EventClicked {
+		t.Errorf("filtered = %+v", got)
+	}
+}
+
+func TestParseMailjetEvents(t *testing.T) {
+	events, err := ParseMailjetEvents([]byte(`{"event":"bounce","time":1700000000,"MessageID":19421777835146490,"email":"jane@example.com","hard_bounce":true,"error_related_to":"recipient","error":"user unknown","CustomID":"welcome, onboarding"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("events = %+v", events)
+	}
+	e := events[0]
+	if e.Type != EventBounced || !e.Permanent || e.MessageID != "19421777835146490" || e.Reason != "recipient: user unknown" ||
+		!reflect.DeepEqual(e.Tags, []string{"welcome", "onboarding"}) {
+		t.Errorf("event = %+v", e)
+	}
+
+	events, err = ParseMailjetEvents([]byte(`[{"event":"sent","email":"a@example.com"},{"event":"open","email":"b@example.com"}]`))
+	if err != nil || len(events) != 2 || events[0].Type != EventDelivered || events[1].Type != EventOpened {
+		t.Errorf("grouped events = %+v, %v", events, err)
+	}
+	if names := MailjetEventNames([]EventType{EventOpened, EventBounced}); !reflect.DeepEqual(names, []string{"open", "bounce"}) {
+		t.Errorf("names = %v", names)
+	}
+}
+
+func TestVerifySendGridSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	publicKey := base64.StdEncoding.EncodeToString(der)
+
+	body := []byte(`[{"event":"open"}]`)
+	timestamp := "1700000000"
+	digest := sha256.Sum256(append([]byte(timestamp), body...))
+	sig, _ := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	signature := base64.StdEncoding.EncodeToString(sig)
+
+	if err := VerifySendGridSignature(publicKey, signature, timestamp, body); err != nil {
+		t.Errorf("valid signature: %v", err)
+	}
+	if err := VerifySendGridSignature(publicKey, signature, timestamp, []byte(`[{"event":"click"}]`)); err == nil {
+		t.Error("expected a tampered body to fail")
+	}
+	if err := VerifySendGridSignature(publicKey, "", timestamp, body); err == nil {
+		t.Error("expected a missing signature to fail")
+	}
+}
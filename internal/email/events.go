@@ -0,0 +1,409 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+// EventType is a provider-neutral delivery event.
+type EventType string
+
+const (
+	// EventAccepted is a message the provider accepted for delivery.
+	EventAccepted EventType = "accepted"
+	// EventDelivered is a message the recipient's server accepted.
+	EventDelivered EventType = "delivered"
+	// EventDeferred is a delivery the provider will retry.
+	EventDeferred EventType = "deferred"
+	// EventBounced is a message the recipient's server rejected.
+	EventBounced EventType = "bounced"
+	// EventDropped is a message the provider refused to send, such as to
+	// an address that bounced before.
+	EventDropped      EventType = "dropped"
+	EventOpened       EventType = "opened"
+	EventClicked      EventType = "clicked"
+	EventComplained   EventType = "complained"
+	EventUnsubscribed EventType = "unsubscribed"
+)
+
+// EventTypeOptions lists the event types for trigger filters.
+var EventTypeOptions = []*smartform.Option{
+	{Value: string(EventAccepted), Label: "Accepted"},
+	{Value: string(EventDelivered), Label: "Delivered"},
+	{Value: string(EventDeferred), Label: "Deferred"},
+	{Value: string(EventBounced), Label: "Bounced"},
+	{Value: string(EventDropped), Label: "Dropped"},
+	{Value: string(EventOpened), Label: "Opened"},
+	{Value: string(EventClicked), Label: "Clicked"},
+	{Value: string(EventComplained), Label: "Marked as Spam"},
+	{Value: string(EventUnsubscribed), Label: "Unsubscribed"},
+}
+
+// EventTypesField is the trigger field filtering event types.
+const EventTypesField = "event_types"
+
+// RegisterEventTypeProps adds the event type filter of a delivery event
+// trigger.
+func RegisterEventTypeProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	return form.MultiSelectField(EventTypesField, "Events").
+		Required(false).
+		AddOptions(EventTypeOptions...).
+		HelpText("The events to trigger on. Leave empty for all events.")
+}
+
+// EventTypesFrom reads the event type filter of a trigger. No types is
+// every type.
+func EventTypesFrom(input map[string]interface{}) []EventType {
+	var types []EventType
+	switch v := input[EventTypesField].(type) {
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				types = append(types, EventType(s))
+			}
+		}
+	case []string:
+		for _, s := range v {
+			types = append(types, EventType(s))
+		}
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				types = append(types, EventType(s))
+			}
+		}
+	}
+	return types
+}
+
+// Event is a delivery event of a sent message.
+type Event struct {
+	Provider string    `json:"provider"`
+	Type     EventType `json:"type"`
+	Email    string    `json:"email"`
+	// MessageID is the provider's ID of the message, as returned when
+	// it was sent.
+	MessageID string `json:"message_id,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
+	// Reason explains bounces, drops and deferrals.
+	Reason string `json:"reason,omitempty"`
+	// Permanent marks a hard bounce: the address will never accept mail.
+	Permanent bool     `json:"permanent,omitempty"`
+	URL       string   `json:"url,omitempty"`
+	UserAgent string   `json:"user_agent,omitempty"`
+	IP        string   `json:"ip,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	// Raw is the event as the provider sent it.
+	Raw map[string]interface{} `json:"raw"`
+}
+
+// Output returns the event as trigger output.
+func (e Event) Output() map[string]interface{} {
+	out := map[string]interface{}{
+		"provider":  e.Provider,
+		"type":      string(e.Type),
+		"email":     e.Email,
+		"permanent": e.Permanent,
+		"tags":      e.Tags,
+		"raw":       e.Raw,
+	}
+	for key, value := range map[string]string{
+		"message_id": e.MessageID,
+		"timestamp":  e.Timestamp,
+		"reason":     e.Reason,
+		"url":        e.URL,
+		"user_agent": e.UserAgent,
+		"ip":         e.IP,
+	} {
+		if value != "" {
+			out[key] = value
+		}
+	}
+	if len(e.Tags) == 0 {
+		out["tags"] = []string{}
+	}
+	return out
+}
+
+// EventsOutput returns events as trigger output.
+func EventsOutput(events []Event) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(events))
+	for _, e := range events {
+		out = append(out, e.Output())
+	}
+	return out
+}
+
+// FilterEvents keeps the events of the given types; no types keeps all.
+func FilterEvents(events []Event, types []EventType) []Event {
+	out := make([]Event, 0, len(events))
+	for _, e := range events {
+		if len(types) == 0 || containsType(types, e.Type) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func containsType(types []EventType, t EventType) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+// sendGridEventTypes maps SendGrid event webhook events.
+var sendGridEventTypes = map[string]EventType{
+	"processed":         EventAccepted,
+	"delivered":         EventDelivered,
+	"deferred":          EventDeferred,
+	"bounce":            EventBounced,
+	"dropped":           EventDropped,
+	"open":              EventOpened,
+	"click":             EventClicked,
+	"spamreport":        EventComplained,
+	"unsubscribe":       EventUnsubscribed,
+	"group_unsubscribe": EventUnsubscribed,
+}
+
+// SendGridEventNames returns the SendGrid event webhook settings that
+// enable the given event types, or all of them when none are given.
+func SendGridEventNames(types []EventType) []string {
+	settings := map[string]string{
+		"processed": "processed", "delivered": "delivered", "deferred": "deferred",
+		"bounce": "bounce", "dropped": "dropped", "open": "open", "click": "click",
+		"spamreport": "spam_report", "unsubscribe": "unsubscribe", "group_unsubscribe": "group_unsubscribe",
+	}
+	var names []string
+	for _, event := range []string{"processed", "delivered", "deferred", "bounce", "dropped", "open", "click", "spamreport", "unsubscribe", "group_unsubscribe"} {
+		if len(types) == 0 || containsType(types, sendGridEventTypes[event]) {
+			names = append(names, settings[event])
+		}
+	}
+	return names
+}
+
+// ParseSendGridEvents reads a SendGrid event webhook delivery, a JSON
+// array of events. Events of unknown types are skipped.
+func ParseSendGridEvents(body []byte) ([]Event, error) {
+	var raw []map[string]interface{}
+	if err := decodeJSON(body, &raw); err != nil {
+		return nil, fmt.Errorf("invalid SendGrid event payload: %w", err)
+	}
+	events := make([]Event, 0, len(raw))
+	for _, r := range raw {
+		t, ok := sendGridEventTypes[stringField(r, "event")]
+		if !ok {
+			continue
+		}
+		e := Event{
+			Provider:  "sendgrid",
+			Type:      t,
+			Email:     stringField(r, "email"),
+			Timestamp: unixTime(r["timestamp"]),
+			Reason:    stringField(r, "reason"),
+			URL:       stringField(r, "url"),
+			UserAgent: stringField(r, "useragent"),
+			IP:        stringField(r, "ip"),
+			Tags:      stringList(r["category"]),
+			Raw:       r,
+		}
+		// sg_message_id is the X-Message-Id returned on send, followed by
+		// a filter suffix.
+		e.MessageID, _, _ = strings.Cut(stringField(r, "sg_message_id"), ".")
+		if t == EventBounced {
+			e.Permanent = stringField(r, "type") != "blocked"
+		}
+		if t == EventDeferred && e.Reason == "" {
+			e.Reason = stringField(r, "response")
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// mailjetEventTypes maps Mailjet event callback events.
+var mailjetEventTypes = map[string]EventType{
+	"sent":    EventDelivered,
+	"open":    EventOpened,
+	"click":   EventClicked,
+	"bounce":  EventBounced,
+	"blocked": EventDropped,
+	"spam":    EventComplained,
+	"unsub":   EventUnsubscribed,
+}
+
+// MailjetEventNames returns the Mailjet event callback types that report
+// the given event types, or all of them when none are given.
+func MailjetEventNames(types []EventType) []string {
+	var names []string
+	for _, event := range []string{"sent", "open", "click", "bounce", "blocked", "spam", "unsub"} {
+		if len(types) == 0 || containsType(types, mailjetEventTypes[event]) {
+			names = append(names, event)
+		}
+	}
+	return names
+}
+
+// ParseMailjetEvents reads a Mailjet event callback delivery: one event,
+// or a JSON array of them when grouping is on.
+func ParseMailjetEvents(body []byte) ([]Event, error) {
+	var raw []map[string]interface{}
+	trimmed := strings.TrimSpace(string(body))
+	if strings.HasPrefix(trimmed, "{") {
+		var single map[string]interface{}
+		if err := decodeJSON(body, &single); err != nil {
+			return nil, fmt.Errorf("invalid Mailjet event payload: %w", err)
+		}
+		raw = append(raw, single)
+	} else if err := decodeJSON(body, &raw); err != nil {
+		return nil, fmt.Errorf("invalid Mailjet event payload: %w", err)
+	}
+
+	events := make([]Event, 0, len(raw))
+	for _, r := range raw {
+		t, ok := mailjetEventTypes[stringField(r, "event")]
+		if !ok {
+			continue
+		}
+		e := Event{
+			Provider:  "mailjet",
+			Type:      t,
+			Email:     stringField(r, "email"),
+			MessageID: stringField(r, "MessageID"),
+			Timestamp: unixTime(r["time"]),
+			URL:       stringField(r, "url"),
+			UserAgent: stringField(r, "agent"),
+			IP:        stringField(r, "ip"),
+			Raw:       r,
+		}
+		if e.MessageID == "" {
+			e.MessageID = stringField(r, "Message_GUID")
+		}
+		if reason := stringField(r, "error"); reason != "" {
+			e.Reason = reason
+			if related := stringField(r, "error_related_to"); related != "" {
+				e.Reason = related + ": " + reason
+			}
+		}
+		if t == EventBounced {
+			e.Permanent, _ = r["hard_bounce"].(bool)
+		}
+		for _, tag := range strings.Split(stringField(r, "CustomID"), ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				e.Tags = append(e.Tags, tag)
+			}
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// VerifySendGridSignature checks the signature of a signed SendGrid event
+// webhook delivery: an ECDSA signature over the timestamp header followed
+// by the raw body, made with the key whose public half SendGrid shows
+// with the webhook.
+func VerifySendGridSignature(publicKey, signature, timestamp string, body []byte) error {
+	if signature == "" || timestamp == "" {
+		return errors.New("missing SendGrid signature headers")
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil {
+		return fmt.Errorf("invalid SendGrid verification key: %w", err)
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return fmt.Errorf("invalid SendGrid verification key: %w", err)
+	}
+	key, ok := parsed.(*ecdsa.PublicKey)
+	if !ok {
+		return errors.New("the SendGrid verification key is not an ECDSA key")
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return errors.New("invalid SendGrid signature encoding")
+	}
+	digest := sha256.Sum256(append([]byte(timestamp), body...))
+	if !ecdsa.VerifyASN1(key, digest[:], sig) {
+		return errors.New("invalid SendGrid webhook signature")
+	}
+	return nil
+}
+
+func stringField(m map[string]interface{}, key string) string {
+	switch v := m[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+func stringList(v interface{}) []string {
+	switch list := v.(type) {
+	case string:
+		return []string{list}
+	case []interface{}:
+		var out []string
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// decodeJSON decodes keeping numbers as they were sent, as Mailjet
+// message IDs don't fit a float64.
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// unixTime formats a Unix timestamp as RFC 3339.
+func unixTime(v interface{}) string {
+	n, ok := v.(json.Number)
+	if !ok {
+		return ""
+	}
+	seconds, err := n.Int64()
+	if err != nil || seconds == 0 {
+		return ""
+	}
+	return time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+}
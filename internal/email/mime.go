@@ -0,0 +1,233 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MIMEOptions tune how a message is encoded.
+type MIMEOptions struct {
+	// IncludeBCC writes the Bcc header, for APIs such as Gmail's that
+	// read the recipients from the message and strip it before delivery.
+	// SMTP passes blind copies as envelope recipients instead.
+	IncludeBCC bool
+	// Date is the Date header; the current time when zero.
+	Date time.Time
+	// MessageID is the Message-ID header; generated from the sender's
+	// domain when empty.
+	MessageID string
+}
+
+// MIME encodes the message as an RFC 5322 message: a text or HTML body,
+// both as alternatives, and attachments.
+func (m *Message) MIME(opts MIMEOptions) ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Date.IsZero() {
+		opts.Date = time.Now()
+	}
+	if opts.MessageID == "" {
+		opts.MessageID = NewMessageID(m.From.Email)
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, "From", m.From.String())
+	writeHeader(&buf, "To", joinAddresses(m.To))
+	if len(m.CC) > 0 {
+		writeHeader(&buf, "Cc", joinAddresses(m.CC))
+	}
+	if opts.IncludeBCC && len(m.BCC) > 0 {
+		writeHeader(&buf, "Bcc", joinAddresses(m.BCC))
+	}
+	if len(m.ReplyTo) > 0 {
+		writeHeader(&buf, "Reply-To", joinAddresses(m.ReplyTo))
+	}
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	writeHeader(&buf, "Date", opts.Date.Format(time.RFC1123Z))
+	writeHeader(&buf, "Message-ID", opts.MessageID)
+	writeHeader(&buf, "MIME-Version", "1.0")
+
+	names := make([]string, 0, len(m.Headers))
+	for name := range m.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeHeader(&buf, textproto.CanonicalMIMEHeaderKey(name), mime.QEncoding.Encode("utf-8", m.Headers[name]))
+	}
+
+	if len(m.Attachments) == 0 {
+		if err := m.writeBody(&buf); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	writeHeader(&buf, "Content-Type", "multipart/mixed; boundary="+mixed.Boundary())
+	buf.WriteString("\r\n")
+
+	var body bytes.Buffer
+	if err := m.writeBody(&body); err != nil {
+		return nil, err
+	}
+	header, content, _ := bytes.Cut(body.Bytes(), []byte("\r\n\r\n"))
+	part, err := mixed.CreatePart(parseHeader(header))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+
+	for _, a := range m.Attachments {
+		if err := writeAttachment(mixed, a); err != nil {
+			return nil, err
+		}
+	}
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeBody writes the Content-Type header and the body: the text or the
+// HTML, or both as alternatives.
+func (m *Message) writeBody(w *bytes.Buffer) error {
+	switch {
+	case m.HTML == "":
+		return writeTextPart(w, "text/plain", m.Text)
+	case m.Text == "":
+		return writeTextPart(w, "text/html", m.HTML)
+	}
+
+	alt := multipart.NewWriter(w)
+	writeHeader(w, "Content-Type", "multipart/alternative; boundary="+alt.Boundary())
+	w.WriteString("\r\n")
+	for _, p := range []struct{ contentType, text string }{{"text/plain", m.Text}, {"text/html", m.HTML}} {
+		part, err := alt.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {p.contentType + "; charset=UTF-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return err
+		}
+		if err := writeQuotedPrintable(part, p.text); err != nil {
+			return err
+		}
+	}
+	return alt.Close()
+}
+
+func writeTextPart(w *bytes.Buffer, contentType, text string) error {
+	writeHeader(w, "Content-Type", contentType+"; charset=UTF-8")
+	writeHeader(w, "Content-Transfer-Encoding", "quoted-printable")
+	w.WriteString("\r\n")
+	return writeQuotedPrintable(w, text)
+}
+
+func writeQuotedPrintable(w io.Writer, text string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(strings.ReplaceAll(text, "\r\n", "\n"))); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func writeAttachment(w *multipart.Writer, a Attachment) error {
+	contentType := a.ContentType
+	if contentType == "" {
+		contentType = ContentType(a.Filename)
+	}
+	disposition := "attachment"
+	header := textproto.MIMEHeader{}
+	if a.ContentID != "" {
+		disposition = "inline"
+		header.Set("Content-ID", "<"+strings.Trim(a.ContentID, "<>")+">")
+	}
+	header.Set("Content-Type", mime.FormatMediaType(contentType, map[string]string{"name": a.Filename}))
+	header.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": a.Filename}))
+	header.Set("Content-Transfer-Encoding", "base64")
+
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	encoded := base64.StdEncoding.EncodeToString(a.Content)
+	for len(encoded) > 76 {
+		if _, err := io.WriteString(part, encoded[:76]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = io.WriteString(part, encoded+"\r\n")
+	return err
+}
+
+// ContentType guesses the MIME type of a file from its name.
+func ContentType(filename string) string {
+	if t := mime.TypeByExtension(strings.ToLower(filepath.Ext(filename))); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// NewMessageID returns a unique Message-ID on the domain of an address.
+func NewMessageID(from string) string {
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 && at < len(from)-1 {
+		domain = from[at+1:]
+	}
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b[:]), domain)
+}
+
+func writeHeader(w *bytes.Buffer, name, value string) {
+	w.WriteString(name + ": " + value + "\r\n")
+}
+
+func parseHeader(raw []byte) textproto.MIMEHeader {
+	header := textproto.MIMEHeader{}
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		if name, value, ok := strings.Cut(line, ": "); ok {
+			header.Add(name, value)
+		}
+	}
+	return header
+}
+
+func joinAddresses(list []Address) string {
+	parts := make([]string, 0, len(list))
+	for _, a := range list {
+		parts = append(parts, a.String())
+	}
+	return strings.Join(parts, ", ")
+}
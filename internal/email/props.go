@@ -0,0 +1,302 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/media"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// RegisterSenderProps adds the sender fields. Providers that send as the
+// connected account make the address optional.
+func RegisterSenderProps(form *smartform.FormBuilder, required bool) {
+	help := "The sender address, such as hello@example.com. It must be verified with the provider."
+	if !required {
+		help = "The sender address. Defaults to the connected account."
+	}
+	form.TextField("from_email", "From Email").
+		Required(required).
+		HelpText(help)
+
+	form.TextField("from_name", "From Name").
+		Required(false)
+}
+
+// RegisterMessageProps adds the recipient, content, attachment and
+// header fields of a message.
+func RegisterMessageProps(form *smartform.FormBuilder) {
+	form.TextField("to", "To").
+		Required(true).
+		HelpText(`Recipients separated by commas, as jane@example.com or "Jane Doe <jane@example.com>".`)
+
+	form.TextField("cc", "CC").
+		Required(false)
+
+	form.TextField("bcc", "BCC").
+		Required(false)
+
+	form.TextField("reply_to", "Reply To").
+		Required(false)
+
+	form.TextField("subject", "Subject").
+		Required(false).
+		HelpText("Required unless a template sets it. {{name}} placeholders are filled from the variables.")
+
+	form.TextareaField("text", "Text Body").
+		Required(false).
+		HelpText("The plain-text body.")
+
+	form.TextareaField("html", "HTML Body").
+		Required(false).
+		HelpText("The HTML body. With a text body too, clients pick the one they can show.")
+
+	attachments := form.ArrayField("attachments", "Attachments")
+	attachment := attachments.ObjectTemplate("attachment", "")
+	attachment.FileField("file", "File").
+		Required(true).
+		HelpText("A file from a file field or a previous step, a URL, or a data URI.")
+	attachment.TextField("filename", "File Name").
+		Required(false).
+		HelpText("The name the recipient sees. Defaults to the file's name.")
+	attachment.TextField("content_id", "Content ID").
+		Required(false).
+		HelpText("Shows the file inline where the HTML body references cid:<content ID>.")
+
+	form.TextareaField("headers", "Headers").
+		Required(false).
+		HelpText(`Extra headers, one "Name: value" per line or a JSON object, such as List-Unsubscribe.`)
+}
+
+// RegisterTemplateProps adds the template fields. With stored templates,
+// the provider renders the template ID with the variables; without,
+// only the placeholders of the subject and bodies are filled.
+func RegisterTemplateProps(form *smartform.FormBuilder, stored bool) {
+	if stored {
+		form.TextField("template_id", "Template ID").
+			Required(false).
+			HelpText("A template stored with the provider, which sets the content instead of the bodies.")
+	}
+
+	form.TextareaField("variables", "Variables").
+		Required(false).
+		HelpText(`Values of the template variables and {{name}} placeholders as a JSON object, such as {"first_name": "Jane"}.`)
+
+	form.TextField("tags", "Tags").
+		Required(false).
+		HelpText("Comma-separated labels for the provider's reports and delivery events.")
+}
+
+func isEmpty(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(value) == ""
+	default:
+		return false
+	}
+}
+
+// MessageFrom builds a message from the fields of RegisterSenderProps,
+// RegisterMessageProps and RegisterTemplateProps, loading attachments
+// from files. It is not validated, as providers fill the sender in.
+func MessageFrom(ctx context.Context, files sdkcontext.FileResource, input map[string]interface{}) (*Message, error) {
+	msg := &Message{
+		Subject:    str(input["subject"]),
+		Text:       str(input["text"]),
+		HTML:       str(input["html"]),
+		TemplateID: strings.TrimSpace(fmt.Sprint(valueOr(input["template_id"], ""))),
+	}
+	if msg.TemplateID == "0" {
+		msg.TemplateID = ""
+	}
+
+	if from := strings.TrimSpace(str(input["from_email"])); from != "" {
+		a, err := ParseAddress(from)
+		if err != nil {
+			return nil, fmt.Errorf("from: %w", err)
+		}
+		msg.From = a
+	}
+	if name := strings.TrimSpace(str(input["from_name"])); name != "" {
+		msg.From.Name = name
+	}
+
+	for field, list := range map[string]*[]Address{"to": &msg.To, "cc": &msg.CC, "bcc": &msg.BCC, "reply_to": &msg.ReplyTo} {
+		addresses, err := ParseAddressList(str(input[field]))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field, err)
+		}
+		*list = addresses
+	}
+
+	headers, err := ParseHeaders(input["headers"])
+	if err != nil {
+		return nil, err
+	}
+	msg.Headers = headers
+
+	vars, err := ParseVariables(input["variables"])
+	if err != nil {
+		return nil, err
+	}
+	msg.Variables = vars
+
+	for _, tag := range strings.Split(str(input["tags"]), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			msg.Tags = append(msg.Tags, tag)
+		}
+	}
+
+	if msg.Attachments, err = LoadAttachments(ctx, files, input["attachments"]); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// LoadAttachments loads the attachments field: a list of items holding a
+// file, or of files, or a single file.
+func LoadAttachments(ctx context.Context, files sdkcontext.FileResource, v interface{}) ([]Attachment, error) {
+	var items []interface{}
+	switch value := v.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		items = value
+	case string:
+		if strings.TrimSpace(value) == "" {
+			return nil, nil
+		}
+		items = []interface{}{value}
+	default:
+		items = []interface{}{value}
+	}
+
+	attachments := make([]Attachment, 0, len(items))
+	for i, item := range items {
+		source := item
+		var filename, contentID string
+		if m, ok := item.(map[string]interface{}); ok {
+			if file, ok := m["file"]; ok {
+				source = file
+				filename, contentID = str(m["filename"]), str(m["content_id"])
+			}
+		}
+		if isEmpty(source) {
+			continue
+		}
+		loaded, err := media.Load(ctx, files, source)
+		if err != nil {
+			return nil, fmt.Errorf("attachment %d: %w", i+1, err)
+		}
+		if filename == "" {
+			filename = loaded.Name
+		}
+		if filename == "" {
+			filename = fmt.Sprintf("attachment-%d", i+1)
+		}
+		attachments = append(attachments, Attachment{
+			Filename:    filename,
+			ContentType: loaded.MimeType,
+			Content:     loaded.Data,
+			ContentID:   contentID,
+		})
+	}
+	return attachments, nil
+}
+
+// ParseHeaders reads extra headers from "Name: value" lines, a JSON
+// object or an object from a previous step.
+func ParseHeaders(v interface{}) (map[string]string, error) {
+	headers := map[string]string{}
+	switch value := v.(type) {
+	case nil:
+		return headers, nil
+	case map[string]interface{}:
+		for name, h := range value {
+			headers[name] = fmt.Sprint(h)
+		}
+		return headers, nil
+	case string:
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return headers, nil
+		}
+		if strings.HasPrefix(value, "{") {
+			var obj map[string]interface{}
+			if err := json.Unmarshal([]byte(value), &obj); err != nil {
+				return nil, fmt.Errorf("headers must be a JSON object: %w", err)
+			}
+			return ParseHeaders(obj)
+		}
+		for _, line := range strings.Split(value, "\n") {
+			if line = strings.TrimSpace(line); line == "" {
+				continue
+			}
+			name, h, ok := strings.Cut(line, ":")
+			if !ok {
+				return nil, fmt.Errorf("header %q must be written as Name: value", line)
+			}
+			headers[strings.TrimSpace(name)] = strings.TrimSpace(h)
+		}
+		return headers, nil
+	default:
+		return nil, errors.New("headers must be text or an object")
+	}
+}
+
+// ParseVariables reads template variables from a JSON object or an
+// object from a previous step.
+func ParseVariables(v interface{}) (map[string]interface{}, error) {
+	switch value := v.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return value, nil
+	case string:
+		if strings.TrimSpace(value) == "" {
+			return nil, nil
+		}
+		var vars map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &vars); err != nil {
+			return nil, fmt.Errorf("variables must be a JSON object: %w", err)
+		}
+		return vars, nil
+	default:
+		return nil, errors.New("variables must be a JSON object")
+	}
+}
+
+func str(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func valueOr(v, fallback interface{}) interface{} {
+	if v == nil {
+		return fallback
+	}
+	if f, ok := v.(float64); ok {
+		return fmt.Sprintf("%.0f", f)
+	}
+	return v
+}
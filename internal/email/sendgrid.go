@@ -0,0 +1,228 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// SendGridURL is the base URL of the SendGrid v3 API.
+const SendGridURL = "https://api.sendgrid.com"
+
+// maxSendGridCategories is the most categories a SendGrid message takes.
+const maxSendGridCategories = 10
+
+// SendGridSender sends messages through the SendGrid v3 mail send API,
+// or another service implementing it at BaseURL.
+type SendGridSender struct {
+	APIKey string
+	// BaseURL is the API base URL; SendGridURL when empty. EU regional
+	// subusers use https://api.eu.sendgrid.com.
+	BaseURL string
+	Client  *http.Client
+}
+
+// SendGridError is an error response of a SendGrid-compatible API.
+type SendGridError struct {
+	Status int
+	Errors []struct {
+		Message string `json:"message"`
+		Field   string `json:"field"`
+	} `json:"errors"`
+}
+
+func (e *SendGridError) Error() string {
+	var msgs []string
+	for _, item := range e.Errors {
+		if item.Field != "" {
+			msgs = append(msgs, item.Field+": "+item.Message)
+		} else {
+			msgs = append(msgs, item.Message)
+		}
+	}
+	if len(msgs) == 0 {
+		return fmt.Sprintf("sendgrid: request failed with status %d", e.Status)
+	}
+	return fmt.Sprintf("sendgrid: %s (%d)", strings.Join(msgs, "; "), e.Status)
+}
+
+// Request calls the API and decodes the JSON response into out, which
+// may be nil. It returns the response headers.
+func (s *SendGridSender) Request(ctx context.Context, method, path string, body, out interface{}) (http.Header, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	base := strings.TrimRight(s.BaseURL, "/")
+	if base == "" {
+		base = SendGridURL
+	}
+	req, err := http.NewRequestWithContext(ctx, method, base+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		apiErr := &SendGridError{Status: res.StatusCode}
+		_ = json.Unmarshal(data, apiErr)
+		return nil, apiErr
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return nil, err
+		}
+	}
+	return res.Header, nil
+}
+
+// Send sends the message. With a template ID, the variables are the
+// dynamic template data; without one, they fill the message's
+// placeholders before sending.
+func (s *SendGridSender) Send(ctx context.Context, msg *Message) (*Result, error) {
+	if s.APIKey == "" {
+		return nil, errors.New("a SendGrid API key is required")
+	}
+	msg.ApplyVariables()
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	headers, err := s.Request(ctx, http.MethodPost, "/v3/mail/send", sendGridPayload(msg), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		Provider:  "sendgrid",
+		MessageID: headers.Get("X-Message-Id"),
+		Accepted:  emails(msg.Recipients()),
+	}, nil
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+func sendGridAddresses(list []Address) []sendGridAddress {
+	out := make([]sendGridAddress, 0, len(list))
+	for _, a := range list {
+		out = append(out, sendGridAddress{Email: a.Email, Name: a.Name})
+	}
+	return out
+}
+
+// sendGridPayload builds the mail send request of a message.
+func sendGridPayload(msg *Message) map[string]interface{} {
+	personalization := map[string]interface{}{"to": sendGridAddresses(msg.To)}
+	if len(msg.CC) > 0 {
+		personalization["cc"] = sendGridAddresses(msg.CC)
+	}
+	if len(msg.BCC) > 0 {
+		personalization["bcc"] = sendGridAddresses(msg.BCC)
+	}
+	if msg.TemplateID != "" && len(msg.Variables) > 0 {
+		personalization["dynamic_template_data"] = msg.Variables
+	}
+
+	payload := map[string]interface{}{
+		"personalizations": []interface{}{personalization},
+		"from":             sendGridAddress{Email: msg.From.Email, Name: msg.From.Name},
+	}
+	if msg.Subject != "" {
+		payload["subject"] = msg.Subject
+	}
+	switch len(msg.ReplyTo) {
+	case 0:
+	case 1:
+		payload["reply_to"] = sendGridAddresses(msg.ReplyTo)[0]
+	default:
+		payload["reply_to_list"] = sendGridAddresses(msg.ReplyTo)
+	}
+
+	// SendGrid wants the plain text before the HTML.
+	var content []map[string]string
+	if msg.Text != "" {
+		content = append(content, map[string]string{"type": "text/plain", "value": msg.Text})
+	}
+	if msg.HTML != "" {
+		content = append(content, map[string]string{"type": "text/html", "value": msg.HTML})
+	}
+	if len(content) > 0 {
+		payload["content"] = content
+	}
+	if msg.TemplateID != "" {
+		payload["template_id"] = msg.TemplateID
+	}
+	if len(msg.Headers) > 0 {
+		payload["headers"] = msg.Headers
+	}
+	if len(msg.Tags) > 0 {
+		tags := msg.Tags
+		if len(tags) > maxSendGridCategories {
+			tags = tags[:maxSendGridCategories]
+		}
+		payload["categories"] = tags
+	}
+
+	if len(msg.Attachments) > 0 {
+		attachments := make([]map[string]string, 0, len(msg.Attachments))
+		for _, a := range msg.Attachments {
+			contentType := a.ContentType
+			if contentType == "" {
+				contentType = ContentType(a.Filename)
+			}
+			attachment := map[string]string{
+				"content":     base64.StdEncoding.EncodeToString(a.Content),
+				"filename":    a.Filename,
+				"type":        contentType,
+				"disposition": "attachment",
+			}
+			if a.ContentID != "" {
+				attachment["disposition"] = "inline"
+				attachment["content_id"] = strings.Trim(a.ContentID, "<>")
+			}
+			attachments = append(attachments, attachment)
+		}
+		payload["attachments"] = attachments
+	}
+	return payload
+}
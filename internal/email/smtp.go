@@ -0,0 +1,232 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTP connection security.
+const (
+	SecuritySTARTTLS = "starttls"
+	SecurityTLS      = "tls"
+	SecurityNone     = "none"
+)
+
+// defaultSMTPTimeout bounds a send when the context has no deadline.
+const defaultSMTPTimeout = time.Minute
+
+// SMTPSender sends messages through an SMTP server.
+type SMTPSender struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// Security is SecuritySTARTTLS, SecurityTLS for implicit TLS, or
+	// SecurityNone for relays on a trusted network.
+	Security string
+	// LocalName is the name given in HELO; localhost when empty.
+	LocalName string
+	// TLSConfig overrides the TLS settings, such as for a private CA.
+	TLSConfig *tls.Config
+}
+
+// DefaultSMTPPort returns the usual port of a security mode.
+func DefaultSMTPPort(security string) int {
+	switch security {
+	case SecurityTLS:
+		return 465
+	case SecurityNone:
+		return 25
+	default:
+		return 587
+	}
+}
+
+// Send delivers the message to every recipient, blind copies included,
+// in one SMTP transaction.
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) (*Result, error) {
+	if s.Host == "" {
+		return nil, errors.New("an SMTP host is required")
+	}
+	msg.ApplyVariables()
+	if msg.TemplateID != "" {
+		return nil, errors.New("SMTP has no stored templates; put the template in the HTML body and pass its variables")
+	}
+	messageID := NewMessageID(msg.From.Email)
+	data, err := msg.MIME(MIMEOptions{MessageID: messageID})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultSMTPTimeout)
+		defer cancel()
+	}
+	client, err := s.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	// net/smtp has no context support, so a cancelled send closes the
+	// connection under it.
+	stop := context.AfterFunc(ctx, func() { client.Close() })
+	defer stop()
+
+	if err := s.authenticate(client); err != nil {
+		return nil, err
+	}
+	if err := client.Mail(msg.From.Email); err != nil {
+		return nil, fmt.Errorf("smtp: sender %s rejected: %w", msg.From.Email, err)
+	}
+	recipients := emails(msg.Recipients())
+	for _, rcpt := range recipients {
+		if err := client.Rcpt(rcpt); err != nil {
+			return nil, fmt.Errorf("smtp: recipient %s rejected: %w", rcpt, err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return nil, fmt.Errorf("smtp: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, fmt.Errorf("smtp: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("smtp: message rejected: %w", err)
+	}
+	// The message was accepted with the DATA reply; a failed QUIT
+	// doesn't undo it.
+	_ = client.Quit()
+	return &Result{Provider: "smtp", MessageID: messageID, Accepted: recipients}, nil
+}
+
+// dial connects and greets the server, upgrading to TLS as configured.
+func (s *SMTPSender) dial(ctx context.Context) (*smtp.Client, error) {
+	port := s.Port
+	if port == 0 {
+		port = DefaultSMTPPort(s.Security)
+	}
+	addr := net.JoinHostPort(s.Host, strconv.Itoa(port))
+	tlsConfig := s.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{ServerName: s.Host, MinVersion: tls.VersionTLS12}
+	}
+
+	var conn net.Conn
+	var err error
+	dialer := &net.Dialer{}
+	if s.Security == SecurityTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("smtp: connecting to %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("smtp: %w", err)
+	}
+	localName := s.LocalName
+	if localName == "" {
+		localName = "localhost"
+	}
+	if err := client.Hello(localName); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("smtp: %w", err)
+	}
+	if s.Security == SecuritySTARTTLS || s.Security == "" {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			client.Close()
+			return nil, errors.New("smtp: the server doesn't offer STARTTLS; choose implicit TLS or no encryption")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("smtp: STARTTLS: %w", err)
+		}
+	}
+	return client, nil
+}
+
+// authenticate logs in when credentials are given, with PLAIN or LOGIN,
+// whichever the server offers.
+func (s *SMTPSender) authenticate(client *smtp.Client) error {
+	if s.Username == "" {
+		return nil
+	}
+	ok, mechanisms := client.Extension("AUTH")
+	if !ok {
+		return errors.New("smtp: the server doesn't accept authentication")
+	}
+	var auth smtp.Auth
+	switch {
+	case strings.Contains(strings.ToUpper(mechanisms), "PLAIN"):
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	case strings.Contains(strings.ToUpper(mechanisms), "LOGIN"):
+		auth = &loginAuth{username: s.Username, password: s.Password}
+	default:
+		return fmt.Errorf("smtp: no supported authentication mechanism in %q", mechanisms)
+	}
+	if err := client.Auth(auth); err != nil {
+		return fmt.Errorf("smtp: authentication failed: %w", err)
+	}
+	return nil
+}
+
+// loginAuth is the LOGIN mechanism, which servers such as Office 365
+// offer without PLAIN.
+type loginAuth struct {
+	username, password string
+}
+
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if !server.TLS && !isLocalhost(server.Name) {
+		return "", nil, errors.New("unencrypted connection")
+	}
+	return "LOGIN", nil, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	switch strings.ToLower(strings.TrimSpace(string(fromServer))) {
+	case "username:":
+		return []byte(a.username), nil
+	case "password:":
+		return []byte(a.password), nil
+	default:
+		return nil, fmt.Errorf("unexpected server challenge %q", fromServer)
+	}
+}
+
+func isLocalhost(name string) bool {
+	return name == "localhost" || name == "127.0.0.1" || name == "::1"
+}
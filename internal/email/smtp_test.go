@@ -0,0 +1,203 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeSMTP is a stand-in SMTP server that records one transaction.
+type fakeSMTP struct {
+	listener net.Listener
+	// reject fails RCPT for this address.
+	reject   string
+	starttls bool
+
+	mu         sync.Mutex
+	auth       string
+	from       string
+	recipients []string
+	data       string
+}
+
+func startFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeSMTP{listener: l}
+	go s.serve()
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *fakeSMTP) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTP) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 fake ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			reply("250-fake")
+			if s.starttls {
+				reply("250-STARTTLS")
+			}
+			reply("250 AUTH PLAIN LOGIN")
+		case strings.HasPrefix(cmd, "AUTH PLAIN"):
+			decoded, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(line[len("AUTH PLAIN"):]))
+			s.mu.Lock()
+			s.auth = string(decoded)
+			s.mu.Unlock()
+			reply("235 ok")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.mu.Lock()
+			s.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			s.mu.Unlock()
+			reply("250 ok")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			rcpt := strings.Trim(line[len("RCPT TO:"):], "<> ")
+			if rcpt == s.reject {
+				reply("550 no such user")
+				continue
+			}
+			s.mu.Lock()
+			s.recipients = append(s.recipients, rcpt)
+			s.mu.Unlock()
+			reply("250 ok")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.mu.Lock()
+			s.data = data.String()
+			s.mu.Unlock()
+			reply("250 queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func testMessage() *Message {
+	return &Message{
+		From:      Address{Name: "Acme", Email: "shop@example.com"},
+		To:        []Address{{Email: "jane@example.com"}},
+		CC:        []Address{{Email: "team@example.com"}},
+		BCC:       []Address{{Email: "audit@example.com"}},
+		Subject:   "Order {{number}}",
+		Text:      "Your order {{number}} shipped.",
+		Variables: map[string]interface{}{"number": "1001"},
+	}
+}
+
+func TestSMTPSend(t *testing.T) {
+	server := startFakeSMTP(t)
+	sender := &SMTPSender{
+		Host:     "127.0.0.1",
+		Port:     server.port(),
+		Username: "user",
+		Password: "secret",
+		Security: SecurityNone,
+	}
+	result, err := sender.Send(context.Background(), testMessage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Provider != "smtp" || result.MessageID == "" || len(result.Accepted) != 3 {
+		t.Errorf("result = %+v", result)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if server.auth != "\x00user\x00secret" {
+		t.Errorf("auth = %q", server.auth)
+	}
+	if server.from != "shop@example.com" {
+		t.Errorf("from = %q", server.from)
+	}
+	if strings.Join(server.recipients, ",") != "jane@example.com,team@example.com,audit@example.com" {
+		t.Errorf("recipients = %v", server.recipients)
+	}
+	if !strings.Contains(server.data, "Subject: Order 1001\r\n") || !strings.Contains(server.data, "Your order 1001 shipped.") {
+		t.Errorf("data = %q", server.data)
+	}
+	if strings.Contains(server.data, "audit@example.com") {
+		t.Error("blind copies must not appear in the message")
+	}
+}
+
+func TestSMTPSendErrors(t *testing.T) {
+	server := startFakeSMTP(t)
+	server.reject = "team@example.com"
+	sender := &SMTPSender{Host: "127.0.0.1", Port: server.port(), Security: SecurityNone}
+	if _, err := sender.Send(context.Background(), testMessage()); err == nil || !strings.Contains(err.Error(), "team@example.com rejected") {
+		t.Errorf("rejected recipient: %v", err)
+	}
+
+	sender.Security = SecuritySTARTTLS
+	if _, err := sender.Send(context.Background(), testMessage()); err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Errorf("missing STARTTLS: %v", err)
+	}
+
+	msg := testMessage()
+	msg.TemplateID = "welcome"
+	sender.Security = SecurityNone
+	if _, err := sender.Send(context.Background(), msg); err == nil {
+		t.Error("expected a stored template to fail over SMTP")
+	}
+
+	closed := &SMTPSender{Host: "127.0.0.1", Port: unusedPort(t), Security: SecurityNone}
+	if _, err := closed.Send(context.Background(), testMessage()); err == nil {
+		t.Error("expected a refused connection to fail")
+	}
+}
+
+func unusedPort(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	l.Close()
+	return port
+}
@@ -0,0 +1,150 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package freshworks is the connection shared by the Freshworks products.
+// An account has a subdomain per product, such as acme.freshdesk.com for
+// Freshdesk and acme.myfreshworks.com for Freshworks CRM, and every
+// product authenticates with an API key.
+package freshworks
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// The auth form fields. Their names are kept from the forms each
+// connector had, so saved connections keep working.
+const (
+	DomainField = "domain"
+	APIKeyField = "api-key"
+)
+
+// Product is a Freshworks product with its own API host.
+type Product struct {
+	ID   string
+	Name string
+	// Host is the domain the account subdomains live under.
+	Host string
+	// Aliases are other domains users may paste, which name the same
+	// subdomain.
+	Aliases []string
+	// BasicAuth sends the API key as the user of basic auth rather than
+	// as a token header.
+	BasicAuth bool
+}
+
+var (
+	Freshdesk = Product{ID: "freshdesk", Name: "Freshdesk", Host: "freshdesk.com", BasicAuth: true}
+	CRM       = Product{ID: "freshworkscrm", Name: "Freshworks CRM", Host: "myfreshworks.com", Aliases: []string{"freshworks.com"}}
+)
+
+var subdomainPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// AuthSchema returns the auth form of the product: the account domain and
+// an API key.
+func (p Product) AuthSchema() *smartform.FormSchema {
+	form := smartform.NewAuthForm(p.ID+"-auth", p.Name+" Auth", smartform.AuthStrategyCustom)
+
+	form.TextField(DomainField, p.Name+" Domain").
+		Required(true).
+		HelpText(fmt.Sprintf("The domain of your %s account, such as acme for acme.%s. The full domain or URL works too.", p.Name, p.Host))
+
+	form.TextField(APIKeyField, "API Key").
+		Required(true).
+		HelpText(fmt.Sprintf("Your %s API key, found under your profile settings.", p.Name))
+
+	return form.Build()
+}
+
+// Subdomain returns the account subdomain of a domain as entered on the
+// connection: the subdomain alone, the full domain or a URL.
+func (p Product) Subdomain(domain string) (string, error) {
+	s := strings.ToLower(strings.TrimSpace(domain))
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "https://"), "http://")
+	if i := strings.IndexAny(s, "/?#"); i >= 0 {
+		s = s[:i]
+	}
+	for _, host := range append([]string{p.Host}, p.Aliases...) {
+		s = strings.TrimSuffix(s, "."+host)
+	}
+	if s == "" {
+		return "", fmt.Errorf("the %s domain is required", p.Name)
+	}
+	if !subdomainPattern.MatchString(s) {
+		return "", fmt.Errorf("%q is not a %s domain, expected the acme of acme.%s", domain, p.Name, p.Host)
+	}
+	return s, nil
+}
+
+// BaseURL returns the base URL of an account domain, such as
+// https://acme.freshdesk.com.
+func (p Product) BaseURL(domain string) (string, error) {
+	sub, err := p.Subdomain(domain)
+	if err != nil {
+		return "", err
+	}
+	return "https://" + sub + "." + p.Host, nil
+}
+
+// Credentials returns the base URL and API key of a connection.
+func (p Product) Credentials(auth *sdkcontext.AuthContext) (string, string, error) {
+	if auth == nil || auth.Extra[APIKeyField] == "" {
+		return "", "", errors.New("missing " + p.Name + " API key")
+	}
+	baseURL, err := p.BaseURL(auth.Extra[DomainField])
+	if err != nil {
+		return "", "", err
+	}
+	return baseURL, auth.Extra[APIKeyField], nil
+}
+
+// Authorize sets the authorization header of a request to the product.
+func (p Product) Authorize(req *http.Request, apiKey string) {
+	if p.BasicAuth {
+		req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(apiKey+":X")))
+		return
+	}
+	req.Header.Set("Authorization", "Token token="+apiKey)
+}
+
+// ParseID reads the record ID in a field, given as a number or as text.
+func ParseID(field string, value json.Number) (int64, error) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(strings.TrimSpace(string(value)), "#"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%s must be a record ID, got %q", field, value)
+	}
+	return id, nil
+}
+
+// ParseIDs reads record IDs separated by commas, spaces or new lines.
+func ParseIDs(text string) ([]int64, error) {
+	var ids []int64
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ' ' || r == '\n' }) {
+		id, err := ParseID("ID", json.Number(field))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a record ID", field)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,71 @@
+package freshworks
+
+import (
+	"net/http"
+	"testing"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+func TestBaseURL(t *testing.T) {
+	tests := []struct {
+		product Product
+		domain  string
+		want    string
+	}{
+		{Freshdesk, "acme", "https://acme.freshdesk.com"},
+		{Freshdesk, " ACME.freshdesk.com ", "https://acme.freshdesk.com"},
+		{Freshdesk, "https://acme.freshdesk.com/a/tickets/12", "https://acme.freshdesk.com"},
+		{CRM, "acme", "https://acme.myfreshworks.com"},
+		{CRM, "acme.myfreshworks.com", "https://acme.myfreshworks.com"},
+		{CRM, "acme.freshworks.com", "https://acme.myfreshworks.com"},
+	}
+	for _, tt := range tests {
+		got, err := tt.product.BaseURL(tt.domain)
+		if err != nil || got != tt.want {
+			t.Errorf("%s.BaseURL(%q) = %q, %v, want %q", tt.product.ID, tt.domain, got, err, tt.want)
+		}
+	}
+
+	for _, domain := range []string{"", "support.acme.com", "acme.freshdesk.com.evil.io", "acme@evil.io"} {
+		if got, err := Freshdesk.BaseURL(domain); err == nil {
+			t.Errorf("BaseURL(%q) = %q, want an error", domain, got)
+		}
+	}
+}
+
+func TestCredentials(t *testing.T) {
+	if _, _, err := CRM.Credentials(&sdkcontext.AuthContext{Extra: map[string]string{"domain": "acme"}}); err == nil {
+		t.Error("Credentials without an API key should fail")
+	}
+	baseURL, apiKey, err := CRM.Credentials(&sdkcontext.AuthContext{Extra: map[string]string{"domain": "acme", "api-key": "k"}})
+	if err != nil || baseURL != "https://acme.myfreshworks.com" || apiKey != "k" {
+		t.Errorf("Credentials = %q, %q, %v", baseURL, apiKey, err)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://acme.freshdesk.com", nil)
+	Freshdesk.Authorize(req, "key")
+	if user, pass, ok := req.BasicAuth(); !ok || user != "key" || pass != "X" {
+		t.Errorf("Freshdesk basic auth = %q, %q, %v", user, pass, ok)
+	}
+
+	CRM.Authorize(req, "key")
+	if got := req.Header.Get("Authorization"); got != "Token token=key" {
+		t.Errorf("CRM authorization = %q", got)
+	}
+}
+
+func TestParseIDs(t *testing.T) {
+	ids, err := ParseIDs("12, #13\n14 ")
+	if err != nil || len(ids) != 3 || ids[0] != 12 || ids[1] != 13 || ids[2] != 14 {
+		t.Errorf("ParseIDs = %v, %v", ids, err)
+	}
+	if _, err := ParseIDs("12, abc"); err == nil {
+		t.Error("ParseIDs should reject abc")
+	}
+	if _, err := ParseID("ticket_id", ""); err == nil {
+		t.Error("ParseID should reject an empty ID")
+	}
+}
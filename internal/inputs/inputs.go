@@ -0,0 +1,46 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inputs keeps steps saved with former field names working after
+// an action's fields are renamed.
+package inputs
+
+import "strings"
+
+// Rename copies the values of legacy fields, keyed by their former name,
+// to the fields that replaced them when those are empty. input is not
+// changed.
+func Rename(input map[string]interface{}, legacy map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		out[k] = v
+	}
+	for old, current := range legacy {
+		if isEmpty(out[current]) && !isEmpty(input[old]) {
+			out[current] = input[old]
+		}
+	}
+	return out
+}
+
+func isEmpty(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(value) == ""
+	default:
+		return false
+	}
+}
@@ -0,0 +1,18 @@
+package inputs
+
+import "testing"
+
+func TestRename(t *testing.T) {
+	input := map[string]interface{}{"to_email": "jane@example.com", "text_part": "Hi", "text": ""}
+	got := Rename(input, map[string]string{"to_email": "to", "text_part": "text"})
+	if got["to"] != "jane@example.com" || got["text"] != "Hi" {
+		t.Errorf("renamed = %v", got)
+	}
+	if _, ok := input["to"]; ok {
+		t.Error("the input must not be changed")
+	}
+	got = Rename(map[string]interface{}{"to": "new@example.com", "to_email": "old@example.com"}, map[string]string{"to_email": "to"})
+	if got["to"] != "new@example.com" {
+		t.Errorf("a current value must win, got %v", got["to"])
+	}
+}
@@ -0,0 +1,77 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type addContactToAutomationActionProps struct {
+	ContactID    string `json:"contact-id"`
+	AutomationID string `json:"automation-id"`
+}
+
+type AddContactToAutomationAction struct{}
+
+// Metadata returns metadata about the action
+func (a *AddContactToAutomationAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "add_contact_to_automation",
+		DisplayName:   "Add Contact to Automation",
+		Description:   "Add a contact to an ActiveCampaign automation, which starts it for the contact.",
+		Type:          core.ActionTypeAction,
+		Documentation: addContactToAutomationDocs,
+		Icon:          "mdi:robot-outline",
+		SampleOutput: map[string]any{
+			"id":         "17",
+			"contact":    "123",
+			"automation": "5",
+			"status":     "1",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *AddContactToAutomationAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("add_contact_to_automation", "Add Contact to Automation")
+
+	shared.RegisterContactsProp(form)
+
+	shared.RegisterAutomationsProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *AddContactToAutomationAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *AddContactToAutomationAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[addContactToAutomationActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ContactID == "" || input.AutomationID == "" {
+		return nil, errors.New("contact and automation are required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.AddToAutomation(authCtx.Extra["api_url"], authCtx.Extra["api_key"], input.ContactID, input.AutomationID)
+}
+
+func NewAddContactToAutomationAction() sdk.Action {
+	return &AddContactToAutomationAction{}
+}
@@ -0,0 +1,91 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type addContactToListActionProps struct {
+	ContactID string `json:"contact-id"`
+	ListID    string `json:"list-id"`
+	Status    string `json:"status"`
+}
+
+type AddContactToListAction struct{}
+
+// Metadata returns metadata about the action
+func (a *AddContactToListAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "add_contact_to_list",
+		DisplayName:   "Add Contact to List",
+		Description:   "Subscribe a contact to an ActiveCampaign list, or unsubscribe it.",
+		Type:          core.ActionTypeAction,
+		Documentation: addContactToListDocs,
+		Icon:          "mdi:playlist-plus",
+		SampleOutput: map[string]any{
+			"id":      "42",
+			"contact": "123",
+			"list":    "3",
+			"status":  "1",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *AddContactToListAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("add_contact_to_list", "Add Contact to List")
+
+	shared.RegisterContactsProp(form)
+
+	shared.RegisterActiveCampaignListsProps(form).
+		Required(true)
+
+	form.SelectField("status", "Status").
+		Required(false).
+		AddOption(shared.ListStatusSubscribed, "Subscribed").
+		AddOption(shared.ListStatusUnsubscribed, "Unsubscribed").
+		DefaultValue(shared.ListStatusSubscribed).
+		HelpText("Subscribed adds the contact to the list. Unsubscribed keeps it on the list without sending to it.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *AddContactToListAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *AddContactToListAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[addContactToListActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ContactID == "" || input.ListID == "" {
+		return nil, errors.New("contact and list are required")
+	}
+
+	status := input.Status
+	if status == "" {
+		status = shared.ListStatusSubscribed
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SetListStatus(authCtx.Extra["api_url"], authCtx.Extra["api_key"], input.ContactID, input.ListID, status)
+}
+
+func NewAddContactToListAction() sdk.Action {
+	return &AddContactToListAction{}
+}
@@ -0,0 +1,194 @@
+package actions
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type bulkImportContactsActionProps struct {
+	ListID             string `json:"list_id"`
+	ExcludeAutomations bool   `json:"exclude_automations"`
+}
+
+type BulkImportContactsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *BulkImportContactsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "bulk_import_contacts",
+		DisplayName:   "Bulk Import Contacts",
+		Description:   "Create or update many ActiveCampaign contacts from a list or a CSV file with the bulk import API.",
+		Type:          core.ActionTypeAction,
+		Documentation: bulkImportContactsDocs,
+		Icon:          "mdi:account-multiple-plus-outline",
+		SampleOutput: map[string]any{
+			"total":     2,
+			"succeeded": 1,
+			"failed":    1,
+			"results": []map[string]any{
+				{"row": 1, "key": "jane.doe@example.com", "status": "queued"},
+				{"row": 2, "status": "failed", "error": `"jane@" is not a valid email address`},
+			},
+			"batch_ids": []string{"4c4b2c8c-8cb7-4a3b-9e8a-0f7fd2a5c1d3"},
+			"ignored":   []string{},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *BulkImportContactsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("bulk_import_contacts", "Bulk Import Contacts")
+
+	crm.RegisterBulkProps(form)
+
+	form.TextField("list_id", "List ID").
+		Required(false).
+		HelpText("Subscribe the imported contacts to this list.")
+
+	form.CheckboxField("exclude_automations", "Exclude Automations").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Don't start automations for the imported contacts.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *BulkImportContactsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform queues the records in batches of the most contacts a bulk
+// import takes. Rows are only checked for an email address here;
+// ActiveCampaign reports the outcome of each contact with the batch
+// status.
+func (a *BulkImportContactsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[bulkImportContactsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := crm.LoadRecords(ctx.Context(), ctx.Files(), ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	apiURL, apiKey := authCtx.Extra["api_url"], authCtx.Extra["api_key"]
+
+	listID := 0
+	if input.ListID != "" {
+		if listID, err = strconv.Atoi(strings.TrimSpace(input.ListID)); err != nil {
+			return nil, fmt.Errorf("list ID %q is not a number", input.ListID)
+		}
+	}
+
+	fieldIDs, err := shared.FieldIDs(apiURL, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]crm.RowResult, len(records))
+	ignored := map[string]bool{}
+	var queued []int
+	var contacts []map[string]interface{}
+	for i, record := range records {
+		results[i] = crm.RowResult{Row: i + 1}
+		contact, err := crm.ContactFromRecord(record)
+		if err != nil {
+			results[i].Status, results[i].Error = crm.RowFailed, err.Error()
+			continue
+		}
+		results[i].Key = contact.Email
+		contacts = append(contacts, importContact(contact, listID, fieldIDs, ignored))
+		queued = append(queued, i)
+	}
+
+	batchIDs := []string{}
+	rows := crm.Chunk(queued, shared.MaxBulkImportContacts)
+	for n, batch := range crm.Chunk(contacts, shared.MaxBulkImportContacts) {
+		batchID, err := shared.BulkImport(apiURL, apiKey, batch, input.ExcludeAutomations)
+		for _, i := range rows[n] {
+			if err != nil {
+				results[i].Status, results[i].Error = crm.RowFailed, err.Error()
+			} else {
+				results[i].Status, results[i].ID = crm.RowQueued, batchID
+			}
+		}
+		if err == nil {
+			batchIDs = append(batchIDs, batchID)
+		}
+	}
+
+	out := crm.BulkOutput(results)
+	out["batch_ids"] = batchIDs
+	out["ignored"] = sortedKeys(ignored)
+	return out, nil
+}
+
+// importContact builds the bulk import entry of a contact. Custom fields
+// are matched by ActiveCampaign field ID, title or personalization tag,
+// and the ones that match no field are added to ignored.
+func importContact(contact crm.Contact, listID int, fieldIDs map[string]string, ignored map[string]bool) map[string]interface{} {
+	entry := map[string]interface{}{"email": contact.Email}
+	for key, value := range map[string]string{
+		"first_name":         contact.FirstName,
+		"last_name":          contact.LastName,
+		"phone":              contact.Phone,
+		"customer_acct_name": contact.Company,
+	} {
+		if value != "" {
+			entry[key] = value
+		}
+	}
+	if len(contact.Tags) > 0 {
+		entry["tags"] = contact.Tags
+	}
+
+	var fields []map[string]interface{}
+	for name, value := range contact.Fields {
+		id, ok := fieldIDs[strings.ToLower(strings.Trim(name, "% "))]
+		if !ok {
+			ignored[name] = true
+			continue
+		}
+		numericID, _ := strconv.Atoi(id)
+		fields = append(fields, map[string]interface{}{"id": numericID, "value": value})
+	}
+	if len(fields) > 0 {
+		entry["fields"] = fields
+	}
+
+	if listID != 0 {
+		entry["subscribe"] = []map[string]interface{}{{"listid": listID}}
+	}
+	return entry
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func NewBulkImportContactsAction() sdk.Action {
+	return &BulkImportContactsAction{}
+}
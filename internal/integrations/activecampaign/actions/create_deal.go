@@ -0,0 +1,186 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type dealActionProps struct {
+	DealID       string   `json:"deal-id"`
+	Title        string   `json:"title"`
+	ContactID    string   `json:"contact-id"`
+	Value        *float64 `json:"value"`
+	Currency     string   `json:"currency"`
+	PipelineID   string   `json:"pipeline-id"`
+	StageID      string   `json:"stage-id"`
+	OwnerID      string   `json:"owner-id"`
+	Status       string   `json:"status"`
+	Description  string   `json:"description"`
+	CustomFields string   `json:"custom-fields"`
+}
+
+// dealSample is the sample output of the deal actions.
+var dealSample = map[string]any{
+	"id":          "88",
+	"title":       "Annual plan",
+	"contact":     "123",
+	"value":       "120000",
+	"currency":    "usd",
+	"group":       "1",
+	"stage":       "2",
+	"owner":       "1",
+	"status":      "0",
+	"description": "Upgrade from the monthly plan",
+}
+
+type CreateDealAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateDealAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_deal",
+		DisplayName:   "Create Deal",
+		Description:   "Create a deal for a contact in an ActiveCampaign pipeline stage.",
+		Type:          core.ActionTypeAction,
+		Documentation: createDealDocs,
+		Icon:          "mdi:handshake-outline",
+		SampleOutput:  dealSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateDealAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_deal", "Create Deal")
+
+	form.TextField("title", "Title").
+		Required(true)
+
+	shared.RegisterContactsProp(form)
+
+	registerDealProps(form, true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateDealAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateDealAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[dealActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Title == "" || input.ContactID == "" || input.StageID == "" {
+		return nil, errors.New("title, contact and stage are required")
+	}
+	if input.Value == nil {
+		input.Value = new(float64)
+	}
+	if input.Currency == "" {
+		input.Currency = "usd"
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	apiURL, apiKey := authCtx.Extra["api_url"], authCtx.Extra["api_key"]
+
+	deal, err := dealFrom(apiURL, apiKey, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveDeal(apiURL, apiKey, "", deal)
+}
+
+// registerDealProps adds the deal fields shared by the create and update
+// actions. Updates leave every field optional.
+func registerDealProps(form *smartform.FormBuilder, create bool) {
+	form.NumberField("value", "Value").
+		Required(false).
+		HelpText("The deal value in the currency's main unit, such as 1200.50.")
+
+	form.TextField("currency", "Currency").
+		Required(false).
+		HelpText("A three-letter currency code, such as usd. Defaults to usd for new deals.")
+
+	shared.RegisterPipelinesProps(form).
+		Required(create)
+
+	shared.RegisterStagesProps(form).
+		Required(create)
+
+	form.TextField("owner-id", "Owner ID").
+		Required(false).
+		HelpText("The ID of the user who owns the deal. Defaults to the API key's user.")
+
+	if !create {
+		form.SelectField("status", "Status").
+			Required(false).
+			AddOption(shared.DealStatusOpen, "Open").
+			AddOption(shared.DealStatusWon, "Won").
+			AddOption(shared.DealStatusLost, "Lost")
+	}
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	form.TextareaField("custom-fields", "Custom Fields").
+		Required(false).
+		HelpText(`Deal custom fields by ID, label or personalization tag, one "field=value" per line or a JSON object.`)
+}
+
+// dealFrom builds the deal of the input, leaving out the fields that are
+// empty so an update keeps them.
+func dealFrom(apiURL, apiKey string, input *dealActionProps) (map[string]interface{}, error) {
+	deal := map[string]interface{}{}
+	for key, value := range map[string]string{
+		"title":       input.Title,
+		"contact":     input.ContactID,
+		"currency":    strings.ToLower(strings.TrimSpace(input.Currency)),
+		"group":       input.PipelineID,
+		"stage":       input.StageID,
+		"owner":       input.OwnerID,
+		"status":      input.Status,
+		"description": input.Description,
+	} {
+		if value != "" {
+			deal[key] = value
+		}
+	}
+	if input.Value != nil {
+		deal["value"] = shared.Cents(*input.Value)
+	}
+
+	fields, err := crm.ParseFields(input.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	values, err := shared.DealFieldValues(apiURL, apiKey, fields)
+	if err != nil {
+		return nil, fmt.Errorf("custom fields: %w", err)
+	}
+	if len(values) > 0 {
+		deal["fields"] = values
+	}
+	return deal, nil
+}
+
+func NewCreateDealAction() sdk.Action {
+	return &CreateDealAction{}
+}
@@ -13,3 +13,30 @@ var createContactDocs string
 
 //go:embed update_contact.md
 var updateContactDocs string
+
+//go:embed upsert_contact.md
+var upsertContactDocs string
+
+//go:embed bulk_import_contacts.md
+var bulkImportContactsDocs string
+
+//go:embed get_bulk_import_status.md
+var getBulkImportStatusDocs string
+
+//go:embed add_contact_to_list.md
+var addContactToListDocs string
+
+//go:embed add_contact_to_automation.md
+var addContactToAutomationDocs string
+
+//go:embed create_deal.md
+var createDealDocs string
+
+//go:embed update_deal.md
+var updateDealDocs string
+
+//go:embed update_custom_field_values.md
+var updateCustomFieldValuesDocs string
+
+//go:embed track_event.md
+var trackEventDocs string
@@ -0,0 +1,137 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// importPollInterval is how often a batch is checked while waiting.
+const importPollInterval = 5 * time.Second
+
+// maxImportWait bounds the wait of a step, which must finish within the
+// action timeout.
+const maxImportWait = 10 * time.Minute
+
+type getBulkImportStatusActionProps struct {
+	BatchID     string  `json:"batch_id"`
+	WaitSeconds float64 `json:"wait_seconds"`
+}
+
+type GetBulkImportStatusAction struct{}
+
+// Metadata returns metadata about the action
+func (a *GetBulkImportStatusAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_bulk_import_status",
+		DisplayName:   "Get Bulk Import Status",
+		Description:   "Check the status of an ActiveCampaign bulk import batch, optionally waiting until it completes.",
+		Type:          core.ActionTypeAction,
+		Documentation: getBulkImportStatusDocs,
+		Icon:          "mdi:progress-check",
+		SampleOutput: map[string]any{
+			"batch_id":  "4c4b2c8c-8cb7-4a3b-9e8a-0f7fd2a5c1d3",
+			"status":    "completed",
+			"done":      true,
+			"succeeded": 249,
+			"failed":    1,
+			"success":   []string{"jane.doe@example.com"},
+			"failure":   []string{"bounced@example.com"},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *GetBulkImportStatusAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_bulk_import_status", "Get Bulk Import Status")
+
+	form.TextField("batch_id", "Batch ID").
+		Required(true).
+		HelpText("A batch ID returned by Bulk Import Contacts.")
+
+	form.NumberField("wait_seconds", "Wait (seconds)").
+		Required(false).
+		DefaultValue(0).
+		HelpText("Check the batch every few seconds until it completes or this time is up, at most 600. Leave at 0 to check once.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *GetBulkImportStatusAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *GetBulkImportStatusAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getBulkImportStatusActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	apiURL, apiKey := authCtx.Extra["api_url"], authCtx.Extra["api_key"]
+
+	batchID := strings.TrimSpace(input.BatchID)
+	wait := min(time.Duration(input.WaitSeconds)*time.Second, maxImportWait)
+	job, err := crm.WaitForJob(ctx.Context(), wait, importPollInterval, func() (crm.Job, error) {
+		info, err := shared.ImportStatus(apiURL, apiKey, batchID)
+		if err != nil {
+			return crm.Job{}, err
+		}
+		return crm.Job{ID: batchID, State: importState(info), Detail: info}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info, _ := job.Detail.(map[string]interface{})
+	success, _ := info["success"].([]interface{})
+	failure, _ := info["failure"].([]interface{})
+	if success == nil {
+		success = []interface{}{}
+	}
+	if failure == nil {
+		failure = []interface{}{}
+	}
+	return map[string]interface{}{
+		"batch_id":  batchID,
+		"status":    fmt.Sprint(info["status"]),
+		"done":      job.Done(),
+		"succeeded": len(success),
+		"failed":    len(failure),
+		"success":   success,
+		"failure":   failure,
+	}, nil
+}
+
+// importState maps the status of a batch to a job state.
+func importState(info map[string]interface{}) string {
+	switch status, _ := info["status"].(string); strings.ToLower(status) {
+	case "completed", "complete":
+		return crm.JobCompleted
+	case "failed", "error":
+		return crm.JobFailed
+	case "", "queued", "pending":
+		return crm.JobQueued
+	default:
+		return crm.JobRunning
+	}
+}
+
+func NewGetBulkImportStatusAction() sdk.Action {
+	return &GetBulkImportStatusAction{}
+}
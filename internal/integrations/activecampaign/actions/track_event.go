@@ -0,0 +1,104 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type trackEventActionProps struct {
+	TrackingAccountID string `json:"tracking-account-id"`
+	EventKey          string `json:"event-key"`
+	Event             string `json:"event"`
+	EventData         string `json:"event-data"`
+	Email             string `json:"email"`
+}
+
+type TrackEventAction struct{}
+
+// Metadata returns metadata about the action
+func (a *TrackEventAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "track_event",
+		DisplayName:   "Track Event",
+		Description:   "Record a custom event for a contact, which automations can start on or wait for.",
+		Type:          core.ActionTypeAction,
+		Documentation: trackEventDocs,
+		Icon:          "mdi:target",
+		SampleOutput: map[string]any{
+			"tracked": true,
+			"event":   "trial_started",
+			"email":   "jane.doe@example.com",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *TrackEventAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("track_event", "Track Event")
+
+	form.TextField("tracking-account-id", "Tracking Account ID").
+		Required(true).
+		HelpText("The actid shown under Settings > Tracking > Event Tracking.")
+
+	form.TextField("event-key", "Event Key").
+		Required(true).
+		HelpText("The event key shown under Settings > Tracking > Event Tracking.")
+
+	form.TextField("event", "Event").
+		Required(true).
+		HelpText("The event name, such as trial_started. Unknown names are added to the account.")
+
+	form.TextField("event-data", "Event Data").
+		Required(false).
+		HelpText("A value stored with the event, such as a plan name.")
+
+	form.TextField("email", "Email").
+		Required(true).
+		HelpText("The email address of the contact the event belongs to.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *TrackEventAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *TrackEventAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[trackEventActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	email := strings.TrimSpace(input.Email)
+	if input.TrackingAccountID == "" || input.EventKey == "" {
+		return nil, errors.New("tracking account ID and event key are required")
+	}
+	if input.Event == "" || email == "" {
+		return nil, errors.New("event and email are required")
+	}
+
+	if err := shared.TrackEvent(input.TrackingAccountID, input.EventKey, input.Event, input.EventData, email); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"tracked": true,
+		"event":   input.Event,
+		"email":   email,
+	}, nil
+}
+
+func NewTrackEventAction() sdk.Action {
+	return &TrackEventAction{}
+}
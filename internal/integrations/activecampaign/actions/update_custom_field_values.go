@@ -0,0 +1,98 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateCustomFieldValuesActionProps struct {
+	ContactID    string `json:"contact-id"`
+	CustomFields string `json:"custom-fields"`
+}
+
+type UpdateCustomFieldValuesAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateCustomFieldValuesAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_custom_field_values",
+		DisplayName:   "Update Contact Custom Fields",
+		Description:   "Set the values of a contact's custom fields, naming fields by ID, title or personalization tag.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateCustomFieldValuesDocs,
+		Icon:          "mdi:form-textbox",
+		SampleOutput: map[string]any{
+			"id":    "123",
+			"email": "jane.doe@example.com",
+			"fieldValues": []map[string]any{
+				{"field": "4", "value": "Gold"},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateCustomFieldValuesAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_custom_field_values", "Update Contact Custom Fields")
+
+	shared.RegisterContactsProp(form)
+
+	form.TextareaField("custom-fields", "Custom Fields").
+		Required(true).
+		HelpText(`Fields by ID, title or personalization tag, one "field=value" per line or a JSON object, such as plan=Gold.`)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateCustomFieldValuesAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateCustomFieldValuesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateCustomFieldValuesActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ContactID == "" {
+		return nil, errors.New("contact is required")
+	}
+
+	fields, err := crm.ParseFields(input.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("at least one custom field is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	apiURL, apiKey := authCtx.Extra["api_url"], authCtx.Extra["api_key"]
+
+	values, err := shared.ContactFieldValues(apiURL, apiKey, fields)
+	if err != nil {
+		return nil, fmt.Errorf("custom fields: %w", err)
+	}
+
+	return shared.SaveContact(apiURL, apiKey, input.ContactID, map[string]interface{}{
+		"fieldValues": values,
+	})
+}
+
+func NewUpdateCustomFieldValuesAction() sdk.Action {
+	return &UpdateCustomFieldValuesAction{}
+}
@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type UpdateDealAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateDealAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_deal",
+		DisplayName:   "Update Deal",
+		Description:   "Update an ActiveCampaign deal, such as to move it to another stage or mark it won or lost.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateDealDocs,
+		Icon:          "mdi:handshake",
+		SampleOutput:  dealSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateDealAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_deal", "Update Deal")
+
+	form.TextField("deal-id", "Deal ID").
+		Required(true)
+
+	form.TextField("title", "Title").
+		Required(false)
+
+	registerDealProps(form, false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateDealAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input. Fields
+// left empty keep their values.
+func (a *UpdateDealAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[dealActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.DealID == "" {
+		return nil, errors.New("deal ID is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	apiURL, apiKey := authCtx.Extra["api_url"], authCtx.Extra["api_key"]
+
+	deal, err := dealFrom(apiURL, apiKey, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(deal) == 0 {
+		return nil, errors.New("nothing to update")
+	}
+
+	return shared.SaveDeal(apiURL, apiKey, input.DealID, deal)
+}
+
+func NewUpdateDealAction() sdk.Action {
+	return &UpdateDealAction{}
+}
@@ -0,0 +1,116 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// contactFieldMap names the ActiveCampaign contact fields of the
+// standard contact fields. Contacts have no company field; companies
+// are accounts in ActiveCampaign.
+var contactFieldMap = crm.FieldMap{
+	crm.FieldEmail:     "email",
+	crm.FieldPhone:     "phone",
+	crm.FieldFirstName: "firstName",
+	crm.FieldLastName:  "lastName",
+}
+
+type UpsertContactAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpsertContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_contact",
+		DisplayName:   "Create or Update Contact",
+		Description:   "Find an ActiveCampaign contact by email and update it, or create it when there is none.",
+		Type:          core.ActionTypeAction,
+		Documentation: upsertContactDocs,
+		Icon:          "mdi:account-sync-outline",
+		SampleOutput: crm.SampleOutput(map[string]any{
+			"id":        "51",
+			"email":     "jane.doe@example.com",
+			"firstName": "Jane",
+			"lastName":  "Doe",
+			"phone":     "+1234567890",
+		}),
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpsertContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_contact", "Create or Update Contact")
+
+	crm.RegisterContactProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpsertContactAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input. Custom
+// fields are given by ActiveCampaign field ID, and tags are created when
+// missing.
+func (a *UpsertContactAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	contact, err := crm.ContactFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	apiURL, apiKey := authCtx.Extra["api_url"], authCtx.Extra["api_key"]
+
+	fields, ignored := contactFieldMap.Standard(contact)
+	if len(contact.Fields) > 0 {
+		fieldValues := make([]map[string]interface{}, 0, len(contact.Fields))
+		for field, value := range contact.Fields {
+			fieldValues = append(fieldValues, map[string]interface{}{
+				"field": field,
+				"value": value,
+			})
+		}
+		fields["fieldValues"] = fieldValues
+	}
+
+	// The lookup only tells whether the contact is new; the sync matches
+	// the contact by email itself.
+	existing, err := shared.FindContactByEmail(apiURL, apiKey, contact.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := shared.SyncContact(apiURL, apiKey, fields)
+	if err != nil {
+		return nil, err
+	}
+	id := fmt.Sprint(saved["id"])
+
+	if err := shared.TagContact(apiURL, apiKey, id, contact.Tags); err != nil {
+		return nil, err
+	}
+
+	return crm.Result{
+		ID:      id,
+		Created: existing == nil,
+		Contact: saved,
+		Ignored: ignored,
+	}.Output(), nil
+}
+
+func NewUpsertContactAction() sdk.Action {
+	return &UpsertContactAction{}
+}
@@ -35,6 +35,8 @@ func (a *ActiveCampaign) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewContactUpdatedTrigger(),
 		triggers.NewContactCreatedTrigger(),
+		triggers.NewTagAppliedTrigger(),
+		triggers.NewDealStageChangedTrigger(),
 	}
 }
 
@@ -44,6 +46,15 @@ func (a *ActiveCampaign) Actions() []sdk.Action {
 		actions.NewGetContactAction(),
 		actions.NewCreateContactAction(),
 		actions.NewUpdateContactAction(),
+		actions.NewUpsertContactAction(),
+		actions.NewBulkImportContactsAction(),
+		actions.NewGetBulkImportStatusAction(),
+		actions.NewAddContactToListAction(),
+		actions.NewAddContactToAutomationAction(),
+		actions.NewCreateDealAction(),
+		actions.NewUpdateDealAction(),
+		actions.NewUpdateCustomFieldValuesAction(),
+		actions.NewTrackEventAction(),
 	}
 }
 
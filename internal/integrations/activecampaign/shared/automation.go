@@ -0,0 +1,140 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// contact list subscription statuses.
+const (
+	ListStatusSubscribed   = "1"
+	ListStatusUnsubscribed = "2"
+)
+
+// trackingURL receives tracked events. It isn't part of the v3 API.
+const trackingURL = "https://trackcmp.net/event"
+
+// post sends a JSON body to an endpoint and returns the decoded response.
+func post(apiURL, apiKey, endpoint string, body interface{}) (map[string]interface{}, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	result, err := PostActiveCampaignClient(apiURL, apiKey, endpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+	resultMap, _ := result.(map[string]interface{})
+	return resultMap, nil
+}
+
+// put sends a JSON body to an endpoint and returns the decoded response.
+func put(apiURL, apiKey, endpoint string, body interface{}) (map[string]interface{}, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	result, err := PutActiveCampaignClient(apiURL, apiKey, endpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+	resultMap, _ := result.(map[string]interface{})
+	return resultMap, nil
+}
+
+// ListAll returns every record of a list endpoint, such as "deals", whose
+// records are under key. The endpoint may have a query.
+func ListAll(apiURL, apiKey, endpoint, key string) ([]map[string]interface{}, error) {
+	sep := "?"
+	if strings.Contains(endpoint, "?") {
+		sep = "&"
+	}
+	var records []map[string]interface{}
+	for offset := 0; ; offset += 100 {
+		result, err := GetActiveCampaignClient(apiURL, apiKey, fmt.Sprintf("%s%slimit=100&offset=%d", endpoint, sep, offset))
+		if err != nil {
+			return nil, err
+		}
+		resultMap, _ := result.(map[string]interface{})
+		page, _ := resultMap[key].([]interface{})
+		for _, r := range page {
+			if record, ok := r.(map[string]interface{}); ok {
+				records = append(records, record)
+			}
+		}
+		if len(page) < 100 {
+			return records, nil
+		}
+	}
+}
+
+// SetListStatus subscribes a contact to a list, or unsubscribes it.
+func SetListStatus(apiURL, apiKey, contactID, listID, status string) (map[string]interface{}, error) {
+	result, err := post(apiURL, apiKey, "contactLists", map[string]interface{}{
+		"contactList": map[string]interface{}{
+			"list":    listID,
+			"contact": contactID,
+			"status":  status,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	contactList, _ := result["contactList"].(map[string]interface{})
+	return contactList, nil
+}
+
+// AddToAutomation adds a contact to an automation, which starts it for
+// the contact.
+func AddToAutomation(apiURL, apiKey, contactID, automationID string) (map[string]interface{}, error) {
+	result, err := post(apiURL, apiKey, "contactAutomations", map[string]interface{}{
+		"contactAutomation": map[string]interface{}{
+			"contact":    contactID,
+			"automation": automationID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	contactAutomation, _ := result["contactAutomation"].(map[string]interface{})
+	return contactAutomation, nil
+}
+
+// TrackEvent records an event for the contact with the email address.
+// Event tracking must be enabled, and is authenticated with the
+// account's tracking ID and event key rather than the API key.
+func TrackEvent(accountID, eventKey, event, eventData, email string) error {
+	form := url.Values{}
+	form.Set("actid", accountID)
+	form.Set("key", eventKey)
+	form.Set("event", event)
+	if eventData != "" {
+		form.Set("eventdata", eventData)
+	}
+	visit, err := json.Marshal(map[string]string{"email": email})
+	if err != nil {
+		return err
+	}
+	form.Set("visit", string(visit))
+
+	resp, err := http.PostForm(trackingURL, form)
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Success int    `json:"success"`
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("error parsing response (status %d): %v", resp.StatusCode, err)
+	}
+	if resp.StatusCode >= 400 || result.Success != 1 {
+		return fmt.Errorf("event not tracked: %s", result.Message)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// FindContactByEmail returns the contact with the email address, or nil
+// when there is none.
+func FindContactByEmail(apiURL, apiKey, email string) (map[string]interface{}, error) {
+	result, err := GetActiveCampaignClient(apiURL, apiKey, "contacts?email="+url.QueryEscape(email))
+	if err != nil {
+		return nil, err
+	}
+	resultMap, _ := result.(map[string]interface{})
+	contacts, _ := resultMap["contacts"].([]interface{})
+	if len(contacts) == 0 {
+		return nil, nil
+	}
+	contact, _ := contacts[0].(map[string]interface{})
+	return contact, nil
+}
+
+// SaveContact creates a contact, or updates the contact with the ID, and
+// returns it.
+func SaveContact(apiURL, apiKey, id string, contact map[string]interface{}) (map[string]interface{}, error) {
+	payload, err := json.Marshal(map[string]interface{}{"contact": contact})
+	if err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	if id == "" {
+		result, err = PostActiveCampaignClient(apiURL, apiKey, "contacts", payload)
+	} else {
+		result, err = PutActiveCampaignClient(apiURL, apiKey, "contacts/"+url.PathEscape(id), payload)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resultMap, _ := result.(map[string]interface{})
+	saved, ok := resultMap["contact"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid response format: contact field not found")
+	}
+	return saved, nil
+}
+
+// SyncContact creates or updates the contact with the email of the
+// fields in one request, so concurrent runs can't create it twice.
+func SyncContact(apiURL, apiKey string, contact map[string]interface{}) (map[string]interface{}, error) {
+	payload, err := json.Marshal(map[string]interface{}{"contact": contact})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := PostActiveCampaignClient(apiURL, apiKey, "contact/sync", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resultMap, _ := result.(map[string]interface{})
+	saved, ok := resultMap["contact"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid response format: contact field not found")
+	}
+	return saved, nil
+}
+
+// TagContact adds the tags with the names to a contact, creating the
+// tags ActiveCampaign doesn't have yet.
+func TagContact(apiURL, apiKey, contactID string, names []string) error {
+	for _, name := range names {
+		tagID, err := tagID(apiURL, apiKey, name)
+		if err != nil {
+			return err
+		}
+		payload, err := json.Marshal(map[string]interface{}{
+			"contactTag": map[string]interface{}{"contact": contactID, "tag": tagID},
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := PostActiveCampaignClient(apiURL, apiKey, "contactTags", payload); err != nil {
+			return fmt.Errorf("tagging contact with %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// tagID returns the ID of the contact tag with the name, creating it
+// when there is none.
+func tagID(apiURL, apiKey, name string) (string, error) {
+	result, err := GetActiveCampaignClient(apiURL, apiKey, "tags?search="+url.QueryEscape(name))
+	if err != nil {
+		return "", err
+	}
+	resultMap, _ := result.(map[string]interface{})
+	tags, _ := resultMap["tags"].([]interface{})
+	for _, t := range tags {
+		tag, _ := t.(map[string]interface{})
+		if strings.EqualFold(fmt.Sprint(tag["tag"]), name) {
+			return fmt.Sprint(tag["id"]), nil
+		}
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"tag": map[string]interface{}{"tag": name, "tagType": "contact", "description": ""},
+	})
+	if err != nil {
+		return "", err
+	}
+	result, err = PostActiveCampaignClient(apiURL, apiKey, "tags", payload)
+	if err != nil {
+		return "", fmt.Errorf("creating tag %q: %w", name, err)
+	}
+	resultMap, _ = result.(map[string]interface{})
+	tag, ok := resultMap["tag"].(map[string]interface{})
+	if !ok {
+		return "", errors.New("invalid response format: tag field not found")
+	}
+	return fmt.Sprint(tag["id"]), nil
+}
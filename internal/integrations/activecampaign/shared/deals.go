@@ -0,0 +1,101 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+)
+
+// deal statuses.
+const (
+	DealStatusOpen = "0"
+	DealStatusWon  = "1"
+	DealStatusLost = "2"
+)
+
+// SaveDeal creates a deal, or updates the deal with the ID, and returns
+// it.
+func SaveDeal(apiURL, apiKey, id string, deal map[string]interface{}) (map[string]interface{}, error) {
+	body := map[string]interface{}{"deal": deal}
+	var (
+		result map[string]interface{}
+		err    error
+	)
+	if id == "" {
+		result, err = post(apiURL, apiKey, "deals", body)
+	} else {
+		result, err = put(apiURL, apiKey, "deals/"+url.PathEscape(id), body)
+	}
+	if err != nil {
+		return nil, err
+	}
+	saved, ok := result["deal"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid response format: deal field not found")
+	}
+	return saved, nil
+}
+
+// Cents converts an amount in the currency's main unit to the cents
+// ActiveCampaign stores deal values in.
+func Cents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// DealFieldValues converts deal custom fields keyed by field ID, title or
+// personalization tag to the list ActiveCampaign expects.
+func DealFieldValues(apiURL, apiKey string, fields map[string]interface{}) ([]map[string]interface{}, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	known, err := ListAll(apiURL, apiKey, "dealCustomFieldMeta", "dealCustomFieldMeta")
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]string{}
+	for _, field := range known {
+		id := fmt.Sprint(field["id"])
+		ids[id] = id
+		for _, key := range []string{"fieldLabel", "personalization"} {
+			if name, _ := field[key].(string); name != "" {
+				ids[strings.ToLower(name)] = id
+			}
+		}
+	}
+
+	values := make([]map[string]interface{}, 0, len(fields))
+	for key, value := range fields {
+		id, ok := ids[strings.ToLower(strings.TrimSpace(key))]
+		if !ok {
+			return nil, fmt.Errorf("ActiveCampaign has no deal field %q", key)
+		}
+		values = append(values, map[string]interface{}{
+			"customFieldId": id,
+			"fieldValue":    value,
+		})
+	}
+	return values, nil
+}
+
+// ContactFieldValues converts contact custom fields keyed by field ID,
+// title or personalization tag to the list ActiveCampaign expects.
+func ContactFieldValues(apiURL, apiKey string, fields map[string]interface{}) ([]map[string]interface{}, error) {
+	ids, err := FieldIDs(apiURL, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]map[string]interface{}, 0, len(fields))
+	for key, value := range fields {
+		id, ok := ids[strings.ToLower(strings.TrimSpace(key))]
+		if !ok {
+			return nil, fmt.Errorf("ActiveCampaign has no contact field %q", key)
+		}
+		values = append(values, map[string]interface{}{
+			"field": id,
+			"value": value,
+		})
+	}
+	return values, nil
+}
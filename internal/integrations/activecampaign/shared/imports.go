@@ -0,0 +1,79 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// MaxBulkImportContacts is the most contacts a bulk import takes per
+// request.
+const MaxBulkImportContacts = 250
+
+// BulkImport queues contacts for import and returns the ID of the batch.
+func BulkImport(apiURL, apiKey string, contacts []map[string]interface{}, excludeAutomations bool) (string, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"contacts":            contacts,
+		"exclude_automations": excludeAutomations,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	result, err := PostActiveCampaignClient(apiURL, apiKey, "import/bulk_import", payload)
+	if err != nil {
+		return "", err
+	}
+	resultMap, _ := result.(map[string]interface{})
+	batchID, _ := resultMap["batchId"].(string)
+	if batchID == "" {
+		return "", fmt.Errorf("bulk import not queued: %v", resultMap["message"])
+	}
+	return batchID, nil
+}
+
+// ImportStatus returns the status of a bulk import batch, with the
+// contacts imported and the ones that failed.
+func ImportStatus(apiURL, apiKey, batchID string) (map[string]interface{}, error) {
+	if batchID == "" {
+		return nil, errors.New("batch ID is required")
+	}
+	result, err := GetActiveCampaignClient(apiURL, apiKey, "import/info?batchId="+url.QueryEscape(batchID))
+	if err != nil {
+		return nil, err
+	}
+	resultMap, _ := result.(map[string]interface{})
+	if resultMap == nil {
+		return nil, errors.New("invalid response format: import status not found")
+	}
+	return resultMap, nil
+}
+
+// FieldIDs returns the IDs of the contact custom fields by lowercased
+// title and personalization tag, so bulk records can name them.
+func FieldIDs(apiURL, apiKey string) (map[string]string, error) {
+	ids := map[string]string{}
+	for offset := 0; ; offset += 100 {
+		result, err := GetActiveCampaignClient(apiURL, apiKey, fmt.Sprintf("fields?limit=100&offset=%d", offset))
+		if err != nil {
+			return nil, err
+		}
+		resultMap, _ := result.(map[string]interface{})
+		fields, _ := resultMap["fields"].([]interface{})
+		for _, f := range fields {
+			field, _ := f.(map[string]interface{})
+			id := fmt.Sprint(field["id"])
+			ids[id] = id
+			for _, key := range []string{"title", "perstag"} {
+				if name, _ := field[key].(string); name != "" {
+					ids[strings.ToLower(name)] = id
+				}
+			}
+		}
+		if len(fields) < 100 {
+			return ids, nil
+		}
+	}
+}
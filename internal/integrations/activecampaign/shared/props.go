@@ -0,0 +1,97 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// RegisterPipelinesProps adds a select of the deal pipelines.
+func RegisterPipelinesProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	return registerResourceProps(form, "pipeline-id", "Pipeline", "dealGroups", "dealGroups", "title", "")
+}
+
+// RegisterStagesProps adds a select of the stages of the pipeline chosen
+// in the pipeline-id field.
+func RegisterStagesProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	return registerResourceProps(form, "stage-id", "Stage", "dealStages", "dealStages", "title", "pipeline-id")
+}
+
+// RegisterAutomationsProps adds a select of the automations.
+func RegisterAutomationsProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	return registerResourceProps(form, "automation-id", "Automation", "automations", "automations", "name", "")
+}
+
+// RegisterTagsProps adds a select of the contact tags.
+func RegisterTagsProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	return registerResourceProps(form, "tag-id", "Tag", "tags", "tags", "tag", "")
+}
+
+// registerResourceProps adds a select of the records of an endpoint,
+// named by their nameKey field. With a pipeline field, only the records
+// of the chosen pipeline are listed.
+func registerResourceProps(form *smartform.FormBuilder, name, label, endpoint, key, nameKey, pipelineField string) *smartform.FieldBuilder {
+	getResources := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		apiURL, apiKey := authCtx.Extra["api_url"], authCtx.Extra["api_key"]
+		if apiURL == "" || apiKey == "" {
+			return nil, errors.New("API URL and API Key are required")
+		}
+
+		path := endpoint
+		if pipelineField != "" {
+			input := sdk.DynamicInputToType[map[string]interface{}](ctx)
+			pipelineID, _ := (*input)[pipelineField].(string)
+			if pipelineID == "" {
+				return ctx.Respond([]map[string]any{}, 0)
+			}
+			path += "?filters[d_groupid]=" + url.QueryEscape(pipelineID)
+		}
+
+		records, err := ListAll(apiURL, apiKey, path, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %v", key, err)
+		}
+
+		items := make([]map[string]any, 0, len(records))
+		for _, record := range records {
+			id := fmt.Sprint(record["id"])
+			title, _ := record[nameKey].(string)
+			if id != "" && title != "" {
+				items = append(items, map[string]any{
+					"id":   id,
+					"name": title,
+				})
+			}
+		}
+
+		return ctx.Respond(items, len(items))
+	}
+
+	options := smartform.NewOptionsBuilder().
+		Dynamic().
+		WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getResources)).
+		WithSearchSupport()
+	if pipelineField != "" {
+		options = options.WithFieldReference(pipelineField, pipelineField)
+	}
+	source := options.End()
+	if pipelineField != "" {
+		source = source.RefreshOn(pipelineField)
+	}
+
+	return form.SelectField(name, label).
+		Placeholder("Select " + label).
+		Required(true).
+		WithDynamicOptions(source.GetDynamicSource()).
+		HelpText("Select " + label)
+}
@@ -0,0 +1,168 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// dealStagesKey stores the stage of each deal at the last poll.
+const dealStagesKey = "dealStages"
+
+type dealStageChangedTriggerProps struct {
+	PipelineID string `json:"pipeline-id"`
+	StageID    string `json:"stage-id"`
+}
+
+type DealStageChangedTrigger struct{}
+
+func (t *DealStageChangedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "deal_stage_changed",
+		DisplayName:   "Deal Stage Changed",
+		Description:   "Triggers when a deal moves to another stage in ActiveCampaign.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: dealStageChangedDocs,
+		Icon:          "mdi:swap-horizontal",
+		SampleOutput: []map[string]any{
+			{
+				"deal_id":           "88",
+				"previous_stage_id": "2",
+				"stage_id":          "3",
+				"deal": map[string]any{
+					"id":      "88",
+					"title":   "Annual plan",
+					"contact": "123",
+					"value":   "120000",
+					"group":   "1",
+					"stage":   "3",
+					"status":  "0",
+				},
+			},
+		},
+	}
+}
+
+func (t *DealStageChangedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *DealStageChangedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("deal_stage_changed", "Deal Stage Changed")
+
+	shared.RegisterPipelinesProps(form).
+		Required(false).
+		HelpText("Only watch the deals of this pipeline. Leave empty for every pipeline.")
+
+	shared.RegisterStagesProps(form).
+		Required(false).
+		HelpText("Only trigger when deals move into this stage. Leave empty for every stage.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the trigger
+func (t *DealStageChangedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// Start initializes the trigger, required for event and webhook triggers in a lifecycle context.
+func (t *DealStageChangedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the trigger, cleaning up resources and performing necessary teardown operations.
+func (t *DealStageChangedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute compares the stage of every deal with the last poll, as
+// ActiveCampaign doesn't record when a deal changed stage. The first poll
+// only records the stages, and deals created since are recorded without
+// triggering.
+func (t *DealStageChangedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[dealStageChangedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := "deals"
+	if input.PipelineID != "" {
+		endpoint += "?filters[group]=" + url.QueryEscape(input.PipelineID)
+	}
+	deals, err := shared.ListAll(authCtx.Extra["api_url"], authCtx.Extra["api_key"], endpoint, "deals")
+	if err != nil {
+		return nil, fmt.Errorf("error fetching deals: %v", err)
+	}
+
+	prev, first := loadDealStages(ctx)
+	next := make(map[string]string, len(deals))
+	events := make([]map[string]interface{}, 0)
+	for _, deal := range deals {
+		id := fmt.Sprint(deal["id"])
+		stage := fmt.Sprint(deal["stage"])
+		next[id] = stage
+
+		old, known := prev[id]
+		if first || !known || old == stage {
+			continue
+		}
+		if input.StageID != "" && stage != input.StageID {
+			continue
+		}
+		events = append(events, map[string]interface{}{
+			"deal_id":           id,
+			"previous_stage_id": old,
+			"stage_id":          stage,
+			"deal":              deal,
+		})
+	}
+
+	if err := ctx.SetMetadata(dealStagesKey, next); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (t *DealStageChangedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+// loadDealStages returns the deal stages of the last poll, and whether
+// there was none.
+func loadDealStages(ctx sdkcontext.ExecuteContext) (map[string]string, bool) {
+	stored, err := ctx.GetMetadata(dealStagesKey)
+	if err != nil || stored == nil {
+		return map[string]string{}, true
+	}
+
+	var stages map[string]string
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &stages)
+	}
+	if err != nil || stages == nil {
+		ctx.Logger().Warn("ignoring unreadable deal stages", "error", err)
+		return map[string]string{}, true
+	}
+	return stages, false
+}
+
+func NewDealStageChangedTrigger() sdk.Trigger {
+	return &DealStageChangedTrigger{}
+}
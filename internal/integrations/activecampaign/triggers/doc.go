@@ -7,3 +7,9 @@ var contactUpdatedDocs string
 
 //go:embed contact_created.md
 var contactCreatedDocs string
+
+//go:embed tag_applied.md
+var tagAppliedDocs string
+
+//go:embed deal_stage_changed.md
+var dealStageChangedDocs string
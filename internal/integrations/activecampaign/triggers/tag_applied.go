@@ -0,0 +1,158 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/activecampaign/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// taggedKey stores the tag and the contacts that had it at the last poll.
+const taggedKey = "taggedContacts"
+
+type tagAppliedTriggerProps struct {
+	TagID string `json:"tag-id"`
+}
+
+// taggedContacts is the state kept between polls.
+type taggedContacts struct {
+	TagID    string          `json:"tagId"`
+	Contacts map[string]bool `json:"contacts"`
+}
+
+type TagAppliedTrigger struct{}
+
+func (t *TagAppliedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "tag_applied",
+		DisplayName:   "Tag Applied",
+		Description:   "Triggers when a tag is added to a contact in ActiveCampaign.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: tagAppliedDocs,
+		Icon:          "mdi:tag-plus",
+		SampleOutput: []map[string]any{
+			{
+				"contact_id": "123",
+				"tag_id":     "7",
+				"contact": map[string]any{
+					"id":        "123",
+					"email":     "sample@example.com",
+					"firstName": "John",
+					"lastName":  "Doe",
+				},
+			},
+		},
+	}
+}
+
+func (t *TagAppliedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *TagAppliedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("tag_applied", "Tag Applied")
+
+	shared.RegisterTagsProps(form).
+		HelpText("Trigger when this tag is added to a contact")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the trigger
+func (t *TagAppliedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// Start initializes the trigger, required for event and webhook triggers in a lifecycle context.
+func (t *TagAppliedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the trigger, cleaning up resources and performing necessary teardown operations.
+func (t *TagAppliedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute compares the contacts that have the tag with the last poll, as
+// ActiveCampaign can't list contacts by when a tag was added. The first
+// poll, and the first after the tag is changed, only record the contacts.
+func (t *TagAppliedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[tagAppliedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.TagID == "" {
+		return nil, errors.New("tag is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, err := shared.ListAll(authCtx.Extra["api_url"], authCtx.Extra["api_key"], "contacts?tagid="+url.QueryEscape(input.TagID), "contacts")
+	if err != nil {
+		return nil, fmt.Errorf("error fetching tagged contacts: %v", err)
+	}
+
+	prev, first := loadTagged(ctx)
+	first = first || prev.TagID != input.TagID
+
+	next := taggedContacts{TagID: input.TagID, Contacts: make(map[string]bool, len(contacts))}
+	events := make([]map[string]interface{}, 0)
+	for _, contact := range contacts {
+		id := fmt.Sprint(contact["id"])
+		next.Contacts[id] = true
+		if first || prev.Contacts[id] {
+			continue
+		}
+		events = append(events, map[string]interface{}{
+			"contact_id": id,
+			"tag_id":     input.TagID,
+			"contact":    contact,
+		})
+	}
+
+	if err := ctx.SetMetadata(taggedKey, next); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (t *TagAppliedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+// loadTagged returns the tagged contacts of the last poll, and whether
+// there was none.
+func loadTagged(ctx sdkcontext.ExecuteContext) (taggedContacts, bool) {
+	stored, err := ctx.GetMetadata(taggedKey)
+	if err != nil || stored == nil {
+		return taggedContacts{}, true
+	}
+
+	var tagged taggedContacts
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &tagged)
+	}
+	if err != nil || tagged.Contacts == nil {
+		ctx.Logger().Warn("ignoring unreadable tagged contacts", "error", err)
+		return taggedContacts{}, true
+	}
+	return tagged, false
+}
+
+func NewTagAppliedTrigger() sdk.Trigger {
+	return &TagAppliedTrigger{}
+}
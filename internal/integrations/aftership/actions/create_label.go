@@ -0,0 +1,64 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type CreateLabelAction struct{}
+
+// Metadata returns metadata about the action
+func (c CreateLabelAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_label",
+		DisplayName:   "Create Label",
+		Description:   "Quotes the shipment, picks the cheapest or fastest rate, or a given service, and buys its label.",
+		Type:          core.ActionTypeAction,
+		Documentation: createLabelDocs,
+		SampleOutput:  aftershipLabelSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (c CreateLabelAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_label", "Create Label")
+
+	shipmentForm(form)
+	shipping.RegisterRateProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (c CreateLabelAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (c CreateLabelAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	return buyLabel(ctx, false)
+}
+
+func NewCreateLabelAction() sdk.Action {
+	return &CreateLabelAction{}
+}
@@ -41,3 +41,15 @@ var markTrackingAsCompletedDocs string
 
 //go:embed retrack_a_tracking.md
 var retrackATracking string
+
+//go:embed get_rates.md
+var getRatesDocs string
+
+//go:embed create_label.md
+var createLabelDocs string
+
+//go:embed create_return_label.md
+var createReturnLabelDocs string
+
+//go:embed validate_address.md
+var validateAddressDocs string
@@ -0,0 +1,73 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type GetRatesAction struct{}
+
+// Metadata returns metadata about the action
+func (c GetRatesAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_rates",
+		DisplayName:   "Get Shipping Rates",
+		Description:   "Quotes a shipment with the selected carrier accounts of AfterShip Shipping, cheapest first.",
+		Type:          core.ActionTypeAction,
+		Documentation: getRatesDocs,
+		SampleOutput: map[string]any{
+			"count":    1,
+			"rates":    []map[string]any{aftershipRateSample},
+			"cheapest": aftershipRateSample,
+			"fastest":  aftershipRateSample,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (c GetRatesAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_rates", "Get Shipping Rates")
+
+	shipmentForm(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (c GetRatesAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (c GetRatesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	_, rates, err := quote(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shipping.RateSummary(rates), nil
+}
+
+func NewGetRatesAction() sdk.Action {
+	return &GetRatesAction{}
+}
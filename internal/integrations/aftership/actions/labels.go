@@ -0,0 +1,94 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/aftership/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+var aftershipRateSample = map[string]any{
+	"provider":       "aftership",
+	"rate_id":        "6f43fe77-b056-45c3-bce3-9fec4040da34:usps-discounted_priority_mail",
+	"carrier":        "usps-discounted",
+	"carrier_id":     "6f43fe77-b056-45c3-bce3-9fec4040da34",
+	"service":        "USPS Priority Mail",
+	"service_code":   "usps-discounted_priority_mail",
+	"amount":         8.12,
+	"currency":       "USD",
+	"estimated_days": 2,
+}
+
+var aftershipLabelSample = map[string]any{
+	"provider":        "aftership",
+	"label_id":        "a2cc9e5b-6e05-4d3c-8c40-7d83e1d1b7f1",
+	"status":          "created",
+	"tracking_number": "9405500000000000000000",
+	"label_url":       "https://sandbox-download.postmen.com/label/2025-03-12/a2cc9e5b-6e05-4d3c-8c40-7d83e1d1b7f1-1741770000000.pdf",
+	"carrier":         "usps-discounted",
+	"service":         "USPS Priority Mail",
+	"is_return":       false,
+	"rate":            aftershipRateSample,
+}
+
+// shipmentForm adds the carrier account, address, parcel and contents
+// fields shared by the rate and label actions.
+func shipmentForm(form *smartform.FormBuilder) {
+	shared.RegisterShipperAccountProps(form, true)
+	shipping.RegisterAddressProps(form, "from", "Ship From")
+	shipping.RegisterAddressProps(form, "to", "Ship To")
+	shipping.RegisterParcelProps(form)
+	shipping.RegisterContentsProps(form)
+}
+
+// quote returns the rates of the shipment described by the input.
+func quote(ctx sdkcontext.PerformContext) (string, []shipping.Rate, error) {
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	input := ctx.Input()
+	parcel, err := shipping.ParcelFrom(input)
+	if err != nil {
+		return "", nil, err
+	}
+
+	rates, err := shared.GetRates(apiKey, shared.ShipperAccountsFrom(input), shipping.AddressFrom(input, "from"), shipping.AddressFrom(input, "to"), parcel, shipping.ContentsFrom(input))
+	return apiKey, rates, err
+}
+
+// buyLabel quotes the shipment, picks a rate and buys its label.
+func buyLabel(ctx sdkcontext.PerformContext, isReturn bool) (core.JSON, error) {
+	apiKey, rates, err := quote(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := ctx.Input()
+	rate, err := shipping.PickRate(input, rates)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := shipping.ParcelFrom(input)
+	if err != nil {
+		return nil, err
+	}
+	return shared.CreateLabel(apiKey, shipping.AddressFrom(input, "from"), shipping.AddressFrom(input, "to"), parcel, shipping.ContentsFrom(input), rate, isReturn)
+}
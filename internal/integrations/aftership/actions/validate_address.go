@@ -0,0 +1,85 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/aftership/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type ValidateAddressAction struct{}
+
+// Metadata returns metadata about the action
+func (c ValidateAddressAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "validate_address",
+		DisplayName:   "Validate Address",
+		Description:   "Checks that an address is deliverable and returns it as corrected by AfterShip.",
+		Type:          core.ActionTypeAction,
+		Documentation: validateAddressDocs,
+		SampleOutput: map[string]any{
+			"provider": "aftership",
+			"valid":    true,
+			"address": map[string]any{
+				"name":    "Jane Doe",
+				"street1": "215 Clayton St",
+				"city":    "San Francisco",
+				"state":   "CA",
+				"zip":     "94117",
+				"country": "US",
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (c ValidateAddressAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("validate_address", "Validate Address")
+
+	shipping.RegisterAddressProps(form, "address", "Address")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (c ValidateAddressAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (c ValidateAddressAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	address := shipping.AddressFrom(ctx.Input(), "address")
+	if err := address.Validate(); err != nil {
+		return nil, err
+	}
+
+	return shared.ValidateAddress(apiKey, address)
+}
+
+func NewValidateAddressAction() sdk.Action {
+	return &ValidateAddressAction{}
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/wakflo/extensions/internal/integrations/aftership/actions"
 	"github.com/wakflo/extensions/internal/integrations/aftership/shared"
+	"github.com/wakflo/extensions/internal/integrations/aftership/triggers"
 
 	"github.com/wakflo/go-sdk/v2"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -46,7 +47,9 @@ func (n *AfterShip) Auth() *core.AuthMetadata {
 }
 
 func (n *AfterShip) Triggers() []sdk.Trigger {
-	return []sdk.Trigger{}
+	return []sdk.Trigger{
+		triggers.NewTrackingStatusChangedTrigger(),
+	}
 }
 
 func (n *AfterShip) Actions() []sdk.Action {
@@ -60,6 +63,10 @@ func (n *AfterShip) Actions() []sdk.Action {
 		actions.NewMarkTrackingAsCompletedAction(),
 		actions.NewRetrackATrackingAction(),
 		actions.NewGetUserCouriersAction(),
+		actions.NewGetRatesAction(),
+		actions.NewCreateLabelAction(),
+		actions.NewCreateReturnLabelAction(),
+		actions.NewValidateAddressAction(),
 	}
 }
 
@@ -0,0 +1,392 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// shippingURL is the AfterShip Shipping API, which quotes rates, buys
+// labels and validates addresses with the same API key as tracking.
+const shippingURL = "https://api.aftership.com/postmen/v3"
+
+const provider = "aftership"
+
+// GetAPIKey returns the AfterShip API key of the connection.
+func GetAPIKey(ctx sdkcontext.BaseContext) (string, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return "", err
+	}
+
+	apiKey := authCtx.Extra["api-key"]
+	if apiKey == "" {
+		return "", errors.New("missing aftership api key")
+	}
+	return apiKey, nil
+}
+
+// ShippingRequest calls the AfterShip Shipping API and decodes the data
+// of the response into out.
+func ShippingRequest(apiKey, method, endpoint string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, shippingURL+endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("as-api-key", apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var envelope struct {
+		Meta struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+			Details []struct {
+				Path string `json:"path"`
+				Info string `json:"info"`
+			} `json:"details"`
+		} `json:"meta"`
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON (status %d): %v", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest || envelope.Meta.Code >= 4000 {
+		msg := envelope.Meta.Message
+		for _, d := range envelope.Meta.Details {
+			msg += "; " + strings.TrimSpace(d.Path+" "+d.Info)
+		}
+		return fmt.Errorf("aftership API error (code %d): %s", envelope.Meta.Code, msg)
+	}
+
+	if out == nil || len(envelope.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(envelope.Data, out)
+}
+
+// RegisterShipperAccountProps adds the carrier account select of the rate
+// and label actions.
+func RegisterShipperAccountProps(form *smartform.FormBuilder, required bool) *smartform.FieldBuilder {
+	getAccounts := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		apiKey, err := GetAPIKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var data struct {
+			ShipperAccounts []struct {
+				ID          string `json:"id"`
+				Slug        string `json:"slug"`
+				Description string `json:"description"`
+			} `json:"shipper_accounts"`
+		}
+		if err := ShippingRequest(apiKey, http.MethodGet, "/shipper-accounts?limit=200", nil, &data); err != nil {
+			return nil, err
+		}
+
+		options := make([]map[string]interface{}, 0, len(data.ShipperAccounts))
+		for _, a := range data.ShipperAccounts {
+			name := a.Description
+			if name == "" {
+				name = a.Slug
+			}
+			options = append(options, map[string]interface{}{"id": a.ID, "name": name})
+		}
+		return ctx.Respond(options, len(options))
+	}
+
+	return form.MultiSelectField("shipper_accounts", "Carrier Accounts").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getAccounts)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("The carrier accounts connected to AfterShip Shipping to quote.")
+}
+
+// ShipperAccountsFrom reads the selected carrier accounts from raw input.
+func ShipperAccountsFrom(input map[string]interface{}) []string {
+	switch v := input["shipper_accounts"].(type) {
+	case []interface{}:
+		ids := make([]string, 0, len(v))
+		for _, id := range v {
+			if s, ok := id.(string); ok && s != "" {
+				ids = append(ids, s)
+			}
+		}
+		return ids
+	case []string:
+		return v
+	case string:
+		return shipping.SplitList(v)
+	default:
+		return nil
+	}
+}
+
+func toAddress(a shipping.Address) map[string]interface{} {
+	out := map[string]interface{}{
+		"contact_name": a.Name,
+		"company_name": a.Company,
+		"street1":      a.Street1,
+		"city":         a.City,
+		"postal_code":  a.Zip,
+		"country":      a.Country3(),
+		"phone":        a.Phone,
+		"email":        a.Email,
+		"type":         "business",
+	}
+	if a.Street2 != "" {
+		out["street2"] = a.Street2
+	}
+	if a.State != "" {
+		out["state"] = a.State
+	}
+	if a.Residential {
+		out["type"] = "residential"
+	}
+	return out
+}
+
+func fromAddress(m map[string]interface{}) shipping.Address {
+	get := func(k string) string {
+		s, _ := m[k].(string)
+		return s
+	}
+	return shipping.Address{
+		Name:        get("contact_name"),
+		Company:     get("company_name"),
+		Street1:     get("street1"),
+		Street2:     get("street2"),
+		City:        get("city"),
+		State:       get("state"),
+		Zip:         get("postal_code"),
+		Country:     shipping.CountryAlpha2(get("country")),
+		Phone:       get("phone"),
+		Email:       get("email"),
+		Residential: get("type") == "residential",
+	}
+}
+
+func toShipment(from, to shipping.Address, parcel shipping.Parcel, contents shipping.Contents) (map[string]interface{}, error) {
+	if err := from.Validate(); err != nil {
+		return nil, fmt.Errorf("ship from: %w", err)
+	}
+	if err := to.Validate(); err != nil {
+		return nil, fmt.Errorf("ship to: %w", err)
+	}
+
+	weight := map[string]interface{}{"value": parcel.Weight, "unit": parcel.MassUnit}
+	item := map[string]interface{}{
+		"description": contents.Description,
+		"quantity":    1,
+		"price":       map[string]interface{}{"amount": contents.Value, "currency": contents.Currency},
+		"weight":      weight,
+	}
+	if contents.HSCode != "" {
+		item["hs_code"] = contents.HSCode
+	}
+
+	return map[string]interface{}{
+		"ship_from": toAddress(from),
+		"ship_to":   toAddress(to),
+		"parcels": []interface{}{map[string]interface{}{
+			"box_type":    "custom",
+			"description": contents.Description,
+			"dimension": map[string]interface{}{
+				"width":  parcel.Width,
+				"height": parcel.Height,
+				"depth":  parcel.Length,
+				"unit":   parcel.DistanceUnit,
+			},
+			"weight": weight,
+			"items":  []interface{}{item},
+		}},
+	}, nil
+}
+
+type money struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+type rate struct {
+	ShipperAccount struct {
+		ID          string `json:"id"`
+		Slug        string `json:"slug"`
+		Description string `json:"description"`
+	} `json:"shipper_account"`
+	ServiceType  string `json:"service_type"`
+	ServiceName  string `json:"service_name"`
+	TotalCharge  *money `json:"total_charge"`
+	TransitTime  *int   `json:"transit_time"`
+	ErrorMessage string `json:"error_message"`
+}
+
+func (r rate) normalize() shipping.Rate {
+	out := shipping.Rate{
+		Provider:    provider,
+		ID:          r.ShipperAccount.ID + ":" + r.ServiceType,
+		Carrier:     r.ShipperAccount.Slug,
+		CarrierID:   r.ShipperAccount.ID,
+		Service:     r.ServiceName,
+		ServiceCode: r.ServiceType,
+	}
+	if r.TotalCharge != nil {
+		out.Amount = r.TotalCharge.Amount
+		out.Currency = r.TotalCharge.Currency
+	}
+	if r.TransitTime != nil {
+		out.EstimatedDays = *r.TransitTime
+	}
+	return out
+}
+
+// GetRates quotes a shipment with the given carrier accounts. Services a
+// carrier cannot quote are left out.
+func GetRates(apiKey string, accounts []string, from, to shipping.Address, parcel shipping.Parcel, contents shipping.Contents) ([]shipping.Rate, error) {
+	if len(accounts) == 0 {
+		return nil, errors.New("select at least one carrier account")
+	}
+	shipment, err := toShipment(from, to, parcel, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]map[string]string, 0, len(accounts))
+	for _, id := range accounts {
+		refs = append(refs, map[string]string{"id": id})
+	}
+
+	var data struct {
+		Rates []rate `json:"rates"`
+	}
+	body := map[string]interface{}{"shipper_accounts": refs, "shipment": shipment}
+	if err := ShippingRequest(apiKey, http.MethodPost, "/rates", body, &data); err != nil {
+		return nil, err
+	}
+
+	var rates []shipping.Rate
+	var failures []string
+	for _, r := range data.Rates {
+		if r.TotalCharge == nil {
+			if r.ErrorMessage != "" {
+				failures = append(failures, r.ShipperAccount.Slug+": "+r.ErrorMessage)
+			}
+			continue
+		}
+		rates = append(rates, r.normalize())
+	}
+	if len(rates) == 0 && len(failures) > 0 {
+		return nil, fmt.Errorf("no carrier could quote the shipment: %s", strings.Join(failures, "; "))
+	}
+	return rates, nil
+}
+
+// CreateLabel buys the label of a rate. A return label keeps the
+// addresses of the outbound shipment; the carrier prints it the other
+// way round.
+func CreateLabel(apiKey string, from, to shipping.Address, parcel shipping.Parcel, contents shipping.Contents, r *shipping.Rate, isReturn bool) (*shipping.Label, error) {
+	shipment, err := toShipment(from, to, parcel, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"return_shipment": isReturn,
+		"paper_size":      "4x6",
+		"service_type":    r.ServiceCode,
+		"shipper_account": map[string]string{"id": r.CarrierID},
+		"shipment":        shipment,
+	}
+
+	var data struct {
+		ID              string   `json:"id"`
+		Status          string   `json:"status"`
+		TrackingNumbers []string `json:"tracking_numbers"`
+		Files           struct {
+			Label *struct {
+				URL string `json:"url"`
+			} `json:"label"`
+		} `json:"files"`
+	}
+	if err := ShippingRequest(apiKey, http.MethodPost, "/labels", body, &data); err != nil {
+		return nil, err
+	}
+
+	label := &shipping.Label{
+		Provider: provider,
+		ID:       data.ID,
+		Status:   data.Status,
+		Carrier:  r.Carrier,
+		Service:  r.Service,
+		IsReturn: isReturn,
+		Rate:     r,
+	}
+	if len(data.TrackingNumbers) > 0 {
+		label.TrackingNumber = data.TrackingNumbers[0]
+	}
+	if data.Files.Label != nil {
+		label.LabelURL = data.Files.Label.URL
+	}
+	return label, nil
+}
+
+// ValidateAddress asks AfterShip to check an address.
+func ValidateAddress(apiKey string, a shipping.Address) (*shipping.AddressValidation, error) {
+	var data struct {
+		Status             string                 `json:"status"`
+		Address            map[string]interface{} `json:"address"`
+		RecommendedAddress map[string]interface{} `json:"recommended_address"`
+	}
+	if err := ShippingRequest(apiKey, http.MethodPost, "/address-validations", map[string]interface{}{"address": toAddress(a)}, &data); err != nil {
+		return nil, err
+	}
+
+	result := &shipping.AddressValidation{
+		Provider: provider,
+		Valid:    data.Status == "valid",
+		Address:  a,
+	}
+	switch {
+	case len(data.RecommendedAddress) > 0:
+		result.Address = fromAddress(data.RecommendedAddress)
+		result.Messages = append(result.Messages, "AfterShip recommends a corrected address")
+	case len(data.Address) > 0:
+		result.Address = fromAddress(data.Address)
+	}
+	if !result.Valid && data.Status != "" {
+		result.Messages = append(result.Messages, "validation status: "+data.Status)
+	}
+	return result, nil
+}
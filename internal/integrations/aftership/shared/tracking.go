@@ -0,0 +1,96 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/aftership/tracking-sdk-go/v5"
+	"github.com/aftership/tracking-sdk-go/v5/model"
+	"github.com/wakflo/extensions/internal/shipping"
+)
+
+// maxTrackingPages bounds the pages read by one trigger run.
+const maxTrackingPages = 20
+
+// UpdatedTrackings returns the trackings updated since the given time.
+// AfterShip lists at most 30 days back.
+func UpdatedTrackings(apiKey string, since time.Time) ([]shipping.Tracking, error) {
+	client, err := tracking.New(tracking.WithApiKey(apiKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if oldest := time.Now().AddDate(0, 0, -30); since.Before(oldest) {
+		since = oldest
+	}
+
+	var trackings []shipping.Tracking
+	for page := 1; page <= maxTrackingPages; page++ {
+		result, err := client.Tracking.GetTrackings().
+			BuildQuery(model.GetTrackingsQuery{
+				Page:         page,
+				Limit:        200,
+				UpdatedAtMin: since.UTC().Format(time.RFC3339),
+			}).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range result.Tracking {
+			trackings = append(trackings, NormalizeTracking(t))
+		}
+		if page*result.Limit >= result.Total || len(result.Tracking) == 0 {
+			break
+		}
+	}
+	return trackings, nil
+}
+
+// NormalizeTracking returns an AfterShip tracking in the shipping
+// package shape.
+func NormalizeTracking(t model.Tracking) shipping.Tracking {
+	out := shipping.Tracking{
+		Provider:          provider,
+		ID:                t.Id,
+		TrackingNumber:    t.TrackingNumber,
+		Carrier:           t.Slug,
+		Status:            shipping.NormalizeStatus(string(t.Tag)),
+		RawStatus:         string(t.Tag),
+		EstimatedDelivery: t.ExpectedDelivery,
+		UpdatedAt:         t.UpdatedAt,
+	}
+	for _, c := range t.Checkpoints {
+		out.Events = append(out.Events, shipping.TrackingEvent{
+			Status:     shipping.NormalizeStatus(string(c.Tag)),
+			RawStatus:  string(c.Tag),
+			Message:    c.Message,
+			Location:   shipping.JoinLocation(c.City, c.State, c.Zip, c.CountryName),
+			OccurredAt: c.CheckpointTime,
+		})
+	}
+	return out
+}
+
+// CreateTracking registers a shipment with AfterShip and returns it in
+// the shipping package shape.
+func CreateTracking(apiKey string, req model.CreateTrackingRequest) (*shipping.Tracking, error) {
+	client, err := tracking.New(tracking.WithApiKey(apiKey))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.Tracking.CreateTracking().BuildBody(req).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return &shipping.Tracking{
+		Provider:          provider,
+		ID:                result.Id,
+		TrackingNumber:    result.TrackingNumber,
+		Carrier:           result.Slug,
+		Status:            shipping.NormalizeStatus(string(result.Tag)),
+		RawStatus:         string(result.Tag),
+		EstimatedDelivery: result.ExpectedDelivery,
+	}, nil
+}
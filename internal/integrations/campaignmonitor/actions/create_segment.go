@@ -0,0 +1,114 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/campaignmonitor/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createSegmentActionProps struct {
+	ListID string `json:"subscriber_list"`
+	Title  string `json:"title"`
+	Rules  string `json:"rules"`
+}
+
+type CreateSegmentAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateSegmentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_segment",
+		DisplayName:   "Create Segment",
+		Description:   "Create a segment of a list from rule groups.",
+		Type:          core.ActionTypeAction,
+		Documentation: createSegmentDocs,
+		Icon:          "mdi:account-filter",
+		SampleOutput: map[string]interface{}{
+			"segment_id": "0246c2aea610a3545d9780bf6ab89006",
+			"title":      "Pro customers",
+			"list_id":    "a1b2c3d4e5f6g7h8i9j0",
+			"rule_groups": []interface{}{
+				map[string]interface{}{
+					"Rules": []interface{}{
+						map[string]interface{}{"RuleType": "[Plan]", "Clause": "EQUALS Pro"},
+					},
+				},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateSegmentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_segment", "Create Segment")
+
+	shared.RegisterCreateSendSubscriberListsProps(form).
+		Required(true).
+		HelpText("The list to create the segment in.")
+
+	form.TextField("title", "Title").
+		Placeholder("Enter segment title").
+		Required(true).
+		HelpText("The title of the segment.")
+
+	form.TextareaField("rules", "Rules").
+		Placeholder("EmailAddress CONTAINS @example.com | [Plan] EQUALS Pro").
+		Required(true).
+		HelpText(`One rule group per line. A subscriber must match every group, and any rule of a group, separated by "|". Write each rule as the rule type and the clause, or give the rule groups as JSON.`)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateSegmentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateSegmentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createSegmentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ListID == "" {
+		return nil, errors.New("list ID is required")
+	}
+
+	if input.Title == "" {
+		return nil, errors.New("title is required")
+	}
+
+	groups, err := shared.ParseSegmentRules(input.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	segmentID, err := shared.CreateSegment(authCtx.Extra["api-key"], authCtx.Extra["client-id"], input.ListID, input.Title, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"segment_id":  segmentID,
+		"title":       input.Title,
+		"list_id":     input.ListID,
+		"rule_groups": groups,
+	}, nil
+}
+
+func NewCreateSegmentAction() sdk.Action {
+	return &CreateSegmentAction{}
+}
@@ -25,3 +25,21 @@ var addSubscriberDocs string
 
 //go:embed get_campaign_list_and_segments.md
 var getCampaignListsAndSegmentsDocs string
+
+//go:embed unsubscribe_subscriber.md
+var unsubscribeSubscriberDocs string
+
+//go:embed suppress_emails.md
+var suppressEmailsDocs string
+
+//go:embed unsuppress_email.md
+var unsuppressEmailDocs string
+
+//go:embed list_suppressed_emails.md
+var listSuppressedEmailsDocs string
+
+//go:embed create_segment.md
+var createSegmentDocs string
+
+//go:embed send_smart_email.md
+var sendSmartEmailDocs string
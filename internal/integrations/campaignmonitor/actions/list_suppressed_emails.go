@@ -0,0 +1,89 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/campaignmonitor/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type listSuppressedEmailsActionProps struct {
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+}
+
+type ListSuppressedEmailsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *ListSuppressedEmailsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_suppressed_emails",
+		DisplayName:   "List Suppressed Emails",
+		Description:   "Retrieve a page of the client's suppression list.",
+		Type:          core.ActionTypeAction,
+		Documentation: listSuppressedEmailsDocs,
+		Icon:          "mdi:email-off-outline",
+		SampleOutput: map[string]interface{}{
+			"Results": []interface{}{
+				map[string]interface{}{
+					"SuppressionReason": "Unsubscribed",
+					"EmailAddress":      "subscriber@example.com",
+					"Date":              "2025-03-12 18:20:00",
+					"State":             "Suppressed",
+				},
+			},
+			"ResultsOrderedBy":     "email",
+			"OrderDirection":       "asc",
+			"PageNumber":           1,
+			"PageSize":             1000,
+			"RecordsOnThisPage":    1,
+			"TotalNumberOfRecords": 1,
+			"NumberOfPages":        1,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *ListSuppressedEmailsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_suppressed_emails", "List Suppressed Emails")
+
+	form.NumberField("page", "Page").
+		Placeholder("Enter page number").
+		Required(false).
+		HelpText("The page number to retrieve (for pagination).")
+
+	form.NumberField("page_size", "Page Size").
+		Placeholder("Enter page size").
+		Required(false).
+		HelpText("The number of addresses per page, up to 1000.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *ListSuppressedEmailsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *ListSuppressedEmailsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listSuppressedEmailsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SuppressionList(authCtx.Extra["api-key"], authCtx.Extra["client-id"], input.Page, input.PageSize)
+}
+
+func NewListSuppressedEmailsAction() sdk.Action {
+	return &ListSuppressedEmailsAction{}
+}
@@ -0,0 +1,154 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/integrations/campaignmonitor/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type sendSmartEmailActionProps struct {
+	SmartEmailID        string `json:"smart_email_id"`
+	To                  string `json:"to"`
+	CC                  string `json:"cc"`
+	BCC                 string `json:"bcc"`
+	AddRecipientsToList bool   `json:"add_recipients_to_list"`
+	ConsentToTrack      string `json:"consent_to_track"`
+}
+
+type SendSmartEmailAction struct{}
+
+// Metadata returns metadata about the action
+func (a *SendSmartEmailAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "send_smart_email",
+		DisplayName:   "Send Smart Email",
+		Description:   "Send a transactional smart email, filling its variables with data.",
+		Type:          core.ActionTypeAction,
+		Documentation: sendSmartEmailDocs,
+		Icon:          "mdi:email-fast",
+		SampleOutput: map[string]interface{}{
+			"smart_email_id": "bcf40510-f09d-4de7-a5e0-0a4e47b7a5b2",
+			"recipients": []interface{}{
+				map[string]interface{}{
+					"Status":    "Accepted",
+					"MessageID": "ddc697c7-0788-4df3-a71a-a7cb935f00bd",
+					"Recipient": "Jane Doe <jane.doe@example.com>",
+				},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *SendSmartEmailAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("send_smart_email", "Send Smart Email")
+
+	shared.RegisterSmartEmailsProps(form)
+
+	form.TextField("to", "To").
+		Required(true).
+		HelpText(`Recipients separated by commas, as jane@example.com or "Jane Doe <jane@example.com>".`)
+
+	form.TextField("cc", "CC").
+		Required(false)
+
+	form.TextField("bcc", "BCC").
+		Required(false)
+
+	form.TextareaField("data", "Data").
+		Required(false).
+		HelpText(`Values of the smart email's variables as a JSON object, such as {"firstname": "Jane"}.`)
+
+	attachments := form.ArrayField("attachments", "Attachments")
+	attachment := attachments.ObjectTemplate("attachment", "")
+	attachment.FileField("file", "File").
+		Required(true).
+		HelpText("A file from a file field or a previous step, a URL, or a data URI.")
+	attachment.TextField("filename", "File Name").
+		Required(false).
+		HelpText("The name the recipient sees. Defaults to the file's name.")
+
+	form.CheckboxField("add_recipients_to_list", "Add Recipients to List").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Whether to add the recipients to the list the smart email is linked to.")
+
+	form.SelectField("consent_to_track", "Consent To Track").
+		Required(true).
+		DefaultValue("Unchanged").
+		AddOptions(
+			&smartform.Option{Value: "Yes", Label: "Yes"},
+			&smartform.Option{Value: "No", Label: "No"},
+			&smartform.Option{Value: "Unchanged", Label: "Unchanged"},
+		).
+		HelpText("Whether the recipients have given consent to track their opens and clicks.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *SendSmartEmailAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *SendSmartEmailAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[sendSmartEmailActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.SmartEmailID == "" {
+		return nil, errors.New("smart email is required")
+	}
+
+	msg := shared.SmartEmail{
+		AddRecipientsToList: input.AddRecipientsToList,
+		ConsentToTrack:      input.ConsentToTrack,
+	}
+	if msg.ConsentToTrack == "" {
+		msg.ConsentToTrack = "Unchanged"
+	}
+	if msg.To, err = email.ParseAddressList(input.To); err != nil {
+		return nil, err
+	}
+	if msg.CC, err = email.ParseAddressList(input.CC); err != nil {
+		return nil, err
+	}
+	if msg.BCC, err = email.ParseAddressList(input.BCC); err != nil {
+		return nil, err
+	}
+	if msg.Data, err = email.ParseVariables(ctx.Input()["data"]); err != nil {
+		return nil, err
+	}
+	if msg.Attachments, err = email.LoadAttachments(ctx.Context(), ctx.Files(), ctx.Input()["attachments"]); err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := shared.SendSmartEmail(authCtx.Extra["api-key"], authCtx.Extra["client-id"], input.SmartEmailID, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"smart_email_id": input.SmartEmailID,
+		"recipients":     recipients,
+	}, nil
+}
+
+func NewSendSmartEmailAction() sdk.Action {
+	return &SendSmartEmailAction{}
+}
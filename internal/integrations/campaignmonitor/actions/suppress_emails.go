@@ -0,0 +1,84 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/campaignmonitor/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type suppressEmailsActionProps struct {
+	Emails string `json:"emails"`
+}
+
+type SuppressEmailsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *SuppressEmailsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "suppress_emails",
+		DisplayName:   "Add to Suppression List",
+		Description:   "Add email addresses to the client's suppression list so no campaigns are sent to them.",
+		Type:          core.ActionTypeAction,
+		Documentation: suppressEmailsDocs,
+		Icon:          "mdi:email-off",
+		SampleOutput: map[string]interface{}{
+			"suppressed": []string{"subscriber@example.com", "former@example.com"},
+			"count":      2,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *SuppressEmailsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("suppress_emails", "Add to Suppression List")
+
+	form.TextareaField("emails", "Email Addresses").
+		Placeholder("Enter email addresses").
+		Required(true).
+		HelpText("The email addresses to suppress, separated by commas or new lines.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *SuppressEmailsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *SuppressEmailsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[suppressEmailsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := shared.ParseEmails(input.Emails)
+	if len(emails) == 0 {
+		return nil, errors.New("at least one email address is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.Suppress(authCtx.Extra["api-key"], authCtx.Extra["client-id"], emails); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"suppressed": emails,
+		"count":      len(emails),
+	}, nil
+}
+
+func NewSuppressEmailsAction() sdk.Action {
+	return &SuppressEmailsAction{}
+}
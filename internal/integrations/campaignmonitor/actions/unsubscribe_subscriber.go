@@ -0,0 +1,94 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/campaignmonitor/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type unsubscribeSubscriberActionProps struct {
+	ListID string `json:"subscriber_list"`
+	Email  string `json:"email"`
+}
+
+type UnsubscribeSubscriberAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UnsubscribeSubscriberAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "unsubscribe_subscriber",
+		DisplayName:   "Unsubscribe Subscriber",
+		Description:   "Unsubscribe an email address from a list and add it to the suppression list.",
+		Type:          core.ActionTypeAction,
+		Documentation: unsubscribeSubscriberDocs,
+		Icon:          "mdi:account-cancel",
+		SampleOutput: map[string]interface{}{
+			"unsubscribed": true,
+			"email":        "subscriber@example.com",
+			"list_id":      "a1b2c3d4e5f6g7h8i9j0",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UnsubscribeSubscriberAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("unsubscribe_subscriber", "Unsubscribe Subscriber")
+
+	shared.RegisterCreateSendSubscriberListsProps(form).
+		Required(true).
+		HelpText("The list to unsubscribe the subscriber from.")
+
+	form.TextField("email", "Email").
+		Placeholder("Enter email address").
+		Required(true).
+		HelpText("The email address of the subscriber.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UnsubscribeSubscriberAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UnsubscribeSubscriberAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[unsubscribeSubscriberActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ListID == "" {
+		return nil, errors.New("list ID is required")
+	}
+
+	if input.Email == "" {
+		return nil, errors.New("email address is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.Unsubscribe(authCtx.Extra["api-key"], authCtx.Extra["client-id"], input.ListID, input.Email); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"unsubscribed": true,
+		"email":        input.Email,
+		"list_id":      input.ListID,
+	}, nil
+}
+
+func NewUnsubscribeSubscriberAction() sdk.Action {
+	return &UnsubscribeSubscriberAction{}
+}
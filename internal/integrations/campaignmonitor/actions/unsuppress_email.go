@@ -0,0 +1,83 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/campaignmonitor/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type unsuppressEmailActionProps struct {
+	Email string `json:"email"`
+}
+
+type UnsuppressEmailAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UnsuppressEmailAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "unsuppress_email",
+		DisplayName:   "Remove from Suppression List",
+		Description:   "Remove an email address from the client's suppression list.",
+		Type:          core.ActionTypeAction,
+		Documentation: unsuppressEmailDocs,
+		Icon:          "mdi:email-check",
+		SampleOutput: map[string]interface{}{
+			"unsuppressed": true,
+			"email":        "subscriber@example.com",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UnsuppressEmailAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("unsuppress_email", "Remove from Suppression List")
+
+	form.TextField("email", "Email").
+		Placeholder("Enter email address").
+		Required(true).
+		HelpText("The email address to remove from the suppression list. It isn't resubscribed to any list.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UnsuppressEmailAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UnsuppressEmailAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[unsuppressEmailActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Email == "" {
+		return nil, errors.New("email address is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.Unsuppress(authCtx.Extra["api-key"], authCtx.Extra["client-id"], input.Email); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"unsuppressed": true,
+		"email":        input.Email,
+	}, nil
+}
+
+func NewUnsuppressEmailAction() sdk.Action {
+	return &UnsuppressEmailAction{}
+}
@@ -35,6 +35,7 @@ func (n *CampaignMonitor) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewSubscriberAddedTrigger(),
 		triggers.NewCampaignSentTrigger(),
+		triggers.NewSubscriberEventTrigger(),
 	}
 }
 
@@ -48,6 +49,12 @@ func (n *CampaignMonitor) Actions() []sdk.Action {
 		actions.NewListAllCampaignsAction(),
 		actions.NewAddSubscriberAction(),
 		actions.NewGetCampaignListsAndSegmentsAction(),
+		actions.NewUnsubscribeSubscriberAction(),
+		actions.NewSuppressEmailsAction(),
+		actions.NewUnsuppressEmailAction(),
+		actions.NewListSuppressedEmailsAction(),
+		actions.NewCreateSegmentAction(),
+		actions.NewSendSmartEmailAction(),
 	}
 }
 
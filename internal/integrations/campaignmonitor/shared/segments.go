@@ -0,0 +1,85 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SegmentRule is a rule of a segment, such as RuleType "EmailAddress"
+// with Clause "CONTAINS @example.com".
+type SegmentRule struct {
+	RuleType string `json:"RuleType"`
+	Clause   string `json:"Clause"`
+}
+
+// SegmentRuleGroup matches subscribers matching any of its rules. A
+// segment matches subscribers matching all of its groups.
+type SegmentRuleGroup struct {
+	Rules []SegmentRule `json:"Rules"`
+}
+
+// ParseSegmentRules reads segment rule groups from the JSON the API
+// takes, or from lines of text: each line is a group, whose rules are
+// separated by "|" and written as the rule type and the clause, such as
+//
+//	EmailAddress CONTAINS @example.com | [Plan] EQUALS Pro
+//	DateSubscribed GREATER_THAN_OR_EQUAL 2025-01-01
+func ParseSegmentRules(text string) ([]SegmentRuleGroup, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, errors.New("segment rules are required")
+	}
+	// Custom field rule types are bracketed, like [Plan], so only an
+	// array of objects is JSON.
+	if strings.HasPrefix(text, "{") {
+		text = "[" + text + "]"
+	}
+	if rest, ok := strings.CutPrefix(text, "["); ok && strings.HasPrefix(strings.TrimSpace(rest), "{") {
+		var groups []SegmentRuleGroup
+		if err := json.Unmarshal([]byte(text), &groups); err != nil {
+			return nil, fmt.Errorf("segment rules must be a JSON array of rule groups: %w", err)
+		}
+		return groups, nil
+	}
+
+	var groups []SegmentRuleGroup
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		var group SegmentRuleGroup
+		for _, rule := range strings.Split(line, "|") {
+			rule = strings.TrimSpace(rule)
+			ruleType, clause, ok := strings.Cut(rule, " ")
+			if !ok || strings.TrimSpace(clause) == "" {
+				return nil, fmt.Errorf("segment rule %q must be written as the rule type and the clause, such as EmailAddress CONTAINS @example.com", rule)
+			}
+			group.Rules = append(group.Rules, SegmentRule{RuleType: ruleType, Clause: strings.TrimSpace(clause)})
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// CreateSegment creates a segment of a list and returns its ID.
+func CreateSegment(apiKey, clientID, listID, title string, groups []SegmentRuleGroup) (string, error) {
+	response, err := GetCampaignMonitorClient(apiKey, clientID,
+		fmt.Sprintf("segments/%s.json", url.PathEscape(listID)),
+		http.MethodPost,
+		map[string]interface{}{
+			"Title":      title,
+			"RuleGroups": groups,
+		})
+	if err != nil {
+		return "", err
+	}
+	id, ok := response.(string)
+	if !ok || id == "" {
+		return "", errors.New("no segment ID in the Campaign Monitor response")
+	}
+	return id, nil
+}
@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Unsubscribe unsubscribes an email address from a list. Campaign Monitor
+// also adds it to the client's suppression list.
+func Unsubscribe(apiKey, clientID, listID, email string) error {
+	_, err := GetCampaignMonitorClient(apiKey, clientID,
+		fmt.Sprintf("subscribers/%s/unsubscribe.json", url.PathEscape(listID)),
+		http.MethodPost,
+		map[string]interface{}{"EmailAddress": email})
+	return err
+}
+
+// GetSubscriber returns the details of a list's subscriber, including
+// its State: Active, Unconfirmed, Unsubscribed, Bounced or Deleted.
+func GetSubscriber(apiKey, clientID, listID, email string) (map[string]interface{}, error) {
+	response, err := GetCampaignMonitorClient(apiKey, clientID,
+		fmt.Sprintf("subscribers/%s.json", url.PathEscape(listID))+BuildQueryString(map[string]string{"email": email}),
+		http.MethodGet,
+		nil)
+	if err != nil {
+		return nil, err
+	}
+	subscriber, ok := response.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid response format")
+	}
+	return subscriber, nil
+}
+
+// Suppress adds email addresses to the client's suppression list, so no
+// campaign is sent to them.
+func Suppress(apiKey, clientID string, emails []string) error {
+	if clientID == "" {
+		return errors.New("client ID is required")
+	}
+	_, err := GetCampaignMonitorClient(apiKey, clientID,
+		fmt.Sprintf("clients/%s/suppress.json", url.PathEscape(clientID)),
+		http.MethodPost,
+		map[string]interface{}{"EmailAddresses": emails})
+	return err
+}
+
+// Unsuppress removes an email address from the client's suppression
+// list. It doesn't resubscribe the address to any list.
+func Unsuppress(apiKey, clientID, email string) error {
+	if clientID == "" {
+		return errors.New("client ID is required")
+	}
+	_, err := GetCampaignMonitorClient(apiKey, clientID,
+		fmt.Sprintf("clients/%s/unsuppress.json", url.PathEscape(clientID))+BuildQueryString(map[string]string{"email": email}),
+		http.MethodPut,
+		nil)
+	return err
+}
+
+// SuppressionList returns a page of the client's suppression list.
+func SuppressionList(apiKey, clientID string, page, pageSize int) (map[string]interface{}, error) {
+	if clientID == "" {
+		return nil, errors.New("client ID is required")
+	}
+	params := map[string]string{}
+	if page > 0 {
+		params["page"] = strconv.Itoa(page)
+	}
+	if pageSize > 0 {
+		params["pagesize"] = strconv.Itoa(pageSize)
+	}
+	response, err := GetCampaignMonitorClient(apiKey, clientID,
+		fmt.Sprintf("clients/%s/suppressionlist.json", url.PathEscape(clientID))+BuildQueryString(params),
+		http.MethodGet,
+		nil)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := response.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid response format")
+	}
+	return list, nil
+}
+
+// ParseEmails reads email addresses separated by commas, semicolons or
+// new lines.
+func ParseEmails(text string) []string {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == ';' || r == '\n' || r == '\r'
+	})
+	emails := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f = strings.TrimSpace(f); f != "" {
+			emails = append(emails, f)
+		}
+	}
+	return emails
+}
@@ -0,0 +1,139 @@
+package shared
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// SmartEmail is a transactional smart email send.
+type SmartEmail struct {
+	To  []email.Address
+	CC  []email.Address
+	BCC []email.Address
+	// Data fills the smart email's variables.
+	Data        map[string]interface{}
+	Attachments []email.Attachment
+	// AddRecipientsToList adds the recipients to the list the smart
+	// email is linked to.
+	AddRecipientsToList bool
+	// ConsentToTrack is Yes, No or Unchanged.
+	ConsentToTrack string
+}
+
+// SendSmartEmail sends a smart email and returns the status of each
+// recipient.
+func SendSmartEmail(apiKey, clientID, smartEmailID string, msg SmartEmail) ([]interface{}, error) {
+	if len(msg.To) == 0 {
+		return nil, errors.New("at least one recipient is required")
+	}
+	body := map[string]interface{}{
+		"To":                  addressStrings(msg.To),
+		"AddRecipientsToList": msg.AddRecipientsToList,
+		"ConsentToTrack":      msg.ConsentToTrack,
+	}
+	if len(msg.CC) > 0 {
+		body["CC"] = addressStrings(msg.CC)
+	}
+	if len(msg.BCC) > 0 {
+		body["BCC"] = addressStrings(msg.BCC)
+	}
+	if len(msg.Data) > 0 {
+		body["Data"] = msg.Data
+	}
+	if len(msg.Attachments) > 0 {
+		attachments := make([]map[string]string, 0, len(msg.Attachments))
+		for _, a := range msg.Attachments {
+			attachments = append(attachments, map[string]string{
+				"Type":    a.ContentType,
+				"Name":    a.Filename,
+				"Content": base64.StdEncoding.EncodeToString(a.Content),
+			})
+		}
+		body["Attachments"] = attachments
+	}
+
+	response, err := GetCampaignMonitorClient(apiKey, clientID,
+		fmt.Sprintf("transactional/smartEmail/%s/send", url.PathEscape(smartEmailID)),
+		http.MethodPost,
+		body)
+	if err != nil {
+		return nil, err
+	}
+	statuses, ok := response.([]interface{})
+	if !ok {
+		return nil, errors.New("invalid response format")
+	}
+	return statuses, nil
+}
+
+func addressStrings(list []email.Address) []string {
+	out := make([]string, 0, len(list))
+	for _, a := range list {
+		out = append(out, a.String())
+	}
+	return out
+}
+
+// RegisterSmartEmailsProps adds a select of the client's active smart
+// emails.
+func RegisterSmartEmailsProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getSmartEmails := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		params := map[string]string{"status": "active"}
+		if clientID := authCtx.Extra["client-id"]; clientID != "" {
+			params["clientID"] = clientID
+		}
+		response, err := GetCampaignMonitorClient(authCtx.Extra["api-key"], authCtx.Extra["client-id"],
+			"transactional/smartEmail"+BuildQueryString(params),
+			http.MethodGet,
+			nil)
+		if err != nil {
+			return nil, err
+		}
+
+		smartEmails, _ := response.([]interface{})
+		items := make([]map[string]any, 0, len(smartEmails))
+		for _, item := range smartEmails {
+			smartEmail, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, idOk := smartEmail["ID"].(string)
+			name, nameOk := smartEmail["Name"].(string)
+			if idOk && nameOk && id != "" {
+				items = append(items, map[string]any{
+					"id":   id,
+					"name": name,
+				})
+			}
+		}
+
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("smart_email_id", "Smart Email").
+		Placeholder("Select a smart email").
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getSmartEmails)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("The smart email to send")
+}
@@ -0,0 +1,119 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/wakflo/extensions/internal/webhook"
+)
+
+// Subscriber events of the subscriber event trigger.
+const (
+	EventSubscribed   = "subscribed"
+	EventUnsubscribed = "unsubscribed"
+	EventBounced      = "bounced"
+	EventDeleted      = "deleted"
+)
+
+// eventStates is the subscriber state each event leaves a subscriber in.
+var eventStates = map[string]string{
+	EventSubscribed:   "Active",
+	EventUnsubscribed: "Unsubscribed",
+	EventBounced:      "Bounced",
+	EventDeleted:      "Deleted",
+}
+
+// WebhookEvent is an event of a list webhook delivery. Type is Subscribe,
+// Update or Deactivate; State tells why a subscriber was deactivated.
+type WebhookEvent struct {
+	Type         string                   `json:"Type"`
+	Date         string                   `json:"Date"`
+	State        string                   `json:"State"`
+	EmailAddress string                   `json:"EmailAddress"`
+	Name         string                   `json:"Name"`
+	CustomFields []map[string]interface{} `json:"CustomFields"`
+}
+
+// Event returns the trigger event of a webhook event, or "" when it is
+// one the trigger doesn't report, such as an update.
+func (e WebhookEvent) Event() string {
+	switch e.Type {
+	case "Subscribe":
+		return EventSubscribed
+	case "Deactivate":
+		for event, state := range eventStates {
+			if event != EventSubscribed && strings.EqualFold(e.State, state) {
+				return event
+			}
+		}
+	}
+	return ""
+}
+
+// CredentialsFromConfig reads the API key and client ID from a trigger
+// config.
+func CredentialsFromConfig(config map[string]interface{}) (apiKey, clientID string, err error) {
+	values := webhook.Connection(config)
+	if values["api-key"] == "" {
+		return "", "", errors.New("missing Campaign Monitor API key")
+	}
+	return values["api-key"], values["client-id"], nil
+}
+
+// CreateListWebhook sends a list's subscribe and deactivate events to
+// targetURL and returns the webhook's ID.
+func CreateListWebhook(apiKey, clientID, listID, targetURL string) (string, error) {
+	response, err := GetCampaignMonitorClient(apiKey, clientID,
+		fmt.Sprintf("lists/%s/webhooks.json", url.PathEscape(listID)),
+		http.MethodPost,
+		map[string]interface{}{
+			"Events":        []string{"Subscribe", "Deactivate"},
+			"Url":           targetURL,
+			"PayloadFormat": "json",
+		})
+	if err != nil {
+		return "", err
+	}
+	id, ok := response.(string)
+	if !ok || id == "" {
+		return "", errors.New("no webhook ID in the Campaign Monitor response")
+	}
+	return id, nil
+}
+
+// DeleteListWebhook removes a list webhook.
+func DeleteListWebhook(apiKey, clientID, listID, webhookID string) error {
+	_, err := GetCampaignMonitorClient(apiKey, clientID,
+		fmt.Sprintf("lists/%s/webhooks/%s.json", url.PathEscape(listID), url.PathEscape(webhookID)),
+		http.MethodDelete,
+		nil)
+	return err
+}
+
+// ParseWebhook reads the list ID and events of a webhook delivery, whose
+// body is {"ListID": "...", "Events": [...]}.
+func ParseWebhook(input map[string]interface{}) (string, []WebhookEvent, error) {
+	var body struct {
+		ListID string         `json:"ListID"`
+		Events []WebhookEvent `json:"Events"`
+	}
+	if err := webhook.DecodeBody(input, &body); err != nil {
+		return "", nil, fmt.Errorf("invalid Campaign Monitor webhook body: %w", err)
+	}
+	return body.ListID, body.Events, nil
+}
+
+// ConfirmEvent reports whether a list's subscriber is in the state an
+// event leaves it in, as Campaign Monitor doesn't sign webhook
+// deliveries.
+func ConfirmEvent(apiKey, clientID, listID, event, email string) (map[string]interface{}, bool, error) {
+	subscriber, err := GetSubscriber(apiKey, clientID, listID, email)
+	if err != nil {
+		return nil, false, err
+	}
+	state, _ := subscriber["State"].(string)
+	return subscriber, strings.EqualFold(state, eventStates[event]), nil
+}
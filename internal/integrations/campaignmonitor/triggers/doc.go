@@ -7,3 +7,6 @@ var subscriberAddedDocs string
 
 //go:embed campaign_sent.md
 var campaignSentDocs string
+
+//go:embed subscriber_event.md
+var subscriberEventDocs string
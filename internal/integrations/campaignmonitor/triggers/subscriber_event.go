@@ -0,0 +1,217 @@
+package triggers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/campaignmonitor/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+const (
+	webhookIDKey     = "webhookId"
+	webhookListIDKey = "webhookListId"
+)
+
+type subscriberEventTriggerProps struct {
+	ListID string   `json:"subscriber_list"`
+	Events []string `json:"events"`
+}
+
+// SubscriberEventTrigger registers a list webhook while the trigger is
+// active and reports subscribe, unsubscribe, bounce and delete events.
+type SubscriberEventTrigger struct{}
+
+func (t *SubscriberEventTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "subscriber_event",
+		DisplayName:   "Subscriber Event (Instant)",
+		Description:   "Trigger a workflow instantly when a subscriber joins, unsubscribes from, bounces on or is deleted from a list.",
+		Type:          sdkcore.TriggerTypeWebhook,
+		Documentation: subscriberEventDocs,
+		Icon:          "mdi:account-sync",
+		SampleOutput: []map[string]interface{}{
+			{
+				"event":       "unsubscribed",
+				"email":       "subscriber@example.com",
+				"name":        "John Doe",
+				"state":       "Unsubscribed",
+				"list_id":     "a1b2c3d4e5f6g7h8i9j0",
+				"occurred_at": "2025-03-12 18:20:00",
+				"subscriber": map[string]interface{}{
+					"EmailAddress": "subscriber@example.com",
+					"Name":         "John Doe",
+					"Date":         "2024-11-02 09:15:00",
+					"State":        "Unsubscribed",
+					"CustomFields": []interface{}{
+						map[string]interface{}{"Key": "City", "Value": "New York"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *SubscriberEventTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypeWebhook
+}
+
+func (t *SubscriberEventTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("subscriber_event", "Subscriber Event (Instant)")
+
+	shared.RegisterCreateSendSubscriberListsProps(form).
+		Required(true).
+		HelpText("The list to watch.")
+
+	form.MultiSelectField("events", "Events").
+		Required(false).
+		AddOptions(
+			&smartform.Option{Value: shared.EventSubscribed, Label: "Subscribed"},
+			&smartform.Option{Value: shared.EventUnsubscribed, Label: "Unsubscribed"},
+			&smartform.Option{Value: shared.EventBounced, Label: "Bounced"},
+			&smartform.Option{Value: shared.EventDeleted, Label: "Deleted"},
+		).
+		HelpText("The events to trigger on. Leave empty for all events.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start creates the list webhook for the trigger's URL.
+func (t *SubscriberEventTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	apiKey, clientID, err := shared.CredentialsFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+
+	listID, _ := ctx.Input()["subscriber_list"].(string)
+	if listID == "" {
+		return errors.New("list ID is required")
+	}
+
+	criteria, err := ctx.TriggerCriteria()
+	if err != nil {
+		return err
+	}
+	if criteria == nil || criteria.Webhook == nil || criteria.Webhook.Endpoint == "" {
+		return errors.New("the trigger has no webhook endpoint")
+	}
+
+	webhookID, err := shared.CreateListWebhook(apiKey, clientID, listID, criteria.Webhook.Endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to register the Campaign Monitor webhook: %w", err)
+	}
+
+	if err := ctx.StoreMetadata(webhookListIDKey, listID); err != nil {
+		return err
+	}
+	return ctx.StoreMetadata(webhookIDKey, webhookID)
+}
+
+// Stop removes the list webhook created by Start.
+func (t *SubscriberEventTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	storedID, err := ctx.GetMetadata(webhookIDKey)
+	if err != nil || storedID == nil {
+		return err
+	}
+	storedList, err := ctx.GetMetadata(webhookListIDKey)
+	if err != nil {
+		return err
+	}
+	webhookID, _ := storedID.(string)
+	listID, _ := storedList.(string)
+	if webhookID == "" || listID == "" {
+		return fmt.Errorf("invalid stored webhook %v", storedID)
+	}
+
+	apiKey, clientID, err := shared.CredentialsFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+	return shared.DeleteListWebhook(apiKey, clientID, listID, webhookID)
+}
+
+// Execute confirms each delivered event with Campaign Monitor before
+// triggering, since deliveries aren't signed.
+func (t *SubscriberEventTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[subscriberEventTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	listID, events, err := shared.ParseWebhook(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+	if listID != input.ListID {
+		return nil, fmt.Errorf("webhook delivery is for list %q, not %q", listID, input.ListID)
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []map[string]interface{}{}
+	for _, e := range events {
+		event := e.Event()
+		if event == "" || !wantsEvent(input.Events, event) {
+			continue
+		}
+
+		subscriber, confirmed, err := shared.ConfirmEvent(authCtx.Extra["api-key"], authCtx.Extra["client-id"], listID, event, e.EmailAddress)
+		if err != nil {
+			return nil, err
+		}
+		if !confirmed {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"event":       event,
+			"email":       e.EmailAddress,
+			"name":        e.Name,
+			"state":       subscriber["State"],
+			"list_id":     listID,
+			"occurred_at": e.Date,
+			"subscriber":  subscriber,
+		})
+	}
+
+	return results, nil
+}
+
+// wantsEvent reports whether event is selected. No selection is every
+// event.
+func wantsEvent(selected []string, event string) bool {
+	if len(selected) == 0 {
+		return true
+	}
+	for _, s := range selected {
+		if strings.EqualFold(s, event) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *SubscriberEventTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	webhook := sdkcore.NewWebhookTriggerCriteria()
+	webhook.Enabled = true
+	webhook.ContentType = "application/json"
+	return sdkcore.TriggerCriteria{Webhook: webhook}
+}
+
+func (t *SubscriberEventTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewSubscriberEventTrigger() sdk.Trigger {
+	return &SubscriberEventTrigger{}
+}
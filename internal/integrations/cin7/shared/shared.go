@@ -15,7 +15,9 @@
 package shared
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -42,6 +44,16 @@ var (
 
 const baseURL = "https://inventory.dearsystems.com"
 
+// CredentialsFrom reads the account ID and application key from the
+// values of a connection, keyed by the fields of the auth form.
+func CredentialsFrom(values map[string]string) (accountID, applicationKey string, err error) {
+	accountID, applicationKey = values["account_id"], values["key"]
+	if accountID == "" || applicationKey == "" {
+		return "", "", errors.New("missing Cin7 account ID and application key")
+	}
+	return accountID, applicationKey, nil
+}
+
 func FetchData(endpoint, accountID, applicationKey string, queryParams map[string]interface{}) (map[string]interface{}, error) {
 	params := url.Values{}
 	for key, value := range queryParams {
@@ -92,3 +104,46 @@ func FetchData(endpoint, accountID, applicationKey string, queryParams map[strin
 
 	return result, nil
 }
+
+// SendData sends a JSON body to the API, for the POST and PUT endpoints
+// FetchData doesn't cover.
+func SendData(method, endpoint, accountID, applicationKey string, payload interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request body: %w", err)
+	}
+
+	req, err := http.NewRequest(method, baseURL+endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Api-Auth-Accountid", accountID)
+	req.Header.Set("Api-Auth-Applicationkey", applicationKey)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("cin7 request failed with status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result map[string]interface{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed to parse response JSON: %w", err)
+		}
+	}
+
+	return result, nil
+}
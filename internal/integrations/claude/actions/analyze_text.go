@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/extensions/internal/integrations/claude/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -163,6 +164,8 @@ func (a *AnalyzeTextAction) Properties() *smartform.FormSchema {
 		HelpText("Controls analysis creativity (0=consistent, 1=creative, default: 0.2)").
 		Required(false)
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -265,6 +268,10 @@ func (a *AnalyzeTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, e
 		MaxTokens:   4096,
 	}
 
+	if err := shared.CheckBudget(ctx, request); err != nil {
+		return nil, err
+	}
+
 	gctx := context.Background()
 	response, err := shared.CallClaudeAPI(gctx, authCtx, request)
 	if err != nil {
@@ -284,11 +291,7 @@ func (a *AnalyzeTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, e
 			"analysis_type": input.AnalysisType,
 			"detail_level":  input.DetailLevel,
 			"model":         response.Model,
-			"usage": map[string]int{
-				"input_tokens":  response.Usage.InputTokens,
-				"output_tokens": response.Usage.OutputTokens,
-				"total_tokens":  response.Usage.InputTokens + response.Usage.OutputTokens,
-			},
+			"usage":         shared.ReportUsage(ctx, response),
 		}, nil
 	}
 
@@ -301,11 +304,7 @@ func (a *AnalyzeTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, e
 		"detail_level":  input.DetailLevel,
 		"text_stats":    textStats,
 		"model":         response.Model,
-		"usage": map[string]int{
-			"input_tokens":  response.Usage.InputTokens,
-			"output_tokens": response.Usage.OutputTokens,
-			"total_tokens":  response.Usage.InputTokens + response.Usage.OutputTokens,
-		},
+		"usage":         shared.ReportUsage(ctx, response),
 	}, nil
 }
 
@@ -5,7 +5,9 @@ import (
 	"errors"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/extensions/internal/integrations/claude/shared"
+	"github.com/wakflo/extensions/internal/prompt"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -32,9 +34,22 @@ func (a *ChatClaudeAction) Metadata() sdk.ActionMetadata {
 		SampleOutput: map[string]any{
 			"response": "Hello! I'd be happy to help you...",
 			"model":    "claude-3-5-sonnet-20241022",
-			"usage": map[string]int{
+			"usage": map[string]any{
 				"input_tokens":  10,
 				"output_tokens": 50,
+				"cached_tokens": 0,
+				"total_tokens":  60,
+				"estimated":     false,
+				"provider":      "anthropic",
+				"model":         "claude-3-5-sonnet-20241022",
+				"cost": map[string]any{
+					"input":    3e-05,
+					"output":   0.00075,
+					"cached":   0,
+					"total":    0.00078,
+					"currency": "USD",
+					"priced":   true,
+				},
 			},
 		},
 		Settings: core.ActionSettings{},
@@ -48,14 +63,16 @@ func (a *ChatClaudeAction) Properties() *smartform.FormSchema {
 
 	form.TextareaField("prompt", "Message").
 		Placeholder("Enter your message to Claude").
-		HelpText("Your question or prompt").
-		Required(true)
+		HelpText("Your question or prompt. Required unless a Prompt Template is set.").
+		Required(false)
 
 	form.TextareaField("system", "System Prompt").
 		Placeholder("You are a helpful assistant...").
 		HelpText("Optional system prompt to set Claude's behavior").
 		Required(false)
 
+	prompt.RegisterTemplateProps(form)
+
 	form.NumberField("temperature", "Temperature").
 		Placeholder("0.7").
 		HelpText("Controls randomness (0=focused, 1=creative)").
@@ -66,6 +83,8 @@ func (a *ChatClaudeAction) Properties() *smartform.FormSchema {
 		HelpText("Maximum response length in tokens").
 		Required(false)
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -92,6 +111,21 @@ func (a *ChatClaudeAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, er
 		return nil, errors.New("Model is required")
 	}
 
+	rendered, err := prompt.FromInput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rendered != nil {
+		input.Prompt = rendered.Prompt
+		if rendered.System != "" {
+			input.System = rendered.System
+		}
+	}
+
+	if input.Prompt == "" {
+		return nil, errors.New("a message or a prompt template is required")
+	}
+
 	if input.MaxTokens == 0 {
 		input.MaxTokens = 1024
 	}
@@ -117,22 +151,27 @@ func (a *ChatClaudeAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, er
 		System:      input.System,
 	}
 
+	if err := shared.CheckBudget(ctx, request); err != nil {
+		return nil, err
+	}
+
 	gctx := context.Background()
 	response, err := shared.CallClaudeAPI(gctx, authCtx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	return map[string]interface{}{
-		"response": shared.ExtractResponseText(response),
-		"model":    response.Model,
-		"usage": map[string]int{
-			"input_tokens":  response.Usage.InputTokens,
-			"output_tokens": response.Usage.OutputTokens,
-			"total_tokens":  response.Usage.InputTokens + response.Usage.OutputTokens,
-		},
+	output := map[string]interface{}{
+		"response":    shared.ExtractResponseText(response),
+		"model":       response.Model,
+		"usage":       shared.ReportUsage(ctx, response),
 		"stop_reason": response.StopReason,
-	}, nil
+	}
+	if rendered != nil {
+		output["prompt_template"] = rendered.Output()
+	}
+
+	return output, nil
 }
 
 func NewChatClaudeAction() sdk.Action {
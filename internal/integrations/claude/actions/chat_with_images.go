@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/extensions/internal/integrations/claude/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -36,9 +37,22 @@ func (a *ChatWithImagesAction) Metadata() sdk.ActionMetadata {
 		SampleOutput: map[string]any{
 			"response": "The image shows...",
 			"model":    "claude-3-5-sonnet-20241022",
-			"usage": map[string]int{
+			"usage": map[string]any{
 				"input_tokens":  100,
 				"output_tokens": 200,
+				"cached_tokens": 0,
+				"total_tokens":  300,
+				"estimated":     false,
+				"provider":      "anthropic",
+				"model":         "claude-3-5-sonnet-20241022",
+				"cost": map[string]any{
+					"input":    0.0003,
+					"output":   0.003,
+					"cached":   0,
+					"total":    0.0033,
+					"currency": "USD",
+					"priced":   true,
+				},
 			},
 		},
 		Settings: core.ActionSettings{},
@@ -65,6 +79,8 @@ func (a *ChatWithImagesAction) Properties() *smartform.FormSchema {
 		HelpText("Maximum response length").
 		Required(false)
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -192,6 +208,10 @@ func (a *ChatWithImagesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON
 		MaxTokens: input.MaxTokens,
 	}
 
+	if err := shared.CheckBudget(ctx, request); err != nil {
+		return nil, err
+	}
+
 	gctx := context.Background()
 	response, err := shared.CallClaudeAPI(gctx, authCtx, request)
 	if err != nil {
@@ -202,11 +222,7 @@ func (a *ChatWithImagesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON
 		"response":  shared.ExtractResponseText(response),
 		"model":     response.Model,
 		"image_url": input.ImageURL,
-		"usage": map[string]int{
-			"input_tokens":  response.Usage.InputTokens,
-			"output_tokens": response.Usage.OutputTokens,
-			"total_tokens":  response.Usage.InputTokens + response.Usage.OutputTokens,
-		},
+		"usage":     shared.ReportUsage(ctx, response),
 	}, nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/extensions/internal/integrations/claude/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -132,6 +133,8 @@ func (a *CompareTextsAction) Properties() *smartform.FormSchema {
 		HelpText("Controls analysis creativity (0=consistent, 1=creative, default: 0.2)").
 		Required(false)
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -232,6 +235,10 @@ func (a *CompareTextsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		MaxTokens:   4096,
 	}
 
+	if err := shared.CheckBudget(ctx, request); err != nil {
+		return nil, err
+	}
+
 	gctx := context.Background()
 	response, err := shared.CallClaudeAPI(gctx, authCtx, request)
 	if err != nil {
@@ -260,11 +267,7 @@ func (a *CompareTextsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 			"text2": text2Stats,
 		},
 		"model": response.Model,
-		"usage": map[string]int{
-			"input_tokens":  response.Usage.InputTokens,
-			"output_tokens": response.Usage.OutputTokens,
-			"total_tokens":  response.Usage.InputTokens + response.Usage.OutputTokens,
-		},
+		"usage": shared.ReportUsage(ctx, response),
 	}, nil
 }
 
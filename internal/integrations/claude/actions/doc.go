@@ -19,3 +19,12 @@ var compareTextsDocs string
 
 //go:embed analyze_text.md
 var analyzeTextDocs string
+
+//go:embed moderate_content.md
+var moderateContentDocs string
+
+//go:embed classify_text.md
+var classifyTextDocs string
+
+//go:embed redact_pii.md
+var redactPIIDocs string
@@ -0,0 +1,147 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiguard"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/integrations/claude/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type moderateContentActionProps struct {
+	Model     string  `json:"model"`
+	Text      string  `json:"text"`
+	Threshold float64 `json:"threshold"`
+}
+
+type ModerateContentAction struct{}
+
+func (a *ModerateContentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "moderate_content_claude",
+		DisplayName:   "Moderate Content",
+		Description:   "Check text for harassment, hate, self-harm, sexual, violent or illicit content and get a score for each category.",
+		Type:          core.ActionTypeAction,
+		Documentation: moderateContentDocs,
+		SampleOutput: map[string]any{
+			"flagged":            true,
+			"flagged_categories": []string{"harassment"},
+			"categories": map[string]bool{
+				"harassment": true,
+				"hate":       false,
+				"violence":   false,
+			},
+			"category_scores": map[string]float64{
+				"harassment": 0.82,
+				"hate":       0.05,
+				"violence":   0.02,
+			},
+			"reasoning": "The message insults the support agent directly.",
+			"model":     "claude-3-5-haiku-20241022",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *ModerateContentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("moderate_content_claude", "Moderate Content")
+
+	shared.RegisterModelProps(form)
+
+	form.TextareaField("text", "Text").
+		Placeholder("Paste the text to moderate...").
+		HelpText("The text to check for policy violations").
+		Required(true)
+
+	form.NumberField("threshold", "Threshold").
+		Placeholder("0.5").
+		HelpText("Score from 0 to 1 at or above which a category is flagged (default: 0.5)").
+		Required(false)
+
+	aiusage.RegisterBudgetProps(form)
+
+	return form.Build()
+}
+
+func (a *ModerateContentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *ModerateContentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[moderateContentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Model == "" {
+		return nil, errors.New("Model is required")
+	}
+
+	if input.Text == "" {
+		return nil, errors.New("text to moderate cannot be empty")
+	}
+
+	system, prompt := aiguard.ModerationPrompt(input.Text)
+	request := newGuardRequest(input.Model, system, prompt, 1024)
+
+	if err := shared.CheckBudget(ctx, request); err != nil {
+		return nil, err
+	}
+
+	response, err := shared.CallClaudeAPI(context.Background(), authCtx, request)
+	if err != nil {
+		return nil, fmt.Errorf("moderation failed: %w", err)
+	}
+
+	usage := shared.ReportUsage(ctx, response)
+
+	moderation, err := aiguard.ParseModeration(shared.ExtractResponseText(response), input.Threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	output := moderation.Output()
+	output["model"] = response.Model
+	output["usage"] = usage
+
+	return output, nil
+}
+
+// newGuardRequest builds a deterministic single-turn request for the
+// moderation, classification and PII actions.
+func newGuardRequest(model, system, prompt string, maxTokens int) shared.ClaudeRequest {
+	return shared.ClaudeRequest{
+		Model: model,
+		Messages: []shared.ClaudeMessage{
+			{
+				Role: "user",
+				Content: []interface{}{
+					shared.ClaudeTextContent{
+						Type: "text",
+						Text: prompt,
+					},
+				},
+			},
+		},
+		System: system,
+		// Keep scores stable between runs. Zero would be dropped by
+		// omitempty and fall back to the API default of 1.
+		Temperature: 0.1,
+		MaxTokens:   maxTokens,
+	}
+}
+
+func NewModerateContentAction() sdk.Action {
+	return &ModerateContentAction{}
+}
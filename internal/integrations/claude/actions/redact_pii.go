@@ -0,0 +1,115 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiguard"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/integrations/claude/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type redactPIIActionProps struct {
+	aiguard.RedactionProps
+	Model string `json:"model"`
+}
+
+type RedactPIIAction struct{}
+
+func (a *RedactPIIAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "redact_pii_claude",
+		DisplayName:   "Redact PII",
+		Description:   "Find and redact emails, phone numbers, credit cards and IBANs in text, optionally with Claude finding names, addresses and other personal data.",
+		Type:          core.ActionTypeAction,
+		Documentation: redactPIIDocs,
+		SampleOutput: map[string]any{
+			"redacted_text": "Hi, I'm [PERSON_NAME_1]. My card [CREDIT_CARD_1] was charged twice, email me at [EMAIL_1].",
+			"entities": []map[string]any{
+				{"type": "person_name", "start": 8, "end": 16, "replacement": "[PERSON_NAME_1]", "source": "llm"},
+				{"type": "credit_card", "start": 26, "end": 45, "replacement": "[CREDIT_CARD_1]", "source": "pattern"},
+				{"type": "email", "start": 79, "end": 95, "replacement": "[EMAIL_1]", "source": "pattern"},
+			},
+			"counts":       map[string]int{"person_name": 1, "credit_card": 1, "email": 1},
+			"entity_count": 3,
+			"contains_pii": true,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *RedactPIIAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("redact_pii_claude", "Redact PII")
+
+	aiguard.RegisterRedactionProps(form)
+
+	shared.RegisterModelProps(form).
+		Required(false).
+		VisibleWhenEquals("use_llm", true)
+
+	aiusage.RegisterBudgetProps(form)
+
+	return form.Build()
+}
+
+func (a *RedactPIIAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *RedactPIIAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[redactPIIActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !input.UseLLM {
+		return input.Output(nil), nil
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Model == "" {
+		return nil, errors.New("Model is required when LLM assistance is enabled")
+	}
+
+	system, prompt := aiguard.PIIPrompt(input.Text)
+	request := newGuardRequest(input.Model, system, prompt, 4096)
+
+	if err := shared.CheckBudget(ctx, request); err != nil {
+		return nil, err
+	}
+
+	response, err := shared.CallClaudeAPI(context.Background(), authCtx, request)
+	if err != nil {
+		return nil, fmt.Errorf("pii detection failed: %w", err)
+	}
+
+	usage := shared.ReportUsage(ctx, response)
+
+	spans, err := aiguard.ParsePII(shared.ExtractResponseText(response), input.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	output := input.Output(spans)
+	output["model"] = response.Model
+	output["usage"] = usage
+
+	return output, nil
+}
+
+func NewRedactPIIAction() sdk.Action {
+	return &RedactPIIAction{}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/extensions/internal/integrations/claude/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -68,6 +69,8 @@ func (a *SummarizeTextAction) Properties() *smartform.FormSchema {
 		).
 		HelpText("Desired summary length")
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -132,6 +135,10 @@ func (a *SummarizeTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		MaxTokens: 2048,
 	}
 
+	if err := shared.CheckBudget(ctx, request); err != nil {
+		return nil, err
+	}
+
 	gctx := context.Background()
 	response, err := shared.CallClaudeAPI(gctx, authCtx, request)
 	if err != nil {
@@ -142,6 +149,7 @@ func (a *SummarizeTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		"summary": shared.ExtractResponseText(response),
 		"model":   response.Model,
 		"style":   input.Style,
+		"usage":   shared.ReportUsage(ctx, response),
 	}, nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/extensions/internal/integrations/claude/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -178,6 +179,8 @@ func (a *TranslateTextAction) Properties() *smartform.FormSchema {
 		HelpText("Controls translation creativity (0=conservative, 1=creative, default: 0.3)").
 		Required(false)
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -348,6 +351,10 @@ func (a *TranslateTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		MaxTokens:   4096,
 	}
 
+	if err := shared.CheckBudget(ctx, request); err != nil {
+		return nil, err
+	}
+
 	gctx := context.Background()
 	response, err := shared.CallClaudeAPI(gctx, authCtx, request)
 	if err != nil {
@@ -363,11 +370,7 @@ func (a *TranslateTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 			"source_language": getLanguageName(input.SourceLang),
 			"target_language": getLanguageName(input.TargetLang),
 			"model":           response.Model,
-			"usage": map[string]int{
-				"input_tokens":  response.Usage.InputTokens,
-				"output_tokens": response.Usage.OutputTokens,
-				"total_tokens":  response.Usage.InputTokens + response.Usage.OutputTokens,
-			},
+			"usage":           shared.ReportUsage(ctx, response),
 		}, nil
 	}
 
@@ -376,11 +379,7 @@ func (a *TranslateTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		"target_language": getLanguageName(input.TargetLang),
 		"formality":       input.Formality,
 		"model":           response.Model,
-		"usage": map[string]int{
-			"input_tokens":  response.Usage.InputTokens,
-			"output_tokens": response.Usage.OutputTokens,
-			"total_tokens":  response.Usage.InputTokens + response.Usage.OutputTokens,
-		},
+		"usage":           shared.ReportUsage(ctx, response),
 	}
 
 	for key, value := range translationResult {
@@ -57,6 +57,9 @@ func (c *Claude) Actions() []sdk.Action {
 
 		actions.NewAnalyzeTextAction(),
 		actions.NewCompareTextsAction(),
+		actions.NewModerateContentAction(),
+		actions.NewClassifyTextAction(),
+		actions.NewRedactPIIAction(),
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 )
 
@@ -97,6 +98,32 @@ func RegisterModelProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
 		HelpText("Claude Sonnet 4.5 offers the best performance for complex agents and coding. Use aliases for automatic updates to the latest snapshot.")
 }
 
+// CheckBudget fails fast when the estimated cost of the request is above the
+// budget configured on the action.
+func CheckBudget(ctx aiusage.Context, request ClaudeRequest) error {
+	texts := []string{request.System}
+	for _, message := range request.Messages {
+		for _, content := range message.Content {
+			if text, ok := content.(ClaudeTextContent); ok {
+				texts = append(texts, text.Text)
+			}
+		}
+	}
+
+	return aiusage.Check(ctx, request.Model, int64(request.MaxTokens), texts...)
+}
+
+// ReportUsage normalizes and prices the usage of a Claude response. Claude
+// reports cache reads and writes apart from input_tokens, so both are folded
+// back into the input count and writes are priced at the cache write rate.
+func ReportUsage(ctx aiusage.Context, response *ClaudeResponse) map[string]interface{} {
+	input := response.Usage.InputTokens + response.Usage.CacheReadInputTokens + response.Usage.CacheCreationInputTokens
+	usage := aiusage.NewUsage(int64(input), int64(response.Usage.OutputTokens), int64(response.Usage.CacheReadInputTokens))
+	usage.CacheWriteTokens = int64(response.Usage.CacheCreationInputTokens)
+
+	return aiusage.Finish(ctx, "anthropic", response.Model, usage)
+}
+
 // ExtractResponseText extracts text from Claude response
 func ExtractResponseText(response *ClaudeResponse) string {
 	var text string
@@ -0,0 +1,71 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/convertkit/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type addToFormActionProps struct {
+	subscriptionFields
+	FormID string `json:"form_id"`
+}
+
+type AddToFormAction struct{}
+
+// Metadata returns metadata about the action
+func (a *AddToFormAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "add_to_form",
+		DisplayName:   "Add Subscriber to Form",
+		Description:   "Subscribe an email address through a ConvertKit form, creating the subscriber when there is none.",
+		Type:          core.ActionTypeAction,
+		Documentation: addToFormDocs,
+		Icon:          "mdi:form-select",
+		SampleOutput:  subscriptionSample("form"),
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *AddToFormAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("add_to_form", "Add Subscriber to Form")
+
+	shared.RegisterFormsProps(form)
+	registerSubscriptionProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *AddToFormAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *AddToFormAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[addToFormActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := input.subscribe(authCtx.Extra["api-key"], authCtx.Extra["api-secret"], input.FormID, shared.AddToForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"subscription": subscription}, nil
+}
+
+func NewAddToFormAction() sdk.Action {
+	return &AddToFormAction{}
+}
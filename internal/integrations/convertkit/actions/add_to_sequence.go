@@ -0,0 +1,71 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/convertkit/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type addToSequenceActionProps struct {
+	subscriptionFields
+	SequenceID string `json:"sequence_id"`
+}
+
+type AddToSequenceAction struct{}
+
+// Metadata returns metadata about the action
+func (a *AddToSequenceAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "add_to_sequence",
+		DisplayName:   "Add Subscriber to Sequence",
+		Description:   "Subscribe an email address to a ConvertKit sequence, creating the subscriber when there is none.",
+		Type:          core.ActionTypeAction,
+		Documentation: addToSequenceDocs,
+		Icon:          "mdi:email-multiple",
+		SampleOutput:  subscriptionSample("course"),
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *AddToSequenceAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("add_to_sequence", "Add Subscriber to Sequence")
+
+	shared.RegisterSequencesProps(form)
+	registerSubscriptionProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *AddToSequenceAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *AddToSequenceAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[addToSequenceActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := input.subscribe(authCtx.Extra["api-key"], authCtx.Extra["api-secret"], input.SequenceID, shared.AddToSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"subscription": subscription}, nil
+}
+
+func NewAddToSequenceAction() sdk.Action {
+	return &AddToSequenceAction{}
+}
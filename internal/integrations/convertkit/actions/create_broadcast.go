@@ -0,0 +1,156 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/convertkit/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createBroadcastActionProps struct {
+	Subject             string `json:"subject"`
+	Content             string `json:"content"`
+	Description         string `json:"description"`
+	EmailAddress        string `json:"email_address"`
+	EmailLayoutTemplate string `json:"email_layout_template"`
+	SendAt              string `json:"send_at"`
+	Public              bool   `json:"public"`
+}
+
+type CreateBroadcastAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateBroadcastAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_broadcast",
+		DisplayName:   "Create Broadcast",
+		Description:   "Create a ConvertKit broadcast as a draft, or scheduled to be sent to your subscribers at a given time.",
+		Type:          core.ActionTypeAction,
+		Documentation: createBroadcastDocs,
+		Icon:          "mdi:bullhorn",
+		SampleOutput: map[string]any{
+			"id":            12345,
+			"created_at":    "2025-03-12T18:20:00.000Z",
+			"subject":       "March product update",
+			"description":   "Monthly update",
+			"content":       "<p>Here is what's new this month.</p>",
+			"public":        false,
+			"published_at":  nil,
+			"send_at":       "2025-03-14T09:00:00.000Z",
+			"email_address": "hello@example.com",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateBroadcastAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_broadcast", "Create Broadcast")
+
+	form.TextField("subject", "Subject").
+		Required(true).
+		HelpText("The subject line of the email")
+
+	form.TextareaField("content", "Content").
+		Required(true).
+		HelpText("The HTML body of the email")
+
+	form.TextField("description", "Description").
+		Required(false).
+		HelpText("An internal description of the broadcast")
+
+	form.TextField("email_address", "From Email").
+		Required(false).
+		HelpText("The sending address. Defaults to the account's sending address.")
+
+	form.TextField("email_layout_template", "Email Template").
+		Required(false).
+		HelpText("The name of the email template. Defaults to the account's default template.")
+
+	form.DateTimeField("send_at", "Send At").
+		Required(false).
+		HelpText("When to send the broadcast. Leave empty to save it as a draft.")
+
+	form.CheckboxField("public", "Publish to Web").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Also publish the broadcast on your ConvertKit creator profile")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateBroadcastAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateBroadcastAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createBroadcastActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(input.Subject) == "" || strings.TrimSpace(input.Content) == "" {
+		return nil, errors.New("subject and content are required")
+	}
+
+	broadcast := map[string]interface{}{
+		"subject": input.Subject,
+		"content": input.Content,
+		"public":  input.Public,
+	}
+	if input.Description != "" {
+		broadcast["description"] = input.Description
+	}
+	if input.EmailAddress != "" {
+		broadcast["email_address"] = input.EmailAddress
+	}
+	if input.EmailLayoutTemplate != "" {
+		broadcast["email_layout_template"] = input.EmailLayoutTemplate
+	}
+	if input.SendAt != "" {
+		at, err := parseTime(input.SendAt)
+		if err != nil {
+			return nil, err
+		}
+		if !at.After(time.Now()) {
+			return nil, fmt.Errorf("send time %s is in the past", at.UTC().Format(time.RFC3339))
+		}
+		broadcast["send_at"] = at.UTC().Format(time.RFC3339)
+	}
+	if input.Public {
+		broadcast["published_at"] = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.CreateBroadcast(authCtx.Extra["api-secret"], broadcast)
+}
+
+// parseTime reads a date and time as RFC 3339, or without a time zone
+// as UTC.
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date and time such as 2024-03-14T09:00:00Z", s)
+}
+
+func NewCreateBroadcastAction() sdk.Action {
+	return &CreateBroadcastAction{}
+}
@@ -19,3 +19,21 @@ var createTagDocs string
 
 //go:embed tag_subscriber.md
 var tagSubscriberDocs string
+
+//go:embed upsert_contact.md
+var upsertContactDocs string
+
+//go:embed add_to_sequence.md
+var addToSequenceDocs string
+
+//go:embed add_to_form.md
+var addToFormDocs string
+
+//go:embed create_broadcast.md
+var createBroadcastDocs string
+
+//go:embed unsubscribe_subscriber.md
+var unsubscribeSubscriberDocs string
+
+//go:embed update_custom_fields.md
+var updateCustomFieldsDocs string
@@ -0,0 +1,80 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/convertkit/shared"
+)
+
+// subscriptionFields are the subscriber fields of actions that subscribe
+// an email address to a form or sequence.
+type subscriptionFields struct {
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	Fields    string `json:"fields"`
+	Tags      string `json:"tags"`
+}
+
+func registerSubscriptionProps(form *smartform.FormBuilder) {
+	form.TextField("email", "Email").
+		Required(true).
+		HelpText("The subscriber is created when there is none with this email address.")
+
+	form.TextField("first_name", "First Name").
+		Required(false)
+
+	form.TextareaField("fields", "Custom Fields").
+		Required(false).
+		HelpText(`Custom fields by key, one "key=value" per line or a JSON object. The fields must exist in ConvertKit.`)
+
+	form.TextField("tags", "Tags").
+		Required(false).
+		HelpText("Comma-separated tag names to add. Missing tags are created.")
+}
+
+// subscribe subscribes the email address through fn, such as
+// shared.AddToSequence, and returns the subscription.
+func (f subscriptionFields) subscribe(apiKey, apiSecret, id string,
+	fn func(apiSecret, id, email, firstName string, fields map[string]interface{}, tagIDs []string) (map[string]interface{}, error),
+) (map[string]interface{}, error) {
+	email := strings.TrimSpace(f.Email)
+	if email == "" {
+		return nil, errors.New("email is required")
+	}
+
+	fields, err := crm.ParseFields(f.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, name := range strings.Split(f.Tags, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	tagIDs, err := shared.TagIDs(apiKey, apiSecret, names)
+	if err != nil {
+		return nil, err
+	}
+
+	return fn(apiSecret, id, email, strings.TrimSpace(f.FirstName), fields, tagIDs)
+}
+
+func subscriptionSample(subscribableType string) map[string]any {
+	return map[string]any{
+		"subscription": map[string]any{
+			"id":                1581633,
+			"state":             "active",
+			"created_at":        "2025-03-12T18:20:00.000Z",
+			"subscribable_id":   1234,
+			"subscribable_type": subscribableType,
+			"subscriber": map[string]any{
+				"id": 1143012,
+			},
+		},
+	}
+}
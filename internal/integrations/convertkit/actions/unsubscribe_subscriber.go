@@ -0,0 +1,88 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/convertkit/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type unsubscribeSubscriberActionProps struct {
+	Email string `json:"email"`
+}
+
+type UnsubscribeSubscriberAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UnsubscribeSubscriberAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "unsubscribe_subscriber",
+		DisplayName:   "Unsubscribe Subscriber",
+		Description:   "Unsubscribe an email address from all ConvertKit emails, sequences and forms.",
+		Type:          core.ActionTypeAction,
+		Documentation: unsubscribeSubscriberDocs,
+		Icon:          "mdi:email-remove",
+		SampleOutput: map[string]any{
+			"subscriber": map[string]any{
+				"id":            1143012,
+				"first_name":    "Jane",
+				"email_address": "jane@example.com",
+				"state":         "cancelled",
+				"created_at":    "2025-01-15T10:30:00.000Z",
+				"fields":        map[string]any{},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UnsubscribeSubscriberAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("unsubscribe_subscriber", "Unsubscribe Subscriber")
+
+	form.TextField("email", "Email").
+		Required(true).
+		HelpText("Email address of the subscriber")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UnsubscribeSubscriberAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UnsubscribeSubscriberAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[unsubscribeSubscriberActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	email := strings.TrimSpace(input.Email)
+	if email == "" {
+		return nil, errors.New("email is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber, err := shared.Unsubscribe(authCtx.Extra["api-secret"], email)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"subscriber": subscriber}, nil
+}
+
+func NewUnsubscribeSubscriberAction() sdk.Action {
+	return &UnsubscribeSubscriberAction{}
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/convertkit/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateCustomFieldsActionProps struct {
+	Email         string `json:"email"`
+	Fields        string `json:"fields"`
+	CreateMissing bool   `json:"create_missing"`
+}
+
+type UpdateCustomFieldsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateCustomFieldsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_custom_fields",
+		DisplayName:   "Update Subscriber Custom Fields",
+		Description:   "Set custom field values of a ConvertKit subscriber, by field key or label, optionally creating missing fields.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateCustomFieldsDocs,
+		Icon:          "mdi:form-textbox",
+		SampleOutput: map[string]any{
+			"subscriber": map[string]any{
+				"id":            1143012,
+				"first_name":    "Jane",
+				"email_address": "jane@example.com",
+				"state":         "active",
+				"fields":        map[string]any{"plan": "Pro", "last_order": "2025-03-12"},
+			},
+			"created_fields": []string{"Last Order"},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateCustomFieldsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_custom_fields", "Update Subscriber Custom Fields")
+
+	form.TextField("email", "Email").
+		Required(true).
+		HelpText("Email address of the subscriber")
+
+	form.TextareaField("fields", "Custom Fields").
+		Required(true).
+		HelpText(`Fields by key or label, one "field=value" per line or a JSON object.`)
+
+	form.CheckboxField("create_missing", "Create Missing Fields").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Create custom fields ConvertKit doesn't have yet, labelled as given, instead of failing")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateCustomFieldsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateCustomFieldsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateCustomFieldsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	email := strings.TrimSpace(input.Email)
+	if email == "" {
+		return nil, errors.New("email is required")
+	}
+	values, err := crm.ParseFields(input.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, errors.New("give at least one custom field")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	apiKey, apiSecret := authCtx.Extra["api-key"], authCtx.Extra["api-secret"]
+
+	existing, err := shared.FindSubscriber(apiSecret, email)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, fmt.Errorf("no ConvertKit subscriber has the email address %s", email)
+	}
+
+	fields, created, err := shared.FieldKeys(apiKey, apiSecret, values, input.CreateMissing)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber, err := shared.UpdateSubscriber(apiSecret, shared.IDString(existing["id"]), "", fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if created == nil {
+		created = []string{}
+	}
+	return map[string]interface{}{
+		"subscriber":     subscriber,
+		"created_fields": created,
+	}, nil
+}
+
+func NewUpdateCustomFieldsAction() sdk.Action {
+	return &UpdateCustomFieldsAction{}
+}
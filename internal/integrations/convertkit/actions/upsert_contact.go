@@ -0,0 +1,127 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/convertkit/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// contactFieldMap names the ConvertKit subscriber fields of the standard
+// contact fields. Subscribers only have an email and a first name; the
+// rest needs custom fields.
+var contactFieldMap = crm.FieldMap{
+	crm.FieldEmail:     "email",
+	crm.FieldFirstName: "first_name",
+}
+
+type upsertContactActionProps struct {
+	FormID string `json:"form_id"`
+}
+
+type UpsertContactAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpsertContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_contact",
+		DisplayName:   "Create or Update Subscriber",
+		Description:   "Find a ConvertKit subscriber by email and update it, or add it through a form or tag when there is none.",
+		Type:          core.ActionTypeAction,
+		Documentation: upsertContactDocs,
+		SampleOutput: crm.SampleOutput(map[string]any{
+			"id":            1234567,
+			"first_name":    "Jane",
+			"email_address": "jane.doe@example.com",
+			"state":         "active",
+			"fields":        map[string]any{"last_name": "Doe"},
+		}),
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpsertContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_contact", "Create or Update Subscriber")
+
+	crm.RegisterContactProps(form)
+
+	form.TextField("form_id", "Form ID").
+		Required(false).
+		HelpText("Form new subscribers are added through. Without a form, they are added through the first tag.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpsertContactAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input. Custom
+// fields are given by their ConvertKit key, such as last_name.
+func (a *UpsertContactAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[upsertContactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := crm.ContactFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	apiKey, apiSecret := authCtx.Extra["api-key"], authCtx.Extra["api-secret"]
+
+	_, ignored := contactFieldMap.Standard(contact)
+
+	tagIDs, err := shared.TagIDs(apiKey, apiSecret, contact.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := shared.FindSubscriber(apiSecret, contact.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	var id string
+	if existing != nil {
+		id = shared.IDString(existing["id"])
+		if _, err := shared.UpdateSubscriber(apiSecret, id, contact.FirstName, contact.Fields); err != nil {
+			return nil, err
+		}
+		if err := shared.TagSubscriber(apiSecret, contact.Email, tagIDs); err != nil {
+			return nil, err
+		}
+	} else {
+		id, err = shared.Subscribe(apiSecret, input.FormID, contact.Email, contact.FirstName, contact.Fields, tagIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	subscriber, err := shared.GetSubscriber(apiSecret, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return crm.Result{
+		ID:      id,
+		Created: existing == nil,
+		Contact: subscriber,
+		Ignored: ignored,
+	}.Output(), nil
+}
+
+func NewUpsertContactAction() sdk.Action {
+	return &UpsertContactAction{}
+}
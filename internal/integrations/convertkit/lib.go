@@ -35,6 +35,7 @@ func (n *ConvertKit) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewSubscriberCreatedTrigger(),
 		triggers.NewTagCreatedTrigger(),
+		triggers.NewSubscriberEventTrigger(),
 	}
 }
 
@@ -46,6 +47,12 @@ func (n *ConvertKit) Actions() []sdk.Action {
 		actions.NewCreateTagAction(),
 		actions.NewTagSubscriberAction(),
 		actions.NewListTagsAction(),
+		actions.NewUpsertContactAction(),
+		actions.NewAddToSequenceAction(),
+		actions.NewAddToFormAction(),
+		actions.NewCreateBroadcastAction(),
+		actions.NewUnsubscribeSubscriberAction(),
+		actions.NewUpdateCustomFieldsAction(),
 	}
 }
 
@@ -0,0 +1,216 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// AddToSequence subscribes an email address to a sequence, creating the
+// subscriber when there is none, and returns the subscription.
+func AddToSequence(apiSecret, sequenceID, email, firstName string, fields map[string]interface{}, tagIDs []string) (map[string]interface{}, error) {
+	return subscribeTo("/sequences/"+url.PathEscape(sequenceID)+"/subscribe", apiSecret, email, firstName, fields, tagIDs)
+}
+
+// AddToForm subscribes an email address through a form, creating the
+// subscriber when there is none, and returns the subscription.
+func AddToForm(apiSecret, formID, email, firstName string, fields map[string]interface{}, tagIDs []string) (map[string]interface{}, error) {
+	return subscribeTo("/forms/"+url.PathEscape(formID)+"/subscribe", apiSecret, email, firstName, fields, tagIDs)
+}
+
+// Unsubscribe unsubscribes an email address from all emails and returns
+// the subscriber.
+func Unsubscribe(apiSecret, email string) (map[string]interface{}, error) {
+	response, err := sendJSON("/unsubscribe", http.MethodPut, map[string]interface{}{
+		"api_secret": apiSecret,
+		"email":      email,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asMap(response["subscriber"]), nil
+}
+
+// CreateBroadcast creates a broadcast from the fields of the broadcasts
+// API and returns it.
+func CreateBroadcast(apiSecret string, broadcast map[string]interface{}) (map[string]interface{}, error) {
+	payload := map[string]interface{}{"api_secret": apiSecret}
+	for k, v := range broadcast {
+		payload[k] = v
+	}
+	response, err := sendJSON("/broadcasts", http.MethodPost, payload)
+	if err != nil {
+		return nil, err
+	}
+	return asMap(response["broadcast"]), nil
+}
+
+// CustomField is a custom subscriber field. Subscribers hold its value
+// under Key.
+type CustomField struct {
+	ID    string
+	Key   string
+	Label string
+}
+
+// CustomFields returns the custom fields of the account.
+func CustomFields(apiKey string) ([]CustomField, error) {
+	response, err := GetConvertKitClient("/custom_fields?api_key="+url.QueryEscape(apiKey), http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	items, _ := asMap(response)["custom_fields"].([]interface{})
+	fields := make([]CustomField, 0, len(items))
+	for _, item := range items {
+		f := asMap(item)
+		fields = append(fields, CustomField{
+			ID:    IDString(f["id"]),
+			Key:   fmt.Sprint(f["key"]),
+			Label: fmt.Sprint(f["label"]),
+		})
+	}
+	return fields, nil
+}
+
+// CreateCustomField creates a custom field and returns it. ConvertKit
+// derives the key from the label.
+func CreateCustomField(apiSecret, label string) (CustomField, error) {
+	response, err := sendJSON("/custom_fields", http.MethodPost, map[string]interface{}{
+		"api_secret": apiSecret,
+		"label":      label,
+	})
+	if err != nil {
+		return CustomField{}, fmt.Errorf("creating custom field %q: %w", label, err)
+	}
+	return CustomField{
+		ID:    IDString(response["id"]),
+		Key:   fmt.Sprint(response["key"]),
+		Label: fmt.Sprint(response["label"]),
+	}, nil
+}
+
+// FieldKeys maps fields given by key or label to their keys, creating
+// the missing fields when create is set. It returns the values by key
+// and the labels of the fields it created.
+func FieldKeys(apiKey, apiSecret string, values map[string]interface{}, create bool) (map[string]interface{}, []string, error) {
+	if len(values) == 0 {
+		return values, nil, nil
+	}
+	fields, err := CustomFields(apiKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	known := map[string]string{}
+	for _, f := range fields {
+		known[strings.ToLower(f.Key)] = f.Key
+		known[strings.ToLower(f.Label)] = f.Key
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	byKey := make(map[string]interface{}, len(values))
+	var created, missing []string
+	for _, name := range names {
+		if key, ok := known[strings.ToLower(name)]; ok {
+			byKey[key] = values[name]
+			continue
+		}
+		if !create {
+			missing = append(missing, name)
+			continue
+		}
+		field, err := CreateCustomField(apiSecret, name)
+		if err != nil {
+			return nil, created, err
+		}
+		created = append(created, field.Label)
+		byKey[field.Key] = values[name]
+	}
+	if len(missing) > 0 {
+		return nil, nil, fmt.Errorf("ConvertKit has no custom fields %s; create them or turn on creating missing fields", strings.Join(missing, ", "))
+	}
+	return byKey, created, nil
+}
+
+// SubscriberTagIDs returns the IDs of a subscriber's tags.
+func SubscriberTagIDs(apiKey, subscriberID string) ([]string, error) {
+	response, err := GetConvertKitClient("/subscribers/"+url.PathEscape(subscriberID)+"/tags?api_key="+url.QueryEscape(apiKey), http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	tags, _ := asMap(response)["tags"].([]interface{})
+	ids := make([]string, 0, len(tags))
+	for _, t := range tags {
+		ids = append(ids, IDString(asMap(t)["id"]))
+	}
+	return ids, nil
+}
+
+// RegisterSequencesProps adds a sequence selection.
+func RegisterSequencesProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	return registerResourceProps(form, "sequence_id", "Sequence", "/sequences", "courses",
+		"Select a ConvertKit sequence")
+}
+
+// RegisterFormsProps adds a form selection.
+func RegisterFormsProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	return registerResourceProps(form, "form_id", "Form", "/forms", "forms",
+		"Select a ConvertKit form")
+}
+
+// registerResourceProps adds a selection of the resources listed at path
+// under key, by ID and name.
+func registerResourceProps(form *smartform.FormBuilder, id, label, path, key, help string) *smartform.FieldBuilder {
+	getResources := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+		apiKey := authCtx.Extra["api-key"]
+		if apiKey == "" {
+			return nil, errors.New("API key not found in auth context")
+		}
+
+		response, err := GetConvertKitClient(path+"?api_key="+url.QueryEscape(apiKey), http.MethodGet, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items, _ := asMap(response)[key].([]interface{})
+		options := make([]map[string]interface{}, 0, len(items))
+		for _, item := range items {
+			resource := asMap(item)
+			options = append(options, map[string]interface{}{
+				"id":   IDString(resource["id"]),
+				"name": fmt.Sprint(resource["name"]),
+			})
+		}
+
+		return ctx.Respond(options, len(options))
+	}
+
+	return form.SelectField(id, label).
+		Placeholder("Select a " + strings.ToLower(label)).
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getResources)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText(help)
+}
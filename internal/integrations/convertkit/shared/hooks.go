@@ -0,0 +1,125 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/wakflo/extensions/internal/webhook"
+)
+
+// Hook events of the subscriber event trigger.
+const (
+	HookSubscribed   = "subscribed"
+	HookUnsubscribed = "unsubscribed"
+	HookBounced      = "bounced"
+	HookComplained   = "complained"
+	HookTagRemoved   = "tag_removed"
+)
+
+// hookEvents names the ConvertKit webhook event of each hook event.
+var hookEvents = map[string]string{
+	HookSubscribed:   "subscriber.subscriber_activate",
+	HookUnsubscribed: "subscriber.subscriber_unsubscribe",
+	HookBounced:      "subscriber.subscriber_bounce",
+	HookComplained:   "subscriber.subscriber_complain",
+	HookTagRemoved:   "subscriber.tag_remove",
+}
+
+// hookStates is the state a subscriber is left in by each hook event.
+var hookStates = map[string]string{
+	HookSubscribed:   "active",
+	HookUnsubscribed: "cancelled",
+	HookBounced:      "bounced",
+	HookComplained:   "complained",
+}
+
+// CreateHook subscribes targetURL to an event, and returns the ID of the
+// webhook rule. Tag events need the tag's ID.
+func CreateHook(apiSecret, targetURL, event, tagID string) (string, error) {
+	name, ok := hookEvents[event]
+	if !ok {
+		return "", fmt.Errorf("unknown subscriber event %q", event)
+	}
+	hookEvent := map[string]interface{}{"name": name}
+	if event == HookTagRemoved {
+		if tagID == "" {
+			return "", errors.New("choose the tag whose removal triggers the workflow")
+		}
+		hookEvent["tag_id"] = tagID
+	}
+
+	response, err := sendJSON("/automations/hooks", http.MethodPost, map[string]interface{}{
+		"api_secret": apiSecret,
+		"target_url": targetURL,
+		"event":      hookEvent,
+	})
+	if err != nil {
+		return "", err
+	}
+	rule := asMap(response["rule"])
+	if rule == nil || rule["id"] == nil {
+		return "", errors.New("no webhook rule in the ConvertKit response")
+	}
+	return IDString(rule["id"]), nil
+}
+
+// DeleteHook removes a webhook rule.
+func DeleteHook(apiSecret, ruleID string) error {
+	_, err := sendJSON("/automations/hooks/"+url.PathEscape(ruleID), http.MethodDelete, map[string]interface{}{
+		"api_secret": apiSecret,
+	})
+	return err
+}
+
+// ConfirmHook reports whether a subscriber is in the state an event
+// leaves it in, as ConvertKit doesn't sign webhook deliveries. For tag
+// removals, the subscriber must no longer have the tag.
+func ConfirmHook(apiKey, apiSecret, event, tagID, subscriberID string) (map[string]interface{}, bool, error) {
+	subscriber, err := GetSubscriber(apiSecret, subscriberID)
+	if err != nil {
+		return nil, false, err
+	}
+	if subscriber == nil {
+		return nil, false, nil
+	}
+	if event != HookTagRemoved {
+		return subscriber, subscriber["state"] == hookStates[event], nil
+	}
+
+	tagIDs, err := SubscriberTagIDs(apiKey, subscriberID)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, id := range tagIDs {
+		if id == tagID {
+			return subscriber, false, nil
+		}
+	}
+	return subscriber, true, nil
+}
+
+// CredentialsFromConfig reads the API key and secret from a trigger
+// config.
+func CredentialsFromConfig(config map[string]interface{}) (apiKey, apiSecret string, err error) {
+	values := webhook.Connection(config)
+	if values["api-secret"] == "" {
+		return "", "", errors.New("missing ConvertKit API secret")
+	}
+	return values["api-key"], values["api-secret"], nil
+}
+
+// HookSubscriber returns the subscriber of a webhook delivery, whose
+// body is {"subscriber": {...}}.
+func HookSubscriber(input map[string]interface{}) (map[string]interface{}, error) {
+	var body map[string]interface{}
+	if err := webhook.DecodeBody(input, &body); err != nil {
+		return nil, fmt.Errorf("invalid ConvertKit webhook body: %w", err)
+	}
+	subscriber := asMap(body["subscriber"])
+	if subscriber == nil || subscriber["id"] == nil {
+		return nil, errors.New("ConvertKit webhook body has no subscriber")
+	}
+	return subscriber, nil
+}
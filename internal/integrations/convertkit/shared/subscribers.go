@@ -0,0 +1,178 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// asMap returns a decoded ConvertKit response as a map.
+func asMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
+
+func sendJSON(path, method string, payload map[string]interface{}) (map[string]interface{}, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request body: %v", err)
+	}
+	response, err := GetConvertKitClient(path, method, strings.NewReader(string(body)))
+	if err != nil {
+		return nil, err
+	}
+	return asMap(response), nil
+}
+
+// IDString formats an ID from a decoded ConvertKit response. IDs decode
+// as float64, which fmt would print in exponent form.
+func IDString(v interface{}) string {
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// FindSubscriber returns the subscriber with the email address, or nil
+// when there is none.
+func FindSubscriber(apiSecret, email string) (map[string]interface{}, error) {
+	query := url.Values{"api_secret": {apiSecret}, "email_address": {email}}
+	response, err := GetConvertKitClient("/subscribers?"+query.Encode(), http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	subscribers, _ := asMap(response)["subscribers"].([]interface{})
+	if len(subscribers) == 0 {
+		return nil, nil
+	}
+	return asMap(subscribers[0]), nil
+}
+
+// GetSubscriber returns the subscriber with the ID.
+func GetSubscriber(apiSecret, id string) (map[string]interface{}, error) {
+	response, err := GetConvertKitClient("/subscribers/"+url.PathEscape(id)+"?api_secret="+url.QueryEscape(apiSecret), http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	return asMap(asMap(response)["subscriber"]), nil
+}
+
+// UpdateSubscriber sets the first name and custom fields of a
+// subscriber, and returns the subscriber.
+func UpdateSubscriber(apiSecret, id, firstName string, fields map[string]interface{}) (map[string]interface{}, error) {
+	payload := map[string]interface{}{"api_secret": apiSecret}
+	if firstName != "" {
+		payload["first_name"] = firstName
+	}
+	if len(fields) > 0 {
+		payload["fields"] = fields
+	}
+	response, err := sendJSON("/subscribers/"+url.PathEscape(id), http.MethodPut, payload)
+	if err != nil {
+		return nil, err
+	}
+	return asMap(response["subscriber"]), nil
+}
+
+// Subscribe adds a subscriber through a form, or through the first tag
+// when no form is given, with the remaining tags. It returns the
+// subscriber's ID.
+func Subscribe(apiSecret, formID, email, firstName string, fields map[string]interface{}, tagIDs []string) (string, error) {
+	path := ""
+	switch {
+	case formID != "":
+		path = "/forms/" + url.PathEscape(formID) + "/subscribe"
+	case len(tagIDs) > 0:
+		path = "/tags/" + url.PathEscape(tagIDs[0]) + "/subscribe"
+		tagIDs = tagIDs[1:]
+	default:
+		return "", errors.New("new ConvertKit subscribers are added through a form or a tag; choose a form or give a tag")
+	}
+
+	subscription, err := subscribeTo(path, apiSecret, email, firstName, fields, tagIDs)
+	if err != nil {
+		return "", err
+	}
+	subscriber := asMap(subscription["subscriber"])
+	if subscriber == nil {
+		return "", errors.New("no subscriber in the ConvertKit response")
+	}
+	return IDString(subscriber["id"]), nil
+}
+
+// subscribeTo subscribes an email address at a subscribe endpoint of a
+// form, tag or sequence, and returns the subscription.
+func subscribeTo(path, apiSecret, email, firstName string, fields map[string]interface{}, tagIDs []string) (map[string]interface{}, error) {
+	payload := map[string]interface{}{
+		"api_secret": apiSecret,
+		"email":      email,
+	}
+	if firstName != "" {
+		payload["first_name"] = firstName
+	}
+	if len(fields) > 0 {
+		payload["fields"] = fields
+	}
+	if len(tagIDs) > 0 {
+		payload["tags"] = tagIDs
+	}
+
+	response, err := sendJSON(path, http.MethodPost, payload)
+	if err != nil {
+		return nil, err
+	}
+	return asMap(response["subscription"]), nil
+}
+
+// TagSubscriber adds tags to a subscriber by email.
+func TagSubscriber(apiSecret, email string, tagIDs []string) error {
+	for _, tagID := range tagIDs {
+		_, err := sendJSON("/tags/"+url.PathEscape(tagID)+"/subscribe", http.MethodPost, map[string]interface{}{
+			"api_secret": apiSecret,
+			"email":      email,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TagIDs returns the IDs of the tags with the names, creating the tags
+// ConvertKit doesn't have yet.
+func TagIDs(apiKey, apiSecret string, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	response, err := GetConvertKitClient("/tags?api_key="+url.QueryEscape(apiKey), http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	known := map[string]string{}
+	tags, _ := asMap(response)["tags"].([]interface{})
+	for _, t := range tags {
+		tag := asMap(t)
+		known[strings.ToLower(fmt.Sprint(tag["name"]))] = IDString(tag["id"])
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if id, ok := known[strings.ToLower(name)]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		tag, err := sendJSON("/tags", http.MethodPost, map[string]interface{}{
+			"api_secret": apiSecret,
+			"tag":        map[string]string{"name": name},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating tag %q: %w", name, err)
+		}
+		ids = append(ids, IDString(tag["id"]))
+	}
+	return ids, nil
+}
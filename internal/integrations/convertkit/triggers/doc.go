@@ -7,3 +7,6 @@ var subscriberCreatedDocs string
 
 //go:embed tags_created.md
 var TagCreatedDocs string
+
+//go:embed subscriber_event.md
+var subscriberEventDocs string
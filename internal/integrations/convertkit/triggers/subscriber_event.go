@@ -0,0 +1,179 @@
+package triggers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/convertkit/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+const hookRuleIDKey = "hookRuleId"
+
+type subscriberEventTriggerProps struct {
+	Event string `json:"event"`
+	TagID string `json:"tag_id"`
+}
+
+// SubscriberEventTrigger registers a ConvertKit webhook rule for one
+// subscriber event while the trigger is active.
+type SubscriberEventTrigger struct{}
+
+func (t *SubscriberEventTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "subscriber_event",
+		DisplayName:   "Subscriber Event (Instant)",
+		Description:   "Triggers a workflow instantly when a ConvertKit subscriber subscribes, unsubscribes, bounces, complains or has a tag removed.",
+		Type:          sdkcore.TriggerTypeWebhook,
+		Documentation: subscriberEventDocs,
+		Icon:          "mdi:account-sync",
+		SampleOutput: []map[string]any{
+			{
+				"event":         "unsubscribed",
+				"subscriber_id": "1143012",
+				"email":         "jane@example.com",
+				"first_name":    "Jane",
+				"state":         "cancelled",
+				"subscriber": map[string]any{
+					"id":            1143012,
+					"first_name":    "Jane",
+					"email_address": "jane@example.com",
+					"state":         "cancelled",
+					"created_at":    "2025-01-15T10:30:00.000Z",
+					"fields":        map[string]any{"plan": "Pro"},
+				},
+			},
+		},
+	}
+}
+
+func (t *SubscriberEventTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *SubscriberEventTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypeWebhook
+}
+
+func (t *SubscriberEventTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("subscriber_event", "Subscriber Event (Instant)")
+
+	form.SelectField("event", "Event").
+		Required(true).
+		DefaultValue(shared.HookUnsubscribed).
+		AddOptions(
+			&smartform.Option{Value: shared.HookSubscribed, Label: "Subscribed"},
+			&smartform.Option{Value: shared.HookUnsubscribed, Label: "Unsubscribed"},
+			&smartform.Option{Value: shared.HookBounced, Label: "Bounced"},
+			&smartform.Option{Value: shared.HookComplained, Label: "Marked as Spam"},
+			&smartform.Option{Value: shared.HookTagRemoved, Label: "Tag Removed"},
+		).
+		HelpText("The subscriber event to trigger on")
+
+	shared.RegisterTagsProps(form).
+		Required(false).
+		HelpText("The tag whose removal triggers the workflow. Only used for Tag Removed.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start creates the webhook rule for the trigger's URL.
+func (t *SubscriberEventTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	_, apiSecret, err := shared.CredentialsFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+
+	criteria, err := ctx.TriggerCriteria()
+	if err != nil {
+		return err
+	}
+	if criteria == nil || criteria.Webhook == nil || criteria.Webhook.Endpoint == "" {
+		return errors.New("the trigger has no webhook endpoint")
+	}
+
+	event, _ := ctx.Input()["event"].(string)
+	tagID, _ := ctx.Input()["tag_id"].(string)
+	ruleID, err := shared.CreateHook(apiSecret, criteria.Webhook.Endpoint, event, tagID)
+	if err != nil {
+		return fmt.Errorf("failed to register the ConvertKit webhook: %w", err)
+	}
+
+	return ctx.StoreMetadata(hookRuleIDKey, ruleID)
+}
+
+// Stop removes the webhook rule created by Start.
+func (t *SubscriberEventTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	stored, err := ctx.GetMetadata(hookRuleIDKey)
+	if err != nil || stored == nil {
+		return err
+	}
+	ruleID, ok := stored.(string)
+	if !ok {
+		return fmt.Errorf("invalid stored webhook rule id %v", stored)
+	}
+
+	_, apiSecret, err := shared.CredentialsFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+	return shared.DeleteHook(apiSecret, ruleID)
+}
+
+// Execute confirms the delivered event with ConvertKit before
+// triggering, since deliveries aren't signed.
+func (t *SubscriberEventTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[subscriberEventTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delivered, err := shared.HookSubscriber(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+	subscriberID := shared.IDString(delivered["id"])
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber, confirmed, err := shared.ConfirmHook(authCtx.Extra["api-key"], authCtx.Extra["api-secret"], input.Event, input.TagID, subscriberID)
+	if err != nil {
+		return nil, err
+	}
+	if !confirmed {
+		return []map[string]interface{}{}, nil
+	}
+
+	result := map[string]interface{}{
+		"event":         input.Event,
+		"subscriber_id": subscriberID,
+		"email":         subscriber["email_address"],
+		"first_name":    subscriber["first_name"],
+		"state":         subscriber["state"],
+		"subscriber":    subscriber,
+	}
+	if input.Event == shared.HookTagRemoved {
+		result["tag_id"] = input.TagID
+	}
+	return []map[string]interface{}{result}, nil
+}
+
+func (t *SubscriberEventTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	webhook := sdkcore.NewWebhookTriggerCriteria()
+	webhook.Enabled = true
+	webhook.ContentType = "application/json"
+	return sdkcore.TriggerCriteria{Webhook: webhook}
+}
+
+func NewSubscriberEventTrigger() sdk.Trigger {
+	return &SubscriberEventTrigger{}
+}
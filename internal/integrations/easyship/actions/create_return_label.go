@@ -0,0 +1,50 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type CreateReturnLabelAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateReturnLabelAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_return_label",
+		DisplayName:   "Create Return Label",
+		Description:   "Buys a label for a parcel sent back by the customer, picking the courier the same way as Create Label.",
+		Type:          core.ActionTypeAction,
+		Documentation: createReturnLabelDocs,
+		SampleOutput:  easyshipLabelSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateReturnLabelAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_return_label", "Create Return Label")
+
+	shipmentForm(form)
+	shipping.RegisterRateProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateReturnLabelAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateReturnLabelAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	return buyLabel(ctx, true)
+}
+
+func NewCreateReturnLabelAction() sdk.Action {
+	return &CreateReturnLabelAction{}
+}
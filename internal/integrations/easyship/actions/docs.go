@@ -23,3 +23,15 @@ var createCourierDocs string
 
 //go:embed create_new_label.md
 var newLabelDocs string
+
+//go:embed get_rates.md
+var getRatesDocs string
+
+//go:embed create_label.md
+var createLabelDocs string
+
+//go:embed create_return_label.md
+var createReturnLabelDocs string
+
+//go:embed validate_address.md
+var validateAddressDocs string
@@ -0,0 +1,70 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/easyship/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type GetRatesAction struct{}
+
+// Metadata returns metadata about the action
+func (a *GetRatesAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_rates",
+		DisplayName:   "Get Shipping Rates",
+		Description:   "Quotes a shipment with every courier of the account, cheapest first.",
+		Type:          core.ActionTypeAction,
+		Documentation: getRatesDocs,
+		SampleOutput: map[string]any{
+			"count":    1,
+			"rates":    []map[string]any{easyshipRateSample},
+			"cheapest": easyshipRateSample,
+			"fastest":  easyshipRateSample,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *GetRatesAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_rates", "Get Shipping Rates")
+
+	shipmentForm(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *GetRatesAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *GetRatesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := readShipmentInput(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := shared.GetRates(apiKey, in.from, in.to, in.parcel, in.contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return shipping.RateSummary(rates), nil
+}
+
+func NewGetRatesAction() sdk.Action {
+	return &GetRatesAction{}
+}
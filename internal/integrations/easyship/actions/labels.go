@@ -0,0 +1,89 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/easyship/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+var easyshipRateSample = map[string]any{
+	"provider":       "easyship",
+	"rate_id":        "01563646-58c1-4607-8fe0-cae3e33c0001",
+	"carrier":        "USPS",
+	"carrier_id":     "01563646-58c1-4607-8fe0-cae3e33c0001",
+	"service":        "USPS - Priority Mail",
+	"service_code":   "01563646-58c1-4607-8fe0-cae3e33c0001",
+	"amount":         9.45,
+	"currency":       "USD",
+	"estimated_days": 3,
+}
+
+var easyshipLabelSample = map[string]any{
+	"provider":        "easyship",
+	"label_id":        "ESUS10000001",
+	"shipment_id":     "ESUS10000001",
+	"status":          "generated",
+	"tracking_number": "9400100000000000000000",
+	"tracking_url":    "https://www.trackmyshipment.co/shipment-tracking/ESUS10000001",
+	"label_url":       "https://easyship-production.s3.amazonaws.com/labels/ESUS10000001.pdf",
+	"carrier":         "USPS",
+	"service":         "USPS - Priority Mail",
+	"is_return":       false,
+	"rate":            easyshipRateSample,
+}
+
+// shipmentForm adds the address, parcel and contents fields shared by
+// the rate and label actions.
+func shipmentForm(form *smartform.FormBuilder) {
+	shipping.RegisterAddressProps(form, "from", "Ship From")
+	shipping.RegisterAddressProps(form, "to", "Ship To")
+	shipping.RegisterParcelProps(form)
+	shipping.RegisterContentsProps(form)
+}
+
+type shipmentInput struct {
+	from     shipping.Address
+	to       shipping.Address
+	parcel   shipping.Parcel
+	contents shipping.Contents
+}
+
+func readShipmentInput(ctx sdkcontext.PerformContext) (*shipmentInput, error) {
+	input := ctx.Input()
+	parcel, err := shipping.ParcelFrom(input)
+	if err != nil {
+		return nil, err
+	}
+	return &shipmentInput{
+		from:     shipping.AddressFrom(input, "from"),
+		to:       shipping.AddressFrom(input, "to"),
+		parcel:   parcel,
+		contents: shipping.ContentsFrom(input),
+	}, nil
+}
+
+// buyLabel quotes the shipment, picks a rate and buys its label.
+func buyLabel(ctx sdkcontext.PerformContext, isReturn bool) (core.JSON, error) {
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := readShipmentInput(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := shared.GetRates(apiKey, in.from, in.to, in.parcel, in.contents)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := shipping.PickRate(ctx.Input(), rates)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.CreateLabel(apiKey, in.from, in.to, in.parcel, in.contents, rate, isReturn)
+}
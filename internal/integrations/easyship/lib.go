@@ -19,6 +19,7 @@ import (
 
 	"github.com/wakflo/extensions/internal/integrations/easyship/actions"
 	"github.com/wakflo/extensions/internal/integrations/easyship/shared"
+	"github.com/wakflo/extensions/internal/integrations/easyship/triggers"
 	"github.com/wakflo/go-sdk/v2"
 	"github.com/wakflo/go-sdk/v2/core"
 )
@@ -45,13 +46,19 @@ func (n *EasyShip) Auth() *core.AuthMetadata {
 }
 
 func (n *EasyShip) Triggers() []sdk.Trigger {
-	return []sdk.Trigger{}
+	return []sdk.Trigger{
+		triggers.NewTrackingStatusChangedTrigger(),
+	}
 }
 
 func (n *EasyShip) Actions() []sdk.Action {
 	return []sdk.Action{
 		actions.NewCreateNewLabelAction(),
 		actions.NewCreateCourierPickupAction(),
+		actions.NewGetRatesAction(),
+		actions.NewCreateLabelAction(),
+		actions.NewCreateReturnLabelAction(),
+		actions.NewValidateAddressAction(),
 	}
 }
 
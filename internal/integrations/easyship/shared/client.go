@@ -0,0 +1,88 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// GetAPIKey returns the Easyship API key of the connection.
+func GetAPIKey(ctx sdkcontext.BaseContext) (string, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return "", err
+	}
+
+	apiKey := authCtx.Extra["api-key"]
+	if apiKey == "" {
+		return "", errors.New("missing easyship api key")
+	}
+	return apiKey, nil
+}
+
+// Request calls the Easyship API and decodes the JSON response into out.
+// Error responses are returned as errors carrying Easyship's message.
+func Request(apiKey, method, endpoint string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, baseURL+endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("easyship API error (status %d): %s", resp.StatusCode, errorMessage(data))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON: %v", err)
+	}
+	return nil
+}
+
+// errorMessage reads the error object of an Easyship error response.
+func errorMessage(body []byte) string {
+	var resp struct {
+		Error struct {
+			Message string   `json:"message"`
+			Details []string `json:"details"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &resp) == nil && resp.Error.Message != "" {
+		if len(resp.Error.Details) > 0 {
+			return resp.Error.Message + ": " + strings.Join(resp.Error.Details, "; ")
+		}
+		return resp.Error.Message
+	}
+	return string(body)
+}
@@ -0,0 +1,298 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wakflo/extensions/internal/shipping"
+)
+
+const (
+	provider   = "easyship"
+	apiVersion = "/2024-09"
+)
+
+func toAddress(a shipping.Address) map[string]interface{} {
+	return map[string]interface{}{
+		"contact_name":   a.Name,
+		"company_name":   a.Company,
+		"line_1":         a.Street1,
+		"line_2":         a.Street2,
+		"city":           a.City,
+		"state":          a.State,
+		"postal_code":    a.Zip,
+		"country_alpha2": a.Country,
+		"contact_phone":  a.Phone,
+		"contact_email":  a.Email,
+	}
+}
+
+// shipmentBody builds the shared part of rate and shipment requests.
+// Parcels are sent in centimeters and kilograms.
+func shipmentBody(from, to shipping.Address, parcel shipping.Parcel, contents shipping.Contents) (map[string]interface{}, error) {
+	if err := from.Validate(); err != nil {
+		return nil, fmt.Errorf("ship from: %w", err)
+	}
+	if err := to.Validate(); err != nil {
+		return nil, fmt.Errorf("ship to: %w", err)
+	}
+	metric, err := parcel.In("cm", "kg")
+	if err != nil {
+		return nil, err
+	}
+
+	item := map[string]interface{}{
+		"description":            contents.Description,
+		"quantity":               1,
+		"actual_weight":          metric.Weight,
+		"declared_currency":      contents.Currency,
+		"declared_customs_value": contents.Value,
+	}
+	if contents.HSCode != "" {
+		item["hs_code"] = contents.HSCode
+	}
+
+	return map[string]interface{}{
+		"origin_address":      toAddress(from),
+		"destination_address": toAddress(to),
+		"incoterms":           "DDU",
+		"insurance":           map[string]interface{}{"is_insured": false},
+		"shipping_settings": map[string]interface{}{
+			"units": map[string]interface{}{"weight": "kg", "dimensions": "cm"},
+		},
+		"parcels": []interface{}{map[string]interface{}{
+			"box": map[string]interface{}{
+				"length": metric.Length,
+				"width":  metric.Width,
+				"height": metric.Height,
+			},
+			"total_actual_weight": metric.Weight,
+			"items":               []interface{}{item},
+		}},
+	}, nil
+}
+
+type courierService struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	UmbrellaName string `json:"umbrella_name"`
+}
+
+// Rate is a rate quoted by Easyship.
+type Rate struct {
+	CourierService  courierService `json:"courier_service"`
+	TotalCharge     float64        `json:"total_charge"`
+	Currency        string         `json:"currency"`
+	MinDeliveryTime int            `json:"min_delivery_time"`
+	MaxDeliveryTime int            `json:"max_delivery_time"`
+}
+
+// Normalize returns the rate in the shipping package shape. The
+// estimate is the slowest of Easyship's delivery window.
+func (r Rate) Normalize() shipping.Rate {
+	carrier := r.CourierService.UmbrellaName
+	if carrier == "" {
+		carrier = r.CourierService.Name
+	}
+	return shipping.Rate{
+		Provider:      provider,
+		ID:            r.CourierService.ID,
+		Carrier:       carrier,
+		CarrierID:     r.CourierService.ID,
+		Service:       r.CourierService.Name,
+		ServiceCode:   r.CourierService.ID,
+		Amount:        r.TotalCharge,
+		Currency:      r.Currency,
+		EstimatedDays: max(r.MinDeliveryTime, r.MaxDeliveryTime),
+	}
+}
+
+// GetRates quotes a shipment with every courier of the account.
+func GetRates(apiKey string, from, to shipping.Address, parcel shipping.Parcel, contents shipping.Contents) ([]shipping.Rate, error) {
+	body, err := shipmentBody(from, to, parcel, contents)
+	if err != nil {
+		return nil, err
+	}
+	body["courier_settings"] = map[string]interface{}{"apply_shipping_rules": true}
+
+	var resp struct {
+		Rates []Rate `json:"rates"`
+	}
+	if err := Request(apiKey, http.MethodPost, apiVersion+"/rates", body, &resp); err != nil {
+		return nil, err
+	}
+
+	rates := make([]shipping.Rate, 0, len(resp.Rates))
+	for _, r := range resp.Rates {
+		rates = append(rates, r.Normalize())
+	}
+	return rates, nil
+}
+
+type shipment struct {
+	EasyshipShipmentID string         `json:"easyship_shipment_id"`
+	LabelState         string         `json:"label_state"`
+	DeliveryState      string         `json:"delivery_state"`
+	ShipmentState      string         `json:"shipment_state"`
+	TrackingPageURL    string         `json:"tracking_page_url"`
+	UpdatedAt          string         `json:"updated_at"`
+	CourierService     courierService `json:"courier_service"`
+	Trackings          []struct {
+		TrackingNumber string `json:"tracking_number"`
+	} `json:"trackings"`
+	ShippingDocuments []struct {
+		Category string `json:"category"`
+		URL      string `json:"url"`
+	} `json:"shipping_documents"`
+}
+
+func (s shipment) trackingNumber() string {
+	for _, t := range s.Trackings {
+		if t.TrackingNumber != "" {
+			return t.TrackingNumber
+		}
+	}
+	return ""
+}
+
+// CreateLabel creates a shipment with the courier of the rate and buys
+// its label. Easyship has no return flag, so a return label is a
+// shipment from the customer back to the merchant.
+func CreateLabel(apiKey string, from, to shipping.Address, parcel shipping.Parcel, contents shipping.Contents, rate *shipping.Rate, isReturn bool) (*shipping.Label, error) {
+	body, err := shipmentBody(from, to, parcel, contents)
+	if err != nil {
+		return nil, err
+	}
+	body["courier_settings"] = map[string]interface{}{
+		"courier_service_id": rate.ID,
+		"allow_fallback":     false,
+	}
+	settings := body["shipping_settings"].(map[string]interface{})
+	settings["buy_label"] = true
+	settings["buy_label_synchronous"] = true
+	settings["printing_options"] = map[string]interface{}{"format": "pdf", "label": "4x6"}
+
+	var resp struct {
+		Shipment shipment `json:"shipment"`
+	}
+	if err := Request(apiKey, http.MethodPost, apiVersion+"/shipments", body, &resp); err != nil {
+		return nil, err
+	}
+
+	s := resp.Shipment
+	label := &shipping.Label{
+		Provider:       provider,
+		ID:             s.EasyshipShipmentID,
+		ShipmentID:     s.EasyshipShipmentID,
+		Status:         s.LabelState,
+		TrackingNumber: s.trackingNumber(),
+		TrackingURL:    s.TrackingPageURL,
+		Carrier:        rate.Carrier,
+		Service:        rate.Service,
+		IsReturn:       isReturn,
+		Rate:           rate,
+	}
+	for _, doc := range s.ShippingDocuments {
+		if doc.Category == "label" && doc.URL != "" {
+			label.LabelURL = doc.URL
+		}
+	}
+	return label, nil
+}
+
+// ValidateAddress asks Easyship to check an address.
+func ValidateAddress(apiKey string, a shipping.Address) (*shipping.AddressValidation, error) {
+	var resp struct {
+		Address struct {
+			Line1         string `json:"line_1"`
+			Line2         string `json:"line_2"`
+			City          string `json:"city"`
+			State         string `json:"state"`
+			PostalCode    string `json:"postal_code"`
+			CountryAlpha2 string `json:"country_alpha2"`
+		} `json:"address"`
+		Validation struct {
+			Status   string   `json:"status"`
+			Messages []string `json:"messages"`
+		} `json:"validation"`
+	}
+	if err := Request(apiKey, http.MethodPost, apiVersion+"/addresses/validations", map[string]interface{}{"address": toAddress(a)}, &resp); err != nil {
+		return nil, err
+	}
+
+	normalized := a
+	if resp.Address.Line1 != "" {
+		normalized.Street1 = resp.Address.Line1
+		normalized.Street2 = resp.Address.Line2
+		normalized.City = resp.Address.City
+		normalized.State = resp.Address.State
+		normalized.Zip = resp.Address.PostalCode
+		normalized.Country = resp.Address.CountryAlpha2
+	}
+
+	status := strings.ToLower(resp.Validation.Status)
+	return &shipping.AddressValidation{
+		Provider: provider,
+		Valid:    status == "valid" || status == "verified",
+		Address:  normalized,
+		Messages: resp.Validation.Messages,
+	}, nil
+}
+
+// maxShipmentPages bounds the pages read by one trigger run.
+const maxShipmentPages = 20
+
+// UpdatedShipments returns the tracking of the shipments updated since
+// the given time.
+func UpdatedShipments(apiKey string, since time.Time) ([]shipping.Tracking, error) {
+	var trackings []shipping.Tracking
+	for page := 1; page <= maxShipmentPages; page++ {
+		params := url.Values{}
+		params.Set("per_page", "100")
+		params.Set("page", strconv.Itoa(page))
+		if !since.IsZero() {
+			params.Set("updated_at_from", since.UTC().Format(time.RFC3339))
+		}
+
+		var resp struct {
+			Shipments []shipment `json:"shipments"`
+			Meta      struct {
+				Pagination struct {
+					Next *int `json:"next"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+		if err := Request(apiKey, http.MethodGet, apiVersion+"/shipments?"+params.Encode(), nil, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, s := range resp.Shipments {
+			raw := s.DeliveryState
+			if raw == "" {
+				raw = s.ShipmentState
+			}
+			carrier := s.CourierService.UmbrellaName
+			if carrier == "" {
+				carrier = s.CourierService.Name
+			}
+			trackings = append(trackings, shipping.Tracking{
+				Provider:       provider,
+				ID:             s.EasyshipShipmentID,
+				TrackingNumber: s.trackingNumber(),
+				Carrier:        carrier,
+				Status:         shipping.NormalizeStatus(raw),
+				RawStatus:      raw,
+				UpdatedAt:      s.UpdatedAt,
+			})
+		}
+
+		if resp.Meta.Pagination.Next == nil || len(resp.Shipments) == 0 {
+			break
+		}
+	}
+	return trackings, nil
+}
@@ -0,0 +1,109 @@
+package triggers
+
+import (
+	"context"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/easyship/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type trackingStatusChangedTriggerProps struct {
+	UpdatedTime *time.Time `json:"updatedTime"`
+}
+
+type TrackingStatusChangedTrigger struct{}
+
+func (t *TrackingStatusChangedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "tracking_status_changed",
+		DisplayName:   "Tracking Status Changed",
+		Description:   "Triggers workflow when the normalized delivery status of an Easyship shipment changes",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: trackingStatusChangedDocs,
+		SampleOutput:  []map[string]any{trackingStatusChangedSample},
+	}
+}
+
+func (t *TrackingStatusChangedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *TrackingStatusChangedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *TrackingStatusChangedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("easyship-tracking-status-changed", "Tracking Status Changed")
+
+	shipping.RegisterStatusFilterProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the TrackingStatusChangedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *TrackingStatusChangedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the TrackingStatusChangedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *TrackingStatusChangedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the shipments updated since the last run whose
+// delivery status changed.
+func (t *TrackingStatusChangedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[trackingStatusChangedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Time{}
+	if input.UpdatedTime != nil {
+		since = *input.UpdatedTime
+	} else if lr := ctx.LastRun(); lr != nil {
+		since = *lr
+	}
+
+	trackings, err := shared.UpdatedShipments(apiKey, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return shipping.TrackingChanges(ctx, trackings), nil
+}
+
+func (t *TrackingStatusChangedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *TrackingStatusChangedTrigger) SampleData() sdkcore.JSON {
+	return trackingStatusChangedSample
+}
+
+var trackingStatusChangedSample = map[string]any{
+	"provider":        "easyship",
+	"id":              "ESUS10000001",
+	"tracking_number": "9400100000000000000000",
+	"carrier":         "USPS",
+	"status":          "in_transit",
+	"raw_status":      "in_transit_to_customer",
+	"previous_status": "pending",
+	"updated_at":      "2025-03-12T09:30:00Z",
+}
+
+func NewTrackingStatusChangedTrigger() sdk.Trigger {
+	return &TrackingStatusChangedTrigger{}
+}
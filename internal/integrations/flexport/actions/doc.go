@@ -11,7 +11,7 @@ var getOrderDocs string
 //go:embed get_order_by_external_id.md
 var getOrderByExternalIDDocs string
 
-//go:embed get_shipment.go
+//go:embed get_shipment.md
 var getShipmentDocs string
 
 //go:embed get_all_products.md
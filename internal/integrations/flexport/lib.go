@@ -4,6 +4,8 @@ import (
 	_ "embed"
 
 	"github.com/wakflo/extensions/internal/integrations/flexport/actions"
+	"github.com/wakflo/extensions/internal/integrations/flexport/shared"
+	"github.com/wakflo/extensions/internal/integrations/flexport/triggers"
 
 	"github.com/wakflo/go-sdk/v2"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -25,12 +27,15 @@ func (n *Flexport) Metadata() sdk.IntegrationMetadata {
 
 func (n *Flexport) Auth() *core.AuthMetadata {
 	return &core.AuthMetadata{
-		Required: false,
+		Required: true,
+		Schema:   shared.FlexportSharedAuth,
 	}
 }
 
 func (n *Flexport) Triggers() []sdk.Trigger {
-	return []sdk.Trigger{}
+	return []sdk.Trigger{
+		triggers.NewTrackingStatusChangedTrigger(),
+	}
 }
 
 func (n *Flexport) Actions() []sdk.Action {
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 
@@ -31,7 +32,7 @@ var (
 		Required(true).
 		HelpText("The api key used to authenticate flexport.")
 
-	WooSharedAuth = form.Build()
+	FlexportSharedAuth = form.Build()
 )
 
 const baseURL = "https://logistics-api.flexport.com/logistics"
@@ -59,6 +60,10 @@ func FlexportRequest(accessToken, reqURL, method string, request []byte) (interf
 		return nil, err
 	}
 
+	if res.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("flexport API error (status %d): %s", res.StatusCode, body)
+	}
+
 	var response interface{}
 	if newErrs := json.Unmarshal(body, &response); newErrs != nil {
 		return nil, errors.New("error parsing response")
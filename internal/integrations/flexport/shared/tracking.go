@@ -0,0 +1,65 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/wakflo/extensions/internal/shipping"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// GetAPIKey returns the Flexport API token of the connection.
+func GetAPIKey(ctx sdkcontext.BaseContext) (string, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return "", err
+	}
+
+	apiKey := authCtx.Extra["api-key"]
+	if apiKey == "" {
+		return "", errors.New("missing flexport api token")
+	}
+	return apiKey, nil
+}
+
+// OrderTracking returns the status of an outbound order as a tracking.
+// The tracking number and carrier are those of the order's first
+// shipment, when it has shipped.
+func OrderTracking(apiKey, orderID string) (*shipping.Tracking, error) {
+	resp, err := FlexportRequest(apiKey, "/api/2024-07/orders/"+url.PathEscape(orderID), http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	order, ok := resp.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response for order %s", orderID)
+	}
+	if data, ok := order["data"].(map[string]interface{}); ok {
+		order = data
+	}
+
+	raw := str(order["status"])
+	t := &shipping.Tracking{
+		Provider:  "flexport",
+		ID:        orderID,
+		Status:    shipping.NormalizeStatus(raw),
+		RawStatus: raw,
+		UpdatedAt: str(order["updatedAt"]),
+	}
+	if shipments, ok := order["shipments"].([]interface{}); ok && len(shipments) > 0 {
+		if first, ok := shipments[0].(map[string]interface{}); ok {
+			t.TrackingNumber = str(first["trackingNumber"])
+			t.Carrier = str(first["carrier"])
+			t.EstimatedDelivery = str(first["estimatedDeliveryDate"])
+		}
+	}
+	return t, nil
+}
+
+func str(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
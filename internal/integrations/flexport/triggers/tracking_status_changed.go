@@ -0,0 +1,115 @@
+package triggers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/flexport/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type trackingStatusChangedTriggerProps struct {
+	OrderIDs string `json:"order_ids"`
+}
+
+type TrackingStatusChangedTrigger struct{}
+
+func (t *TrackingStatusChangedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "tracking_status_changed",
+		DisplayName:   "Tracking Status Changed",
+		Description:   "Triggers workflow when the normalized status of a watched Flexport order changes",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: trackingStatusChangedDocs,
+		SampleOutput:  []map[string]any{trackingStatusChangedSample},
+	}
+}
+
+func (t *TrackingStatusChangedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *TrackingStatusChangedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *TrackingStatusChangedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("flexport-tracking-status-changed", "Tracking Status Changed")
+
+	form.TextareaField("order_ids", "Order IDs").
+		Required(true).
+		HelpText("The Flexport order IDs to watch, one per line or separated by commas.")
+
+	shipping.RegisterStatusFilterProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the TrackingStatusChangedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *TrackingStatusChangedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the TrackingStatusChangedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *TrackingStatusChangedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the watched orders whose status changed since the last
+// run.
+func (t *TrackingStatusChangedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[trackingStatusChangedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := shipping.SplitList(input.OrderIDs)
+	if len(ids) == 0 {
+		return nil, errors.New("at least one order ID is required")
+	}
+
+	trackings := make([]shipping.Tracking, 0, len(ids))
+	for _, id := range ids {
+		tracking, err := shared.OrderTracking(apiKey, id)
+		if err != nil {
+			return nil, err
+		}
+		trackings = append(trackings, *tracking)
+	}
+
+	return shipping.TrackingChanges(ctx, trackings), nil
+}
+
+func (t *TrackingStatusChangedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *TrackingStatusChangedTrigger) SampleData() sdkcore.JSON {
+	return trackingStatusChangedSample
+}
+
+var trackingStatusChangedSample = map[string]any{
+	"provider":        "flexport",
+	"id":              "123456789",
+	"tracking_number": "1Z999AA10123456784",
+	"carrier":         "UPS",
+	"status":          "in_transit",
+	"raw_status":      "SHIPPED",
+	"previous_status": "pending",
+	"updated_at":      "2025-03-12T18:20:00Z",
+}
+
+func NewTrackingStatusChangedTrigger() sdk.Trigger {
+	return &TrackingStatusChangedTrigger{}
+}
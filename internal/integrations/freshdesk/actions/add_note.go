@@ -0,0 +1,132 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type addNoteActionProps struct {
+	TicketID     json.Number `json:"ticket_id"`
+	Body         string      `json:"body"`
+	Private      *bool       `json:"private"`
+	NotifyEmails string      `json:"notify_emails"`
+	Incoming     bool        `json:"incoming"`
+}
+
+type AddNoteAction struct{}
+
+// Metadata returns metadata about the action
+func (a *AddNoteAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "add_note",
+		DisplayName:   "Add Note",
+		Description:   "Add a private or public note to an existing ticket for internal communication or customer updates.",
+		Type:          core.ActionTypeAction,
+		Documentation: addNoteDocs,
+		Icon:          "mdi:note-plus-outline",
+		SampleOutput: map[string]any{
+			"id":            7002,
+			"ticket_id":     123,
+			"body":          "<div>Escalated to the billing team.</div>",
+			"body_text":     "Escalated to the billing team.",
+			"private":       true,
+			"incoming":      false,
+			"notify_emails": []string{"lead@acme.com"},
+			"attachments":   []any{},
+			"created_at":    "2023-12-01T12:30:45Z",
+			"user_id":       789,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *AddNoteAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("add_note", "Add Note")
+
+	form.NumberField("ticket_id", "Ticket ID").
+		Required(true).
+		HelpText("The ticket to add the note to")
+
+	form.TextareaField("body", "Body").
+		Required(true).
+		HelpText("The note, as HTML or text")
+
+	form.CheckboxField("private", "Private").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Private notes are only seen by agents. Public notes are shown to the requester in the portal.")
+
+	form.TextField("notify_emails", "Notify Agents").
+		Required(false).
+		HelpText("Comma-separated email addresses of agents to notify of the note")
+
+	form.CheckboxField("incoming", "Incoming").
+		Required(false).
+		HelpText("Show the note as coming from outside, such as a message the requester sent through another channel")
+
+	registerAttachmentsProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *AddNoteAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *AddNoteAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[addNoteActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	ticketID, err := freshworks.ParseID("ticket_id", input.TicketID)
+	if err != nil {
+		return nil, err
+	}
+	body := strings.TrimSpace(input.Body)
+	if body == "" {
+		return nil, errors.New("body is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{
+		"body":     body,
+		"private":  input.Private == nil || *input.Private,
+		"incoming": input.Incoming,
+	}
+	if emails := splitEmails(input.NotifyEmails); len(emails) > 0 {
+		fields["notify_emails"] = emails
+	}
+
+	attachments, err := email.LoadAttachments(ctx.Context(), ctx.Files(), ctx.Input()["attachments"])
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.Conversation(freshdeskDomain, apiKey, ticketID, "notes", fields, attachments)
+}
+
+func NewAddNoteAction() sdk.Action {
+	return &AddNoteAction{}
+}
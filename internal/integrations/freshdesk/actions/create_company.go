@@ -0,0 +1,154 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type companyActionProps struct {
+	CompanyID    json.Number `json:"company_id"`
+	Name         string      `json:"name"`
+	Domains      string      `json:"domains"`
+	Description  string      `json:"description"`
+	Note         string      `json:"note"`
+	Industry     string      `json:"industry"`
+	CustomFields string      `json:"custom_fields"`
+}
+
+// companySample is the sample output of the company actions.
+var companySample = map[string]any{
+	"id":            21,
+	"name":          "Acme Inc.",
+	"description":   "Wholesale customer",
+	"domains":       []string{"acme.com"},
+	"note":          "Renews in March",
+	"industry":      "Retail",
+	"custom_fields": map[string]any{"plan": "Gold"},
+	"created_at":    "2023-12-01T12:30:45Z",
+	"updated_at":    "2023-12-01T12:30:45Z",
+}
+
+type CreateCompanyAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateCompanyAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_company",
+		DisplayName:   "Create Company",
+		Description:   "Create a Freshdesk company. Contacts with an email on its domains are added to it.",
+		Type:          core.ActionTypeAction,
+		Documentation: createCompanyDocs,
+		Icon:          "mdi:domain-plus",
+		SampleOutput:  companySample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateCompanyAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_company", "Create Company")
+
+	form.TextField("name", "Name").
+		Required(true)
+
+	registerCompanyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateCompanyAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateCompanyAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[companyActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+
+	company, err := companyFrom(input)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshdeskDomain, apiKey, "companies", 0, company)
+}
+
+// registerCompanyProps adds the company fields shared by the create and
+// update actions.
+func registerCompanyProps(form *smartform.FormBuilder) {
+	form.TextField("domains", "Domains").
+		Required(false).
+		HelpText("Comma-separated email domains of the company, such as acme.com. On an update they replace the company's domains.")
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	form.TextareaField("note", "Note").
+		Required(false)
+
+	form.TextField("industry", "Industry").
+		Required(false)
+
+	form.TextareaField("custom_fields", "Custom Fields").
+		Required(false).
+		HelpText(`Custom fields by API name, one "field=value" per line or a JSON object.`)
+}
+
+// companyFrom builds the company of the input, leaving out the fields
+// that are empty so an update keeps them.
+func companyFrom(input *companyActionProps) (map[string]interface{}, error) {
+	company := map[string]interface{}{}
+	for key, value := range map[string]string{
+		"name":        input.Name,
+		"description": input.Description,
+		"note":        input.Note,
+		"industry":    input.Industry,
+	} {
+		if value = strings.TrimSpace(value); value != "" {
+			company[key] = value
+		}
+	}
+	if domains := crm.SplitTags(input.Domains); len(domains) > 0 {
+		company["domains"] = domains
+	}
+
+	fields, err := crm.ParseFields(input.CustomFields)
+	if err != nil {
+		return nil, fmt.Errorf("custom fields: %w", err)
+	}
+	if len(fields) > 0 {
+		company["custom_fields"] = fields
+	}
+	return company, nil
+}
+
+func NewCreateCompanyAction() sdk.Action {
+	return &CreateCompanyAction{}
+}
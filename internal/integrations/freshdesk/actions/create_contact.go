@@ -0,0 +1,188 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type contactActionProps struct {
+	ContactID    json.Number `json:"contact_id"`
+	Name         string      `json:"name"`
+	Email        string      `json:"email"`
+	Phone        string      `json:"phone"`
+	Mobile       string      `json:"mobile"`
+	CompanyID    json.Number `json:"company_id"`
+	JobTitle     string      `json:"job_title"`
+	Address      string      `json:"address"`
+	Description  string      `json:"description"`
+	Tags         string      `json:"tags"`
+	CustomFields string      `json:"custom_fields"`
+}
+
+// contactSample is the sample output of the contact actions.
+var contactSample = map[string]any{
+	"id":            432,
+	"name":          "Jane Doe",
+	"email":         "jane.doe@example.com",
+	"phone":         "+1234567890",
+	"mobile":        nil,
+	"company_id":    21,
+	"job_title":     "Office Manager",
+	"active":        false,
+	"tags":          []string{"vip"},
+	"custom_fields": map[string]any{"plan": "Gold"},
+	"created_at":    "2023-12-01T12:30:45Z",
+	"updated_at":    "2023-12-01T12:30:45Z",
+}
+
+type CreateContactAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_contact",
+		DisplayName:   "Create Contact",
+		Description:   "Create a Freshdesk contact, the requester of tickets.",
+		Type:          core.ActionTypeAction,
+		Documentation: createContactDocs,
+		Icon:          "mdi:account-plus-outline",
+		SampleOutput:  contactSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_contact", "Create Contact")
+
+	form.TextField("name", "Name").
+		Required(true)
+
+	registerContactProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateContactAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateContactAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[contactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+	if input.Email == "" && input.Phone == "" && input.Mobile == "" {
+		return nil, errors.New("an email, phone or mobile number is required")
+	}
+
+	contact, err := contactFrom(input)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshdeskDomain, apiKey, "contacts", 0, contact)
+}
+
+// registerContactProps adds the contact fields shared by the create and
+// update actions.
+func registerContactProps(form *smartform.FormBuilder) {
+	form.TextField("email", "Email").
+		Required(false).
+		HelpText("The primary email address. A new contact needs an email, phone or mobile number.")
+
+	form.TextField("phone", "Phone").
+		Required(false)
+
+	form.TextField("mobile", "Mobile").
+		Required(false)
+
+	form.NumberField("company_id", "Company ID").
+		Required(false).
+		HelpText("The ID of the company the contact belongs to")
+
+	form.TextField("job_title", "Job Title").
+		Required(false)
+
+	form.TextareaField("address", "Address").
+		Required(false)
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	form.TextField("tags", "Tags").
+		Required(false).
+		HelpText("Comma-separated tags. On an update they replace the contact's tags.")
+
+	form.TextareaField("custom_fields", "Custom Fields").
+		Required(false).
+		HelpText(`Custom fields by API name, one "field=value" per line or a JSON object.`)
+}
+
+// contactFrom builds the contact of the input, leaving out the fields
+// that are empty so an update keeps them.
+func contactFrom(input *contactActionProps) (map[string]interface{}, error) {
+	contact := map[string]interface{}{}
+	for key, value := range map[string]string{
+		"name":        input.Name,
+		"email":       input.Email,
+		"phone":       input.Phone,
+		"mobile":      input.Mobile,
+		"job_title":   input.JobTitle,
+		"address":     input.Address,
+		"description": input.Description,
+	} {
+		if value = strings.TrimSpace(value); value != "" {
+			contact[key] = value
+		}
+	}
+	if input.CompanyID != "" {
+		id, err := freshworks.ParseID("company_id", input.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+		contact["company_id"] = id
+	}
+	if tags := crm.SplitTags(input.Tags); len(tags) > 0 {
+		contact["tags"] = tags
+	}
+
+	fields, err := crm.ParseFields(input.CustomFields)
+	if err != nil {
+		return nil, fmt.Errorf("custom fields: %w", err)
+	}
+	if len(fields) > 0 {
+		contact["custom_fields"] = fields
+	}
+	return contact, nil
+}
+
+func NewCreateContactAction() sdk.Action {
+	return &CreateContactAction{}
+}
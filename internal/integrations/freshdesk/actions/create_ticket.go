@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -116,9 +117,10 @@ func (a *CreateTicketAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		return nil, err
 	}
 
-	domain := authCtx.Extra["domain"]
-	// freshdeskDomain := "https://" + domain + ".freshdesk.com"
-	freshdeskDomain := shared.BuildFreshdeskURL(domain)
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
 	ticketData := map[string]interface{}{
 		"description": input.Description,
@@ -147,7 +149,7 @@ func (a *CreateTicketAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		ticketData["cc_emails"] = []string{input.CCEmails}
 	}
 
-	response, err := shared.CreateTicket(freshdeskDomain, authCtx.Extra["api-key"], ticketData)
+	response, err := shared.CreateTicket(freshdeskDomain, apiKey, ticketData)
 	if err != nil {
 		return nil, fmt.Errorf("error creating ticket:  %v", err)
 	}
@@ -0,0 +1,80 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type DeleteCompanyAction struct{}
+
+// Metadata returns metadata about the action
+func (a *DeleteCompanyAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_company",
+		DisplayName:   "Delete Company",
+		Description:   "Delete a Freshdesk company. Its contacts are kept and unlinked from it.",
+		Type:          core.ActionTypeAction,
+		Documentation: deleteCompanyDocs,
+		Icon:          "mdi:domain-remove",
+		SampleOutput: map[string]any{
+			"company_id": 21,
+			"deleted":    true,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *DeleteCompanyAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_company", "Delete Company")
+
+	form.NumberField("company_id", "Company ID").
+		Required(true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *DeleteCompanyAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *DeleteCompanyAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getCompanyActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("company_id", input.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.DeleteRecord(freshdeskDomain, apiKey, "companies", id); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"company_id": id,
+		"deleted":    true,
+	}, nil
+}
+
+func NewDeleteCompanyAction() sdk.Action {
+	return &DeleteCompanyAction{}
+}
@@ -0,0 +1,80 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type DeleteContactAction struct{}
+
+// Metadata returns metadata about the action
+func (a *DeleteContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_contact",
+		DisplayName:   "Delete Contact",
+		Description:   "Delete a Freshdesk contact. Deleted contacts go to the trash, where they can be restored.",
+		Type:          core.ActionTypeAction,
+		Documentation: deleteContactDocs,
+		Icon:          "mdi:account-remove-outline",
+		SampleOutput: map[string]any{
+			"contact_id": 432,
+			"deleted":    true,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *DeleteContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_contact", "Delete Contact")
+
+	form.NumberField("contact_id", "Contact ID").
+		Required(true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *DeleteContactAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *DeleteContactAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getContactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("contact_id", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.DeleteRecord(freshdeskDomain, apiKey, "contacts", id); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"contact_id": id,
+		"deleted":    true,
+	}, nil
+}
+
+func NewDeleteContactAction() sdk.Action {
+	return &DeleteContactAction{}
+}
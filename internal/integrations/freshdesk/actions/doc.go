@@ -16,3 +16,36 @@ var searchTicketDocs string
 
 //go:embed list_tickets.md
 var listTicketsDocs string
+
+//go:embed reply_to_ticket.md
+var replyToTicketDocs string
+
+//go:embed add_note.md
+var addNoteDocs string
+
+//go:embed merge_tickets.md
+var mergeTicketsDocs string
+
+//go:embed create_contact.md
+var createContactDocs string
+
+//go:embed get_contact.md
+var getContactDocs string
+
+//go:embed update_contact.md
+var updateContactDocs string
+
+//go:embed delete_contact.md
+var deleteContactDocs string
+
+//go:embed create_company.md
+var createCompanyDocs string
+
+//go:embed get_company.md
+var getCompanyDocs string
+
+//go:embed update_company.md
+var updateCompanyDocs string
+
+//go:embed delete_company.md
+var deleteCompanyDocs string
@@ -0,0 +1,76 @@
+package actions
+
+import (
+	"encoding/json"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type getCompanyActionProps struct {
+	CompanyID json.Number `json:"company_id"`
+}
+
+type GetCompanyAction struct{}
+
+// Metadata returns metadata about the action
+func (a *GetCompanyAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_company",
+		DisplayName:   "Get Company",
+		Description:   "Retrieve a Freshdesk company by its ID.",
+		Type:          core.ActionTypeAction,
+		Documentation: getCompanyDocs,
+		Icon:          "mdi:domain",
+		SampleOutput:  companySample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *GetCompanyAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_company", "Get Company")
+
+	form.NumberField("company_id", "Company ID").
+		Required(true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *GetCompanyAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *GetCompanyAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getCompanyActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("company_id", input.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.GetRecord(freshdeskDomain, apiKey, "companies", id)
+}
+
+func NewGetCompanyAction() sdk.Action {
+	return &GetCompanyAction{}
+}
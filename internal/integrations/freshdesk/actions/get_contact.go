@@ -0,0 +1,76 @@
+package actions
+
+import (
+	"encoding/json"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type getContactActionProps struct {
+	ContactID json.Number `json:"contact_id"`
+}
+
+type GetContactAction struct{}
+
+// Metadata returns metadata about the action
+func (a *GetContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_contact",
+		DisplayName:   "Get Contact",
+		Description:   "Retrieve a Freshdesk contact by its ID.",
+		Type:          core.ActionTypeAction,
+		Documentation: getContactDocs,
+		Icon:          "mdi:account-outline",
+		SampleOutput:  contactSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *GetContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_contact", "Get Contact")
+
+	form.NumberField("contact_id", "Contact ID").
+		Required(true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *GetContactAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *GetContactAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getContactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("contact_id", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.GetRecord(freshdeskDomain, apiKey, "contacts", id)
+}
+
+func NewGetContactAction() sdk.Action {
+	return &GetContactAction{}
+}
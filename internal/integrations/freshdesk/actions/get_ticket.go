@@ -2,6 +2,7 @@ package actions
 
 import (
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -68,11 +69,12 @@ func (a *GetTicketAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, err
 		return nil, err
 	}
 
-	domain := authCtx.Extra["domain"]
-	// freshdeskDomain := "https://" + domain + ".freshdesk.com"
-	freshdeskDomain := shared.BuildFreshdeskURL(domain)
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
-	ticket, err := shared.GetTicket(freshdeskDomain, authCtx.Extra["api-key"], input.TicketID)
+	ticket, err := shared.GetTicket(freshdeskDomain, apiKey, input.TicketID)
 	if err != nil {
 		return nil, err
 	}
@@ -1,10 +1,10 @@
 package actions
 
 import (
-	"errors"
 	"strconv"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -79,10 +79,6 @@ func (a *ListTicketsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, e
 		return nil, err
 	}
 
-	if authCtx.Extra["api-key"] == "" {
-		return nil, errors.New("missing freshdesk api key")
-	}
-
 	endpoint := "/tickets"
 
 	queryParams := "?"
@@ -95,11 +91,12 @@ func (a *ListTicketsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, e
 		endpoint += queryParams[:len(queryParams)-1] // Remove trailing & or ?
 	}
 
-	domain := authCtx.Extra["domain"]
-	// freshdeskDomain := "https://" + domain + ".freshdesk.com"
-	freshdeskDomain := shared.BuildFreshdeskURL(domain)
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := shared.GetTickets(endpoint, freshdeskDomain, authCtx.Extra["api-key"])
+	response, err := shared.GetTickets(endpoint, freshdeskDomain, apiKey)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,120 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type mergeTicketsActionProps struct {
+	PrimaryTicketID       json.Number `json:"primary_ticket_id"`
+	TicketIDs             string      `json:"ticket_ids"`
+	PrimaryNote           string      `json:"primary_note"`
+	SecondaryNote         string      `json:"secondary_note"`
+	ConvertRecipientsToCC bool        `json:"convert_recipients_to_cc"`
+}
+
+type MergeTicketsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *MergeTicketsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "merge_tickets",
+		DisplayName:   "Merge Tickets",
+		Description:   "Merge duplicate tickets into a primary ticket, which keeps their conversations, and close them.",
+		Type:          core.ActionTypeAction,
+		Documentation: mergeTicketsDocs,
+		Icon:          "mdi:call-merge",
+		SampleOutput: map[string]any{
+			"primary_ticket_id": 123,
+			"merged_ticket_ids": []int64{124, 125},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *MergeTicketsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("merge_tickets", "Merge Tickets")
+
+	form.NumberField("primary_ticket_id", "Primary Ticket ID").
+		Required(true).
+		HelpText("The ticket the others are merged into")
+
+	form.TextField("ticket_ids", "Tickets to Merge").
+		Required(true).
+		HelpText("IDs of the tickets to merge into the primary ticket, separated by commas")
+
+	form.TextareaField("primary_note", "Note on Primary Ticket").
+		Required(false).
+		HelpText("A private note added to the primary ticket")
+
+	form.TextareaField("secondary_note", "Note on Merged Tickets").
+		Required(false).
+		HelpText("A public note added to each merged ticket, such as where the conversation continues")
+
+	form.CheckboxField("convert_recipients_to_cc", "Copy Requesters").
+		Required(false).
+		HelpText("Add the requesters of the merged tickets to the CC of the primary ticket")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *MergeTicketsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *MergeTicketsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[mergeTicketsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	primaryID, err := freshworks.ParseID("primary_ticket_id", input.PrimaryTicketID)
+	if err != nil {
+		return nil, err
+	}
+	ticketIDs, err := freshworks.ParseIDs(input.TicketIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ticketIDs {
+		if id == primaryID {
+			return nil, errors.New("the primary ticket can't be merged into itself")
+		}
+	}
+	if len(ticketIDs) == 0 {
+		return nil, errors.New("at least one ticket to merge is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := shared.MergeTickets(freshdeskDomain, apiKey, primaryID, ticketIDs, input.PrimaryNote, input.SecondaryNote, input.ConvertRecipientsToCC); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"primary_ticket_id": primaryID,
+		"merged_ticket_ids": ticketIDs,
+	}, nil
+}
+
+func NewMergeTicketsAction() sdk.Action {
+	return &MergeTicketsAction{}
+}
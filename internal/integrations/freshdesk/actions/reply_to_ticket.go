@@ -0,0 +1,180 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type replyToTicketActionProps struct {
+	TicketID         json.Number `json:"ticket_id"`
+	Body             string      `json:"body"`
+	CannedResponseID string      `json:"canned_response_id"`
+	FromEmail        string      `json:"from_email"`
+	CCEmails         string      `json:"cc_emails"`
+	BCCEmails        string      `json:"bcc_emails"`
+}
+
+type ReplyToTicketAction struct{}
+
+// Metadata returns metadata about the action
+func (a *ReplyToTicketAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "reply_to_ticket",
+		DisplayName:   "Reply to Ticket",
+		Description:   "Send a reply to the requester of a ticket, written out or from a canned response, with attachments.",
+		Type:          core.ActionTypeAction,
+		Documentation: replyToTicketDocs,
+		Icon:          "mdi:reply",
+		SampleOutput: map[string]any{
+			"id":            7001,
+			"ticket_id":     123,
+			"body":          "<div>Hi Jane, thanks for reaching out.</div>",
+			"body_text":     "Hi Jane, thanks for reaching out.",
+			"from_email":    "support@acme.freshdesk.com",
+			"to_emails":     []string{"jane.doe@example.com"},
+			"cc_emails":     []string{},
+			"bcc_emails":    []string{},
+			"attachments":   []any{},
+			"created_at":    "2023-12-01T12:30:45Z",
+			"user_id":       789,
+			"support_email": "support@acme.freshdesk.com",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *ReplyToTicketAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("reply_to_ticket", "Reply to Ticket")
+
+	form.NumberField("ticket_id", "Ticket ID").
+		Required(true).
+		HelpText("The ticket to reply to")
+
+	form.TextareaField("body", "Body").
+		Required(false).
+		HelpText("The reply, as HTML or text. Required unless a canned response is chosen.")
+
+	shared.RegisterCannedResponseProps(form)
+
+	form.TextField("from_email", "From Email").
+		Required(false).
+		HelpText("A support email address of the account to send from. Defaults to the ticket's.")
+
+	form.TextField("cc_emails", "CC").
+		Required(false).
+		HelpText("Comma-separated email addresses to copy")
+
+	form.TextField("bcc_emails", "BCC").
+		Required(false).
+		HelpText("Comma-separated email addresses to blind copy")
+
+	registerAttachmentsProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *ReplyToTicketAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input. A canned
+// response has its ticket and requester placeholders filled in, as the
+// API sends it as is.
+func (a *ReplyToTicketAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[replyToTicketActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	ticketID, err := freshworks.ParseID("ticket_id", input.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(input.Body)
+	if input.CannedResponseID != "" {
+		cannedID, err := freshworks.ParseID("canned_response_id", json.Number(input.CannedResponseID))
+		if err != nil {
+			return nil, err
+		}
+		canned, err := shared.GetCannedResponse(freshdeskDomain, apiKey, cannedID)
+		if err != nil {
+			return nil, err
+		}
+		ticket, err := shared.GetTicketWithRequester(freshdeskDomain, apiKey, ticketID)
+		if err != nil {
+			return nil, err
+		}
+		content, _ := canned["content_html"].(string)
+		body = shared.RenderPlaceholders(content, ticket)
+	}
+	if body == "" {
+		return nil, errors.New("a body or a canned response is required")
+	}
+
+	fields := map[string]interface{}{"body": body}
+	if from := strings.TrimSpace(input.FromEmail); from != "" {
+		fields["from_email"] = from
+	}
+	for name, list := range map[string]string{"cc_emails": input.CCEmails, "bcc_emails": input.BCCEmails} {
+		if emails := splitEmails(list); len(emails) > 0 {
+			fields[name] = emails
+		}
+	}
+
+	attachments, err := email.LoadAttachments(ctx.Context(), ctx.Files(), ctx.Input()["attachments"])
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.Conversation(freshdeskDomain, apiKey, ticketID, "reply", fields, attachments)
+}
+
+// registerAttachmentsProps adds the attachments field of replies and
+// notes.
+func registerAttachmentsProps(form *smartform.FormBuilder) {
+	attachments := form.ArrayField("attachments", "Attachments")
+	attachment := attachments.ObjectTemplate("attachment", "")
+	attachment.FileField("file", "File").
+		Required(true).
+		HelpText("A file from a file field or a previous step, a URL, or a data URI. Up to 20 MB in total.")
+	attachment.TextField("filename", "File Name").
+		Required(false).
+		HelpText("The name the recipient sees. Defaults to the file's name.")
+}
+
+// splitEmails reads comma-separated email addresses.
+func splitEmails(text string) []string {
+	var emails []string
+	for _, e := range strings.Split(text, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			emails = append(emails, e)
+		}
+	}
+	return emails
+}
+
+func NewReplyToTicketAction() sdk.Action {
+	return &ReplyToTicketAction{}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -78,10 +79,6 @@ func (a *SearchTicketsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		return nil, errors.New("search query is required")
 	}
 
-	if authCtx.Extra["api-key"] == "" {
-		return nil, errors.New("missing Freshdesk API key")
-	}
-
 	endpoint := "/search/tickets"
 
 	queryParams := "?"
@@ -91,11 +88,12 @@ func (a *SearchTicketsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		queryParams = queryParams[:len(queryParams)-1]
 	}
 
-	domain := authCtx.Extra["domain"]
-	// freshdeskDomain := "https://" + domain + ".freshdesk.com"
-	freshdeskDomain := shared.BuildFreshdeskURL(domain)
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := shared.GetTickets(endpoint+queryParams, freshdeskDomain, authCtx.Extra["api-key"])
+	response, err := shared.GetTickets(endpoint+queryParams, freshdeskDomain, apiKey)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,85 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type UpdateCompanyAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateCompanyAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_company",
+		DisplayName:   "Update Company",
+		Description:   "Update a Freshdesk company. Fields left empty keep their values.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateCompanyDocs,
+		Icon:          "mdi:domain-switch",
+		SampleOutput:  companySample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateCompanyAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_company", "Update Company")
+
+	form.NumberField("company_id", "Company ID").
+		Required(true)
+
+	form.TextField("name", "Name").
+		Required(false)
+
+	registerCompanyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateCompanyAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateCompanyAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[companyActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	companyID, err := freshworks.ParseID("company_id", input.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := companyFrom(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(company) == 0 {
+		return nil, errors.New("nothing to update")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshdeskDomain, apiKey, "companies", companyID, company)
+}
+
+func NewUpdateCompanyAction() sdk.Action {
+	return &UpdateCompanyAction{}
+}
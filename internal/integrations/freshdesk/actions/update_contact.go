@@ -0,0 +1,85 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type UpdateContactAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_contact",
+		DisplayName:   "Update Contact",
+		Description:   "Update a Freshdesk contact. Fields left empty keep their values.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateContactDocs,
+		Icon:          "mdi:account-edit-outline",
+		SampleOutput:  contactSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_contact", "Update Contact")
+
+	form.NumberField("contact_id", "Contact ID").
+		Required(true)
+
+	form.TextField("name", "Name").
+		Required(false)
+
+	registerContactProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateContactAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateContactAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[contactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	contactID, err := freshworks.ParseID("contact_id", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := contactFrom(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(contact) == 0 {
+		return nil, errors.New("nothing to update")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshdeskDomain, apiKey, "contacts", contactID, contact)
+}
+
+func NewUpdateContactAction() sdk.Action {
+	return &UpdateContactAction{}
+}
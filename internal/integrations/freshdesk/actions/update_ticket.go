@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -106,9 +107,10 @@ func (a *UpdateTicketAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		return nil, err
 	}
 
-	domain := authCtx.Extra["domain"]
-	// freshdeskDomain := "https://" + domain + ".freshdesk.com"
-	freshdeskDomain := shared.BuildFreshdeskURL(domain)
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
 	ticketData := shared.TicketUpdate{}
 	ticketID := fmt.Sprintf("%v", input.TicketID)
@@ -135,7 +137,7 @@ func (a *UpdateTicketAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		ticketData.Priority = priority
 	}
 
-	err = shared.UpdateTicket(freshdeskDomain, authCtx.Extra["api-key"], ticketID, ticketData)
+	err = shared.UpdateTicket(freshdeskDomain, apiKey, ticketID, ticketData)
 	if err != nil {
 		return nil, fmt.Errorf("error creating ticket:  %v", err)
 	}
@@ -3,8 +3,8 @@ package freshdesk
 import (
 	_ "embed"
 
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk/actions"
-	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk/triggers"
 
 	"github.com/wakflo/go-sdk/v2"
@@ -28,7 +28,7 @@ func (n *Freshdesk) Metadata() sdk.IntegrationMetadata {
 func (n *Freshdesk) Auth() *core.AuthMetadata {
 	return &core.AuthMetadata{
 		Required: true,
-		Schema:   shared.FreshdeskSharedAuth,
+		Schema:   freshworks.Freshdesk.AuthSchema(),
 	}
 }
 
@@ -36,6 +36,7 @@ func (n *Freshdesk) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewTicketCreatedTrigger(),
 		triggers.NewTicketUpdatedTrigger(),
+		triggers.NewSLABreachedTrigger(),
 	}
 }
 
@@ -46,6 +47,17 @@ func (n *Freshdesk) Actions() []sdk.Action {
 		actions.NewListTicketsAction(),
 		actions.NewUpdateTicketAction(),
 		actions.NewSearchTicketsAction(),
+		actions.NewReplyToTicketAction(),
+		actions.NewAddNoteAction(),
+		actions.NewMergeTicketsAction(),
+		actions.NewCreateContactAction(),
+		actions.NewGetContactAction(),
+		actions.NewUpdateContactAction(),
+		actions.NewDeleteContactAction(),
+		actions.NewCreateCompanyAction(),
+		actions.NewGetCompanyAction(),
+		actions.NewUpdateCompanyAction(),
+		actions.NewDeleteCompanyAction(),
 	}
 }
 
@@ -0,0 +1,174 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/freshworks"
+)
+
+// MaxAttachmentsSize is the most bytes of attachments Freshdesk takes
+// with a ticket, reply or note.
+const MaxAttachmentsSize = 20 << 20
+
+// request calls the Freshdesk API with a JSON body and decodes the JSON
+// response, returning error responses as errors.
+func request(baseURL, apiKey, method, path string, payload interface{}) (interface{}, error) {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal JSON: %v", err)
+		}
+		body = bytes.NewReader(data)
+	}
+	return send(baseURL, apiKey, method, path, "application/json", body)
+}
+
+// requestMultipart calls the Freshdesk API with a multipart body holding
+// the fields and the attachments, as Freshdesk only takes files that way.
+// List fields are sent once per value, as name[].
+func requestMultipart(baseURL, apiKey, method, path string, fields map[string]interface{}, attachments []email.Attachment) (interface{}, error) {
+	var size int
+	for _, a := range attachments {
+		size += len(a.Content)
+	}
+	if size > MaxAttachmentsSize {
+		return nil, fmt.Errorf("attachments total %d MB, over the 20 MB Freshdesk takes", size>>20)
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for name, value := range fields {
+		values, isList := formValues(value)
+		if isList {
+			name += "[]"
+		}
+		for _, v := range values {
+			if err := w.WriteField(name, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, a := range attachments {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="attachments[]"; filename=%q`, a.Filename))
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header.Set("Content-Type", contentType)
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(a.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return send(baseURL, apiKey, method, path, w.FormDataContentType(), &body)
+}
+
+// formValues returns the text of a form field value, and whether it is a
+// list.
+func formValues(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case []string:
+		return v, true
+	case []int64:
+		values := make([]string, 0, len(v))
+		for _, n := range v {
+			values = append(values, strconv.FormatInt(n, 10))
+		}
+		return values, true
+	case bool:
+		return []string{strconv.FormatBool(v)}, false
+	default:
+		return []string{fmt.Sprint(v)}, false
+	}
+}
+
+func send(baseURL, apiKey, method, path, contentType string, body io.Reader) (interface{}, error) {
+	req, err := http.NewRequest(method, baseURL+"/api/v2"+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", "application/json")
+	freshworks.Freshdesk.Authorize(req, apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("freshdesk error (%d): %s", resp.StatusCode, string(data))
+	}
+
+	var result interface{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %v", err)
+	}
+	return result, nil
+}
+
+// recordPath is the path of a record of a resource such as "contacts".
+func recordPath(resource string, id int64) string {
+	return "/" + resource + "/" + url.PathEscape(strconv.FormatInt(id, 10))
+}
+
+// GetRecord returns a record of a resource such as "contacts" or
+// "companies".
+func GetRecord(baseURL, apiKey, resource string, id int64) (interface{}, error) {
+	return request(baseURL, apiKey, http.MethodGet, recordPath(resource, id), nil)
+}
+
+// SaveRecord creates a record of a resource, or updates the record with
+// the ID when it isn't 0, and returns it.
+func SaveRecord(baseURL, apiKey, resource string, id int64, record map[string]interface{}) (interface{}, error) {
+	if id == 0 {
+		return request(baseURL, apiKey, http.MethodPost, "/"+resource, record)
+	}
+	return request(baseURL, apiKey, http.MethodPut, recordPath(resource, id), record)
+}
+
+// DeleteRecord deletes a record of a resource. Deleted contacts go to the
+// trash and can be restored.
+func DeleteRecord(baseURL, apiKey, resource string, id int64) error {
+	_, err := request(baseURL, apiKey, http.MethodDelete, recordPath(resource, id), nil)
+	return err
+}
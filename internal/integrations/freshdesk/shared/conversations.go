@@ -0,0 +1,223 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// Conversation posts a reply or note to a ticket. Kind is "reply" or
+// "notes", the endpoint under the ticket.
+func Conversation(baseURL, apiKey string, ticketID int64, kind string, fields map[string]interface{}, attachments []email.Attachment) (interface{}, error) {
+	path := recordPath("tickets", ticketID) + "/" + kind
+	if len(attachments) == 0 {
+		return request(baseURL, apiKey, http.MethodPost, path, fields)
+	}
+	return requestMultipart(baseURL, apiKey, http.MethodPost, path, fields, attachments)
+}
+
+// GetTicketWithRequester returns a ticket with its requester embedded,
+// for filling canned response placeholders.
+func GetTicketWithRequester(baseURL, apiKey string, ticketID int64) (map[string]interface{}, error) {
+	result, err := request(baseURL, apiKey, http.MethodGet, recordPath("tickets", ticketID)+"?include=requester", nil)
+	if err != nil {
+		return nil, err
+	}
+	ticket, _ := result.(map[string]interface{})
+	if ticket == nil {
+		return nil, fmt.Errorf("ticket %d not found", ticketID)
+	}
+	return ticket, nil
+}
+
+// MergeTickets merges tickets into the primary ticket, which keeps their
+// conversations, and closes them. A note can be added to each side.
+func MergeTickets(baseURL, apiKey string, primaryID int64, ticketIDs []int64, primaryNote, secondaryNote string, recipientsToCC bool) (interface{}, error) {
+	payload := map[string]interface{}{
+		"primary_id": primaryID,
+		"ticket_ids": ticketIDs,
+		// Freshdesk spells the field this way.
+		"convert_recepients_to_cc": recipientsToCC,
+	}
+	if primaryNote != "" {
+		payload["note_in_primary"] = map[string]interface{}{"body": primaryNote, "private": true}
+	}
+	if secondaryNote != "" {
+		payload["note_in_secondary"] = map[string]interface{}{"body": secondaryNote, "private": false}
+	}
+	return request(baseURL, apiKey, http.MethodPut, "/tickets/merge", payload)
+}
+
+// GetCannedResponse returns a canned response with its content.
+func GetCannedResponse(baseURL, apiKey string, id int64) (map[string]interface{}, error) {
+	result, err := GetRecord(baseURL, apiKey, "canned_responses", id)
+	if err != nil {
+		return nil, err
+	}
+	response, _ := result.(map[string]interface{})
+	if response == nil {
+		return nil, fmt.Errorf("canned response %d not found", id)
+	}
+	return response, nil
+}
+
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([a-z_.]+)\s*\}\}`)
+
+// RenderPlaceholders fills the ticket and requester placeholders of a
+// canned response, such as {{ticket.requester.firstname}}, from a ticket
+// fetched with its requester. The API sends content as is, so without
+// this customers would see the placeholders. Unknown placeholders are
+// kept.
+func RenderPlaceholders(content string, ticket map[string]interface{}) string {
+	requester, _ := ticket["requester"].(map[string]interface{})
+	name := strings.TrimSpace(text(requester["name"]))
+	first, last, _ := strings.Cut(name, " ")
+
+	values := map[string]string{
+		"ticket.id":                  text(ticket["id"]),
+		"ticket.subject":             text(ticket["subject"]),
+		"ticket.requester.name":      name,
+		"ticket.requester.firstname": first,
+		"ticket.requester.lastname":  strings.TrimSpace(last),
+		"ticket.requester.email":     text(requester["email"]),
+		"ticket.requester.phone":     text(requester["phone"]),
+		"ticket.requester.mobile":    text(requester["mobile"]),
+	}
+	return placeholderPattern.ReplaceAllStringFunc(content, func(match string) string {
+		key := placeholderPattern.FindStringSubmatch(match)[1]
+		if value, ok := values[key]; ok {
+			return value
+		}
+		return match
+	})
+}
+
+func text(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// RegisterCannedResponseProps adds a select of the canned response
+// folders and one of the responses of the chosen folder.
+func RegisterCannedResponseProps(form *smartform.FormBuilder) {
+	getFolders := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+		baseURL, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := request(baseURL, apiKey, http.MethodGet, "/canned_response_folders", nil)
+		if err != nil {
+			return nil, err
+		}
+		items := options(result, "name")
+		return ctx.Respond(items, len(items))
+	}
+
+	getResponses := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		input := sdk.DynamicInputToType[struct {
+			FolderID string `json:"canned_folder_id"`
+		}](ctx)
+		if input.FolderID == "" {
+			return ctx.Respond([]map[string]any{}, 0)
+		}
+
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+		baseURL, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := request(baseURL, apiKey, http.MethodGet, "/canned_response_folders/"+url.PathEscape(input.FolderID)+"/responses", nil)
+		if err != nil {
+			return nil, err
+		}
+		items := options(result, "title")
+		return ctx.Respond(items, len(items))
+	}
+
+	form.SelectField("canned_folder_id", "Canned Response Folder").
+		Placeholder("Select a folder").
+		Required(false).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getFolders)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("The folder of the canned response to send")
+
+	form.SelectField("canned_response_id", "Canned Response").
+		Placeholder("Select a canned response").
+		Required(false).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getResponses)).
+				WithFieldReference("canned_folder_id", "canned_folder_id").
+				WithSearchSupport().
+				End().
+				RefreshOn("canned_folder_id").
+				GetDynamicSource(),
+		).
+		HelpText("Sent instead of the body, with ticket and requester placeholders filled in")
+}
+
+// options converts a list of records to select options named by the
+// nameKey field.
+func options(result interface{}, nameKey string) []map[string]any {
+	records, _ := result.([]interface{})
+	items := make([]map[string]any, 0, len(records))
+	for _, r := range records {
+		record, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name := text(record[nameKey]); name != "" {
+			items = append(items, map[string]any{
+				"id":   text(record["id"]),
+				"name": name,
+			})
+		}
+	}
+	return items
+}
@@ -23,11 +23,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"strings"
 
 	fastshot "github.com/opus-domini/fast-shot"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/go-sdk/v2"
 
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -252,9 +252,12 @@ func RegisterTicketProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
 			return nil, err
 		}
 
-		baseAPI := "https://" + authCtx.Extra["domain"] + ".freshdesk.com"
+		baseAPI, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+		if err != nil {
+			return nil, err
+		}
 		qu := fastshot.NewClient(baseAPI).
-			Auth().BasicAuth(authCtx.Extra["api-key"], "X").
+			Auth().BasicAuth(apiKey, "X").
 			Header().
 			AddAccept("application/json").
 			Build().GET("/api/v2/tickets")
@@ -318,12 +321,3 @@ var FreshdeskStatusType = []*smartform.Option{
 	{Value: "4", Label: "Resolved"},
 	{Value: "5", Label: "Closed"},
 }
-
-func BuildFreshdeskURL(domain string) string {
-	// First, extract just the domain name
-	domain = strings.TrimPrefix(domain, "https://")
-	domain = strings.TrimSuffix(domain, ".freshdesk.com")
-
-	// Then build the full URL
-	return "https://" + domain + ".freshdesk.com"
-}
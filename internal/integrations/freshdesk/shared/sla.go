@@ -0,0 +1,77 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// The SLA targets a ticket can miss.
+const (
+	BreachFirstResponse = "first_response"
+	BreachResolution    = "resolution"
+)
+
+// searchPages is the most pages the search API returns, of 30 tickets
+// each.
+const searchPages = 10
+
+// OverdueTickets returns the open and pending tickets whose first response
+// or resolution is due by the end of the day of now, the ones that may
+// have breached their SLA. The search API returns at most 300 tickets;
+// complete is false when there were more.
+func OverdueTickets(baseURL, apiKey string, now time.Time) (tickets []map[string]interface{}, complete bool, err error) {
+	// Search filters dates by day, so Breaches checks the exact due times.
+	day := now.UTC().AddDate(0, 0, 1).Format("2006-01-02")
+	query := url.QueryEscape(fmt.Sprintf(`"(status:2 OR status:3) AND (due_by:<'%s' OR fr_due_by:<'%s')"`, day, day))
+
+	for page := 1; page <= searchPages; page++ {
+		result, err := request(baseURL, apiKey, http.MethodGet, "/search/tickets?query="+query+"&page="+strconv.Itoa(page), nil)
+		if err != nil {
+			return nil, false, err
+		}
+		resultMap, _ := result.(map[string]interface{})
+		results, _ := resultMap["results"].([]interface{})
+		for _, r := range results {
+			if ticket, ok := r.(map[string]interface{}); ok {
+				tickets = append(tickets, ticket)
+			}
+		}
+		if len(results) < 30 {
+			return tickets, true, nil
+		}
+	}
+	return tickets, false, nil
+}
+
+// Breaches returns the SLA targets an unresolved ticket has missed by
+// now: the first response when Freshdesk flagged it as escalated for
+// that, and the resolution when it is past its due time.
+func Breaches(ticket map[string]interface{}, now time.Time) []string {
+	var breaches []string
+	if escalated, _ := ticket["fr_escalated"].(bool); escalated {
+		breaches = append(breaches, BreachFirstResponse)
+	}
+	if due, ok := ticket["due_by"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, due); err == nil && now.After(t) {
+			breaches = append(breaches, BreachResolution)
+		}
+	}
+	return breaches
+}
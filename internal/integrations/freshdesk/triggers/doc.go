@@ -7,3 +7,6 @@ var ticketCreatedDocs string
 
 //go:embed ticket_updated.md
 var ticketUpdatedDocs string
+
+//go:embed sla_breached.md
+var slaBreachedDocs string
@@ -0,0 +1,191 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// breachesKey stores the breaches found at the last poll, as
+// "ticketID:breach".
+const breachesKey = "slaBreaches"
+
+type slaBreachedTriggerProps struct {
+	Breach      string `json:"breach"`
+	MinPriority string `json:"min_priority"`
+}
+
+type SLABreachedTrigger struct{}
+
+func (t *SLABreachedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "sla_breached",
+		DisplayName:   "SLA Breached",
+		Description:   "Trigger a workflow when an open or pending ticket misses its first response or resolution due time.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: slaBreachedDocs,
+		Icon:          "mdi:timer-alert-outline",
+		SampleOutput: []map[string]any{
+			{
+				"ticket_id": 123,
+				"breach":    shared.BreachResolution,
+				"due_by":    "2023-12-03T12:30:45Z",
+				"ticket": map[string]any{
+					"id":           123,
+					"subject":      "Order never arrived",
+					"status":       2,
+					"priority":     3,
+					"requester_id": 456,
+					"responder_id": 789,
+					"group_id":     12,
+					"fr_escalated": false,
+					"due_by":       "2023-12-03T12:30:45Z",
+					"fr_due_by":    "2023-12-02T12:30:45Z",
+				},
+			},
+		},
+	}
+}
+
+func (t *SLABreachedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *SLABreachedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *SLABreachedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("freshdesk-sla-breached", "SLA Breached")
+
+	form.SelectField("breach", "Breach").
+		Required(false).
+		AddOption(shared.BreachFirstResponse, "First response overdue").
+		AddOption(shared.BreachResolution, "Resolution overdue").
+		HelpText("The due time to watch. Leave empty for both.")
+
+	form.SelectField("min_priority", "Minimum Priority").
+		Required(false).
+		AddOptions(shared.FreshdeskPriorityType...).
+		HelpText("Only trigger for tickets of this priority or higher")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the SLABreachedTrigger
+func (t *SLABreachedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the SLABreachedTrigger
+func (t *SLABreachedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute compares the breaches of the overdue tickets with the last
+// poll, so each breach triggers once. The first poll only records the
+// breaches.
+func (t *SLABreachedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[slaBreachedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	minPriority, _ := strconv.Atoi(input.MinPriority)
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tickets, complete, err := shared.OverdueTickets(freshdeskDomain, apiKey, now)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching overdue tickets: %v", err)
+	}
+
+	prev, first := loadBreaches(ctx)
+	next := map[string]bool{}
+	if !complete {
+		// Breaches of tickets past the search limit are still breached;
+		// keep them so they don't trigger again once they are read.
+		for key := range prev {
+			next[key] = true
+		}
+	}
+	events := make([]map[string]interface{}, 0)
+	for _, ticket := range tickets {
+		if priority, _ := ticket["priority"].(float64); int(priority) < minPriority {
+			continue
+		}
+		for _, breach := range shared.Breaches(ticket, now) {
+			if input.Breach != "" && breach != input.Breach {
+				continue
+			}
+			key := fmt.Sprint(ticket["id"]) + ":" + breach
+			next[key] = true
+			if first || prev[key] {
+				continue
+			}
+
+			due := ticket["due_by"]
+			if breach == shared.BreachFirstResponse {
+				due = ticket["fr_due_by"]
+			}
+			events = append(events, map[string]interface{}{
+				"ticket_id": ticket["id"],
+				"breach":    breach,
+				"due_by":    due,
+				"ticket":    ticket,
+			})
+		}
+	}
+
+	if err := ctx.SetMetadata(breachesKey, next); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (t *SLABreachedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+// loadBreaches returns the breaches of the last poll, and whether there
+// was none.
+func loadBreaches(ctx sdkcontext.ExecuteContext) (map[string]bool, bool) {
+	stored, err := ctx.GetMetadata(breachesKey)
+	if err != nil || stored == nil {
+		return map[string]bool{}, true
+	}
+
+	var breaches map[string]bool
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &breaches)
+	}
+	if err != nil || breaches == nil {
+		ctx.Logger().Warn("ignoring unreadable SLA breaches", "error", err)
+		return map[string]bool{}, true
+	}
+	return breaches, false
+}
+
+func NewSLABreachedTrigger() sdk.Trigger {
+	return &SLABreachedTrigger{}
+}
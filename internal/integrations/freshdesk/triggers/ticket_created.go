@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -84,11 +85,12 @@ func (t *TicketCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.J
 		endpoint = "/tickets?order_by=created_at&order_type=desc"
 	}
 
-	domain := authCtx.Extra["domain"]
-	// freshdeskDomain := "https://" + domain + ".freshdesk.com"
-	freshdeskDomain := shared.BuildFreshdeskURL(domain)
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := shared.GetTickets(endpoint, freshdeskDomain, authCtx.Extra["api-key"])
+	response, err := shared.GetTickets(endpoint, freshdeskDomain, apiKey)
 	if err != nil {
 		return nil, err
 	}
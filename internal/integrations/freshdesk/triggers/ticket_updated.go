@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshdesk/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -83,11 +84,12 @@ func (t *TicketUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.J
 
 	endpoint := "/tickets?order_by=updated_at&order_type=desc&updated_since=" + updatedSince
 
-	domain := authCtx.Extra["domain"]
-	// freshdeskDomain := "https://" + domain + ".freshdesk.com"
-	freshdeskDomain := shared.BuildFreshdeskURL(domain)
+	freshdeskDomain, apiKey, err := freshworks.Freshdesk.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := shared.GetTickets(endpoint, freshdeskDomain, authCtx.Extra["api-key"])
+	response, err := shared.GetTickets(endpoint, freshdeskDomain, apiKey)
 	if err != nil {
 		return nil, err
 	}
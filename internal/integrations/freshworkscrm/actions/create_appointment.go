@@ -0,0 +1,162 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createAppointmentActionProps struct {
+	Title          string      `json:"title"`
+	Description    string      `json:"description"`
+	FromDate       string      `json:"from_date"`
+	EndDate        string      `json:"end_date"`
+	Location       string      `json:"location"`
+	TimeZone       string      `json:"time_zone"`
+	ContactIDs     string      `json:"contact_ids"`
+	UserIDs        string      `json:"user_ids"`
+	TargetableType string      `json:"targetable_type"`
+	TargetableID   json.Number `json:"targetable_id"`
+}
+
+type CreateAppointmentAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateAppointmentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_appointment",
+		DisplayName:   "Create Appointment",
+		Description:   "Schedule an appointment in Freshworks CRM with contacts and users as attendees.",
+		Type:          core.ActionTypeAction,
+		Documentation: createAppointmentDocs,
+		SampleOutput: map[string]any{
+			"id":              "70000033445",
+			"title":           "Renewal call",
+			"from_date":       "2024-05-03T15:00:00Z",
+			"end_date":        "2024-05-03T15:30:00Z",
+			"location":        "https://meet.example.com/renewal",
+			"targetable_type": "Deal",
+			"targetable_id":   "70000054321",
+			"appointment_attendees": []map[string]any{
+				{"attendee_type": "Contact", "attendee_id": "70000067890"},
+				{"attendee_type": "FdMultitenant::User", "attendee_id": "70000000042"},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateAppointmentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_appointment", "Create Appointment")
+
+	form.TextField("title", "Title").
+		Required(true).
+		HelpText("The title of the appointment")
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	form.DateTimeField("from_date", "Start").
+		Required(true).
+		HelpText("When the appointment starts, such as 2024-05-03T15:00:00Z. Times without a zone are UTC.")
+
+	form.DateTimeField("end_date", "End").
+		Required(true).
+		HelpText("When the appointment ends")
+
+	form.TextField("location", "Location").
+		Required(false)
+
+	form.TextField("time_zone", "Time Zone").
+		Required(false).
+		HelpText("The time zone the appointment is shown in, such as America/New_York")
+
+	form.TextField("contact_ids", "Contact Attendees").
+		Required(false).
+		HelpText("Comma-separated IDs of the contacts attending")
+
+	form.TextField("user_ids", "User Attendees").
+		Required(false).
+		HelpText("Comma-separated IDs of the CRM users attending")
+
+	registerTargetableProps(form, false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateAppointmentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateAppointmentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createAppointmentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Title) == "" {
+		return nil, errors.New("title is required")
+	}
+	if strings.TrimSpace(input.FromDate) == "" || strings.TrimSpace(input.EndDate) == "" {
+		return nil, errors.New("start and end are required")
+	}
+
+	appointment := map[string]interface{}{"title": input.Title}
+	shared.UpdateField(appointment, "description", input.Description)
+	shared.UpdateField(appointment, "location", strings.TrimSpace(input.Location))
+	shared.UpdateField(appointment, "time_zone", strings.TrimSpace(input.TimeZone))
+	for key, value := range map[string]string{"from_date": input.FromDate, "end_date": input.EndDate} {
+		if err := shared.SetTime(appointment, key, value); err != nil {
+			return nil, err
+		}
+	}
+	if err := setTargetable(appointment, input.TargetableType, input.TargetableID, false); err != nil {
+		return nil, err
+	}
+
+	var attendees []map[string]interface{}
+	for _, attendee := range []struct{ kind, ids string }{
+		{"Contact", input.ContactIDs},
+		{"FdMultitenant::User", input.UserIDs},
+	} {
+		ids, err := freshworks.ParseIDs(attendee.ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			attendees = append(attendees, map[string]interface{}{
+				"attendee_type": attendee.kind,
+				"attendee_id":   id,
+			})
+		}
+	}
+	if len(attendees) > 0 {
+		appointment["appointment_attendees_attributes"] = attendees
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshworksDomain, apiKey, "appointment", "", appointment)
+}
+
+func NewCreateAppointmentAction() sdk.Action {
+	return &CreateAppointmentAction{}
+}
@@ -1,10 +1,10 @@
 package actions
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -137,18 +137,16 @@ func (a *CreateNewContactAction) Perform(ctx sdkcontext.PerformContext) (core.JS
 		return nil, err
 	}
 
-	if authCtx.Extra["api-key"] == "" || authCtx.Extra["domain"] == "" {
-		return nil, errors.New("missing freshworks auth parameters")
-	}
-
 	// Use the InputToTypeSafely helper function to convert the input to our struct
 	input, err := sdk.InputToTypeSafely[createNewContactActionProps](ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	domain := authCtx.Extra["domain"]
-	freshworksDomain := "https://" + domain + ".myfreshworks.com"
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
 	contactData := map[string]interface{}{
 		"contact": map[string]interface{}{
@@ -185,7 +183,7 @@ func (a *CreateNewContactAction) Perform(ctx sdkcontext.PerformContext) (core.JS
 		contactMap["country"] = *input.Country
 	}
 
-	response, err := shared.CreateContact(freshworksDomain, authCtx.Extra["api-key"], contactData)
+	response, err := shared.CreateContact(freshworksDomain, apiKey, contactData)
 	if err != nil {
 		return nil, fmt.Errorf("error creating contact:  %v", err)
 	}
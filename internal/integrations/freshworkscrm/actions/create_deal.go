@@ -0,0 +1,170 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type dealActionProps struct {
+	DealID         json.Number `json:"deal_id"`
+	Name           string      `json:"name"`
+	Amount         json.Number `json:"amount"`
+	SalesAccountID json.Number `json:"sales_account_id"`
+	PipelineID     json.Number `json:"deal_pipeline_id"`
+	StageID        json.Number `json:"deal_stage_id"`
+	ExpectedClose  string      `json:"expected_close"`
+	OwnerID        json.Number `json:"owner_id"`
+	ContactIDs     string      `json:"contact_ids"`
+	CustomFields   string      `json:"custom_field"`
+}
+
+var dealSample = map[string]any{
+	"id":               "70000054321",
+	"name":             "Acme annual plan",
+	"amount":           "12000.0",
+	"sales_account_id": "70000012345",
+	"deal_pipeline_id": "70000000011",
+	"deal_stage_id":    "70000000101",
+	"expected_close":   "2024-06-30",
+	"owner_id":         "70000000042",
+	"created_at":       "2024-05-01T10:00:00Z",
+	"updated_at":       "2024-05-01T10:00:00Z",
+}
+
+type CreateDealAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateDealAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_deal",
+		DisplayName:   "Create Deal",
+		Description:   "Create a deal in a Freshworks CRM pipeline, linked to a sales account and contacts.",
+		Type:          core.ActionTypeAction,
+		Documentation: createDealDocs,
+		SampleOutput:  dealSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateDealAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_deal", "Create Deal")
+
+	form.TextField("name", "Name").
+		Placeholder("Enter the deal name").
+		Required(true).
+		HelpText("The name of the deal")
+
+	form.NumberField("amount", "Amount").
+		Required(true).
+		HelpText("The value of the deal in the account's currency")
+
+	registerDealProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateDealAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateDealAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[dealActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+	if input.Amount == "" {
+		return nil, errors.New("amount is required")
+	}
+
+	deal, err := dealFrom(input)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshworksDomain, apiKey, "deal", "", deal)
+}
+
+// registerDealProps adds the deal fields shared by the create and update
+// actions.
+func registerDealProps(form *smartform.FormBuilder) {
+	form.TextField("sales_account_id", "Sales Account ID").
+		Required(false).
+		HelpText("The sales account the deal is with")
+
+	shared.RegisterDealPipelinesProps(form, false)
+	shared.RegisterDealStagesProps(form, false)
+
+	form.DateField("expected_close", "Expected Close").
+		Required(false).
+		HelpText("The date the deal is expected to close, such as 2024-06-30")
+
+	shared.RegisterOwnersProps(form)
+
+	form.TextField("contact_ids", "Contact IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of contacts to add to the deal. Contacts already on the deal are kept.")
+
+	registerCustomFieldProps(form)
+}
+
+// dealFrom builds the deal of the input, leaving out the fields that are
+// empty so an update keeps them.
+func dealFrom(input *dealActionProps) (map[string]interface{}, error) {
+	deal := map[string]interface{}{}
+	shared.UpdateField(deal, "name", strings.TrimSpace(input.Name))
+	if input.Amount != "" {
+		deal["amount"] = input.Amount
+	}
+	if expected := strings.TrimSpace(input.ExpectedClose); expected != "" {
+		if len(expected) > len("2006-01-02") {
+			expected = expected[:len("2006-01-02")]
+		}
+		deal["expected_close"] = expected
+	}
+	for key, value := range map[string]json.Number{
+		"sales_account_id": input.SalesAccountID,
+		"deal_pipeline_id": input.PipelineID,
+		"deal_stage_id":    input.StageID,
+		"owner_id":         input.OwnerID,
+	} {
+		if err := shared.SetID(deal, key, value); err != nil {
+			return nil, err
+		}
+	}
+	if err := shared.SetIDs(deal, "contacts_added_list", input.ContactIDs); err != nil {
+		return nil, err
+	}
+	if err := setCustomFields(deal, input.CustomFields); err != nil {
+		return nil, err
+	}
+	return deal, nil
+}
+
+func NewCreateDealAction() sdk.Action {
+	return &CreateDealAction{}
+}
@@ -0,0 +1,127 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createNoteActionProps struct {
+	Description    string      `json:"description"`
+	TargetableType string      `json:"targetable_type"`
+	TargetableID   json.Number `json:"targetable_id"`
+}
+
+type CreateNoteAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateNoteAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_note",
+		DisplayName:   "Create Note",
+		Description:   "Add a note to a contact, sales account or deal in Freshworks CRM.",
+		Type:          core.ActionTypeAction,
+		Documentation: createNoteDocs,
+		SampleOutput: map[string]any{
+			"id":              "70000099887",
+			"description":     "Called to confirm the renewal date.",
+			"targetable_type": "Deal",
+			"targetable_id":   "70000054321",
+			"created_at":      "2024-05-01T10:00:00Z",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateNoteAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_note", "Create Note")
+
+	form.TextareaField("description", "Note").
+		Required(true).
+		HelpText("The text of the note")
+
+	registerTargetableProps(form, true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateNoteAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateNoteAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createNoteActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Description) == "" {
+		return nil, errors.New("note is required")
+	}
+
+	note := map[string]interface{}{"description": input.Description}
+	if err := setTargetable(note, input.TargetableType, input.TargetableID, true); err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshworksDomain, apiKey, "note", "", note)
+}
+
+// registerTargetableProps adds the fields of the record a note, task or
+// appointment is added to.
+func registerTargetableProps(form *smartform.FormBuilder, required bool) {
+	form.SelectField("targetable_type", "Related To").
+		Required(required).
+		AddOptions(shared.TargetableTypes...).
+		HelpText("The type of record this is added to")
+
+	form.TextField("targetable_id", "Related Record ID").
+		Required(required).
+		HelpText("The ID of the contact, sales account or deal")
+}
+
+// setTargetable sets the record a note, task or appointment is added to,
+// which must be given in full or, when optional, not at all.
+func setTargetable(record map[string]interface{}, targetType string, targetID json.Number, required bool) error {
+	if targetType == "" && targetID == "" && !required {
+		return nil
+	}
+	valid := false
+	for _, option := range shared.TargetableTypes {
+		valid = valid || option.Value == targetType
+	}
+	if !valid {
+		return errors.New("related to must be Contact, SalesAccount or Deal")
+	}
+	id, err := freshworks.ParseID("targetable_id", targetID)
+	if err != nil {
+		return err
+	}
+	record["targetable_type"] = targetType
+	record["targetable_id"] = id
+	return nil
+}
+
+func NewCreateNoteAction() sdk.Action {
+	return &CreateNoteAction{}
+}
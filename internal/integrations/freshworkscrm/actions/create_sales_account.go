@@ -0,0 +1,201 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type salesAccountActionProps struct {
+	SalesAccountID    json.Number `json:"sales_account_id"`
+	Name              string      `json:"name"`
+	Website           string      `json:"website"`
+	Phone             string      `json:"phone"`
+	Address           string      `json:"address"`
+	City              string      `json:"city"`
+	State             string      `json:"state"`
+	Zipcode           string      `json:"zipcode"`
+	Country           string      `json:"country"`
+	NumberOfEmployees json.Number `json:"number_of_employees"`
+	AnnualRevenue     json.Number `json:"annual_revenue"`
+	OwnerID           json.Number `json:"owner_id"`
+	CustomFields      string      `json:"custom_field"`
+}
+
+var salesAccountSample = map[string]any{
+	"id":                  "70000012345",
+	"name":                "Acme Inc",
+	"website":             "https://acme.com",
+	"phone":               "+1 555 0100",
+	"city":                "Austin",
+	"country":             "USA",
+	"number_of_employees": 250,
+	"annual_revenue":      5000000,
+	"owner_id":            "70000000042",
+	"created_at":          "2024-05-01T10:00:00Z",
+	"updated_at":          "2024-05-01T10:00:00Z",
+}
+
+type CreateSalesAccountAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateSalesAccountAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_sales_account",
+		DisplayName:   "Create Sales Account",
+		Description:   "Create a sales account, the company your contacts and deals belong to, in Freshworks CRM.",
+		Type:          core.ActionTypeAction,
+		Documentation: createSalesAccountDocs,
+		SampleOutput:  salesAccountSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateSalesAccountAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_sales_account", "Create Sales Account")
+
+	form.TextField("name", "Name").
+		Placeholder("Enter the account name").
+		Required(true).
+		HelpText("The name of the sales account, which must be unique")
+
+	registerSalesAccountProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateSalesAccountAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateSalesAccountAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[salesAccountActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+
+	account, err := salesAccountFrom(input)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshworksDomain, apiKey, "sales_account", "", account)
+}
+
+// registerSalesAccountProps adds the sales account fields shared by the
+// create and update actions.
+func registerSalesAccountProps(form *smartform.FormBuilder) {
+	form.TextField("website", "Website").
+		Required(false)
+
+	form.TextField("phone", "Phone").
+		Required(false)
+
+	form.TextField("address", "Address").
+		Required(false)
+
+	form.TextField("city", "City").
+		Required(false)
+
+	form.TextField("state", "State").
+		Required(false)
+
+	form.TextField("zipcode", "Zip Code").
+		Required(false)
+
+	form.TextField("country", "Country").
+		Required(false)
+
+	form.NumberField("number_of_employees", "Number of Employees").
+		Required(false)
+
+	form.NumberField("annual_revenue", "Annual Revenue").
+		Required(false)
+
+	shared.RegisterOwnersProps(form)
+
+	registerCustomFieldProps(form)
+}
+
+// salesAccountFrom builds the sales account of the input, leaving out
+// the fields that are empty so an update keeps them.
+func salesAccountFrom(input *salesAccountActionProps) (map[string]interface{}, error) {
+	account := map[string]interface{}{}
+	for key, value := range map[string]string{
+		"name":    input.Name,
+		"website": input.Website,
+		"phone":   input.Phone,
+		"address": input.Address,
+		"city":    input.City,
+		"state":   input.State,
+		"zipcode": input.Zipcode,
+		"country": input.Country,
+	} {
+		shared.UpdateField(account, key, strings.TrimSpace(value))
+	}
+	for key, value := range map[string]json.Number{
+		"number_of_employees": input.NumberOfEmployees,
+		"annual_revenue":      input.AnnualRevenue,
+	} {
+		if value != "" {
+			account[key] = value
+		}
+	}
+	if err := shared.SetID(account, "owner_id", input.OwnerID); err != nil {
+		return nil, err
+	}
+	if err := setCustomFields(account, input.CustomFields); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// registerCustomFieldProps adds the custom field values of a record.
+func registerCustomFieldProps(form *smartform.FormBuilder) {
+	form.TextareaField("custom_field", "Custom Fields").
+		Required(false).
+		HelpText(`Custom fields by API name, such as cf_region, one "field=value" per line or a JSON object.`)
+}
+
+// setCustomFields sets the custom fields of a record from the text of
+// the custom_field field.
+func setCustomFields(record map[string]interface{}, text string) error {
+	fields, err := crm.ParseFields(text)
+	if err != nil {
+		return fmt.Errorf("custom fields: %w", err)
+	}
+	if len(fields) > 0 {
+		record["custom_field"] = fields
+	}
+	return nil
+}
+
+func NewCreateSalesAccountAction() sdk.Action {
+	return &CreateSalesAccountAction{}
+}
@@ -0,0 +1,117 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createTaskActionProps struct {
+	Title          string      `json:"title"`
+	Description    string      `json:"description"`
+	DueDate        string      `json:"due_date"`
+	OwnerID        json.Number `json:"owner_id"`
+	TargetableType string      `json:"targetable_type"`
+	TargetableID   json.Number `json:"targetable_id"`
+}
+
+type CreateTaskAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateTaskAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_task",
+		DisplayName:   "Create Task",
+		Description:   "Create a task on a contact, sales account or deal in Freshworks CRM, assigned to an owner.",
+		Type:          core.ActionTypeAction,
+		Documentation: createTaskDocs,
+		SampleOutput: map[string]any{
+			"id":              "70000011223",
+			"title":           "Send the renewal quote",
+			"description":     "Include the multi-year discount.",
+			"due_date":        "2024-05-03T15:00:00Z",
+			"owner_id":        "70000000042",
+			"targetable_type": "Deal",
+			"targetable_id":   "70000054321",
+			"status":          0,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateTaskAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_task", "Create Task")
+
+	form.TextField("title", "Title").
+		Required(true).
+		HelpText("The title of the task")
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	form.DateTimeField("due_date", "Due Date").
+		Required(true).
+		HelpText("When the task is due, such as 2024-05-03T15:00:00Z. Times without a zone are UTC.")
+
+	shared.RegisterOwnersProps(form)
+
+	registerTargetableProps(form, true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateTaskAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateTaskAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createTaskActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Title) == "" {
+		return nil, errors.New("title is required")
+	}
+	if strings.TrimSpace(input.DueDate) == "" {
+		return nil, errors.New("due date is required")
+	}
+
+	task := map[string]interface{}{"title": input.Title}
+	shared.UpdateField(task, "description", input.Description)
+	if err := shared.SetTime(task, "due_date", input.DueDate); err != nil {
+		return nil, err
+	}
+	if err := shared.SetID(task, "owner_id", input.OwnerID); err != nil {
+		return nil, err
+	}
+	if err := setTargetable(task, input.TargetableType, input.TargetableID, true); err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshworksDomain, apiKey, "task", "", task)
+}
+
+func NewCreateTaskAction() sdk.Action {
+	return &CreateTaskAction{}
+}
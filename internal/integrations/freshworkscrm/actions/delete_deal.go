@@ -0,0 +1,78 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type DeleteDealAction struct{}
+
+// Metadata returns metadata about the action
+func (a *DeleteDealAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_deal",
+		DisplayName:   "Delete Deal",
+		Description:   "Delete a Freshworks CRM deal.",
+		Type:          core.ActionTypeAction,
+		Documentation: deleteDealDocs,
+		SampleOutput: map[string]any{
+			"deal_id": 70000054321,
+			"deleted": true,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *DeleteDealAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_deal", "Delete Deal")
+
+	registerDealIDProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *DeleteDealAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *DeleteDealAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getDealActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("deal_id", input.DealID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.DeleteRecord(freshworksDomain, apiKey, "deal", formatID(id)); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"deal_id": id,
+		"deleted": true,
+	}, nil
+}
+
+func NewDeleteDealAction() sdk.Action {
+	return &DeleteDealAction{}
+}
@@ -0,0 +1,78 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type DeleteSalesAccountAction struct{}
+
+// Metadata returns metadata about the action
+func (a *DeleteSalesAccountAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_sales_account",
+		DisplayName:   "Delete Sales Account",
+		Description:   "Delete a Freshworks CRM sales account.",
+		Type:          core.ActionTypeAction,
+		Documentation: deleteSalesAccountDocs,
+		SampleOutput: map[string]any{
+			"sales_account_id": 70000012345,
+			"deleted":          true,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *DeleteSalesAccountAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_sales_account", "Delete Sales Account")
+
+	registerSalesAccountIDProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *DeleteSalesAccountAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *DeleteSalesAccountAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getSalesAccountActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("sales_account_id", input.SalesAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.DeleteRecord(freshworksDomain, apiKey, "sales_account", formatID(id)); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"sales_account_id": id,
+		"deleted":          true,
+	}, nil
+}
+
+func NewDeleteSalesAccountAction() sdk.Action {
+	return &DeleteSalesAccountAction{}
+}
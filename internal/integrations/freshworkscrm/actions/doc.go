@@ -10,3 +10,39 @@ var updateContactDocs string
 
 //go:embed list_contacts.md
 var listContactsDocs string
+
+//go:embed upsert_contact.md
+var upsertContactDocs string
+
+//go:embed create_sales_account.md
+var createSalesAccountDocs string
+
+//go:embed update_sales_account.md
+var updateSalesAccountDocs string
+
+//go:embed get_sales_account.md
+var getSalesAccountDocs string
+
+//go:embed delete_sales_account.md
+var deleteSalesAccountDocs string
+
+//go:embed create_deal.md
+var createDealDocs string
+
+//go:embed update_deal.md
+var updateDealDocs string
+
+//go:embed get_deal.md
+var getDealDocs string
+
+//go:embed delete_deal.md
+var deleteDealDocs string
+
+//go:embed create_note.md
+var createNoteDocs string
+
+//go:embed create_task.md
+var createTaskDocs string
+
+//go:embed create_appointment.md
+var createAppointmentDocs string
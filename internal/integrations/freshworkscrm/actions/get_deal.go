@@ -0,0 +1,83 @@
+package actions
+
+import (
+	"encoding/json"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type getDealActionProps struct {
+	DealID json.Number `json:"deal_id"`
+}
+
+type GetDealAction struct{}
+
+// Metadata returns metadata about the action
+func (a *GetDealAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_deal",
+		DisplayName:   "Get Deal",
+		Description:   "Retrieve a Freshworks CRM deal by its ID.",
+		Type:          core.ActionTypeAction,
+		Documentation: getDealDocs,
+		SampleOutput:  dealSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *GetDealAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_deal", "Get Deal")
+
+	registerDealIDProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *GetDealAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *GetDealAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getDealActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("deal_id", input.DealID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.GetRecord(freshworksDomain, apiKey, "deal", formatID(id))
+}
+
+// registerDealIDProps adds the field of the deal an
+// action works on.
+func registerDealIDProps(form *smartform.FormBuilder) {
+	form.TextField("deal_id", "Deal ID").
+		Placeholder("Enter the deal ID").
+		Required(true).
+		HelpText("The ID of the deal, as shown in its URL")
+}
+
+func NewGetDealAction() sdk.Action {
+	return &GetDealAction{}
+}
@@ -0,0 +1,88 @@
+package actions
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type getSalesAccountActionProps struct {
+	SalesAccountID json.Number `json:"sales_account_id"`
+}
+
+type GetSalesAccountAction struct{}
+
+// Metadata returns metadata about the action
+func (a *GetSalesAccountAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_sales_account",
+		DisplayName:   "Get Sales Account",
+		Description:   "Retrieve a Freshworks CRM sales account by its ID.",
+		Type:          core.ActionTypeAction,
+		Documentation: getSalesAccountDocs,
+		SampleOutput:  salesAccountSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *GetSalesAccountAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_sales_account", "Get Sales Account")
+
+	registerSalesAccountIDProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *GetSalesAccountAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *GetSalesAccountAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getSalesAccountActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("sales_account_id", input.SalesAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.GetRecord(freshworksDomain, apiKey, "sales_account", formatID(id))
+}
+
+// registerSalesAccountIDProps adds the field of the sales account an
+// action works on.
+func registerSalesAccountIDProps(form *smartform.FormBuilder) {
+	form.TextField("sales_account_id", "Sales Account ID").
+		Placeholder("Enter the sales account ID").
+		Required(true).
+		HelpText("The ID of the sales account, as shown in its URL")
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func NewGetSalesAccountAction() sdk.Action {
+	return &GetSalesAccountAction{}
+}
@@ -1,11 +1,11 @@
 package actions
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -107,18 +107,16 @@ func (a *ListContactsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		return nil, err
 	}
 
-	if authCtx.Extra["api-key"] == "" || authCtx.Extra["domain"] == "" {
-		return nil, errors.New("missing freshworks auth parameters")
-	}
-
 	// Use the InputToTypeSafely helper function to convert the input to our struct
 	input, err := sdk.InputToTypeSafely[listContactsActionProps](ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	domain := authCtx.Extra["domain"]
-	freshworksDomain := "https://" + domain + ".myfreshworks.com"
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
 	if input.Page <= 0 {
 		input.Page = 1
@@ -136,7 +134,7 @@ func (a *ListContactsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		queryParams["filter"] = input.FilterBy
 	}
 
-	response, err := shared.ListContacts(freshworksDomain, authCtx.Extra["api-key"], queryParams)
+	response, err := shared.ListContacts(freshworksDomain, apiKey, queryParams)
 	if err != nil {
 		return nil, fmt.Errorf("error listing contacts: %v", err)
 	}
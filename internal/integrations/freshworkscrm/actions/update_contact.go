@@ -1,10 +1,10 @@
 package actions
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -92,18 +92,16 @@ func (a *UpdateContactAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		return nil, err
 	}
 
-	if authCtx.Extra["api-key"] == "" || authCtx.Extra["domain"] == "" {
-		return nil, errors.New("missing freshworks auth parameters")
-	}
-
 	// Use the InputToTypeSafely helper function to convert the input to our struct
 	input, err := sdk.InputToTypeSafely[updateContactActionProps](ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	domain := authCtx.Extra["domain"]
-	freshworksDomain := "https://" + domain + ".myfreshworks.com"
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
 	contact := make(map[string]interface{})
 
@@ -116,7 +114,7 @@ func (a *UpdateContactAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		"contact": contact,
 	}
 
-	response, err := shared.UpdateContact(freshworksDomain, authCtx.Extra["api-key"], input.ContactID, contactData)
+	response, err := shared.UpdateContact(freshworksDomain, apiKey, input.ContactID, contactData)
 	if err != nil {
 		return nil, fmt.Errorf("error updating contact:  %v", err)
 	}
@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type UpdateDealAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateDealAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_deal",
+		DisplayName:   "Update Deal",
+		Description:   "Update a Freshworks CRM deal. Fields left empty are kept.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateDealDocs,
+		SampleOutput:  dealSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateDealAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_deal", "Update Deal")
+
+	registerDealIDProps(form)
+
+	form.TextField("name", "Name").
+		Required(false)
+
+	form.NumberField("amount", "Amount").
+		Required(false)
+
+	registerDealProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateDealAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateDealAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[dealActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("deal_id", input.DealID)
+	if err != nil {
+		return nil, err
+	}
+
+	deal, err := dealFrom(input)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshworksDomain, apiKey, "deal", formatID(id), deal)
+}
+
+func NewUpdateDealAction() sdk.Action {
+	return &UpdateDealAction{}
+}
@@ -0,0 +1,78 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type UpdateSalesAccountAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateSalesAccountAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_sales_account",
+		DisplayName:   "Update Sales Account",
+		Description:   "Update a Freshworks CRM sales account. Fields left empty are kept.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateSalesAccountDocs,
+		SampleOutput:  salesAccountSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateSalesAccountAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_sales_account", "Update Sales Account")
+
+	registerSalesAccountIDProps(form)
+
+	form.TextField("name", "Name").
+		Required(false)
+
+	registerSalesAccountProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateSalesAccountAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateSalesAccountAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[salesAccountActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := freshworks.ParseID("sales_account_id", input.SalesAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := salesAccountFrom(input)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SaveRecord(freshworksDomain, apiKey, "sales_account", formatID(id), account)
+}
+
+func NewUpdateSalesAccountAction() sdk.Action {
+	return &UpdateSalesAccountAction{}
+}
@@ -0,0 +1,132 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// contactFieldMap names the Freshworks CRM contact fields of the
+// standard contact fields. The company is linked as a sales account.
+var contactFieldMap = crm.FieldMap{
+	crm.FieldEmail:     "email",
+	crm.FieldPhone:     "mobile_number",
+	crm.FieldFirstName: "first_name",
+	crm.FieldLastName:  "last_name",
+	crm.FieldCompany:   "company",
+}
+
+type UpsertContactAction struct{}
+
+func (a *UpsertContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_contact",
+		DisplayName:   "Create or Update Contact",
+		Description:   "Find a Freshworks CRM contact by email and update it, or create it when there is none.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertContactDocs,
+		SampleOutput: crm.SampleOutput(map[string]any{
+			"id":            51,
+			"first_name":    "Jane",
+			"last_name":     "Doe",
+			"email":         "jane.doe@example.com",
+			"mobile_number": "+1234567890",
+			"tags":          []string{"lead"},
+		}),
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_contact", "Create or Update Contact")
+
+	crm.RegisterContactProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *UpsertContactAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// Perform writes the contact with its tags added to the ones it has.
+// Custom fields are given by their API name, such as cf_plan.
+func (a *UpsertContactAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := crm.ContactFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	contactMap, ignored := contactFieldMap.Standard(contact)
+	if contact.Company != "" {
+		delete(contactMap, "company")
+		accountID, err := shared.SalesAccountID(freshworksDomain, apiKey, contact.Company)
+		if err != nil {
+			return nil, err
+		}
+		contactMap["sales_accounts"] = []map[string]interface{}{
+			{"id": accountID, "is_primary": true},
+		}
+	}
+	if len(contact.Fields) > 0 {
+		contactMap["custom_field"] = contact.Fields
+	}
+
+	existing, err := shared.FindContactByEmail(freshworksDomain, apiKey, contact.Email)
+	if err != nil {
+		return nil, fmt.Errorf("error finding contact: %v", err)
+	}
+
+	// The lookup tells whether the contact is new and which tags to keep;
+	// the upsert matches the contact by email itself.
+	var tags []string
+	if existing != nil {
+		if current, ok := existing["tags"].([]interface{}); ok {
+			for _, tag := range current {
+				tags = append(tags, fmt.Sprint(tag))
+			}
+		}
+	}
+	if len(contact.Tags) > 0 {
+		known := map[string]string{}
+		for _, tag := range tags {
+			known[strings.ToLower(tag)] = tag
+		}
+		contactMap["tags"] = append(tags, crm.MissingTags(contact.Tags, known)...)
+	}
+
+	saved, err := shared.UpsertContact(freshworksDomain, apiKey, contact.Email, contactMap)
+	if err != nil {
+		return nil, fmt.Errorf("error saving contact: %v", err)
+	}
+
+	return crm.Result{
+		ID:      fmt.Sprint(saved["id"]),
+		Created: existing == nil,
+		Contact: saved,
+		Ignored: ignored,
+	}.Output(), nil
+}
+
+func NewUpsertContactAction() sdk.Action {
+	return &UpsertContactAction{}
+}
@@ -3,8 +3,8 @@ package freshworkscrm
 import (
 	_ "embed"
 
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/actions"
-	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
 	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/triggers"
 
 	"github.com/wakflo/go-sdk/v2"
@@ -28,7 +28,7 @@ func (n *FreshWorksCRM) Metadata() sdk.IntegrationMetadata {
 func (n *FreshWorksCRM) Auth() *core.AuthMetadata {
 	return &core.AuthMetadata{
 		Required: true,
-		Schema:   shared.FreshworksCRMSharedAuth,
+		Schema:   freshworks.CRM.AuthSchema(),
 	}
 }
 
@@ -43,6 +43,18 @@ func (n *FreshWorksCRM) Actions() []sdk.Action {
 		actions.NewCreateNewContactAction(),
 		actions.NewUpdateContactAction(),
 		actions.NewListContactsAction(),
+		actions.NewUpsertContactAction(),
+		actions.NewCreateSalesAccountAction(),
+		actions.NewUpdateSalesAccountAction(),
+		actions.NewGetSalesAccountAction(),
+		actions.NewDeleteSalesAccountAction(),
+		actions.NewCreateDealAction(),
+		actions.NewUpdateDealAction(),
+		actions.NewGetDealAction(),
+		actions.NewDeleteDealAction(),
+		actions.NewCreateNoteAction(),
+		actions.NewCreateTaskAction(),
+		actions.NewCreateAppointmentAction(),
 	}
 }
 
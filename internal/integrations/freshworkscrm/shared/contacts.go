@@ -0,0 +1,133 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// request calls the Freshworks CRM API and decodes the JSON response,
+// returning error responses as errors.
+func request(baseURL, apiKey, method, path string, payload interface{}) (map[string]interface{}, error) {
+	body, err := send(baseURL, apiKey, method, path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON: %v", err)
+		}
+	}
+	return result, nil
+}
+
+// send calls the Freshworks CRM API and returns the response body,
+// returning error responses as errors.
+func send(baseURL, apiKey, method, path string, payload interface{}) ([]byte, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		jsonData, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal JSON: %v", err)
+		}
+		reqBody = bytes.NewBuffer(jsonData)
+	}
+
+	req, err := http.NewRequest(method, baseURL+"/crm/sales/api"+path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	freshworks.CRM.Authorize(req, apiKey)
+
+	resp, err := NewFreshWorksAPIClient(baseURL, apiKey).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("freshworks CRM error (%d): %s", resp.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// lookup returns the records of an entity whose field equals the value.
+func lookup(baseURL, apiKey, entity, field, value string) ([]map[string]interface{}, error) {
+	query := url.Values{"q": {value}, "f": {field}, "entities": {entity}}
+	result, err := request(baseURL, apiKey, http.MethodGet, "/lookup?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	group, _ := result[entity+"s"].(map[string]interface{})
+	list, _ := group[entity+"s"].([]interface{})
+	records := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		if record, ok := item.(map[string]interface{}); ok {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// FindContactByEmail returns the contact with the email address, with
+// its tags, or nil when there is none.
+func FindContactByEmail(baseURL, apiKey, email string) (map[string]interface{}, error) {
+	contacts, err := lookup(baseURL, apiKey, "contact", "email", email)
+	if err != nil || len(contacts) == 0 {
+		return nil, err
+	}
+	result, err := request(baseURL, apiKey, http.MethodGet, fmt.Sprintf("/contacts/%v", contacts[0]["id"]), nil)
+	if err != nil {
+		return nil, err
+	}
+	contact, _ := result["contact"].(map[string]interface{})
+	return contact, nil
+}
+
+// UpsertContact creates or updates the contact with the email in one
+// request, so concurrent runs can't create it twice.
+func UpsertContact(baseURL, apiKey, email string, contact map[string]interface{}) (map[string]interface{}, error) {
+	result, err := request(baseURL, apiKey, http.MethodPost, "/contacts/upsert", map[string]interface{}{
+		"unique_identifier": map[string]interface{}{"emails": email},
+		"contact":           contact,
+	})
+	if err != nil {
+		return nil, err
+	}
+	saved, _ := result["contact"].(map[string]interface{})
+	return saved, nil
+}
+
+// SalesAccountID returns the ID of the account with the name, creating
+// the account when there is none.
+func SalesAccountID(baseURL, apiKey, name string) (interface{}, error) {
+	accounts, err := lookup(baseURL, apiKey, "sales_account", "name", name)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if strings.EqualFold(fmt.Sprint(account["name"]), name) {
+			return account["id"], nil
+		}
+	}
+
+	result, err := request(baseURL, apiKey, http.MethodPost, "/sales_accounts",
+		map[string]interface{}{"sales_account": map[string]interface{}{"name": name}})
+	if err != nil {
+		return nil, fmt.Errorf("creating account %q: %w", name, err)
+	}
+	account, _ := result["sales_account"].(map[string]interface{})
+	return account["id"], nil
+}
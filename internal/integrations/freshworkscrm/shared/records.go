@@ -0,0 +1,229 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// The record types notes, tasks and appointments can be added to.
+var TargetableTypes = []*smartform.Option{
+	{Value: "Contact", Label: "Contact"},
+	{Value: "SalesAccount", Label: "Sales Account"},
+	{Value: "Deal", Label: "Deal"},
+}
+
+// recordPath is the path of the records of an entity such as "deal", or
+// of the record with the ID.
+func recordPath(entity, id string) string {
+	path := "/" + entity + "s"
+	if id != "" {
+		path += "/" + url.PathEscape(id)
+	}
+	return path
+}
+
+// GetRecord returns the record of an entity such as "sales_account" or
+// "deal".
+func GetRecord(baseURL, apiKey, entity, id string) (map[string]interface{}, error) {
+	result, err := request(baseURL, apiKey, http.MethodGet, recordPath(entity, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	record, ok := result[entity].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %s not found", entity)
+	}
+	return record, nil
+}
+
+// SaveRecord creates a record of an entity, or updates the record with
+// the ID, and returns it.
+func SaveRecord(baseURL, apiKey, entity, id string, record map[string]interface{}) (map[string]interface{}, error) {
+	method := http.MethodPost
+	if id != "" {
+		method = http.MethodPut
+	}
+	result, err := request(baseURL, apiKey, method, recordPath(entity, id), map[string]interface{}{entity: record})
+	if err != nil {
+		return nil, err
+	}
+	saved, _ := result[entity].(map[string]interface{})
+	return saved, nil
+}
+
+// DeleteRecord deletes the record of an entity.
+func DeleteRecord(baseURL, apiKey, entity, id string) error {
+	_, err := send(baseURL, apiKey, http.MethodDelete, recordPath(entity, id), nil)
+	return err
+}
+
+// SetIDs sets a field to the IDs of a comma-separated list, when there
+// are any.
+func SetIDs(record map[string]interface{}, key, text string) error {
+	ids, err := freshworks.ParseIDs(text)
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		record[key] = ids
+	}
+	return nil
+}
+
+// SetID sets a field to an ID, when it is given.
+func SetID(record map[string]interface{}, key string, value json.Number) error {
+	if strings.TrimSpace(string(value)) == "" {
+		return nil
+	}
+	id, err := freshworks.ParseID(key, value)
+	if err != nil {
+		return err
+	}
+	record[key] = id
+	return nil
+}
+
+// timeLayouts are the layouts dates and times are read in, with the
+// ones without a zone read as UTC.
+var timeLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02"}
+
+// SetTime sets a field to a date and time in the format the API takes,
+// when it is given.
+func SetTime(record map[string]interface{}, key, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			record[key] = t.Format(time.RFC3339)
+			return nil
+		}
+	}
+	return fmt.Errorf("%s must be a date and time such as 2024-05-01T15:00:00Z, got %q", key, value)
+}
+
+// RegisterDealPipelinesProps adds a select of the deal pipelines.
+func RegisterDealPipelinesProps(form *smartform.FormBuilder, required bool) *smartform.FieldBuilder {
+	getPipelines := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		items, err := selectorOptions(ctx, "/selector/deal_pipelines", "deal_pipelines", "name")
+		if err != nil {
+			return nil, err
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("deal_pipeline_id", "Pipeline").
+		Placeholder("Select a pipeline").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getPipelines)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("The pipeline of the deal")
+}
+
+// RegisterDealStagesProps adds a select of the stages of the pipeline
+// chosen in the deal_pipeline_id field.
+func RegisterDealStagesProps(form *smartform.FormBuilder, required bool) *smartform.FieldBuilder {
+	getStages := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		input := sdk.DynamicInputToType[struct {
+			PipelineID string `json:"deal_pipeline_id"`
+		}](ctx)
+		if input.PipelineID == "" {
+			return ctx.Respond([]map[string]any{}, 0)
+		}
+
+		items, err := selectorOptions(ctx, "/selector/deal_pipelines/"+url.PathEscape(input.PipelineID)+"/deal_stages", "deal_stages", "name")
+		if err != nil {
+			return nil, err
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("deal_stage_id", "Stage").
+		Placeholder("Select a stage").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getStages)).
+				WithFieldReference("deal_pipeline_id", "deal_pipeline_id").
+				WithSearchSupport().
+				End().
+				RefreshOn("deal_pipeline_id").
+				GetDynamicSource(),
+		).
+		HelpText("The stage of the deal in the pipeline")
+}
+
+// RegisterOwnersProps adds a select of the users who can own records.
+func RegisterOwnersProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getOwners := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		items, err := selectorOptions(ctx, "/selector/owners", "users", "display_name")
+		if err != nil {
+			return nil, err
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("owner_id", "Owner").
+		Placeholder("Select an owner").
+		Required(false).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getOwners)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("The user who owns the record. Defaults to the API key's user.")
+}
+
+// selectorOptions returns the records of a selector endpoint as select
+// options named by the nameKey field.
+func selectorOptions(ctx sdkcontext.DynamicFieldContext, path, key, nameKey string) ([]map[string]any, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	baseURL, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := request(baseURL, apiKey, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	records, _ := result[key].([]interface{})
+	items := make([]map[string]any, 0, len(records))
+	for _, r := range records {
+		record, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := record[nameKey].(string); name != "" {
+			items = append(items, map[string]any{
+				"id":   fmt.Sprint(record["id"]),
+				"name": name,
+			})
+		}
+	}
+	return items, nil
+}
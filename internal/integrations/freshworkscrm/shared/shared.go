@@ -9,6 +9,7 @@ import (
 	"net/url"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/go-sdk/v2"
 
 	"github.com/gookit/goutil/arrutil"
@@ -63,8 +64,10 @@ func RegisterContactViewProps(form *smartform.FormBuilder) *smartform.FieldBuild
 			return nil, err
 		}
 
-		baseAPI := "https://" + authCtx.Extra["domain"] + ".myfreshworks.com"
-		apiKey := authCtx.Extra["api-key"]
+		baseAPI, apiKey, err := freshworks.CRM.Credentials(authCtx)
+		if err != nil {
+			return nil, err
+		}
 
 		// Build the request
 		req, err := http.NewRequest(http.MethodGet, baseAPI+"/crm/sales/api/contacts/filters", nil)
@@ -132,8 +135,10 @@ func RegisterContactsProps(form *smartform.FormBuilder) *smartform.FieldBuilder
 			ContactViewID string `json:"contact_view_id"`
 		}](ctx)
 
-		baseAPI := "https://" + authCtx.Extra["domain"] + ".myfreshworks.com"
-		apiKey := authCtx.Extra["api-key"]
+		baseAPI, apiKey, err := freshworks.CRM.Credentials(authCtx)
+		if err != nil {
+			return nil, err
+		}
 
 		request := fmt.Sprintf("%s/crm/sales/api/contacts/view/%s", baseAPI, input.ContactViewID)
 
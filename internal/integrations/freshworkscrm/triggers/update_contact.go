@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/freshworks"
 	"github.com/wakflo/extensions/internal/integrations/freshworkscrm/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -72,10 +73,6 @@ func (t *ContactUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.
 		return nil, err
 	}
 
-	if authCtx.Extra["api-key"] == "" || authCtx.Extra["domain"] == "" {
-		return nil, errors.New("missing freshworks auth parameters")
-	}
-
 	var lastRunTime *time.Time
 	lastRun, err := ctx.GetMetadata("lastRun")
 	if err == nil && lastRun != nil {
@@ -96,10 +93,12 @@ func (t *ContactUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.
 		queryParams["filter"] = filterJSON
 	}
 
-	domain := authCtx.Extra["domain"]
-	freshworksDomain := "https://" + domain + ".myfreshworks.com"
+	freshworksDomain, apiKey, err := freshworks.CRM.Credentials(authCtx)
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := shared.ListContacts(freshworksDomain, authCtx.Extra["api-key"], queryParams)
+	response, err := shared.ListContacts(freshworksDomain, apiKey, queryParams)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching contacts: %v", err)
 	}
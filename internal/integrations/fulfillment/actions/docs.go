@@ -0,0 +1,8 @@
+package actions
+
+import (
+	_ "embed"
+)
+
+//go:embed fulfill_order.md
+var fulfillOrderDocs string
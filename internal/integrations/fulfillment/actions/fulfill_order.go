@@ -0,0 +1,288 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/fulfillment/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type fulfillOrderActionProps struct {
+	Store            string `json:"store"`
+	Order            string `json:"order"`
+	Carrier          string `json:"carrier"`
+	PreferredCarrier string `json:"preferred_carrier"`
+	RegisterTracking *bool  `json:"register_tracking"`
+	NotifyCustomer   bool   `json:"notify_customer"`
+	DryRun           bool   `json:"dry_run"`
+}
+
+type FulfillOrderAction struct{}
+
+// Metadata returns metadata about the action
+func (a *FulfillOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "fulfill_order",
+		DisplayName:   "Fulfill Order",
+		Description:   "Ships a Shopify or WooCommerce order: quotes it with Shippo or AfterShip Shipping, buys the cheapest or preferred label, registers its tracking with AfterShip and writes the tracking back to the order.",
+		Type:          core.ActionTypeAction,
+		Documentation: fulfillOrderDocs,
+		SampleOutput: map[string]any{
+			"status":     "fulfilled",
+			"store":      "shopify",
+			"order_id":   "gid://shopify/Order/5512345678",
+			"order_name": "#1001",
+			"ship_to": map[string]any{
+				"name":    "Jane Doe",
+				"street1": "1 Main St",
+				"city":    "Springfield",
+				"state":   "IL",
+				"zip":     "62701",
+				"country": "US",
+			},
+			"rate": map[string]any{
+				"provider":     "shippo",
+				"rate_id":      "545ab0a1a6ea4c9f9adb2512a57d6d8b",
+				"carrier":      "USPS",
+				"service":      "Priority Mail",
+				"service_code": "usps_priority",
+				"amount":       7.58,
+				"currency":     "USD",
+			},
+			"label": map[string]any{
+				"provider":        "shippo",
+				"label_id":        "70ae8117ee1749e393f249d5b77c45e0",
+				"tracking_number": "9400100000000000000000",
+				"tracking_url":    "https://tools.usps.com/go/TrackConfirmAction_input?origTrackNum=9400100000000000000000",
+				"label_url":       "https://shippo-delivery.s3.amazonaws.com/70ae8117ee1749e393f249d5b77c45e0.pdf",
+				"carrier":         "USPS",
+				"service":         "Priority Mail",
+			},
+			"tracking": map[string]any{
+				"provider":        "aftership",
+				"id":              "a8b4d2c1e3f5",
+				"tracking_number": "9400100000000000000000",
+				"carrier":         "usps",
+				"status":          "pending",
+			},
+			"store_update": []map[string]any{
+				{"id": "gid://shopify/Fulfillment/4412345678", "status": "SUCCESS"},
+			},
+			"steps": []map[string]any{
+				{"step": "get_order", "status": "done", "detail": "#1001"},
+				{"step": "get_rates", "status": "done", "detail": "4 rates, picked USPS Priority Mail at 7.58 USD"},
+				{"step": "buy_label", "status": "done", "detail": "9400100000000000000000"},
+				{"step": "register_tracking", "status": "done", "detail": "usps"},
+				{"step": "update_store", "status": "done"},
+			},
+			"rollback": []string{},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *FulfillOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("fulfill_order", "Fulfill Order")
+
+	form.SelectField("store", "Store").
+		Required(true).
+		AddOptions(shared.StoreOptions...).
+		HelpText("The store of the order. Its credentials must be set on the connection.")
+
+	form.TextField("order", "Order").
+		Required(true).
+		HelpText("The order ID, or a Shopify order name such as #1001.")
+
+	form.SelectField("carrier", "Label Provider").
+		Required(true).
+		AddOptions(shared.CarrierOptions...).
+		HelpText("Where to quote the shipment and buy the label.")
+
+	shipping.RegisterAddressProps(form, "from", "Ship From")
+	shipping.RegisterParcelProps(form)
+	shipping.RegisterContentsProps(form)
+	shipping.RegisterRateProps(form)
+
+	form.TextField("preferred_carrier", "Preferred Carrier").
+		Required(false).
+		HelpText("Only pick among this carrier's rates, such as usps or DHL Express, when it has any.")
+
+	form.CheckboxField("register_tracking", "Register Tracking").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Register the label's tracking with AfterShip. Needs the AfterShip Tracking key on the connection.")
+
+	form.CheckboxField("notify_customer", "Notify Customer").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Let the store and AfterShip email the customer the tracking.")
+
+	form.CheckboxField("dry_run", "Dry Run").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Read the order and pick the rate without buying anything.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *FulfillOrderAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input. Bad
+// input and missing credentials are errors; once the steps start, their
+// failures are reported in the result, with what to undo.
+func (a *FulfillOrderAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	props, err := sdk.InputToTypeSafely[fulfillOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if props.Order == "" {
+		return nil, errors.New("order is required")
+	}
+
+	input := ctx.Input()
+	from := shipping.AddressFrom(input, "from")
+	if err := from.Validate(); err != nil {
+		return nil, fmt.Errorf("ship from: %w", err)
+	}
+	parcel, err := shipping.ParcelFrom(input)
+	if err != nil {
+		return nil, err
+	}
+
+	conns, err := shared.GetConnections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	store, err := conns.Store(props.Store)
+	if err != nil {
+		return nil, err
+	}
+	carrier, err := conns.Carrier(props.Carrier)
+	if err != nil {
+		return nil, err
+	}
+
+	result := shipping.NewFulfillmentResult(props.Store, props.Order)
+
+	order, err := store.Order(props.Order)
+	if err != nil {
+		result.Fail(shipping.StepOrder, err)
+		return result, nil
+	}
+	result.OrderID = order.ID
+	result.OrderName = order.Name
+	result.ShipTo = &order.ShipTo
+	if order.Fulfilled {
+		result.Fail(shipping.StepOrder, errors.New("the order is already fulfilled"))
+		return result, nil
+	}
+	if err := order.ShipTo.Validate(); err != nil {
+		result.Fail(shipping.StepOrder, fmt.Errorf("ship to: %w", err))
+		return result, nil
+	}
+	result.Done(shipping.StepOrder, order.Name)
+
+	shipment := shared.Shipment{
+		From:     from,
+		To:       order.ShipTo,
+		Parcel:   parcel,
+		Contents: shipping.ContentsFrom(input),
+	}
+	rates, err := carrier.Rates(shipment)
+	if err != nil {
+		result.Fail(shipping.StepRates, err)
+		return result, nil
+	}
+	candidates, note := preferCarrier(rates, props.PreferredCarrier)
+	rate, err := shipping.PickRate(input, candidates)
+	if err != nil {
+		result.Fail(shipping.StepRates, err)
+		return result, nil
+	}
+	result.Rate = rate
+	result.Done(shipping.StepRates, fmt.Sprintf("%d rates, picked %s %s at %.2f %s%s",
+		len(rates), rate.Carrier, rate.Service, rate.Amount, rate.Currency, note))
+
+	if props.DryRun {
+		result.Skip(shipping.StepLabel, "dry run")
+		result.Skip(shipping.StepTracking, "dry run")
+		result.Skip(shipping.StepWriteBack, "dry run")
+		return result, nil
+	}
+
+	label, err := carrier.Buy(shipment, rate)
+	if err != nil {
+		result.Fail(shipping.StepLabel, err)
+		return result, nil
+	}
+	result.Label = label
+	result.Done(shipping.StepLabel, label.TrackingNumber)
+
+	// Tracking is a convenience: the order is fulfilled without it.
+	if props.RegisterTracking != nil && !*props.RegisterTracking {
+		result.Skip(shipping.StepTracking, "turned off")
+	} else {
+		tracking, err := conns.RegisterTracking(order, label, props.NotifyCustomer)
+		switch {
+		case errors.Is(err, shared.ErrNoTrackingKey):
+			result.Skip(shipping.StepTracking, err.Error())
+		case err != nil:
+			result.Warn(shipping.StepTracking, err)
+		default:
+			result.Tracking = tracking
+			result.Done(shipping.StepTracking, tracking.Carrier)
+		}
+	}
+
+	update, err := store.Fulfill(order, label, props.NotifyCustomer)
+	if err != nil {
+		result.Fail(shipping.StepWriteBack, err)
+		return result, nil
+	}
+	result.StoreUpdate = update
+	result.Done(shipping.StepWriteBack, "")
+	result.Complete()
+
+	return result, nil
+}
+
+// preferCarrier narrows the rates to the preferred carrier's, matched by
+// courier when known. Without rates of that carrier every rate is kept,
+// and the returned note says so.
+func preferCarrier(rates []shipping.Rate, preferred string) ([]shipping.Rate, string) {
+	if preferred == "" {
+		return rates, ""
+	}
+	want, known := shipping.MatchCourier(preferred)
+
+	var matched []shipping.Rate
+	for _, r := range rates {
+		if strings.EqualFold(r.Carrier, preferred) {
+			matched = append(matched, r)
+			continue
+		}
+		if c, ok := shipping.MatchCourier(r.Carrier); known && ok && c.Code == want.Code {
+			matched = append(matched, r)
+		}
+	}
+	if len(matched) == 0 {
+		return rates, fmt.Sprintf(" (no %s rates)", preferred)
+	}
+	return matched, ""
+}
+
+func NewFulfillOrderAction() sdk.Action {
+	return &FulfillOrderAction{}
+}
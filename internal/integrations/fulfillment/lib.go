@@ -0,0 +1,45 @@
+package fulfillment
+
+import (
+	_ "embed"
+
+	"github.com/wakflo/extensions/internal/integrations/fulfillment/actions"
+	"github.com/wakflo/extensions/internal/integrations/fulfillment/shared"
+	"github.com/wakflo/go-sdk/v2"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+//go:embed README.md
+var ReadME string
+
+//go:embed flo.toml
+var Flow string
+
+var Integration = sdk.Register(NewFulfillment())
+
+type Fulfillment struct{}
+
+func (n *Fulfillment) Metadata() sdk.IntegrationMetadata {
+	return sdk.LoadMetadataFromFlo(Flow, ReadME)
+}
+
+func (n *Fulfillment) Auth() *core.AuthMetadata {
+	return &core.AuthMetadata{
+		Required: true,
+		Schema:   shared.SharedAuth,
+	}
+}
+
+func (n *Fulfillment) Triggers() []sdk.Trigger {
+	return []sdk.Trigger{}
+}
+
+func (n *Fulfillment) Actions() []sdk.Action {
+	return []sdk.Action{
+		actions.NewFulfillOrderAction(),
+	}
+}
+
+func NewFulfillment() sdk.Integration {
+	return &Fulfillment{}
+}
@@ -0,0 +1,70 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"errors"
+
+	aftershipshared "github.com/wakflo/extensions/internal/integrations/aftership/shared"
+	shipposhared "github.com/wakflo/extensions/internal/integrations/shippo/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+)
+
+type shippoCarrier struct {
+	apiKey string
+}
+
+func newShippoCarrier(c Connections) (*shippoCarrier, error) {
+	if c["shippo_api_key"] == "" {
+		return nil, errors.New("the connection has no Shippo API key")
+	}
+	return &shippoCarrier{apiKey: c["shippo_api_key"]}, nil
+}
+
+// Rates quotes the shipment. Shippo doesn't need the contents for rates.
+func (s *shippoCarrier) Rates(sh Shipment) ([]shipping.Rate, error) {
+	shipment, err := shipposhared.CreateShipment(s.apiKey, sh.From, sh.To, sh.Parcel, false)
+	if err != nil {
+		return nil, err
+	}
+	return shipment.NormalizedRates(), nil
+}
+
+func (s *shippoCarrier) Buy(_ Shipment, rate *shipping.Rate) (*shipping.Label, error) {
+	return shipposhared.BuyLabel(s.apiKey, rate, false)
+}
+
+type afterShipCarrier struct {
+	apiKey   string
+	accounts []string
+}
+
+func newAfterShipCarrier(c Connections) (*afterShipCarrier, error) {
+	if c["aftership_shipping_api_key"] == "" {
+		return nil, errors.New("the connection has no AfterShip Shipping API key")
+	}
+	return &afterShipCarrier{
+		apiKey:   c["aftership_shipping_api_key"],
+		accounts: shipping.SplitList(c["aftership_shipper_accounts"]),
+	}, nil
+}
+
+func (a *afterShipCarrier) Rates(sh Shipment) ([]shipping.Rate, error) {
+	return aftershipshared.GetRates(a.apiKey, a.accounts, sh.From, sh.To, sh.Parcel, sh.Contents)
+}
+
+func (a *afterShipCarrier) Buy(sh Shipment, rate *shipping.Rate) (*shipping.Label, error) {
+	return aftershipshared.CreateLabel(a.apiKey, sh.From, sh.To, sh.Parcel, sh.Contents, rate, false)
+}
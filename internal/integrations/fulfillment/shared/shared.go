@@ -0,0 +1,155 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/shipping"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// Store names.
+const (
+	Shopify     = "shopify"
+	WooCommerce = "woocommerce"
+)
+
+// Carrier names.
+const (
+	Shippo    = "shippo"
+	AfterShip = "aftership"
+)
+
+var StoreOptions = []*smartform.Option{
+	{Value: Shopify, Label: "Shopify"},
+	{Value: WooCommerce, Label: "WooCommerce"},
+}
+
+var CarrierOptions = []*smartform.Option{
+	{Value: Shippo, Label: "Shippo"},
+	{Value: AfterShip, Label: "AfterShip Shipping"},
+}
+
+var (
+	form = smartform.NewAuthForm("fulfillment-auth", "Order Fulfillment Connections", smartform.AuthStrategyCustom)
+
+	_ = form.TextField("shopify_domain", "Shopify Shop Name").
+		Required(false).
+		HelpText("The shop name in your admin URL: **example** for https://example.myshopify.com/admin.")
+
+	_ = form.TextField("shopify_token", "Shopify Admin Token").
+		Required(false).
+		HelpText("An admin API token with the read_orders, read_merchant_managed_fulfillment_orders and write_merchant_managed_fulfillment_orders scopes.")
+
+	_ = form.TextField("woocommerce_url", "WooCommerce Store URL").
+		Required(false).
+		HelpText("For example https://shop.example.com.")
+
+	_ = form.TextField("woocommerce_consumer_key", "WooCommerce Consumer Key").
+		Required(false)
+
+	_ = form.TextField("woocommerce_consumer_secret", "WooCommerce Consumer Secret").
+		Required(false)
+
+	_ = form.TextField("shippo_api_key", "Shippo API Key").
+		Required(false).
+		HelpText("A live or test token from the API page of your Shippo settings.")
+
+	_ = form.TextField("aftership_shipping_api_key", "AfterShip Shipping API Key").
+		Required(false).
+		HelpText("An API key of AfterShip Shipping, to buy labels through it.")
+
+	_ = form.TextField("aftership_shipper_accounts", "AfterShip Shipper Account IDs").
+		Required(false).
+		HelpText("The carrier accounts of AfterShip Shipping to quote, separated by commas.")
+
+	_ = form.TextField("aftership_tracking_api_key", "AfterShip Tracking API Key").
+		Required(false).
+		HelpText("An API key of AfterShip Tracking, to register the labels bought. Leave empty to skip.")
+
+	SharedAuth = form.Build()
+)
+
+// Order is the part of a store order fulfillment needs.
+type Order struct {
+	ID        string
+	Name      string
+	Email     string
+	ShipTo    shipping.Address
+	Fulfilled bool
+}
+
+// Store reads orders and records their shipment.
+type Store interface {
+	Order(ref string) (*Order, error)
+	// Fulfill marks the order shipped with the label's tracking and
+	// returns what the store made of it.
+	Fulfill(order *Order, label *shipping.Label, notifyCustomer bool) (interface{}, error)
+}
+
+// Shipment is what a carrier quotes and ships.
+type Shipment struct {
+	From     shipping.Address
+	To       shipping.Address
+	Parcel   shipping.Parcel
+	Contents shipping.Contents
+}
+
+// Carrier quotes shipments and buys labels.
+type Carrier interface {
+	Rates(s Shipment) ([]shipping.Rate, error)
+	Buy(s Shipment, rate *shipping.Rate) (*shipping.Label, error)
+}
+
+// Connections are the credentials of the connection.
+type Connections map[string]string
+
+// GetConnections returns the credentials of the connection.
+func GetConnections(ctx sdkcontext.BaseContext) (Connections, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	if authCtx.Extra == nil {
+		return Connections{}, nil
+	}
+	return Connections(authCtx.Extra), nil
+}
+
+// Store connects to the named store.
+func (c Connections) Store(name string) (Store, error) {
+	switch name {
+	case Shopify:
+		return newShopifyStore(c)
+	case WooCommerce:
+		return newWooCommerceStore(c)
+	default:
+		return nil, fmt.Errorf("unknown store %q", name)
+	}
+}
+
+// Carrier connects to the named carrier.
+func (c Connections) Carrier(name string) (Carrier, error) {
+	switch name {
+	case Shippo:
+		return newShippoCarrier(c)
+	case AfterShip:
+		return newAfterShipCarrier(c)
+	default:
+		return nil, fmt.Errorf("unknown carrier %q", name)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	shopifyshared "github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	wooshared "github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+)
+
+const shopifyOrderFields = `
+	id name email displayFulfillmentStatus
+	shippingAddress { name company address1 address2 city provinceCode zip countryCodeV2 phone }
+`
+
+const shopifyOrderQuery = `query($id: ID!) {
+  order(id: $id) {` + shopifyOrderFields + `}
+}`
+
+const shopifyOrderByNameQuery = `query($query: String!) {
+  orders(first: 1, query: $query) {
+    nodes {` + shopifyOrderFields + `}
+  }
+}`
+
+type shopifyOrder struct {
+	ID                       string `json:"id"`
+	Name                     string `json:"name"`
+	Email                    string `json:"email"`
+	DisplayFulfillmentStatus string `json:"displayFulfillmentStatus"`
+	ShippingAddress          *struct {
+		Name          string `json:"name"`
+		Company       string `json:"company"`
+		Address1      string `json:"address1"`
+		Address2      string `json:"address2"`
+		City          string `json:"city"`
+		ProvinceCode  string `json:"provinceCode"`
+		Zip           string `json:"zip"`
+		CountryCodeV2 string `json:"countryCodeV2"`
+		Phone         string `json:"phone"`
+	} `json:"shippingAddress"`
+}
+
+type shopifyStore struct {
+	client *goshopify.Client
+}
+
+func newShopifyStore(c Connections) (*shopifyStore, error) {
+	if c["shopify_domain"] == "" || c["shopify_token"] == "" {
+		return nil, errors.New("the connection has no Shopify shop name and admin token")
+	}
+	client, err := shopifyshared.NewGraphQLClient(c["shopify_domain"], c["shopify_token"])
+	if err != nil {
+		return nil, err
+	}
+	return &shopifyStore{client: client}, nil
+}
+
+// Order reads an order by ID, GID, or name such as #1001.
+func (s *shopifyStore) Order(ref string) (*Order, error) {
+	var order *shopifyOrder
+	if strings.HasPrefix(ref, "#") {
+		var resp struct {
+			Orders struct {
+				Nodes []shopifyOrder `json:"nodes"`
+			} `json:"orders"`
+		}
+		vars := map[string]interface{}{"query": "name:" + strconv.Quote(ref)}
+		if err := s.client.GraphQL.Query(context.Background(), shopifyOrderByNameQuery, vars, &resp); err != nil {
+			return nil, err
+		}
+		if len(resp.Orders.Nodes) > 0 {
+			order = &resp.Orders.Nodes[0]
+		}
+	} else {
+		gid, err := shopifyshared.GID("Order", ref)
+		if err != nil {
+			return nil, err
+		}
+		var resp struct {
+			Order *shopifyOrder `json:"order"`
+		}
+		if err := s.client.GraphQL.Query(context.Background(), shopifyOrderQuery, map[string]interface{}{"id": gid}, &resp); err != nil {
+			return nil, err
+		}
+		order = resp.Order
+	}
+	if order == nil {
+		return nil, fmt.Errorf("no Shopify order %s", ref)
+	}
+
+	out := &Order{
+		ID:        order.ID,
+		Name:      order.Name,
+		Email:     order.Email,
+		Fulfilled: order.DisplayFulfillmentStatus == "FULFILLED",
+	}
+	if a := order.ShippingAddress; a != nil {
+		out.ShipTo = shipping.Address{
+			Name:    a.Name,
+			Company: a.Company,
+			Street1: a.Address1,
+			Street2: a.Address2,
+			City:    a.City,
+			State:   a.ProvinceCode,
+			Zip:     a.Zip,
+			Country: a.CountryCodeV2,
+			Phone:   a.Phone,
+			Email:   order.Email,
+		}
+	}
+	return out, nil
+}
+
+// Fulfill fulfills every open item of the order with the label's
+// tracking. The company is Shopify's name for the carrier when known, so
+// Shopify links the tracking page.
+func (s *shopifyStore) Fulfill(order *Order, label *shipping.Label, notifyCustomer bool) (interface{}, error) {
+	company := label.Carrier
+	if c, ok := shipping.MatchCourier(label.Carrier); ok {
+		company = c.Name
+	}
+	fulfillments, err := shopifyshared.CreateFulfillments(context.Background(), s.client, order.ID, shopifyshared.FulfillmentRequest{
+		Tracking: shopifyshared.FulfillmentTracking{
+			Company: company,
+			Number:  label.TrackingNumber,
+			URL:     label.TrackingURL,
+		},
+		NotifyCustomer: notifyCustomer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]map[string]interface{}, 0, len(fulfillments))
+	for _, f := range fulfillments {
+		out = append(out, f.Output())
+	}
+	return out, nil
+}
+
+type wooCommerceOrder struct {
+	ID       int                `json:"id"`
+	Number   string             `json:"number"`
+	Status   string             `json:"status"`
+	Billing  wooCommerceAddress `json:"billing"`
+	Shipping wooCommerceAddress `json:"shipping"`
+}
+
+type wooCommerceAddress struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Company   string `json:"company"`
+	Address1  string `json:"address_1"`
+	Address2  string `json:"address_2"`
+	City      string `json:"city"`
+	State     string `json:"state"`
+	Postcode  string `json:"postcode"`
+	Country   string `json:"country"`
+	Email     string `json:"email"`
+	Phone     string `json:"phone"`
+}
+
+type wooCommerceStore struct {
+	creds *wooshared.Credentials
+}
+
+func newWooCommerceStore(c Connections) (*wooCommerceStore, error) {
+	creds := &wooshared.Credentials{
+		ShopURL:        strings.TrimRight(c["woocommerce_url"], "/"),
+		ConsumerKey:    c["woocommerce_consumer_key"],
+		ConsumerSecret: c["woocommerce_consumer_secret"],
+	}
+	if creds.ShopURL == "" || creds.ConsumerKey == "" || creds.ConsumerSecret == "" {
+		return nil, errors.New("the connection has no WooCommerce store URL and API keys")
+	}
+	return &wooCommerceStore{creds: creds}, nil
+}
+
+// Order reads an order by ID. Orders without a shipping address ship to
+// the billing address.
+func (s *wooCommerceStore) Order(ref string) (*Order, error) {
+	ref = strings.TrimPrefix(ref, "#")
+	var order wooCommerceOrder
+	if err := s.creds.Request(http.MethodGet, "/orders/"+url.PathEscape(ref), nil, &order); err != nil {
+		return nil, err
+	}
+
+	a := order.Shipping
+	if a.Address1 == "" {
+		a = order.Billing
+	}
+	return &Order{
+		ID:        strconv.Itoa(order.ID),
+		Name:      "#" + order.Number,
+		Email:     order.Billing.Email,
+		Fulfilled: order.Status == "completed",
+		ShipTo: shipping.Address{
+			Name:    strings.TrimSpace(a.FirstName + " " + a.LastName),
+			Company: a.Company,
+			Street1: a.Address1,
+			Street2: a.Address2,
+			City:    a.City,
+			State:   a.State,
+			Zip:     a.Postcode,
+			Country: a.Country,
+			Phone:   firstNonEmpty(a.Phone, order.Billing.Phone),
+			Email:   order.Billing.Email,
+		},
+	}, nil
+}
+
+// Fulfill completes the order, stores the tracking in its meta data in
+// the keys of the common shipment tracking plugins, and adds a note with
+// the tracking, shown to the customer when notifyCustomer is set.
+func (s *wooCommerceStore) Fulfill(order *Order, label *shipping.Label, notifyCustomer bool) (interface{}, error) {
+	body := map[string]interface{}{
+		"status": "completed",
+		"meta_data": []map[string]interface{}{
+			{"key": "_tracking_number", "value": label.TrackingNumber},
+			{"key": "_tracking_provider", "value": label.Carrier},
+			{"key": "_tracking_url", "value": label.TrackingURL},
+		},
+	}
+	var updated map[string]interface{}
+	if err := s.creds.Request(http.MethodPut, "/orders/"+order.ID, body, &updated); err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Shipped with %s, tracking number %s.", label.Carrier, label.TrackingNumber)
+	if label.TrackingURL != "" {
+		note += " Track it at " + label.TrackingURL
+	}
+	var created map[string]interface{}
+	if err := s.creds.Request(http.MethodPost, "/orders/"+order.ID+"/notes",
+		map[string]interface{}{"note": note, "customer_note": notifyCustomer}, &created); err != nil {
+		return nil, fmt.Errorf("the order was completed but its note failed: %w", err)
+	}
+
+	return map[string]interface{}{
+		"id":      updated["id"],
+		"status":  updated["status"],
+		"note_id": created["id"],
+	}, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
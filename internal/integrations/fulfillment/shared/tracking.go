@@ -0,0 +1,51 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"errors"
+
+	"github.com/aftership/tracking-sdk-go/v5/model"
+	aftershipshared "github.com/wakflo/extensions/internal/integrations/aftership/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+)
+
+// ErrNoTrackingKey is returned by RegisterTracking when the connection
+// has no AfterShip Tracking key.
+var ErrNoTrackingKey = errors.New("the connection has no AfterShip Tracking API key")
+
+// RegisterTracking registers the label's tracking with AfterShip, titled
+// with the order name. The courier slug is matched from the label's
+// carrier; AfterShip detects it when there is no match.
+func (c Connections) RegisterTracking(order *Order, label *shipping.Label, notifyCustomer bool) (*shipping.Tracking, error) {
+	apiKey := c["aftership_tracking_api_key"]
+	if apiKey == "" {
+		return nil, ErrNoTrackingKey
+	}
+
+	req := model.CreateTrackingRequest{
+		TrackingNumber: label.TrackingNumber,
+		Title:          order.Name,
+		OrderId:        order.ID,
+		OrderNumber:    order.Name,
+	}
+	if courier, ok := shipping.MatchCourier(label.Carrier); ok {
+		req.Slug = courier.Code
+	}
+	if notifyCustomer && order.Email != "" {
+		req.Emails = []string{order.Email}
+	}
+	return aftershipshared.CreateTracking(apiKey, req)
+}
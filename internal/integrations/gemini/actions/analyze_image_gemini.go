@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/generative-ai-go/genai"
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -52,6 +53,8 @@ func (a *AnalyzeImageAction) Properties() *smartform.FormSchema {
 
 	RegisterVisionModelProps(form)
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -91,6 +94,10 @@ func (a *AnalyzeImageAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		URI: input.ImageURL,
 	}
 
+	if err := checkBudget(ctx, modelName, input.Prompt); err != nil {
+		return nil, err
+	}
+
 	// Generate content with image and prompt
 	content, err := model.GenerateContent(gctx, imagePart, genai.Text(input.Prompt))
 	if err != nil {
@@ -112,6 +119,7 @@ func (a *AnalyzeImageAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		"model":     modelName,
 		"prompt":    input.Prompt,
 		"image_url": input.ImageURL,
+		"usage":     reportUsage(ctx, modelName, content, []string{input.Prompt}, response),
 	}, nil
 }
 
@@ -2,10 +2,13 @@ package actions
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/google/generative-ai-go/genai"
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/prompt"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -40,11 +43,15 @@ func (a *ChatGeminiAction) Properties() *smartform.FormSchema {
 
 	form.TextareaField("chat", "chat").
 		Placeholder("Enter your prompt here.").
-		HelpText("Chat Prompt").
-		Required(true)
+		HelpText("Chat Prompt. Required unless a Prompt Template is set.").
+		Required(false)
+
+	prompt.RegisterTemplateProps(form)
 
 	RegisterModelProps(form)
 
+	aiusage.RegisterBudgetProps(form)
+
 	schema := form.Build()
 
 	return schema
@@ -67,6 +74,20 @@ func (a *ChatGeminiAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, er
 		return nil, err
 	}
 
+	rendered, err := prompt.FromInput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var system string
+	if rendered != nil {
+		input.Chat = rendered.Prompt
+		system = rendered.System
+	}
+
+	if strings.TrimSpace(input.Chat) == "" {
+		return nil, errors.New("a chat prompt or a prompt template is required")
+	}
+
 	gctx := context.Background()
 	client, err := CreateGeminiClient(gctx, authCtx)
 	if err != nil {
@@ -77,6 +98,13 @@ func (a *ChatGeminiAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, er
 	modelName := strings.TrimPrefix(input.Model, "models/")
 
 	model := client.GenerativeModel(modelName)
+	if system != "" {
+		model.SystemInstruction = genai.NewUserContent(genai.Text(system))
+	}
+
+	if err := checkBudget(ctx, modelName, system, input.Chat); err != nil {
+		return nil, err
+	}
 
 	content, err := model.GenerateContent(gctx, genai.Text(input.Chat))
 	if err != nil {
@@ -92,10 +120,16 @@ func (a *ChatGeminiAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, er
 		}
 	}
 
-	return map[string]interface{}{
+	output := map[string]interface{}{
 		"message": response,
 		"model":   modelName,
-	}, nil
+		"usage":   reportUsage(ctx, modelName, content, []string{system, input.Chat}, response),
+	}
+	if rendered != nil {
+		output["prompt_template"] = rendered.Output()
+	}
+
+	return output, nil
 }
 
 func NewChatGeminiAction() sdk.Action {
@@ -19,3 +19,21 @@ var functionCallingGeminiDocs string
 
 //go:embed analyze_image_gemini.md
 var analyzeImageGeminiDocs string
+
+//go:embed moderate_content_gemini.md
+var moderateContentGeminiDocs string
+
+//go:embed classify_text_gemini.md
+var classifyTextGeminiDocs string
+
+//go:embed redact_pii_gemini.md
+var redactPIIGeminiDocs string
+
+//go:embed transcribe_audio_gemini.md
+var transcribeAudioGeminiDocs string
+
+//go:embed translate_audio_gemini.md
+var translateAudioGeminiDocs string
+
+//go:embed text_to_speech_gemini.md
+var textToSpeechGeminiDocs string
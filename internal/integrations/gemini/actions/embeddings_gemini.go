@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/generative-ai-go/genai"
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -60,6 +61,8 @@ func (a *GenerateEmbeddingAction) Properties() *smartform.FormSchema {
 		).
 		HelpText("The type of task for optimized embeddings (optional)")
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -107,6 +110,10 @@ func (a *GenerateEmbeddingAction) Perform(ctx sdkcontext.PerformContext) (core.J
 		embeddingModel.TaskType = taskType
 	}
 
+	if err := aiusage.Check(ctx, modelName, 0, input.Text); err != nil {
+		return nil, err
+	}
+
 	resp, err := embeddingModel.EmbedContent(gctx, genai.Text(input.Text))
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate embedding: %w", err)
@@ -117,6 +124,7 @@ func (a *GenerateEmbeddingAction) Perform(ctx sdkcontext.PerformContext) (core.J
 		"dimension": len(resp.Embedding.Values),
 		"model":     modelName,
 		"task":      input.Task,
+		"usage":     reportEmbeddingUsage(ctx, modelName, input.Text),
 	}, nil
 }
 
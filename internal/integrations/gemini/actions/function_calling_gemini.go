@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/generative-ai-go/genai"
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -66,6 +67,8 @@ func (a *FunctionCallingAction) Properties() *smartform.FormSchema {
 
 	RegisterModelProps(form)
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -201,6 +204,10 @@ func (a *FunctionCallingAction) Perform(ctx sdkcontext.PerformContext) (core.JSO
 		fullPrompt = fmt.Sprintf("%s\n\nText to analyze:\n%s", input.SystemPrompt, input.Prompt)
 	}
 
+	if err := checkBudget(ctx, modelName, fullPrompt, input.FunctionSchema); err != nil {
+		return nil, err
+	}
+
 	// Generate content with function calling
 	resp, err := model.GenerateContent(gctx, genai.Text(fullPrompt))
 	if err != nil {
@@ -241,6 +248,7 @@ func (a *FunctionCallingAction) Perform(ctx sdkcontext.PerformContext) (core.JSO
 	// Return the results
 	result := map[string]interface{}{
 		"model": modelName,
+		"usage": reportUsage(ctx, modelName, resp, []string{fullPrompt, input.FunctionSchema}, ""),
 	}
 
 	if extractedData != nil {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/generative-ai-go/genai"
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
@@ -81,3 +82,79 @@ func RegisterEmbeddingModelProps(form *smartform.FormBuilder) *smartform.FieldBu
 		).
 		HelpText("Select a Gemini embedding model")
 }
+
+// checkBudget fails fast when the estimated cost of a generation request is
+// above the budget configured on the action. Gemini actions do not cap the
+// output length, so the default output size is assumed.
+func checkBudget(ctx aiusage.Context, model string, prompt ...string) error {
+	return aiusage.Check(ctx, model, aiusage.DefaultOutputTokens, prompt...)
+}
+
+// reportUsage normalizes and prices the usage metadata of a Gemini response,
+// falling back to a local estimate when the API did not return any.
+func reportUsage(ctx aiusage.Context, model string, resp *genai.GenerateContentResponse, prompt []string, output string) map[string]interface{} {
+	if resp != nil && resp.UsageMetadata != nil {
+		meta := resp.UsageMetadata
+		return aiusage.Finish(ctx, "google", model, aiusage.NewUsage(
+			int64(meta.PromptTokenCount),
+			int64(meta.CandidatesTokenCount),
+			int64(meta.CachedContentTokenCount),
+		))
+	}
+
+	usage := aiusage.NewUsage(aiusage.EstimateTokens(prompt...), aiusage.EstimateTokens(output), 0)
+	usage.Estimated = true
+
+	return aiusage.Finish(ctx, "google", model, usage)
+}
+
+// reportEmbeddingUsage prices an embedding request. The embeddings API does
+// not return usage, so the input is always estimated locally.
+func reportEmbeddingUsage(ctx aiusage.Context, model string, text string) map[string]interface{} {
+	usage := aiusage.NewUsage(aiusage.EstimateTokens(text), 0, 0)
+	usage.Estimated = true
+
+	return aiusage.Finish(ctx, "google", model, usage)
+}
+
+// generateJSON runs a deterministic single-turn request that answers with
+// JSON, as used by the moderation, classification and PII actions. It
+// enforces the budget and returns the answer with its usage report.
+func generateJSON(ctx sdkcontext.PerformContext, modelName, system, prompt string) (string, map[string]interface{}, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := checkBudget(ctx, modelName, system, prompt); err != nil {
+		return "", nil, err
+	}
+
+	gctx := context.Background()
+	client, err := CreateGeminiClient(gctx, authCtx)
+	if err != nil {
+		return "", nil, err
+	}
+	defer client.Close()
+
+	model := client.GenerativeModel(modelName)
+	model.SystemInstruction = genai.NewUserContent(genai.Text(system))
+	model.ResponseMIMEType = "application/json"
+	model.SetTemperature(0)
+
+	resp, err := model.GenerateContent(gctx, genai.Text(prompt))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var answer string
+	if len(resp.Candidates) > 0 && resp.Candidates[0].Content != nil {
+		for _, part := range resp.Candidates[0].Content.Parts {
+			if text, ok := part.(genai.Text); ok {
+				answer += string(text)
+			}
+		}
+	}
+
+	return answer, reportUsage(ctx, modelName, resp, []string{system, prompt}, answer), nil
+}
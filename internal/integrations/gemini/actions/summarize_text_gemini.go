@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/generative-ai-go/genai"
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -69,6 +70,8 @@ func (a *SummarizeTextAction) Properties() *smartform.FormSchema {
 
 	RegisterModelProps(form)
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -126,6 +129,10 @@ func (a *SummarizeTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 
 	prompt += input.Text
 
+	if err := checkBudget(ctx, modelName, prompt); err != nil {
+		return nil, err
+	}
+
 	content, err := model.GenerateContent(gctx, genai.Text(prompt))
 	if err != nil {
 		return nil, err
@@ -144,6 +151,7 @@ func (a *SummarizeTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		"summary": summary,
 		"model":   modelName,
 		"style":   input.Style,
+		"usage":   reportUsage(ctx, modelName, content, []string{prompt}, summary),
 	}, nil
 }
 
@@ -0,0 +1,320 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/media"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+const geminiAPIURL = "https://generativelanguage.googleapis.com/v1beta"
+
+// geminiVoices are the prebuilt voices of the Gemini speech models.
+var geminiVoices = []string{
+	"Kore", "Puck", "Charon", "Zephyr", "Fenrir", "Leda", "Orus", "Aoede",
+	"Callirrhoe", "Autonoe", "Enceladus", "Iapetus", "Umbriel", "Algieba",
+	"Despina", "Erinome", "Algenib", "Rasalgethi", "Laomedeia", "Achernar",
+	"Alnilam", "Schedar", "Gacrux", "Pulcherrima", "Achird", "Zubenelgenubi",
+	"Vindemiatrix", "Sadachbia", "Sadaltager", "Sulafat",
+}
+
+type textToSpeechActionProps struct {
+	Text         string `json:"text"`
+	Model        string `json:"model"`
+	Voice        string `json:"voice"`
+	Format       string `json:"format"`
+	Instructions string `json:"instructions"`
+	FileName     string `json:"file_name"`
+}
+
+type speechInlineData struct {
+	MimeType string `json:"mimeType"`
+	Data     string `json:"data"`
+}
+
+type speechResponse struct {
+	Candidates []struct {
+		Content struct {
+			Parts []struct {
+				InlineData *speechInlineData `json:"inlineData"`
+			} `json:"parts"`
+		} `json:"content"`
+	} `json:"candidates"`
+	UsageMetadata struct {
+		PromptTokenCount     int64 `json:"promptTokenCount"`
+		CandidatesTokenCount int64 `json:"candidatesTokenCount"`
+	} `json:"usageMetadata"`
+}
+
+type TextToSpeechAction struct{}
+
+func (a *TextToSpeechAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "text_to_speech_gemini",
+		DisplayName:   "Text to Speech",
+		Description:   "Turn text into spoken audio with a choice of voice and format, stored as a file that messaging and storage actions can send or upload.",
+		Type:          core.ActionTypeAction,
+		Documentation: textToSpeechGeminiDocs,
+		SampleOutput: map[string]any{
+			"file": map[string]any{
+				"id":          "cs1v2qk0p1h1234abcd0",
+				"fileName":    "speech.wav",
+				"ext":         ".wav",
+				"mimeType":    "audio/wav",
+				"url":         "https://files.example.com/cs1v2qk0p1h1234abcd0",
+				"downloadUrl": "https://files.example.com/cs1v2qk0p1h1234abcd0",
+				"size":        192044,
+				"sizeBytes":   192044,
+			},
+			"url":        "https://files.example.com/cs1v2qk0p1h1234abcd0",
+			"mime_type":  "audio/wav",
+			"format":     "wav",
+			"voice":      "Kore",
+			"model":      "gemini-2.5-flash-preview-tts",
+			"characters": 64,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *TextToSpeechAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("text_to_speech_gemini", "Text to Speech")
+
+	form.TextareaField("text", "Text").
+		Required(true).
+		HelpText("The text to speak")
+
+	form.SelectField("model", "Model").
+		Required(true).
+		DefaultValue("gemini-2.5-flash-preview-tts").
+		AddOptions(
+			smartform.NewOption("gemini-2.5-flash-preview-tts", "Gemini 2.5 Flash TTS"),
+			smartform.NewOption("gemini-2.5-pro-preview-tts", "Gemini 2.5 Pro TTS"),
+		).
+		HelpText("Select a Gemini speech model")
+
+	voices := make([]*smartform.Option, 0, len(geminiVoices))
+	for _, voice := range geminiVoices {
+		voices = append(voices, smartform.NewOption(voice, voice))
+	}
+
+	form.SelectField("voice", "Voice").
+		Required(true).
+		DefaultValue("Kore").
+		AddOptions(voices...).
+		HelpText("The prebuilt voice to use")
+
+	form.SelectField("format", "Audio Format").
+		Required(false).
+		DefaultValue("wav").
+		AddOptions(
+			smartform.NewOption("wav", "WAV"),
+			smartform.NewOption("pcm", "Raw PCM (16-bit, mono)"),
+		).
+		HelpText("Gemini returns raw PCM audio. WAV wraps it so players and messaging apps can open it.")
+
+	form.TextareaField("instructions", "Instructions").
+		Required(false).
+		Placeholder("Say cheerfully:").
+		HelpText("Optional direction placed before the text, such as tone, pace or accent.")
+
+	form.TextField("file_name", "File Name").
+		Required(false).
+		Placeholder("speech").
+		HelpText("Name of the generated file, without extension (default: speech)")
+
+	aiusage.RegisterBudgetProps(form)
+
+	return form.Build()
+}
+
+func (a *TextToSpeechAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *TextToSpeechAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[textToSpeechActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(input.Text) == "" {
+		return nil, errors.New("text to speak cannot be empty")
+	}
+
+	if input.Model == "" {
+		input.Model = "gemini-2.5-flash-preview-tts"
+	}
+	if input.Voice == "" {
+		input.Voice = "Kore"
+	}
+	if input.Format == "" {
+		input.Format = "wav"
+	}
+	modelName := strings.TrimPrefix(input.Model, "models/")
+
+	prompt := input.Text
+	if input.Instructions != "" {
+		prompt = strings.TrimSpace(input.Instructions) + "\n\n" + input.Text
+	}
+
+	// Speech is billed as audio output tokens, about 32 per second of audio;
+	// the check assumes the default output size.
+	if err := checkBudget(ctx, modelName, prompt); err != nil {
+		return nil, err
+	}
+
+	requestBody := map[string]interface{}{
+		"contents": []interface{}{
+			map[string]interface{}{
+				"parts": []interface{}{
+					map[string]interface{}{"text": prompt},
+				},
+			},
+		},
+		"generationConfig": map[string]interface{}{
+			"responseModalities": []string{"AUDIO"},
+			"speechConfig": map[string]interface{}{
+				"voiceConfig": map[string]interface{}{
+					"prebuiltVoiceConfig": map[string]interface{}{
+						"voiceName": input.Voice,
+					},
+				},
+			},
+		},
+	}
+
+	speech, err := generateSpeech(ctx, authCtx.Extra["key"], modelName, requestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var inline *speechInlineData
+	for _, candidate := range speech.Candidates {
+		for _, part := range candidate.Content.Parts {
+			if part.InlineData != nil {
+				inline = part.InlineData
+				break
+			}
+		}
+	}
+	if inline == nil {
+		return nil, errors.New("the model did not return any audio")
+	}
+
+	pcm, err := base64.StdEncoding.DecodeString(inline.Data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode the audio returned by the model: %w", err)
+	}
+
+	fileName := strings.TrimSpace(input.FileName)
+	if fileName == "" {
+		fileName = "speech"
+	}
+
+	var (
+		audio    []byte
+		mimeType string
+	)
+	switch input.Format {
+	case "pcm":
+		audio, mimeType = pcm, inline.MimeType
+		fileName += ".pcm"
+	default:
+		audio, mimeType = media.WAV(pcm, sampleRate(inline.MimeType), 1, 16), "audio/wav"
+		fileName += ".wav"
+	}
+
+	file, err := media.Store(ctx.Context(), ctx.Files(), fileName, mimeType, audio)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := aiusage.NewUsage(speech.UsageMetadata.PromptTokenCount, speech.UsageMetadata.CandidatesTokenCount, 0)
+
+	return map[string]interface{}{
+		"file":       file,
+		"url":        file["url"],
+		"mime_type":  mimeType,
+		"format":     input.Format,
+		"voice":      input.Voice,
+		"model":      modelName,
+		"characters": len([]rune(input.Text)),
+		"usage":      aiusage.Finish(ctx, "google", modelName, usage),
+	}, nil
+}
+
+// generateSpeech calls generateContent over REST since the Go client does
+// not expose the speech configuration.
+func generateSpeech(ctx sdkcontext.PerformContext, apiKey, model string, requestBody map[string]interface{}) (*speechResponse, error) {
+	body, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/models/%s:generateContent", geminiAPIURL, url.PathEscape(model))
+
+	req, err := http.NewRequestWithContext(ctx.Context(), http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-goog-api-key", apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("speech generation failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("speech generation failed (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var speech speechResponse
+	if err := json.Unmarshal(respBody, &speech); err != nil {
+		return nil, fmt.Errorf("could not read the response body: %w", err)
+	}
+
+	return &speech, nil
+}
+
+// sampleRate reads the rate parameter of an audio/L16 MIME type, which
+// Gemini currently sets to 24000.
+func sampleRate(mimeType string) int {
+	for _, param := range strings.Split(mimeType, ";") {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(param), "rate="); ok {
+			if rate, err := strconv.Atoi(value); err == nil && rate > 0 {
+				return rate
+			}
+		}
+	}
+	return 24000
+}
+
+func NewTextToSpeechAction() sdk.Action {
+	return &TextToSpeechAction{}
+}
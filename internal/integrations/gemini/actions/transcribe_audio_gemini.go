@@ -0,0 +1,199 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/media"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// maxInlineAudio is the largest request Gemini accepts with inline data.
+const maxInlineAudio = 20 << 20
+
+type transcribeAudioActionProps struct {
+	Audio          interface{} `json:"audio"`
+	Model          string      `json:"model"`
+	Language       string      `json:"language"`
+	Prompt         string      `json:"prompt"`
+	SubtitleFormat string      `json:"subtitle_format"`
+}
+
+type TranscribeAudioAction struct{}
+
+func (a *TranscribeAudioAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "transcribe_audio_gemini",
+		DisplayName:   "Transcribe Audio",
+		Description:   "Convert speech in an audio file, such as a WhatsApp or Telegram voice note, to text with timestamps, SRT/VTT subtitles and the detected language.",
+		Type:          core.ActionTypeAction,
+		Documentation: transcribeAudioGeminiDocs,
+		SampleOutput: map[string]any{
+			"text":     "Hi, I ordered a blue jacket last week and it still hasn't arrived.",
+			"language": "en",
+			"duration": 4.2,
+			"segments": []map[string]any{
+				{"start": 0.0, "end": 4.2, "text": "Hi, I ordered a blue jacket last week and it still hasn't arrived."},
+			},
+			"srt":   "1\n00:00:00,000 --> 00:00:04,200\nHi, I ordered a blue jacket last week and it still hasn't arrived.\n\n",
+			"vtt":   "WEBVTT\n\n00:00:00.000 --> 00:00:04.200\nHi, I ordered a blue jacket last week and it still hasn't arrived.\n\n",
+			"model": "gemini-2.5-flash",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *TranscribeAudioAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("transcribe_audio_gemini", "Transcribe Audio")
+
+	registerAudioInputProps(form)
+
+	RegisterModelProps(form)
+
+	form.TextField("language", "Language").
+		Required(false).
+		Placeholder("en").
+		HelpText("ISO-639-1 code of the spoken language. Leave empty to detect it automatically.")
+
+	form.TextareaField("prompt", "Prompt").
+		Required(false).
+		HelpText("Optional context to guide spelling, e.g. product names or speaker names.")
+
+	registerSubtitleProps(form)
+
+	return form.Build()
+}
+
+func (a *TranscribeAudioAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *TranscribeAudioAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[transcribeAudioActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	instructions := "Transcribe the speech in this audio verbatim, in the language it is spoken."
+	if input.Language != "" {
+		instructions += fmt.Sprintf(" The speech is in the language with ISO-639-1 code %q.", input.Language)
+	}
+
+	return transcribeAudio(ctx, input.Audio, input.Model, instructions, input.Prompt, input.SubtitleFormat)
+}
+
+// registerAudioInputProps adds the audio file field of the transcription
+// actions.
+func registerAudioInputProps(form *smartform.FormBuilder) {
+	form.FileField("audio", "Audio").
+		Required(true).
+		HelpText("The audio to process: a file, a URL, a data URI or base64. Supports wav, mp3, aiff, aac, ogg and flac up to 20 MB.")
+}
+
+// registerSubtitleProps adds the subtitle file choice of the transcription
+// actions.
+func registerSubtitleProps(form *smartform.FormBuilder) {
+	form.SelectField("subtitle_format", "Subtitle File").
+		Required(false).
+		HelpText("Also store the subtitles as a file. SRT and VTT text is always returned.").
+		AddOptions([]*smartform.Option{
+			{Value: "", Label: "None"},
+			{Value: "srt", Label: "SRT"},
+			{Value: "vtt", Label: "WebVTT"},
+		}...)
+}
+
+type geminiTranscript struct {
+	Language string          `json:"language"`
+	Duration float64         `json:"duration"`
+	Text     string          `json:"text"`
+	Segments []media.Segment `json:"segments"`
+}
+
+// transcribeAudio sends the audio inline with instructions asking for a
+// timed transcript as JSON, since Gemini has no dedicated speech endpoint.
+func transcribeAudio(ctx sdkcontext.PerformContext, source interface{}, model, instructions, hint, subtitleFormat string) (core.JSON, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if model == "" {
+		return nil, errors.New("Model is required")
+	}
+	modelName := strings.TrimPrefix(model, "models/")
+
+	audio, err := media.Load(ctx.Context(), ctx.Files(), source)
+	if err != nil {
+		return nil, err
+	}
+	if len(audio.Data) > maxInlineAudio {
+		return nil, fmt.Errorf("audio is larger than %d MB", maxInlineAudio>>20)
+	}
+
+	prompt := instructions + ` Return a JSON object of this shape:
+{"language": "<ISO-639-1 code of the spoken language>", "duration": <length of the audio in seconds>, "text": "<full transcript>", "segments": [{"start": <seconds>, "end": <seconds>, "text": "<sentence>"}]}
+Split segments at sentence boundaries and give times in seconds as decimal numbers.`
+	if hint != "" {
+		prompt += "\n\nContext: " + hint
+	}
+
+	gctx := context.Background()
+	client, err := CreateGeminiClient(gctx, authCtx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	genModel := client.GenerativeModel(modelName)
+	genModel.ResponseMIMEType = "application/json"
+	genModel.SetTemperature(0)
+
+	resp, err := genModel.GenerateContent(gctx, genai.Blob{MIMEType: audio.MimeType, Data: audio.Data}, genai.Text(prompt))
+	if err != nil {
+		return nil, fmt.Errorf("transcription failed: %w", err)
+	}
+
+	var answer string
+	if len(resp.Candidates) > 0 && resp.Candidates[0].Content != nil {
+		for _, part := range resp.Candidates[0].Content.Parts {
+			if text, ok := part.(genai.Text); ok {
+				answer += string(text)
+			}
+		}
+	}
+
+	usage := reportUsage(ctx, modelName, resp, []string{prompt}, answer)
+
+	var result geminiTranscript
+	if err := json.Unmarshal([]byte(answer), &result); err != nil {
+		return nil, fmt.Errorf("could not read the transcript returned by the model: %w", err)
+	}
+
+	transcript := media.Transcript{
+		Text:     result.Text,
+		Language: result.Language,
+		Duration: result.Duration,
+		Segments: result.Segments,
+	}
+
+	output, err := transcript.Output(ctx.Context(), ctx.Files(), subtitleFormat, audio.Name)
+	if err != nil {
+		return nil, err
+	}
+	output["model"] = modelName
+	output["usage"] = usage
+
+	return output, nil
+}
+
+func NewTranscribeAudioAction() sdk.Action {
+	return &TranscribeAudioAction{}
+}
@@ -0,0 +1,74 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type translateAudioActionProps struct {
+	Audio          interface{} `json:"audio"`
+	Model          string      `json:"model"`
+	Prompt         string      `json:"prompt"`
+	SubtitleFormat string      `json:"subtitle_format"`
+}
+
+type TranslateAudioAction struct{}
+
+func (a *TranslateAudioAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "translate_audio_gemini",
+		DisplayName:   "Translate Audio to English",
+		Description:   "Transcribe speech in any language directly into English text, with timestamps and SRT/VTT subtitles.",
+		Type:          core.ActionTypeAction,
+		Documentation: translateAudioGeminiDocs,
+		SampleOutput: map[string]any{
+			"text":     "Hello, my package arrived damaged. Can I get a replacement?",
+			"language": "es",
+			"duration": 5.1,
+			"segments": []map[string]any{
+				{"start": 0.0, "end": 5.1, "text": "Hello, my package arrived damaged. Can I get a replacement?"},
+			},
+			"srt":   "1\n00:00:00,000 --> 00:00:05,100\nHello, my package arrived damaged. Can I get a replacement?\n\n",
+			"vtt":   "WEBVTT\n\n00:00:00.000 --> 00:00:05.100\nHello, my package arrived damaged. Can I get a replacement?\n\n",
+			"model": "gemini-2.5-flash",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *TranslateAudioAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("translate_audio_gemini", "Translate Audio to English")
+
+	registerAudioInputProps(form)
+
+	RegisterModelProps(form)
+
+	form.TextareaField("prompt", "Prompt").
+		Required(false).
+		HelpText("Optional context to guide the translation, e.g. product names or terminology.")
+
+	registerSubtitleProps(form)
+
+	return form.Build()
+}
+
+func (a *TranslateAudioAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *TranslateAudioAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[translateAudioActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	instructions := "Translate the speech in this audio into natural English. Report the language that is spoken, and write the text and every segment in English."
+
+	return transcribeAudio(ctx, input.Audio, input.Model, instructions, input.Prompt, input.SubtitleFormat)
+}
+
+func NewTranslateAudioAction() sdk.Action {
+	return &TranslateAudioAction{}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/generative-ai-go/genai"
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -96,6 +97,8 @@ func (a *TranslateTextAction) Properties() *smartform.FormSchema {
 
 	RegisterModelProps(form)
 
+	aiusage.RegisterBudgetProps(form)
+
 	return form.Build()
 }
 
@@ -134,6 +137,10 @@ func (a *TranslateTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 			getLanguageName(input.SourceLang), getLanguageName(input.TargetLang), input.Text)
 	}
 
+	if err := checkBudget(ctx, modelName, prompt); err != nil {
+		return nil, err
+	}
+
 	content, err := model.GenerateContent(gctx, genai.Text(prompt))
 	if err != nil {
 		return nil, err
@@ -153,6 +160,7 @@ func (a *TranslateTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		"source_language": input.SourceLang,
 		"target_language": input.TargetLang,
 		"model":           modelName,
+		"usage":           reportUsage(ctx, modelName, content, []string{prompt}, translatedText),
 	}, nil
 }
 
@@ -55,6 +55,12 @@ func (n *Gemini) Actions() []sdk.Action {
 		actions.NewSummarizeTextAction(),
 		actions.NewAnalyzeImageAction(),
 		actions.NewFunctionCallingAction(),
+		actions.NewModerateContentAction(),
+		actions.NewClassifyTextAction(),
+		actions.NewRedactPIIAction(),
+		actions.NewTranscribeAudioAction(),
+		actions.NewTranslateAudioAction(),
+		actions.NewTextToSpeechAction(),
 	}
 }
 
@@ -1,13 +1,11 @@
 package actions
 
 import (
-	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
 	"github.com/wakflo/extensions/internal/integrations/googlemail/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
@@ -18,12 +16,14 @@ import (
 
 type sendEmailTemplateActionProps struct {
 	To              string `json:"to"`
+	CC              string `json:"cc"`
+	BCC             string `json:"bcc"`
 	TemplateSubject string `json:"temp-subject"`
 	Subject         string `json:"subject"`
-	Body            string `json:"body"`
 	FirstName       string `json:"firstName"`
 	LastName        string `json:"lastName"`
 	FromName        string `json:"from"`
+	Variables       string `json:"variables"`
 }
 
 type SendEmailTemplateAction struct{}
@@ -38,7 +38,10 @@ func (a *SendEmailTemplateAction) Metadata() sdk.ActionMetadata {
 		Documentation: sendEmailTemplateDocs,
 		Icon:          "",
 		SampleOutput: map[string]any{
-			"message": "Hello World!",
+			"provider":   "gmail",
+			"message_id": "18e2f7c1a9b04d52",
+			"accepted":   []string{"jane.doe@example.com"},
+			"sent":       true,
 		},
 		Settings: core.ActionSettings{},
 	}
@@ -55,8 +58,8 @@ func (a *SendEmailTemplateAction) Properties() *smartform.FormSchema {
 
 	form.TextField("subject", "subject").
 		Placeholder("Subject").
-		HelpText("The template subject you want to send.").
-		Required(true)
+		HelpText("The subject of the sent email. Defaults to the template's subject.").
+		Required(false)
 
 	form.TextField("to", "to").
 		Placeholder("To").
@@ -68,14 +71,29 @@ func (a *SendEmailTemplateAction) Properties() *smartform.FormSchema {
 		HelpText("The sender of the message address").
 		Required(false)
 
+	form.TextField("cc", "cc").
+		Placeholder("CC").
+		HelpText("Carbon copy recipients (comma-separated)").
+		Required(false)
+
+	form.TextField("bcc", "bcc").
+		Placeholder("BCC").
+		HelpText("Blind carbon copy recipients (comma-separated)").
+		Required(false)
+
 	form.TextField("firstName", "firstName").
 		Placeholder("First Name").
-		HelpText("First name").
-		Required(true)
+		HelpText("Fills {{FirstName}} in the template").
+		Required(false)
 
 	form.TextField("lastName", "lastName").
 		Placeholder("Last Name").
-		HelpText("Last name").
+		HelpText("Fills {{LastName}} in the template").
+		Required(false)
+
+	form.TextareaField("variables", "variables").
+		Placeholder(`{"order_id": "1001"}`).
+		HelpText("Values of other {{name}} placeholders in the template, as a JSON object").
 		Required(false)
 
 	schema := form.Build()
@@ -95,42 +113,40 @@ func (a *SendEmailTemplateAction) Perform(ctx sdkcontext.PerformContext) (core.J
 		return nil, err
 	}
 
-	authCtx, err := ctx.AuthContext()
+	vars, err := email.ParseVariables(input.Variables)
 	if err != nil {
 		return nil, err
 	}
+	if vars == nil {
+		vars = map[string]interface{}{}
+	}
+	vars["FirstName"] = input.FirstName
+	vars["LastName"] = input.LastName
 
-	gmailService, err := gmail.NewService(context.Background(), option.WithTokenSource(*authCtx.TokenSource))
-	if err != nil {
-		return nil, err
+	msg := &email.Message{
+		From:      email.Address{Name: input.FromName},
+		Variables: vars,
+	}
+	if msg.To, err = email.ParseAddressList(input.To); err != nil {
+		return nil, fmt.Errorf("to: %w", err)
+	}
+	if msg.CC, err = email.ParseAddressList(input.CC); err != nil {
+		return nil, fmt.Errorf("cc: %w", err)
+	}
+	if msg.BCC, err = email.ParseAddressList(input.BCC); err != nil {
+		return nil, fmt.Errorf("bcc: %w", err)
 	}
 
-	userProfile, err := gmailService.Users.GetProfile("me").Do()
+	authCtx, err := ctx.AuthContext()
 	if err != nil {
 		return nil, err
 	}
-	userEmail := userProfile.EmailAddress
 
-	if !shared.IsValidEmail(input.To) {
-		return nil, fmt.Errorf("invalid To email address: %s", input.To)
+	gmailService, err := gmail.NewService(ctx.Context(), option.WithTokenSource(*authCtx.TokenSource))
+	if err != nil {
+		return nil, err
 	}
 
-	// bccEmails := strings.Split(input.BCC, ",")
-	// for _, email := range bccEmails {
-	// 	email = strings.TrimSpace(email)
-	// 	if email != "" && !shared.IsValidEmail(email) {
-	// 		return nil, fmt.Errorf("invalid BCC email address: %s", email)
-	// 	}
-	// }
-
-	// ccEmails := strings.Split(input.CC, ",")
-	// for _, email := range ccEmails {
-	// 	email = strings.TrimSpace(email)
-	// 	if email != "" && !shared.IsValidEmail(email) {
-	// 		return nil, fmt.Errorf("invalid CC email address: %s", email)
-	// 	}
-	// }
-
 	// Search for the template message
 	query := "subject:" + input.TemplateSubject
 	searchResult, err := gmailService.Users.Messages.List("me").Q(query).Do()
@@ -147,48 +163,23 @@ func (a *SendEmailTemplateAction) Perform(ctx sdkcontext.PerformContext) (core.J
 		return nil, errors.New("error fetching template message")
 	}
 
-	// Decode the email body
-	var emailBody string
-	for _, part := range templateMsg.Payload.Parts {
-		if part.MimeType == "text/html" {
-			data, _ := base64.URLEncoding.DecodeString(part.Body.Data)
-			emailBody = string(data)
-			break
-		}
-	}
-	if emailBody == "" {
+	msg.Text, msg.HTML = shared.MessageBody(templateMsg.Payload)
+	if msg.HTML == "" {
 		return nil, errors.New("no HTML body found in template message")
 	}
 
-	emailBody = strings.ReplaceAll(emailBody, "{{FirstName}}", input.FirstName)
-	emailBody = strings.ReplaceAll(emailBody, "{{LastName}}", input.LastName)
-
-	var fromField string
-	if input.FromName != "" {
-		fromField = fmt.Sprintf("%s <%s>", input.FromName, userEmail)
-	} else {
-		fromField = userEmail
-	}
-
-	// Use the modified template as the email body
-	message := &gmail.Message{
-		Raw: base64.URLEncoding.EncodeToString([]byte(
-			"From: " + fromField + "\r\n" +
-				"To: " + input.To + "\r\n" +
-				"Subject: " + input.TemplateSubject + "\r\n" +
-				"Content-Type: text/html; charset=UTF-8\r\n\r\n" +
-				emailBody)),
+	msg.Subject = input.Subject
+	if msg.Subject == "" {
+		msg.Subject = shared.GetHeader(templateMsg.Payload.Headers, "Subject")
 	}
 
-	// Send the message
-	_, err = gmailService.Users.Messages.Send("me", message).Do()
+	sender := &shared.Sender{Service: gmailService}
+	result, err := sender.Send(ctx.Context(), msg)
 	if err != nil {
 		return nil, err
 	}
 
-	return map[string]interface{}{
-		"status": "Message sent successfully!",
-	}, nil
+	return result.Output(), nil
 }
 
 func NewSendEmailTemplateAction() sdk.Action {
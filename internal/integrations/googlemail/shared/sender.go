@@ -0,0 +1,90 @@
+package shared
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/wakflo/extensions/internal/email"
+	"google.golang.org/api/gmail/v1"
+)
+
+// Sender sends messages as the connected Gmail account.
+type Sender struct {
+	Service *gmail.Service
+}
+
+var _ email.Sender = (*Sender)(nil)
+
+// Send sends a message. The sender defaults to the account's address;
+// Gmail replaces other addresses unless they are aliases of the account.
+func (s *Sender) Send(ctx context.Context, msg *email.Message) (*email.Result, error) {
+	if msg.TemplateID != "" {
+		return nil, errors.New("gmail has no stored templates; fill the subject and bodies with variables instead")
+	}
+	msg.ApplyVariables()
+
+	if msg.From.Email == "" {
+		profile, err := s.Service.Users.GetProfile("me").Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+		msg.From.Email = profile.EmailAddress
+	}
+
+	// Gmail reads the blind copies from the Bcc header and strips it.
+	raw, err := msg.MIME(email.MIMEOptions{IncludeBCC: true})
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := s.Service.Users.Messages.Send("me", &gmail.Message{
+		Raw: base64.URLEncoding.EncodeToString(raw),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &email.Result{Provider: "gmail", MessageID: sent.Id}
+	for _, a := range msg.Recipients() {
+		result.Accepted = append(result.Accepted, a.Email)
+	}
+	return result, nil
+}
+
+// MessageBody returns the text and HTML bodies of a message, searching
+// nested multipart parts.
+func MessageBody(part *gmail.MessagePart) (text, html string) {
+	if part == nil {
+		return "", ""
+	}
+	if part.Body != nil && part.Body.Data != "" {
+		data := decodeBody(part.Body.Data)
+		switch {
+		case strings.HasPrefix(part.MimeType, "text/html"):
+			html = data
+		case strings.HasPrefix(part.MimeType, "text/plain"):
+			text = data
+		}
+	}
+	for _, p := range part.Parts {
+		t, h := MessageBody(p)
+		if text == "" {
+			text = t
+		}
+		if html == "" {
+			html = h
+		}
+	}
+	return text, html
+}
+
+func decodeBody(data string) string {
+	// Gmail usually leaves out the padding.
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(data, "="))
+	if err != nil {
+		return ""
+	}
+	return string(decoded)
+}
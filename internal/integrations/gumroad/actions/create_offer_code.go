@@ -0,0 +1,145 @@
+package actions
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/gumroad/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createOfferCodeActionProps struct {
+	ProductID        string  `json:"product_id"`
+	Name             string  `json:"name"`
+	OfferType        string  `json:"offer_type"`
+	AmountOff        float64 `json:"amount_off"`
+	MaxPurchaseCount int     `json:"max_purchase_count"`
+	Universal        bool    `json:"universal"`
+}
+
+type CreateOfferCodeAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateOfferCodeAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_offer_code",
+		DisplayName:   "Create Offer Code",
+		Description:   "Creates a discount code for a product, as a fixed amount or a percentage off.",
+		Type:          core.ActionTypeAction,
+		Documentation: createOfferCodeDocs,
+		Icon:          "mdi:ticket-percent",
+		SampleOutput: map[string]any{
+			"offer_code": map[string]any{
+				"id":                 "mN7CdHiwHaR9FlxKvF-n-g==",
+				"name":               "SPRING20",
+				"percent_off":        20,
+				"max_purchase_count": 100,
+				"universal":          false,
+				"times_used":         0,
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateOfferCodeAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_offer_code", "Create Offer Code")
+
+	shared.RegisterProductsProps(form)
+
+	form.TextField("name", "Code").
+		Required(true).
+		HelpText("The code buyers enter at checkout, such as SPRING20.")
+
+	form.SelectField("offer_type", "Discount Type").
+		Required(true).
+		AddOption("percent", "Percentage").
+		AddOption("cents", "Fixed Amount").
+		DefaultValue("percent")
+
+	form.NumberField("amount_off", "Amount Off").
+		Required(true).
+		HelpText("The percentage off, or the amount off in the product's currency, such as 5.00.")
+
+	form.NumberField("max_purchase_count", "Maximum Uses").
+		Required(false).
+		HelpText("Leave empty for unlimited uses.")
+
+	form.CheckboxField("universal", "All Products").
+		Required(false).
+		HelpText("Make the code valid for all of your products.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateOfferCodeAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateOfferCodeAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createOfferCodeActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ProductID == "" {
+		return nil, errors.New("product ID is required")
+	}
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, errors.New("code is required")
+	}
+	if input.AmountOff <= 0 {
+		return nil, errors.New("amount off must be greater than zero")
+	}
+
+	params := url.Values{}
+	params.Set("name", name)
+	switch input.OfferType {
+	case "cents":
+		// Gumroad takes fixed discounts in cents.
+		params.Set("offer_type", "cents")
+		params.Set("amount_off", strconv.FormatInt(int64(math.Round(input.AmountOff*100)), 10))
+	default:
+		if input.AmountOff > 100 || input.AmountOff != math.Trunc(input.AmountOff) {
+			return nil, errors.New("the percentage must be a whole number up to 100")
+		}
+		params.Set("offer_type", "percent")
+		params.Set("amount_off", strconv.Itoa(int(input.AmountOff)))
+	}
+	if input.MaxPurchaseCount > 0 {
+		params.Set("max_purchase_count", strconv.Itoa(input.MaxPurchaseCount))
+	}
+	if input.Universal {
+		params.Set("universal", "true")
+	}
+
+	token, err := shared.GetAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	path := "/products/" + url.PathEscape(input.ProductID) + "/offer_codes"
+	if err := shared.Request(token, http.MethodPost, path, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"offer_code": resp["offer_code"]}, nil
+}
+
+func NewCreateOfferCodeAction() sdk.Action {
+	return &CreateOfferCodeAction{}
+}
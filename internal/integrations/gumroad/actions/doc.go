@@ -25,3 +25,9 @@ var deleteProductDocs string
 
 // go:embed mark_as_shipped.md
 var markasShippedDocs string
+
+//go:embed create_offer_code.md
+var createOfferCodeDocs string
+
+//go:embed verify_license_key.md
+var verifyLicenseKeyDocs string
@@ -0,0 +1,161 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/gumroad/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type verifyLicenseKeyActionProps struct {
+	ProductID          string `json:"product_id"`
+	LicenseKey         string `json:"license_key"`
+	IncrementUsesCount bool   `json:"increment_uses_count"`
+	MaxUses            int    `json:"max_uses"`
+}
+
+type VerifyLicenseKeyAction struct{}
+
+// Metadata returns metadata about the action
+func (a *VerifyLicenseKeyAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "verify_license_key",
+		DisplayName:   "Verify License Key",
+		Description:   "Checks a license key of a product and tells whether it is still valid, counting the use if asked to.",
+		Type:          core.ActionTypeAction,
+		Documentation: verifyLicenseKeyDocs,
+		Icon:          "mdi:key-variant",
+		SampleOutput: map[string]any{
+			"valid":  true,
+			"reason": "",
+			"uses":   3,
+			"purchase": map[string]any{
+				"id":                        "OmyG5_uqbA9iMk3kwfgQ0A==",
+				"product_id":                "32-nPAicqbLj8B_WswVlMw==",
+				"product_name":              "Digital Product",
+				"email":                     "customer@example.com",
+				"license_key":               "85DB562A-C11D4B06-A2335A6B-8C079166",
+				"refunded":                  false,
+				"disputed":                  false,
+				"chargebacked":              false,
+				"subscription_ended_at":     nil,
+				"subscription_cancelled_at": nil,
+				"subscription_failed_at":    nil,
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *VerifyLicenseKeyAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("verify_license_key", "Verify License Key")
+
+	shared.RegisterProductsProps(form)
+
+	form.TextField("license_key", "License Key").
+		Required(true)
+
+	form.CheckboxField("increment_uses_count", "Count This Use").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Add one to the key's uses, for example once per activation.")
+
+	form.NumberField("max_uses", "Maximum Uses").
+		Required(false).
+		HelpText("Treat the key as invalid once it has been used more often than this. Leave empty for no limit.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *VerifyLicenseKeyAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *VerifyLicenseKeyAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[verifyLicenseKeyActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ProductID == "" {
+		return nil, errors.New("product ID is required")
+	}
+	key := strings.TrimSpace(input.LicenseKey)
+	if key == "" {
+		return nil, errors.New("license key is required")
+	}
+
+	token, err := shared.GetAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("product_id", input.ProductID)
+	params.Set("license_key", key)
+	params.Set("increment_uses_count", strconv.FormatBool(input.IncrementUsesCount))
+
+	var resp struct {
+		Uses     int                    `json:"uses"`
+		Purchase map[string]interface{} `json:"purchase"`
+	}
+	err = shared.Request(token, http.MethodPost, "/licenses/verify", params, &resp)
+
+	// An unknown key is an answer, not a failure of the step.
+	var apiErr *shared.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return map[string]interface{}{
+			"valid":    false,
+			"reason":   "not_found",
+			"uses":     0,
+			"purchase": nil,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reason := licenseProblem(resp.Purchase)
+	if reason == "" && input.MaxUses > 0 && resp.Uses > input.MaxUses {
+		reason = "max_uses_exceeded"
+	}
+
+	return map[string]interface{}{
+		"valid":    reason == "",
+		"reason":   reason,
+		"uses":     resp.Uses,
+		"purchase": resp.Purchase,
+	}, nil
+}
+
+// licenseProblem returns why the purchase of a key no longer entitles
+// the buyer to the product, or "" when it does.
+func licenseProblem(purchase map[string]interface{}) string {
+	for _, flag := range []string{"refunded", "chargebacked", "disputed"} {
+		if shared.Flag(purchase, flag) {
+			return flag
+		}
+	}
+	for _, field := range []string{"subscription_ended_at", "subscription_cancelled_at", "subscription_failed_at"} {
+		if v, _ := purchase[field].(string); v != "" {
+			return strings.TrimSuffix(field, "_at")
+		}
+	}
+	return ""
+}
+
+func NewVerifyLicenseKeyAction() sdk.Action {
+	return &VerifyLicenseKeyAction{}
+}
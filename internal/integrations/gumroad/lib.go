@@ -5,6 +5,7 @@ import (
 
 	"github.com/wakflo/extensions/internal/integrations/gumroad/actions"
 	"github.com/wakflo/extensions/internal/integrations/gumroad/shared"
+	"github.com/wakflo/extensions/internal/integrations/gumroad/triggers"
 	"github.com/wakflo/go-sdk/v2"
 	"github.com/wakflo/go-sdk/v2/core"
 )
@@ -31,7 +32,13 @@ func (n *Gumroad) Auth() *core.AuthMetadata {
 }
 
 func (n *Gumroad) Triggers() []sdk.Trigger {
-	return []sdk.Trigger{}
+	return []sdk.Trigger{
+		triggers.NewSaleCreatedTrigger(),
+		triggers.NewRefundCreatedTrigger(),
+		triggers.NewDisputeCreatedTrigger(),
+		triggers.NewSubscriptionCancelledTrigger(),
+		triggers.NewSaleActivityTrigger(),
+	}
 }
 
 func (n *Gumroad) Actions() []sdk.Action {
@@ -45,6 +52,8 @@ func (n *Gumroad) Actions() []sdk.Action {
 		actions.NewGetSaleAction(),
 		actions.NewDeleteProductAction(),
 		actions.NewMarkasShippedAction(),
+		actions.NewCreateOfferCodeAction(),
+		actions.NewVerifyLicenseKeyAction(),
 	}
 }
 
@@ -0,0 +1,106 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// GetAccessToken returns the OAuth access token of the connection.
+func GetAccessToken(ctx sdkcontext.BaseContext) (string, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return "", err
+	}
+	if authCtx.Token != nil && authCtx.Token.AccessToken != "" {
+		return authCtx.Token.AccessToken, nil
+	}
+	if authCtx.AccessToken != "" {
+		return authCtx.AccessToken, nil
+	}
+	return "", errors.New("missing Gumroad auth token")
+}
+
+// Request sends form params to an API path such as /sales and decodes
+// the response into out. Gumroad reports failures as success false with
+// a message, sometimes with a 200 status.
+func Request(accessToken, method, path string, params url.Values, out interface{}) error {
+	endpoint := baseURL + path
+
+	var body io.Reader
+	if method == http.MethodGet || method == http.MethodDelete {
+		if len(params) > 0 {
+			endpoint += "?" + params.Encode()
+		}
+	} else if len(params) > 0 {
+		body = strings.NewReader(params.Encode())
+	}
+
+	req, err := http.NewRequest(method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	var status struct {
+		Success *bool  `json:"success"`
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(data, &status)
+	if res.StatusCode >= http.StatusBadRequest || (status.Success != nil && !*status.Success) {
+		if status.Message != "" {
+			return &APIError{StatusCode: res.StatusCode, Message: status.Message}
+		}
+		return &APIError{StatusCode: res.StatusCode, Message: string(data)}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// APIError is a failed Gumroad request.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("gumroad request failed with status code %d: %s", e.StatusCode, e.Message)
+}
@@ -0,0 +1,130 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/wakflo/extensions/internal/webhook"
+)
+
+// Resource names of the resource subscriptions Gumroad pings for.
+const (
+	ResourceSale         = "sale"
+	ResourceRefund       = "refund"
+	ResourceDispute      = "dispute"
+	ResourceCancellation = "cancellation"
+)
+
+// ResourceSubscription is a subscription of a URL to pings for one kind
+// of resource.
+type ResourceSubscription struct {
+	ID           string `json:"id"`
+	ResourceName string `json:"resource_name"`
+	PostURL      string `json:"post_url"`
+}
+
+// TokenFromConfig reads the access token from a trigger config.
+func TokenFromConfig(config map[string]interface{}) (string, error) {
+	if token := webhook.Connection(config)["access_token"]; token != "" {
+		return token, nil
+	}
+	return "", errors.New("missing Gumroad auth token")
+}
+
+// Subscribe asks Gumroad to ping postURL for a resource.
+func Subscribe(accessToken, resource, postURL string) (*ResourceSubscription, error) {
+	params := url.Values{}
+	params.Set("resource_name", resource)
+	params.Set("post_url", postURL)
+
+	var resp struct {
+		ResourceSubscription ResourceSubscription `json:"resource_subscription"`
+	}
+	if err := Request(accessToken, http.MethodPut, "/resource_subscriptions", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ResourceSubscription, nil
+}
+
+// Unsubscribe removes a resource subscription.
+func Unsubscribe(accessToken, id string) error {
+	return Request(accessToken, http.MethodDelete, "/resource_subscriptions/"+url.PathEscape(id), nil, nil)
+}
+
+// PingFrom reads the form fields of a ping from trigger input, where the
+// runtime puts the request body under "body".
+func PingFrom(input map[string]interface{}) (map[string]interface{}, error) {
+	var raw string
+	switch body := input["body"].(type) {
+	case map[string]interface{}:
+		return body, nil
+	case string:
+		raw = body
+	case []byte:
+		raw = string(body)
+	case nil:
+		return nil, errors.New("ping has no body")
+	default:
+		return nil, errors.New("unexpected ping body")
+	}
+
+	values, err := url.ParseQuery(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, err
+	}
+	ping := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if len(v) == 1 {
+			ping[k] = v[0]
+		} else {
+			ping[k] = v
+		}
+	}
+	return ping, nil
+}
+
+// Field returns a string field of a ping.
+func Field(ping map[string]interface{}, key string) string {
+	s, _ := ping[key].(string)
+	return s
+}
+
+// FetchSale reads a sale. Pings carry no signature, so triggers read the
+// sale back instead of trusting the posted fields.
+func FetchSale(accessToken, saleID string) (map[string]interface{}, error) {
+	var resp struct {
+		Sale map[string]interface{} `json:"sale"`
+	}
+	if err := Request(accessToken, http.MethodGet, "/sales/"+url.PathEscape(saleID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Sale, nil
+}
+
+// FetchSubscriber reads a subscriber, the subscription of a buyer to a
+// membership product.
+func FetchSubscriber(accessToken, subscriberID string) (map[string]interface{}, error) {
+	var resp struct {
+		Subscriber map[string]interface{} `json:"subscriber"`
+	}
+	if err := Request(accessToken, http.MethodGet, "/subscribers/"+url.PathEscape(subscriberID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Subscriber, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// maxSalePages bounds the pages of sales one poll reads.
+const maxSalePages = 10
+
+// SalesSince lists the sales made on or after the day of since, newest
+// first, optionally for one product.
+func SalesSince(accessToken string, since time.Time, productID string) ([]map[string]interface{}, error) {
+	params := url.Values{}
+	params.Set("after", since.UTC().Format("2006-01-02"))
+	if productID != "" {
+		params.Set("product_id", productID)
+	}
+
+	var sales []map[string]interface{}
+	for page := 0; page < maxSalePages; page++ {
+		var resp struct {
+			Sales       []map[string]interface{} `json:"sales"`
+			NextPageKey string                   `json:"next_page_key"`
+		}
+		if err := Request(accessToken, http.MethodGet, "/sales", params, &resp); err != nil {
+			return nil, err
+		}
+		sales = append(sales, resp.Sales...)
+
+		if resp.NextPageKey == "" {
+			break
+		}
+		params.Set("page_key", resp.NextPageKey)
+	}
+	return sales, nil
+}
+
+// Flag reports whether a boolean sale field is set. Gumroad sends some
+// flags as strings in pings.
+func Flag(sale map[string]interface{}, key string) bool {
+	switch v := sale[key].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package triggers
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/gumroad/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type DisputeCreatedTrigger struct {
+	pingTrigger
+}
+
+func (t *DisputeCreatedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "dispute_created",
+		DisplayName:   "Sale Disputed (Instant)",
+		Description:   "Triggered instantly by a Gumroad ping when a buyer disputes a sale.",
+		Type:          core.TriggerTypeWebhook,
+		Documentation: disputeCreatedDocs,
+		SampleOutput:  []map[string]any{saleSample},
+	}
+}
+
+func (t *DisputeCreatedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("dispute-created", "Sale Disputed (Instant)")
+
+	t.registerProps(form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (t *DisputeCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	sale, err := t.sale(ctx, saleEvents[shared.ResourceDispute])
+	if err != nil || sale == nil {
+		return []map[string]interface{}{}, err
+	}
+
+	return []map[string]interface{}{sale}, nil
+}
+
+func (t *DisputeCreatedTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return t.criteria()
+}
+
+func NewDisputeCreatedTrigger() sdk.Trigger {
+	return &DisputeCreatedTrigger{
+		pingTrigger: pingTrigger{resource: shared.ResourceDispute},
+	}
+}
@@ -0,0 +1,18 @@
+package triggers
+
+import _ "embed"
+
+//go:embed sale_created.md
+var saleCreatedDocs string
+
+//go:embed refund_created.md
+var refundCreatedDocs string
+
+//go:embed dispute_created.md
+var disputeCreatedDocs string
+
+//go:embed subscription_cancelled.md
+var subscriptionCancelledDocs string
+
+//go:embed sale_activity.md
+var saleActivityDocs string
@@ -0,0 +1,150 @@
+package triggers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/gumroad/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+const subscriptionIDKey = "resourceSubscriptionId"
+
+type pingTriggerProps struct {
+	ProductID string `json:"product_id"`
+}
+
+// pingTrigger subscribes the trigger's URL to Gumroad pings for one
+// resource while the trigger is active.
+type pingTrigger struct {
+	resource string
+}
+
+func (p pingTrigger) GetType() core.TriggerType {
+	return core.TriggerTypeWebhook
+}
+
+func (p pingTrigger) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (p pingTrigger) criteria() core.TriggerCriteria {
+	webhook := core.NewWebhookTriggerCriteria()
+	webhook.Enabled = true
+	webhook.ContentType = "application/x-www-form-urlencoded"
+	return core.TriggerCriteria{Webhook: webhook}
+}
+
+func (p pingTrigger) registerProps(form *smartform.FormBuilder) {
+	shared.RegisterProductsProps(form)
+}
+
+// Start subscribes the trigger's endpoint to the resource.
+func (p pingTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	token, err := shared.TokenFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+
+	criteria, err := ctx.TriggerCriteria()
+	if err != nil {
+		return err
+	}
+	if criteria == nil || criteria.Webhook == nil || criteria.Webhook.Endpoint == "" {
+		return errors.New("the trigger has no webhook endpoint")
+	}
+
+	subscription, err := shared.Subscribe(token, p.resource, criteria.Webhook.Endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to Gumroad %s pings: %w", p.resource, err)
+	}
+	return ctx.StoreMetadata(subscriptionIDKey, subscription.ID)
+}
+
+// Stop removes the subscription made by Start.
+func (p pingTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	stored, err := ctx.GetMetadata(subscriptionIDKey)
+	if err != nil || stored == nil {
+		return err
+	}
+	id, ok := stored.(string)
+	if !ok || id == "" {
+		return fmt.Errorf("invalid stored subscription id %v", stored)
+	}
+
+	token, err := shared.TokenFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+	return shared.Unsubscribe(token, id)
+}
+
+// sale reads back the sale a ping is about and returns it when it
+// matches the event and the product filter, or nil otherwise.
+func (p pingTrigger) sale(ctx sdkcontext.ExecuteContext, matches func(map[string]interface{}) bool) (map[string]interface{}, error) {
+	input, err := sdk.InputToTypeSafely[pingTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ping, err := shared.PingFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+	saleID := shared.Field(ping, "sale_id")
+	if saleID == "" {
+		return nil, errors.New("ping has no sale_id")
+	}
+	if input.ProductID != "" && shared.Field(ping, "product_id") != input.ProductID {
+		return nil, nil
+	}
+
+	token, err := shared.GetAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sale, err := shared.FetchSale(token, saleID)
+	if err != nil {
+		return nil, err
+	}
+	if sale == nil || !matches(sale) {
+		return nil, nil
+	}
+	return sale, nil
+}
+
+// Matchers of the sale events, shared with the polling trigger.
+var saleEvents = map[string]func(map[string]interface{}) bool{
+	shared.ResourceSale: func(map[string]interface{}) bool {
+		return true
+	},
+	shared.ResourceRefund: func(sale map[string]interface{}) bool {
+		return shared.Flag(sale, "refunded") || shared.Flag(sale, "partially_refunded")
+	},
+	shared.ResourceDispute: func(sale map[string]interface{}) bool {
+		return shared.Flag(sale, "disputed")
+	},
+	shared.ResourceCancellation: func(sale map[string]interface{}) bool {
+		cancelledAt, _ := sale["subscription_cancelled_at"].(string)
+		return shared.Flag(sale, "cancelled") || cancelledAt != ""
+	},
+}
+
+var saleSample = map[string]any{
+	"id":             "B28UKN-dvxYabdavG97Y-Q==",
+	"product_id":     "32-nPAicqbLj8B_WswVlMw==",
+	"product_name":   "Digital Product",
+	"email":          "customer@example.com",
+	"full_name":      "John Doe",
+	"price":          1999,
+	"currency":       "usd",
+	"order_id":       524459995,
+	"license_key":    "83DB262A-C19D41B0-A5A6E57F-9E8D7B2C",
+	"refunded":       false,
+	"disputed":       false,
+	"created_at":     "2025-03-12T18:20:00Z",
+	"sale_timestamp": "2025-03-12T18:20:00Z",
+}
@@ -0,0 +1,54 @@
+package triggers
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/gumroad/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type RefundCreatedTrigger struct {
+	pingTrigger
+}
+
+func (t *RefundCreatedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "refund_created",
+		DisplayName:   "Sale Refunded (Instant)",
+		Description:   "Triggered instantly by a Gumroad ping when a sale is refunded in full or in part.",
+		Type:          core.TriggerTypeWebhook,
+		Documentation: refundCreatedDocs,
+		SampleOutput:  []map[string]any{saleSample},
+	}
+}
+
+func (t *RefundCreatedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("refund-created", "Sale Refunded (Instant)")
+
+	t.registerProps(form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (t *RefundCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	sale, err := t.sale(ctx, saleEvents[shared.ResourceRefund])
+	if err != nil || sale == nil {
+		return []map[string]interface{}{}, err
+	}
+
+	return []map[string]interface{}{sale}, nil
+}
+
+func (t *RefundCreatedTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return t.criteria()
+}
+
+func NewRefundCreatedTrigger() sdk.Trigger {
+	return &RefundCreatedTrigger{
+		pingTrigger: pingTrigger{resource: shared.ResourceRefund},
+	}
+}
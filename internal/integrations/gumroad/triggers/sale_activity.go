@@ -0,0 +1,156 @@
+package triggers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/gumroad/shared"
+	"github.com/wakflo/extensions/internal/polling"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type saleActivityTriggerProps struct {
+	Event        string `json:"event"`
+	ProductID    string `json:"product_id"`
+	LookbackDays int    `json:"lookback_days"`
+}
+
+type SaleActivityTrigger struct{}
+
+func (t *SaleActivityTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "sale_activity",
+		DisplayName:   "Sale Activity (Polling)",
+		Description:   "Polls Gumroad sales for new sales, refunds, disputes or subscription cancellations, for when pings can't be received.",
+		Type:          core.TriggerTypePolling,
+		Documentation: saleActivityDocs,
+		SampleOutput:  []map[string]any{saleSample},
+	}
+}
+
+func (t *SaleActivityTrigger) GetType() core.TriggerType {
+	return core.TriggerTypePolling
+}
+
+func (t *SaleActivityTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("sale-activity", "Sale Activity (Polling)")
+
+	form.SelectField("event", "Event").
+		Required(true).
+		AddOption(shared.ResourceSale, "New Sale").
+		AddOption(shared.ResourceRefund, "Sale Refunded").
+		AddOption(shared.ResourceDispute, "Sale Disputed").
+		AddOption(shared.ResourceCancellation, "Subscription Cancelled").
+		DefaultValue(shared.ResourceSale)
+
+	shared.RegisterProductsProps(form)
+
+	form.NumberField("lookback_days", "Lookback Days").
+		Required(false).
+		DefaultValue(30).
+		HelpText("How old a sale can be for its refund, dispute or cancellation to be noticed.")
+
+	schema := form.Build()
+	return schema
+}
+
+// Start initializes the SaleActivityTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *SaleActivityTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the SaleActivityTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *SaleActivityTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute lists recent sales and returns those the event newly applies
+// to. Refunds, disputes and cancellations change old sales, so those
+// events scan the lookback window and remember the sales already seen.
+func (t *SaleActivityTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[saleActivityTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event := input.Event
+	if event == "" {
+		event = shared.ResourceSale
+	}
+	matches, ok := saleEvents[event]
+	if !ok {
+		return nil, fmt.Errorf("unknown event %q", event)
+	}
+
+	token, err := shared.GetAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lastRun := ctx.LastRun()
+	since := time.Now().Add(-24 * time.Hour)
+	if event == shared.ResourceSale {
+		if lastRun != nil {
+			since = *lastRun
+		}
+	} else {
+		days := input.LookbackDays
+		if days <= 0 {
+			days = 30
+		}
+		since = time.Now().AddDate(0, 0, -days)
+	}
+
+	sales, err := shared.SalesSince(token, since, input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	byID := map[string]map[string]interface{}{}
+	for _, sale := range sales {
+		id, _ := sale["id"].(string)
+		if id == "" || !matches(sale) {
+			continue
+		}
+		ids = append(ids, id)
+		byID[id] = sale
+	}
+
+	fresh := polling.Unseen(ctx, "seenSales:"+event, ids)
+
+	// The first poll of a change event only records the current state,
+	// so old refunds don't all fire at once.
+	if lastRun == nil && event != shared.ResourceSale {
+		return []map[string]interface{}{}, nil
+	}
+
+	out := make([]map[string]interface{}, 0, len(fresh))
+	for _, id := range ids {
+		if fresh[id] {
+			out = append(out, byID[id])
+			delete(fresh, id)
+		}
+	}
+	return out, nil
+}
+
+func (t *SaleActivityTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return core.TriggerCriteria{}
+}
+
+func (t *SaleActivityTrigger) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (t *SaleActivityTrigger) SampleData() core.JSON {
+	return saleSample
+}
+
+func NewSaleActivityTrigger() sdk.Trigger {
+	return &SaleActivityTrigger{}
+}
@@ -0,0 +1,54 @@
+package triggers
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/gumroad/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type SaleCreatedTrigger struct {
+	pingTrigger
+}
+
+func (t *SaleCreatedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "sale_created",
+		DisplayName:   "New Sale (Instant)",
+		Description:   "Triggered instantly by a Gumroad ping when a product is sold.",
+		Type:          core.TriggerTypeWebhook,
+		Documentation: saleCreatedDocs,
+		SampleOutput:  []map[string]any{saleSample},
+	}
+}
+
+func (t *SaleCreatedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("sale-created", "New Sale (Instant)")
+
+	t.registerProps(form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (t *SaleCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	sale, err := t.sale(ctx, saleEvents[shared.ResourceSale])
+	if err != nil || sale == nil {
+		return []map[string]interface{}{}, err
+	}
+
+	return []map[string]interface{}{sale}, nil
+}
+
+func (t *SaleCreatedTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return t.criteria()
+}
+
+func NewSaleCreatedTrigger() sdk.Trigger {
+	return &SaleCreatedTrigger{
+		pingTrigger: pingTrigger{resource: shared.ResourceSale},
+	}
+}
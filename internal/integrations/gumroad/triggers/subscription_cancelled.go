@@ -0,0 +1,98 @@
+package triggers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/gumroad/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type SubscriptionCancelledTrigger struct {
+	pingTrigger
+}
+
+func (t *SubscriptionCancelledTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "subscription_cancelled",
+		DisplayName:   "Subscription Cancelled (Instant)",
+		Description:   "Triggered instantly by a Gumroad ping when a membership subscription is cancelled by the buyer, the seller or Gumroad.",
+		Type:          core.TriggerTypeWebhook,
+		Documentation: subscriptionCancelledDocs,
+		SampleOutput: []map[string]any{
+			{
+				"id":                             "P5ppE6H8XIjy2JSCgUhbAw==",
+				"product_id":                     "32-nPAicqbLj8B_WswVlMw==",
+				"product_name":                   "Pencil Icon PSD",
+				"user_email":                     "customer@example.com",
+				"purchase_ids":                   []string{"O4pjE6H8XNjy2JSCgUhbAw=="},
+				"recurrence":                     "monthly",
+				"status":                         "pending_cancellation",
+				"user_requested_cancellation_at": "2025-03-12T18:20:00Z",
+				"cancelled_at":                   nil,
+				"charge_occurrence_count":        nil,
+				"created_at":                     "2025-01-12T18:20:00Z",
+			},
+		},
+	}
+}
+
+func (t *SubscriptionCancelledTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("subscription-cancelled", "Subscription Cancelled (Instant)")
+
+	t.registerProps(form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (t *SubscriptionCancelledTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[pingTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ping, err := shared.PingFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := shared.Field(ping, "subscription_id")
+	if subscriptionID == "" {
+		return nil, errors.New("ping has no subscription_id")
+	}
+	if input.ProductID != "" && shared.Field(ping, "product_id") != input.ProductID {
+		return []map[string]interface{}{}, nil
+	}
+
+	token, err := shared.GetAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pings carry no signature, so the cancellation is confirmed on the
+	// subscriber itself.
+	subscriber, err := shared.FetchSubscriber(token, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	cancelledAt, _ := subscriber["cancelled_at"].(string)
+	requestedAt, _ := subscriber["user_requested_cancellation_at"].(string)
+	if cancelledAt == "" && requestedAt == "" {
+		return []map[string]interface{}{}, nil
+	}
+
+	return []map[string]interface{}{subscriber}, nil
+}
+
+func (t *SubscriptionCancelledTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return t.criteria()
+}
+
+func NewSubscriptionCancelledTrigger() sdk.Trigger {
+	return &SubscriptionCancelledTrigger{
+		pingTrigger: pingTrigger{resource: shared.ResourceCancellation},
+	}
+}
@@ -0,0 +1,124 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type associateRecordsActionProps struct {
+	FromType        string `json:"from_type"`
+	FromID          string `json:"from_id"`
+	ToType          string `json:"to_type"`
+	ToIDs           string `json:"to_ids"`
+	AssociationType string `json:"association_type"`
+	Remove          bool   `json:"remove"`
+}
+
+type AssociateRecordsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *AssociateRecordsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "associate_records",
+		DisplayName:   "Associate Records",
+		Description:   "Link a HubSpot contact, company, deal or ticket to other records, with the default association or a label, or remove their associations.",
+		Type:          core.ActionTypeAction,
+		Documentation: associateRecordsDocs,
+		SampleOutput: map[string]any{
+			"from_type": "deals",
+			"from_id":   "12345",
+			"to_type":   "contacts",
+			"to_ids":    []string{"51", "52"},
+			"removed":   false,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *AssociateRecordsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("associate_records", "Associate Records")
+
+	form.SelectField("from_type", "From Object").
+		Required(true).
+		AddOptions(shared.ObjectTypeOptions...)
+
+	form.TextField("from_id", "From Record ID").
+		Required(true)
+
+	form.SelectField("to_type", "To Object").
+		Required(true).
+		AddOptions(shared.ObjectTypeOptions...)
+
+	form.TextField("to_ids", "To Record IDs").
+		Required(true).
+		HelpText("Comma-separated IDs of the records to link")
+
+	shared.RegisterAssociationTypeProps(form)
+
+	form.CheckboxField("remove", "Remove Associations").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Remove every association between the records instead of adding one")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *AssociateRecordsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *AssociateRecordsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[associateRecordsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var label *shared.AssociationType
+	if input.AssociationType != "" && !input.Remove {
+		t, err := shared.ParseAssociationType(input.AssociationType)
+		if err != nil {
+			return nil, err
+		}
+		label = &t
+	}
+
+	toIDs := shared.SplitProperties(input.ToIDs)
+	for _, toID := range toIDs {
+		switch {
+		case input.Remove:
+			err = shared.RemoveAssociations(token, input.FromType, input.FromID, input.ToType, toID)
+		case label != nil:
+			err = shared.Associate(token, input.FromType, input.FromID, input.ToType, toID, *label)
+		default:
+			err = shared.AssociateDefault(token, input.FromType, input.FromID, input.ToType, toID)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{
+		"from_type": input.FromType,
+		"from_id":   input.FromID,
+		"to_type":   input.ToType,
+		"to_ids":    toIDs,
+		"removed":   input.Remove,
+	}, nil
+}
+
+func NewAssociateRecordsAction() sdk.Action {
+	return &AssociateRecordsAction{}
+}
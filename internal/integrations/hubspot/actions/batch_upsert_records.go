@@ -0,0 +1,172 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type batchUpsertRecordsActionProps struct {
+	ObjectType       string `json:"object_type"`
+	CustomObjectType string `json:"custom_object_type"`
+	IDProperty       string `json:"id_property"`
+}
+
+type BatchUpsertRecordsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *BatchUpsertRecordsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "batch_upsert_records",
+		DisplayName:   "Batch Create or Update Records",
+		Description:   "Create or update many HubSpot records from a list or a CSV file, 100 per request, finding each by a unique property such as email.",
+		Type:          core.ActionTypeAction,
+		Documentation: batchUpsertRecordsDocs,
+		SampleOutput: map[string]any{
+			"total":     2,
+			"succeeded": 1,
+			"failed":    1,
+			"results": []map[string]any{
+				{"row": 1, "key": "jane.doe@example.com", "id": "51", "status": "created"},
+				{"row": 2, "key": "john@example.com", "status": "failed", "error": "Property values were not valid: lifecyclestage"},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *BatchUpsertRecordsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("batch_upsert_records", "Batch Create or Update Records")
+
+	form.SelectField("object_type", "Object").
+		Required(true).
+		DefaultValue(shared.ObjectContacts).
+		AddOptions(shared.ObjectTypeOptions...)
+
+	form.TextField("custom_object_type", "Custom Object Type").
+		Required(false).
+		HelpText("A custom object type ID such as 2-1234567, used instead of the object above.")
+
+	form.TextField("id_property", "Unique Property").
+		Required(true).
+		DefaultValue("email").
+		HelpText("The property records are found by, such as email for contacts or another property with unique values. Every record must have it.")
+
+	crm.RegisterBulkProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *BatchUpsertRecordsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform writes the records in batches of the most HubSpot takes per
+// request, and matches the results and errors of each batch back to the
+// records by their unique property.
+func (a *BatchUpsertRecordsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[batchUpsertRecordsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objectType := strings.TrimSpace(input.CustomObjectType)
+	if objectType == "" {
+		objectType = input.ObjectType
+	}
+	idProperty := strings.TrimSpace(input.IDProperty)
+	if objectType == "" || idProperty == "" {
+		return nil, fmt.Errorf("object and unique property are required")
+	}
+
+	records, err := crm.LoadRecords(ctx.Context(), ctx.Files(), ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]crm.RowResult, len(records))
+	rows := map[string]int{}
+	var inputs []shared.UpsertInput
+	var keys []string
+	for i, record := range records {
+		results[i] = crm.RowResult{Row: i + 1}
+		id := strings.TrimSpace(fmt.Sprint(record[idProperty]))
+		if record[idProperty] == nil || id == "" {
+			results[i].Status, results[i].Error = crm.RowFailed, "no value for "+idProperty
+			continue
+		}
+		key := strings.ToLower(id)
+		results[i].Key = id
+		if first, ok := rows[key]; ok {
+			results[i].Status, results[i].Error = crm.RowFailed, fmt.Sprintf("duplicate of row %d", first+1)
+			continue
+		}
+		rows[key] = i
+		inputs = append(inputs, shared.UpsertInput{IDProperty: idProperty, ID: id, Properties: record})
+		keys = append(keys, key)
+	}
+
+	batchKeys := crm.Chunk(keys, shared.MaxBatchSize)
+	for n, batch := range crm.Chunk(inputs, shared.MaxBatchSize) {
+		result, err := shared.BatchUpsert(token, objectType, batch)
+		if err != nil {
+			for _, key := range batchKeys[n] {
+				results[rows[key]].Status, results[rows[key]].Error = crm.RowFailed, err.Error()
+			}
+			continue
+		}
+
+		for _, obj := range result.Results {
+			i, ok := rows[strings.ToLower(obj.Property(idProperty))]
+			if !ok {
+				continue
+			}
+			results[i].ID, results[i].Status = obj.ID, crm.RowUpdated
+			if obj.New {
+				results[i].Status = crm.RowCreated
+			}
+		}
+		for _, batchErr := range result.Errors {
+			for _, id := range batchErr.IDs() {
+				if i, ok := rows[strings.ToLower(id)]; ok {
+					results[i].Status, results[i].Error = crm.RowFailed, batchErr.Message
+				}
+			}
+		}
+
+		// Records HubSpot reported neither way failed when the batch had
+		// errors it didn't attribute, and were written otherwise.
+		for _, key := range batchKeys[n] {
+			r := &results[rows[key]]
+			if r.Status != "" {
+				continue
+			}
+			if len(result.Errors) > 0 {
+				r.Status, r.Error = crm.RowFailed, result.Errors[0].Message
+			} else {
+				r.Status = crm.RowSucceeded
+			}
+		}
+	}
+
+	return crm.BulkOutput(results), nil
+}
+
+func NewBatchUpsertRecordsAction() sdk.Action {
+	return &BatchUpsertRecordsAction{}
+}
@@ -0,0 +1,97 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+)
+
+type companyFields struct {
+	Name              string   `json:"name"`
+	Domain            string   `json:"domain"`
+	Industry          string   `json:"industry"`
+	Phone             string   `json:"phone"`
+	City              string   `json:"city"`
+	State             string   `json:"state"`
+	Country           string   `json:"country"`
+	NumberOfEmployees *float64 `json:"numberofemployees"`
+	AnnualRevenue     *float64 `json:"annualrevenue"`
+	Description       string   `json:"description"`
+	OwnerID           string   `json:"hubspot_owner_id"`
+	Properties        string   `json:"properties"`
+}
+
+var companySample = map[string]any{
+	"id": "9876543210",
+	"properties": map[string]any{
+		"name":                "Acme Corp",
+		"domain":              "acme.com",
+		"industry":            "COMPUTER_SOFTWARE",
+		"city":                "Boston",
+		"hs_object_id":        "9876543210",
+		"createdate":          "2024-03-01T10:00:00.000Z",
+		"hs_lastmodifieddate": "2024-03-01T10:00:00.000Z",
+	},
+	"createdAt": "2024-03-01T10:00:00.000Z",
+	"updatedAt": "2024-03-01T10:00:00.000Z",
+	"archived":  false,
+}
+
+func registerCompanyFields(form *smartform.FormBuilder, creating bool) {
+	form.TextField("name", "Company Name").
+		Required(creating).
+		HelpText("Name of the company")
+
+	form.TextField("domain", "Domain").
+		Required(false).
+		HelpText("Website domain of the company, such as acme.com")
+
+	form.TextField("industry", "Industry").
+		Required(false).
+		HelpText("Internal value of the industry, such as COMPUTER_SOFTWARE")
+
+	form.TextField("phone", "Phone").
+		Required(false)
+
+	form.TextField("city", "City").
+		Required(false)
+
+	form.TextField("state", "State/Region").
+		Required(false)
+
+	form.TextField("country", "Country").
+		Required(false)
+
+	form.NumberField("numberofemployees", "Number of Employees").
+		Required(false)
+
+	form.NumberField("annualrevenue", "Annual Revenue").
+		Required(false)
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	shared.RegisterOwnerProps(form, "hubspot_owner_id", "Company Owner")
+
+	form.TextareaField("properties", "Additional Properties").
+		Required(false).
+		HelpText("JSON object of other properties, such as {\"lifecyclestage\": \"customer\"}")
+}
+
+func (f companyFields) properties() (map[string]interface{}, error) {
+	props, err := shared.ParseProperties(f.Properties)
+	if err != nil {
+		return nil, err
+	}
+	shared.SetIfNotEmpty(props, "name", f.Name)
+	shared.SetIfNotEmpty(props, "domain", f.Domain)
+	shared.SetIfNotEmpty(props, "industry", f.Industry)
+	shared.SetIfNotEmpty(props, "phone", f.Phone)
+	shared.SetIfNotEmpty(props, "city", f.City)
+	shared.SetIfNotEmpty(props, "state", f.State)
+	shared.SetIfNotEmpty(props, "country", f.Country)
+	shared.SetNumber(props, "numberofemployees", f.NumberOfEmployees)
+	shared.SetNumber(props, "annualrevenue", f.AnnualRevenue)
+	shared.SetIfNotEmpty(props, "description", f.Description)
+	shared.SetIfNotEmpty(props, "hubspot_owner_id", f.OwnerID)
+	return props, nil
+}
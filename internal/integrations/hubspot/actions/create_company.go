@@ -0,0 +1,97 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createCompanyActionProps struct {
+	companyFields
+	ContactIDs string `json:"contact_ids"`
+	DealIDs    string `json:"deal_ids"`
+}
+
+type CreateCompanyAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateCompanyAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_company",
+		DisplayName:   "Create Company",
+		Description:   "Create a company in HubSpot and associate it with contacts and deals.",
+		Type:          core.ActionTypeAction,
+		Documentation: createCompanyDocs,
+		SampleOutput: map[string]any{
+			"company":    companySample,
+			"associated": []map[string]any{{"type": "contacts", "id": "51"}},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateCompanyAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_company", "Create Company")
+
+	registerCompanyFields(form, true)
+
+	form.TextField("contact_ids", "Contact IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of contacts to associate with the company")
+
+	form.TextField("deal_ids", "Deal IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of deals to associate with the company")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateCompanyAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateCompanyAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createCompanyActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := input.properties()
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := shared.CreateObject(token, shared.ObjectCompanies, props)
+	if err != nil {
+		return nil, err
+	}
+
+	associated, err := associateAll(token, shared.ObjectCompanies, company.ID, map[string]string{
+		shared.ObjectContacts: input.ContactIDs,
+		shared.ObjectDeals:    input.DealIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"company":    company,
+		"associated": associated,
+	}, nil
+}
+
+func NewCreateCompanyAction() sdk.Action {
+	return &CreateCompanyAction{}
+}
@@ -0,0 +1,121 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createCustomObjectActionProps struct {
+	ObjectType string `json:"object_type"`
+	Properties string `json:"properties"`
+	ContactIDs string `json:"contact_ids"`
+	CompanyIDs string `json:"company_ids"`
+	DealIDs    string `json:"deal_ids"`
+}
+
+type CreateCustomObjectAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateCustomObjectAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_custom_object",
+		DisplayName:   "Create Custom Object Record",
+		Description:   "Create a record of a HubSpot custom object, checking its properties against the object's schema.",
+		Type:          core.ActionTypeAction,
+		Documentation: createCustomObjectDocs,
+		SampleOutput: map[string]any{
+			"record":     customObjectSample,
+			"associated": []map[string]any{},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateCustomObjectAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_custom_object", "Create Custom Object Record")
+
+	shared.RegisterCustomObjectProps(form)
+
+	form.TextareaField("properties", "Properties").
+		Required(true).
+		HelpText("JSON object of the record's properties, by internal name")
+
+	form.TextField("contact_ids", "Contact IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of contacts to associate with the record")
+
+	form.TextField("company_ids", "Company IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of companies to associate with the record")
+
+	form.TextField("deal_ids", "Deal IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of deals to associate with the record")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateCustomObjectAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateCustomObjectAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createCustomObjectActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := shared.ParseProperties(input.Properties)
+	if err != nil {
+		return nil, err
+	}
+	if len(props) == 0 {
+		return nil, errors.New("properties are required")
+	}
+
+	schema, err := shared.GetSchema(token, input.ObjectType)
+	if err != nil {
+		return nil, err
+	}
+	if err := schema.CheckProperties(props, true); err != nil {
+		return nil, err
+	}
+
+	record, err := shared.CreateObject(token, schema.ObjectTypeID, props)
+	if err != nil {
+		return nil, err
+	}
+
+	associated, err := associateAll(token, schema.ObjectTypeID, record.ID, map[string]string{
+		shared.ObjectContacts:  input.ContactIDs,
+		shared.ObjectCompanies: input.CompanyIDs,
+		shared.ObjectDeals:     input.DealIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"record":     record,
+		"associated": associated,
+	}, nil
+}
+
+func NewCreateCustomObjectAction() sdk.Action {
+	return &CreateCustomObjectAction{}
+}
@@ -0,0 +1,97 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createDealActionProps struct {
+	dealFields
+	ContactIDs string `json:"contact_ids"`
+	CompanyIDs string `json:"company_ids"`
+}
+
+type CreateDealAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateDealAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_deal",
+		DisplayName:   "Create Deal",
+		Description:   "Create a deal in a HubSpot pipeline stage and associate it with contacts and companies.",
+		Type:          core.ActionTypeAction,
+		Documentation: createDealDocs,
+		SampleOutput: map[string]any{
+			"deal":       dealSample,
+			"associated": []map[string]any{{"type": "companies", "id": "9876543210"}},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateDealAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_deal", "Create Deal")
+
+	registerDealFields(form, true)
+
+	form.TextField("contact_ids", "Contact IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of contacts to associate with the deal")
+
+	form.TextField("company_ids", "Company IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of companies to associate with the deal")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateDealAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateDealAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createDealActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := input.properties()
+	if err != nil {
+		return nil, err
+	}
+
+	deal, err := shared.CreateObject(token, shared.ObjectDeals, props)
+	if err != nil {
+		return nil, err
+	}
+
+	associated, err := associateAll(token, shared.ObjectDeals, deal.ID, map[string]string{
+		shared.ObjectContacts:  input.ContactIDs,
+		shared.ObjectCompanies: input.CompanyIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"deal":       deal,
+		"associated": associated,
+	}, nil
+}
+
+func NewCreateDealAction() sdk.Action {
+	return &CreateDealAction{}
+}
@@ -0,0 +1,58 @@
+package actions
+
+import (
+	"strings"
+
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// accessToken returns the OAuth access token of the connection.
+func accessToken(ctx sdkcontext.PerformContext) (string, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return "", err
+	}
+	return authCtx.Token.AccessToken, nil
+}
+
+// associateAll links a record to the records listed by object type, as
+// comma-separated IDs, with the default association of each type.
+func associateAll(token, fromType, fromID string, targets map[string]string) ([]map[string]interface{}, error) {
+	associated := []map[string]interface{}{}
+	for _, toType := range []string{shared.ObjectContacts, shared.ObjectCompanies, shared.ObjectDeals, shared.ObjectTickets} {
+		for _, toID := range shared.SplitProperties(targets[toType]) {
+			if err := shared.AssociateDefault(token, fromType, fromID, toType, toID); err != nil {
+				return associated, err
+			}
+			associated = append(associated, map[string]interface{}{"type": toType, "id": toID})
+		}
+	}
+	return associated, nil
+}
+
+// searchOutput flattens a page of search results for action output.
+func searchOutput(page *shared.SearchPage) map[string]interface{} {
+	return map[string]interface{}{
+		"total":   page.Total,
+		"count":   len(page.Results),
+		"results": page.Results,
+		"after":   page.After,
+		"hasMore": page.After != "",
+	}
+}
+
+// searchFields are the fields added by shared.RegisterSearchProps.
+type searchFields struct {
+	Query          string `json:"query"`
+	FilterProperty string `json:"filter_property"`
+	FilterOperator string `json:"filter_operator"`
+	FilterValue    string `json:"filter_value"`
+	Properties     string `json:"properties"`
+	Limit          int    `json:"limit"`
+	After          string `json:"after"`
+}
+
+func (f searchFields) request() shared.SearchRequest {
+	return shared.NewSearchRequest(f.Query, strings.TrimSpace(f.FilterProperty), f.FilterOperator, f.FilterValue, f.Properties, f.Limit, f.After)
+}
@@ -0,0 +1,28 @@
+package actions
+
+import (
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+)
+
+var customObjectSample = map[string]any{
+	"id": "18394857",
+	"properties": map[string]any{
+		"hs_object_id":        "18394857",
+		"name":                "Unit 4B",
+		"hs_createdate":       "2024-03-01T10:00:00.000Z",
+		"hs_lastmodifieddate": "2024-03-01T10:00:00.000Z",
+	},
+	"createdAt": "2024-03-01T10:00:00.000Z",
+	"updatedAt": "2024-03-01T10:00:00.000Z",
+	"archived":  false,
+}
+
+// schemaProperties returns the names of the properties a schema defines,
+// which a record is read with when none are asked for.
+func schemaProperties(schema *shared.Schema) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for _, p := range schema.Properties {
+		names = append(names, p.Name)
+	}
+	return names
+}
@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+)
+
+type dealFields struct {
+	DealName    string   `json:"dealname"`
+	Amount      *float64 `json:"amount"`
+	Pipeline    string   `json:"pipeline"`
+	DealStage   string   `json:"dealstage"`
+	CloseDate   string   `json:"closedate"`
+	DealType    string   `json:"dealtype"`
+	Description string   `json:"description"`
+	OwnerID     string   `json:"hubspot_owner_id"`
+	Properties  string   `json:"properties"`
+}
+
+var dealSample = map[string]any{
+	"id": "12345",
+	"properties": map[string]any{
+		"dealname":            "Acme renewal",
+		"amount":              "10000",
+		"pipeline":            "default",
+		"dealstage":           "qualifiedtobuy",
+		"closedate":           "2024-06-30T00:00:00.000Z",
+		"hs_object_id":        "12345",
+		"createdate":          "2024-03-01T10:00:00.000Z",
+		"hs_lastmodifieddate": "2024-03-01T10:00:00.000Z",
+	},
+	"createdAt": "2024-03-01T10:00:00.000Z",
+	"updatedAt": "2024-03-01T10:00:00.000Z",
+	"archived":  false,
+}
+
+func registerDealFields(form *smartform.FormBuilder, creating bool) {
+	form.TextField("dealname", "Deal Name").
+		Required(creating).
+		HelpText("Name of the deal")
+
+	form.NumberField("amount", "Amount").
+		Required(false).
+		HelpText("Value of the deal")
+
+	shared.RegisterPipelineProps(form, creating)
+
+	form.DateField("closedate", "Close Date").
+		Required(false).
+		HelpText("Expected close date of the deal")
+
+	form.SelectField("dealtype", "Deal Type").
+		Required(false).
+		AddOption("newbusiness", "New Business").
+		AddOption("existingbusiness", "Existing Business")
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	shared.RegisterOwnerProps(form, "hubspot_owner_id", "Deal Owner")
+
+	form.TextareaField("properties", "Additional Properties").
+		Required(false).
+		HelpText("JSON object of other properties, such as {\"hs_priority\": \"high\"}")
+}
+
+func (f dealFields) properties() (map[string]interface{}, error) {
+	props, err := shared.ParseProperties(f.Properties)
+	if err != nil {
+		return nil, err
+	}
+	shared.SetIfNotEmpty(props, "dealname", f.DealName)
+	shared.SetNumber(props, "amount", f.Amount)
+	shared.SetIfNotEmpty(props, "pipeline", f.Pipeline)
+	shared.SetIfNotEmpty(props, "dealstage", f.DealStage)
+	shared.SetIfNotEmpty(props, "closedate", f.CloseDate)
+	shared.SetIfNotEmpty(props, "dealtype", f.DealType)
+	shared.SetIfNotEmpty(props, "description", f.Description)
+	shared.SetIfNotEmpty(props, "hubspot_owner_id", f.OwnerID)
+	return props, nil
+}
@@ -0,0 +1,79 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type deleteCustomObjectActionProps struct {
+	ObjectType string `json:"object_type"`
+	RecordID   string `json:"record_id"`
+}
+
+type DeleteCustomObjectAction struct{}
+
+// Metadata returns metadata about the action
+func (a *DeleteCustomObjectAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_custom_object",
+		DisplayName:   "Delete Custom Object Record",
+		Description:   "Archive a record of a HubSpot custom object.",
+		Type:          core.ActionTypeAction,
+		Documentation: deleteCustomObjectDocs,
+		SampleOutput: map[string]any{
+			"object_type": "2-1234567",
+			"id":          "18394857",
+			"archived":    true,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *DeleteCustomObjectAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_custom_object", "Delete Custom Object Record")
+
+	shared.RegisterCustomObjectProps(form)
+
+	form.TextField("record_id", "Record ID").
+		Required(true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *DeleteCustomObjectAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *DeleteCustomObjectAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[deleteCustomObjectActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.DeleteObject(token, input.ObjectType, input.RecordID); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"object_type": input.ObjectType,
+		"id":          input.RecordID,
+		"archived":    true,
+	}, nil
+}
+
+func NewDeleteCustomObjectAction() sdk.Action {
+	return &DeleteCustomObjectAction{}
+}
@@ -22,3 +22,54 @@ var searchOwnerDocs string
 
 //go:embed get_deal.md
 var getDealDocs string
+
+//go:embed create_company.md
+var createCompanyDocs string
+
+//go:embed update_company.md
+var updateCompanyDocs string
+
+//go:embed search_companies.md
+var searchCompaniesDocs string
+
+//go:embed create_deal.md
+var createDealDocs string
+
+//go:embed update_deal.md
+var updateDealDocs string
+
+//go:embed search_deals.md
+var searchDealsDocs string
+
+//go:embed move_deal_stage.md
+var moveDealStageDocs string
+
+//go:embed associate_records.md
+var associateRecordsDocs string
+
+//go:embed list_associations.md
+var listAssociationsDocs string
+
+//go:embed log_engagement.md
+var logEngagementDocs string
+
+//go:embed create_custom_object.md
+var createCustomObjectDocs string
+
+//go:embed get_custom_object.md
+var getCustomObjectDocs string
+
+//go:embed update_custom_object.md
+var updateCustomObjectDocs string
+
+//go:embed delete_custom_object.md
+var deleteCustomObjectDocs string
+
+//go:embed search_custom_objects.md
+var searchCustomObjectsDocs string
+
+//go:embed upsert_contact.md
+var upsertContactDocs string
+
+//go:embed batch_upsert_records.md
+var batchUpsertRecordsDocs string
@@ -0,0 +1,82 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type getCustomObjectActionProps struct {
+	ObjectType string `json:"object_type"`
+	RecordID   string `json:"record_id"`
+	Properties string `json:"properties"`
+}
+
+type GetCustomObjectAction struct{}
+
+// Metadata returns metadata about the action
+func (a *GetCustomObjectAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_custom_object",
+		DisplayName:   "Get Custom Object Record",
+		Description:   "Retrieve a record of a HubSpot custom object with all the properties of its schema.",
+		Type:          core.ActionTypeAction,
+		Documentation: getCustomObjectDocs,
+		SampleOutput:  customObjectSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *GetCustomObjectAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_custom_object", "Get Custom Object Record")
+
+	shared.RegisterCustomObjectProps(form)
+
+	form.TextField("record_id", "Record ID").
+		Required(true)
+
+	form.TextField("properties", "Properties").
+		Required(false).
+		HelpText("Comma-separated properties to return. Defaults to every property of the object.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *GetCustomObjectAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *GetCustomObjectAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getCustomObjectActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := shared.GetSchema(token, input.ObjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := shared.SplitProperties(input.Properties)
+	if len(properties) == 0 {
+		properties = schemaProperties(schema)
+	}
+
+	return shared.GetObject(token, schema.ObjectTypeID, input.RecordID, properties)
+}
+
+func NewGetCustomObjectAction() sdk.Action {
+	return &GetCustomObjectAction{}
+}
@@ -0,0 +1,95 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// maxAssociationPages bounds the pages of 500 associations read.
+const maxAssociationPages = 10
+
+type listAssociationsActionProps struct {
+	FromType string `json:"from_type"`
+	FromID   string `json:"from_id"`
+	ToType   string `json:"to_type"`
+}
+
+type ListAssociationsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *ListAssociationsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_associations",
+		DisplayName:   "List Associations",
+		Description:   "List the records of an object type associated with a HubSpot record, with their association labels.",
+		Type:          core.ActionTypeAction,
+		Documentation: listAssociationsDocs,
+		SampleOutput: map[string]any{
+			"count": 1,
+			"results": []map[string]any{
+				{
+					"toObjectId": "51",
+					"associationTypes": []map[string]any{
+						{"category": "HUBSPOT_DEFINED", "typeId": 3, "label": ""},
+					},
+				},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *ListAssociationsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_associations", "List Associations")
+
+	form.SelectField("from_type", "Object").
+		Required(true).
+		AddOptions(shared.ObjectTypeOptions...)
+
+	form.TextField("from_id", "Record ID").
+		Required(true)
+
+	form.SelectField("to_type", "Associated Object").
+		Required(true).
+		AddOptions(shared.ObjectTypeOptions...)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *ListAssociationsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *ListAssociationsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listAssociationsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	associations, err := shared.ListAssociations(token, input.FromType, input.FromID, input.ToType, maxAssociationPages)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"count":   len(associations),
+		"results": associations,
+	}, nil
+}
+
+func NewListAssociationsAction() sdk.Action {
+	return &ListAssociationsAction{}
+}
@@ -0,0 +1,213 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type logEngagementActionProps struct {
+	EngagementType string   `json:"engagement_type"`
+	Body           string   `json:"body"`
+	Subject        string   `json:"subject"`
+	Timestamp      string   `json:"timestamp"`
+	Direction      string   `json:"direction"`
+	CallDuration   *float64 `json:"call_duration"`
+	CallStatus     string   `json:"call_status"`
+	OwnerID        string   `json:"hubspot_owner_id"`
+	ContactIDs     string   `json:"contact_ids"`
+	CompanyIDs     string   `json:"company_ids"`
+	DealIDs        string   `json:"deal_ids"`
+	TicketIDs      string   `json:"ticket_ids"`
+}
+
+type LogEngagementAction struct{}
+
+// Metadata returns metadata about the action
+func (a *LogEngagementAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "log_engagement",
+		DisplayName:   "Log Engagement",
+		Description:   "Log a note, call or email on the timeline of HubSpot contacts, companies, deals and tickets.",
+		Type:          core.ActionTypeAction,
+		Documentation: logEngagementDocs,
+		SampleOutput: map[string]any{
+			"type": "notes",
+			"engagement": map[string]any{
+				"id": "30012345678",
+				"properties": map[string]any{
+					"hs_note_body": "Called to confirm the renewal.",
+					"hs_timestamp": "2024-03-01T10:00:00.000Z",
+				},
+				"createdAt": "2024-03-01T10:00:00.000Z",
+				"updatedAt": "2024-03-01T10:00:00.000Z",
+			},
+			"associated": []map[string]any{{"type": "deals", "id": "12345"}},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *LogEngagementAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("log_engagement", "Log Engagement")
+
+	form.SelectField("engagement_type", "Type").
+		Required(true).
+		DefaultValue(shared.ObjectNotes).
+		AddOption(shared.ObjectNotes, "Note").
+		AddOption(shared.ObjectCalls, "Call").
+		AddOption(shared.ObjectEmails, "Email")
+
+	form.TextareaField("body", "Body").
+		Required(true).
+		HelpText("The note, the call notes, or the email text")
+
+	form.TextField("subject", "Subject").
+		Required(false).
+		HelpText("The call title or email subject. Not used for notes.")
+
+	form.DateTimeField("timestamp", "Time").
+		Required(false).
+		HelpText("When it happened. Defaults to now.")
+
+	form.SelectField("direction", "Direction").
+		Required(false).
+		DefaultValue("outbound").
+		AddOption("outbound", "Outbound").
+		AddOption("inbound", "Inbound").
+		HelpText("For calls and emails")
+
+	form.NumberField("call_duration", "Call Duration (seconds)").
+		Required(false)
+
+	form.SelectField("call_status", "Call Status").
+		Required(false).
+		DefaultValue("COMPLETED").
+		AddOption("COMPLETED", "Completed").
+		AddOption("NO_ANSWER", "No answer").
+		AddOption("BUSY", "Busy").
+		AddOption("FAILED", "Failed").
+		AddOption("CANCELED", "Canceled")
+
+	shared.RegisterOwnerProps(form, "hubspot_owner_id", "Owner")
+
+	form.TextField("contact_ids", "Contact IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of contacts to log it on")
+
+	form.TextField("company_ids", "Company IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of companies to log it on")
+
+	form.TextField("deal_ids", "Deal IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of deals to log it on")
+
+	form.TextField("ticket_ids", "Ticket IDs").
+		Required(false).
+		HelpText("Comma-separated IDs of tickets to log it on")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *LogEngagementAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *LogEngagementAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[logEngagementActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.EngagementType == "" {
+		input.EngagementType = shared.ObjectNotes
+	}
+	if input.ContactIDs == "" && input.CompanyIDs == "" && input.DealIDs == "" && input.TicketIDs == "" {
+		return nil, errors.New("at least one contact, company, deal or ticket ID is required")
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := input.properties()
+	if err != nil {
+		return nil, err
+	}
+
+	engagement, err := shared.CreateObject(token, input.EngagementType, props)
+	if err != nil {
+		return nil, err
+	}
+
+	associated, err := associateAll(token, input.EngagementType, engagement.ID, map[string]string{
+		shared.ObjectContacts:  input.ContactIDs,
+		shared.ObjectCompanies: input.CompanyIDs,
+		shared.ObjectDeals:     input.DealIDs,
+		shared.ObjectTickets:   input.TicketIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("logged %s %s but failed to associate it: %w", input.EngagementType, engagement.ID, err)
+	}
+
+	return map[string]interface{}{
+		"type":       input.EngagementType,
+		"engagement": engagement,
+		"associated": associated,
+	}, nil
+}
+
+// properties maps the fields to the properties of the engagement type.
+func (p logEngagementActionProps) properties() (map[string]interface{}, error) {
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	if p.Timestamp != "" {
+		timestamp = p.Timestamp
+	}
+	props := map[string]interface{}{"hs_timestamp": timestamp}
+	shared.SetIfNotEmpty(props, "hubspot_owner_id", p.OwnerID)
+
+	inbound := p.Direction == "inbound"
+	switch p.EngagementType {
+	case shared.ObjectNotes:
+		props["hs_note_body"] = p.Body
+	case shared.ObjectCalls:
+		props["hs_call_body"] = p.Body
+		shared.SetIfNotEmpty(props, "hs_call_title", p.Subject)
+		shared.SetIfNotEmpty(props, "hs_call_status", p.CallStatus)
+		props["hs_call_direction"] = "OUTBOUND"
+		if inbound {
+			props["hs_call_direction"] = "INBOUND"
+		}
+		if p.CallDuration != nil {
+			props["hs_call_duration"] = strconv.FormatInt(int64(*p.CallDuration*1000), 10)
+		}
+	case shared.ObjectEmails:
+		props["hs_email_text"] = p.Body
+		shared.SetIfNotEmpty(props, "hs_email_subject", p.Subject)
+		props["hs_email_status"] = "SENT"
+		props["hs_email_direction"] = "EMAIL"
+		if inbound {
+			props["hs_email_direction"] = "INCOMING_EMAIL"
+		}
+	default:
+		return nil, fmt.Errorf("unknown engagement type %q", p.EngagementType)
+	}
+	return props, nil
+}
+
+func NewLogEngagementAction() sdk.Action {
+	return &LogEngagementAction{}
+}
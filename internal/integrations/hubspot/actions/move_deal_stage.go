@@ -0,0 +1,129 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type moveDealStageActionProps struct {
+	DealID    string `json:"deal_id"`
+	Pipeline  string `json:"pipeline"`
+	DealStage string `json:"dealstage"`
+}
+
+type MoveDealStageAction struct{}
+
+// Metadata returns metadata about the action
+func (a *MoveDealStageAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "move_deal_stage",
+		DisplayName:   "Move Deal to Stage",
+		Description:   "Move a HubSpot deal to a stage of a pipeline, reporting the stage it left.",
+		Type:          core.ActionTypeAction,
+		Documentation: moveDealStageDocs,
+		SampleOutput: map[string]any{
+			"deal":    dealSample,
+			"changed": true,
+			"from": map[string]any{
+				"pipeline":    "default",
+				"stage":       "appointmentscheduled",
+				"stage_label": "Appointment Scheduled",
+			},
+			"to": map[string]any{
+				"pipeline":    "default",
+				"stage":       "qualifiedtobuy",
+				"stage_label": "Qualified To Buy",
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *MoveDealStageAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("move_deal_stage", "Move Deal to Stage")
+
+	form.TextField("deal_id", "Deal ID").
+		Required(true).
+		HelpText("ID of the deal to move")
+
+	shared.RegisterPipelineProps(form, true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *MoveDealStageAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *MoveDealStageAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[moveDealStageActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The stage is resolved against the pipeline, so it may be given by
+	// label and a stage of another pipeline fails before any update.
+	pipeline, err := shared.GetPipeline(token, shared.ObjectDeals, input.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+	stage, err := pipeline.FindStage(input.DealStage)
+	if err != nil {
+		return nil, err
+	}
+
+	deal, err := shared.GetObject(token, shared.ObjectDeals, input.DealID, []string{"dealname", "amount", "pipeline", "dealstage", "closedate"})
+	if err != nil {
+		return nil, err
+	}
+
+	from := map[string]interface{}{
+		"pipeline": deal.Property("pipeline"),
+		"stage":    deal.Property("dealstage"),
+	}
+	if deal.Property("pipeline") == pipeline.ID {
+		if s, err := pipeline.FindStage(deal.Property("dealstage")); err == nil {
+			from["stage_label"] = s.Label
+		}
+	}
+	to := map[string]interface{}{
+		"pipeline":    pipeline.ID,
+		"stage":       stage.ID,
+		"stage_label": stage.Label,
+	}
+
+	changed := deal.Property("pipeline") != pipeline.ID || deal.Property("dealstage") != stage.ID
+	if changed {
+		deal, err = shared.UpdateObject(token, shared.ObjectDeals, input.DealID, map[string]interface{}{
+			"pipeline":  pipeline.ID,
+			"dealstage": stage.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{
+		"deal":    deal,
+		"changed": changed,
+		"from":    from,
+		"to":      to,
+	}, nil
+}
+
+func NewMoveDealStageAction() sdk.Action {
+	return &MoveDealStageAction{}
+}
@@ -0,0 +1,70 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type SearchCompaniesAction struct{}
+
+// Metadata returns metadata about the action
+func (a *SearchCompaniesAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "search_companies",
+		DisplayName:   "Search Companies",
+		Description:   "Search HubSpot companies by text or by a property filter.",
+		Type:          core.ActionTypeAction,
+		Documentation: searchCompaniesDocs,
+		SampleOutput: map[string]any{
+			"total":   1,
+			"count":   1,
+			"results": []map[string]any{companySample},
+			"after":   "",
+			"hasMore": false,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *SearchCompaniesAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("search_companies", "Search Companies")
+
+	shared.RegisterSearchProps(form, "name,domain,industry,city,country,hubspot_owner_id")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *SearchCompaniesAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *SearchCompaniesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[searchFields](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := shared.Search(token, shared.ObjectCompanies, input.request())
+	if err != nil {
+		return nil, err
+	}
+
+	return searchOutput(page), nil
+}
+
+func NewSearchCompaniesAction() sdk.Action {
+	return &SearchCompaniesAction{}
+}
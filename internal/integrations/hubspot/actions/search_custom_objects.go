@@ -0,0 +1,87 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type searchCustomObjectsActionProps struct {
+	searchFields
+	ObjectType string `json:"object_type"`
+}
+
+type SearchCustomObjectsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *SearchCustomObjectsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "search_custom_objects",
+		DisplayName:   "Search Custom Object Records",
+		Description:   "Search the records of a HubSpot custom object by text or by a property filter.",
+		Type:          core.ActionTypeAction,
+		Documentation: searchCustomObjectsDocs,
+		SampleOutput: map[string]any{
+			"total":   1,
+			"count":   1,
+			"results": []map[string]any{customObjectSample},
+			"after":   "",
+			"hasMore": false,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *SearchCustomObjectsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("search_custom_objects", "Search Custom Object Records")
+
+	shared.RegisterCustomObjectProps(form)
+	shared.RegisterSearchProps(form, "")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *SearchCustomObjectsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input. Without
+// properties, records are returned with every property of the schema.
+func (a *SearchCustomObjectsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[searchCustomObjectsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := shared.GetSchema(token, input.ObjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	req := input.request()
+	if len(req.Properties) == 0 {
+		req.Properties = schemaProperties(schema)
+	}
+
+	page, err := shared.Search(token, schema.ObjectTypeID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchOutput(page), nil
+}
+
+func NewSearchCustomObjectsAction() sdk.Action {
+	return &SearchCustomObjectsAction{}
+}
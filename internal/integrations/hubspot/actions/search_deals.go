@@ -0,0 +1,70 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type SearchDealsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *SearchDealsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "search_deals",
+		DisplayName:   "Search Deals",
+		Description:   "Search HubSpot deals by text or by a property filter.",
+		Type:          core.ActionTypeAction,
+		Documentation: searchDealsDocs,
+		SampleOutput: map[string]any{
+			"total":   1,
+			"count":   1,
+			"results": []map[string]any{dealSample},
+			"after":   "",
+			"hasMore": false,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *SearchDealsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("search_deals", "Search Deals")
+
+	shared.RegisterSearchProps(form, "dealname,amount,pipeline,dealstage,closedate,hubspot_owner_id")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *SearchDealsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *SearchDealsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[searchFields](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := shared.Search(token, shared.ObjectDeals, input.request())
+	if err != nil {
+		return nil, err
+	}
+
+	return searchOutput(page), nil
+}
+
+func NewSearchDealsAction() sdk.Action {
+	return &SearchDealsAction{}
+}
@@ -0,0 +1,78 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateCompanyActionProps struct {
+	companyFields
+	CompanyID string `json:"company_id"`
+}
+
+type UpdateCompanyAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateCompanyAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_company",
+		DisplayName:   "Update Company",
+		Description:   "Update the properties of a HubSpot company. Empty fields are left unchanged.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateCompanyDocs,
+		SampleOutput:  companySample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateCompanyAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_company", "Update Company")
+
+	form.TextField("company_id", "Company ID").
+		Required(true).
+		HelpText("ID of the company to update")
+
+	registerCompanyFields(form, false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateCompanyAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateCompanyAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateCompanyActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := input.properties()
+	if err != nil {
+		return nil, err
+	}
+	if len(props) == 0 {
+		return nil, errors.New("no properties to update")
+	}
+
+	return shared.UpdateObject(token, shared.ObjectCompanies, input.CompanyID, props)
+}
+
+func NewUpdateCompanyAction() sdk.Action {
+	return &UpdateCompanyAction{}
+}
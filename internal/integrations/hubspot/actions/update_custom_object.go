@@ -0,0 +1,90 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateCustomObjectActionProps struct {
+	ObjectType string `json:"object_type"`
+	RecordID   string `json:"record_id"`
+	Properties string `json:"properties"`
+}
+
+type UpdateCustomObjectAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateCustomObjectAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_custom_object",
+		DisplayName:   "Update Custom Object Record",
+		Description:   "Update properties of a HubSpot custom object record, checking them against the object's schema.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateCustomObjectDocs,
+		SampleOutput:  customObjectSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateCustomObjectAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_custom_object", "Update Custom Object Record")
+
+	shared.RegisterCustomObjectProps(form)
+
+	form.TextField("record_id", "Record ID").
+		Required(true)
+
+	form.TextareaField("properties", "Properties").
+		Required(true).
+		HelpText("JSON object of the properties to change, by internal name")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateCustomObjectAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateCustomObjectAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateCustomObjectActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := shared.ParseProperties(input.Properties)
+	if err != nil {
+		return nil, err
+	}
+	if len(props) == 0 {
+		return nil, errors.New("no properties to update")
+	}
+
+	schema, err := shared.GetSchema(token, input.ObjectType)
+	if err != nil {
+		return nil, err
+	}
+	if err := schema.CheckProperties(props, false); err != nil {
+		return nil, err
+	}
+
+	return shared.UpdateObject(token, schema.ObjectTypeID, input.RecordID, props)
+}
+
+func NewUpdateCustomObjectAction() sdk.Action {
+	return &UpdateCustomObjectAction{}
+}
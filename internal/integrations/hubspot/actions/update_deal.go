@@ -0,0 +1,78 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateDealActionProps struct {
+	dealFields
+	DealID string `json:"deal_id"`
+}
+
+type UpdateDealAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateDealAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_deal",
+		DisplayName:   "Update Deal",
+		Description:   "Update the properties of a HubSpot deal. Empty fields are left unchanged.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateDealDocs,
+		SampleOutput:  dealSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateDealAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_deal", "Update Deal")
+
+	form.TextField("deal_id", "Deal ID").
+		Required(true).
+		HelpText("ID of the deal to update")
+
+	registerDealFields(form, false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateDealAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateDealAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateDealActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := input.properties()
+	if err != nil {
+		return nil, err
+	}
+	if len(props) == 0 {
+		return nil, errors.New("no properties to update")
+	}
+
+	return shared.UpdateObject(token, shared.ObjectDeals, input.DealID, props)
+}
+
+func NewUpdateDealAction() sdk.Action {
+	return &UpdateDealAction{}
+}
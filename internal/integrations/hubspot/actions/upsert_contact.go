@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// contactFieldMap names the HubSpot contact properties of the standard
+// contact fields.
+var contactFieldMap = crm.FieldMap{
+	crm.FieldEmail:     "email",
+	crm.FieldPhone:     "phone",
+	crm.FieldFirstName: "firstname",
+	crm.FieldLastName:  "lastname",
+	crm.FieldCompany:   "company",
+}
+
+type UpsertContactAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpsertContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_contact",
+		DisplayName:   "Create or Update Contact",
+		Description:   "Find a HubSpot contact by email and update it, or create it when there is none.",
+		Type:          core.ActionTypeAction,
+		Documentation: upsertContactDocs,
+		SampleOutput: crm.SampleOutput(map[string]any{
+			"id": "51",
+			"properties": map[string]any{
+				"email":     "jane.doe@example.com",
+				"firstname": "Jane",
+				"lastname":  "Doe",
+				"company":   "Acme Inc.",
+			},
+		}),
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpsertContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_contact", "Create or Update Contact")
+
+	crm.RegisterContactProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpsertContactAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input. HubSpot
+// contacts have no tags, so tags are reported as ignored.
+func (a *UpsertContactAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	contact, err := crm.ContactFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	props, ignored := contactFieldMap.Map(contact)
+	if len(contact.Tags) > 0 {
+		ignored = append(ignored, crm.FieldTags)
+	}
+
+	// The upsert matches the contact by email in one request, so runs at
+	// the same moment still leave a single contact.
+	result, err := shared.BatchUpsert(token, shared.ObjectContacts, []shared.UpsertInput{
+		{IDProperty: "email", ID: contact.Email, Properties: props},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Results) == 0 {
+		if len(result.Errors) > 0 {
+			return nil, fmt.Errorf("hubspot: %s", result.Errors[0].Message)
+		}
+		return nil, errors.New("hubspot returned no contact")
+	}
+	record := result.Results[0]
+
+	return crm.Result{
+		ID:      record.ID,
+		Created: record.New,
+		Contact: record.Object,
+		Ignored: ignored,
+	}.Output(), nil
+}
+
+func NewUpsertContactAction() sdk.Action {
+	return &UpsertContactAction{}
+}
@@ -56,6 +56,39 @@ func (n *Hubspot) Actions() []sdk.Action {
 		actions.NewSearchOwnerByEmailAction(),
 
 		actions.NewGetDealAction(),
+
+		actions.NewUpsertContactAction(),
+		actions.NewBatchUpsertRecordsAction(),
+
+		actions.NewCreateCompanyAction(),
+
+		actions.NewUpdateCompanyAction(),
+
+		actions.NewSearchCompaniesAction(),
+
+		actions.NewCreateDealAction(),
+
+		actions.NewUpdateDealAction(),
+
+		actions.NewSearchDealsAction(),
+
+		actions.NewMoveDealStageAction(),
+
+		actions.NewAssociateRecordsAction(),
+
+		actions.NewListAssociationsAction(),
+
+		actions.NewLogEngagementAction(),
+
+		actions.NewCreateCustomObjectAction(),
+
+		actions.NewGetCustomObjectAction(),
+
+		actions.NewUpdateCustomObjectAction(),
+
+		actions.NewDeleteCustomObjectAction(),
+
+		actions.NewSearchCustomObjectsAction(),
 	}
 }
 
@@ -0,0 +1,126 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AssociationType is a kind of association between two object types,
+// such as a labelled "Decision maker" contact of a company.
+type AssociationType struct {
+	Category string `json:"category"`
+	TypeID   int    `json:"typeId"`
+	Label    string `json:"label"`
+}
+
+// Value encodes the type as category:typeId, the value of the
+// association label field.
+func (t AssociationType) Value() string {
+	return t.Category + ":" + strconv.Itoa(t.TypeID)
+}
+
+// ParseAssociationType decodes a category:typeId value.
+func ParseAssociationType(v string) (AssociationType, error) {
+	category, id, ok := strings.Cut(v, ":")
+	typeID, err := strconv.Atoi(id)
+	if !ok || err != nil || category == "" {
+		return AssociationType{}, fmt.Errorf("invalid association label %q", v)
+	}
+	return AssociationType{Category: category, TypeID: typeID}, nil
+}
+
+// Association is a record associated with another.
+type Association struct {
+	ToObjectID string            `json:"toObjectId"`
+	Types      []AssociationType `json:"associationTypes"`
+}
+
+func associationPath(fromType, fromID, toType, toID string) string {
+	return fmt.Sprintf("/crm/v4/objects/%s/%s/associations/%s/%s",
+		url.PathEscape(fromType), url.PathEscape(fromID), url.PathEscape(toType), url.PathEscape(toID))
+}
+
+// AssociateDefault links two records with the default association of
+// their object types.
+func AssociateDefault(accessToken, fromType, fromID, toType, toID string) error {
+	endpoint := fmt.Sprintf("/crm/v4/objects/%s/%s/associations/default/%s/%s",
+		url.PathEscape(fromType), url.PathEscape(fromID), url.PathEscape(toType), url.PathEscape(toID))
+	return Request(accessToken, http.MethodPut, endpoint, nil, nil)
+}
+
+// Associate links two records with a labelled association type.
+func Associate(accessToken, fromType, fromID, toType, toID string, t AssociationType) error {
+	body := []map[string]interface{}{
+		{"associationCategory": t.Category, "associationTypeId": t.TypeID},
+	}
+	return Request(accessToken, http.MethodPut, associationPath(fromType, fromID, toType, toID), body, nil)
+}
+
+// RemoveAssociations removes every association between two records.
+func RemoveAssociations(accessToken, fromType, fromID, toType, toID string) error {
+	return Request(accessToken, http.MethodDelete, associationPath(fromType, fromID, toType, toID), nil, nil)
+}
+
+// ListAssociations returns the records of an object type associated with
+// a record, following pages up to maxPages.
+func ListAssociations(accessToken, fromType, fromID, toType string, maxPages int) ([]Association, error) {
+	var all []Association
+	after := ""
+	for page := 0; page < maxPages; page++ {
+		endpoint := fmt.Sprintf("/crm/v4/objects/%s/%s/associations/%s?limit=500",
+			url.PathEscape(fromType), url.PathEscape(fromID), url.PathEscape(toType))
+		if after != "" {
+			endpoint += "&after=" + url.QueryEscape(after)
+		}
+		var resp struct {
+			Results []Association `json:"results"`
+			Paging  *struct {
+				Next struct {
+					After string `json:"after"`
+				} `json:"next"`
+			} `json:"paging"`
+		}
+		if err := Request(accessToken, http.MethodGet, endpoint, nil, &resp); err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Results...)
+		if resp.Paging == nil || resp.Paging.Next.After == "" {
+			break
+		}
+		after = resp.Paging.Next.After
+	}
+	if all == nil {
+		all = []Association{}
+	}
+	return all, nil
+}
+
+// AssociationLabels returns the association types between two object
+// types, the unlabelled default among them.
+func AssociationLabels(accessToken, fromType, toType string) ([]AssociationType, error) {
+	var resp struct {
+		Results []AssociationType `json:"results"`
+	}
+	endpoint := fmt.Sprintf("/crm/v4/associations/%s/%s/labels", url.PathEscape(fromType), url.PathEscape(toType))
+	if err := Request(accessToken, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// MaxBatchSize is the most records a batch request takes.
+const MaxBatchSize = 100
+
+// UpsertInput is a record of a batch upsert, found by the value of its
+// unique property.
+type UpsertInput struct {
+	IDProperty string                 `json:"idProperty"`
+	ID         string                 `json:"id"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// UpsertedObject is a record written by a batch upsert. New tells
+// whether it was created.
+type UpsertedObject struct {
+	Object
+	New bool `json:"new"`
+}
+
+// BatchError is the error of some records of a batch. Context names the
+// records it applies to, by the values they were given in.
+type BatchError struct {
+	Status   string                 `json:"status"`
+	Category string                 `json:"category"`
+	Message  string                 `json:"message"`
+	Context  map[string]interface{} `json:"context"`
+}
+
+// IDs returns the values in the context of the error, which name the
+// records it applies to.
+func (e BatchError) IDs() []string {
+	var ids []string
+	for _, v := range e.Context {
+		values, _ := v.([]interface{})
+		for _, value := range values {
+			if id, ok := value.(string); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// BatchResult is the outcome of a batch request, which may succeed for
+// some records and fail for others.
+type BatchResult struct {
+	Status  string           `json:"status"`
+	Results []UpsertedObject `json:"results"`
+	Errors  []BatchError     `json:"errors"`
+}
+
+// BatchUpsert creates or updates up to MaxBatchSize records of an object
+// type, finding each by its unique property.
+func BatchUpsert(accessToken, objectType string, inputs []UpsertInput) (*BatchResult, error) {
+	var result BatchResult
+	err := Request(accessToken, http.MethodPost, "/crm/v3/objects/"+url.PathEscape(objectType)+"/batch/upsert",
+		map[string]interface{}{"inputs": inputs}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
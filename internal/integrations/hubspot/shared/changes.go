@@ -0,0 +1,166 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"encoding/json"
+	"time"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// cursorKey is the trigger metadata key of the polling cursor.
+const cursorKey = "cursor"
+
+// maxChangePages bounds the pages read by one poll: 100 pages of 100 is
+// the 10,000 results the search API returns for one query.
+const maxChangePages = 100
+
+// Cursor marks how far a polling trigger has read: the time of the last
+// change seen, in milliseconds, and the records changed at that exact
+// time. The next poll reads from that time again and skips those
+// records, so changes sharing a timestamp across polls aren't lost.
+type Cursor struct {
+	Time int64    `json:"time"`
+	IDs  []string `json:"ids"`
+}
+
+func (c Cursor) seen(id string, ms int64) bool {
+	if ms != c.Time {
+		return false
+	}
+	for _, seen := range c.IDs {
+		if seen == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Cursor) advance(id string, ms int64) {
+	switch {
+	case ms > c.Time:
+		c.Time = ms
+		c.IDs = []string{id}
+	case ms == c.Time:
+		c.IDs = append(c.IDs, id)
+	}
+}
+
+// ChangesSince pages through the records whose timestamp property is at
+// or after the cursor, oldest first, and returns the ones not seen yet
+// with the cursor to continue from. When more changed than one query
+// returns, the next poll picks up where this one stopped.
+func ChangesSince(accessToken, objectType, property string, cursor Cursor, properties []string) ([]Object, Cursor, error) {
+	if len(properties) > 0 {
+		properties = append(properties, property)
+	}
+	req := SearchRequest{
+		FilterGroups: []FilterGroup{{Filters: []Filter{
+			{PropertyName: property, Operator: "GTE", Value: cursor.Time},
+		}}},
+		Sorts:      []Sort{{PropertyName: property, Direction: "ASCENDING"}},
+		Properties: properties,
+		Limit:      maxSearchLimit,
+	}
+
+	changes := []Object{}
+	next := Cursor{Time: cursor.Time, IDs: append([]string(nil), cursor.IDs...)}
+	for page := 0; page < maxChangePages; page++ {
+		resp, err := Search(accessToken, objectType, req)
+		if err != nil {
+			return nil, cursor, err
+		}
+		for _, obj := range resp.Results {
+			ms := timestampMillis(obj.Property(property))
+			if ms == 0 || cursor.seen(obj.ID, ms) {
+				continue
+			}
+			changes = append(changes, obj)
+			next.advance(obj.ID, ms)
+		}
+		if resp.After == "" {
+			break
+		}
+		req.After = resp.After
+	}
+	return changes, next, nil
+}
+
+// PollChanges runs a polling trigger over the records whose timestamp
+// property changed since the last poll. The first poll starts from the
+// last run, or a day back.
+func PollChanges(ctx sdkcontext.ExecuteContext, objectType, property string, properties []string) (core.JSON, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, ok := loadCursor(ctx)
+	if !ok {
+		since := time.Now().Add(-24 * time.Hour)
+		if lastRun := ctx.LastRun(); lastRun != nil {
+			since = *lastRun
+		}
+		cursor = Cursor{Time: since.UnixMilli()}
+	}
+
+	changes, next, err := ChangesSince(authCtx.Token.AccessToken, objectType, property, cursor, properties)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.SetMetadata(cursorKey, next); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"results": changes,
+		"count":   len(changes),
+	}, nil
+}
+
+// loadCursor reads the cursor of the last poll, and whether there was
+// one.
+func loadCursor(ctx sdkcontext.ExecuteContext) (Cursor, bool) {
+	stored, err := ctx.GetMetadata(cursorKey)
+	if err != nil || stored == nil {
+		return Cursor{}, false
+	}
+
+	var cursor Cursor
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &cursor)
+	}
+	if err != nil || cursor.Time == 0 {
+		ctx.Logger().Warn("ignoring an unreadable polling cursor", "error", err)
+		return Cursor{}, false
+	}
+	return cursor, true
+}
+
+// timestampMillis parses a HubSpot datetime property, which the search
+// API returns as an ISO 8601 string or milliseconds.
+func timestampMillis(v string) int64 {
+	if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+		return t.UnixMilli()
+	}
+	var ms int64
+	if err := json.Unmarshal([]byte(v), &ms); err == nil {
+		return ms
+	}
+	return 0
+}
@@ -0,0 +1,297 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Object types of the standard CRM objects.
+const (
+	ObjectContacts  = "contacts"
+	ObjectCompanies = "companies"
+	ObjectDeals     = "deals"
+	ObjectTickets   = "tickets"
+	ObjectTasks     = "tasks"
+	ObjectNotes     = "notes"
+	ObjectCalls     = "calls"
+	ObjectEmails    = "emails"
+)
+
+// maxSearchLimit is the most results a search returns per page.
+const maxSearchLimit = 100
+
+// Object is a CRM record as returned by the v3 objects API.
+type Object struct {
+	ID         string                 `json:"id"`
+	Properties map[string]interface{} `json:"properties"`
+	CreatedAt  string                 `json:"createdAt,omitempty"`
+	UpdatedAt  string                 `json:"updatedAt,omitempty"`
+	Archived   bool                   `json:"archived"`
+}
+
+// Property returns a property of the object as a string.
+func (o Object) Property(name string) string {
+	v, _ := o.Properties[name].(string)
+	return v
+}
+
+// APIError is an error response of the HubSpot API.
+type APIError struct {
+	Status   int    `json:"-"`
+	Message  string `json:"message"`
+	Category string `json:"category"`
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("hubspot: %s (%d)", e.Message, e.Status)
+	}
+	return fmt.Sprintf("hubspot: request failed with status %d", e.Status)
+}
+
+// IsNotFound reports whether err is HubSpot's answer for a missing record.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound
+}
+
+// Request calls the HubSpot API and decodes the JSON response into out,
+// which may be nil. Error responses are returned as errors carrying
+// HubSpot's message.
+func Request(accessToken, method, endpoint string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, baseAPI+endpoint, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		apiErr := &APIError{Status: res.StatusCode}
+		_ = json.Unmarshal(data, apiErr)
+		return apiErr
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// CreateObject creates a record of an object type.
+func CreateObject(accessToken, objectType string, properties map[string]interface{}) (*Object, error) {
+	var obj Object
+	err := Request(accessToken, http.MethodPost, "/crm/v3/objects/"+url.PathEscape(objectType),
+		map[string]interface{}{"properties": properties}, &obj)
+	if err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+// UpdateObject updates the given properties of a record.
+func UpdateObject(accessToken, objectType, id string, properties map[string]interface{}) (*Object, error) {
+	if id == "" {
+		return nil, errors.New("record ID is required")
+	}
+	var obj Object
+	err := Request(accessToken, http.MethodPatch, "/crm/v3/objects/"+url.PathEscape(objectType)+"/"+url.PathEscape(id),
+		map[string]interface{}{"properties": properties}, &obj)
+	if err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+// GetObject reads a record with the given properties, or the default
+// ones when none are given.
+func GetObject(accessToken, objectType, id string, properties []string) (*Object, error) {
+	if id == "" {
+		return nil, errors.New("record ID is required")
+	}
+	endpoint := "/crm/v3/objects/" + url.PathEscape(objectType) + "/" + url.PathEscape(id)
+	if len(properties) > 0 {
+		endpoint += "?properties=" + url.QueryEscape(strings.Join(properties, ","))
+	}
+	var obj Object
+	if err := Request(accessToken, http.MethodGet, endpoint, nil, &obj); err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+// DeleteObject archives a record.
+func DeleteObject(accessToken, objectType, id string) error {
+	if id == "" {
+		return errors.New("record ID is required")
+	}
+	return Request(accessToken, http.MethodDelete, "/crm/v3/objects/"+url.PathEscape(objectType)+"/"+url.PathEscape(id), nil, nil)
+}
+
+// Filter is a condition of a search.
+type Filter struct {
+	PropertyName string      `json:"propertyName"`
+	Operator     string      `json:"operator"`
+	Value        interface{} `json:"value,omitempty"`
+}
+
+// FilterGroup holds filters that must all match.
+type FilterGroup struct {
+	Filters []Filter `json:"filters"`
+}
+
+// Sort orders search results.
+type Sort struct {
+	PropertyName string `json:"propertyName"`
+	Direction    string `json:"direction"`
+}
+
+// SearchRequest is the body of the v3 search API.
+type SearchRequest struct {
+	Query        string        `json:"query,omitempty"`
+	FilterGroups []FilterGroup `json:"filterGroups,omitempty"`
+	Sorts        []Sort        `json:"sorts,omitempty"`
+	Properties   []string      `json:"properties,omitempty"`
+	Limit        int           `json:"limit,omitempty"`
+	After        string        `json:"after,omitempty"`
+}
+
+// SearchPage is a page of search results. After is empty on the last
+// page.
+type SearchPage struct {
+	Total   int      `json:"total"`
+	Results []Object `json:"results"`
+	After   string   `json:"after,omitempty"`
+}
+
+// Search reads one page of a search.
+func Search(accessToken, objectType string, req SearchRequest) (*SearchPage, error) {
+	if req.Limit <= 0 || req.Limit > maxSearchLimit {
+		req.Limit = maxSearchLimit
+	}
+	var resp struct {
+		Total   int      `json:"total"`
+		Results []Object `json:"results"`
+		Paging  *struct {
+			Next struct {
+				After string `json:"after"`
+			} `json:"next"`
+		} `json:"paging"`
+	}
+	err := Request(accessToken, http.MethodPost, "/crm/v3/objects/"+url.PathEscape(objectType)+"/search", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	page := &SearchPage{Total: resp.Total, Results: resp.Results}
+	if page.Results == nil {
+		page.Results = []Object{}
+	}
+	if resp.Paging != nil {
+		page.After = resp.Paging.Next.After
+	}
+	return page, nil
+}
+
+// NewSearchRequest builds a search from the fields of the search
+// actions: free text, an optional single filter, and the properties to
+// return.
+func NewSearchRequest(query, property, operator, value, properties string, limit int, after string) SearchRequest {
+	req := SearchRequest{
+		Query:      strings.TrimSpace(query),
+		Properties: SplitProperties(properties),
+		Limit:      limit,
+		After:      after,
+	}
+	if property != "" {
+		if operator == "" {
+			operator = "EQ"
+		}
+		f := Filter{PropertyName: property, Operator: operator}
+		if operator != "HAS_PROPERTY" && operator != "NOT_HAS_PROPERTY" {
+			f.Value = value
+		}
+		req.FilterGroups = []FilterGroup{{Filters: []Filter{f}}}
+	}
+	return req
+}
+
+// SplitProperties splits a comma or newline separated list of property
+// names.
+func SplitProperties(s string) []string {
+	var out []string
+	for _, p := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// ParseProperties parses a JSON object of extra properties. An empty
+// string is no properties.
+func ParseProperties(s string) (map[string]interface{}, error) {
+	props := map[string]interface{}{}
+	if strings.TrimSpace(s) == "" {
+		return props, nil
+	}
+	if err := json.Unmarshal([]byte(s), &props); err != nil {
+		return nil, fmt.Errorf("properties must be a JSON object: %w", err)
+	}
+	return props, nil
+}
+
+// SetNumber sets a numeric property when the value is given.
+func SetNumber(props map[string]interface{}, name string, value *float64) {
+	if value != nil {
+		props[name] = strconv.FormatFloat(*value, 'f', -1, 64)
+	}
+}
+
+// SetIfNotEmpty sets a property when the value is given.
+func SetIfNotEmpty(props map[string]interface{}, name, value string) {
+	if value != "" {
+		props[name] = value
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListPipelines returns the pipelines of an object type with their
+// stages.
+func ListPipelines(accessToken, objectType string) ([]PipelineResult, error) {
+	var resp DealPipelineResponse
+	if err := Request(accessToken, http.MethodGet, "/crm/v3/pipelines/"+url.PathEscape(objectType), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// GetPipeline returns a pipeline with its stages.
+func GetPipeline(accessToken, objectType, pipelineID string) (*PipelineResult, error) {
+	var pipeline PipelineResult
+	endpoint := "/crm/v3/pipelines/" + url.PathEscape(objectType) + "/" + url.PathEscape(pipelineID)
+	if err := Request(accessToken, http.MethodGet, endpoint, nil, &pipeline); err != nil {
+		return nil, err
+	}
+	return &pipeline, nil
+}
+
+// FindStage returns the stage of the pipeline with the ID or label.
+func (p *PipelineResult) FindStage(stage string) (*Stage, error) {
+	for i := range p.Stages {
+		if p.Stages[i].ID == stage {
+			return &p.Stages[i], nil
+		}
+	}
+	for i := range p.Stages {
+		if p.Stages[i].Label == stage {
+			return &p.Stages[i], nil
+		}
+	}
+	return nil, fmt.Errorf("pipeline %q has no stage %q", p.Label, stage)
+}
@@ -0,0 +1,287 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// ObjectTypeOptions lists the standard objects records can be
+// associated between.
+var ObjectTypeOptions = []*smartform.Option{
+	{Value: ObjectContacts, Label: "Contact"},
+	{Value: ObjectCompanies, Label: "Company"},
+	{Value: ObjectDeals, Label: "Deal"},
+	{Value: ObjectTickets, Label: "Ticket"},
+}
+
+// SearchOperatorOptions lists the filter operators of the search API.
+var SearchOperatorOptions = []*smartform.Option{
+	{Value: "EQ", Label: "Equals"},
+	{Value: "NEQ", Label: "Does not equal"},
+	{Value: "LT", Label: "Less than"},
+	{Value: "LTE", Label: "Less than or equal"},
+	{Value: "GT", Label: "Greater than"},
+	{Value: "GTE", Label: "Greater than or equal"},
+	{Value: "CONTAINS_TOKEN", Label: "Contains word"},
+	{Value: "HAS_PROPERTY", Label: "Is set"},
+	{Value: "NOT_HAS_PROPERTY", Label: "Is not set"},
+}
+
+// RegisterSearchProps adds the fields of the search actions.
+func RegisterSearchProps(form *smartform.FormBuilder, defaultProperties string) {
+	form.TextField("query", "Search Text").
+		Required(false).
+		HelpText("Text to search for in the default searchable properties.")
+
+	form.TextField("filter_property", "Filter Property").
+		Required(false).
+		HelpText("The internal name of a property to filter on, such as domain or amount.")
+
+	form.SelectField("filter_operator", "Filter Operator").
+		Required(false).
+		DefaultValue("EQ").
+		AddOptions(SearchOperatorOptions...)
+
+	form.TextField("filter_value", "Filter Value").
+		Required(false)
+
+	form.TextField("properties", "Properties").
+		Required(false).
+		DefaultValue(defaultProperties).
+		HelpText("Comma-separated properties to return.")
+
+	form.NumberField("limit", "Limit").
+		Required(false).
+		DefaultValue(20).
+		HelpText("Results per page, up to 100.")
+
+	form.TextField("after", "After").
+		Required(false).
+		HelpText("The paging cursor returned as after by the previous page.")
+}
+
+// RegisterPipelineProps adds the deal pipeline and stage fields. Stages
+// are listed for the selected pipeline.
+func RegisterPipelineProps(form *smartform.FormBuilder, required bool) {
+	getPipelines := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		pipelines, err := ListPipelines(authCtx.Token.AccessToken, ObjectDeals)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]map[string]any, 0, len(pipelines))
+		for _, p := range pipelines {
+			items = append(items, map[string]any{"id": p.ID, "name": p.Label})
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	getStages := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		input := sdk.DynamicInputToType[struct {
+			Pipeline string `json:"pipeline"`
+		}](ctx)
+		if input.Pipeline == "" {
+			return ctx.Respond([]map[string]any{}, 0)
+		}
+
+		pipeline, err := GetPipeline(authCtx.Token.AccessToken, ObjectDeals, input.Pipeline)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]map[string]any, 0, len(pipeline.Stages))
+		for _, s := range pipeline.Stages {
+			items = append(items, map[string]any{"id": s.ID, "name": s.Label})
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	form.SelectField("pipeline", "Pipeline").
+		Placeholder("Select a pipeline").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getPipelines)).
+				WithSearchSupport().
+				End().
+				RefreshOn("connection").
+				GetDynamicSource(),
+		).
+		HelpText("The deal pipeline.")
+
+	form.SelectField("dealstage", "Deal Stage").
+		Placeholder("Select a stage").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getStages)).
+				WithFieldReference("pipeline", "pipeline").
+				WithSearchSupport().
+				End().
+				RefreshOn("pipeline").
+				GetDynamicSource(),
+		).
+		HelpText("The stage of the selected pipeline.")
+}
+
+// RegisterOwnerProps adds a HubSpot owner field.
+func RegisterOwnerProps(form *smartform.FormBuilder, id, label string) *smartform.FieldBuilder {
+	getOwners := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		var resp struct {
+			Results []struct {
+				ID        string `json:"id"`
+				Email     string `json:"email"`
+				FirstName string `json:"firstName"`
+				LastName  string `json:"lastName"`
+			} `json:"results"`
+		}
+		if err := Request(authCtx.Token.AccessToken, http.MethodGet, "/crm/v3/owners?limit=500", nil, &resp); err != nil {
+			return nil, err
+		}
+
+		items := make([]map[string]any, 0, len(resp.Results))
+		for _, o := range resp.Results {
+			name := o.FirstName + " " + o.LastName
+			if o.FirstName == "" && o.LastName == "" {
+				name = o.Email
+			}
+			items = append(items, map[string]any{"id": o.ID, "name": name})
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField(id, label).
+		Placeholder("Select an owner").
+		Required(false).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getOwners)).
+				WithSearchSupport().
+				End().
+				RefreshOn("connection").
+				GetDynamicSource(),
+		).
+		HelpText("The HubSpot user who owns the record.")
+}
+
+// RegisterCustomObjectProps adds the custom object type field, listed
+// from the schemas API.
+func RegisterCustomObjectProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getSchemas := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		schemas, err := ListSchemas(authCtx.Token.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]map[string]any, 0, len(schemas))
+		for _, s := range schemas {
+			items = append(items, map[string]any{"id": s.ObjectTypeID, "name": s.Labels.Plural})
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("object_type", "Custom Object").
+		Placeholder("Select a custom object").
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getSchemas)).
+				WithSearchSupport().
+				End().
+				RefreshOn("connection").
+				GetDynamicSource(),
+		).
+		HelpText("The custom object type, such as 2-1234567.")
+}
+
+// RegisterAssociationTypeProps adds the association label field, listed
+// for the object types selected in the from_type and to_type fields.
+// Its values are the category and type ID, as in USER_DEFINED:12.
+func RegisterAssociationTypeProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getLabels := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		input := sdk.DynamicInputToType[struct {
+			FromType string `json:"from_type"`
+			ToType   string `json:"to_type"`
+		}](ctx)
+		if input.FromType == "" || input.ToType == "" {
+			return ctx.Respond([]map[string]any{}, 0)
+		}
+
+		labels, err := AssociationLabels(authCtx.Token.AccessToken, input.FromType, input.ToType)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]map[string]any, 0, len(labels))
+		for _, l := range labels {
+			name := l.Label
+			if name == "" {
+				name = "Default (unlabelled)"
+			}
+			items = append(items, map[string]any{"id": l.Value(), "name": name})
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("association_type", "Association Label").
+		Placeholder("Default association").
+		Required(false).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getLabels)).
+				WithFieldReference("from_type", "from_type").
+				WithFieldReference("to_type", "to_type").
+				End().
+				RefreshOn("from_type", "to_type").
+				GetDynamicSource(),
+		).
+		HelpText("A labelled association, such as Decision maker. Leave empty for the default association.")
+}
@@ -0,0 +1,127 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Schema describes a custom object type.
+type Schema struct {
+	ID                     string `json:"id"`
+	ObjectTypeID           string `json:"objectTypeId"`
+	FullyQualifiedName     string `json:"fullyQualifiedName"`
+	Name                   string `json:"name"`
+	PrimaryDisplayProperty string `json:"primaryDisplayProperty"`
+	Labels                 struct {
+		Singular string `json:"singular"`
+		Plural   string `json:"plural"`
+	} `json:"labels"`
+	RequiredProperties []string         `json:"requiredProperties"`
+	Properties         []SchemaProperty `json:"properties"`
+}
+
+// SchemaProperty is a property of a custom object type.
+type SchemaProperty struct {
+	Name                 string `json:"name"`
+	Label                string `json:"label"`
+	Type                 string `json:"type"`
+	FieldType            string `json:"fieldType"`
+	ModificationMetadata *struct {
+		ReadOnlyValue bool `json:"readOnlyValue"`
+	} `json:"modificationMetadata,omitempty"`
+}
+
+// ListSchemas returns the custom object types of the account.
+func ListSchemas(accessToken string) ([]Schema, error) {
+	var resp struct {
+		Results []Schema `json:"results"`
+	}
+	if err := Request(accessToken, http.MethodGet, "/crm/v3/schemas", nil, &resp); err != nil {
+		return nil, customObjectsError(err)
+	}
+	return resp.Results, nil
+}
+
+// GetSchema returns the schema of a custom object type, by its object
+// type ID or fully qualified name.
+func GetSchema(accessToken, objectType string) (*Schema, error) {
+	if objectType == "" {
+		return nil, errors.New("object type is required")
+	}
+	var schema Schema
+	if err := Request(accessToken, http.MethodGet, "/crm/v3/schemas/"+url.PathEscape(objectType), nil, &schema); err != nil {
+		return nil, customObjectsError(err)
+	}
+	return &schema, nil
+}
+
+// customObjectsError explains a forbidden schema request: the custom
+// object scopes are optional and only granted on Enterprise accounts.
+func customObjectsError(err error) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.Status == http.StatusForbidden {
+		return fmt.Errorf("custom objects need a HubSpot Enterprise account and a connection that granted the custom object scopes; reconnect HubSpot after upgrading: %w", err)
+	}
+	return err
+}
+
+// CheckProperties rejects properties the schema doesn't define or that
+// are read only, and, when creating, missing required properties, so a
+// typo fails with the property names instead of HubSpot's generic
+// validation error.
+func (s *Schema) CheckProperties(props map[string]interface{}, creating bool) error {
+	known := map[string]SchemaProperty{}
+	for _, p := range s.Properties {
+		known[p.Name] = p
+	}
+
+	var unknown, readOnly []string
+	for name := range props {
+		p, ok := known[name]
+		switch {
+		case !ok:
+			unknown = append(unknown, name)
+		case p.ModificationMetadata != nil && p.ModificationMetadata.ReadOnlyValue:
+			readOnly = append(readOnly, name)
+		}
+	}
+	sort.Strings(unknown)
+	sort.Strings(readOnly)
+	if len(unknown) > 0 {
+		return fmt.Errorf("%s has no properties %s", s.Labels.Singular, strings.Join(unknown, ", "))
+	}
+	if len(readOnly) > 0 {
+		return fmt.Errorf("%s properties %s are read only", s.Labels.Singular, strings.Join(readOnly, ", "))
+	}
+
+	if creating {
+		var missing []string
+		for _, name := range s.RequiredProperties {
+			if v, ok := props[name]; !ok || v == nil || v == "" {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("%s requires properties %s", s.Labels.Singular, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
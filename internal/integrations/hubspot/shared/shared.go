@@ -19,17 +19,23 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/juicycleff/smartform/v1"
 )
 
 const baseAPI = "https://api.hubapi.com"
 
+// customObjectScopes are requested as optional scopes: HubSpot only grants
+// them on Enterprise accounts, and requiring them would keep every other
+// account from connecting.
+const customObjectScopes = "crm.objects.custom.read crm.objects.custom.write crm.schemas.custom.read"
+
 var (
 	hubspotForm = smartform.NewAuthForm("hubspot-auth", "Hubspot OAuth", smartform.AuthStrategyOAuth2)
 	_           = hubspotForm.
 			OAuthField("oauth", "Hubspot OAuth").
-			AuthorizationURL("https://app.hubspot.com/oauth/authorize").
+			AuthorizationURL("https://app.hubspot.com/oauth/authorize?optional_scope=" + url.QueryEscape(customObjectScopes)).
 			TokenURL("https://api.hubapi.com/oauth/v1/token").
 			Scopes([]string{
 			"oauth" +
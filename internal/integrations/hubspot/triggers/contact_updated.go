@@ -2,10 +2,6 @@ package triggers
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"time"
 
 	"github.com/juicycleff/smartform/v1"
 	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
@@ -30,6 +26,7 @@ func (t *ContactUpdatedTrigger) Metadata() sdk.TriggerMetadata {
 		Documentation: contactUpdatedDoc,
 		Icon:          "mdi:account-check",
 		SampleOutput: map[string]any{
+			"count": 1,
 			"results": []map[string]any{
 				{
 					"id": "51",
@@ -82,69 +79,20 @@ func (t *ContactUpdatedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
 	return nil
 }
 
-// Execute performs the trigger logic
+// Execute returns the contacts created or updated since the last poll, following every
+// page of results and resuming from a stored cursor.
 func (t *ContactUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
 	input, err := sdk.InputToTypeSafely[contactUpdatedTriggerProps](ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	authCtx, err := ctx.AuthContext()
-	if err != nil {
-		return nil, err
-	}
-
-	// Get the last run time
-	var lastRunTime *time.Time
-	lr, err := ctx.GetMetadata("lastRun")
-	if err == nil && lr != nil {
-		lastRunTime = lr.(*time.Time)
-	}
-
-	url := "/crm/v3/objects/contacts/search"
-	const limit = 100
-
-	requestBody := map[string]interface{}{
-		"limit": limit,
-		"sorts": []map[string]string{
-			{
-				"propertyName": "lastmodifieddate",
-				"direction":    "DESCENDING",
-			},
-		},
-	}
-
-	if lastRunTime != nil {
-		requestBody["filterGroups"] = []map[string]interface{}{
-			{
-				"filters": []map[string]interface{}{
-					{
-						"propertyName": "lastmodifieddate",
-						"operator":     "GT",
-						"value":        lastRunTime.UnixMilli(),
-					},
-				},
-			},
-		}
-	}
-
-	if input.Properties != "" {
-		requestBody["properties"] = append(
-			[]string{"firstname", "lastname", "email", "lastmodifieddate"},
-			input.Properties,
-		)
-	}
-
-	jsonBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request body: %v", err)
-	}
-	resp, err := shared.HubspotClient(url, authCtx.Token.AccessToken, http.MethodPost, jsonBody)
-	if err != nil {
-		return nil, err
+	properties := shared.SplitProperties(input.Properties)
+	if len(properties) > 0 {
+		properties = append([]string{"firstname", "lastname", "email", "lastmodifieddate"}, properties...)
 	}
 
-	return resp, nil
+	return shared.PollChanges(ctx, shared.ObjectContacts, "lastmodifieddate", properties)
 }
 
 // Criteria returns the criteria for triggering this trigger
@@ -155,6 +103,7 @@ func (t *ContactUpdatedTrigger) Criteria(ctx context.Context) core.TriggerCriter
 // SampleData returns sample data for this trigger
 func (t *ContactUpdatedTrigger) SampleData() core.JSON {
 	return map[string]any{
+		"count": 1,
 		"results": []map[string]any{
 			{
 				"id": "51",
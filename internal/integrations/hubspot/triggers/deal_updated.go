@@ -2,10 +2,6 @@ package triggers
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"time"
 
 	"github.com/juicycleff/smartform/v1"
 	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
@@ -30,6 +26,7 @@ func (t *DealUpdatedTrigger) Metadata() sdk.TriggerMetadata {
 		Documentation: dealUpdatedDoc,
 		Icon:          "mdi:cash-multiple",
 		SampleOutput: map[string]any{
+			"count": 1,
 			"results": []map[string]any{
 				{
 					"id": "12345",
@@ -79,70 +76,20 @@ func (t *DealUpdatedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
 	return nil
 }
 
-// Execute performs the trigger logic
+// Execute returns the deals updated since the last poll, following every
+// page of results and resuming from a stored cursor.
 func (t *DealUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
 	props, err := sdk.InputToTypeSafely[dealUpdatedTriggerProps](ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	authCtx, err := ctx.AuthContext()
-	if err != nil {
-		return nil, err
-	}
-
-	// Get the last run time
-	var lastRunTime *time.Time
-	lr, err := ctx.GetMetadata("lastRun")
-	if err == nil && lr != nil {
-		lastRunTime = lr.(*time.Time)
-	}
-
-	url := "/crm/v3/objects/deals/search"
-	const limit = 100
-
-	requestBody := map[string]interface{}{
-		"limit": limit,
-		"sorts": []map[string]string{
-			{
-				"propertyName": "hs_lastmodifieddate",
-				"direction":    "DESCENDING",
-			},
-		},
-	}
-
-	if lastRunTime != nil {
-		requestBody["filterGroups"] = []map[string]interface{}{
-			{
-				"filters": []map[string]interface{}{
-					{
-						"propertyName": "hs_lastmodifieddate",
-						"operator":     "GT",
-						"value":        lastRunTime.UnixMilli(),
-					},
-				},
-			},
-		}
-	}
-
-	if props.Properties != "" {
-		requestBody["properties"] = append(
-			[]string{"dealname", "amount", "dealstage", "hs_lastmodifieddate"},
-			props.Properties,
-		)
-	}
-
-	jsonBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request body: %v", err)
-	}
-
-	resp, err := shared.HubspotClient(url, authCtx.Token.AccessToken, http.MethodPost, jsonBody)
-	if err != nil {
-		return nil, err
+	properties := shared.SplitProperties(props.Properties)
+	if len(properties) > 0 {
+		properties = append([]string{"dealname", "amount", "dealstage", "hs_lastmodifieddate"}, properties...)
 	}
 
-	return resp, nil
+	return shared.PollChanges(ctx, shared.ObjectDeals, "hs_lastmodifieddate", properties)
 }
 
 // Criteria returns the criteria for triggering this trigger
@@ -153,6 +100,7 @@ func (t *DealUpdatedTrigger) Criteria(ctx context.Context) core.TriggerCriteria
 // SampleData returns sample data for this trigger
 func (t *DealUpdatedTrigger) SampleData() core.JSON {
 	return map[string]any{
+		"count": 1,
 		"results": []map[string]any{
 			{
 				"id": "12345",
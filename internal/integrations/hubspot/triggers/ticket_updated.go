@@ -2,10 +2,6 @@ package triggers
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"time"
 
 	"github.com/juicycleff/smartform/v1"
 	"github.com/wakflo/extensions/internal/integrations/hubspot/shared"
@@ -30,6 +26,7 @@ func (t *TicketCreatedTrigger) Metadata() sdk.TriggerMetadata {
 		Documentation: ticketCreatedDoc,
 		Icon:          "mdi:ticket-confirmation",
 		SampleOutput: map[string]any{
+			"count": 1,
 			"results": []map[string]any{
 				{
 					"id": "12345",
@@ -82,70 +79,20 @@ func (t *TicketCreatedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
 	return nil
 }
 
-// Execute performs the trigger logic
+// Execute returns the tickets created since the last poll, following every
+// page of results and resuming from a stored cursor.
 func (t *TicketCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
 	props, err := sdk.InputToTypeSafely[ticketCreatedTriggerProps](ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	authCtx, err := ctx.AuthContext()
-	if err != nil {
-		return nil, err
-	}
-
-	// Get the last run time
-	var lastRunTime *time.Time
-	lr, err := ctx.GetMetadata("lastRun")
-	if err == nil && lr != nil {
-		lastRunTime = lr.(*time.Time)
-	}
-
-	url := "/crm/v3/objects/tickets/search"
-	const limit = 100
-
-	requestBody := map[string]interface{}{
-		"limit": limit,
-		"sorts": []map[string]string{
-			{
-				"propertyName": "createdate",
-				"direction":    "DESCENDING",
-			},
-		},
-	}
-
-	// Only add filter if there's a last run time
-	if lastRunTime != nil {
-		requestBody["filterGroups"] = []map[string]interface{}{
-			{
-				"filters": []map[string]interface{}{
-					{
-						"propertyName": "createdate",
-						"operator":     "GT",
-						"value":        lastRunTime.UnixMilli(),
-					},
-				},
-			},
-		}
-	}
-
-	// Add properties if specified
-	if props.Properties != "" {
-		requestBody["properties"] = append(
-			[]string{"subject", "hs_ticket_priority", "hs_pipeline_stage", "createdate"},
-			props.Properties,
-		)
-	}
-	jsonBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request body: %v", err)
-	}
-	resp, err := shared.HubspotClient(url, authCtx.Token.AccessToken, http.MethodPost, jsonBody)
-	if err != nil {
-		return nil, err
+	properties := shared.SplitProperties(props.Properties)
+	if len(properties) > 0 {
+		properties = append([]string{"subject", "hs_ticket_priority", "hs_pipeline_stage", "createdate"}, properties...)
 	}
 
-	return resp, nil
+	return shared.PollChanges(ctx, shared.ObjectTickets, "createdate", properties)
 }
 
 // Criteria returns the criteria for triggering this trigger
@@ -156,6 +103,7 @@ func (t *TicketCreatedTrigger) Criteria(ctx context.Context) core.TriggerCriteri
 // SampleData returns sample data for this trigger
 func (t *TicketCreatedTrigger) SampleData() core.JSON {
 	return map[string]any{
+		"count": 1,
 		"results": []map[string]any{
 			{
 				"id": "12345",
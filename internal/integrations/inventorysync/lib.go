@@ -0,0 +1,45 @@
+package inventorysync
+
+import (
+	_ "embed"
+
+	"github.com/wakflo/extensions/internal/integrations/inventorysync/shared"
+	"github.com/wakflo/extensions/internal/integrations/inventorysync/triggers"
+	"github.com/wakflo/go-sdk/v2"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+//go:embed README.md
+var ReadME string
+
+//go:embed flo.toml
+var Flow string
+
+var Integration = sdk.Register(NewInventorySync())
+
+type InventorySync struct{}
+
+func (n *InventorySync) Metadata() sdk.IntegrationMetadata {
+	return sdk.LoadMetadataFromFlo(Flow, ReadME)
+}
+
+func (n *InventorySync) Auth() *core.AuthMetadata {
+	return &core.AuthMetadata{
+		Required: true,
+		Schema:   shared.SharedAuth,
+	}
+}
+
+func (n *InventorySync) Triggers() []sdk.Trigger {
+	return []sdk.Trigger{
+		triggers.NewInventorySyncTrigger(),
+	}
+}
+
+func (n *InventorySync) Actions() []sdk.Action {
+	return []sdk.Action{}
+}
+
+func NewInventorySync() sdk.Integration {
+	return &InventorySync{}
+}
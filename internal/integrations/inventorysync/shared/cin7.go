@@ -0,0 +1,144 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	cin7shared "github.com/wakflo/extensions/internal/integrations/cin7/shared"
+	"github.com/wakflo/extensions/internal/inventory"
+)
+
+const cin7PageSize = 1000
+
+type cin7Product struct {
+	ID        string
+	OnHand    float64
+	Available float64
+	Value     float64
+}
+
+type cin7Store struct {
+	accountID string
+	key       string
+	location  string
+	products  map[string]*cin7Product
+}
+
+func newCin7Store(values map[string]string) (*cin7Store, error) {
+	accountID, key, err := cin7shared.CredentialsFrom(values)
+	if err != nil {
+		return nil, err
+	}
+	store := &cin7Store{
+		accountID: accountID,
+		key:       key,
+		location:  values["location"],
+		products:  map[string]*cin7Product{},
+	}
+	if store.location == "" {
+		return nil, errors.New("the connection has no Cin7 location to sync")
+	}
+	return store, nil
+}
+
+// Levels pages through the availability of the location. Rows per bin
+// and batch are added up by SKU.
+func (s *cin7Store) Levels(skus []string) (map[string]int, error) {
+	wanted := map[string]bool{}
+	for _, sku := range skus {
+		wanted[sku] = true
+	}
+
+	for page := 1; ; page++ {
+		result, err := cin7shared.FetchData("/ExternalApi/v2/ref/productavailability", s.accountID, s.key, map[string]interface{}{
+			"Page":     page,
+			"Limit":    cin7PageSize,
+			"Location": s.location,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var resp struct {
+			Total int `json:"Total"`
+			Rows  []struct {
+				ID          string  `json:"ID"`
+				SKU         string  `json:"SKU"`
+				Location    string  `json:"Location"`
+				OnHand      float64 `json:"OnHand"`
+				Available   float64 `json:"Available"`
+				StockOnHand float64 `json:"StockOnHand"`
+			} `json:"ProductAvailabilityList"`
+		}
+		if err := remarshal(result, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, row := range resp.Rows {
+			if !wanted[row.SKU] || row.Location != s.location {
+				continue
+			}
+			product, ok := s.products[row.SKU]
+			if !ok {
+				product = &cin7Product{ID: row.ID}
+				s.products[row.SKU] = product
+			}
+			product.OnHand += row.OnHand
+			product.Available += row.Available
+			product.Value += row.StockOnHand
+		}
+
+		if page*cin7PageSize >= resp.Total {
+			break
+		}
+	}
+
+	levels := make(map[string]int, len(s.products))
+	for sku, product := range s.products {
+		levels[sku] = int(math.Round(product.Available))
+	}
+	return levels, nil
+}
+
+// Apply posts a completed stock adjustment. Cin7 takes the new on hand
+// quantity, so the delta of the available quantity is applied to it, at
+// the current average cost.
+func (s *cin7Store) Apply(adjustments []*inventory.Adjustment, fail func(*inventory.Adjustment, error)) {
+	lines := make([]map[string]interface{}, 0, len(adjustments))
+	for _, adj := range adjustments {
+		product := s.products[adj.LocalSKU]
+		var unitCost float64
+		if product.OnHand > 0 {
+			unitCost = product.Value / product.OnHand
+		}
+		lines = append(lines, map[string]interface{}{
+			"ProductID": product.ID,
+			"SKU":       adj.LocalSKU,
+			"Quantity":  product.OnHand + float64(adj.Delta),
+			"UnitCost":  math.Round(unitCost*10000) / 10000,
+			"Location":  s.location,
+		})
+	}
+
+	body := map[string]interface{}{
+		"EffectiveDate": time.Now().UTC().Format(time.RFC3339),
+		"Status":        "COMPLETED",
+		"Reference":     "Inventory sync",
+		"Lines":         lines,
+	}
+	if _, err := cin7shared.SendData(http.MethodPost, "/ExternalApi/v2/stockadjustment", s.accountID, s.key, body); err != nil {
+		failAll(adjustments, fail, fmt.Errorf("cin7: %w", err))
+	}
+}
+
+func remarshal(in interface{}, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
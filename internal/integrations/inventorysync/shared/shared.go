@@ -0,0 +1,194 @@
+package shared
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/inventory"
+	"github.com/wakflo/extensions/internal/zoho"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// System names, as used in SKU mappings and reports.
+const (
+	Shopify     = "shopify"
+	WooCommerce = "woocommerce"
+	Zoho        = "zoho"
+	Cin7        = "cin7"
+)
+
+var SystemOptions = []*smartform.Option{
+	{Value: Shopify, Label: "Shopify"},
+	{Value: WooCommerce, Label: "WooCommerce"},
+	{Value: Zoho, Label: "Zoho Inventory"},
+	{Value: Cin7, Label: "Cin7 Core"},
+}
+
+// The fields of a system are named after the ones of its connector, with
+// the system as prefix, so the values the connector's client reads can be
+// passed on as they are.
+var (
+	form = smartform.NewAuthForm("inventory-sync-auth", "Inventory Sync Connections", smartform.AuthStrategyCustom)
+
+	_ = form.TextField("shopify_domain", "Shopify Shop Name").
+		Required(false).
+		HelpText("The shop name in your admin URL: **example** for https://example.myshopify.com/admin.")
+
+	_ = form.TextField("shopify_token", "Shopify Admin Token").
+		Required(false).
+		HelpText("An admin API token with the read_inventory, write_inventory, read_products and read_locations scopes.")
+
+	_ = form.TextField("shopify_location_id", "Shopify Location ID").
+		Required(false).
+		HelpText("The location to sync. Leave empty for the shop's primary location.")
+
+	_ = form.TextField("woocommerce_shop-url", "WooCommerce Store URL").
+		Required(false).
+		HelpText("For example https://shop.example.com.")
+
+	_ = form.TextField("woocommerce_consumer-key", "WooCommerce Consumer Key").
+		Required(false)
+
+	_ = form.TextField("woocommerce_consumer-secret", "WooCommerce Consumer Secret").
+		Required(false)
+
+	_ = form.TextField("zoho_client_id", "Zoho Client ID").
+		Required(false).
+		HelpText("From a self client created in the Zoho API console.")
+
+	_ = form.TextField("zoho_client_secret", "Zoho Client Secret").
+		Required(false)
+
+	_ = form.TextField("zoho_refresh_token", "Zoho Refresh Token").
+		Required(false).
+		HelpText("A refresh token generated by the self client with the ZohoInventory.items.READ and ZohoInventory.inventoryadjustments.CREATE scopes.")
+
+	_ = form.TextField("zoho_organization_id", "Zoho Organization ID").
+		Required(false)
+
+	_ = form.SelectField(Zoho+"_"+zoho.RegionField, "Zoho Data Center").
+		Required(false).
+		DefaultValue(zoho.US.Code).
+		AddOptions(zoho.RegionOptions()...).
+		HelpText("The Zoho data center of your account, as in the domain you sign in to, such as zoho.eu.")
+
+	_ = form.TextField("zoho_warehouse_id", "Zoho Warehouse ID").
+		Required(false).
+		HelpText("The warehouse adjustments are made in, when warehouses are enabled.")
+
+	_ = form.TextField("cin7_account_id", "Cin7 Account ID").
+		Required(false)
+
+	_ = form.TextField("cin7_key", "Cin7 Application Key").
+		Required(false)
+
+	_ = form.TextField("cin7_location", "Cin7 Location").
+		Required(false).
+		HelpText("The name of the Cin7 location to sync, for example Main Warehouse.")
+
+	SharedAuth = form.Build()
+)
+
+// Store reads and adjusts the stock of one system. SKUs are the ones the
+// system uses; Levels must be called before Apply.
+type Store interface {
+	Levels(skus []string) (map[string]int, error)
+	Apply(adjustments []*inventory.Adjustment, fail func(*inventory.Adjustment, error))
+}
+
+// Stores connects to the given systems with the credentials of the
+// connection.
+func Stores(ctx sdkcontext.BaseContext, systems []string) (map[string]Store, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	values := authCtx.Extra
+	if values == nil {
+		values = map[string]string{}
+	}
+
+	stores := map[string]Store{}
+	for _, system := range systems {
+		var store Store
+		connection := systemValues(values, system)
+		switch system {
+		case Shopify:
+			store, err = newShopifyStore(connection)
+		case WooCommerce:
+			store, err = newWooCommerceStore(connection)
+		case Zoho:
+			store, err = newZohoStore(connection)
+		case Cin7:
+			store, err = newCin7Store(connection)
+		default:
+			err = fmt.Errorf("unknown system %q", system)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to %s: %w", system, err)
+		}
+		stores[system] = store
+	}
+	return stores, nil
+}
+
+// systemValues returns the values of a system's fields, keyed by the
+// field names of the system's connector.
+func systemValues(values map[string]string, system string) map[string]string {
+	connection := map[string]string{}
+	for key, value := range values {
+		if field, ok := strings.CutPrefix(key, system+"_"); ok {
+			connection[field] = value
+		}
+	}
+	return connection
+}
+
+// ReadLevels reads every store and returns the levels by canonical SKU.
+func ReadLevels(stores map[string]Store, mapping *inventory.Mapping) (map[string]inventory.Levels, error) {
+	systems := make([]string, 0, len(stores))
+	for system := range stores {
+		systems = append(systems, system)
+	}
+	sort.Strings(systems)
+
+	levels := map[string]inventory.Levels{}
+	for _, system := range systems {
+		local, err := stores[system].Levels(mapping.LocalSKUs(system))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s stock: %w", system, err)
+		}
+		levels[system] = mapping.Translate(system, local)
+	}
+	return levels, nil
+}
+
+// ApplyReport pushes the adjustments of a report and records failures.
+func ApplyReport(stores map[string]Store, mapping *inventory.Mapping, report *inventory.Report) {
+	for system, adjustments := range report.Adjustments() {
+		for _, adj := range adjustments {
+			adj.LocalSKU = mapping.Local(system, adj.SKU)
+		}
+		if report.DryRun {
+			continue
+		}
+		stores[system].Apply(adjustments, report.Fail)
+	}
+	report.Finish()
+}
+
+func chunks[T any](items []T, size int) [][]T {
+	var out [][]T
+	for start := 0; start < len(items); start += size {
+		out = append(out, items[start:min(start+size, len(items))])
+	}
+	return out
+}
+
+func failAll(adjustments []*inventory.Adjustment, fail func(*inventory.Adjustment, error), err error) {
+	for _, adj := range adjustments {
+		fail(adj, err)
+	}
+}
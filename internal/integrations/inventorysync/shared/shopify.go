@@ -0,0 +1,178 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	shopifyshared "github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/extensions/internal/inventory"
+)
+
+const (
+	shopifySKUsPerQuery  = 50
+	shopifyChangesPerRun = 250
+)
+
+const shopifyLevelsQuery = `query($query: String!, $location: ID!, $after: String) {
+  productVariants(first: 100, query: $query, after: $after) {
+    pageInfo { hasNextPage endCursor }
+    nodes {
+      sku
+      inventoryItem {
+        id
+        tracked
+        inventoryLevel(locationId: $location) {
+          quantities(names: ["available"]) { name quantity }
+        }
+      }
+    }
+  }
+}`
+
+const shopifyAdjustMutation = `mutation($input: InventoryAdjustQuantitiesInput!) {
+  inventoryAdjustQuantities(input: $input) {
+    userErrors { field message }
+  }
+}`
+
+type shopifyStore struct {
+	client     *goshopify.Client
+	locationID string
+	items      map[string]string
+}
+
+func newShopifyStore(values map[string]string) (*shopifyStore, error) {
+	client, err := shopifyshared.GraphQLClientFrom(values)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &shopifyStore{client: client, items: map[string]string{}}
+	if id := values["location_id"]; id != "" {
+		if store.locationID, err = shopifyshared.GID("Location", id); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+// location returns the location to sync, the primary one by default.
+func (s *shopifyStore) location(ctx context.Context) (string, error) {
+	if s.locationID != "" {
+		return s.locationID, nil
+	}
+	var resp struct {
+		Location struct {
+			ID string `json:"id"`
+		} `json:"location"`
+	}
+	if err := s.client.GraphQL.Query(ctx, `{ location { id } }`, nil, &resp); err != nil {
+		return "", err
+	}
+	if resp.Location.ID == "" {
+		return "", errors.New("the shop has no primary location")
+	}
+	s.locationID = resp.Location.ID
+	return s.locationID, nil
+}
+
+// Levels returns the available quantity of tracked variants at the
+// location. SKU search matches prefixes, so only exact SKUs are kept.
+func (s *shopifyStore) Levels(skus []string) (map[string]int, error) {
+	ctx := context.Background()
+	location, err := s.location(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]bool{}
+	for _, sku := range skus {
+		wanted[sku] = true
+	}
+
+	levels := map[string]int{}
+	for _, chunk := range chunks(skus, shopifySKUsPerQuery) {
+		terms := make([]string, 0, len(chunk))
+		for _, sku := range chunk {
+			terms = append(terms, "sku:"+strconv.Quote(sku))
+		}
+
+		vars := map[string]interface{}{"query": strings.Join(terms, " OR "), "location": location}
+		for {
+			var resp struct {
+				ProductVariants struct {
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Nodes []struct {
+						SKU           string `json:"sku"`
+						InventoryItem struct {
+							ID             string `json:"id"`
+							Tracked        bool   `json:"tracked"`
+							InventoryLevel *struct {
+								Quantities []struct {
+									Name     string `json:"name"`
+									Quantity int    `json:"quantity"`
+								} `json:"quantities"`
+							} `json:"inventoryLevel"`
+						} `json:"inventoryItem"`
+					} `json:"nodes"`
+				} `json:"productVariants"`
+			}
+			if err := s.client.GraphQL.Query(ctx, shopifyLevelsQuery, vars, &resp); err != nil {
+				return nil, err
+			}
+
+			for _, node := range resp.ProductVariants.Nodes {
+				item := node.InventoryItem
+				if !wanted[node.SKU] || !item.Tracked || item.InventoryLevel == nil {
+					continue
+				}
+				for _, q := range item.InventoryLevel.Quantities {
+					if q.Name == "available" {
+						levels[node.SKU] = q.Quantity
+						s.items[node.SKU] = item.ID
+					}
+				}
+			}
+
+			if !resp.ProductVariants.PageInfo.HasNextPage {
+				break
+			}
+			vars["after"] = resp.ProductVariants.PageInfo.EndCursor
+		}
+	}
+	return levels, nil
+}
+
+// Apply adjusts the available quantities by their deltas, so sales made
+// between the read and the write are not lost.
+func (s *shopifyStore) Apply(adjustments []*inventory.Adjustment, fail func(*inventory.Adjustment, error)) {
+	ctx := context.Background()
+	for _, chunk := range chunks(adjustments, shopifyChangesPerRun) {
+		changes := make([]map[string]interface{}, 0, len(chunk))
+		for _, adj := range chunk {
+			changes = append(changes, map[string]interface{}{
+				"inventoryItemId": s.items[adj.LocalSKU],
+				"locationId":      s.locationID,
+				"delta":           adj.Delta,
+			})
+		}
+
+		input := map[string]interface{}{
+			"reason":  "correction",
+			"name":    "available",
+			"changes": changes,
+		}
+		err := shopifyshared.Mutate(ctx, s.client, "inventoryAdjustQuantities", shopifyAdjustMutation,
+			map[string]interface{}{"input": input}, nil)
+		if err != nil {
+			failAll(chunk, fail, fmt.Errorf("shopify: %w", err))
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"fmt"
+
+	wooshared "github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/extensions/internal/inventory"
+)
+
+type wooCommerceStore struct {
+	creds  *wooshared.Credentials
+	levels map[string]wooshared.StockLevel
+}
+
+func newWooCommerceStore(values map[string]string) (*wooCommerceStore, error) {
+	creds, err := wooshared.CredentialsFrom(values)
+	if err != nil {
+		return nil, err
+	}
+	return &wooCommerceStore{creds: creds}, nil
+}
+
+// Levels returns the stock of products and variations that manage it.
+func (s *wooCommerceStore) Levels(skus []string) (map[string]int, error) {
+	found, err := s.creds.StockLevels(skus)
+	if err != nil {
+		return nil, err
+	}
+	s.levels = found
+
+	levels := make(map[string]int, len(found))
+	for sku, level := range found {
+		levels[sku] = level.Quantity
+	}
+	return levels, nil
+}
+
+// Apply sets the stock quantities with the batch endpoints. WooCommerce
+// has no relative stock update, so the new level is written as is.
+func (s *wooCommerceStore) Apply(adjustments []*inventory.Adjustment, fail func(*inventory.Adjustment, error)) {
+	updates := make([]wooshared.StockUpdate, 0, len(adjustments))
+	byID := map[int]*inventory.Adjustment{}
+	for _, adj := range adjustments {
+		level := s.levels[adj.LocalSKU]
+		quantity := adj.To
+		updates = append(updates, wooshared.StockUpdate{
+			ID:            level.ID,
+			ParentID:      level.ParentID,
+			SKU:           adj.LocalSKU,
+			StockQuantity: &quantity,
+		})
+		byID[level.ID] = adj
+	}
+
+	result, err := s.creds.BatchUpdateStock(updates)
+	if err != nil {
+		failAll(adjustments, fail, fmt.Errorf("woocommerce: %w", err))
+		return
+	}
+	for _, item := range result.Failed {
+		id, _ := item["id"].(float64)
+		if adj, ok := byID[int(id)]; ok {
+			fail(adj, fmt.Errorf("woocommerce: %v", item["error"]))
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	zohoshared "github.com/wakflo/extensions/internal/integrations/zohoinventory/shared"
+	"github.com/wakflo/extensions/internal/inventory"
+	"github.com/wakflo/extensions/internal/zoho"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"golang.org/x/oauth2"
+)
+
+const zohoLinesPerAdjustment = 100
+
+type zohoStore struct {
+	auth           *sdkcontext.AuthContext
+	organizationID string
+	warehouseID    string
+	items          map[string]string
+}
+
+// newZohoStore connects with the refresh token of a Zoho self client. It
+// is held as an expired token of a Zoho Inventory connection, which the
+// connector's client refreshes against the data center of the account.
+func newZohoStore(values map[string]string) (*zohoStore, error) {
+	store := &zohoStore{
+		auth: &sdkcontext.AuthContext{
+			Key:    values["client_id"],
+			Secret: values["client_secret"],
+			Token:  &oauth2.Token{RefreshToken: values["refresh_token"]},
+			Extra:  map[string]string{zoho.RegionField: values[zoho.RegionField]},
+		},
+		organizationID: values["organization_id"],
+		warehouseID:    values["warehouse_id"],
+		items:          map[string]string{},
+	}
+	if store.auth.Key == "" || store.auth.Secret == "" || store.auth.Token.RefreshToken == "" || store.organizationID == "" {
+		return nil, errors.New("the connection has no Zoho client, refresh token and organization ID")
+	}
+	if region := values[zoho.RegionField]; region != "" {
+		if _, ok := zoho.FindRegion(region); !ok {
+			return nil, fmt.Errorf("unknown Zoho data center %q", region)
+		}
+	}
+	return store, nil
+}
+
+func (s *zohoStore) request(method, endpoint string, params url.Values, body interface{}, out interface{}) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("organization_id", s.organizationID)
+	return zohoshared.Request(s.auth, method, "/v1"+endpoint, params, body, out)
+}
+
+// Levels pages through the items and returns the available stock of the
+// tracked ones with the given SKUs.
+func (s *zohoStore) Levels(skus []string) (map[string]int, error) {
+	wanted := map[string]bool{}
+	for _, sku := range skus {
+		wanted[sku] = true
+	}
+
+	levels := map[string]int{}
+	for page := 1; ; page++ {
+		params := url.Values{}
+		params.Set("page", strconv.Itoa(page))
+		params.Set("per_page", "200")
+
+		var resp struct {
+			Items []struct {
+				ItemID         string   `json:"item_id"`
+				SKU            string   `json:"sku"`
+				AvailableStock *float64 `json:"available_stock"`
+			} `json:"items"`
+			PageContext struct {
+				HasMorePage bool `json:"has_more_page"`
+			} `json:"page_context"`
+		}
+		if err := s.request(http.MethodGet, "/items", params, nil, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, item := range resp.Items {
+			if !wanted[item.SKU] || item.AvailableStock == nil {
+				continue
+			}
+			levels[item.SKU] = int(math.Round(*item.AvailableStock))
+			s.items[item.SKU] = item.ItemID
+		}
+
+		if !resp.PageContext.HasMorePage {
+			break
+		}
+	}
+	return levels, nil
+}
+
+// Apply records quantity adjustments of the deltas.
+func (s *zohoStore) Apply(adjustments []*inventory.Adjustment, fail func(*inventory.Adjustment, error)) {
+	for _, chunk := range chunks(adjustments, zohoLinesPerAdjustment) {
+		lines := make([]map[string]interface{}, 0, len(chunk))
+		for _, adj := range chunk {
+			line := map[string]interface{}{
+				"item_id":           s.items[adj.LocalSKU],
+				"quantity_adjusted": adj.Delta,
+			}
+			if s.warehouseID != "" {
+				line["warehouse_id"] = s.warehouseID
+			}
+			lines = append(lines, line)
+		}
+
+		body := map[string]interface{}{
+			"date":            time.Now().UTC().Format("2006-01-02"),
+			"reason":          "Inventory sync",
+			"adjustment_type": "quantity",
+			"line_items":      lines,
+		}
+		if err := s.request(http.MethodPost, "/inventoryadjustments", nil, body, nil); err != nil {
+			failAll(chunk, fail, err)
+		}
+	}
+}
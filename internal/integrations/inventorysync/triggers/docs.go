@@ -0,0 +1,8 @@
+package triggers
+
+import (
+	_ "embed"
+)
+
+//go:embed inventory_sync.md
+var inventorySyncDocs string
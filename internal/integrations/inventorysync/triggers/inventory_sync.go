@@ -0,0 +1,232 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/inventorysync/shared"
+	"github.com/wakflo/extensions/internal/inventory"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+const snapshotKey = "snapshot"
+
+type inventorySyncTriggerProps struct {
+	Systems    []string `json:"systems"`
+	Rule       string   `json:"rule"`
+	Source     string   `json:"source_system"`
+	ReadOnly   []string `json:"read_only"`
+	SKUs       string   `json:"skus"`
+	DryRun     bool     `json:"dry_run"`
+	EmitInSync bool     `json:"emit_in_sync"`
+}
+
+type InventorySyncTrigger struct{}
+
+func (t *InventorySyncTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "inventory_sync",
+		DisplayName:   "Sync Inventory",
+		Description:   "Keeps stock levels in sync across Shopify, WooCommerce, Zoho Inventory and Cin7 on every poll, and triggers with a reconciliation report of the changes made.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: inventorySyncDocs,
+		SampleOutput:  []map[string]any{reportSample},
+	}
+}
+
+func (t *InventorySyncTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *InventorySyncTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *InventorySyncTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("inventory-sync", "Sync Inventory")
+
+	form.MultiSelectField("systems", "Systems").
+		Required(true).
+		AddOptions(shared.SystemOptions...).
+		HelpText("The systems to keep in sync. Each needs its credentials in the connection.")
+
+	form.SelectField("rule", "Rule").
+		Required(true).
+		DefaultValue(string(inventory.Sum)).
+		AddOption(string(inventory.Sum), "Sum: count the changes of every system").
+		AddOption(string(inventory.SourceOfTruth), "Source of truth: copy the source system").
+		AddOption(string(inventory.Min), "Min: bring every system down to the lowest level").
+		HelpText("How the level every system is brought to is decided.")
+
+	form.SelectField("source_system", "Source System").
+		Required(false).
+		AddOptions(shared.SystemOptions...).
+		HelpText("The system of record. Required by the source of truth rule, it seeds the sum rule on the first sync of a SKU, after which the sum rule adjusts it like the others unless it is read-only.")
+
+	form.MultiSelectField("read_only", "Read-Only Systems").
+		Required(false).
+		AddOptions(shared.SystemOptions...).
+		HelpText("Systems that are compared but never adjusted.")
+
+	form.TextareaField("skus", "SKUs").
+		Required(true).
+		Placeholder("TSHIRT-M\nMUG-01, shopify=MUG-WHITE, cin7=100234").
+		HelpText("One SKU per line, followed by the SKU other systems use for it when it differs, as system=SKU.")
+
+	form.CheckboxField("dry_run", "Dry Run").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Report the adjustments without making them.")
+
+	form.CheckboxField("emit_in_sync", "Trigger When In Sync").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Also trigger when there was nothing to adjust.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the inventorySyncTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *InventorySyncTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the inventorySyncTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *InventorySyncTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute reads the stock of every system, reconciles it against the
+// snapshot of the last sync, pushes the adjustments and keeps the new
+// snapshot. A dry run leaves the snapshot alone.
+func (t *InventorySyncTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[inventorySyncTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(input.Systems) < 2 {
+		return nil, errors.New("select at least two systems to sync")
+	}
+	if input.Source != "" && !slices.Contains(input.Systems, input.Source) {
+		return nil, errors.New("the source system must be one of the synced systems")
+	}
+
+	mapping, err := inventory.ParseMapping(input.SKUs)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapping.SKUs()) == 0 {
+		return nil, errors.New("at least one SKU is required")
+	}
+
+	stores, err := shared.Stores(ctx, input.Systems)
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := shared.ReadLevels(stores, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	prev := loadSnapshot(ctx)
+	report, err := inventory.Reconcile(levels, prev, inventory.Options{
+		Rule:     inventory.Rule(input.Rule),
+		Source:   input.Source,
+		ReadOnly: input.ReadOnly,
+		DryRun:   input.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shared.ApplyReport(stores, mapping, report)
+
+	if !input.DryRun {
+		if err := ctx.SetMetadata(snapshotKey, report.Snapshot(prev)); err != nil {
+			return nil, err
+		}
+	}
+
+	if report.Summary.InSync == report.Summary.SKUs && !input.EmitInSync {
+		return []*inventory.Report{}, nil
+	}
+	return []*inventory.Report{report}, nil
+}
+
+// loadSnapshot returns the snapshot of the last sync, or an empty one.
+func loadSnapshot(ctx sdkcontext.ExecuteContext) inventory.Snapshot {
+	var snapshot inventory.Snapshot
+	stored, err := ctx.GetMetadata(snapshotKey)
+	if err != nil || stored == nil {
+		return snapshot
+	}
+
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &snapshot)
+	}
+	if err != nil {
+		ctx.Logger().Warn("ignoring an unreadable inventory snapshot", "error", err)
+		return inventory.Snapshot{}
+	}
+	return snapshot
+}
+
+func (t *InventorySyncTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *InventorySyncTrigger) SampleData() sdkcore.JSON {
+	return reportSample
+}
+
+func NewInventorySyncTrigger() sdk.Trigger {
+	return &InventorySyncTrigger{}
+}
+
+var reportSample = map[string]any{
+	"rule":    "sum",
+	"source":  "cin7",
+	"dry_run": false,
+	"systems": []string{"cin7", "shopify", "woocommerce"},
+	"summary": map[string]any{
+		"skus":        2,
+		"in_sync":     1,
+		"adjusted":    1,
+		"conflicts":   0,
+		"oversold":    0,
+		"missing":     0,
+		"failed":      0,
+		"adjustments": 3,
+	},
+	"lines": []map[string]any{
+		{
+			"sku":     "MUG-01",
+			"status":  "adjusted",
+			"target":  5,
+			"levels":  map[string]any{"cin7": 7, "shopify": 8, "woocommerce": 10},
+			"changes": map[string]any{"cin7": -3, "shopify": -2},
+			"adjustments": []map[string]any{
+				{"system": "cin7", "sku": "MUG-01", "local_sku": "100234", "from": 7, "to": 5, "delta": -2, "applied": true},
+				{"system": "shopify", "sku": "MUG-01", "local_sku": "MUG-WHITE", "from": 8, "to": 5, "delta": -3, "applied": true},
+				{"system": "woocommerce", "sku": "MUG-01", "local_sku": "MUG-01", "from": 10, "to": 5, "delta": -5, "applied": true},
+			},
+		},
+		{
+			"sku":    "TSHIRT-M",
+			"status": "in_sync",
+			"target": 12,
+			"levels": map[string]any{"cin7": 12, "shopify": 12, "woocommerce": 12},
+		},
+	},
+	"ran_at": "2025-03-12T18:20:00Z",
+}
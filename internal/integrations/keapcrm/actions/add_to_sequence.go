@@ -0,0 +1,98 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type addToSequenceActionProps struct {
+	ContactID  string `json:"contact_id"`
+	CampaignID string `json:"campaign_id"`
+	SequenceID string `json:"sequence_id"`
+}
+
+type AddToSequenceAction struct{}
+
+func (a *AddToSequenceAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "add_to_sequence",
+		DisplayName:   "Add Contact to Campaign Sequence",
+		Description:   "Start a Keap campaign sequence for a contact",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: addToSequenceDocs,
+		Icon:          "mdi:playlist-play",
+		SampleOutput: map[string]any{
+			"contact_id":  12345,
+			"campaign_id": 38,
+			"sequence_id": 112,
+			"added":       true,
+		},
+	}
+}
+
+func (a *AddToSequenceAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("add_to_sequence", "Add Contact to Campaign Sequence")
+
+	form.TextField("contact_id", "Contact ID").
+		Placeholder("contact ID").
+		Required(true).
+		HelpText("Unique identifier of the contact to add")
+
+	shared.RegisterCampaignsProps(form)
+
+	shared.RegisterSequencesProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *AddToSequenceAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[addToSequenceActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	contactID, err := shared.ParseID("contact ID", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+	campaignID, err := shared.ParseID("campaign", input.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	sequenceID, err := shared.ParseID("sequence", input.SequenceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := shared.AddToSequence(token, campaignID, sequenceID, contactID); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"contact_id":  contactID,
+		"campaign_id": campaignID,
+		"sequence_id": sequenceID,
+		"added":       true,
+	}, nil
+}
+
+func (a *AddToSequenceAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewAddToSequenceAction() sdk.Action {
+	return &AddToSequenceAction{}
+}
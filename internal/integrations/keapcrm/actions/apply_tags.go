@@ -0,0 +1,97 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type applyTagsActionProps struct {
+	ContactID string `json:"contact_id"`
+	Tags      string `json:"tags"`
+}
+
+type ApplyTagsAction struct{}
+
+func (a *ApplyTagsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "apply_tags",
+		DisplayName:   "Apply Tags",
+		Description:   "Apply tags to a contact in Keap, creating tags that don't exist yet",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: applyTagsDocs,
+		Icon:          "mdi:tag-plus",
+		SampleOutput: map[string]any{
+			"contact_id": 12345,
+			"tag_ids":    []int64{91, 93},
+			"tags":       []string{"Customer", "Webinar Attendee"},
+		},
+	}
+}
+
+func (a *ApplyTagsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("apply_tags", "Apply Tags")
+
+	form.TextField("contact_id", "Contact ID").
+		Placeholder("contact ID").
+		Required(true).
+		HelpText("Unique identifier of the contact to tag")
+
+	form.TextField("tags", "Tags").
+		Placeholder("Customer, Webinar Attendee").
+		Required(true).
+		HelpText("Comma-separated tag names. Tags Keap doesn't have yet are created.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *ApplyTagsAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[applyTagsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	contactID, err := shared.ParseID("contact ID", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+	tags := crm.SplitTags(input.Tags)
+	if len(tags) == 0 {
+		return nil, errors.New("at least one tag is required")
+	}
+
+	tagIDs, err := shared.TagIDs(token, tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := shared.ApplyTags(token, contactID, tagIDs); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"contact_id": contactID,
+		"tag_ids":    tagIDs,
+		"tags":       tags,
+	}, nil
+}
+
+func (a *ApplyTagsAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewApplyTagsAction() sdk.Action {
+	return &ApplyTagsAction{}
+}
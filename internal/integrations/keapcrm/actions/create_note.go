@@ -0,0 +1,115 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createNoteActionProps struct {
+	ContactID string `json:"contact_id"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	Type      string `json:"type"`
+}
+
+type CreateNoteAction struct{}
+
+func (a *CreateNoteAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_note",
+		DisplayName:   "Create Note",
+		Description:   "Add a note to a contact's record in Keap",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createNoteDocs,
+		Icon:          "mdi:note-plus",
+		SampleOutput: map[string]any{
+			"id":           771,
+			"contact_id":   12345,
+			"title":        "Support call",
+			"body":         "Asked about upgrading to the annual plan.",
+			"type":         "Call",
+			"date_created": "2025-03-12T18:20:00.000Z",
+		},
+	}
+}
+
+func (a *CreateNoteAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_note", "Create Note")
+
+	form.TextField("contact_id", "Contact ID").
+		Placeholder("contact ID").
+		Required(true).
+		HelpText("Unique identifier of the contact the note is about")
+
+	form.TextField("title", "Title").
+		Required(true)
+
+	form.TextareaField("body", "Body").
+		Required(false)
+
+	form.SelectField("type", "Type").
+		Required(false).
+		DefaultValue("Other").
+		AddOptions(noteTypeOptions...)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateNoteAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createNoteActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	contactID, err := shared.ParseID("contact ID", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Title) == "" {
+		return nil, errors.New("title is required")
+	}
+
+	note := map[string]interface{}{
+		"contact_id": contactID,
+		"title":      input.Title,
+		"body":       input.Body,
+	}
+	if input.Type != "" {
+		note["type"] = input.Type
+	}
+
+	return shared.MakeKeapRequest(token, http.MethodPost, "/notes", note)
+}
+
+func (a *CreateNoteAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// noteTypeOptions are the types Keap takes for notes and tasks.
+var noteTypeOptions = []*smartform.Option{
+	{Value: "Call", Label: "Call"},
+	{Value: "Email", Label: "Email"},
+	{Value: "Appointment", Label: "Appointment"},
+	{Value: "Fax", Label: "Fax"},
+	{Value: "Letter", Label: "Letter"},
+	{Value: "Other", Label: "Other"},
+}
+
+func NewCreateNoteAction() sdk.Action {
+	return &CreateNoteAction{}
+}
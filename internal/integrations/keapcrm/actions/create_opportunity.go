@@ -0,0 +1,148 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createOpportunityActionProps struct {
+	ContactID            string  `json:"contact_id"`
+	Title                string  `json:"opportunity_title"`
+	StageID              string  `json:"stage_id"`
+	EstimatedCloseDate   string  `json:"estimated_close_date"`
+	ProjectedRevenueLow  float64 `json:"projected_revenue_low"`
+	ProjectedRevenueHigh float64 `json:"projected_revenue_high"`
+	Notes                string  `json:"opportunity_notes"`
+}
+
+type CreateOpportunityAction struct{}
+
+func (a *CreateOpportunityAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_opportunity",
+		DisplayName:   "Create Opportunity",
+		Description:   "Create an opportunity for a contact in a stage of the Keap pipeline",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createOpportunityDocs,
+		Icon:          "mdi:handshake",
+		SampleOutput: map[string]any{
+			"id":                     301,
+			"opportunity_title":      "Annual plan",
+			"contact":                map[string]any{"id": 12345, "first_name": "John", "last_name": "Doe", "email": "john.doe@example.com"},
+			"stage":                  map[string]any{"id": 4, "name": "Proposal"},
+			"estimated_close_date":   "2025-06-30T00:00:00.000Z",
+			"projected_revenue_low":  4000,
+			"projected_revenue_high": 6000,
+			"date_created":           "2025-03-12T18:20:00.000Z",
+		},
+	}
+}
+
+func (a *CreateOpportunityAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_opportunity", "Create Opportunity")
+
+	form.TextField("contact_id", "Contact ID").
+		Placeholder("contact ID").
+		Required(true).
+		HelpText("Unique identifier of the contact the opportunity is for")
+
+	form.TextField("opportunity_title", "Title").
+		Required(true).
+		HelpText("Title of the opportunity")
+
+	shared.RegisterStagesProps(form, "stage_id", "Stage")
+
+	form.DateTimeField("estimated_close_date", "Estimated Close Date").
+		Required(false)
+
+	form.NumberField("projected_revenue_low", "Projected Revenue (Low)").
+		Required(false)
+
+	form.NumberField("projected_revenue_high", "Projected Revenue (High)").
+		Required(false)
+
+	form.TextareaField("opportunity_notes", "Notes").
+		Required(false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateOpportunityAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createOpportunityActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	contactID, err := shared.ParseID("contact ID", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+	stageID, err := shared.ParseID("stage", input.StageID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Title) == "" {
+		return nil, errors.New("title is required")
+	}
+
+	opportunity := map[string]interface{}{
+		"opportunity_title": input.Title,
+		"contact":           map[string]interface{}{"id": contactID},
+		"stage":             map[string]interface{}{"id": stageID},
+	}
+	if input.EstimatedCloseDate != "" {
+		closeDate, err := parseTime(input.EstimatedCloseDate)
+		if err != nil {
+			return nil, err
+		}
+		opportunity["estimated_close_date"] = closeDate.UTC().Format(time.RFC3339)
+	}
+	if input.ProjectedRevenueLow != 0 {
+		opportunity["projected_revenue_low"] = input.ProjectedRevenueLow
+	}
+	if input.ProjectedRevenueHigh != 0 {
+		opportunity["projected_revenue_high"] = input.ProjectedRevenueHigh
+	}
+	if input.Notes != "" {
+		opportunity["opportunity_notes"] = input.Notes
+	}
+
+	return shared.MakeKeapRequest(token, http.MethodPost, "/opportunities", opportunity)
+}
+
+func (a *CreateOpportunityAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// parseTime reads a date and time, or a date, as the date fields send
+// them.
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date and time such as 2024-03-14T09:00:00Z", s)
+}
+
+func NewCreateOpportunityAction() sdk.Action {
+	return &CreateOpportunityAction{}
+}
@@ -0,0 +1,186 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type orderItem struct {
+	ProductID   string  `json:"product_id"`
+	Quantity    float64 `json:"quantity"`
+	Price       float64 `json:"price"`
+	Description string  `json:"description"`
+}
+
+type createOrderActionProps struct {
+	ContactID  string      `json:"contact_id"`
+	OrderTitle string      `json:"order_title"`
+	OrderDate  string      `json:"order_date"`
+	OrderType  string      `json:"order_type"`
+	Items      []orderItem `json:"order_items"`
+	PromoCodes string      `json:"promo_codes"`
+}
+
+type CreateOrderAction struct{}
+
+func (a *CreateOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_order",
+		DisplayName:   "Create Order",
+		Description:   "Create an order of products for a contact in Keap",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createOrderDocs,
+		Icon:          "mdi:cart-plus",
+		SampleOutput: map[string]any{
+			"id":         8801,
+			"title":      "Online store order #1042",
+			"status":     "UNPAID",
+			"order_type": "Offline",
+			"total":      149,
+			"contact":    map[string]any{"id": 12345, "first_name": "John", "last_name": "Doe", "email": "john.doe@example.com"},
+			"order_items": []map[string]any{
+				{"id": 1, "name": "Starter Kit", "quantity": 1, "price": 149, "product": map[string]any{"id": 17}},
+			},
+			"order_date":    "2025-03-12T18:20:00.000Z",
+			"creation_date": "2025-03-12T18:20:01.000Z",
+		},
+	}
+}
+
+func (a *CreateOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_order", "Create Order")
+
+	form.TextField("contact_id", "Contact ID").
+		Placeholder("contact ID").
+		Required(true).
+		HelpText("Unique identifier of the contact placing the order")
+
+	form.TextField("order_title", "Order Title").
+		Required(true)
+
+	form.DateTimeField("order_date", "Order Date").
+		Required(false).
+		HelpText("When the order was placed. Defaults to now.")
+
+	form.SelectField("order_type", "Order Type").
+		Required(false).
+		DefaultValue("Offline").
+		AddOptions(
+			&smartform.Option{Value: "Offline", Label: "Offline"},
+			&smartform.Option{Value: "Online", Label: "Online"},
+		)
+
+	items := form.ArrayField("order_items", "Items")
+	item := items.ObjectTemplate("order_item", "")
+	item.TextField("product_id", "Product ID").
+		Required(true).
+		HelpText("Unique identifier of the Keap product")
+	item.NumberField("quantity", "Quantity").
+		Required(true)
+	item.NumberField("price", "Price").
+		Required(false).
+		HelpText("Overrides the product's price")
+	item.TextField("description", "Description").
+		Required(false)
+
+	form.TextField("promo_codes", "Promo Codes").
+		Required(false).
+		HelpText("Comma-separated promo codes to apply")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateOrderAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	contactID, err := shared.ParseID("contact ID", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.OrderTitle) == "" {
+		return nil, errors.New("order title is required")
+	}
+	if len(input.Items) == 0 {
+		return nil, errors.New("at least one item is required")
+	}
+
+	orderDate := time.Now()
+	if input.OrderDate != "" {
+		if orderDate, err = parseTime(input.OrderDate); err != nil {
+			return nil, err
+		}
+	}
+	orderType := input.OrderType
+	if orderType == "" {
+		orderType = "Offline"
+	}
+
+	items := make([]map[string]interface{}, 0, len(input.Items))
+	for i, it := range input.Items {
+		productID, err := shared.ParseID(fmt.Sprintf("product ID of item %d", i+1), it.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if it.Quantity < 1 {
+			return nil, fmt.Errorf("quantity of item %d must be at least 1", i+1)
+		}
+		item := map[string]interface{}{
+			"product_id": productID,
+			"quantity":   int(it.Quantity),
+		}
+		if it.Price != 0 {
+			item["price"] = it.Price
+		}
+		if it.Description != "" {
+			item["description"] = it.Description
+		}
+		items = append(items, item)
+	}
+
+	order := map[string]interface{}{
+		"contact_id":  contactID,
+		"order_title": input.OrderTitle,
+		"order_date":  orderDate.UTC().Format(time.RFC3339),
+		"order_type":  orderType,
+		"order_items": items,
+	}
+	var codes []string
+	for _, code := range strings.Split(input.PromoCodes, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) > 0 {
+		order["promo_codes"] = codes
+	}
+
+	return shared.MakeKeapRequest(token, http.MethodPost, "/orders", order)
+}
+
+func (a *CreateOrderAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreateOrderAction() sdk.Action {
+	return &CreateOrderAction{}
+}
@@ -0,0 +1,137 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createTaskActionProps struct {
+	ContactID   string `json:"contact_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	DueDate     string `json:"due_date"`
+	Priority    string `json:"priority"`
+	Type        string `json:"type"`
+}
+
+type CreateTaskAction struct{}
+
+func (a *CreateTaskAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_task",
+		DisplayName:   "Create Task",
+		Description:   "Create a task for a contact in Keap",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createTaskDocs,
+		Icon:          "mdi:checkbox-marked-circle-plus-outline",
+		SampleOutput: map[string]any{
+			"id":          502,
+			"title":       "Follow up on proposal",
+			"description": "Call to answer questions about the annual plan.",
+			"due_date":    "2025-03-14T09:00:00.000Z",
+			"priority":    3,
+			"type":        "Call",
+			"completed":   false,
+			"contact":     map[string]any{"id": 12345, "first_name": "John", "last_name": "Doe", "email": "john.doe@example.com"},
+		},
+	}
+}
+
+func (a *CreateTaskAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_task", "Create Task")
+
+	form.TextField("contact_id", "Contact ID").
+		Placeholder("contact ID").
+		Required(true).
+		HelpText("Unique identifier of the contact the task is for")
+
+	form.TextField("title", "Title").
+		Required(true)
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	form.DateTimeField("due_date", "Due Date").
+		Required(true)
+
+	form.SelectField("priority", "Priority").
+		Required(false).
+		AddOptions(
+			&smartform.Option{Value: "1", Label: "Low"},
+			&smartform.Option{Value: "2", Label: "Medium"},
+			&smartform.Option{Value: "3", Label: "High"},
+		)
+
+	form.SelectField("type", "Type").
+		Required(false).
+		AddOptions(noteTypeOptions...)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateTaskAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createTaskActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	contactID, err := shared.ParseID("contact ID", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Title) == "" {
+		return nil, errors.New("title is required")
+	}
+	if input.DueDate == "" {
+		return nil, errors.New("due date is required")
+	}
+	dueDate, err := parseTime(input.DueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	task := map[string]interface{}{
+		"title":    input.Title,
+		"due_date": dueDate.UTC().Format(time.RFC3339),
+		"contact":  map[string]interface{}{"id": contactID},
+	}
+	if input.Description != "" {
+		task["description"] = input.Description
+	}
+	if input.Priority != "" {
+		priority, err := shared.ParseID("priority", input.Priority)
+		if err != nil {
+			return nil, err
+		}
+		task["priority"] = priority
+	}
+	if input.Type != "" {
+		task["type"] = input.Type
+	}
+
+	return shared.MakeKeapRequest(token, http.MethodPost, "/tasks", task)
+}
+
+func (a *CreateTaskAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreateTaskAction() sdk.Action {
+	return &CreateTaskAction{}
+}
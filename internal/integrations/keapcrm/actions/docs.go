@@ -29,3 +29,30 @@ var createContactDocs string
 
 //go:embed update_contact.md
 var updateContactDocs string
+
+//go:embed upsert_contact.md
+var upsertContactDocs string
+
+//go:embed apply_tags.md
+var applyTagsDocs string
+
+//go:embed remove_tags.md
+var removeTagsDocs string
+
+//go:embed create_opportunity.md
+var createOpportunityDocs string
+
+//go:embed move_opportunity_stage.md
+var moveOpportunityStageDocs string
+
+//go:embed create_order.md
+var createOrderDocs string
+
+//go:embed create_note.md
+var createNoteDocs string
+
+//go:embed create_task.md
+var createTaskDocs string
+
+//go:embed add_to_sequence.md
+var addToSequenceDocs string
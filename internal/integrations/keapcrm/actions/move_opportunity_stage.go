@@ -0,0 +1,87 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type moveOpportunityStageActionProps struct {
+	OpportunityID string `json:"opportunity_id"`
+	StageID       string `json:"stage_id"`
+}
+
+type MoveOpportunityStageAction struct{}
+
+func (a *MoveOpportunityStageAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "move_opportunity_stage",
+		DisplayName:   "Move Opportunity Stage",
+		Description:   "Move an opportunity to another stage of the Keap pipeline",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: moveOpportunityStageDocs,
+		Icon:          "mdi:arrow-right-bold-box",
+		SampleOutput: map[string]any{
+			"id":                301,
+			"opportunity_title": "Annual plan",
+			"stage":             map[string]any{"id": 5, "name": "Won"},
+			"last_updated":      "2025-03-14T09:00:00.000Z",
+		},
+	}
+}
+
+func (a *MoveOpportunityStageAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("move_opportunity_stage", "Move Opportunity Stage")
+
+	form.TextField("opportunity_id", "Opportunity ID").
+		Placeholder("opportunity ID").
+		Required(true).
+		HelpText("Unique identifier of the opportunity to move")
+
+	shared.RegisterStagesProps(form, "stage_id", "Stage").
+		HelpText("The stage to move the opportunity to")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *MoveOpportunityStageAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[moveOpportunityStageActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	opportunityID, err := shared.ParseID("opportunity ID", input.OpportunityID)
+	if err != nil {
+		return nil, err
+	}
+	stageID, err := shared.ParseID("stage", input.StageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.MakeKeapRequest(token, http.MethodPatch, fmt.Sprintf("/opportunities/%d", opportunityID), map[string]interface{}{
+		"stage": map[string]interface{}{"id": stageID},
+	})
+}
+
+func (a *MoveOpportunityStageAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewMoveOpportunityStageAction() sdk.Action {
+	return &MoveOpportunityStageAction{}
+}
@@ -0,0 +1,97 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type removeTagsActionProps struct {
+	ContactID string `json:"contact_id"`
+	Tags      string `json:"tags"`
+}
+
+type RemoveTagsAction struct{}
+
+func (a *RemoveTagsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "remove_tags",
+		DisplayName:   "Remove Tags",
+		Description:   "Remove tags from a contact in Keap",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: removeTagsDocs,
+		Icon:          "mdi:tag-remove",
+		SampleOutput: map[string]any{
+			"contact_id": 12345,
+			"tag_ids":    []int64{91, 93},
+			"tags":       []string{"Customer", "Webinar Attendee"},
+		},
+	}
+}
+
+func (a *RemoveTagsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("remove_tags", "Remove Tags")
+
+	form.TextField("contact_id", "Contact ID").
+		Placeholder("contact ID").
+		Required(true).
+		HelpText("Unique identifier of the contact to remove the tags from")
+
+	form.TextField("tags", "Tags").
+		Placeholder("Customer, Webinar Attendee").
+		Required(true).
+		HelpText("Comma-separated tag names. Every tag must exist in Keap.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *RemoveTagsAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[removeTagsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	contactID, err := shared.ParseID("contact ID", input.ContactID)
+	if err != nil {
+		return nil, err
+	}
+	tags := crm.SplitTags(input.Tags)
+	if len(tags) == 0 {
+		return nil, errors.New("at least one tag is required")
+	}
+
+	tagIDs, err := shared.FindTagIDs(token, tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := shared.RemoveTags(token, contactID, tagIDs); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"contact_id": contactID,
+		"tag_ids":    tagIDs,
+		"tags":       tags,
+	}, nil
+}
+
+func (a *RemoveTagsAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewRemoveTagsAction() sdk.Action {
+	return &RemoveTagsAction{}
+}
@@ -0,0 +1,145 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// contactFieldMap names the Keap contact fields of the standard contact
+// fields. Email, phone and company are nested objects in Keap and are
+// built from these names.
+var contactFieldMap = crm.FieldMap{
+	crm.FieldEmail:     "email",
+	crm.FieldPhone:     "phone",
+	crm.FieldFirstName: "given_name",
+	crm.FieldLastName:  "family_name",
+	crm.FieldCompany:   "company",
+}
+
+type UpsertContactAction struct{}
+
+func (a *UpsertContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_contact",
+		DisplayName:   "Create or Update Contact",
+		Description:   "Find a Keap contact by email and update it, or create it when there is none.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertContactDocs,
+		SampleOutput: crm.SampleOutput(map[string]any{
+			"id":          51,
+			"given_name":  "Jane",
+			"family_name": "Doe",
+			"email_addresses": []map[string]any{
+				{"email": "jane.doe@example.com", "field": "EMAIL1"},
+			},
+			"company": map[string]any{"id": 7, "company_name": "Acme Inc."},
+		}),
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_contact", "Create or Update Contact")
+
+	crm.RegisterContactProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Perform writes the contact, then adds its tags. The company is linked
+// by name and created when Keap has none, and tags are created the same
+// way. Custom fields are given by Keap field ID.
+func (a *UpsertContactAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	contact, err := crm.ContactFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	fields, ignored := contactFieldMap.Standard(contact)
+	contactData := map[string]interface{}{}
+	for _, name := range []string{"given_name", "family_name"} {
+		if v, ok := fields[name]; ok {
+			contactData[name] = v
+		}
+	}
+	contactData["email_addresses"] = []map[string]interface{}{
+		{"email": contact.Email, "field": "EMAIL1"},
+	}
+	if contact.Phone != "" {
+		contactData["phone_numbers"] = []map[string]interface{}{
+			{"number": contact.Phone, "field": "PHONE1"},
+		}
+	}
+	if contact.Company != "" {
+		companyID, err := shared.CompanyID(token, contact.Company)
+		if err != nil {
+			return nil, err
+		}
+		contactData["company"] = map[string]interface{}{"id": companyID}
+	}
+	if len(contact.Fields) > 0 {
+		values, err := shared.CustomFieldValues(contact.Fields)
+		if err != nil {
+			return nil, err
+		}
+		contactData["custom_fields"] = values
+	}
+
+	// The lookup only tells whether the contact is new; the upsert matches
+	// the contact by email itself.
+	existing, err := shared.FindContactByEmail(token, contact.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	contactData["duplicate_option"] = "Email"
+	record, err := shared.MakeKeapRequest(token, http.MethodPut, "/contacts", contactData)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := shared.ContactID(record)
+	if err != nil {
+		return nil, err
+	}
+	if len(contact.Tags) > 0 {
+		tagIDs, err := shared.TagIDs(token, contact.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if err := shared.ApplyTags(token, id, tagIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return crm.Result{
+		ID:      strconv.FormatInt(id, 10),
+		Created: existing == nil,
+		Contact: record,
+		Ignored: ignored,
+	}.Output(), nil
+}
+
+func (a *UpsertContactAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpsertContactAction() sdk.Action {
+	return &UpsertContactAction{}
+}
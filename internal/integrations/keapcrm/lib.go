@@ -49,6 +49,8 @@ func (n *KeapCrm) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewContactCreatedTrigger(),
 		triggers.NewContactUpdatedTrigger(),
+		triggers.NewTagAppliedTrigger(),
+		triggers.NewOpportunityStageChangedTrigger(),
 	}
 }
 
@@ -58,6 +60,15 @@ func (n *KeapCrm) Actions() []sdk.Action {
 		actions.NewUpdateContactAction(),
 		actions.NewGetContactAction(),
 		actions.NewListContactsAction(),
+		actions.NewUpsertContactAction(),
+		actions.NewApplyTagsAction(),
+		actions.NewRemoveTagsAction(),
+		actions.NewCreateOpportunityAction(),
+		actions.NewMoveOpportunityStageAction(),
+		actions.NewCreateOrderAction(),
+		actions.NewCreateNoteAction(),
+		actions.NewCreateTaskAction(),
+		actions.NewAddToSequenceAction(),
 	}
 }
 
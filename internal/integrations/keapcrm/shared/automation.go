@@ -0,0 +1,216 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// FindTagIDs returns the IDs of the tags with the names. Unlike TagIDs,
+// it doesn't create tags, and fails on names Keap doesn't have.
+func FindTagIDs(accessToken string, names []string) ([]int64, error) {
+	ids := make([]int64, 0, len(names))
+	for _, name := range names {
+		result, err := MakeKeapRequest(accessToken, http.MethodGet, "/tags?limit=50&name="+url.QueryEscape(name), nil)
+		if err != nil {
+			return nil, err
+		}
+		var id int64
+		tags, _ := result["tags"].([]interface{})
+		for _, t := range tags {
+			tag, _ := t.(map[string]interface{})
+			if strings.EqualFold(fmt.Sprint(tag["name"]), name) {
+				if id, err = numericID(tag["id"]); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+		if id == 0 {
+			return nil, fmt.Errorf("keap has no tag named %q", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// RemoveTags removes tags from a contact.
+func RemoveTags(accessToken string, contactID int64, tagIDs []int64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		ids = append(ids, fmt.Sprint(id))
+	}
+	_, err := MakeKeapRequest(accessToken, http.MethodDelete,
+		fmt.Sprintf("/contacts/%d/tags?ids=%s", contactID, url.QueryEscape(strings.Join(ids, ","))), nil)
+	return err
+}
+
+// ParseID reads a numeric Keap ID given as text.
+func ParseID(name, value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("%s is required", name)
+	}
+	id, err := numericID(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a numeric Keap ID, got %q", name, value)
+	}
+	return id, nil
+}
+
+// AddToSequence adds a contact to a campaign sequence, which starts the
+// sequence's steps for the contact.
+func AddToSequence(accessToken string, campaignID, sequenceID, contactID int64) error {
+	_, err := MakeKeapRequest(accessToken, http.MethodPost,
+		fmt.Sprintf("/campaigns/%d/sequences/%d/contacts/%d", campaignID, sequenceID, contactID), nil)
+	return err
+}
+
+// RegisterTagsProps adds a select of the account's tags.
+func RegisterTagsProps(form *smartform.FormBuilder, name, label string) *smartform.FieldBuilder {
+	getTags := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := MakeKeapRequest(authCtx.AccessToken, http.MethodGet, "/tags?limit=1000", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items := options(result["tags"], "name")
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField(name, label).
+		Placeholder("Select a tag").
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getTags)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("Select a tag")
+}
+
+// RegisterCampaignsProps adds a select of the account's campaigns.
+func RegisterCampaignsProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getCampaigns := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := MakeKeapRequest(authCtx.AccessToken, http.MethodGet, "/campaigns?limit=1000", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items := options(result["campaigns"], "name")
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("campaign_id", "Campaign").
+		Placeholder("Select a campaign").
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getCampaigns)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("Select a campaign")
+}
+
+// RegisterSequencesProps adds a select of the sequences of the campaign
+// chosen in the campaign_id field.
+func RegisterSequencesProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getSequences := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		input := sdk.DynamicInputToType[struct {
+			CampaignID string `json:"campaign_id,omitempty"`
+		}](ctx)
+		if input.CampaignID == "" {
+			return ctx.Respond([]map[string]any{}, 0)
+		}
+
+		result, err := MakeKeapRequest(authCtx.AccessToken, http.MethodGet,
+			"/campaigns/"+url.PathEscape(input.CampaignID)+"?optional_properties=sequences", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items := options(result["sequences"], "name")
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("sequence_id", "Sequence").
+		Placeholder("Select a sequence").
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getSequences)).
+				WithFieldReference("campaign_id", "campaign_id").
+				WithSearchSupport().
+				End().
+				RefreshOn("campaign_id").
+				GetDynamicSource(),
+		).
+		HelpText("Select a sequence of the campaign")
+}
+
+// options converts a list of Keap records to select options, naming each
+// by the nameKey field.
+func options(v interface{}, nameKey string) []map[string]any {
+	records, _ := v.([]interface{})
+	items := make([]map[string]any, 0, len(records))
+	for _, r := range records {
+		record, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id := FormatID(record["id"])
+		if id == "" {
+			continue
+		}
+		items = append(items, map[string]any{
+			"id":   id,
+			"name": fmt.Sprint(record[nameKey]),
+		})
+	}
+	return items
+}
@@ -0,0 +1,134 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// FindContactByEmail returns the first contact with the email address,
+// or nil when there is none.
+func FindContactByEmail(accessToken, email string) (map[string]interface{}, error) {
+	result, err := MakeKeapRequest(accessToken, http.MethodGet, "/contacts?limit=1&email="+url.QueryEscape(email), nil)
+	if err != nil {
+		return nil, err
+	}
+	contacts, _ := result["contacts"].([]interface{})
+	if len(contacts) == 0 {
+		return nil, nil
+	}
+	contact, _ := contacts[0].(map[string]interface{})
+	return contact, nil
+}
+
+// CompanyID returns the ID of the company with the name, creating the
+// company when Keap has none.
+func CompanyID(accessToken, name string) (int64, error) {
+	result, err := MakeKeapRequest(accessToken, http.MethodGet, "/companies?limit=50&company_name="+url.QueryEscape(name), nil)
+	if err != nil {
+		return 0, err
+	}
+	companies, _ := result["companies"].([]interface{})
+	for _, c := range companies {
+		company, _ := c.(map[string]interface{})
+		if strings.EqualFold(fmt.Sprint(company["company_name"]), name) {
+			return numericID(company["id"])
+		}
+	}
+
+	company, err := MakeKeapRequest(accessToken, http.MethodPost, "/companies", map[string]interface{}{"company_name": name})
+	if err != nil {
+		return 0, fmt.Errorf("creating company %q: %w", name, err)
+	}
+	return numericID(company["id"])
+}
+
+// TagIDs returns the IDs of the tags with the names, creating the tags
+// Keap doesn't have yet.
+func TagIDs(accessToken string, names []string) ([]int64, error) {
+	ids := make([]int64, 0, len(names))
+	for _, name := range names {
+		result, err := MakeKeapRequest(accessToken, http.MethodGet, "/tags?limit=50&name="+url.QueryEscape(name), nil)
+		if err != nil {
+			return nil, err
+		}
+		var id int64
+		tags, _ := result["tags"].([]interface{})
+		for _, t := range tags {
+			tag, _ := t.(map[string]interface{})
+			if strings.EqualFold(fmt.Sprint(tag["name"]), name) {
+				if id, err = numericID(tag["id"]); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+		if id == 0 {
+			tag, err := MakeKeapRequest(accessToken, http.MethodPost, "/tags", map[string]interface{}{"name": name})
+			if err != nil {
+				return nil, fmt.Errorf("creating tag %q: %w", name, err)
+			}
+			if id, err = numericID(tag["id"]); err != nil {
+				return nil, err
+			}
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// ApplyTags adds tags to a contact.
+func ApplyTags(accessToken string, contactID int64, tagIDs []int64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	_, err := MakeKeapRequest(accessToken, http.MethodPost, fmt.Sprintf("/contacts/%d/tags", contactID),
+		map[string]interface{}{"tagIds": tagIDs})
+	return err
+}
+
+// CustomFieldValues converts custom fields keyed by Keap field ID to the
+// list Keap expects.
+func CustomFieldValues(fields map[string]interface{}) ([]map[string]interface{}, error) {
+	values := make([]map[string]interface{}, 0, len(fields))
+	for key, value := range fields {
+		id, err := strconv.ParseInt(strings.TrimSpace(key), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("custom fields are set by numeric Keap field ID, got %q", key)
+		}
+		values = append(values, map[string]interface{}{"id": id, "content": value})
+	}
+	return values, nil
+}
+
+func numericID(v interface{}) (int64, error) {
+	switch id := v.(type) {
+	case float64:
+		return int64(id), nil
+	case string:
+		return strconv.ParseInt(id, 10, 64)
+	default:
+		return 0, fmt.Errorf("unexpected ID %v in Keap response", v)
+	}
+}
+
+// ContactID returns the ID of a contact as Keap returned it.
+func ContactID(contact map[string]interface{}) (int64, error) {
+	return numericID(contact["id"])
+}
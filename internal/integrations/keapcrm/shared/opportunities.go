@@ -0,0 +1,131 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// pageSize is the largest page Keap returns.
+const pageSize = 1000
+
+// ListOpportunities returns every opportunity, or those in a stage.
+func ListOpportunities(accessToken, stageID string) ([]map[string]interface{}, error) {
+	var opportunities []map[string]interface{}
+	for offset := 0; ; offset += pageSize {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(pageSize))
+		params.Set("offset", strconv.Itoa(offset))
+		if stageID != "" {
+			params.Set("stage_id", stageID)
+		}
+		result, err := MakeKeapRequest(accessToken, http.MethodGet, "/opportunities?"+params.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		page, _ := result["opportunities"].([]interface{})
+		for _, o := range page {
+			if opportunity, ok := o.(map[string]interface{}); ok {
+				opportunities = append(opportunities, opportunity)
+			}
+		}
+		if len(page) < pageSize {
+			return opportunities, nil
+		}
+	}
+}
+
+// StageID returns the ID of an opportunity's stage, or "" when it has
+// none.
+func StageID(opportunity map[string]interface{}) string {
+	stage, _ := opportunity["stage"].(map[string]interface{})
+	if stage == nil {
+		return ""
+	}
+	return FormatID(stage["id"])
+}
+
+// FormatID formats an ID as Keap returned it, or returns "" when it
+// isn't one. Decoded IDs are floats, which fmt prints with exponents.
+func FormatID(v interface{}) string {
+	id, err := numericID(v)
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// TaggedContact is a contact with a tag, and when the tag was applied.
+type TaggedContact struct {
+	Contact     map[string]interface{} `json:"contact"`
+	DateApplied string                 `json:"date_applied"`
+}
+
+// TaggedContacts returns the contacts that have a tag.
+func TaggedContacts(accessToken string, tagID int64) ([]TaggedContact, error) {
+	var tagged []TaggedContact
+	for offset := 0; ; offset += pageSize {
+		var result struct {
+			Contacts []TaggedContact `json:"contacts"`
+		}
+		endpoint := fmt.Sprintf("/tags/%d/contacts?limit=%d&offset=%d", tagID, pageSize, offset)
+		if err := KeapRequest(accessToken, http.MethodGet, endpoint, nil, &result); err != nil {
+			return nil, err
+		}
+		tagged = append(tagged, result.Contacts...)
+		if len(result.Contacts) < pageSize {
+			return tagged, nil
+		}
+	}
+}
+
+// RegisterStagesProps adds a select of the opportunity stages.
+func RegisterStagesProps(form *smartform.FormBuilder, name, label string) *smartform.FieldBuilder {
+	getStages := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		var stages []interface{}
+		if err := KeapRequest(authCtx.AccessToken, http.MethodGet, "/opportunity/stage_pipeline", nil, &stages); err != nil {
+			return nil, err
+		}
+
+		items := options(stages, "name")
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField(name, label).
+		Placeholder("Select a stage").
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getStages)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("Select an opportunity stage")
+}
@@ -48,6 +48,16 @@ const (
 
 // MakeKeapRequest makes a request to the Keap API
 func MakeKeapRequest(accessToken, method, endpoint string, payload interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := KeapRequest(accessToken, method, endpoint, payload, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// KeapRequest makes a request to the Keap API and decodes the response
+// into out, for responses that aren't objects.
+func KeapRequest(accessToken, method, endpoint string, payload, out interface{}) error {
 	url := baseURL + endpoint
 	client := &http.Client{}
 
@@ -55,14 +65,14 @@ func MakeKeapRequest(accessToken, method, endpoint string, payload interface{})
 	if payload != nil {
 		jsonData, err := json.Marshal(payload)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		reqBody = bytes.NewBuffer(jsonData)
 	}
 
 	req, err := http.NewRequest(method, url, reqBody)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	req.Header.Set("Authorization", "Bearer "+accessToken)
@@ -71,27 +81,26 @@ func MakeKeapRequest(accessToken, method, endpoint string, payload interface{})
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("HTTP error %d: %s", resp.StatusCode, string(body))
+		return fmt.Errorf("HTTP error %d: %s", resp.StatusCode, string(body))
 	}
 
-	var result map[string]interface{}
-	if len(body) > 0 {
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, err
+	if len(body) > 0 && out != nil {
+		if err := json.Unmarshal(body, out); err != nil {
+			return err
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
 type ContactData struct {
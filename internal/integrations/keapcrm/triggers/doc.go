@@ -7,3 +7,9 @@ var contactCreatedDocs string
 
 //go:embed contact_updated.md
 var contactUpdatedDocs string
+
+//go:embed tag_applied.md
+var tagAppliedDocs string
+
+//go:embed opportunity_stage_changed.md
+var opportunityStageChangedDocs string
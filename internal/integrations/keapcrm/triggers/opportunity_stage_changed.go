@@ -0,0 +1,156 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// stagesKey stores the stage of each opportunity at the last poll.
+const stagesKey = "opportunityStages"
+
+type opportunityStageChangedTriggerProps struct {
+	StageID string `json:"stage_id"`
+}
+
+type OpportunityStageChangedTrigger struct{}
+
+func (t *OpportunityStageChangedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "opportunity_stage_changed",
+		DisplayName:   "Opportunity Stage Changed",
+		Description:   "Triggers when an opportunity moves to another stage of the Keap pipeline",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: opportunityStageChangedDocs,
+		Icon:          "mdi:swap-horizontal",
+		SampleOutput: []map[string]any{
+			{
+				"opportunity_id":    301,
+				"previous_stage_id": "4",
+				"stage_id":          "5",
+				"opportunity": map[string]any{
+					"id":                301,
+					"opportunity_title": "Annual plan",
+					"contact":           map[string]any{"id": 12345, "first_name": "John", "last_name": "Doe", "email": "john.doe@example.com"},
+					"stage":             map[string]any{"id": 5, "name": "Won"},
+				},
+			},
+		},
+	}
+}
+
+func (t *OpportunityStageChangedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *OpportunityStageChangedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("opportunity_stage_changed", "Opportunity Stage Changed")
+
+	shared.RegisterStagesProps(form, "stage_id", "Stage").
+		Required(false).
+		HelpText("Only trigger when opportunities move into this stage. Leave empty for every stage.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (t *OpportunityStageChangedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+func (t *OpportunityStageChangedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute compares the stage of every opportunity with the last poll,
+// as Keap can't list opportunities by when they changed. The first poll
+// only records the stages.
+func (t *OpportunityStageChangedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[opportunityStageChangedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	opportunities, err := shared.ListOpportunities(token, "")
+	if err != nil {
+		return nil, fmt.Errorf("error fetching opportunities: %v", err)
+	}
+
+	prev, first := loadStages(ctx)
+	next := make(map[string]string, len(opportunities))
+	events := make([]map[string]interface{}, 0)
+	for _, opportunity := range opportunities {
+		id := shared.FormatID(opportunity["id"])
+		if id == "" {
+			continue
+		}
+		stage := shared.StageID(opportunity)
+		next[id] = stage
+
+		old, known := prev[id]
+		if first || !known || old == stage {
+			continue
+		}
+		if input.StageID != "" && stage != input.StageID {
+			continue
+		}
+		events = append(events, map[string]interface{}{
+			"opportunity_id":    opportunity["id"],
+			"previous_stage_id": old,
+			"stage_id":          stage,
+			"opportunity":       opportunity,
+		})
+	}
+
+	if err := ctx.SetMetadata(stagesKey, next); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (t *OpportunityStageChangedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *OpportunityStageChangedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// loadStages returns the opportunity stages of the last poll, and
+// whether there was none.
+func loadStages(ctx sdkcontext.ExecuteContext) (map[string]string, bool) {
+	stored, err := ctx.GetMetadata(stagesKey)
+	if err != nil || stored == nil {
+		return map[string]string{}, true
+	}
+
+	var stages map[string]string
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &stages)
+	}
+	if err != nil || stages == nil {
+		ctx.Logger().Warn("ignoring unreadable opportunity stages", "error", err)
+		return map[string]string{}, true
+	}
+	return stages, false
+}
+
+func NewOpportunityStageChangedTrigger() sdk.Trigger {
+	return &OpportunityStageChangedTrigger{}
+}
@@ -0,0 +1,156 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+
+	"github.com/wakflo/extensions/internal/integrations/keapcrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type tagAppliedTriggerProps struct {
+	TagID string `json:"tag_id"`
+}
+
+// taggedKey is the metadata key of the contacts that had the tag at the
+// last poll.
+const taggedKey = "taggedContacts"
+
+// taggedContacts is a snapshot of the IDs of the contacts with a tag.
+type taggedContacts struct {
+	TagID    int64           `json:"tag_id"`
+	Contacts map[string]bool `json:"contacts"`
+}
+
+type TagAppliedTrigger struct{}
+
+func (t *TagAppliedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "tag_applied",
+		DisplayName:   "Tag Applied",
+		Description:   "Triggers when a tag is applied to a contact in Keap",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: tagAppliedDocs,
+		Icon:          "mdi:tag-plus",
+		SampleOutput: []map[string]any{
+			{
+				"tag_id":       91,
+				"date_applied": "2025-03-12T18:20:00.000Z",
+				"contact": map[string]any{
+					"id":         12345,
+					"email":      "john.doe@example.com",
+					"first_name": "John",
+					"last_name":  "Doe",
+				},
+			},
+		},
+	}
+}
+
+func (t *TagAppliedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *TagAppliedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("tag_applied", "Tag Applied")
+
+	shared.RegisterTagsProps(form, "tag_id", "Tag").
+		HelpText("The tag to watch")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (t *TagAppliedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+func (t *TagAppliedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+func (t *TagAppliedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[tagAppliedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tagID, err := shared.ParseID("tag", input.TagID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	tagged, err := shared.TaggedContacts(token, tagID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching tagged contacts: %v", err)
+	}
+
+	prev, first := loadTagged(ctx)
+	first = first || prev.TagID != tagID
+
+	next := taggedContacts{TagID: tagID, Contacts: make(map[string]bool, len(tagged))}
+	events := make([]map[string]interface{}, 0)
+	for _, c := range tagged {
+		id := fmt.Sprint(c.Contact["id"])
+		next.Contacts[id] = true
+		if first || prev.Contacts[id] {
+			continue
+		}
+		events = append(events, map[string]interface{}{
+			"tag_id":       tagID,
+			"date_applied": c.DateApplied,
+			"contact":      c.Contact,
+		})
+	}
+
+	if err := ctx.SetMetadata(taggedKey, next); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (t *TagAppliedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *TagAppliedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// loadTagged returns the tagged contacts of the last poll, and whether
+// there was none.
+func loadTagged(ctx sdkcontext.ExecuteContext) (taggedContacts, bool) {
+	stored, err := ctx.GetMetadata(taggedKey)
+	if err != nil || stored == nil {
+		return taggedContacts{}, true
+	}
+
+	var tagged taggedContacts
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &tagged)
+	}
+	if err != nil || tagged.Contacts == nil {
+		ctx.Logger().Warn("ignoring unreadable tagged contacts", "error", err)
+		return taggedContacts{}, true
+	}
+	return tagged, false
+}
+
+func NewTagAppliedTrigger() sdk.Trigger {
+	return &TagAppliedTrigger{}
+}
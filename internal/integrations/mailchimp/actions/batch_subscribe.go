@@ -0,0 +1,183 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type batchSubscribeActionProps struct {
+	ListID      string `json:"list_id"`
+	StatusIfNew string `json:"status_if_new"`
+}
+
+type BatchSubscribeAction struct{}
+
+func (a *BatchSubscribeAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "batch_subscribe",
+		DisplayName:   "Batch Subscribe",
+		Description:   "Add or update many audience members from a list or a CSV file in a background batch.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: batchSubscribeDocs,
+		SampleOutput: map[string]any{
+			"total":     2,
+			"succeeded": 1,
+			"failed":    1,
+			"results": []map[string]any{
+				{"row": 1, "key": "jane.doe@example.com", "id": "a1b2c3d4e5", "status": "queued"},
+				{"row": 2, "status": "failed", "error": "an email address is required to find or create the contact"},
+			},
+			"batch_ids": []string{"a1b2c3d4e5"},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *BatchSubscribeAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("batch_subscribe", "Batch Subscribe")
+
+	shared.RegisterListProps(form, true)
+
+	form.SelectField("status_if_new", "Status If New").
+		Required(false).
+		DefaultValue("subscribed").
+		HelpText("The status of new members. Existing members keep their status.").
+		AddOptions(shared.MailchimpSubscriberStatus...)
+
+	crm.RegisterBulkProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Perform queues a member upsert per record, and a tag update for
+// records with tags, in batches of at most shared.MaxBatchOperations
+// operations. Operations are named after the record's row, so
+// get_batch_status reports results by row.
+func (a *BatchSubscribeAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[batchSubscribeActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ListID == "" {
+		return nil, errors.New("audience is required")
+	}
+	if input.StatusIfNew == "" {
+		input.StatusIfNew = "subscribed"
+	}
+
+	records, err := crm.LoadRecords(ctx.Context(), ctx.Files(), ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]crm.RowResult, len(records))
+	var queued []int
+	var operations [][]shared.BatchOperation
+	for i, record := range records {
+		results[i] = crm.RowResult{Row: i + 1}
+		contact, err := crm.ContactFromRecord(record)
+		var ops []shared.BatchOperation
+		if err == nil {
+			results[i].Key = contact.Email
+			ops, err = memberOperations(input.ListID, input.StatusIfNew, i+1, contact)
+		}
+		if err != nil {
+			results[i].Status, results[i].Error = crm.RowFailed, err.Error()
+			continue
+		}
+		operations = append(operations, ops)
+		queued = append(queued, i)
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batchIDs := []string{}
+	rows := crm.Chunk(queued, shared.MaxBatchOperations/2)
+	for n, chunk := range crm.Chunk(operations, shared.MaxBatchOperations/2) {
+		var ops []shared.BatchOperation
+		for _, rowOps := range chunk {
+			ops = append(ops, rowOps...)
+		}
+		batch, err := client.StartBatch(ops)
+		for _, i := range rows[n] {
+			if err != nil {
+				results[i].Status, results[i].Error = crm.RowFailed, err.Error()
+			} else {
+				results[i].Status, results[i].ID = crm.RowQueued, batch.ID
+			}
+		}
+		if err == nil {
+			batchIDs = append(batchIDs, batch.ID)
+		}
+	}
+
+	out := crm.BulkOutput(results)
+	out["batch_ids"] = batchIDs
+	return out, nil
+}
+
+// memberOperations returns the operations writing a contact: the member
+// upsert, and a tag update when it has tags.
+func memberOperations(listID, statusIfNew string, row int, contact crm.Contact) ([]shared.BatchOperation, error) {
+	mergeFields, _ := contactFieldMap.Map(contact)
+	delete(mergeFields, "EMAIL")
+	body := map[string]interface{}{
+		"email_address": contact.Email,
+		"status_if_new": statusIfNew,
+	}
+	if len(mergeFields) > 0 {
+		body["merge_fields"] = mergeFields
+	}
+
+	memberPath := fmt.Sprintf("/lists/%s/members/%s", listID, shared.SubscriberHash(contact.Email))
+	op, err := shared.NewOperation(rowOperationID(row, ""), http.MethodPut, memberPath, body)
+	if err != nil {
+		return nil, err
+	}
+	ops := []shared.BatchOperation{op}
+
+	if len(contact.Tags) > 0 {
+		tags := make([]map[string]string, 0, len(contact.Tags))
+		for _, tag := range contact.Tags {
+			tags = append(tags, map[string]string{"name": tag, "status": "active"})
+		}
+		op, err := shared.NewOperation(rowOperationID(row, tagsOperation), http.MethodPost, memberPath+"/tags", map[string]interface{}{"tags": tags})
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// tagsOperation marks the operation tagging the member of a row.
+const tagsOperation = "tags"
+
+// rowOperationID names an operation after the row it writes.
+func rowOperationID(row int, kind string) string {
+	if kind == "" {
+		return fmt.Sprintf("row-%d", row)
+	}
+	return fmt.Sprintf("row-%d-%s", row, kind)
+}
+
+func (a *BatchSubscribeAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewBatchSubscribeAction() sdk.Action {
+	return &BatchSubscribeAction{}
+}
@@ -0,0 +1,39 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// newClient returns a Marketing API client for the connection.
+func newClient(ctx sdkcontext.PerformContext) (*shared.Client, error) {
+	return shared.NewClient(ctx.Auth())
+}
+
+// splitEmails splits email addresses separated by commas, semicolons or
+// new lines.
+func splitEmails(s string) []string {
+	var emails []string
+	for _, e := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' || r == '\n' }) {
+		if e = strings.TrimSpace(e); e != "" {
+			emails = append(emails, strings.ToLower(e))
+		}
+	}
+	return emails
+}
+
+// parseTime reads a date and time as RFC 3339, or without a time zone
+// as UTC.
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date and time such as 2024-03-14T09:00:00Z", s)
+}
@@ -0,0 +1,157 @@
+package actions
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createCampaignActionProps struct {
+	Type        string `json:"type"`
+	ListID      string `json:"list_id"`
+	SegmentID   string `json:"segment_id"`
+	Title       string `json:"title"`
+	SubjectLine string `json:"subject_line"`
+	PreviewText string `json:"preview_text"`
+	FromName    string `json:"from_name"`
+	ReplyTo     string `json:"reply_to"`
+	TemplateID  string `json:"template_id"`
+}
+
+type CreateCampaignAction struct{}
+
+func (a *CreateCampaignAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_campaign",
+		DisplayName:   "Create Campaign",
+		Description:   "Create a draft email campaign for an audience or one of its segments.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createCampaignDocs,
+		SampleOutput:  campaignSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreateCampaignAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_campaign", "Create Campaign")
+
+	form.SelectField("type", "Type").
+		Required(true).
+		DefaultValue("regular").
+		AddOptions(shared.CampaignTypeOptions...)
+
+	shared.RegisterListProps(form, true)
+
+	shared.RegisterSegmentProps(form, false, false).
+		HelpText("Send only to this saved or static segment of the audience.")
+
+	form.TextField("title", "Campaign Name").
+		Required(false).
+		HelpText("The internal name of the campaign.")
+
+	form.TextField("subject_line", "Subject").
+		Required(true)
+
+	form.TextField("preview_text", "Preview Text").
+		Required(false).
+		HelpText("The text shown after the subject in most inboxes.")
+
+	form.TextField("from_name", "From Name").
+		Required(true)
+
+	form.TextField("reply_to", "Reply-To Email").
+		Required(true).
+		HelpText("The reply-to address, which must be on a verified domain.")
+
+	shared.RegisterTemplateProps(form, false).
+		HelpText("Start the campaign from one of your templates. Its content can be set later with Set Campaign Content.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateCampaignAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createCampaignActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ListID == "" {
+		return nil, errors.New("audience is required")
+	}
+	if input.Type == "" {
+		input.Type = "regular"
+	}
+
+	recipients := map[string]interface{}{"list_id": input.ListID}
+	if input.SegmentID != "" {
+		segmentID, err := strconv.Atoi(input.SegmentID)
+		if err != nil {
+			return nil, errors.New("segment ID must be a number")
+		}
+		recipients["segment_opts"] = map[string]interface{}{"saved_segment_id": segmentID}
+	}
+
+	settings := map[string]interface{}{
+		"subject_line": input.SubjectLine,
+		"from_name":    input.FromName,
+		"reply_to":     input.ReplyTo,
+	}
+	if input.Title != "" {
+		settings["title"] = input.Title
+	}
+	if input.PreviewText != "" {
+		settings["preview_text"] = input.PreviewText
+	}
+	if input.TemplateID != "" {
+		templateID, err := strconv.Atoi(input.TemplateID)
+		if err != nil {
+			return nil, errors.New("template ID must be a number")
+		}
+		settings["template_id"] = templateID
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateCampaign(map[string]interface{}{
+		"type":       input.Type,
+		"recipients": recipients,
+		"settings":   settings,
+	})
+}
+
+func (a *CreateCampaignAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+var campaignSample = map[string]any{
+	"id":          "42694e9e57",
+	"web_id":      1234567,
+	"type":        "regular",
+	"status":      "save",
+	"archive_url": "http://eepurl.com/abc123",
+	"recipients": map[string]any{
+		"list_id":         "57afe96172",
+		"list_name":       "Newsletter",
+		"recipient_count": 1520,
+	},
+	"settings": map[string]any{
+		"subject_line": "Our spring collection is here",
+		"preview_text": "20% off this week only",
+		"title":        "Spring launch",
+		"from_name":    "Acme Store",
+		"reply_to":     "hello@acme.example",
+	},
+	"create_time": "2024-03-14T09:00:00+00:00",
+}
+
+func NewCreateCampaignAction() sdk.Action {
+	return &CreateCampaignAction{}
+}
@@ -0,0 +1,107 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createSegmentActionProps struct {
+	ListID     string `json:"list_id"`
+	Name       string `json:"name"`
+	Emails     string `json:"emails"`
+	Match      string `json:"match"`
+	Conditions string `json:"conditions"`
+}
+
+type CreateSegmentAction struct{}
+
+func (a *CreateSegmentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_segment",
+		DisplayName:   "Create Segment",
+		Description:   "Create a static segment of given members, or a saved segment of the members matching conditions.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createSegmentDocs,
+		SampleOutput:  segmentSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreateSegmentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_segment", "Create Segment")
+
+	shared.RegisterListProps(form, true)
+
+	form.TextField("name", "Name").
+		Required(true)
+
+	form.TextareaField("emails", "Members").
+		Required(false).
+		HelpText("Email addresses of audience members for a static segment, separated by commas or new lines. Leave empty for an empty static segment.")
+
+	form.SelectField("match", "Match").
+		Required(false).
+		DefaultValue("all").
+		AddOption("all", "All conditions").
+		AddOption("any", "Any condition")
+
+	form.TextareaField("conditions", "Conditions").
+		Required(false).
+		HelpText(`Conditions of a saved segment as a JSON array, such as [{"condition_type": "TextMerge", "field": "FNAME", "op": "is", "value": "Jane"}]. A saved segment updates itself as members match.`)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateSegmentAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createSegmentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ListID == "" {
+		return nil, errors.New("audience is required")
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+
+	var conditions []interface{}
+	if strings.TrimSpace(input.Conditions) != "" {
+		if err := json.Unmarshal([]byte(input.Conditions), &conditions); err != nil {
+			return nil, fmt.Errorf("conditions must be a JSON array: %w", err)
+		}
+		if input.Emails != "" {
+			return nil, errors.New("give either members for a static segment or conditions for a saved segment")
+		}
+	}
+	if input.Match == "" {
+		input.Match = "all"
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	segment, err := client.CreateSegment(input.ListID, strings.TrimSpace(input.Name), splitEmails(input.Emails), input.Match, conditions)
+	if err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
+func (a *CreateSegmentAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreateSegmentAction() sdk.Action {
+	return &CreateSegmentAction{}
+}
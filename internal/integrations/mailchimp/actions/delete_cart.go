@@ -0,0 +1,82 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type deleteCartActionProps struct {
+	StoreID string `json:"store_id"`
+	CartID  string `json:"cart_id"`
+}
+
+type DeleteCartAction struct{}
+
+func (a *DeleteCartAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_cart",
+		DisplayName:   "Delete Cart",
+		Description:   "Delete a cart from a Mailchimp store once it is checked out or emptied, so no abandoned cart email is sent for it.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: deleteCartDocs,
+		SampleOutput: map[string]any{
+			"store_id": "acme-shop",
+			"cart_id":  "cart-1001",
+			"deleted":  true,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *DeleteCartAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_cart", "Delete Cart")
+
+	shared.RegisterStoreProps(form)
+
+	form.TextField("cart_id", "Cart ID").
+		Required(true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *DeleteCartAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[deleteCartActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	cartID := strings.TrimSpace(input.CartID)
+	if input.StoreID == "" || cartID == "" {
+		return nil, errors.New("store and cart ID are required")
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = client.Request(http.MethodDelete, shared.StorePath(input.StoreID, "carts", cartID), nil, nil)
+	if err != nil && !shared.IsNotFound(err) {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"store_id": input.StoreID,
+		"cart_id":  cartID,
+		"deleted":  err == nil,
+	}, nil
+}
+
+func (a *DeleteCartAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewDeleteCartAction() sdk.Action {
+	return &DeleteCartAction{}
+}
@@ -0,0 +1,77 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type deleteSegmentActionProps struct {
+	ListID    string `json:"list_id"`
+	SegmentID string `json:"segment_id"`
+}
+
+type DeleteSegmentAction struct{}
+
+func (a *DeleteSegmentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_segment",
+		DisplayName:   "Delete Segment",
+		Description:   "Delete a segment of an audience. Its members stay on the audience.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: deleteSegmentDocs,
+		SampleOutput: map[string]any{
+			"list_id":    "57afe96172",
+			"segment_id": "49381",
+			"deleted":    true,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *DeleteSegmentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_segment", "Delete Segment")
+
+	shared.RegisterListProps(form, true)
+
+	shared.RegisterSegmentProps(form, false, true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *DeleteSegmentAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[deleteSegmentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ListID == "" || input.SegmentID == "" {
+		return nil, errors.New("audience and segment are required")
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.DeleteSegment(input.ListID, input.SegmentID); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"list_id":    input.ListID,
+		"segment_id": input.SegmentID,
+		"deleted":    true,
+	}, nil
+}
+
+func (a *DeleteSegmentAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewDeleteSegmentAction() sdk.Action {
+	return &DeleteSegmentAction{}
+}
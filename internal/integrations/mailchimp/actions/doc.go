@@ -19,3 +19,60 @@ var removeSubscriberFromTagDocs string
 
 //go:embed update_subscriber_status.md
 var updateSubscriberStatusDocs string
+
+//go:embed upsert_contact.md
+var upsertContactDocs string
+
+//go:embed create_campaign.md
+var createCampaignDocs string
+
+//go:embed set_campaign_content.md
+var setCampaignContentDocs string
+
+//go:embed send_test_email.md
+var sendTestEmailDocs string
+
+//go:embed schedule_campaign.md
+var scheduleCampaignDocs string
+
+//go:embed send_campaign.md
+var sendCampaignDocs string
+
+//go:embed list_templates.md
+var listTemplatesDocs string
+
+//go:embed list_segments.md
+var listSegmentsDocs string
+
+//go:embed create_segment.md
+var createSegmentDocs string
+
+//go:embed update_segment_members.md
+var updateSegmentMembersDocs string
+
+//go:embed delete_segment.md
+var deleteSegmentDocs string
+
+//go:embed batch_subscribe.md
+var batchSubscribeDocs string
+
+//go:embed get_batch_status.md
+var getBatchStatusDocs string
+
+//go:embed upsert_store.md
+var upsertStoreDocs string
+
+//go:embed upsert_customer.md
+var upsertCustomerDocs string
+
+//go:embed upsert_product.md
+var upsertProductDocs string
+
+//go:embed upsert_cart.md
+var upsertCartDocs string
+
+//go:embed upsert_order.md
+var upsertOrderDocs string
+
+//go:embed delete_cart.md
+var deleteCartDocs string
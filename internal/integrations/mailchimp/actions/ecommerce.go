@@ -0,0 +1,159 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+)
+
+// customerFields are the fields added by registerCustomerProps.
+type customerFields struct {
+	CustomerID  string `json:"customer_id"`
+	Email       string `json:"email"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	OptInStatus bool   `json:"opt_in_status"`
+}
+
+// registerCustomerProps adds the fields of the customer of a cart or an
+// order.
+func registerCustomerProps(form *smartform.FormBuilder) {
+	form.TextField("customer_id", "Customer ID").
+		Required(false).
+		HelpText("The customer's ID in your store. Defaults to the email address, for guest checkouts.")
+
+	form.TextField("email", "Customer Email").
+		Required(true)
+
+	form.TextField("first_name", "First Name").
+		Required(false)
+
+	form.TextField("last_name", "Last Name").
+		Required(false)
+
+	form.CheckboxField("opt_in_status", "Subscribe Customer").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Subscribe a new customer to the store's audience. Existing members keep their status.")
+}
+
+// customer returns the customer of a cart or an order as the
+// e-commerce API takes it.
+func (f customerFields) customer() (map[string]interface{}, error) {
+	email := strings.ToLower(strings.TrimSpace(f.Email))
+	if email == "" {
+		return nil, errors.New("customer email is required")
+	}
+	id := strings.TrimSpace(f.CustomerID)
+	if id == "" {
+		id = email
+	}
+	customer := map[string]interface{}{
+		"id":            id,
+		"email_address": email,
+		"opt_in_status": f.OptInStatus,
+	}
+	if f.FirstName != "" {
+		customer["first_name"] = f.FirstName
+	}
+	if f.LastName != "" {
+		customer["last_name"] = f.LastName
+	}
+	return customer, nil
+}
+
+// linesHelp describes the lines field of carts and orders.
+const linesHelp = `The line items as a JSON array, such as [{"product_id": "93", "variant_id": "101", "quantity": 2, "price": 19.99}]. Line items of Shopify and WooCommerce orders can be passed as they are.`
+
+var cartSample = map[string]any{
+	"id":            "cart-1001",
+	"customer":      map[string]any{"id": "jane.doe@example.com", "email_address": "jane.doe@example.com", "opt_in_status": false},
+	"checkout_url":  "https://shop.example.com/checkout/cart-1001",
+	"currency_code": "USD",
+	"order_total":   39.98,
+	"lines": []map[string]any{
+		{"id": "1", "product_id": "93", "product_variant_id": "101", "quantity": 2, "price": 19.99},
+	},
+	"created_at": "2024-03-14T09:00:00+00:00",
+}
+
+// checkoutFields are the fields shared by carts and orders.
+type checkoutFields struct {
+	customerFields
+	StoreID               string      `json:"store_id"`
+	Lines                 interface{} `json:"lines"`
+	CurrencyCode          string      `json:"currency_code"`
+	Total                 *float64    `json:"total"`
+	CampaignID            string      `json:"campaign_id"`
+	CreateMissingProducts bool        `json:"create_missing_products"`
+}
+
+// registerCheckoutProps adds the fields shared by carts and orders.
+func registerCheckoutProps(form *smartform.FormBuilder) {
+	shared.RegisterStoreProps(form)
+
+	registerCustomerProps(form)
+
+	form.TextareaField("lines", "Lines").
+		Required(true).
+		HelpText(linesHelp)
+
+	form.TextField("currency_code", "Currency").
+		Required(true).
+		DefaultValue("USD").
+		HelpText("The three-letter ISO 4217 currency code.")
+
+	form.NumberField("total", "Total").
+		Required(false).
+		HelpText("The total amount. Defaults to the sum of the lines.")
+
+	form.TextField("campaign_id", "Campaign ID").
+		Required(false).
+		HelpText("The campaign the purchase is attributed to, for revenue reports.")
+
+	form.CheckboxField("create_missing_products", "Create Missing Products").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Create the products of lines the store doesn't have yet, named by the line title. Mailchimp rejects lines of unknown products.")
+}
+
+// body builds the fields of a cart or an order shared by both, creating
+// missing products first when asked to. It returns the IDs of the
+// products created.
+func (f checkoutFields) body(client *shared.Client) (map[string]interface{}, []string, error) {
+	if f.StoreID == "" {
+		return nil, nil, errors.New("store is required")
+	}
+	customer, err := f.customer()
+	if err != nil {
+		return nil, nil, err
+	}
+	lines, err := shared.ParseLines(f.Lines)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := []string{}
+	if f.CreateMissingProducts {
+		if created, err = client.EnsureProducts(f.StoreID, lines); err != nil {
+			return nil, created, err
+		}
+	}
+
+	total := shared.LinesTotal(lines)
+	if f.Total != nil {
+		total = *f.Total
+	}
+	body := map[string]interface{}{
+		"customer":      customer,
+		"currency_code": strings.ToUpper(strings.TrimSpace(f.CurrencyCode)),
+		"order_total":   total,
+		"lines":         lines,
+	}
+	if f.CampaignID != "" {
+		body["campaign_id"] = f.CampaignID
+	}
+	return body, created, nil
+}
@@ -0,0 +1,169 @@
+package actions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// batchPollInterval is how often a batch is checked while waiting.
+const batchPollInterval = 5 * time.Second
+
+// maxBatchWait bounds the wait of a step, which must finish within the
+// action timeout.
+const maxBatchWait = 10 * time.Minute
+
+type getBatchStatusActionProps struct {
+	BatchID     string  `json:"batch_id"`
+	WaitSeconds float64 `json:"wait_seconds"`
+}
+
+type GetBatchStatusAction struct{}
+
+func (a *GetBatchStatusAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_batch_status",
+		DisplayName:   "Get Batch Status",
+		Description:   "Check a batch started by Batch Subscribe, optionally waiting until it finishes, and read the outcome of every row.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: getBatchStatusDocs,
+		SampleOutput: map[string]any{
+			"batch_id":            "a1b2c3d4e5",
+			"status":              "finished",
+			"done":                true,
+			"total_operations":    2,
+			"finished_operations": 2,
+			"errored_operations":  1,
+			"total":               2,
+			"succeeded":           1,
+			"failed":              1,
+			"results": []map[string]any{
+				{"row": 1, "status": "succeeded"},
+				{"row": 2, "status": "failed", "error": "Invalid Resource: Please provide a valid email address."},
+			},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *GetBatchStatusAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_batch_status", "Get Batch Status")
+
+	form.TextField("batch_id", "Batch ID").
+		Required(true).
+		HelpText("A batch ID returned by Batch Subscribe.")
+
+	form.NumberField("wait_seconds", "Wait (seconds)").
+		Required(false).
+		DefaultValue(0).
+		HelpText("Check the batch every few seconds until it finishes or this time is up, at most 600. Leave at 0 to check once.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *GetBatchStatusAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getBatchStatusActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	batchID := strings.TrimSpace(input.BatchID)
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var batch *shared.Batch
+	wait := min(time.Duration(input.WaitSeconds)*time.Second, maxBatchWait)
+	job, err := crm.WaitForJob(ctx.Context(), wait, batchPollInterval, func() (crm.Job, error) {
+		b, err := client.GetBatch(batchID)
+		if err != nil {
+			return crm.Job{}, err
+		}
+		batch = b
+		return crm.Job{ID: batchID, State: batchState(b.Status)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]interface{}{}
+	if batch.Finished() && batch.ResponseBodyURL != "" {
+		responses, err := shared.DownloadBatchResponses(batch.ResponseBodyURL)
+		if err != nil {
+			return nil, err
+		}
+		out = crm.BulkOutput(rowResults(responses))
+	}
+
+	out["batch_id"] = batch.ID
+	out["status"] = batch.Status
+	out["done"] = job.Done()
+	out["total_operations"] = batch.TotalOperations
+	out["finished_operations"] = batch.FinishedOperations
+	out["errored_operations"] = batch.ErroredOperations
+	out["submitted_at"] = batch.SubmittedAt
+	out["completed_at"] = batch.CompletedAt
+	return out, nil
+}
+
+// batchState maps the status of a batch to a job state.
+func batchState(status string) string {
+	switch status {
+	case "finished":
+		return crm.JobCompleted
+	case "pending":
+		return crm.JobQueued
+	default:
+		return crm.JobRunning
+	}
+}
+
+// rowResults folds the responses of a batch into a result per row, by
+// the operation IDs Batch Subscribe gives. A row fails with the error of
+// its first failed operation.
+func rowResults(responses []shared.BatchResponse) []crm.RowResult {
+	byRow := map[int]*crm.RowResult{}
+	for _, resp := range responses {
+		var row int
+		if _, err := fmt.Sscanf(resp.OperationID, "row-%d", &row); err != nil {
+			continue
+		}
+		r, ok := byRow[row]
+		if !ok {
+			r = &crm.RowResult{Row: row, Status: crm.RowSucceeded}
+			byRow[row] = r
+		}
+		if msg := resp.Error(); msg != "" && !r.Failed() {
+			if strings.HasSuffix(resp.OperationID, "-"+tagsOperation) {
+				msg = "tags: " + msg
+			}
+			r.Status, r.Error = crm.RowFailed, msg
+		}
+	}
+
+	results := make([]crm.RowResult, 0, len(byRow))
+	for _, r := range byRow {
+		results = append(results, *r)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Row < results[j].Row })
+	return results
+}
+
+func (a *GetBatchStatusAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewGetBatchStatusAction() sdk.Action {
+	return &GetBatchStatusAction{}
+}
@@ -0,0 +1,88 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type listSegmentsActionProps struct {
+	ListID     string `json:"list_id"`
+	StaticOnly bool   `json:"static_only"`
+}
+
+type ListSegmentsAction struct{}
+
+func (a *ListSegmentsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_segments",
+		DisplayName:   "List Segments",
+		Description:   "List the saved and static segments of an audience with their member counts.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: listSegmentsDocs,
+		SampleOutput: map[string]any{
+			"segments": []map[string]any{segmentSample},
+			"count":    1,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *ListSegmentsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_segments", "List Segments")
+
+	shared.RegisterListProps(form, true)
+
+	form.CheckboxField("static_only", "Static Segments Only").
+		Required(false).
+		DefaultValue(false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *ListSegmentsAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listSegmentsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ListID == "" {
+		return nil, errors.New("audience is required")
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := client.ListSegments(input.ListID, input.StaticOnly)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"segments": segments,
+		"count":    len(segments),
+	}, nil
+}
+
+func (a *ListSegmentsAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+var segmentSample = map[string]any{
+	"id":           49381,
+	"name":         "Spring buyers",
+	"member_count": 245,
+	"type":         "static",
+	"created_at":   "2024-03-01T10:00:00+00:00",
+	"updated_at":   "2024-03-14T09:00:00+00:00",
+	"list_id":      "57afe96172",
+}
+
+func NewListSegmentsAction() sdk.Action {
+	return &ListSegmentsAction{}
+}
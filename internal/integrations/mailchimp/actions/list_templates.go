@@ -0,0 +1,106 @@
+package actions
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type listTemplatesActionProps struct {
+	Type   string `json:"type"`
+	Count  int    `json:"count"`
+	Offset int    `json:"offset"`
+}
+
+type ListTemplatesAction struct{}
+
+func (a *ListTemplatesAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_templates",
+		DisplayName:   "List Templates",
+		Description:   "List the email templates of the account, to pick one for a campaign.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: listTemplatesDocs,
+		SampleOutput: map[string]any{
+			"templates": []map[string]any{
+				{
+					"id":            2000094,
+					"type":          "user",
+					"name":          "Monthly newsletter",
+					"drag_and_drop": true,
+					"responsive":    true,
+					"date_created":  "2024-01-10T12:00:00+00:00",
+					"thumbnail":     "https://gallery.mailchimp.com/template-thumbnail.png",
+				},
+			},
+			"total_items": 1,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *ListTemplatesAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_templates", "List Templates")
+
+	form.SelectField("type", "Type").
+		Required(false).
+		DefaultValue("user").
+		AddOption("user", "My templates").
+		AddOption("base", "Basic templates").
+		AddOption("gallery", "Themes")
+
+	form.NumberField("count", "Limit").
+		Required(false).
+		DefaultValue(100).
+		HelpText("The most templates to return, at most 1000.")
+
+	form.NumberField("offset", "Offset").
+		Required(false).
+		DefaultValue(0).
+		HelpText("The number of templates to skip, to read the next page.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *ListTemplatesAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listTemplatesActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Count <= 0 || input.Count > 1000 {
+		input.Count = 100
+	}
+
+	query := url.Values{
+		"count":          {strconv.Itoa(input.Count)},
+		"offset":         {strconv.Itoa(max(input.Offset, 0))},
+		"exclude_fields": {"templates._links,_links"},
+	}
+	if input.Type != "" {
+		query.Set("type", input.Type)
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp map[string]interface{}
+	if err := client.Get("/templates", query, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *ListTemplatesAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewListTemplatesAction() sdk.Action {
+	return &ListTemplatesAction{}
+}
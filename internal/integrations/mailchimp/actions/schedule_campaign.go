@@ -0,0 +1,110 @@
+package actions
+
+import (
+	"errors"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type scheduleCampaignActionProps struct {
+	CampaignID   string `json:"campaign_id"`
+	ScheduleTime string `json:"schedule_time"`
+	Timewarp     bool   `json:"timewarp"`
+	Unschedule   bool   `json:"unschedule"`
+}
+
+type ScheduleCampaignAction struct{}
+
+func (a *ScheduleCampaignAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "schedule_campaign",
+		DisplayName:   "Schedule Campaign",
+		Description:   "Schedule a campaign to be sent at a time, or return a scheduled campaign to draft.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: scheduleCampaignDocs,
+		SampleOutput: map[string]any{
+			"campaign_id":   "42694e9e57",
+			"status":        "schedule",
+			"schedule_time": "2024-03-15T09:00:00Z",
+			"timewarp":      false,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *ScheduleCampaignAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("schedule_campaign", "Schedule Campaign")
+
+	shared.RegisterCampaignProps(form)
+
+	form.DateTimeField("schedule_time", "Send Time").
+		Required(false).
+		HelpText("When to send the campaign, on the quarter hour, such as 09:00 or 09:15 UTC.")
+
+	form.CheckboxField("timewarp", "Timewarp").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Send at this time of day in each recipient's time zone. Must be at least 24 hours ahead.")
+
+	form.CheckboxField("unschedule", "Unschedule").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Return a scheduled campaign to draft instead of scheduling it.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *ScheduleCampaignAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[scheduleCampaignActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.CampaignID == "" {
+		return nil, errors.New("campaign is required")
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Unschedule {
+		if err := client.UnscheduleCampaign(input.CampaignID); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"campaign_id": input.CampaignID, "status": "save"}, nil
+	}
+
+	if input.ScheduleTime == "" {
+		return nil, errors.New("send time is required")
+	}
+	at, err := parseTime(input.ScheduleTime)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ScheduleCampaign(input.CampaignID, at, input.Timewarp); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"campaign_id":   input.CampaignID,
+		"status":        "schedule",
+		"schedule_time": at.UTC().Format(time.RFC3339),
+		"timewarp":      input.Timewarp,
+	}, nil
+}
+
+func (a *ScheduleCampaignAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewScheduleCampaignAction() sdk.Action {
+	return &ScheduleCampaignAction{}
+}
@@ -0,0 +1,71 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type sendCampaignActionProps struct {
+	CampaignID string `json:"campaign_id"`
+}
+
+type SendCampaignAction struct{}
+
+func (a *SendCampaignAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "send_campaign",
+		DisplayName:   "Send Campaign",
+		Description:   "Send a campaign to its audience now, after checking that Mailchimp considers it ready.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: sendCampaignDocs,
+		SampleOutput:  campaignSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *SendCampaignAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("send_campaign", "Send Campaign")
+
+	shared.RegisterCampaignProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Perform checks the campaign's send checklist first, so a campaign
+// missing content or a verified sender fails with Mailchimp's reasons.
+func (a *SendCampaignAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[sendCampaignActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.CampaignID == "" {
+		return nil, errors.New("campaign is required")
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.CheckCampaign(input.CampaignID); err != nil {
+		return nil, err
+	}
+	if err := client.SendCampaign(input.CampaignID); err != nil {
+		return nil, err
+	}
+	return client.GetCampaign(input.CampaignID)
+}
+
+func (a *SendCampaignAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewSendCampaignAction() sdk.Action {
+	return &SendCampaignAction{}
+}
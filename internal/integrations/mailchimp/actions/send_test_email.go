@@ -0,0 +1,94 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type sendTestEmailActionProps struct {
+	CampaignID string `json:"campaign_id"`
+	TestEmails string `json:"test_emails"`
+	SendType   string `json:"send_type"`
+}
+
+type SendTestEmailAction struct{}
+
+func (a *SendTestEmailAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "send_test_email",
+		DisplayName:   "Send Test Email",
+		Description:   "Send a test of a campaign to a few email addresses before sending it to the audience.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: sendTestEmailDocs,
+		SampleOutput: map[string]any{
+			"campaign_id": "42694e9e57",
+			"test_emails": []string{"review@acme.example"},
+			"sent":        true,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *SendTestEmailAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("send_test_email", "Send Test Email")
+
+	shared.RegisterCampaignProps(form)
+
+	form.TextField("test_emails", "Test Emails").
+		Required(true).
+		HelpText("Comma-separated email addresses to send the test to.")
+
+	form.SelectField("send_type", "Send As").
+		Required(false).
+		DefaultValue("html").
+		AddOption("html", "HTML").
+		AddOption("plaintext", "Plain text")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *SendTestEmailAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[sendTestEmailActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.CampaignID == "" {
+		return nil, errors.New("campaign is required")
+	}
+	emails := splitEmails(input.TestEmails)
+	if len(emails) == 0 {
+		return nil, errors.New("at least one test email is required")
+	}
+	if input.SendType == "" {
+		input.SendType = "html"
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.SendTestEmail(input.CampaignID, emails, input.SendType); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"campaign_id": input.CampaignID,
+		"test_emails": emails,
+		"sent":        true,
+	}, nil
+}
+
+func (a *SendTestEmailAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewSendTestEmailAction() sdk.Action {
+	return &SendTestEmailAction{}
+}
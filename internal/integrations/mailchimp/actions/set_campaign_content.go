@@ -0,0 +1,141 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type setCampaignContentActionProps struct {
+	CampaignID       string `json:"campaign_id"`
+	Source           string `json:"source"`
+	HTML             string `json:"html"`
+	PlainText        string `json:"plain_text"`
+	TemplateID       string `json:"template_id"`
+	TemplateSections string `json:"template_sections"`
+	URL              string `json:"url"`
+}
+
+type SetCampaignContentAction struct{}
+
+func (a *SetCampaignContentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "set_campaign_content",
+		DisplayName:   "Set Campaign Content",
+		Description:   "Set the content of a campaign from HTML, plain text, a template with editable sections, or a web page.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: setCampaignContentDocs,
+		SampleOutput: map[string]any{
+			"plain_text":   "Our spring collection is here...",
+			"html":         "<html><body><h1>Our spring collection is here</h1></body></html>",
+			"archive_html": "<html>...</html>",
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *SetCampaignContentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("set_campaign_content", "Set Campaign Content")
+
+	shared.RegisterCampaignProps(form)
+
+	form.SelectField("source", "Content Source").
+		Required(true).
+		DefaultValue("html").
+		AddOption("html", "HTML").
+		AddOption("template", "Template").
+		AddOption("url", "Web page").
+		AddOption("plain_text", "Plain text only")
+
+	form.TextareaField("html", "HTML").
+		Required(false).
+		HelpText("The HTML of the email, when the source is HTML.")
+
+	form.TextareaField("plain_text", "Plain Text").
+		Required(false).
+		HelpText("The plain-text version of the email. Mailchimp generates one from the HTML when empty.")
+
+	shared.RegisterTemplateProps(form, false).
+		HelpText("The template, when the source is a template.")
+
+	form.TextareaField("template_sections", "Template Sections").
+		Required(false).
+		HelpText(`Content of the template's editable sections as a JSON object by section name, such as {"header": "<h1>Hello</h1>"}.`)
+
+	form.TextField("url", "Page URL").
+		Required(false).
+		HelpText("A web page to import as the content, when the source is a web page.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *SetCampaignContentAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[setCampaignContentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.CampaignID == "" {
+		return nil, errors.New("campaign is required")
+	}
+
+	content := map[string]interface{}{}
+	if input.PlainText != "" {
+		content["plain_text"] = input.PlainText
+	}
+	switch input.Source {
+	case "", "html":
+		if strings.TrimSpace(input.HTML) == "" {
+			return nil, errors.New("HTML is required")
+		}
+		content["html"] = input.HTML
+	case "template":
+		templateID, err := strconv.Atoi(input.TemplateID)
+		if err != nil {
+			return nil, errors.New("template is required")
+		}
+		template := map[string]interface{}{"id": templateID}
+		if strings.TrimSpace(input.TemplateSections) != "" {
+			var sections map[string]interface{}
+			if err := json.Unmarshal([]byte(input.TemplateSections), &sections); err != nil {
+				return nil, fmt.Errorf("template sections must be a JSON object: %w", err)
+			}
+			template["sections"] = sections
+		}
+		content["template"] = template
+	case "url":
+		if input.URL == "" {
+			return nil, errors.New("page URL is required")
+		}
+		content["url"] = input.URL
+	case "plain_text":
+		if input.PlainText == "" {
+			return nil, errors.New("plain text is required")
+		}
+	default:
+		return nil, fmt.Errorf("unknown content source %q", input.Source)
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.SetCampaignContent(input.CampaignID, content)
+}
+
+func (a *SetCampaignContentAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewSetCampaignContentAction() sdk.Action {
+	return &SetCampaignContentAction{}
+}
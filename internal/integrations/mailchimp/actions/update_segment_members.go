@@ -0,0 +1,99 @@
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateSegmentMembersActionProps struct {
+	ListID    string `json:"list_id"`
+	SegmentID string `json:"segment_id"`
+	Add       string `json:"add"`
+	Remove    string `json:"remove"`
+}
+
+type UpdateSegmentMembersAction struct{}
+
+func (a *UpdateSegmentMembersAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_segment_members",
+		DisplayName:   "Update Static Segment Members",
+		Description:   "Add members to a static segment and remove members from it, up to 500 of each per step.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: updateSegmentMembersDocs,
+		SampleOutput: map[string]any{
+			"total_added":   2,
+			"total_removed": 1,
+			"error_count":   1,
+			"errors": []map[string]any{
+				{"email_addresses": []string{"not.a.member@example.com"}, "error": "Email addresses are not subscribed to the list"},
+			},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpdateSegmentMembersAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_segment_members", "Update Static Segment Members")
+
+	shared.RegisterListProps(form, true)
+
+	shared.RegisterSegmentProps(form, true, true).
+		HelpText("A static segment of the audience.")
+
+	form.TextareaField("add", "Members to Add").
+		Required(false).
+		HelpText("Email addresses of audience members to add, separated by commas or new lines.")
+
+	form.TextareaField("remove", "Members to Remove").
+		Required(false).
+		HelpText("Email addresses to remove from the segment. They stay on the audience.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// maxSegmentMembers is the most members Mailchimp adds or removes per
+// request.
+const maxSegmentMembers = 500
+
+func (a *UpdateSegmentMembersAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateSegmentMembersActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ListID == "" || input.SegmentID == "" {
+		return nil, errors.New("audience and segment are required")
+	}
+	add, remove := splitEmails(input.Add), splitEmails(input.Remove)
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, errors.New("give members to add or remove")
+	}
+	if len(add) > maxSegmentMembers || len(remove) > maxSegmentMembers {
+		return nil, errors.New("at most 500 members can be added and 500 removed per step")
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.UpdateSegmentMembers(input.ListID, input.SegmentID, add, remove)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (a *UpdateSegmentMembersAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpdateSegmentMembersAction() sdk.Action {
+	return &UpdateSegmentMembersAction{}
+}
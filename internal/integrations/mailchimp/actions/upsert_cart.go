@@ -0,0 +1,95 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type upsertCartActionProps struct {
+	checkoutFields
+	CartID      string `json:"cart_id"`
+	CheckoutURL string `json:"checkout_url"`
+}
+
+type UpsertCartAction struct{}
+
+func (a *UpsertCartAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_cart",
+		DisplayName:   "Create or Update Cart",
+		Description:   "Add a cart to a Mailchimp store, or update it, so abandoned cart automations can remind the customer to check out.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertCartDocs,
+		SampleOutput: map[string]any{
+			"cart":             cartSample,
+			"created":          true,
+			"created_products": []string{"93"},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertCartAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_cart", "Create or Update Cart")
+
+	form.TextField("cart_id", "Cart ID").
+		Required(true).
+		HelpText("The cart's ID in your store, such as the Shopify checkout token or the WooCommerce session key.")
+
+	form.TextField("checkout_url", "Checkout URL").
+		Required(true).
+		HelpText("The URL that brings the customer back to the cart, linked from abandoned cart emails.")
+
+	registerCheckoutProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *UpsertCartAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[upsertCartActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	cartID := strings.TrimSpace(input.CartID)
+	if cartID == "" {
+		return nil, errors.New("cart ID is required")
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, createdProducts, err := input.body(client)
+	if err != nil {
+		return nil, err
+	}
+	if input.CheckoutURL != "" {
+		body["checkout_url"] = input.CheckoutURL
+	}
+
+	cart, created, err := client.SaveResource(shared.StorePath(input.StoreID, "carts"), cartID, body)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"cart":             cart,
+		"created":          created,
+		"created_products": createdProducts,
+	}, nil
+}
+
+func (a *UpsertCartAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpsertCartAction() sdk.Action {
+	return &UpsertCartAction{}
+}
@@ -0,0 +1,134 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// contactFieldMap names the merge fields of the standard contact fields.
+// FNAME, LNAME and PHONE are in every new audience; a company needs a
+// merge field of its own, passed as a custom field.
+var contactFieldMap = crm.FieldMap{
+	crm.FieldFirstName: "FNAME",
+	crm.FieldLastName:  "LNAME",
+	crm.FieldPhone:     "PHONE",
+	crm.FieldEmail:     "EMAIL",
+}
+
+type upsertContactActionProps struct {
+	ListID      string `json:"list-id"`
+	StatusIfNew string `json:"status-if-new"`
+}
+
+type UpsertContactAction struct{}
+
+func (a *UpsertContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_contact",
+		DisplayName:   "Create or Update Contact",
+		Description:   "Add a contact to a Mailchimp audience, or update the member with that email when they are on it already.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertContactDocs,
+		SampleOutput: crm.SampleOutput(map[string]any{
+			"id":            "62eeb292278cc15f5817cb78f7790b08",
+			"email_address": "jane.doe@example.com",
+			"status":        "subscribed",
+			"merge_fields": map[string]any{
+				"FNAME": "Jane",
+				"LNAME": "Doe",
+			},
+			"tags": []map[string]any{{"id": 101, "name": "lead"}},
+		}),
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_contact", "Create or Update Contact")
+
+	form.TextField("list-id", "List ID").
+		Placeholder("Enter a value for List ID.").
+		Required(true).
+		HelpText("The ID of the audience the contact belongs to.")
+
+	form.SelectField("status-if-new", "Status If New").
+		Required(false).
+		DefaultValue("subscribed").
+		HelpText("The status of a new member. Existing members keep their status.").
+		AddOptions(shared.MailchimpSubscriberStatus...)
+
+	crm.RegisterContactProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Perform writes the member and adds its tags. Custom fields are merge
+// fields by tag, such as COMPANY.
+func (a *UpsertContactAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[upsertContactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.StatusIfNew == "" {
+		input.StatusIfNew = "subscribed"
+	}
+
+	contact, err := crm.ContactFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := ctx.Auth().Token
+	if tokenSource == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	token := tokenSource.AccessToken
+
+	dc, err := shared.GetMailChimpServerPrefix(token)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get mailchimp server prefix: %v", err)
+	}
+
+	mergeFields, ignored := contactFieldMap.Map(contact)
+	delete(mergeFields, "EMAIL")
+
+	existing, err := shared.GetMember(token, dc, input.ListID, contact.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := shared.PutMember(token, dc, input.ListID, contact.Email, input.StatusIfNew, mergeFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contact.Tags) > 0 {
+		if err := shared.ModifySubscriberTags(token, dc, input.ListID, contact.Email, contact.Tags, "active"); err != nil {
+			return nil, fmt.Errorf("adding tags: %w", err)
+		}
+	}
+
+	return crm.Result{
+		ID:      fmt.Sprint(member["id"]),
+		Created: existing == nil,
+		Contact: member,
+		Ignored: ignored,
+	}.Output(), nil
+}
+
+func (a *UpsertContactAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpsertContactAction() sdk.Action {
+	return &UpsertContactAction{}
+}
@@ -0,0 +1,74 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type upsertCustomerActionProps struct {
+	customerFields
+	StoreID string `json:"store_id"`
+}
+
+type UpsertCustomerAction struct{}
+
+func (a *UpsertCustomerAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_customer",
+		DisplayName:   "Create or Update Customer",
+		Description:   "Add a customer to a Mailchimp store, or replace it, linking the customer to the store's audience.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertCustomerDocs,
+		SampleOutput: map[string]any{
+			"id":            "jane.doe@example.com",
+			"email_address": "jane.doe@example.com",
+			"opt_in_status": false,
+			"first_name":    "Jane",
+			"last_name":     "Doe",
+			"orders_count":  3,
+			"total_spent":   119.94,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertCustomerAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_customer", "Create or Update Customer")
+
+	shared.RegisterStoreProps(form)
+
+	registerCustomerProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *UpsertCustomerAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[upsertCustomerActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	customer, err := input.customer()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, _ := customer["id"].(string)
+	return client.PutResource(shared.StorePath(input.StoreID, "customers", id), customer)
+}
+
+func (a *UpsertCustomerAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpsertCustomerAction() sdk.Action {
+	return &UpsertCustomerAction{}
+}
@@ -0,0 +1,158 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type upsertOrderActionProps struct {
+	checkoutFields
+	OrderID           string `json:"order_id"`
+	FinancialStatus   string `json:"financial_status"`
+	FulfillmentStatus string `json:"fulfillment_status"`
+	ProcessedAt       string `json:"processed_at"`
+	OrderURL          string `json:"order_url"`
+	CartID            string `json:"cart_id"`
+}
+
+type UpsertOrderAction struct{}
+
+func (a *UpsertOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_order",
+		DisplayName:   "Create or Update Order",
+		Description:   "Add an order to a Mailchimp store, or update it, for purchase automations and revenue reports, and optionally close the customer's cart.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertOrderDocs,
+		SampleOutput: map[string]any{
+			"order": map[string]any{
+				"id":               "1001",
+				"customer":         map[string]any{"id": "jane.doe@example.com", "email_address": "jane.doe@example.com"},
+				"financial_status": "paid",
+				"currency_code":    "USD",
+				"order_total":      39.98,
+				"lines": []map[string]any{
+					{"id": "1", "product_id": "93", "product_variant_id": "101", "quantity": 2, "price": 19.99},
+				},
+			},
+			"created":          true,
+			"created_products": []string{},
+			"cart_deleted":     true,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_order", "Create or Update Order")
+
+	form.TextField("order_id", "Order ID").
+		Required(true).
+		HelpText("The order's ID in your store.")
+
+	registerCheckoutProps(form)
+
+	form.SelectField("financial_status", "Financial Status").
+		Required(false).
+		AddOption("paid", "Paid").
+		AddOption("pending", "Pending").
+		AddOption("refunded", "Refunded").
+		AddOption("cancelled", "Cancelled").
+		HelpText("Paid, refunded and cancelled orders send the matching order notifications when they are set up.")
+
+	form.SelectField("fulfillment_status", "Fulfillment Status").
+		Required(false).
+		AddOption("shipped", "Shipped").
+		HelpText("Shipped orders send the shipping confirmation when it is set up.")
+
+	form.DateTimeField("processed_at", "Placed At").
+		Required(false).
+		HelpText("When the order was placed. Defaults to now.")
+
+	form.TextField("order_url", "Order URL").
+		Required(false).
+		HelpText("A link to the order in your store.")
+
+	form.TextField("cart_id", "Cart ID").
+		Required(false).
+		HelpText("The cart the order was placed from. It is deleted so its abandoned cart emails stop.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *UpsertOrderAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[upsertOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	orderID := strings.TrimSpace(input.OrderID)
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, createdProducts, err := input.body(client)
+	if err != nil {
+		return nil, err
+	}
+	if input.ProcessedAt != "" {
+		processedAt, err := parseTime(input.ProcessedAt)
+		if err != nil {
+			return nil, err
+		}
+		body["processed_at_foreign"] = processedAt.UTC().Format(time.RFC3339)
+	}
+	for key, value := range map[string]string{
+		"financial_status":   input.FinancialStatus,
+		"fulfillment_status": input.FulfillmentStatus,
+		"order_url":          input.OrderURL,
+	} {
+		if value != "" {
+			body[key] = value
+		}
+	}
+
+	order, created, err := client.SaveResource(shared.StorePath(input.StoreID, "orders"), orderID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	cartDeleted := false
+	if cartID := strings.TrimSpace(input.CartID); cartID != "" {
+		err := client.Request(http.MethodDelete, shared.StorePath(input.StoreID, "carts", cartID), nil, nil)
+		switch {
+		case err == nil:
+			cartDeleted = true
+		case !shared.IsNotFound(err):
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{
+		"order":            order,
+		"created":          created,
+		"created_products": createdProducts,
+		"cart_deleted":     cartDeleted,
+	}, nil
+}
+
+func (a *UpsertOrderAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpsertOrderAction() sdk.Action {
+	return &UpsertOrderAction{}
+}
@@ -0,0 +1,119 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type upsertProductActionProps struct {
+	StoreID     string   `json:"store_id"`
+	ProductID   string   `json:"product_id"`
+	Title       string   `json:"title"`
+	URL         string   `json:"url"`
+	ImageURL    string   `json:"image_url"`
+	Description string   `json:"description"`
+	Price       *float64 `json:"price"`
+	Variants    string   `json:"variants"`
+}
+
+type UpsertProductAction struct{}
+
+func (a *UpsertProductAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_product",
+		DisplayName:   "Create or Update Product",
+		Description:   "Add a product with its variants to a Mailchimp store, or replace it, so carts, orders and product recommendations can show it.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertProductDocs,
+		SampleOutput: map[string]any{
+			"id":        "93",
+			"title":     "Wireless Mouse",
+			"url":       "https://shop.example.com/products/wireless-mouse",
+			"image_url": "https://shop.example.com/images/mouse.jpg",
+			"variants": []map[string]any{
+				{"id": "101", "title": "Black", "price": 19.99},
+			},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertProductAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_product", "Create or Update Product")
+
+	shared.RegisterStoreProps(form)
+
+	form.TextField("product_id", "Product ID").
+		Required(true).
+		HelpText("The product's ID in your store.")
+
+	form.TextField("title", "Title").
+		Required(true)
+
+	form.TextField("url", "Product URL").
+		Required(false)
+
+	form.TextField("image_url", "Image URL").
+		Required(false)
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	form.NumberField("price", "Price").
+		Required(false).
+		HelpText("The price of a product without variants, which gets one variant with the product's ID.")
+
+	form.TextareaField("variants", "Variants").
+		Required(false).
+		HelpText(`The variants as a JSON array, such as [{"id": "101", "title": "Black", "price": 19.99, "sku": "MOUSE-BLK"}]. Variants of Shopify and WooCommerce products can be passed as they are.`)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *UpsertProductAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[upsertProductActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	productID := strings.TrimSpace(input.ProductID)
+	if input.StoreID == "" || productID == "" || input.Title == "" {
+		return nil, errors.New("store, product ID and title are required")
+	}
+
+	variants, err := shared.ParseVariants(input.Variants, productID, input.Title, input.Price)
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]interface{}{
+		"id":       productID,
+		"title":    input.Title,
+		"variants": variants,
+	}
+	for key, value := range map[string]string{"url": input.URL, "image_url": input.ImageURL, "description": input.Description} {
+		if value != "" {
+			body[key] = value
+		}
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.PutResource(shared.StorePath(input.StoreID, "products", productID), body)
+}
+
+func (a *UpsertProductAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpsertProductAction() sdk.Action {
+	return &UpsertProductAction{}
+}
@@ -0,0 +1,123 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type upsertStoreActionProps struct {
+	StoreID      string `json:"store_id"`
+	ListID       string `json:"list_id"`
+	Name         string `json:"name"`
+	Platform     string `json:"platform"`
+	Domain       string `json:"domain"`
+	Email        string `json:"email"`
+	CurrencyCode string `json:"currency_code"`
+}
+
+type UpsertStoreAction struct{}
+
+func (a *UpsertStoreAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_store",
+		DisplayName:   "Create or Update Store",
+		Description:   "Add an e-commerce store to Mailchimp, or update it, so its customers, orders and carts can feed automations.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertStoreDocs,
+		SampleOutput: map[string]any{
+			"store": map[string]any{
+				"id":            "acme-shop",
+				"list_id":       "57afe96172",
+				"name":          "Acme Shop",
+				"platform":      "Shopify",
+				"domain":        "shop.acme.example",
+				"email_address": "hello@acme.example",
+				"currency_code": "USD",
+			},
+			"created": true,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertStoreAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_store", "Create or Update Store")
+
+	form.TextField("store_id", "Store ID").
+		Required(true).
+		HelpText("A unique ID of your choice for the store, such as acme-shop.")
+
+	shared.RegisterListProps(form, true).
+		HelpText("The audience the store's customers are added to. It can't be changed once the store exists.")
+
+	form.TextField("name", "Name").
+		Required(true)
+
+	form.TextField("platform", "Platform").
+		Required(false).
+		Placeholder("Shopify").
+		HelpText("The e-commerce platform of the store, such as Shopify or WooCommerce.")
+
+	form.TextField("domain", "Domain").
+		Required(false).
+		Placeholder("shop.example.com")
+
+	form.TextField("email", "Store Email").
+		Required(false)
+
+	form.TextField("currency_code", "Currency").
+		Required(true).
+		DefaultValue("USD").
+		HelpText("The three-letter ISO 4217 currency code of the store.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *UpsertStoreAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[upsertStoreActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	storeID := strings.TrimSpace(input.StoreID)
+	if storeID == "" || input.ListID == "" || input.Name == "" {
+		return nil, errors.New("store ID, audience and name are required")
+	}
+
+	body := map[string]interface{}{
+		"list_id":       input.ListID,
+		"name":          input.Name,
+		"currency_code": strings.ToUpper(strings.TrimSpace(input.CurrencyCode)),
+	}
+	for key, value := range map[string]string{"platform": input.Platform, "domain": input.Domain, "email_address": input.Email} {
+		if value != "" {
+			body[key] = value
+		}
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The audience of an existing store can't be changed.
+	store, created, err := client.SaveResource("/ecommerce/stores", storeID, body, "list_id")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"store": store, "created": created}, nil
+}
+
+func (a *UpsertStoreAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpsertStoreAction() sdk.Action {
+	return &UpsertStoreAction{}
+}
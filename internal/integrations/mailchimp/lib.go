@@ -36,6 +36,8 @@ func (n *Mailchimp) Triggers() []sdk.Trigger {
 		triggers.NewUnsubscriberTrigger(),
 
 		triggers.NewNewSubscriberTrigger(),
+
+		triggers.NewCampaignSentTrigger(),
 	}
 }
 
@@ -52,6 +54,44 @@ func (n *Mailchimp) Actions() []sdk.Action {
 		actions.NewAddNoteToSubscriberAction(),
 
 		actions.NewAddMemberToListAction(),
+
+		actions.NewUpsertContactAction(),
+
+		actions.NewCreateCampaignAction(),
+
+		actions.NewSetCampaignContentAction(),
+
+		actions.NewSendTestEmailAction(),
+
+		actions.NewScheduleCampaignAction(),
+
+		actions.NewSendCampaignAction(),
+
+		actions.NewListTemplatesAction(),
+
+		actions.NewListSegmentsAction(),
+
+		actions.NewCreateSegmentAction(),
+
+		actions.NewUpdateSegmentMembersAction(),
+
+		actions.NewDeleteSegmentAction(),
+
+		actions.NewBatchSubscribeAction(),
+
+		actions.NewGetBatchStatusAction(),
+
+		actions.NewUpsertStoreAction(),
+
+		actions.NewUpsertCustomerAction(),
+
+		actions.NewUpsertProductAction(),
+
+		actions.NewUpsertCartAction(),
+
+		actions.NewUpsertOrderAction(),
+
+		actions.NewDeleteCartAction(),
 	}
 }
 
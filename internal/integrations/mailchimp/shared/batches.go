@@ -0,0 +1,140 @@
+package shared
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// MaxBatchOperations bounds the operations of one batch, so a batch
+// finishes in reasonable time and its results stay small enough to read.
+const MaxBatchOperations = 5000
+
+// BatchOperation is an API call run by a batch. Body is the JSON body as
+// a string.
+type BatchOperation struct {
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	Body        string `json:"body,omitempty"`
+	OperationID string `json:"operation_id,omitempty"`
+}
+
+// Batch is the status of a batch of operations.
+type Batch struct {
+	ID                 string `json:"id"`
+	Status             string `json:"status"`
+	TotalOperations    int    `json:"total_operations"`
+	FinishedOperations int    `json:"finished_operations"`
+	ErroredOperations  int    `json:"errored_operations"`
+	SubmittedAt        string `json:"submitted_at"`
+	CompletedAt        string `json:"completed_at"`
+	ResponseBodyURL    string `json:"response_body_url"`
+}
+
+// Finished reports whether all operations of the batch have run.
+func (b *Batch) Finished() bool {
+	return b.Status == "finished"
+}
+
+// BatchResponse is the response to an operation of a batch.
+type BatchResponse struct {
+	StatusCode  int    `json:"status_code"`
+	OperationID string `json:"operation_id"`
+	Response    string `json:"response"`
+}
+
+// Error returns the error of a failed operation, or an empty string.
+func (r BatchResponse) Error() string {
+	if r.StatusCode < http.StatusBadRequest {
+		return ""
+	}
+	apiErr := &APIError{Status: r.StatusCode}
+	if json.Unmarshal([]byte(r.Response), apiErr) != nil || apiErr.Title == "" {
+		return fmt.Sprintf("operation failed with status %d", r.StatusCode)
+	}
+	apiErr.Status = r.StatusCode
+	return strings.TrimPrefix(apiErr.Error(), "mailchimp: ")
+}
+
+// NewOperation returns a batch operation with a JSON body.
+func NewOperation(id, method, path string, body interface{}) (BatchOperation, error) {
+	op := BatchOperation{Method: method, Path: path, OperationID: id}
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return op, err
+		}
+		op.Body = string(data)
+	}
+	return op, nil
+}
+
+// StartBatch queues operations to run in the background.
+func (c *Client) StartBatch(operations []BatchOperation) (*Batch, error) {
+	var batch Batch
+	if err := c.Request(http.MethodPost, "/batches", map[string]interface{}{"operations": operations}, &batch); err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
+
+// GetBatch returns the status of a batch.
+func (c *Client) GetBatch(batchID string) (*Batch, error) {
+	if batchID == "" {
+		return nil, errors.New("batch ID is required")
+	}
+	var batch Batch
+	if err := c.Request(http.MethodGet, "/batches/"+url.PathEscape(batchID), nil, &batch); err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
+
+// DownloadBatchResponses reads the responses of a finished batch from
+// its gzipped tar archive of JSON files.
+func DownloadBatchResponses(responseBodyURL string) ([]BatchResponse, error) {
+	if !strings.HasPrefix(responseBodyURL, "https://") {
+		return nil, fmt.Errorf("unexpected batch response URL %q", responseBodyURL)
+	}
+	// The archive is on a presigned storage URL, which takes no token.
+	resp, err := http.Get(responseBodyURL) // #nosec G107
+	if err != nil {
+		return nil, fmt.Errorf("failed to download batch responses: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download batch responses: %s", resp.Status)
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read batch responses: %w", err)
+	}
+	defer gz.Close()
+
+	var responses []BatchResponse
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return responses, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read batch responses: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg || !strings.HasSuffix(header.Name, ".json") {
+			continue
+		}
+		var part []BatchResponse
+		if err := json.NewDecoder(tr).Decode(&part); err != nil {
+			return nil, fmt.Errorf("failed to decode batch responses in %s: %w", header.Name, err)
+		}
+		responses = append(responses, part...)
+	}
+}
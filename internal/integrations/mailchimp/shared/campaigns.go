@@ -0,0 +1,141 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CreateCampaign creates a campaign from the fields of the campaigns API
+// and returns it.
+func (c *Client) CreateCampaign(body map[string]interface{}) (map[string]interface{}, error) {
+	var campaign map[string]interface{}
+	if err := c.Request(http.MethodPost, "/campaigns", body, &campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// SetCampaignContent sets the content of a campaign and returns it.
+func (c *Client) SetCampaignContent(campaignID string, content map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := c.Request(http.MethodPut, campaignPath(campaignID, "/content"), content, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SendTestEmail sends a test of a campaign to the email addresses, as
+// html or plaintext.
+func (c *Client) SendTestEmail(campaignID string, emails []string, sendType string) error {
+	return c.Request(http.MethodPost, campaignPath(campaignID, "/actions/test"),
+		map[string]interface{}{"test_emails": emails, "send_type": sendType}, nil)
+}
+
+// ScheduleCampaign schedules a campaign. Mailchimp only sends on the
+// quarter hour, so other times are rejected here with the nearest
+// valid ones. With timewarp, the campaign is sent at the time in each
+// recipient's time zone.
+func (c *Client) ScheduleCampaign(campaignID string, at time.Time, timewarp bool) error {
+	at = at.UTC()
+	if at.Minute()%15 != 0 || at.Second() != 0 || at.Nanosecond() != 0 {
+		before := at.Truncate(15 * time.Minute)
+		return fmt.Errorf("campaigns can only be scheduled on the quarter hour, such as %s or %s",
+			before.Format(time.RFC3339), before.Add(15*time.Minute).Format(time.RFC3339))
+	}
+	if !at.After(time.Now()) {
+		return fmt.Errorf("schedule time %s is in the past", at.Format(time.RFC3339))
+	}
+	return c.Request(http.MethodPost, campaignPath(campaignID, "/actions/schedule"),
+		map[string]interface{}{"schedule_time": at.Format(time.RFC3339), "timewarp": timewarp}, nil)
+}
+
+// UnscheduleCampaign returns a scheduled campaign to draft.
+func (c *Client) UnscheduleCampaign(campaignID string) error {
+	return c.Request(http.MethodPost, campaignPath(campaignID, "/actions/unschedule"), nil, nil)
+}
+
+// SendCampaign sends a campaign now.
+func (c *Client) SendCampaign(campaignID string) error {
+	return c.Request(http.MethodPost, campaignPath(campaignID, "/actions/send"), nil, nil)
+}
+
+// CheckCampaign returns an error listing what keeps a campaign from
+// being sent, such as missing content or an unverified sender domain.
+func (c *Client) CheckCampaign(campaignID string) error {
+	var checklist struct {
+		IsReady bool `json:"is_ready"`
+		Items   []struct {
+			Type    string `json:"type"`
+			Heading string `json:"heading"`
+			Details string `json:"details"`
+		} `json:"items"`
+	}
+	if err := c.Request(http.MethodGet, campaignPath(campaignID, "/send-checklist"), nil, &checklist); err != nil {
+		return err
+	}
+	if checklist.IsReady {
+		return nil
+	}
+	var problems []string
+	for _, item := range checklist.Items {
+		if item.Type == "error" {
+			problems = append(problems, fmt.Sprintf("%s: %s", item.Heading, item.Details))
+		}
+	}
+	return fmt.Errorf("campaign is not ready to send: %s", strings.Join(problems, "; "))
+}
+
+// GetCampaign returns a campaign.
+func (c *Client) GetCampaign(campaignID string) (map[string]interface{}, error) {
+	var campaign map[string]interface{}
+	if err := c.Request(http.MethodGet, campaignPath(campaignID, ""), nil, &campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// SentCampaigns returns the campaigns sent in a time window, oldest
+// first.
+func (c *Client) SentCampaigns(since, before time.Time) ([]map[string]interface{}, error) {
+	var campaigns []map[string]interface{}
+	for offset := 0; ; offset += 1000 {
+		var resp struct {
+			Campaigns  []map[string]interface{} `json:"campaigns"`
+			TotalItems int                      `json:"total_items"`
+		}
+		err := c.Get("/campaigns", url.Values{
+			"status":           {"sent"},
+			"since_send_time":  {since.UTC().Format(time.RFC3339)},
+			"before_send_time": {before.UTC().Format(time.RFC3339)},
+			"sort_field":       {"send_time"},
+			"sort_dir":         {"ASC"},
+			"count":            {"1000"},
+			"offset":           {fmt.Sprint(offset)},
+			"exclude_fields":   {"campaigns._links"},
+		}, &resp)
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, resp.Campaigns...)
+		if len(resp.Campaigns) < 1000 || len(campaigns) >= resp.TotalItems {
+			return campaigns, nil
+		}
+	}
+}
+
+// GetReport returns the report of a sent campaign.
+func (c *Client) GetReport(campaignID string) (map[string]interface{}, error) {
+	var report map[string]interface{}
+	err := c.Get("/reports/"+url.PathEscape(campaignID), url.Values{"exclude_fields": {"_links"}}, &report)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func campaignPath(campaignID, suffix string) string {
+	return "/campaigns/" + url.PathEscape(campaignID) + suffix
+}
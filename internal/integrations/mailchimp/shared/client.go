@@ -0,0 +1,130 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// APIURL returns the base URL of the Marketing API in a data center,
+// such as us21.
+func APIURL(server string) string {
+	return fmt.Sprintf("https://%s.api.mailchimp.com/3.0", server)
+}
+
+// Client calls the Marketing API in the data center of an account.
+type Client struct {
+	Token  string
+	Server string
+}
+
+// NewClient returns a client for the connection, looking up the data
+// center of its account.
+func NewClient(auth *sdkcontext.AuthContext) (*Client, error) {
+	if auth == nil || auth.Token == nil {
+		return nil, errors.New("missing authentication token")
+	}
+	server, err := GetMailChimpServerPrefix(auth.Token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get mailchimp server prefix: %w", err)
+	}
+	return &Client{Token: auth.Token.AccessToken, Server: server}, nil
+}
+
+// APIError is an error response of the Marketing API.
+type APIError struct {
+	Status int    `json:"status"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	// Errors lists the invalid fields of a validation error.
+	Errors []struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("mailchimp: %s (%d)", e.Title, e.Status)
+	if e.Detail != "" {
+		msg = fmt.Sprintf("mailchimp: %s: %s", e.Title, e.Detail)
+	}
+	for _, fe := range e.Errors {
+		msg += fmt.Sprintf("; %s: %s", fe.Field, fe.Message)
+	}
+	return msg
+}
+
+// IsNotFound reports whether err is Mailchimp's answer for a missing
+// resource.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound
+}
+
+// Request calls an endpoint of the API, such as /campaigns, and decodes
+// the JSON response into out, which may be nil.
+func (c *Client) Request(method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, APIURL(c.Server)+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &APIError{}
+		if json.Unmarshal(data, apiErr) != nil || apiErr.Title == "" {
+			apiErr.Title = resp.Status
+		}
+		apiErr.Status = resp.StatusCode
+		return apiErr
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
+
+// Get reads an endpoint with query parameters.
+func (c *Client) Get(path string, query url.Values, out interface{}) error {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.Request(http.MethodGet, path, nil, out)
+}
+
+// SubscriberHash returns the ID of a list member, the MD5 hash of their
+// lowercased email address.
+func SubscriberHash(email string) string {
+	return getSubscriberHash(email)
+}
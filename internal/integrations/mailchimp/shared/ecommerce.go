@@ -0,0 +1,283 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// StorePath returns the path of a resource of an e-commerce store, such
+// as /ecommerce/stores/shop/carts/42.
+func StorePath(storeID string, parts ...string) string {
+	path := "/ecommerce/stores/" + url.PathEscape(storeID)
+	for _, part := range parts {
+		path += "/" + url.PathEscape(part)
+	}
+	return path
+}
+
+// SaveResource creates the resource with the ID in a collection, or
+// updates it when it exists, as orders, carts and stores can't be
+// replaced with a PUT. Fields named in createOnly are only sent on
+// create, for those that can't be changed later. It returns the
+// resource and whether it was created.
+func (c *Client) SaveResource(collection, id string, body map[string]interface{}, createOnly ...string) (map[string]interface{}, bool, error) {
+	if id == "" {
+		return nil, false, errors.New("ID is required")
+	}
+	var saved map[string]interface{}
+	err := c.Request(http.MethodGet, collection+"/"+url.PathEscape(id), nil, nil)
+	switch {
+	case IsNotFound(err):
+		body["id"] = id
+		err = c.Request(http.MethodPost, collection, body, &saved)
+		return saved, true, err
+	case err != nil:
+		return nil, false, err
+	}
+	delete(body, "id")
+	for _, field := range createOnly {
+		delete(body, field)
+	}
+	err = c.Request(http.MethodPatch, collection+"/"+url.PathEscape(id), body, &saved)
+	return saved, false, err
+}
+
+// PutResource creates or replaces the resource with the ID, as
+// customers and products can be.
+func (c *Client) PutResource(path string, body map[string]interface{}) (map[string]interface{}, error) {
+	var saved map[string]interface{}
+	if err := c.Request(http.MethodPut, path, body, &saved); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// Line is a line of a cart or an order.
+type Line struct {
+	ID               string  `json:"id"`
+	ProductID        string  `json:"product_id"`
+	ProductVariantID string  `json:"product_variant_id"`
+	Quantity         int     `json:"quantity"`
+	Price            float64 `json:"price"`
+	Discount         float64 `json:"discount,omitempty"`
+	// Title names the product when it has to be created.
+	Title string `json:"-"`
+}
+
+// ParseLines reads the lines of a cart or an order from a JSON array or
+// a list of objects. Line items of Shopify and WooCommerce are read as
+// they are: a variant_id or variation_id names the variant, which is
+// the product itself when there is none, and price is the unit price.
+func ParseLines(v interface{}) ([]Line, error) {
+	if s, ok := v.(string); ok {
+		if strings.TrimSpace(s) == "" {
+			return nil, errors.New("lines are required")
+		}
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return nil, fmt.Errorf("lines must be a JSON array: %w", err)
+		}
+	}
+	items, ok := v.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, errors.New("lines must be a non-empty list")
+	}
+
+	lines := make([]Line, 0, len(items))
+	for i, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("line %d is not an object", i+1)
+		}
+		line := Line{
+			ID:        idString(m["id"]),
+			ProductID: idString(m["product_id"]),
+			Quantity:  1,
+		}
+		if line.ProductID == "" {
+			return nil, fmt.Errorf("line %d has no product_id", i+1)
+		}
+		if line.ID == "" {
+			line.ID = strconv.Itoa(i + 1)
+		}
+		for _, key := range []string{"product_variant_id", "variant_id", "variation_id"} {
+			if id := idString(m[key]); id != "" && id != "0" {
+				line.ProductVariantID = id
+				break
+			}
+		}
+		if line.ProductVariantID == "" {
+			line.ProductVariantID = line.ProductID
+		}
+		if q, ok := number(m["quantity"]); ok {
+			line.Quantity = int(q)
+		}
+		if price, ok := number(m["price"]); ok {
+			line.Price = price
+		} else if total, ok := number(m["total"]); ok && line.Quantity > 0 {
+			line.Price = total / float64(line.Quantity)
+		}
+		for _, key := range []string{"discount", "total_discount"} {
+			if d, ok := number(m[key]); ok {
+				line.Discount = d
+				break
+			}
+		}
+		for _, key := range []string{"title", "name", "product_title"} {
+			if title, ok := m[key].(string); ok && title != "" {
+				line.Title = title
+				break
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// LinesTotal returns the total of lines after discounts.
+func LinesTotal(lines []Line) float64 {
+	total := 0.0
+	for _, l := range lines {
+		total += l.Price*float64(l.Quantity) - l.Discount
+	}
+	return total
+}
+
+// EnsureProducts creates the products of lines the store doesn't have,
+// named by the line title, with the line's variant at the line's price,
+// so carts and orders can reference them. Existing products are left as
+// they are. It returns the IDs of the products created.
+func (c *Client) EnsureProducts(storeID string, lines []Line) ([]string, error) {
+	created := []string{}
+	seen := map[string]bool{}
+	for _, l := range lines {
+		if seen[l.ProductID] {
+			continue
+		}
+		seen[l.ProductID] = true
+
+		err := c.Request(http.MethodGet, StorePath(storeID, "products", l.ProductID), nil, nil)
+		if err == nil {
+			continue
+		}
+		if !IsNotFound(err) {
+			return created, err
+		}
+
+		title := l.Title
+		if title == "" {
+			title = "Product " + l.ProductID
+		}
+		var variants []map[string]interface{}
+		variantSeen := map[string]bool{}
+		for _, v := range lines {
+			if v.ProductID == l.ProductID && !variantSeen[v.ProductVariantID] {
+				variantSeen[v.ProductVariantID] = true
+				variants = append(variants, map[string]interface{}{"id": v.ProductVariantID, "title": title, "price": v.Price})
+			}
+		}
+		_, err = c.PutResource(StorePath(storeID, "products", l.ProductID), map[string]interface{}{
+			"id":       l.ProductID,
+			"title":    title,
+			"variants": variants,
+		})
+		if err != nil {
+			return created, fmt.Errorf("creating product %s: %w", l.ProductID, err)
+		}
+		created = append(created, l.ProductID)
+	}
+	return created, nil
+}
+
+// ParseVariants reads the variants of a product from a JSON array,
+// keeping the fields Mailchimp takes. Variants of Shopify and
+// WooCommerce products are read as they are. With none given, the
+// product gets a single variant sharing its ID, title and price.
+func ParseVariants(raw, productID, title string, price *float64) ([]map[string]interface{}, error) {
+	if strings.TrimSpace(raw) == "" {
+		variant := map[string]interface{}{"id": productID, "title": title}
+		if price != nil {
+			variant["price"] = *price
+		}
+		return []map[string]interface{}{variant}, nil
+	}
+
+	var items []map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &items); err != nil {
+		return nil, fmt.Errorf("variants must be a JSON array: %w", err)
+	}
+	if len(items) == 0 {
+		return nil, errors.New("variants must not be empty")
+	}
+	variants := make([]map[string]interface{}, 0, len(items))
+	for i, item := range items {
+		id := idString(item["id"])
+		if id == "" {
+			return nil, fmt.Errorf("variant %d has no id", i+1)
+		}
+		variant := map[string]interface{}{"id": id, "title": title}
+		for _, key := range []string{"title", "name"} {
+			if s, ok := item[key].(string); ok && s != "" {
+				variant["title"] = s
+				break
+			}
+		}
+		for _, key := range []string{"sku", "url", "image_url"} {
+			if s, ok := item[key].(string); ok && s != "" {
+				variant[key] = s
+			}
+		}
+		if item["price"] != nil {
+			p, ok := number(item["price"])
+			if !ok {
+				return nil, fmt.Errorf("variant %d has an invalid price", i+1)
+			}
+			variant["price"] = p
+		}
+		for _, key := range []string{"inventory_quantity", "stock_quantity"} {
+			if q, ok := number(item[key]); ok {
+				variant["inventory_quantity"] = int(q)
+				break
+			}
+		}
+		variants = append(variants, variant)
+	}
+	return variants, nil
+}
+
+// idString formats an ID given as a string or a JSON number, which
+// Shopify and WooCommerce use for their IDs.
+func idString(v interface{}) string {
+	switch id := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(id)
+	case float64:
+		return strconv.FormatFloat(id, 'f', -1, 64)
+	case json.Number:
+		return id.String()
+	default:
+		return fmt.Sprint(id)
+	}
+}
+
+// number reads a number given as a JSON number or a string, as Shopify
+// gives prices.
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
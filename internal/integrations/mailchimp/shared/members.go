@@ -0,0 +1,84 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// memberRequest calls the member endpoint of a list for an email, and
+// returns the decoded member with the response status.
+func memberRequest(accessToken, server, listID, email, method string, payload interface{}) (map[string]interface{}, int, error) {
+	url := fmt.Sprintf("%s/lists/%s/members/%s", APIURL(server), listID, getSubscriberHash(email))
+
+	var reqBody io.Reader
+	if payload != nil {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+		reqBody = bytes.NewBuffer(body)
+	}
+
+	req, err := http.NewRequest(method, url, reqBody)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr struct {
+			Title  string `json:"title"`
+			Detail string `json:"detail"`
+		}
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Detail != "" {
+			return nil, resp.StatusCode, fmt.Errorf("mailchimp: %s: %s", apiErr.Title, apiErr.Detail)
+		}
+		return nil, resp.StatusCode, fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	var member map[string]interface{}
+	if err := json.Unmarshal(body, &member); err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("failed to decode member: %w", err)
+	}
+	return member, resp.StatusCode, nil
+}
+
+// GetMember returns the list member with the email address, or nil when
+// the address isn't on the list.
+func GetMember(accessToken, server, listID, email string) (map[string]interface{}, error) {
+	member, status, err := memberRequest(accessToken, server, listID, email, http.MethodGet, nil)
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	return member, err
+}
+
+// PutMember adds the email address to a list, or updates the member with
+// it. statusIfNew is the subscription status of a new member; an
+// existing member keeps theirs.
+func PutMember(accessToken, server, listID, email, statusIfNew string, mergeFields map[string]interface{}) (map[string]interface{}, error) {
+	payload := map[string]interface{}{
+		"email_address": email,
+		"status_if_new": statusIfNew,
+	}
+	if len(mergeFields) > 0 {
+		payload["merge_fields"] = mergeFields
+	}
+	member, _, err := memberRequest(accessToken, server, listID, email, http.MethodPut, payload)
+	return member, err
+}
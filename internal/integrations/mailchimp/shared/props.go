@@ -0,0 +1,161 @@
+package shared
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// CampaignTypeOptions lists the campaign types that can be created with
+// content.
+var CampaignTypeOptions = []*smartform.Option{
+	{Value: "regular", Label: "Regular"},
+	{Value: "plaintext", Label: "Plain text"},
+}
+
+// optionsFn lists the items of an endpoint for a select field. items
+// picks the value and label of each item of the decoded response.
+func optionsFn(path string, query url.Values, items func(resp map[string]interface{}) []map[string]any) *sdk.DynamicOptionsFn {
+	fn := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+		client, err := NewClient(authCtx)
+		if err != nil {
+			return nil, err
+		}
+		var resp map[string]interface{}
+		if err := client.Get(path, query, &resp); err != nil {
+			return nil, err
+		}
+		options := items(resp)
+		return ctx.Respond(options, len(options))
+	}
+	return &fn
+}
+
+// namedItems picks the id and a name field of the items under key.
+func namedItems(key, name string) func(map[string]interface{}) []map[string]any {
+	return func(resp map[string]interface{}) []map[string]any {
+		list, _ := resp[key].([]interface{})
+		options := make([]map[string]any, 0, len(list))
+		for _, item := range list {
+			m, _ := item.(map[string]interface{})
+			options = append(options, map[string]any{"id": fmt.Sprint(m["id"]), "name": fmt.Sprint(m[name])})
+		}
+		return options
+	}
+}
+
+func selectField(form *smartform.FormBuilder, id, label string, required bool, fn *sdk.DynamicOptionsFn) *smartform.FieldBuilder {
+	return form.SelectField(id, label).
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(fn)).
+				WithSearchSupport().
+				End().
+				RefreshOn("connection").
+				GetDynamicSource(),
+		)
+}
+
+// RegisterListProps adds an audience field, listed from the account.
+func RegisterListProps(form *smartform.FormBuilder, required bool) *smartform.FieldBuilder {
+	fn := optionsFn("/lists", url.Values{"count": {"1000"}, "fields": {"lists.id,lists.name"}}, namedItems("lists", "name"))
+	return selectField(form, "list_id", "Audience", required, fn).
+		HelpText("The audience, also called list.")
+}
+
+// RegisterCampaignProps adds a campaign field, listing the campaigns not
+// sent yet.
+func RegisterCampaignProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	fn := optionsFn("/campaigns", url.Values{
+		"count":      {"1000"},
+		"fields":     {"campaigns.id,campaigns.settings.title,campaigns.settings.subject_line,campaigns.status"},
+		"sort_field": {"create_time"},
+		"sort_dir":   {"DESC"},
+	}, func(resp map[string]interface{}) []map[string]any {
+		list, _ := resp["campaigns"].([]interface{})
+		options := make([]map[string]any, 0, len(list))
+		for _, item := range list {
+			m, _ := item.(map[string]interface{})
+			status, _ := m["status"].(string)
+			if status == "sent" || status == "sending" {
+				continue
+			}
+			settings, _ := m["settings"].(map[string]interface{})
+			name, _ := settings["title"].(string)
+			if name == "" {
+				name, _ = settings["subject_line"].(string)
+			}
+			options = append(options, map[string]any{"id": fmt.Sprint(m["id"]), "name": fmt.Sprintf("%s (%s)", name, status)})
+		}
+		return options
+	})
+	return selectField(form, "campaign_id", "Campaign", true, fn).
+		HelpText("A campaign that hasn't been sent.")
+}
+
+// RegisterTemplateProps adds a template field, listing the account's own
+// templates.
+func RegisterTemplateProps(form *smartform.FormBuilder, required bool) *smartform.FieldBuilder {
+	fn := optionsFn("/templates", url.Values{"count": {"1000"}, "type": {"user"}, "fields": {"templates.id,templates.name"}}, namedItems("templates", "name"))
+	return selectField(form, "template_id", "Template", required, fn)
+}
+
+// RegisterStoreProps adds an e-commerce store field.
+func RegisterStoreProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	fn := optionsFn("/ecommerce/stores", url.Values{"count": {"1000"}, "fields": {"stores.id,stores.name"}}, namedItems("stores", "name"))
+	return selectField(form, "store_id", "Store", true, fn).
+		HelpText("A store added with Create or Update Store.")
+}
+
+// RegisterSegmentProps adds a segment field, listing the segments of the
+// audience in the list_id field. With static, only static segments,
+// whose members are managed by hand, are listed.
+func RegisterSegmentProps(form *smartform.FormBuilder, static, required bool) *smartform.FieldBuilder {
+	getSegments := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		input := sdk.DynamicInputToType[struct {
+			ListID string `json:"list_id"`
+		}](ctx)
+		if input.ListID == "" {
+			return ctx.Respond([]map[string]any{}, 0)
+		}
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+		client, err := NewClient(authCtx)
+		if err != nil {
+			return nil, err
+		}
+		segments, err := client.ListSegments(input.ListID, static)
+		if err != nil {
+			return nil, err
+		}
+		options := make([]map[string]any, 0, len(segments))
+		for _, s := range segments {
+			options = append(options, map[string]any{"id": fmt.Sprint(s.ID), "name": s.Name})
+		}
+		return ctx.Respond(options, len(options))
+	}
+
+	return form.SelectField("segment_id", "Segment").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getSegments)).
+				WithSearchSupport().
+				End().
+				RefreshOn("list_id").
+				GetDynamicSource(),
+		)
+}
@@ -0,0 +1,93 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Segment is a saved or static segment of an audience.
+type Segment struct {
+	ID          int                    `json:"id"`
+	Name        string                 `json:"name"`
+	MemberCount int                    `json:"member_count"`
+	Type        string                 `json:"type"`
+	CreatedAt   string                 `json:"created_at"`
+	UpdatedAt   string                 `json:"updated_at"`
+	ListID      string                 `json:"list_id"`
+	Options     map[string]interface{} `json:"options,omitempty"`
+}
+
+// ListSegments returns the segments of an audience, only the static ones
+// with static.
+func (c *Client) ListSegments(listID string, static bool) ([]Segment, error) {
+	query := url.Values{"count": {"1000"}}
+	if static {
+		query.Set("type", "static")
+	}
+	var resp struct {
+		Segments []Segment `json:"segments"`
+	}
+	if err := c.Get("/lists/"+url.PathEscape(listID)+"/segments", query, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Segments == nil {
+		resp.Segments = []Segment{}
+	}
+	return resp.Segments, nil
+}
+
+// CreateSegment creates a static segment of the email addresses, or,
+// with conditions, a saved segment matching them.
+func (c *Client) CreateSegment(listID, name string, emails []string, match string, conditions []interface{}) (*Segment, error) {
+	body := map[string]interface{}{"name": name}
+	if len(conditions) > 0 {
+		body["options"] = map[string]interface{}{"match": match, "conditions": conditions}
+	} else {
+		if emails == nil {
+			emails = []string{}
+		}
+		body["static_segment"] = emails
+	}
+	var segment Segment
+	if err := c.Request(http.MethodPost, "/lists/"+url.PathEscape(listID)+"/segments", body, &segment); err != nil {
+		return nil, err
+	}
+	return &segment, nil
+}
+
+// SegmentMembersResult is the outcome of adding and removing members of
+// a static segment.
+type SegmentMembersResult struct {
+	TotalAdded   int                      `json:"total_added"`
+	TotalRemoved int                      `json:"total_removed"`
+	ErrorCount   int                      `json:"error_count"`
+	Errors       []map[string]interface{} `json:"errors"`
+}
+
+// UpdateSegmentMembers adds and removes members of a static segment by
+// email address. Addresses not on the audience are reported in the
+// errors of the result.
+func (c *Client) UpdateSegmentMembers(listID, segmentID string, add, remove []string) (*SegmentMembersResult, error) {
+	if add == nil {
+		add = []string{}
+	}
+	if remove == nil {
+		remove = []string{}
+	}
+	var result SegmentMembersResult
+	err := c.Request(http.MethodPost, fmt.Sprintf("/lists/%s/segments/%s", url.PathEscape(listID), url.PathEscape(segmentID)),
+		map[string]interface{}{"members_to_add": add, "members_to_remove": remove}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Errors == nil {
+		result.Errors = []map[string]interface{}{}
+	}
+	return &result, nil
+}
+
+// DeleteSegment deletes a segment. Its members stay on the audience.
+func (c *Client) DeleteSegment(listID, segmentID string) error {
+	return c.Request(http.MethodDelete, fmt.Sprintf("/lists/%s/segments/%s", url.PathEscape(listID), url.PathEscape(segmentID)), nil, nil)
+}
@@ -127,7 +127,7 @@ func GetMailChimpServerPrefix(accessToken string) (string, error) {
 //  }
 
 func AddContactToList(accessToken, server, listID, email, firstName, status, lastName string) error {
-	url := fmt.Sprintf("https://%s.api.mailchimp.com/3.0/lists/%s/members", server, listID)
+	url := fmt.Sprintf("%s/lists/%s/members", APIURL(server), listID)
 
 	payload := map[string]interface{}{
 		"email_address": email,
@@ -178,7 +178,7 @@ var MailchimpSubscriberStatus = []*smartform.Option{
 }
 
 func FetchMailchimpLists(accessToken, server string) (interface{}, error) {
-	url := fmt.Sprintf("https://%s.api.mailchimp.com/3.0/lists", server)
+	url := fmt.Sprintf("%s/lists", APIURL(server))
 
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
@@ -214,7 +214,7 @@ func FetchMailchimpLists(accessToken, server string) (interface{}, error) {
 func UpdateSubscriberStatus(accessToken, server, listID, email, status string) error {
 	subscriberHash := getSubscriberHash(email)
 
-	url := fmt.Sprintf("https://%s.api.mailchimp.com/3.0/lists/%s/members/%s", server, listID, subscriberHash)
+	url := fmt.Sprintf("%s/lists/%s/members/%s", APIURL(server), listID, subscriberHash)
 
 	payload := map[string]interface{}{
 		"status": status,
@@ -246,7 +246,7 @@ func UpdateSubscriberStatus(accessToken, server, listID, email, status string) e
 }
 
 func ListRecentSubscribers(accessToken, server, listID, date string) (interface{}, error) {
-	url := fmt.Sprintf("https://%s.api.mailchimp.com/3.0/lists/%s/members?since_timestamp_opt=%s", server, listID, date)
+	url := fmt.Sprintf("%s/lists/%s/members?since_timestamp_opt=%s", APIURL(server), listID, date)
 
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
@@ -279,7 +279,7 @@ func ListRecentSubscribers(accessToken, server, listID, date string) (interface{
 	return data, nil
 } // ListRecentSubscribers fetches and lists subscribers added or updated in the last 24 hours.
 func ListRecentUnSubscribers(accessToken, server, listID, date string) (interface{}, error) {
-	url := fmt.Sprintf("https://%s.api.mailchimp.com/3.0/lists/%s/members?unsubscribed_since=%s", server, listID, date)
+	url := fmt.Sprintf("%s/lists/%s/members?unsubscribed_since=%s", APIURL(server), listID, date)
 
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
@@ -315,7 +315,7 @@ func ListRecentUnSubscribers(accessToken, server, listID, date string) (interfac
 func AddMemberNote(accessToken, server, listID, email, note string) error {
 	subscriberHash := getSubscriberHash(email)
 
-	url := fmt.Sprintf("https://%s.api.mailchimp.com/3.0/lists/%s/members/%s/notes", server, listID, subscriberHash)
+	url := fmt.Sprintf("%s/lists/%s/members/%s/notes", APIURL(server), listID, subscriberHash)
 
 	payload := map[string]string{
 		"note": note,
@@ -350,7 +350,7 @@ func AddMemberNote(accessToken, server, listID, email, note string) error {
 func ModifySubscriberTags(accessToken, server, listID, email string, tags []string, status string) error {
 	subscriberHash := getSubscriberHash(email)
 
-	url := fmt.Sprintf("https://%s.api.mailchimp.com/3.0/lists/%s/members/%s/tags", server, listID, subscriberHash)
+	url := fmt.Sprintf("%s/lists/%s/members/%s/tags", APIURL(server), listID, subscriberHash)
 
 	tagEntries := make([]map[string]interface{}, 0, len(tags))
 
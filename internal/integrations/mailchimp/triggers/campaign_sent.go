@@ -0,0 +1,149 @@
+package triggers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/mailchimp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type campaignSentTriggerProps struct {
+	ListID      string `json:"list_id"`
+	DelayHours  int    `json:"delay_hours"`
+	WithReports bool   `json:"with_reports"`
+}
+
+type CampaignSentTrigger struct{}
+
+func (t *CampaignSentTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "campaign_sent",
+		DisplayName:   "Campaign Sent",
+		Description:   "Triggers once per campaign sent, optionally some hours later, with its report of opens, clicks, bounces and unsubscribes.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: campaignSentDocs,
+		SampleOutput:  campaignSentSample,
+	}
+}
+
+func (t *CampaignSentTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *CampaignSentTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("campaign_sent", "Campaign Sent")
+
+	shared.RegisterListProps(form, false).
+		HelpText("Only campaigns sent to this audience. Leave empty for all campaigns.")
+
+	form.NumberField("delay_hours", "Delay (hours)").
+		Required(false).
+		DefaultValue(0).
+		HelpText("Trigger this many hours after a campaign was sent, so its report has collected opens and clicks.")
+
+	form.CheckboxField("with_reports", "Include Report").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Add the campaign's report stats to the output.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the CampaignSentTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *CampaignSentTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the CampaignSentTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *CampaignSentTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the campaigns sent between the last run and now, both
+// shifted back by the delay, with their reports.
+func (t *CampaignSentTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[campaignSentTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.DelayHours < 0 {
+		return nil, fmt.Errorf("delay must not be negative, got %d hours", input.DelayHours)
+	}
+
+	client, err := shared.NewClient(ctx.Auth())
+	if err != nil {
+		return nil, err
+	}
+
+	delay := time.Duration(input.DelayHours) * time.Hour
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+	if lastRun := ctx.LastRun(); lastRun != nil {
+		since = *lastRun
+	}
+	campaigns, err := client.SentCampaigns(since.Add(-delay), now.Add(-delay))
+	if err != nil {
+		return nil, err
+	}
+
+	sent := make([]map[string]interface{}, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		if input.ListID != "" && campaignListID(campaign) != input.ListID {
+			continue
+		}
+		if input.WithReports {
+			id, _ := campaign["id"].(string)
+			report, err := client.GetReport(id)
+			if err != nil {
+				return nil, fmt.Errorf("report of campaign %s: %w", id, err)
+			}
+			campaign["report"] = report
+		}
+		sent = append(sent, campaign)
+	}
+	return sent, nil
+}
+
+func (t *CampaignSentTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *CampaignSentTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// campaignListID returns the audience a campaign was sent to.
+func campaignListID(campaign map[string]interface{}) string {
+	recipients, _ := campaign["recipients"].(map[string]interface{})
+	id, _ := recipients["list_id"].(string)
+	return id
+}
+
+var campaignSentSample = map[string]any{
+	"id":          "42694e9e57",
+	"type":        "regular",
+	"status":      "sent",
+	"send_time":   "2024-03-14T09:00:00+00:00",
+	"emails_sent": 1250,
+	"recipients":  map[string]any{"list_id": "57afe96172", "list_name": "Newsletter"},
+	"settings":    map[string]any{"subject_line": "Spring sale starts today", "title": "Spring Sale"},
+	"report": map[string]any{
+		"emails_sent":   1250,
+		"abuse_reports": 0,
+		"unsubscribed":  4,
+		"bounces":       map[string]any{"hard_bounces": 3, "soft_bounces": 7},
+		"opens":         map[string]any{"opens_total": 812, "unique_opens": 540, "open_rate": 0.434},
+		"clicks":        map[string]any{"clicks_total": 230, "unique_subscriber_clicks": 151, "click_rate": 0.121},
+	},
+}
+
+func NewCampaignSentTrigger() sdk.Trigger {
+	return &CampaignSentTrigger{}
+}
@@ -7,3 +7,6 @@ var newSubscriberDocs string
 
 //go:embed unsubscriber.md
 var unsubscriberDocs string
+
+//go:embed campaign_sent.md
+var campaignSentDocs string
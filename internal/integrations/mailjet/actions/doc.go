@@ -13,3 +13,6 @@ var createContactDocs string
 
 //go:embed list_contacts.md
 var contactListDocs string
+
+//go:embed upsert_contact.md
+var upsertContactDocs string
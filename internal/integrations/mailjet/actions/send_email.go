@@ -1,34 +1,22 @@
 package actions
 
 import (
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
-
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/inputs"
 	"github.com/wakflo/extensions/internal/integrations/mailjet/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	sdkcore "github.com/wakflo/go-sdk/v2/core"
 )
 
-type sendEmailActionProps struct {
-	FromEmail      string `json:"from_email"`
-	FromName       string `json:"from_name"`
-	ToEmail        string `json:"to_email"`
-	ToName         string `json:"to_name"`
-	Subject        string `json:"subject"`
-	TextPart       string `json:"text_part"`
-	HTMLPart       string `json:"html_part"`
-	CC             string `json:"cc,omitempty"`
-	BCC            string `json:"bcc,omitempty"`
-	TemplateID     int    `json:"template_id,omitempty"`
-	TemplateVars   string `json:"template_vars,omitempty"`
-	TrackOpens     bool   `json:"track_opens"`
-	TrackClicks    bool   `json:"track_clicks"`
-	CustomID       string `json:"custom_id,omitempty"`
-	CustomCampaign string `json:"custom_campaign,omitempty"`
+// legacySendEmailFields maps the fields this action took before it used
+// the shared email fields.
+var legacySendEmailFields = map[string]string{
+	"text_part":     "text",
+	"html_part":     "html",
+	"template_vars": "variables",
+	"custom_id":     "tags",
 }
 
 type SendEmailAction struct{}
@@ -41,8 +29,17 @@ func (a *SendEmailAction) Metadata() sdk.ActionMetadata {
 		Type:          sdkcore.ActionTypeAction,
 		Documentation: sendEmailDocs,
 		SampleOutput: map[string]any{
-			"MessageID": "123456",
-			"Status":    "success",
+			"provider":   "mailjet",
+			"message_id": "1152921520212115678",
+			"accepted":   []string{"jane.doe@example.com"},
+			"sent":       true,
+			"Messages": []map[string]any{{
+				"Status": "success",
+				"To": []map[string]any{{
+					"Email":     "jane.doe@example.com",
+					"MessageID": 1152921520212115678,
+				}},
+			}},
 		},
 		Settings: sdkcore.ActionSettings{},
 	}
@@ -51,55 +48,9 @@ func (a *SendEmailAction) Metadata() sdk.ActionMetadata {
 func (a *SendEmailAction) Properties() *smartform.FormSchema {
 	form := smartform.NewForm("send_email", "Send Email")
 
-	form.TextField("from_email", "From Email").
-		Placeholder("Enter From Email").
-		Required(true).
-		HelpText("The sender's email address")
-
-	form.TextField("from_name", "From Name").
-		Placeholder("Enter From Name").
-		Required(true).
-		HelpText("The sender's name")
-
-	form.TextField("to_email", "To Email").
-		Placeholder("Enter To Email").
-		Required(true).
-		HelpText("The recipient's email address")
-
-	form.TextField("to_name", "To Name").
-		Placeholder("Enter To Name").
-		Required(false).
-		HelpText("The recipient's name")
-
-	form.TextField("subject", "Subject").
-		Placeholder("Enter Subject").
-		Required(true).
-		HelpText("The email subject line")
-
-	form.TextareaField("text_part", "Text Content").
-		Placeholder("Enter Text Content").
-		Required(false).
-		HelpText("The plain text content of the email")
-
-	form.TextareaField("html_part", "HTML Content").
-		Placeholder("Enter HTML Content").
-		Required(false).
-		HelpText("The HTML content of the email")
-
-	form.TextField("cc", "CC").
-		Placeholder("Enter CC").
-		Required(false).
-		HelpText("Carbon copy recipients")
-
-	form.TextField("bcc", "BCC").
-		Placeholder("Enter BCC").
-		Required(false).
-		HelpText("Blind carbon copy recipients")
-
-	form.NumberField("template_id", "Template ID").
-		Placeholder("Enter Template ID").
-		Required(false).
-		HelpText("ID of the template to use (if any)")
+	email.RegisterSenderProps(form, true)
+	email.RegisterMessageProps(form)
+	email.RegisterTemplateProps(form, true)
 
 	schema := form.Build()
 
@@ -107,12 +58,21 @@ func (a *SendEmailAction) Properties() *smartform.FormSchema {
 }
 
 func (a *SendEmailAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
-	input, err := sdk.InputToTypeSafely[sendEmailActionProps](ctx)
+	input := inputs.Rename(ctx.Input(), legacySendEmailFields)
+
+	// The recipient used to be one address and name.
+	if to, _ := input["to"].(string); to == "" {
+		if toEmail, _ := input["to_email"].(string); toEmail != "" {
+			toName, _ := input["to_name"].(string)
+			input["to"] = email.Address{Name: toName, Email: toEmail}.String()
+		}
+	}
+
+	msg, err := email.MessageFrom(ctx.Context(), ctx.Files(), input)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the auth context
 	authCtx, err := ctx.AuthContext()
 	if err != nil {
 		return nil, err
@@ -123,98 +83,13 @@ func (a *SendEmailAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON,
 		return nil, err
 	}
 
-	if input.TextPart == "" && input.HTMLPart == "" && input.TemplateID <= 0 {
-		return nil, fmt.Errorf("at least one of Text Content, HTML Content, or Template ID must be provided")
-	}
-
-	message := map[string]interface{}{
-		"From": map[string]interface{}{
-			"Email": input.FromEmail,
-			"Name":  input.FromName,
-		},
-		"To": []map[string]interface{}{
-			{
-				"Email": input.ToEmail,
-				"Name":  input.ToName,
-			},
-		},
-		"Subject": input.Subject,
-	}
-
-	// Add content parts if provided
-	if input.TextPart != "" {
-		message["TextPart"] = input.TextPart
-	}
-
-	if input.HTMLPart != "" {
-		message["HTMLPart"] = input.HTMLPart
-	}
-
-	// Add CC recipients if specified - FIXED
-	if input.CC != "" {
-		// Split by commas if multiple emails are provided
-		emails := strings.Split(input.CC, ",")
-		cc := make([]map[string]interface{}, 0, len(emails))
-
-		for _, email := range emails {
-			email = strings.TrimSpace(email)
-			if email != "" {
-				cc = append(cc, map[string]interface{}{
-					"Email": email,
-				})
-			}
-		}
-
-		if len(cc) > 0 {
-			message["Cc"] = cc
-		}
-	}
-
-	// Add BCC recipients if specified - FIXED
-	if input.BCC != "" {
-		// Split by commas if multiple emails are provided
-		emails := strings.Split(input.BCC, ",")
-		bcc := make([]map[string]interface{}, 0, len(emails))
-
-		for _, email := range emails {
-			email = strings.TrimSpace(email)
-			if email != "" {
-				bcc = append(bcc, map[string]interface{}{
-					"Email": email,
-				})
-			}
-		}
-
-		if len(bcc) > 0 {
-			message["Bcc"] = bcc
-		}
-	}
-
-	// Add template if specified
-	if input.TemplateID > 0 {
-		message["TemplateID"] = input.TemplateID
-		message["TemplateLanguage"] = true
-
-		if input.TemplateVars != "" {
-			var templateVars map[string]interface{}
-			if err := json.Unmarshal([]byte(input.TemplateVars), &templateVars); err != nil {
-				return nil, fmt.Errorf("invalid template variables JSON: %v", err)
-			}
-			message["Variables"] = templateVars
-		}
-	}
-
-	payload := map[string]interface{}{
-		"Messages": []map[string]interface{}{message},
-	}
-
-	var result map[string]interface{}
-	err = client.Request(http.MethodPost, "/v3.1/send", payload, &result)
+	sender := &shared.Sender{Client: client}
+	result, err := sender.Send(ctx.Context(), msg)
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	return result.Output(), nil
 }
 
 func (a *SendEmailAction) Auth() *sdkcore.AuthMetadata {
@@ -0,0 +1,123 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/mailjet/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// contactFieldMap names the MailJet contact properties of the standard
+// contact fields. firstname and name are the properties every MailJet
+// account starts with; phone and company need custom properties.
+var contactFieldMap = crm.FieldMap{
+	crm.FieldEmail:     "Email",
+	crm.FieldFirstName: "firstname",
+	crm.FieldLastName:  "name",
+}
+
+type UpsertContactAction struct{}
+
+func (a *UpsertContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_contact",
+		DisplayName:   "Create or Update Contact",
+		Description:   "Find a MailJet contact by email and update it, or create it when there is none.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertContactDocs,
+		SampleOutput: crm.SampleOutput(map[string]any{
+			"ID":    51,
+			"Email": "jane.doe@example.com",
+			"Name":  "Jane Doe",
+			"Properties": []map[string]any{
+				{"Name": "firstname", "Value": "Jane"},
+				{"Name": "name", "Value": "Doe"},
+			},
+		}),
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_contact", "Create or Update Contact")
+
+	crm.RegisterContactProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Perform writes the contact with its full name, then its properties.
+// Custom fields are MailJet contact properties by name.
+func (a *UpsertContactAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	contact, err := crm.ContactFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.GetMailJetClient(authCtx.Extra["api_key"], authCtx.Extra["secret_key"])
+	if err != nil {
+		return nil, err
+	}
+
+	properties, ignored := contactFieldMap.Map(contact)
+	delete(properties, "Email")
+	if len(contact.Tags) > 0 {
+		ignored = append(ignored, crm.FieldTags)
+	}
+
+	existing, err := client.FindContact(contact.Email)
+	if err != nil {
+		return nil, err
+	}
+	id := ""
+	if existing != nil {
+		id = fmt.Sprint(existing["ID"])
+	}
+
+	// MailJet has no upsert, so a run that lost the race to create the
+	// contact updates the one the other run created.
+	saved, err := client.SaveContact(id, contact.Email, contact.Name())
+	if existing == nil && shared.IsDuplicate(err) {
+		if existing, _ = client.FindContact(contact.Email); existing != nil {
+			saved, err = client.SaveContact(fmt.Sprint(existing["ID"]), contact.Email, contact.Name())
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	id = fmt.Sprint(saved["ID"])
+
+	if len(properties) > 0 {
+		data, err := client.SetContactData(id, properties)
+		if err != nil {
+			return nil, fmt.Errorf("setting contact properties: %w", err)
+		}
+		saved["Properties"] = data
+	}
+
+	return crm.Result{
+		ID:      id,
+		Created: existing == nil,
+		Contact: saved,
+		Ignored: ignored,
+	}.Output(), nil
+}
+
+func (a *UpsertContactAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpsertContactAction() sdk.Action {
+	return &UpsertContactAction{}
+}
@@ -35,6 +35,7 @@ func (m *MailJet) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewEmailSentTrigger(),
 		triggers.NewContactUpdatedTrigger(),
+		triggers.NewEmailEventTrigger(),
 	}
 }
 
@@ -44,6 +45,7 @@ func (m *MailJet) Actions() []sdk.Action {
 		actions.NewGetContactAction(),
 		actions.NewCreateContactAction(),
 		actions.NewListContactsAction(),
+		actions.NewUpsertContactAction(),
 	}
 }
 
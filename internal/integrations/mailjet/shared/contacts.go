@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// FindContact returns the contact with the email address, or nil when
+// there is none.
+func (c *Client) FindContact(email string) (map[string]interface{}, error) {
+	var result struct {
+		Data []map[string]interface{} `json:"Data"`
+	}
+	err := c.Request(http.MethodGet, "/v3/REST/contact/"+url.PathEscape(email), nil, &result)
+	if IsNotFound(err) || (err == nil && len(result.Data) == 0) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.Data[0], nil
+}
+
+// SaveContact creates a contact, or renames the contact with the ID, and
+// returns it.
+func (c *Client) SaveContact(id, email, name string) (map[string]interface{}, error) {
+	var result struct {
+		Data []map[string]interface{} `json:"Data"`
+	}
+	var err error
+	if id == "" {
+		payload := map[string]interface{}{"Email": email}
+		if name != "" {
+			payload["Name"] = name
+		}
+		err = c.Request(http.MethodPost, "/v3/REST/contact", payload, &result)
+	} else if name != "" {
+		err = c.Request(http.MethodPut, "/v3/REST/contact/"+url.PathEscape(id), map[string]interface{}{"Name": name}, &result)
+	} else {
+		err = c.Request(http.MethodGet, "/v3/REST/contact/"+url.PathEscape(id), nil, &result)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Data) == 0 {
+		return nil, errors.New("no contact in the MailJet response")
+	}
+	return result.Data[0], nil
+}
+
+// SetContactData sets contact properties, which must exist in the
+// account, and returns the contact's properties.
+func (c *Client) SetContactData(id string, properties map[string]interface{}) ([]interface{}, error) {
+	data := make([]map[string]interface{}, 0, len(properties))
+	for name, value := range properties {
+		data = append(data, map[string]interface{}{"Name": name, "Value": value})
+	}
+
+	var result struct {
+		Data []struct {
+			Data []interface{} `json:"Data"`
+		} `json:"Data"`
+	}
+	if err := c.Request(http.MethodPut, "/v3/REST/contactdata/"+url.PathEscape(id), map[string]interface{}{"Data": data}, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Data) == 0 {
+		return []interface{}{}, nil
+	}
+	return result.Data[0].Data, nil
+}
@@ -0,0 +1,166 @@
+package shared
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/wakflo/extensions/internal/email"
+)
+
+// Sender sends messages through the Mailjet send API.
+type Sender struct {
+	Client *Client
+}
+
+var _ email.Sender = (*Sender)(nil)
+
+// Send sends a message. Stored templates are rendered by Mailjet with the
+// variables in its template language; the tags are sent as the custom ID
+// that Mailjet reports back with delivery events.
+func (s *Sender) Send(_ context.Context, msg *email.Message) (*email.Result, error) {
+	msg.ApplyVariables()
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	message, err := sendPayload(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Messages []struct {
+			Status string `json:"Status"`
+			Errors []struct {
+				ErrorMessage   string   `json:"ErrorMessage"`
+				ErrorRelatedTo []string `json:"ErrorRelatedTo"`
+			} `json:"Errors"`
+			To []struct {
+				Email     string      `json:"Email"`
+				MessageID json.Number `json:"MessageID"`
+			} `json:"To"`
+		} `json:"Messages"`
+	}
+	payload := map[string]interface{}{"Messages": []map[string]interface{}{message}}
+	var body json.RawMessage
+	if err := s.Client.Request(http.MethodPost, "/v3.1/send", payload, &body); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Messages) == 0 {
+		return nil, errors.New("mailjet returned no message status")
+	}
+
+	sent := resp.Messages[0]
+	if sent.Status != "success" {
+		var problems []string
+		for _, e := range sent.Errors {
+			problem := e.ErrorMessage
+			if len(e.ErrorRelatedTo) > 0 {
+				problem = strings.Join(e.ErrorRelatedTo, ", ") + ": " + problem
+			}
+			problems = append(problems, problem)
+		}
+		return nil, fmt.Errorf("mailjet did not send the message: %s", strings.Join(problems, "; "))
+	}
+
+	result := &email.Result{Provider: "mailjet"}
+	_ = json.Unmarshal(body, &result.Raw)
+	for _, to := range sent.To {
+		if result.MessageID == "" {
+			result.MessageID = to.MessageID.String()
+		}
+		result.Accepted = append(result.Accepted, to.Email)
+	}
+	return result, nil
+}
+
+func sendPayload(msg *email.Message) (map[string]interface{}, error) {
+	message := map[string]interface{}{
+		"From": address(msg.From),
+		"To":   addresses(msg.To),
+	}
+	if msg.Subject != "" {
+		message["Subject"] = msg.Subject
+	}
+	if msg.Text != "" {
+		message["TextPart"] = msg.Text
+	}
+	if msg.HTML != "" {
+		message["HTMLPart"] = msg.HTML
+	}
+	if len(msg.CC) > 0 {
+		message["Cc"] = addresses(msg.CC)
+	}
+	if len(msg.BCC) > 0 {
+		message["Bcc"] = addresses(msg.BCC)
+	}
+	// Mailjet takes a single reply-to address.
+	if len(msg.ReplyTo) > 0 {
+		message["ReplyTo"] = address(msg.ReplyTo[0])
+	}
+	if len(msg.Headers) > 0 {
+		message["Headers"] = msg.Headers
+	}
+	if len(msg.Tags) > 0 {
+		message["CustomID"] = strings.Join(msg.Tags, ",")
+	}
+
+	if msg.TemplateID != "" {
+		id, err := strconv.Atoi(msg.TemplateID)
+		if err != nil {
+			return nil, fmt.Errorf("mailjet template IDs are numbers, got %q", msg.TemplateID)
+		}
+		message["TemplateID"] = id
+		message["TemplateLanguage"] = true
+		if len(msg.Variables) > 0 {
+			message["Variables"] = msg.Variables
+		}
+	}
+
+	var attachments, inlined []map[string]interface{}
+	for _, a := range msg.Attachments {
+		item := map[string]interface{}{
+			"ContentType":   a.ContentType,
+			"Filename":      a.Filename,
+			"Base64Content": base64.StdEncoding.EncodeToString(a.Content),
+		}
+		if a.ContentID != "" {
+			item["ContentID"] = a.ContentID
+			inlined = append(inlined, item)
+			continue
+		}
+		attachments = append(attachments, item)
+	}
+	if len(attachments) > 0 {
+		message["Attachments"] = attachments
+	}
+	if len(inlined) > 0 {
+		message["InlinedAttachments"] = inlined
+	}
+	return message, nil
+}
+
+func address(a email.Address) map[string]interface{} {
+	m := map[string]interface{}{"Email": a.Email}
+	if a.Name != "" {
+		m["Name"] = a.Name
+	}
+	return m
+}
+
+func addresses(list []email.Address) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(list))
+	for _, a := range list {
+		out = append(out, address(a))
+	}
+	return out
+}
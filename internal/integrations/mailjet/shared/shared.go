@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/juicycleff/smartform/v1"
 	"github.com/wakflo/go-sdk/v2"
@@ -53,6 +54,31 @@ func NewClient(apiKey, secretKey string) *Client {
 	}
 }
 
+// APIError is an error response of the MailJet API.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+// IsNotFound reports whether err is MailJet's answer for a missing
+// resource.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsDuplicate reports whether err is MailJet's answer for creating a
+// resource that already exists, such as a contact another run created.
+func IsDuplicate(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(apiErr.Message), "already exists")
+}
+
 func (c *Client) Request(method, path string, payload interface{}, result interface{}) error {
 	url := BaseURL + path
 
@@ -88,14 +114,14 @@ func (c *Client) Request(method, path string, payload interface{}, result interf
 	if resp.StatusCode >= errCode {
 		var errResp map[string]interface{}
 		if err := json.Unmarshal(body, &errResp); err != nil {
-			return fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+			return &APIError{StatusCode: resp.StatusCode, Message: fmt.Sprintf("API error: %d - %s", resp.StatusCode, string(body))}
 		}
 
 		errMessage := fmt.Sprintf("API error: %d", resp.StatusCode)
 		if errObj, ok := errResp["ErrorMessage"]; ok {
 			errMessage = fmt.Sprintf("%s - %v", errMessage, errObj)
 		}
-		return errors.New(errMessage)
+		return &APIError{StatusCode: resp.StatusCode, Message: errMessage}
 	}
 
 	if result != nil {
@@ -0,0 +1,96 @@
+package shared
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/wakflo/extensions/internal/webhook"
+)
+
+// webhookUser is the user name of the credentials in event callback URLs.
+const webhookUser = "wakflo"
+
+// ClientFromConfig returns a client for the connection in a trigger
+// config.
+func ClientFromConfig(config map[string]interface{}) (*Client, error) {
+	values := webhook.Connection(config)
+	return GetMailJetClient(values["api_key"], values["secret_key"])
+}
+
+// WebhookPassword derives the password Mailjet sends with a trigger's
+// event callbacks from the secret key, so deliveries can be checked
+// without storing it.
+func (c *Client) WebhookPassword(triggerID string) string {
+	mac := hmac.New(sha256.New, []byte(c.secretKey))
+	mac.Write([]byte("wakflo-webhook:" + triggerID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// CallbackURL returns endpoint with the trigger's credentials, which
+// Mailjet sends back as basic auth.
+func (c *Client) CallbackURL(endpoint, triggerID string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid webhook endpoint: %w", err)
+	}
+	u.User = url.UserPassword(webhookUser, c.WebhookPassword(triggerID))
+	return u.String(), nil
+}
+
+// VerifyDelivery checks the basic auth credentials of an event callback.
+func (c *Client) VerifyDelivery(triggerID string, delivery webhook.Delivery) error {
+	req := http.Request{Header: http.Header{}}
+	req.Header.Set("Authorization", delivery.Header("Authorization"))
+	user, password, ok := req.BasicAuth()
+	if !ok {
+		return errors.New("mailjet event callback has no credentials")
+	}
+	expected := c.WebhookPassword(triggerID)
+	if user != webhookUser || subtle.ConstantTimeCompare([]byte(password), []byte(expected)) != 1 {
+		return errors.New("invalid mailjet event callback credentials")
+	}
+	return nil
+}
+
+// CreateEventCallback sends the events of a type, such as open, to
+// callbackURL and returns the ID of the callback. Mailjet keeps one
+// callback URL per event type.
+func (c *Client) CreateEventCallback(eventType, callbackURL string) (string, error) {
+	var resp struct {
+		Data []struct {
+			ID interface{} `json:"ID"`
+		} `json:"Data"`
+	}
+	body := map[string]interface{}{
+		"EventType": eventType,
+		"Url":       callbackURL,
+		"Status":    "alive",
+		"Version":   2,
+	}
+	if err := c.Request(http.MethodPost, "/v3/REST/eventcallbackurl", body, &resp); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+			return "", fmt.Errorf("mailjet already has a callback URL for %s events; remove it under Account settings > Event notifications: %w", eventType, err)
+		}
+		return "", err
+	}
+	if len(resp.Data) == 0 {
+		return "", errors.New("mailjet returned no event callback")
+	}
+	return fmt.Sprint(resp.Data[0].ID), nil
+}
+
+// DeleteEventCallback removes an event callback.
+func (c *Client) DeleteEventCallback(id string) error {
+	err := c.Request(http.MethodDelete, "/v3/REST/eventcallbackurl/"+url.PathEscape(id), nil, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
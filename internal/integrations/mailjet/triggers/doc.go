@@ -7,3 +7,6 @@ var emailSentDocs string
 
 //go:embed contact_updated.md
 var contactUpdatedDocs string
+
+//go:embed email_event.md
+var emailEventDocs string
@@ -0,0 +1,167 @@
+package triggers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/integrations/mailjet/shared"
+	"github.com/wakflo/extensions/internal/webhook"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+const callbackIDsKey = "eventCallbackIds"
+
+// EmailEventTrigger receives Mailjet event callbacks as delivery events
+// in the shape every email provider's event trigger uses.
+type EmailEventTrigger struct{}
+
+func (t *EmailEventTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "email_event",
+		DisplayName:   "Email Event (Instant)",
+		Description:   "Triggered instantly when Mailjet reports that an email was delivered, bounced, opened, clicked or marked as spam.",
+		Type:          sdkcore.TriggerTypeWebhook,
+		Documentation: emailEventDocs,
+		SampleOutput: []map[string]any{
+			{
+				"provider":   "mailjet",
+				"type":       "bounced",
+				"email":      "jane.doe@example.com",
+				"message_id": "1152921520212115678",
+				"timestamp":  "2025-03-12T18:20:00Z",
+				"reason":     "recipient: user unknown",
+				"permanent":  true,
+				"tags":       []string{"welcome"},
+				"raw": map[string]any{
+					"event":            "bounce",
+					"time":             1741803600,
+					"MessageID":        1152921520212115678,
+					"email":            "jane.doe@example.com",
+					"CustomID":         "welcome",
+					"hard_bounce":      true,
+					"error_related_to": "recipient",
+					"error":            "user unknown",
+				},
+			},
+		},
+	}
+}
+
+func (t *EmailEventTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypeWebhook
+}
+
+func (t *EmailEventTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("email_event", "Email Event (Instant)")
+
+	email.RegisterEventTypeProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start registers the trigger's URL as the event callback of each event
+// type, with credentials derived from the secret key.
+func (t *EmailEventTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	client, err := shared.ClientFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+
+	criteria, err := ctx.TriggerCriteria()
+	if err != nil {
+		return err
+	}
+	if criteria == nil || criteria.Webhook == nil || criteria.Webhook.Endpoint == "" {
+		return errors.New("the trigger has no webhook endpoint")
+	}
+	callbackURL, err := client.CallbackURL(criteria.Webhook.Endpoint, ctx.TriggerID())
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, eventType := range email.MailjetEventNames(email.EventTypesFrom(ctx.Input())) {
+		id, err := client.CreateEventCallback(eventType, callbackURL)
+		if err != nil {
+			// Don't leave the callbacks already made behind.
+			for _, created := range ids {
+				_ = client.DeleteEventCallback(created)
+			}
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	return ctx.StoreMetadata(callbackIDsKey, strings.Join(ids, ","))
+}
+
+// Stop removes the event callbacks registered by Start.
+func (t *EmailEventTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	stored, err := ctx.GetMetadata(callbackIDsKey)
+	if err != nil || stored == nil {
+		return err
+	}
+	ids, _ := stored.(string)
+
+	client, err := shared.ClientFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+	for _, id := range strings.Split(ids, ",") {
+		if id == "" {
+			continue
+		}
+		if err := client.DeleteEventCallback(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *EmailEventTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	delivery, err := webhook.DeliveryFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.GetMailJetClient(authCtx.Extra["api_key"], authCtx.Extra["secret_key"])
+	if err != nil {
+		return nil, err
+	}
+	if err := client.VerifyDelivery(ctx.TriggerID(), delivery); err != nil {
+		return nil, err
+	}
+
+	events, err := email.ParseMailjetEvents(delivery.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return email.EventsOutput(email.FilterEvents(events, email.EventTypesFrom(ctx.Input()))), nil
+}
+
+func (t *EmailEventTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	webhook := sdkcore.NewWebhookTriggerCriteria()
+	webhook.Enabled = true
+	webhook.ContentType = "application/json"
+	return sdkcore.TriggerCriteria{Webhook: webhook}
+}
+
+func (t *EmailEventTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewEmailEventTrigger() sdk.Trigger {
+	return &EmailEventTrigger{}
+}
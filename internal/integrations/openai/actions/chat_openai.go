@@ -6,6 +6,8 @@ import (
 	"io"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/prompt"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -50,6 +52,23 @@ func (a *ChatOpenAIAction) Metadata() sdk.ActionMetadata {
 			"response_format": "text",
 			"max_tokens":      "500",
 			"gpt_answer":      "The Oscar for Best Actor at the 82nd Academy Awards, held in 2010, was won by Jeff Bridges for his role as Otis \"Bad\" Blake in the film \"Crazy Heart.\"",
+			"usage": map[string]any{
+				"input_tokens":  18,
+				"output_tokens": 42,
+				"cached_tokens": 0,
+				"total_tokens":  60,
+				"estimated":     false,
+				"provider":      "openai",
+				"model":         "gpt-4o-mini",
+				"cost": map[string]any{
+					"input":    0.000003,
+					"output":   0.000025,
+					"cached":   0,
+					"total":    0.000028,
+					"currency": "USD",
+					"priced":   true,
+				},
+			},
 		},
 		Settings: core.ActionSettings{},
 	}
@@ -65,7 +84,7 @@ func (a *ChatOpenAIAction) Properties() *smartform.FormSchema {
 			return nil, err
 		}
 
-		models, err := getModels(authCtx.Extra["token"], "gpt")
+		models, err := getModels(newOpenAIConnection(authCtx.Extra), "gpt")
 		if err != nil {
 			return nil, err
 		}
@@ -99,8 +118,10 @@ func (a *ChatOpenAIAction) Properties() *smartform.FormSchema {
 		HelpText("Optional system message to set the behavior of the assistant. This helps guide the AI's responses and behavior.")
 
 	form.TextareaField("prompt", "Prompt").
-		Required(true).
-		HelpText("What would you like to ask ChatGPT?")
+		Required(false).
+		HelpText("What would you like to ask ChatGPT? Required unless a Prompt Template is set.")
+
+	prompt.RegisterTemplateProps(form)
 
 	form.SelectField("response_format", "Response Format").
 		Required(false).
@@ -134,6 +155,8 @@ func (a *ChatOpenAIAction) Properties() *smartform.FormSchema {
 		Required(false).
 		HelpText("An alternative to temperature that considers the probability of the tokens appearing. Lower values consider low probabilities (0 to 2). It's advised to only use this or temperature and not both.")
 
+	aiusage.RegisterBudgetProps(form)
+
 	schema := form.Build()
 
 	return schema
@@ -154,13 +177,28 @@ func (a *ChatOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, er
 		return nil, err
 	}
 
+	rendered, err := prompt.FromInput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rendered != nil {
+		input.Prompt = rendered.Prompt
+		if rendered.System != "" {
+			input.SystemPrompt = rendered.System
+		}
+	}
+
 	if err := validateInput(input); err != nil {
 		return nil, err
 	}
 
+	if err := aiusage.Check(ctx, input.Model, maxOutputTokens(input.MaxTokens), input.SystemPrompt, input.Prompt); err != nil {
+		return nil, err
+	}
+
 	requestBody := buildRequestBody(input)
 
-	client, err := getOpenAiClient(authCtx.Extra["token"])
+	client, err := getOpenAiClient(newOpenAIConnection(authCtx.Extra), input.Model)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +237,7 @@ func (a *ChatOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, er
 		"model":          input.Model,
 		"model_settings": requestBody,
 		"gpt_answer":     chatCompletion.Choices[0].Message.Content,
+		"usage":          aiusage.Finish(ctx, "openai", input.Model, chatCompletion.Usage.Normalized()),
 	}
 
 	// Include system prompt if it was provided
@@ -206,6 +245,10 @@ func (a *ChatOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, er
 		response["system_prompt"] = input.SystemPrompt
 	}
 
+	if rendered != nil {
+		response["prompt_template"] = rendered.Output()
+	}
+
 	// Include response format if it was specified
 	if input.ResponseFormat != "" {
 		response["response_format"] = input.ResponseFormat
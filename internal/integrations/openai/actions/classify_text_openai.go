@@ -0,0 +1,146 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiguard"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type classifyTextActionProps struct {
+	Model        string  `json:"model"`
+	Text         string  `json:"text"`
+	Labels       string  `json:"labels"`
+	MultiLabel   bool    `json:"multi_label"`
+	Examples     string  `json:"examples"`
+	Instructions string  `json:"instructions"`
+	Threshold    float64 `json:"threshold"`
+}
+
+type ClassifyTextAction struct{}
+
+func (a *ClassifyTextAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "classify_text_openai",
+		DisplayName:   "Classify Text",
+		Description:   "Sort text into your own labels, with optional examples, and get a confidence score for each label.",
+		Type:          core.ActionTypeAction,
+		Documentation: classifyTextOpenAIDocs,
+		SampleOutput: map[string]any{
+			"label":      "Billing",
+			"confidence": 0.91,
+			"scores": []map[string]any{
+				{"label": "Billing", "confidence": 0.91},
+				{"label": "Bug Report", "confidence": 0.06},
+				{"label": "Feature Request", "confidence": 0.03},
+			},
+			"reasoning": "The customer asks about a duplicate charge.",
+			"model":     "gpt-4o-mini",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *ClassifyTextAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("classify_text_openai", "Classify Text")
+
+	registerChatModelProps(form)
+
+	form.TextareaField("text", "Text").
+		Placeholder("Paste the text to classify...").
+		HelpText("The text to classify").
+		Required(true)
+
+	form.TextareaField("labels", "Labels").
+		Placeholder("Billing, Bug Report, Feature Request").
+		HelpText("The labels to choose from, separated by commas or new lines").
+		Required(true)
+
+	form.CheckboxField("multi_label", "Allow Multiple Labels").
+		DefaultValue(false).
+		HelpText("Score every label independently and return all labels above the threshold").
+		Required(false)
+
+	form.TextareaField("examples", "Examples").
+		HelpText(`Optional few-shot examples. Format: Example text ||LABEL|| Label. Separate multiple examples with ||EXAMPLE||`).
+		Required(false)
+
+	form.TextareaField("instructions", "Instructions").
+		Placeholder("Tickets that mention refunds are Billing...").
+		HelpText("Optional guidelines describing when each label applies").
+		Required(false)
+
+	form.NumberField("threshold", "Threshold").
+		Placeholder("0.5").
+		HelpText("Minimum confidence for a label to be selected in multi-label mode (default: 0.5)").
+		Required(false)
+
+	aiusage.RegisterBudgetProps(form)
+
+	return form.Build()
+}
+
+func (a *ClassifyTextAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *ClassifyTextAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[classifyTextActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Model == "" {
+		return nil, errors.New("Model is required")
+	}
+
+	if input.Text == "" {
+		return nil, errors.New("text to classify cannot be empty")
+	}
+
+	classification := aiguard.ClassificationRequest{
+		Text:         input.Text,
+		Labels:       aiguard.SplitList(input.Labels),
+		Examples:     aiguard.ParseExamples(input.Examples),
+		MultiLabel:   input.MultiLabel,
+		Instructions: input.Instructions,
+	}
+	if len(classification.Labels) < 2 {
+		return nil, errors.New("at least two labels are required")
+	}
+
+	if input.Threshold <= 0 {
+		input.Threshold = aiguard.DefaultClassificationThreshold
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	system, prompt := aiguard.ClassificationPrompt(classification)
+	answer, usage, err := completeJSON(ctx, newOpenAIConnection(authCtx.Extra), input.Model, system, prompt, 1024)
+	if err != nil {
+		return nil, fmt.Errorf("classification failed: %w", err)
+	}
+
+	result, err := aiguard.ParseClassification(answer, classification, input.Threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	output := result.Output(input.MultiLabel, input.Threshold)
+	output["model"] = input.Model
+	output["usage"] = usage
+
+	return output, nil
+}
+
+func NewClassifyTextAction() sdk.Action {
+	return &ClassifyTextAction{}
+}
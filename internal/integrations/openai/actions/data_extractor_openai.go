@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -73,14 +74,15 @@ func (a *DataExtractorOpenAIAction) Properties() *smartform.FormSchema {
 			return nil, err
 		}
 
-		models, err := getModels(authCtx.Extra["token"], "gpt")
+		conn := newOpenAIConnection(authCtx.Extra)
+		models, err := getModels(conn, "gpt")
 		if err != nil {
 			return nil, err
 		}
 
 		var options []map[string]interface{}
 		for _, model := range models {
-			if strings.Contains(model.ID, "gpt-4") || strings.Contains(model.ID, "gpt-3.5-turbo") {
+			if !conn.IsOpenAI() || strings.Contains(model.ID, "gpt-4") || strings.Contains(model.ID, "gpt-3.5-turbo") {
 				options = append(options, map[string]interface{}{
 					"id":   model.ID,
 					"name": model.ID,
@@ -157,6 +159,8 @@ Example: {"company": "string", "date": "date", "items": [{"name": "string", "pri
 		Required(false).
 		HelpText("Maximum tokens for the extraction response")
 
+	aiusage.RegisterBudgetProps(form)
+
 	schema := form.Build()
 	return schema
 }
@@ -218,9 +222,13 @@ func (a *DataExtractorOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core
 
 	systemPrompt, userPrompt := buildExtractionPrompts(input)
 
+	if err := aiusage.Check(ctx, input.Model, maxOutputTokens(input.MaxTokens), systemPrompt, userPrompt); err != nil {
+		return nil, err
+	}
+
 	requestBody := buildExtractorRequestBody(input, systemPrompt, userPrompt)
 
-	client, err := getOpenAiClient(authCtx.Extra["token"])
+	client, err := getOpenAiClient(newOpenAIConnection(authCtx.Extra), input.Model)
 	if err != nil {
 		return nil, err
 	}
@@ -252,6 +260,8 @@ func (a *DataExtractorOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core
 		return nil, errors.New("no extraction result returned")
 	}
 
+	usage := aiusage.Finish(ctx, "openai", input.Model, chatCompletion.Usage.Normalized())
+
 	extractedContent := chatCompletion.Choices[0].Message.Content
 
 	extractedContent = cleanJSONResponse(extractedContent)
@@ -263,6 +273,7 @@ func (a *DataExtractorOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core
 			"extracted_data":    nil,
 			"extraction_type":   input.ExtractionType,
 			"tokens_used":       chatCompletion.Usage.TotalTokens,
+			"usage":             usage,
 			"validation_passed": false,
 			"error":             "Failed to parse JSON response",
 			"raw_response":      extractedContent,
@@ -278,6 +289,7 @@ func (a *DataExtractorOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core
 		"extracted_data":    extractedData,
 		"extraction_type":   input.ExtractionType,
 		"tokens_used":       chatCompletion.Usage.TotalTokens,
+		"usage":             usage,
 		"validation_passed": validationPassed,
 		"raw_response":      extractedContent,
 	}
@@ -16,3 +16,21 @@ var VisionOpenAIDocs string
 
 //go:embed image_generation_openai.md
 var ImageGenerationOpenAIDocs string
+
+//go:embed moderate_content_openai.md
+var moderateContentOpenAIDocs string
+
+//go:embed classify_text_openai.md
+var classifyTextOpenAIDocs string
+
+//go:embed redact_pii_openai.md
+var redactPIIOpenAIDocs string
+
+//go:embed transcribe_audio_openai.md
+var transcribeAudioOpenAIDocs string
+
+//go:embed translate_audio_openai.md
+var translateAudioOpenAIDocs string
+
+//go:embed text_to_speech_openai.md
+var textToSpeechOpenAIDocs string
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -65,6 +66,23 @@ func (a *EmbeddingsOpenAIAction) Metadata() sdk.ActionMetadata {
 			"total_tokens":   15,
 			"dimensions":     1536,
 			"chunks_created": 1,
+			"usage": map[string]any{
+				"input_tokens":  15,
+				"output_tokens": 0,
+				"cached_tokens": 0,
+				"total_tokens":  15,
+				"estimated":     false,
+				"provider":      "openai",
+				"model":         "text-embedding-3-small",
+				"cost": map[string]any{
+					"input":    0,
+					"output":   0,
+					"cached":   0,
+					"total":    0,
+					"currency": "USD",
+					"priced":   true,
+				},
+			},
 		},
 		Settings: core.ActionSettings{},
 	}
@@ -74,6 +92,15 @@ func (a *EmbeddingsOpenAIAction) Properties() *smartform.FormSchema {
 	form := smartform.NewForm("embeddings_openai", "Create Embeddings")
 
 	getEmbeddingModels := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		if conn := newOpenAIConnection(authCtx.Extra); !conn.IsOpenAI() {
+			return respondWithModels(ctx, conn)
+		}
+
 		models := []map[string]interface{}{
 			{
 				"id":   "text-embedding-3-small",
@@ -131,6 +158,8 @@ func (a *EmbeddingsOpenAIAction) Properties() *smartform.FormSchema {
 		Required(false).
 		HelpText("Optional unique identifier for the end-user (for abuse monitoring)")
 
+	aiusage.RegisterBudgetProps(form)
+
 	schema := form.Build()
 	return schema
 }
@@ -192,9 +221,13 @@ func (a *EmbeddingsOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core.JS
 		processedTexts = chunkTexts(input.Input, *input.ChunkSize, input.OverlapSize)
 	}
 
+	if err := aiusage.Check(ctx, input.Model, 0, processedTexts...); err != nil {
+		return nil, err
+	}
+
 	requestBody := buildEmbeddingsRequestBody(input, processedTexts)
 
-	client, err := getOpenAiClient(authCtx.Extra["token"])
+	client, err := getOpenAiClient(newOpenAIConnection(authCtx.Extra), input.Model)
 	if err != nil {
 		return nil, err
 	}
@@ -256,6 +289,7 @@ func (a *EmbeddingsOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core.JS
 		"dimensions":     dimensions,
 		"chunks_created": len(processedTexts),
 		"original_texts": len(input.Input),
+		"usage":          aiusage.Finish(ctx, "openai", input.Model, aiusage.NewUsage(int64(embeddingsResp.Usage.PromptTokens), 0, 0)),
 	}, nil
 }
 
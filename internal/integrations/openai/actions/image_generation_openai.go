@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -115,6 +116,9 @@ Generate images from text descriptions using DALL-E 3 or DALL-E 2.
 - Test prompts iteratively
 - Save successful prompts as templates
 - Consider copyright and usage rights
+
+## Usage and Budget
+The output usage counts the images generated, with their estimated cost by model, quality and size. Set Max Cost per Run or Max Cost per Workflow to fail the step before the request is sent when the estimated cost would exceed the limit.
 `,
 		SampleOutput: map[string]any{
 			"images": []map[string]any{
@@ -198,6 +202,8 @@ func (a *ImageGenerationOpenAIAction) Properties() *smartform.FormSchema {
 		Required(false).
 		HelpText("Optional unique identifier for the end-user (for abuse monitoring)")
 
+	aiusage.RegisterBudgetProps(form)
+
 	schema := form.Build()
 	return schema
 }
@@ -257,8 +263,18 @@ func (a *ImageGenerationOpenAIAction) Perform(ctx sdkcontext.PerformContext) (co
 	// Build request body
 	requestBody := buildImageGenRequestBody(input)
 
+	// Images are billed per image, by model, quality and size.
+	pricedModel := aiusage.ImageModel(input.Model, input.Quality, requestBody["size"].(string))
+	images := 1
+	if input.NumImages != nil {
+		images = *input.NumImages
+	}
+	if err := aiusage.CheckUnits(ctx, pricedModel, float64(images), aiusage.UnitImage); err != nil {
+		return nil, err
+	}
+
 	// Make API call
-	client, err := getOpenAiClient(authCtx.Extra["token"])
+	client, err := getOpenAiClient(newOpenAIConnection(authCtx.Extra), input.Model)
 	if err != nil {
 		return nil, err
 	}
@@ -306,6 +322,7 @@ func (a *ImageGenerationOpenAIAction) Perform(ctx sdkcontext.PerformContext) (co
 		"expires_in":             "1 hour",
 		"prompt":                 rawInput["prompt"].(string),
 		"model":                  input.Model,
+		"usage":                  aiusage.Finish(ctx, "openai", pricedModel, aiusage.NewUnitUsage(float64(len(imageResponse.Data)), aiusage.UnitImage)),
 	}
 
 	if input.Size != "" {
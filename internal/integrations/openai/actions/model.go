@@ -1,5 +1,10 @@
 package actions
 
+import (
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/media"
+)
+
 type ModelResponse struct {
 	ID      string `json:"id"`
 	Object  string `json:"object"`
@@ -13,9 +18,17 @@ type ModelListResponse struct {
 }
 
 type ChatCompletionUsageResponse struct {
-	CompletionTokens int64 `json:"completion_tokens"`
-	PromptTokens     int64 `json:"prompt_tokens"`
-	TotalTokens      int64 `json:"total_tokens"`
+	CompletionTokens    int64 `json:"completion_tokens"`
+	PromptTokens        int64 `json:"prompt_tokens"`
+	TotalTokens         int64 `json:"total_tokens"`
+	PromptTokensDetails struct {
+		CachedTokens int64 `json:"cached_tokens"`
+	} `json:"prompt_tokens_details"`
+}
+
+// Normalized converts the OpenAI usage block to the shared usage shape.
+func (u ChatCompletionUsageResponse) Normalized() aiusage.Usage {
+	return aiusage.NewUsage(u.PromptTokens, u.CompletionTokens, u.PromptTokensDetails.CachedTokens)
 }
 
 type ChatCompletionMessageResponse struct {
@@ -48,3 +61,77 @@ type ImageGenerationResponse struct {
 	Created int64                    `json:"created"`
 	Data    []GeneratedImageResponse `json:"data"`
 }
+
+type ModerationResultResponse struct {
+	Flagged        bool               `json:"flagged"`
+	Categories     map[string]bool    `json:"categories"`
+	CategoryScores map[string]float64 `json:"category_scores"`
+}
+
+type ModerationResponse struct {
+	ID      string                     `json:"id"`
+	Model   string                     `json:"model"`
+	Results []ModerationResultResponse `json:"results"`
+}
+
+type TranscriptionSegmentResponse struct {
+	ID    int64   `json:"id"`
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+	Text  string  `json:"text"`
+}
+
+type TranscriptionResponse struct {
+	Text     string                         `json:"text"`
+	Language string                         `json:"language"`
+	Duration float64                        `json:"duration"`
+	Segments []TranscriptionSegmentResponse `json:"segments"`
+	Usage    *TranscriptionUsageResponse    `json:"usage,omitempty"`
+}
+
+// TranscriptionUsageResponse is the usage of a transcription: tokens for
+// the GPT-4o models, seconds of audio for whisper-1.
+type TranscriptionUsageResponse struct {
+	Type         string  `json:"type"`
+	InputTokens  int64   `json:"input_tokens"`
+	OutputTokens int64   `json:"output_tokens"`
+	Seconds      float64 `json:"seconds"`
+}
+
+// Normalized returns the usage of the call: the tokens reported by the
+// models billed per token, else the minutes of audio. Without either, the
+// tokens of the text are estimated.
+func (t TranscriptionResponse) Normalized() aiusage.Usage {
+	if t.Usage != nil && t.Usage.Type == "tokens" {
+		return aiusage.NewUsage(t.Usage.InputTokens, t.Usage.OutputTokens, 0)
+	}
+
+	seconds := t.Duration
+	if t.Usage != nil && t.Usage.Seconds > 0 {
+		seconds = t.Usage.Seconds
+	}
+	if seconds > 0 {
+		return aiusage.NewUnitUsage(seconds/60, aiusage.UnitMinute)
+	}
+
+	usage := aiusage.NewUsage(0, aiusage.EstimateTokens(t.Text), 0)
+	usage.Estimated = true
+	return usage
+}
+
+// Transcript converts the response to the shared transcript shape.
+func (t TranscriptionResponse) Transcript() media.Transcript {
+	transcript := media.Transcript{
+		Text:     t.Text,
+		Language: t.Language,
+		Duration: t.Duration,
+	}
+	for _, segment := range t.Segments {
+		transcript.Segments = append(transcript.Segments, media.Segment{
+			Start: segment.Start,
+			End:   segment.End,
+			Text:  segment.Text,
+		})
+	}
+	return transcript
+}
@@ -0,0 +1,213 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiguard"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+const defaultModerationModel = "omni-moderation-latest"
+
+type moderateContentActionProps struct {
+	Model     string  `json:"model"`
+	Text      string  `json:"text"`
+	Threshold float64 `json:"threshold"`
+}
+
+type ModerateContentAction struct{}
+
+func (a *ModerateContentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "moderate_content_openai",
+		DisplayName:   "Moderate Content",
+		Description:   "Check text for harassment, hate, self-harm, sexual, violent or illicit content using the OpenAI moderations endpoint, or a chat model on other endpoints.",
+		Type:          core.ActionTypeAction,
+		Documentation: moderateContentOpenAIDocs,
+		SampleOutput: map[string]any{
+			"flagged":            true,
+			"flagged_categories": []string{"harassment"},
+			"categories": map[string]bool{
+				"harassment": true,
+				"hate":       false,
+				"violence":   false,
+			},
+			"category_scores": map[string]float64{
+				"harassment": 0.82,
+				"hate":       0.05,
+				"violence":   0.02,
+			},
+			"model":  "omni-moderation-latest",
+			"method": "moderations_endpoint",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *ModerateContentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("moderate_content_openai", "Moderate Content")
+
+	getModerationModels := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		conn := newOpenAIConnection(authCtx.Extra)
+		if !conn.IsOpenAI() {
+			return respondWithModels(ctx, conn)
+		}
+
+		models := []map[string]interface{}{
+			{"id": defaultModerationModel, "name": "Omni Moderation (Latest)"},
+			{"id": "text-moderation-latest", "name": "Text Moderation (Legacy)"},
+		}
+
+		return ctx.Respond(models, len(models))
+	}
+
+	form.SelectField("model", "Model").
+		Required(false).
+		HelpText("On OpenAI, leave empty to use omni-moderation-latest. On self-hosted or Azure endpoints, choose a chat model; it scores the text against the same categories.").
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getModerationModels)).
+				WithSearchSupport().
+				WithPagination(10).
+				End().
+				GetDynamicSource(),
+		)
+
+	form.TextareaField("text", "Text").
+		Required(true).
+		HelpText("The text to check for policy violations")
+
+	form.NumberField("threshold", "Threshold").
+		Required(false).
+		HelpText("Score from 0 to 1 at or above which a category is flagged. Leave empty to use OpenAI's own flags, or 0.5 with a chat model.")
+
+	aiusage.RegisterBudgetProps(form)
+
+	return form.Build()
+}
+
+func (a *ModerateContentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *ModerateContentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[moderateContentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(input.Text) == "" {
+		return nil, errors.New("text to moderate cannot be empty")
+	}
+
+	conn := newOpenAIConnection(authCtx.Extra)
+
+	if conn.IsOpenAI() && (input.Model == "" || strings.Contains(input.Model, "moderation")) {
+		return moderateWithEndpoint(conn, input)
+	}
+
+	if input.Model == "" {
+		return nil, errors.New("a chat model is required to moderate content on this endpoint")
+	}
+
+	system, prompt := aiguard.ModerationPrompt(input.Text)
+	answer, usage, err := completeJSON(ctx, conn, input.Model, system, prompt, 1024)
+	if err != nil {
+		return nil, fmt.Errorf("moderation failed: %w", err)
+	}
+
+	moderation, err := aiguard.ParseModeration(answer, input.Threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	output := moderation.Output()
+	output["model"] = input.Model
+	output["method"] = "llm"
+	output["usage"] = usage
+
+	return output, nil
+}
+
+// moderateWithEndpoint calls the free OpenAI moderations endpoint. OpenAI's
+// own flags are kept unless the action sets a threshold.
+func moderateWithEndpoint(conn openAIConnection, input *moderateContentActionProps) (core.JSON, error) {
+	model := input.Model
+	if model == "" {
+		model = defaultModerationModel
+	}
+
+	client, err := getOpenAiClient(conn, model)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.POST("/moderations").
+		Header().AddContentType("application/json").
+		Body().AsJSON(map[string]interface{}{
+		"model": model,
+		"input": input.Text,
+	}).
+		Send()
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status().IsError() {
+		return nil, errors.New(res.Status().Text())
+	}
+
+	bodyBytes, err := io.ReadAll(res.Body().Raw())
+	if err != nil {
+		return nil, err
+	}
+
+	var moderationRes ModerationResponse
+	if err = json.Unmarshal(bodyBytes, &moderationRes); err != nil {
+		return nil, errors.New("could not read the response body")
+	}
+
+	if len(moderationRes.Results) == 0 {
+		return nil, errors.New("the moderations endpoint returned no result")
+	}
+
+	result := moderationRes.Results[0]
+
+	moderation := aiguard.NewModeration(result.CategoryScores, input.Threshold)
+	if input.Threshold <= 0 {
+		moderation.Flagged = result.Flagged
+		for category := range moderation.Categories {
+			moderation.Categories[category] = result.Categories[category]
+		}
+	}
+
+	output := moderation.Output()
+	output["model"] = moderationRes.Model
+	output["method"] = "moderations_endpoint"
+
+	return output, nil
+}
+
+func NewModerateContentAction() sdk.Action {
+	return &ModerateContentAction{}
+}
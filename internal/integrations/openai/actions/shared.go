@@ -1,34 +1,192 @@
 package actions
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/juicycleff/smartform/v1"
 	fastshot "github.com/opus-domini/fast-shot"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/media"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
 )
 
+const defaultOpenAIBaseURL = "https://api.openai.com/v1"
+
 var (
 	form = smartform.NewAuthForm("openai-auth", "OpenAI API Authentication", smartform.AuthStrategyCustom)
-	_    = form.TextField("token", "OpenAI Token (Required*)").
-		Required(true).
-		HelpText("The api token used to authenticate OpenAI")
+	_    = form.TextField("token", "API Key").
+		Required(false).
+		HelpText("The API key used to authenticate. Required for OpenAI and Azure OpenAI; local servers such as Ollama or LM Studio usually accept any value.")
+
+	_ = form.TextField("base_url", "Base URL").
+		Required(false).
+		DefaultValue(defaultOpenAIBaseURL).
+		Placeholder(defaultOpenAIBaseURL).
+		HelpText("Root of an OpenAI-compatible API, e.g. http://localhost:11434/v1 for Ollama, http://localhost:8000/v1 for vLLM or https://<resource>.openai.azure.com/openai/deployments/{model} for Azure OpenAI. {model} is replaced with the model selected in the action.")
+
+	_ = form.TextField("api_version", "API Version").
+		Required(false).
+		Placeholder("2024-06-01").
+		HelpText("Sent as the api-version query parameter on every request. Required by Azure OpenAI, leave empty otherwise.")
+
+	_ = form.SelectField("auth_header", "Authentication Header").
+		Required(false).
+		DefaultValue("bearer").
+		HelpText("How the API key is sent. Azure OpenAI expects the api-key header.").
+		AddOptions([]*smartform.Option{
+			{Value: "bearer", Label: "Authorization: Bearer (OpenAI, Ollama, vLLM, LM Studio)"},
+			{Value: "api-key", Label: "api-key (Azure OpenAI)"},
+		}...)
+
+	_ = form.TextField("models", "Available Models").
+		Required(false).
+		Placeholder("llama3.1:8b, my-gpt-4o-deployment").
+		HelpText("Comma-separated model or deployment names offered in model dropdowns when the endpoint has no /models listing.")
 
 	OpenAISharedAuth = form.Build()
 )
 
-func getOpenAiClient(token string) (fastshot.ClientHttpMethods, error) {
-	return fastshot.NewClient("https://api.openai.com/v1").
-		Auth().BearerToken(token).
-		Header().AddAccept("application/json").
-		Build(), nil
+// openAIConnection describes the OpenAI-compatible endpoint configured on the
+// connection.
+type openAIConnection struct {
+	Token      string
+	BaseURL    string
+	APIVersion string
+	AuthHeader string
+	Models     []string
+}
+
+func newOpenAIConnection(extra map[string]string) openAIConnection {
+	conn := openAIConnection{
+		Token:      extra["token"],
+		BaseURL:    strings.TrimRight(strings.TrimSpace(extra["base_url"]), "/"),
+		APIVersion: strings.TrimSpace(extra["api_version"]),
+		AuthHeader: extra["auth_header"],
+	}
+
+	if conn.BaseURL == "" {
+		conn.BaseURL = defaultOpenAIBaseURL
+	}
+
+	for _, model := range strings.Split(extra["models"], ",") {
+		if model = strings.TrimSpace(model); model != "" {
+			conn.Models = append(conn.Models, model)
+		}
+	}
+
+	return conn
+}
+
+// IsOpenAI reports whether the connection targets the public OpenAI API,
+// where model IDs follow OpenAI's naming.
+func (c openAIConnection) IsOpenAI() bool {
+	return c.BaseURL == defaultOpenAIBaseURL
+}
+
+func getOpenAiClient(conn openAIConnection, model string) (fastshot.ClientHttpMethods, error) {
+	baseURL := strings.ReplaceAll(conn.BaseURL, "{model}", url.PathEscape(model))
+
+	builder := fastshot.NewClient(baseURL).
+		Header().AddAccept("application/json")
+
+	if conn.Token != "" {
+		if conn.AuthHeader == "api-key" {
+			builder = builder.Header().Add("api-key", conn.Token)
+		} else {
+			builder = builder.Auth().BearerToken(conn.Token)
+		}
+	}
+
+	if conn.APIVersion != "" {
+		builder = builder.Config().SetCustomTransport(&apiVersionTransport{
+			version: conn.APIVersion,
+			base:    http.DefaultTransport,
+		})
+	}
+
+	return builder.Build(), nil
+}
+
+// apiVersionTransport appends the api-version query parameter Azure OpenAI
+// requires to every request.
+type apiVersionTransport struct {
+	version string
+	base    http.RoundTripper
+}
+
+func (t *apiVersionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	clone := req.Clone(req.Context())
+	query := clone.URL.Query()
+	query.Set("api-version", t.version)
+	clone.URL.RawQuery = query.Encode()
+
+	return t.base.RoundTrip(clone)
 }
 
-func getModels(token string, modelPrefix string) ([]ModelResponse, error) {
-	client, err := getOpenAiClient(token)
+// getModels lists the models of the endpoint. The prefix filter only applies
+// to the public OpenAI API since self-hosted model names are arbitrary. When
+// the endpoint has no /models route, the models configured on the connection
+// are returned instead.
+func getModels(conn openAIConnection, modelPrefix string) ([]ModelResponse, error) {
+	models, err := listModels(conn)
+	if err != nil && len(conn.Models) == 0 {
+		return nil, fmt.Errorf("could not list models from %s (%w); add the model names under Available Models on the connection", conn.BaseURL, err)
+	}
+
+	if err != nil || len(models) == 0 {
+		models = nil
+		for _, id := range conn.Models {
+			models = append(models, ModelResponse{ID: id, Object: "model"})
+		}
+		return models, nil
+	}
+
+	if !conn.IsOpenAI() {
+		return models, nil
+	}
+
+	var filtered []ModelResponse
+	for _, model := range models {
+		if strings.HasPrefix(model.ID, modelPrefix) {
+			filtered = append(filtered, model)
+		}
+	}
+
+	return filtered, nil
+}
+
+// respondWithModels answers a model dropdown with every model of a
+// self-hosted or Azure endpoint, whose names the curated lists cannot know.
+func respondWithModels(ctx sdkcontext.DynamicFieldContext, conn openAIConnection) (*sdkcore.DynamicOptionsResponse, error) {
+	models, err := getModels(conn, "")
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]map[string]interface{}, 0, len(models))
+	for _, model := range models {
+		options = append(options, map[string]interface{}{
+			"id":   model.ID,
+			"name": model.ID,
+		})
+	}
+
+	return ctx.Respond(options, len(options))
+}
+
+func listModels(conn openAIConnection) ([]ModelResponse, error) {
+	client, err := getOpenAiClient(conn, "")
 	if err != nil {
 		return nil, err
 	}
@@ -52,19 +210,12 @@ func getModels(token string, modelPrefix string) ([]ModelResponse, error) {
 		return nil, err
 	}
 
-	var models []ModelResponse
-	for _, model := range modelsRes.Data {
-		if strings.HasPrefix(model.ID, modelPrefix) {
-			models = append(models, model)
-		}
-	}
-
-	return models, nil
+	return modelsRes.Data, nil
 }
 
 func validateInput(inputPt *chatOpenAIActionProps) error {
-	if len(inputPt.Model) == 0 || !strings.HasPrefix(inputPt.Model, "gpt") {
-		return errors.New("invalid open ai model")
+	if strings.TrimSpace(inputPt.Model) == "" {
+		return errors.New("a model is required")
 	}
 
 	if len(inputPt.Prompt) == 0 {
@@ -94,6 +245,14 @@ func validateInput(inputPt *chatOpenAIActionProps) error {
 	return nil
 }
 
+// maxOutputTokens returns the output cap used for budget estimates.
+func maxOutputTokens(maxTokens *int) int64 {
+	if maxTokens != nil && *maxTokens > 0 {
+		return int64(*maxTokens)
+	}
+	return aiusage.DefaultOutputTokens
+}
+
 func buildRequestBody(inputPt *chatOpenAIActionProps) map[string]interface{} {
 	requestBody := map[string]interface{}{
 		"model": inputPt.Model,
@@ -158,3 +317,226 @@ func ObfuscateImageURL(url string) map[string]interface{} {
 		"copy_instructions": "Copy 'full_link' value and paste in new browser tab to view image",
 	}
 }
+
+// registerChatModelProps adds a chat model dropdown listing the GPT models
+// of the public API, or every model of a self-hosted or Azure endpoint.
+func registerChatModelProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getChatModels := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		conn := newOpenAIConnection(authCtx.Extra)
+		if !conn.IsOpenAI() {
+			return respondWithModels(ctx, conn)
+		}
+
+		models, err := getModels(conn, "gpt")
+		if err != nil {
+			return nil, err
+		}
+
+		options := make([]map[string]interface{}, 0, len(models))
+		for _, model := range models {
+			options = append(options, map[string]interface{}{
+				"id":   model.ID,
+				"name": model.ID,
+			})
+		}
+
+		return ctx.Respond(options, len(options))
+	}
+
+	return form.SelectField("model", "Model").
+		Required(true).
+		HelpText("Choose the chat model used for the task").
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getChatModels)).
+				WithSearchSupport().
+				WithPagination(10).
+				End().
+				GetDynamicSource(),
+		)
+}
+
+// completeJSON runs a deterministic single-turn chat completion that answers
+// with JSON, as used by the moderation, classification and PII actions. It
+// enforces the budget and returns the answer with its usage report.
+func completeJSON(ctx sdkcontext.PerformContext, conn openAIConnection, model, system, prompt string, maxTokens int) (string, map[string]interface{}, error) {
+	if err := aiusage.Check(ctx, model, int64(maxTokens), system, prompt); err != nil {
+		return "", nil, err
+	}
+
+	requestBody := map[string]interface{}{
+		"model": model,
+		"messages": []interface{}{
+			map[string]interface{}{"role": "system", "content": system},
+			map[string]interface{}{"role": "user", "content": prompt},
+		},
+		"temperature": 0,
+		"max_tokens":  maxTokens,
+	}
+
+	// JSON mode is not implemented by every compatible server, and the
+	// answer is parsed leniently anyway.
+	if conn.IsOpenAI() {
+		requestBody["response_format"] = map[string]interface{}{"type": "json_object"}
+	}
+
+	client, err := getOpenAiClient(conn, model)
+	if err != nil {
+		return "", nil, err
+	}
+
+	res, err := client.POST("/chat/completions").
+		Header().AddContentType("application/json").
+		Body().AsJSON(requestBody).
+		Send()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if res.Status().IsError() {
+		return "", nil, errors.New(res.Status().Text())
+	}
+
+	bodyBytes, err := io.ReadAll(res.Body().Raw())
+	if err != nil {
+		return "", nil, err
+	}
+
+	var chatCompletion ChatCompletionResponse
+	if err = json.Unmarshal(bodyBytes, &chatCompletion); err != nil {
+		return "", nil, errors.New("could not read the response body")
+	}
+
+	if len(chatCompletion.Choices) == 0 {
+		return "", nil, errors.New("GPT did not give an answer")
+	}
+
+	usage := aiusage.Finish(ctx, "openai", model, chatCompletion.Usage.Normalized())
+
+	return chatCompletion.Choices[0].Message.Content, usage, nil
+}
+
+// postAudio uploads audio to one of the multipart audio endpoints and
+// decodes the JSON answer into v.
+func postAudio(conn openAIConnection, endpoint, model string, audio *media.Media, fields map[string]string, v interface{}) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile("file", audio.Name)
+	if err != nil {
+		return err
+	}
+	if _, err = part.Write(audio.Data); err != nil {
+		return err
+	}
+
+	fields["model"] = model
+	for key, value := range fields {
+		if value == "" {
+			continue
+		}
+		if err = writer.WriteField(key, value); err != nil {
+			return err
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	client, err := getOpenAiClient(conn, model)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.POST(endpoint).
+		Header().Add("Content-Type", writer.FormDataContentType()).
+		Body().AsReader(&body).
+		Send()
+	if err != nil {
+		return err
+	}
+
+	bodyBytes, err := io.ReadAll(res.Body().Raw())
+	if err != nil {
+		return err
+	}
+
+	if res.Status().IsError() {
+		return fmt.Errorf("%s: %s", res.Status().Text(), string(bodyBytes))
+	}
+
+	if err = json.Unmarshal(bodyBytes, v); err != nil {
+		return errors.New("could not read the response body")
+	}
+
+	return nil
+}
+
+// checkAudioBudget checks the budget of a transcription or translation.
+// The length of the audio is only known once it is processed, so the
+// check only stops the call once the workflow budget is spent.
+func checkAudioBudget(ctx aiusage.Context, model, prompt string) error {
+	if price, ok := aiusage.LookupPrice(model); ok && price.Unit != "" {
+		return aiusage.CheckUnits(ctx, model, 0, price.Unit)
+	}
+	return aiusage.Check(ctx, model, 0, prompt)
+}
+
+// registerAudioModelProps adds a model dropdown listing the given audio
+// models on OpenAI, or every model of a self-hosted or Azure endpoint.
+func registerAudioModelProps(form *smartform.FormBuilder, models ...string) *smartform.FieldBuilder {
+	getAudioModels := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		conn := newOpenAIConnection(authCtx.Extra)
+		if !conn.IsOpenAI() {
+			return respondWithModels(ctx, conn)
+		}
+
+		options := make([]map[string]interface{}, 0, len(models))
+		for _, model := range models {
+			options = append(options, map[string]interface{}{
+				"id":   model,
+				"name": model,
+			})
+		}
+
+		return ctx.Respond(options, len(options))
+	}
+
+	return form.SelectField("model", "Model").
+		Required(true).
+		DefaultValue(models[0]).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getAudioModels)).
+				WithSearchSupport().
+				WithPagination(10).
+				End().
+				GetDynamicSource(),
+		)
+}
+
+// registerSubtitleProps adds the subtitle file choice of the transcription
+// actions.
+func registerSubtitleProps(form *smartform.FormBuilder) {
+	form.SelectField("subtitle_format", "Subtitle File").
+		Required(false).
+		HelpText("Also store the subtitles as a file. SRT and VTT text is always returned when the model provides timestamps.").
+		AddOptions([]*smartform.Option{
+			{Value: "", Label: "None"},
+			{Value: "srt", Label: "SRT"},
+			{Value: "vtt", Label: "WebVTT"},
+		}...)
+}
@@ -0,0 +1,245 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/media"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// maxSpeechInput is the longest text the speech endpoint accepts.
+const maxSpeechInput = 4096
+
+// speechFormats maps the speech response formats to their MIME types.
+var speechFormats = map[string]string{
+	"mp3":  "audio/mpeg",
+	"opus": "audio/ogg",
+	"aac":  "audio/aac",
+	"flac": "audio/flac",
+	"wav":  "audio/wav",
+}
+
+type textToSpeechActionProps struct {
+	Text         string   `json:"text"`
+	Model        string   `json:"model"`
+	Voice        string   `json:"voice"`
+	Format       string   `json:"format"`
+	Speed        *float64 `json:"speed"`
+	Instructions string   `json:"instructions"`
+	FileName     string   `json:"file_name"`
+}
+
+type TextToSpeechAction struct{}
+
+func (a *TextToSpeechAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "text_to_speech_openai",
+		DisplayName:   "Text to Speech",
+		Description:   "Turn text into spoken audio with a choice of voice and format, stored as a file that messaging and storage actions can send or upload.",
+		Type:          core.ActionTypeAction,
+		Documentation: textToSpeechOpenAIDocs,
+		SampleOutput: map[string]any{
+			"file": map[string]any{
+				"id":          "cs1v2qk0p1h1234abcd0",
+				"fileName":    "speech.mp3",
+				"ext":         ".mp3",
+				"mimeType":    "audio/mpeg",
+				"url":         "https://files.example.com/cs1v2qk0p1h1234abcd0",
+				"downloadUrl": "https://files.example.com/cs1v2qk0p1h1234abcd0",
+				"size":        48213,
+				"sizeBytes":   48213,
+			},
+			"url":        "https://files.example.com/cs1v2qk0p1h1234abcd0",
+			"mime_type":  "audio/mpeg",
+			"format":     "mp3",
+			"voice":      "alloy",
+			"model":      "tts-1",
+			"characters": 64,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *TextToSpeechAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("text_to_speech_openai", "Text to Speech")
+
+	form.TextareaField("text", "Text").
+		Required(true).
+		HelpText("The text to speak, up to 4096 characters.")
+
+	registerAudioModelProps(form, "tts-1", "tts-1-hd", "gpt-4o-mini-tts").
+		HelpText("tts-1 is fastest, tts-1-hd has higher quality and gpt-4o-mini-tts follows speaking instructions.")
+
+	form.SelectField("voice", "Voice").
+		Required(true).
+		DefaultValue("alloy").
+		AddOptions([]*smartform.Option{
+			{Value: "alloy", Label: "Alloy"},
+			{Value: "ash", Label: "Ash"},
+			{Value: "ballad", Label: "Ballad"},
+			{Value: "coral", Label: "Coral"},
+			{Value: "echo", Label: "Echo"},
+			{Value: "fable", Label: "Fable"},
+			{Value: "onyx", Label: "Onyx"},
+			{Value: "nova", Label: "Nova"},
+			{Value: "sage", Label: "Sage"},
+			{Value: "shimmer", Label: "Shimmer"},
+			{Value: "verse", Label: "Verse"},
+		}...).
+		HelpText("The voice to use. Ash, Ballad, Coral, Sage and Verse require gpt-4o-mini-tts.")
+
+	form.SelectField("format", "Audio Format").
+		Required(false).
+		DefaultValue("mp3").
+		AddOptions([]*smartform.Option{
+			{Value: "mp3", Label: "MP3"},
+			{Value: "opus", Label: "Opus (OGG, for WhatsApp and Telegram voice notes)"},
+			{Value: "aac", Label: "AAC"},
+			{Value: "flac", Label: "FLAC"},
+			{Value: "wav", Label: "WAV"},
+		}...).
+		HelpText("The audio file format")
+
+	form.NumberField("speed", "Speed").
+		Required(false).
+		HelpText("Speaking speed from 0.25 to 4.0 (default: 1.0)")
+
+	form.TextareaField("instructions", "Instructions").
+		Required(false).
+		Placeholder("Speak in a calm, friendly tone.").
+		HelpText("How the voice should sound. Only used by gpt-4o-mini-tts.")
+
+	form.TextField("file_name", "File Name").
+		Required(false).
+		Placeholder("speech").
+		HelpText("Name of the generated file, without extension (default: speech)")
+
+	aiusage.RegisterBudgetProps(form)
+
+	return form.Build()
+}
+
+func (a *TextToSpeechAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *TextToSpeechAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[textToSpeechActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(input.Text) == "" {
+		return nil, errors.New("text to speak cannot be empty")
+	}
+
+	characters := utf8.RuneCountInString(input.Text)
+	if characters > maxSpeechInput {
+		return nil, fmt.Errorf("text is %d characters long, the limit is %d", characters, maxSpeechInput)
+	}
+
+	if input.Model == "" {
+		input.Model = "tts-1"
+	}
+	if input.Voice == "" {
+		input.Voice = "alloy"
+	}
+	if input.Format == "" {
+		input.Format = "mp3"
+	}
+
+	mimeType, ok := speechFormats[input.Format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported audio format %q", input.Format)
+	}
+
+	// tts-1 and tts-1-hd are billed per character. The speech endpoint
+	// reports no usage, so the tokens of gpt-4o-mini-tts are estimated from
+	// the text and the check assumes the default output size.
+	var usage aiusage.Usage
+	if price, ok := aiusage.LookupPrice(input.Model); ok && price.Unit == aiusage.UnitCharacter {
+		usage = aiusage.NewUnitUsage(float64(characters), aiusage.UnitCharacter)
+		err = aiusage.CheckUnits(ctx, input.Model, usage.Units, usage.Unit)
+	} else {
+		usage = aiusage.NewUsage(aiusage.EstimateTokens(input.Instructions, input.Text), 0, 0)
+		usage.Estimated = true
+		err = aiusage.Check(ctx, input.Model, aiusage.DefaultOutputTokens, input.Instructions, input.Text)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	requestBody := map[string]interface{}{
+		"model":           input.Model,
+		"input":           input.Text,
+		"voice":           input.Voice,
+		"response_format": input.Format,
+	}
+	if input.Speed != nil {
+		requestBody["speed"] = *input.Speed
+	}
+	if input.Instructions != "" {
+		requestBody["instructions"] = input.Instructions
+	}
+
+	client, err := getOpenAiClient(newOpenAIConnection(authCtx.Extra), input.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.POST("/audio/speech").
+		Header().AddContentType("application/json").
+		Body().AsJSON(requestBody).
+		Send()
+	if err != nil {
+		return nil, err
+	}
+
+	audio, err := io.ReadAll(res.Body().Raw())
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status().IsError() {
+		return nil, fmt.Errorf("speech generation failed: %s: %s", res.Status().Text(), string(audio))
+	}
+
+	fileName := strings.TrimSpace(input.FileName)
+	if fileName == "" {
+		fileName = "speech"
+	}
+	fileName += "." + strings.Replace(input.Format, "opus", "ogg", 1)
+
+	file, err := media.Store(ctx.Context(), ctx.Files(), fileName, mimeType, audio)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"file":       file,
+		"url":        file["url"],
+		"mime_type":  mimeType,
+		"format":     input.Format,
+		"voice":      input.Voice,
+		"model":      input.Model,
+		"characters": characters,
+		"usage":      aiusage.Finish(ctx, "openai", input.Model, usage),
+	}, nil
+}
+
+func NewTextToSpeechAction() sdk.Action {
+	return &TextToSpeechAction{}
+}
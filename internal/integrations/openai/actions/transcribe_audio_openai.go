@@ -0,0 +1,142 @@
+package actions
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/media"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type transcribeAudioActionProps struct {
+	Audio          interface{} `json:"audio"`
+	Model          string      `json:"model"`
+	Language       string      `json:"language"`
+	Prompt         string      `json:"prompt"`
+	Temperature    *float64    `json:"temperature"`
+	SubtitleFormat string      `json:"subtitle_format"`
+}
+
+type TranscribeAudioAction struct{}
+
+func (a *TranscribeAudioAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "transcribe_audio_openai",
+		DisplayName:   "Transcribe Audio",
+		Description:   "Convert speech in an audio file, such as a WhatsApp or Telegram voice note, to text with timestamps, SRT/VTT subtitles and the detected language.",
+		Type:          core.ActionTypeAction,
+		Documentation: transcribeAudioOpenAIDocs,
+		SampleOutput: map[string]any{
+			"text":     "Hi, I ordered a blue jacket last week and it still hasn't arrived.",
+			"language": "english",
+			"duration": 4.2,
+			"segments": []map[string]any{
+				{"start": 0.0, "end": 4.2, "text": "Hi, I ordered a blue jacket last week and it still hasn't arrived."},
+			},
+			"srt":   "1\n00:00:00,000 --> 00:00:04,200\nHi, I ordered a blue jacket last week and it still hasn't arrived.\n\n",
+			"vtt":   "WEBVTT\n\n00:00:00.000 --> 00:00:04.200\nHi, I ordered a blue jacket last week and it still hasn't arrived.\n\n",
+			"model": "whisper-1",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *TranscribeAudioAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("transcribe_audio_openai", "Transcribe Audio")
+
+	form.FileField("audio", "Audio").
+		Required(true).
+		HelpText("The audio to transcribe: a file, a URL, a data URI or base64. Supports flac, m4a, mp3, mp4, mpeg, mpga, oga, ogg, wav and webm up to 25 MB.")
+
+	registerAudioModelProps(form, "whisper-1", "gpt-4o-transcribe", "gpt-4o-mini-transcribe").
+		HelpText("whisper-1 returns timestamps, subtitles and the detected language. The GPT-4o models are more accurate but return text only.")
+
+	form.TextField("language", "Language").
+		Required(false).
+		Placeholder("en").
+		HelpText("ISO-639-1 code of the spoken language. Leave empty to detect it automatically.")
+
+	form.TextareaField("prompt", "Prompt").
+		Required(false).
+		HelpText("Optional text to guide the style or spelling, e.g. product names or a previous part of the conversation.")
+
+	form.NumberField("temperature", "Temperature").
+		Required(false).
+		HelpText("Sampling temperature between 0 and 1. Leave empty for the default.")
+
+	registerSubtitleProps(form)
+
+	aiusage.RegisterBudgetProps(form)
+
+	return form.Build()
+}
+
+func (a *TranscribeAudioAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *TranscribeAudioAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[transcribeAudioActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Model == "" {
+		input.Model = "whisper-1"
+	}
+
+	if err := checkAudioBudget(ctx, input.Model, input.Prompt); err != nil {
+		return nil, err
+	}
+
+	audio, err := media.Load(ctx.Context(), ctx.Files(), input.Audio)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{
+		"language": input.Language,
+		"prompt":   input.Prompt,
+	}
+	if input.Temperature != nil {
+		fields["temperature"] = strconv.FormatFloat(*input.Temperature, 'f', -1, 64)
+	}
+	if input.Model == "whisper-1" {
+		fields["response_format"] = "verbose_json"
+		fields["timestamp_granularities[]"] = "segment"
+	} else {
+		fields["response_format"] = "json"
+	}
+
+	var transcription TranscriptionResponse
+	if err := postAudio(newOpenAIConnection(authCtx.Extra), "/audio/transcriptions", input.Model, audio, fields, &transcription); err != nil {
+		return nil, fmt.Errorf("transcription failed: %w", err)
+	}
+
+	transcript := transcription.Transcript()
+	if transcript.Language == "" {
+		transcript.Language = input.Language
+	}
+
+	output, err := transcript.Output(ctx.Context(), ctx.Files(), input.SubtitleFormat, audio.Name)
+	if err != nil {
+		return nil, err
+	}
+	output["model"] = input.Model
+	output["usage"] = aiusage.Finish(ctx, "openai", input.Model, transcription.Normalized())
+
+	return output, nil
+}
+
+func NewTranscribeAudioAction() sdk.Action {
+	return &TranscribeAudioAction{}
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/media"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type translateAudioActionProps struct {
+	Audio          interface{} `json:"audio"`
+	Model          string      `json:"model"`
+	Prompt         string      `json:"prompt"`
+	Temperature    *float64    `json:"temperature"`
+	SubtitleFormat string      `json:"subtitle_format"`
+}
+
+type TranslateAudioAction struct{}
+
+func (a *TranslateAudioAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "translate_audio_openai",
+		DisplayName:   "Translate Audio to English",
+		Description:   "Transcribe speech in any supported language directly into English text, with timestamps and SRT/VTT subtitles.",
+		Type:          core.ActionTypeAction,
+		Documentation: translateAudioOpenAIDocs,
+		SampleOutput: map[string]any{
+			"text":     "Hello, my package arrived damaged. Can I get a replacement?",
+			"language": "english",
+			"duration": 5.1,
+			"segments": []map[string]any{
+				{"start": 0.0, "end": 5.1, "text": "Hello, my package arrived damaged. Can I get a replacement?"},
+			},
+			"srt":   "1\n00:00:00,000 --> 00:00:05,100\nHello, my package arrived damaged. Can I get a replacement?\n\n",
+			"vtt":   "WEBVTT\n\n00:00:00.000 --> 00:00:05.100\nHello, my package arrived damaged. Can I get a replacement?\n\n",
+			"model": "whisper-1",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *TranslateAudioAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("translate_audio_openai", "Translate Audio to English")
+
+	form.FileField("audio", "Audio").
+		Required(true).
+		HelpText("The audio to translate: a file, a URL, a data URI or base64. Supports flac, m4a, mp3, mp4, mpeg, mpga, oga, ogg, wav and webm up to 25 MB.")
+
+	registerAudioModelProps(form, "whisper-1").
+		HelpText("Only whisper-1 supports audio translation on OpenAI.")
+
+	form.TextareaField("prompt", "Prompt").
+		Required(false).
+		HelpText("Optional English text to guide the style or spelling of the translation.")
+
+	form.NumberField("temperature", "Temperature").
+		Required(false).
+		HelpText("Sampling temperature between 0 and 1. Leave empty for the default.")
+
+	registerSubtitleProps(form)
+
+	aiusage.RegisterBudgetProps(form)
+
+	return form.Build()
+}
+
+func (a *TranslateAudioAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *TranslateAudioAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[translateAudioActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Model == "" {
+		input.Model = "whisper-1"
+	}
+
+	if err := checkAudioBudget(ctx, input.Model, input.Prompt); err != nil {
+		return nil, err
+	}
+
+	audio, err := media.Load(ctx.Context(), ctx.Files(), input.Audio)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{
+		"prompt":          input.Prompt,
+		"response_format": "verbose_json",
+	}
+	if input.Temperature != nil {
+		fields["temperature"] = strconv.FormatFloat(*input.Temperature, 'f', -1, 64)
+	}
+
+	var translation TranscriptionResponse
+	if err := postAudio(newOpenAIConnection(authCtx.Extra), "/audio/translations", input.Model, audio, fields, &translation); err != nil {
+		return nil, fmt.Errorf("translation failed: %w", err)
+	}
+
+	transcript := translation.Transcript()
+	transcript.Language = "english"
+
+	output, err := transcript.Output(ctx.Context(), ctx.Files(), input.SubtitleFormat, audio.Name)
+	if err != nil {
+		return nil, err
+	}
+	output["model"] = input.Model
+	output["usage"] = aiusage.Finish(ctx, "openai", input.Model, translation.Normalized())
+
+	return output, nil
+}
+
+func NewTranslateAudioAction() sdk.Action {
+	return &TranslateAudioAction{}
+}
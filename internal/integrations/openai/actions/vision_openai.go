@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -52,6 +53,15 @@ func (a *VisionOpenAIAction) Properties() *smartform.FormSchema {
 	form := smartform.NewForm("vision_openai", "Analyze Image (Vision)")
 
 	getVisionModels := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		authCtx, err := ctx.AuthContext()
+		if err != nil {
+			return nil, err
+		}
+
+		if conn := newOpenAIConnection(authCtx.Extra); !conn.IsOpenAI() {
+			return respondWithModels(ctx, conn)
+		}
+
 		models := []map[string]interface{}{
 			{
 				"id":   "gpt-4-vision-preview",
@@ -126,6 +136,8 @@ func (a *VisionOpenAIAction) Properties() *smartform.FormSchema {
 		Required(false).
 		HelpText("Control response creativity (0 = focused, 2 = creative). Default: 0.3 for accuracy")
 
+	aiusage.RegisterBudgetProps(form)
+
 	schema := form.Build()
 	return schema
 }
@@ -178,11 +190,17 @@ func (a *VisionOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		return nil, err
 	}
 
+	// Image tokens are not estimated locally, so the budget check covers
+	// the text prompts and the output cap only.
+	if err := aiusage.Check(ctx, input.Model, maxOutputTokens(input.MaxTokens), input.SystemPrompt, input.Prompt); err != nil {
+		return nil, err
+	}
+
 	// Build request body
 	requestBody := buildVisionRequestBody(input)
 
 	// Make API call
-	client, err := getOpenAiClient(authCtx.Extra["token"])
+	client, err := getOpenAiClient(newOpenAIConnection(authCtx.Extra), input.Model)
 	if err != nil {
 		return nil, err
 	}
@@ -222,6 +240,7 @@ func (a *VisionOpenAIAction) Perform(ctx sdkcontext.PerformContext) (core.JSON,
 		"image_type":      input.ImageType,
 		"detail_level":    input.Detail,
 		"tokens_used":     chatCompletion.Usage.TotalTokens,
+		"usage":           aiusage.Finish(ctx, "openai", input.Model, chatCompletion.Usage.Normalized()),
 	}
 
 	if input.SystemPrompt != "" {
@@ -43,6 +43,12 @@ func (n *OpenAI) Actions() []sdk.Action {
 		actions.NewDataExtractorOpenAIAction(),
 		// actions.NewImageGenerationOpenAIAction(),
 		actions.NewVisionOpenAIAction(),
+		actions.NewModerateContentAction(),
+		actions.NewClassifyTextAction(),
+		actions.NewRedactPIIAction(),
+		actions.NewTranscribeAudioAction(),
+		actions.NewTranslateAudioAction(),
+		actions.NewTextToSpeechAction(),
 	}
 }
 
@@ -19,3 +19,6 @@ var getProductsSmartpriceDocs string
 
 //go:embed list_products.md
 var listProductsDocs string
+
+//go:embed reprice_product.md
+var repriceProductDocs string
@@ -0,0 +1,222 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/prisync/shared"
+	"github.com/wakflo/extensions/internal/pricing"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type repriceProductActionProps struct {
+	ProductID        string   `json:"product_id"`
+	Store            string   `json:"store"`
+	SKU              string   `json:"sku"`
+	OwnDomain        string   `json:"own_domain"`
+	UndercutBy       float64  `json:"undercut_by"`
+	UndercutPercent  float64  `json:"undercut_percent"`
+	Floor            float64  `json:"floor_price"`
+	Ceiling          float64  `json:"ceiling_price"`
+	Cost             *float64 `json:"cost"`
+	MinMarginPercent float64  `json:"min_margin_percent"`
+	MinChange        float64  `json:"min_change"`
+	DryRun           bool     `json:"dry_run"`
+}
+
+type RepriceProductAction struct{}
+
+// Metadata returns metadata about the action
+func (a *RepriceProductAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "reprice_product",
+		DisplayName:   "Reprice Product",
+		Description:   "Prices a product from its Prisync competitor prices with undercut, floor, ceiling and margin rules, and pushes the new price to its Shopify or WooCommerce variants.",
+		Type:          core.ActionTypeAction,
+		Documentation: repriceProductDocs,
+		SampleOutput: map[string]any{
+			"product_id":              "3422",
+			"product_name":            "Wireless Mouse",
+			"store":                   "shopify",
+			"sku":                     "MOUSE-01",
+			"current_price":           24.99,
+			"on_sale":                 false,
+			"new_price":               23.49,
+			"lowest_competitor_price": 23.99,
+			"target_price":            23.49,
+			"changed":                 true,
+			"reason":                  "undercut",
+			"applied":                 true,
+			"dry_run":                 false,
+			"variants": []map[string]any{
+				{"id": "gid://shopify/ProductVariant/4321", "product_id": "gid://shopify/Product/1234", "sku": "MOUSE-01", "price": 24.99},
+			},
+			"competitors": map[string]any{
+				"https://competitor.example.com/mouse": 23.99,
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *RepriceProductAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("reprice_product", "Reprice Product")
+
+	shared.GetProductProp("product_id", "Product", "The Prisync product to reprice.", true, form)
+
+	form.SelectField("store", "Store").
+		Required(true).
+		AddOptions(shared.StoreOptions...).
+		HelpText("Where to push the price. The store's credentials must be set on the connection.")
+
+	form.TextField("sku", "SKU").
+		Required(false).
+		HelpText("The SKU of the variants to reprice. Defaults to the product code in Prisync.")
+
+	form.TextField("own_domain", "Your Store Domain").
+		Required(false).
+		HelpText("URLs of this domain are your own listings, not competitors, such as shop.example.com.")
+
+	form.NumberField("undercut_by", "Undercut By").
+		Required(false).
+		HelpText("The amount to price below the lowest competitor. Leave empty to match it.")
+
+	form.NumberField("undercut_percent", "Undercut By Percent").
+		Required(false).
+		HelpText("The percentage to price below the lowest competitor.")
+
+	form.NumberField("floor_price", "Floor Price").
+		Required(false).
+		HelpText("Never price below this.")
+
+	form.NumberField("ceiling_price", "Ceiling Price").
+		Required(false).
+		HelpText("Never price above this.")
+
+	form.NumberField("cost", "Cost").
+		Required(false).
+		HelpText("The product's cost. Defaults to the cost in Prisync.")
+
+	form.NumberField("min_margin_percent", "Minimum Margin Over Cost (%)").
+		Required(false).
+		HelpText("Never price below cost plus this percentage of cost.")
+
+	form.NumberField("min_change", "Minimum Change").
+		Required(false).
+		HelpText("Leave the price alone when the new price differs by less than this.")
+
+	form.CheckboxField("dry_run", "Dry Run").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Work out the new price without pushing it.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *RepriceProductAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *RepriceProductAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[repriceProductActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.ProductID == "" {
+		return nil, errors.New("product_id is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := shared.NewStore(input.Store, authCtx.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := shared.GetProductPricing(authCtx.Extra["api-key"], authCtx.Extra["api-token"], input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	sku := input.SKU
+	if sku == "" {
+		sku = product.ProductCode
+	}
+	if sku == "" {
+		return nil, errors.New("the product has no product code in Prisync, set the SKU")
+	}
+
+	variants, err := store.Variants(sku)
+	if err != nil {
+		return nil, err
+	}
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("no %s variant has the SKU %q", input.Store, sku)
+	}
+
+	rules := pricing.Rules{
+		UndercutBy:       input.UndercutBy,
+		UndercutPercent:  input.UndercutPercent,
+		Floor:            input.Floor,
+		Ceiling:          input.Ceiling,
+		Cost:             product.Cost,
+		MinMarginPercent: input.MinMarginPercent,
+		MinChange:        input.MinChange,
+	}
+	if input.Cost != nil {
+		rules.Cost = *input.Cost
+	}
+
+	competitors := product.Prices(input.OwnDomain)
+	prices := make([]float64, 0, len(competitors))
+	for _, p := range competitors {
+		prices = append(prices, p)
+	}
+
+	// Variants sharing a SKU are priced from the first one.
+	decision, err := pricing.Reprice(variants[0].Price, prices, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := false
+	if decision.Changed && !input.DryRun {
+		if err := store.SetPrice(variants, decision.Price); err != nil {
+			return nil, err
+		}
+		applied = true
+	}
+
+	return map[string]interface{}{
+		"product_id":              product.ID,
+		"product_name":            product.Name,
+		"store":                   input.Store,
+		"sku":                     sku,
+		"current_price":           decision.Current,
+		"on_sale":                 variants[0].OnSale,
+		"new_price":               decision.Price,
+		"lowest_competitor_price": decision.Lowest,
+		"target_price":            decision.Target,
+		"changed":                 decision.Changed,
+		"reason":                  decision.Reason,
+		"applied":                 applied,
+		"dry_run":                 input.DryRun,
+		"variants":                variants,
+		"competitors":             competitors,
+	}, nil
+}
+
+func NewRepriceProductAction() sdk.Action {
+	return &RepriceProductAction{}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/wakflo/extensions/internal/integrations/prisync/actions"
 	"github.com/wakflo/extensions/internal/integrations/prisync/shared"
+	"github.com/wakflo/extensions/internal/integrations/prisync/triggers"
 	"github.com/wakflo/go-sdk/v2"
 	"github.com/wakflo/go-sdk/v2/core"
 )
@@ -31,7 +32,9 @@ func (n *Prisync) Auth() *core.AuthMetadata {
 }
 
 func (n *Prisync) Triggers() []sdk.Trigger {
-	return []sdk.Trigger{}
+	return []sdk.Trigger{
+		triggers.NewCompetitorPriceChangedTrigger(),
+	}
 }
 
 func (n *Prisync) Actions() []sdk.Action {
@@ -47,6 +50,8 @@ func (n *Prisync) Actions() []sdk.Action {
 		actions.NewEditProductAction(),
 
 		actions.NewAddProductAction(),
+
+		actions.NewRepriceProductAction(),
 	}
 }
 
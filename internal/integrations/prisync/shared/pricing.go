@@ -0,0 +1,224 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxProductPages bounds the pages of products a listing reads.
+const maxProductPages = 20
+
+// Competitor is a tracked URL of a product and its last price.
+type Competitor struct {
+	URL      string  `json:"url"`
+	Domain   string  `json:"domain"`
+	Price    float64 `json:"price"`
+	InStock  bool    `json:"in_stock"`
+	Currency string  `json:"currency,omitempty"`
+}
+
+// ProductPricing is a product with the prices of its tracked URLs.
+type ProductPricing struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	ProductCode string       `json:"product_code"`
+	Cost        float64      `json:"cost"`
+	Competitors []Competitor `json:"competitors"`
+}
+
+// Prices returns the in-stock competitor prices by URL, leaving out the
+// URLs on the given own domain.
+func (p *ProductPricing) Prices(ownDomain string) map[string]float64 {
+	ownDomain = normalizeDomain(ownDomain)
+	prices := map[string]float64{}
+	for _, c := range p.Competitors {
+		if ownDomain != "" && (c.Domain == ownDomain || strings.HasSuffix(c.Domain, "."+ownDomain)) {
+			continue
+		}
+		if !c.InStock {
+			prices[c.URL] = 0
+			continue
+		}
+		prices[c.URL] = c.Price
+	}
+	return prices
+}
+
+// GetProductPricing reads a product and the last prices of its URLs.
+// Prisync lists URL IDs on the product, so each URL is read in turn
+// unless the product already carries its details.
+func GetProductPricing(apiKey, apiToken, productID string) (*ProductPricing, error) {
+	resp, err := PrisyncRequest(apiKey, apiToken, "/api/v2/get/product/id/"+url.PathEscape(productID), http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	product, ok := resp.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response for product %s", productID)
+	}
+	if msg, failed := errorMessage(product); failed {
+		return nil, fmt.Errorf("failed to get Prisync product %s: %s", productID, msg)
+	}
+
+	pricing := &ProductPricing{
+		ID:          IDString(product["id"]),
+		Name:        stringValue(product["name"]),
+		ProductCode: stringValue(product["product_code"]),
+		Cost:        floatValue(product["cost"]),
+		Competitors: []Competitor{},
+	}
+	if pricing.ID == "" {
+		pricing.ID = productID
+	}
+
+	urls, _ := product["urls"].([]interface{})
+	for _, item := range urls {
+		details, ok := item.(map[string]interface{})
+		if !ok {
+			id := IDString(item)
+			if id == "" {
+				continue
+			}
+			resp, err := PrisyncRequest(apiKey, apiToken, "/api/v2/get/url/id/"+url.PathEscape(id), http.MethodGet, nil)
+			if err != nil {
+				return nil, err
+			}
+			if details, ok = resp.(map[string]interface{}); !ok {
+				continue
+			}
+			if _, failed := errorMessage(details); failed {
+				continue
+			}
+		}
+		pricing.Competitors = append(pricing.Competitors, competitorFrom(details))
+	}
+	return pricing, nil
+}
+
+// ListProductIDs returns the IDs of all products, up to maxProductPages
+// pages of them.
+func ListProductIDs(apiKey, apiToken string) ([]string, error) {
+	var ids []string
+	endpoint := "/api/v2/list/product/startFrom/0"
+	for page := 0; page < maxProductPages && endpoint != ""; page++ {
+		resp, err := PrisyncRequest(apiKey, apiToken, endpoint, http.MethodGet, nil)
+		if err != nil {
+			return nil, err
+		}
+		body, ok := resp.(map[string]interface{})
+		if !ok {
+			break
+		}
+		results, _ := body["results"].([]interface{})
+		for _, item := range results {
+			if product, ok := item.(map[string]interface{}); ok {
+				if id := IDString(product["id"]); id != "" {
+					ids = append(ids, id)
+				}
+			}
+		}
+
+		next := stringValue(body["nextURL"])
+		if len(results) == 0 || next == "" {
+			break
+		}
+		// nextURL may be absolute.
+		if u, err := url.Parse(next); err == nil && u.Path != "" {
+			next = u.Path
+		}
+		endpoint = next
+	}
+	return ids, nil
+}
+
+func competitorFrom(details map[string]interface{}) Competitor {
+	c := Competitor{
+		URL:      stringValue(details["url"]),
+		Price:    floatValue(details["price"]),
+		Currency: stringValue(details["currency"]),
+		InStock:  true,
+	}
+	if u, err := url.Parse(c.URL); err == nil {
+		c.Domain = normalizeDomain(u.Host)
+	}
+	switch stock := details["stock"].(type) {
+	case string:
+		c.InStock = !strings.EqualFold(stock, "out of stock") && !strings.EqualFold(stock, "outofstock")
+	case bool:
+		c.InStock = stock
+	}
+	if c.Price <= 0 {
+		c.InStock = false
+	}
+	return c
+}
+
+// errorMessage reports the error of a Prisync response, which carries
+// an error field instead of failing with a status code.
+func errorMessage(body map[string]interface{}) (string, bool) {
+	switch e := body["error"].(type) {
+	case nil:
+		return "", false
+	case string:
+		return e, e != ""
+	case bool:
+		return stringValue(body["errorMessage"]), e
+	default:
+		return fmt.Sprint(e), true
+	}
+}
+
+func normalizeDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimPrefix(strings.TrimPrefix(domain, "https://"), "http://")
+	domain = strings.TrimSuffix(domain, "/")
+	return strings.TrimPrefix(domain, "www.")
+}
+
+// IDString converts a Prisync ID, which may be a number, to a string.
+func IDString(v interface{}) string {
+	switch id := v.(type) {
+	case string:
+		return id
+	case float64:
+		return strconv.FormatFloat(id, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(id)
+	}
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// floatValue reads a number Prisync may send as a string.
+func floatValue(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case string:
+		f, _ := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f
+	}
+	return 0
+}
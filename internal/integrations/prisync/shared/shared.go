@@ -40,6 +40,24 @@ var (
 		Required(true).
 		HelpText("The api token used to authenticate prisync.")
 
+	_ = form.TextField("shopify-domain", "Shopify Shop Name").
+		Required(false).
+		HelpText("To push prices to Shopify: the shop name in your admin URL, **example** for https://example.myshopify.com/admin.")
+
+	_ = form.TextField("shopify-token", "Shopify Admin Token").
+		Required(false).
+		HelpText("An admin API token with the read_products and write_products scopes.")
+
+	_ = form.TextField("woocommerce-url", "WooCommerce Store URL").
+		Required(false).
+		HelpText("To push prices to WooCommerce: the store URL, such as https://shop.example.com.")
+
+	_ = form.TextField("woocommerce-consumer-key", "WooCommerce Consumer Key").
+		Required(false)
+
+	_ = form.TextField("woocommerce-consumer-secret", "WooCommerce Consumer Secret").
+		Required(false)
+
 	PrisyncSharedAuth = form.Build()
 )
 
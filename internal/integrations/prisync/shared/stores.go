@@ -0,0 +1,210 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/juicycleff/smartform/v1"
+	shopifyshared "github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	wooshared "github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+)
+
+// Stores prices can be pushed to.
+const (
+	StoreShopify     = "shopify"
+	StoreWooCommerce = "woocommerce"
+)
+
+var StoreOptions = []*smartform.Option{
+	{Value: StoreShopify, Label: "Shopify"},
+	{Value: StoreWooCommerce, Label: "WooCommerce"},
+}
+
+// Variant is a sellable product or variant of a store, found by SKU.
+type Variant struct {
+	ID        string `json:"id"`
+	ProductID string `json:"product_id,omitempty"`
+	SKU       string `json:"sku"`
+	// Price is the price charged, the sale price while a sale is on.
+	Price float64 `json:"price"`
+	// RegularPrice and OnSale are set by stores with separate sale prices.
+	RegularPrice float64 `json:"regular_price,omitempty"`
+	OnSale       bool    `json:"on_sale,omitempty"`
+}
+
+// Store reads and writes the prices of variants by SKU.
+type Store interface {
+	Variants(sku string) ([]Variant, error)
+	SetPrice(variants []Variant, price float64) error
+}
+
+// NewStore returns the store of the given name, with the credentials of
+// the connection.
+func NewStore(name string, values map[string]string) (Store, error) {
+	switch name {
+	case StoreShopify:
+		if values["shopify-domain"] == "" || values["shopify-token"] == "" {
+			return nil, errors.New("the connection has no Shopify shop name and admin token")
+		}
+		client, err := shopifyshared.NewGraphQLClient(values["shopify-domain"], values["shopify-token"])
+		if err != nil {
+			return nil, err
+		}
+		return &shopifyStore{client: client}, nil
+	case StoreWooCommerce:
+		creds := &wooshared.Credentials{
+			ShopURL:        strings.TrimRight(values["woocommerce-url"], "/"),
+			ConsumerKey:    values["woocommerce-consumer-key"],
+			ConsumerSecret: values["woocommerce-consumer-secret"],
+		}
+		if creds.ShopURL == "" || creds.ConsumerKey == "" || creds.ConsumerSecret == "" {
+			return nil, errors.New("the connection has no WooCommerce store URL and API keys")
+		}
+		return &wooCommerceStore{creds: creds}, nil
+	default:
+		return nil, fmt.Errorf("unknown store %q", name)
+	}
+}
+
+const shopifyVariantsQuery = `query($query: String!) {
+  productVariants(first: 50, query: $query) {
+    nodes { id sku price product { id } }
+  }
+}`
+
+const shopifyPriceMutation = `mutation($productId: ID!, $variants: [ProductVariantsBulkInput!]!) {
+  productVariantsBulkUpdate(productId: $productId, variants: $variants) {
+    userErrors { field message }
+  }
+}`
+
+type shopifyStore struct {
+	client *goshopify.Client
+}
+
+// Variants returns the variants with the SKU. SKU search matches
+// prefixes, so only exact SKUs are kept.
+func (s *shopifyStore) Variants(sku string) ([]Variant, error) {
+	var resp struct {
+		ProductVariants struct {
+			Nodes []struct {
+				ID      string `json:"id"`
+				SKU     string `json:"sku"`
+				Price   string `json:"price"`
+				Product struct {
+					ID string `json:"id"`
+				} `json:"product"`
+			} `json:"nodes"`
+		} `json:"productVariants"`
+	}
+	vars := map[string]interface{}{"query": "sku:" + strconv.Quote(sku)}
+	if err := s.client.GraphQL.Query(context.Background(), shopifyVariantsQuery, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	var variants []Variant
+	for _, node := range resp.ProductVariants.Nodes {
+		if node.SKU != sku {
+			continue
+		}
+		price, _ := strconv.ParseFloat(node.Price, 64)
+		variants = append(variants, Variant{ID: node.ID, ProductID: node.Product.ID, SKU: node.SKU, Price: price})
+	}
+	return variants, nil
+}
+
+// SetPrice updates the variants, one bulk update per product.
+func (s *shopifyStore) SetPrice(variants []Variant, price float64) error {
+	byProduct := map[string][]map[string]interface{}{}
+	var products []string
+	for _, v := range variants {
+		if _, ok := byProduct[v.ProductID]; !ok {
+			products = append(products, v.ProductID)
+		}
+		byProduct[v.ProductID] = append(byProduct[v.ProductID], map[string]interface{}{
+			"id":    v.ID,
+			"price": strconv.FormatFloat(price, 'f', 2, 64),
+		})
+	}
+
+	for _, product := range products {
+		vars := map[string]interface{}{"productId": product, "variants": byProduct[product]}
+		if err := shopifyshared.Mutate(context.Background(), s.client, "productVariantsBulkUpdate",
+			shopifyPriceMutation, vars, nil); err != nil {
+			return fmt.Errorf("shopify: %w", err)
+		}
+	}
+	return nil
+}
+
+type wooCommerceStore struct {
+	creds *wooshared.Credentials
+}
+
+// Variants returns the product or variation with the SKU, which is
+// unique in WooCommerce. Its price is the price charged, so a running
+// sale is what competitors are compared with.
+func (s *wooCommerceStore) Variants(sku string) ([]Variant, error) {
+	levels, err := s.creds.PriceLevels([]string{sku})
+	if err != nil {
+		return nil, err
+	}
+	level, ok := levels[sku]
+	if !ok {
+		return nil, nil
+	}
+	v := Variant{ID: strconv.Itoa(level.ID), SKU: sku, Price: level.Price, RegularPrice: level.RegularPrice}
+	if v.Price <= 0 {
+		v.Price = level.RegularPrice
+	}
+	v.OnSale = level.RegularPrice > 0 && v.Price < level.RegularPrice
+	if level.ParentID != 0 {
+		v.ProductID = strconv.Itoa(level.ParentID)
+	}
+	return []Variant{v}, nil
+}
+
+// SetPrice sets the price charged with the batch endpoints: the sale price
+// of variants on sale, the regular price of the others.
+func (s *wooCommerceStore) SetPrice(variants []Variant, price float64) error {
+	updates := make([]wooshared.StockUpdate, 0, len(variants))
+	for _, v := range variants {
+		id, _ := strconv.Atoi(v.ID)
+		parent, _ := strconv.Atoi(v.ProductID)
+		p := price
+		update := wooshared.StockUpdate{ID: id, ParentID: parent, SKU: v.SKU}
+		if v.OnSale {
+			update.SalePrice = &p
+		} else {
+			update.RegularPrice = &p
+		}
+		updates = append(updates, update)
+	}
+
+	result, err := s.creds.BatchUpdateStock(updates)
+	if err != nil {
+		return fmt.Errorf("woocommerce: %w", err)
+	}
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("woocommerce: %v", result.Failed[0]["error"])
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/prisync/shared"
+	"github.com/wakflo/extensions/internal/pricing"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+const snapshotKey = "snapshot"
+
+// maxWatchedProducts bounds the products one poll reads, since each
+// product costs a request per tracked URL.
+const maxWatchedProducts = 200
+
+type competitorPriceChangedTriggerProps struct {
+	ProductIDs       string  `json:"product_ids"`
+	OwnDomain        string  `json:"own_domain"`
+	ThresholdPercent float64 `json:"threshold_percent"`
+	ThresholdAmount  float64 `json:"threshold_amount"`
+}
+
+type CompetitorPriceChangedTrigger struct{}
+
+func (t *CompetitorPriceChangedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "competitor_price_changed",
+		DisplayName:   "Competitor Price Changed",
+		Description:   "Polls the competitor prices Prisync tracks and triggers once per product whose prices moved past a threshold since they were last reported.",
+		Type:          core.TriggerTypePolling,
+		Documentation: competitorPriceChangedDocs,
+		SampleOutput:  []map[string]any{priceChangeSample},
+	}
+}
+
+func (t *CompetitorPriceChangedTrigger) GetType() core.TriggerType {
+	return core.TriggerTypePolling
+}
+
+func (t *CompetitorPriceChangedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("competitor_price_changed", "Competitor Price Changed")
+
+	form.TextareaField("product_ids", "Product IDs").
+		Required(false).
+		HelpText("Prisync product IDs to watch, separated by commas or new lines. Leave empty to watch all products.")
+
+	form.TextField("own_domain", "Your Store Domain").
+		Required(false).
+		HelpText("URLs of this domain are your own listings and are ignored, such as shop.example.com.")
+
+	form.NumberField("threshold_percent", "Threshold (%)").
+		Required(false).
+		HelpText("Fire when a competitor price moves by at least this percentage.")
+
+	form.NumberField("threshold_amount", "Threshold Amount").
+		Required(false).
+		HelpText("Fire when a competitor price moves by at least this amount. With no threshold set, every change fires.")
+
+	schema := form.Build()
+	return schema
+}
+
+// Start initializes the CompetitorPriceChangedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *CompetitorPriceChangedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the CompetitorPriceChangedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *CompetitorPriceChangedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the watched products whose competitor prices moved past
+// the threshold since they were last reported. A competitor's price in
+// the snapshot only advances once its change is reported, so small moves
+// add up. The first poll only takes the snapshot.
+func (t *CompetitorPriceChangedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[competitorPriceChangedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	apiKey, apiToken := authCtx.Extra["api-key"], authCtx.Extra["api-token"]
+
+	ids := splitIDs(input.ProductIDs)
+	if len(ids) == 0 {
+		if ids, err = shared.ListProductIDs(apiKey, apiToken); err != nil {
+			return nil, err
+		}
+	}
+	if len(ids) > maxWatchedProducts {
+		ctx.Logger().Warn("watching only the first products", "count", maxWatchedProducts, "total", len(ids))
+		ids = ids[:maxWatchedProducts]
+	}
+
+	prev, first := loadSnapshot(ctx)
+	next := pricing.Snapshot{}
+	threshold := pricing.Threshold{Amount: input.ThresholdAmount, Percent: input.ThresholdPercent}
+
+	events := make([]map[string]interface{}, 0)
+	for _, id := range ids {
+		product, err := shared.GetProductPricing(apiKey, apiToken, id)
+		if err != nil {
+			return nil, fmt.Errorf("product %s: %w", id, err)
+		}
+		prices := product.Prices(input.OwnDomain)
+
+		old, known := prev[id]
+		if first || !known {
+			next[id] = prices
+			continue
+		}
+		changes := pricing.Diff(old, prices, threshold)
+		next[id] = pricing.Advance(old, prices, changes)
+		if len(changes) == 0 {
+			continue
+		}
+		events = append(events, map[string]interface{}{
+			"product_id":                       product.ID,
+			"product_name":                     product.Name,
+			"product_code":                     product.ProductCode,
+			"cost":                             product.Cost,
+			"changes":                          changes,
+			"lowest_competitor_price":          pricing.Lowest(prices),
+			"previous_lowest_competitor_price": pricing.Lowest(old),
+			"competitors":                      prices,
+		})
+	}
+
+	if err := ctx.SetMetadata(snapshotKey, next); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (t *CompetitorPriceChangedTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return core.TriggerCriteria{}
+}
+
+func (t *CompetitorPriceChangedTrigger) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (t *CompetitorPriceChangedTrigger) SampleData() core.JSON {
+	return priceChangeSample
+}
+
+// loadSnapshot returns the prices of the last poll, and whether there
+// was none.
+func loadSnapshot(ctx sdkcontext.ExecuteContext) (pricing.Snapshot, bool) {
+	stored, err := ctx.GetMetadata(snapshotKey)
+	if err != nil || stored == nil {
+		return pricing.Snapshot{}, true
+	}
+
+	var snapshot pricing.Snapshot
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &snapshot)
+	}
+	if err != nil || snapshot == nil {
+		ctx.Logger().Warn("ignoring an unreadable price snapshot", "error", err)
+		return pricing.Snapshot{}, true
+	}
+	return snapshot, false
+}
+
+func splitIDs(s string) []string {
+	var ids []string
+	for _, id := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '\n' || r == ' ' }) {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+var priceChangeSample = map[string]any{
+	"product_id":   "3422",
+	"product_name": "Wireless Mouse",
+	"product_code": "MOUSE-01",
+	"cost":         12.5,
+	"changes": []map[string]any{
+		{
+			"competitor":     "https://competitor.example.com/mouse",
+			"old_price":      25.99,
+			"new_price":      23.99,
+			"change":         -2,
+			"change_percent": -7.7,
+		},
+	},
+	"lowest_competitor_price":          23.99,
+	"previous_lowest_competitor_price": 24.49,
+	"competitors": map[string]any{
+		"https://competitor.example.com/mouse": 23.99,
+		"https://other.example.com/p/123":      24.49,
+	},
+}
+
+func NewCompetitorPriceChangedTrigger() sdk.Trigger {
+	return &CompetitorPriceChangedTrigger{}
+}
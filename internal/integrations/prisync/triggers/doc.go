@@ -0,0 +1,6 @@
+package triggers
+
+import _ "embed"
+
+//go:embed competitor_price_changed.md
+var competitorPriceChangedDocs string
@@ -0,0 +1,71 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/prompt"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type ListPromptTemplatesAction struct{}
+
+func (a *ListPromptTemplatesAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_prompt_templates",
+		DisplayName:   "List Prompt Templates",
+		Description:   "List the templates of a prompt library with their versions, published version and variables.",
+		Type:          core.ActionTypeAction,
+		Documentation: listPromptTemplatesDocs,
+		SampleOutput: map[string]any{
+			"templates": []map[string]any{
+				{
+					"name":              "support-reply",
+					"description":       "Reply to a customer about an order",
+					"versions":          []int{1, 2},
+					"latest_version":    2,
+					"published_version": 1,
+					"variables":         []string{"company", "customer", "order_id"},
+					"examples":          0,
+				},
+			},
+			"count": 1,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *ListPromptTemplatesAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_prompt_templates", "List Prompt Templates")
+
+	prompt.RegisterLibraryProps(form, true)
+
+	return form.Build()
+}
+
+func (a *ListPromptTemplatesAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *ListPromptTemplatesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input := prompt.InputFrom(ctx.Input())
+
+	lib, err := prompt.LoadLibrary(ctx.Context(), ctx.Files(), input.Library)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]map[string]interface{}, 0, len(lib.Templates))
+	for _, tmpl := range lib.Templates {
+		templates = append(templates, tmpl.Summary())
+	}
+
+	return map[string]interface{}{
+		"templates": templates,
+		"count":     len(templates),
+	}, nil
+}
+
+func NewListPromptTemplatesAction() sdk.Action {
+	return &ListPromptTemplatesAction{}
+}
@@ -0,0 +1,100 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/aiusage"
+	"github.com/wakflo/extensions/internal/prompt"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type RenderPromptTemplateAction struct{}
+
+func (a *RenderPromptTemplateAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "render_prompt_template",
+		DisplayName:   "Render Prompt Template",
+		Description:   "Fill in a prompt template with step data and return the system and user prompt text, to preview a version or pass the prompt to any action.",
+		Type:          core.ActionTypeAction,
+		Documentation: renderPromptTemplateDocs,
+		SampleOutput: map[string]any{
+			"system":            "You are a friendly support agent for Acme.",
+			"prompt":            "Reply to Ada about order 1042, which is delayed.",
+			"template":          "support-reply",
+			"version":           2,
+			"ref":               "support-reply@2",
+			"examples":          0,
+			"characters":        90,
+			"estimated_tokens":  23,
+			"versions":          []int{1, 2},
+			"latest_version":    2,
+			"published_version": 1,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *RenderPromptTemplateAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("render_prompt_template", "Render Prompt Template")
+
+	prompt.RegisterPreviewProps(form)
+
+	return form.Build()
+}
+
+func (a *RenderPromptTemplateAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *RenderPromptTemplateAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input := prompt.InputFrom(ctx.Input())
+
+	ref, err := prompt.ParseRef(input.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	lib, err := prompt.LoadLibrary(ctx.Context(), ctx.Files(), input.Library)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, rev, err := lib.Resolve(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := prompt.Variables(input.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := prompt.Render(tmpl.Name, rev, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	output := map[string]interface{}{
+		"system":            rendered.System,
+		"prompt":            rendered.Prompt,
+		"template":          tmpl.Name,
+		"version":           rev.Version,
+		"ref":               prompt.Ref{Name: tmpl.Name, Version: rev.Version}.String(),
+		"examples":          rendered.Examples,
+		"characters":        len([]rune(rendered.System)) + len([]rune(rendered.Prompt)),
+		"estimated_tokens":  aiusage.EstimateTokens(rendered.System, rendered.Prompt),
+		"versions":          tmpl.VersionNumbers(),
+		"latest_version":    tmpl.Latest(),
+		"published_version": tmpl.PublishedVersion(),
+	}
+	if rev.Notes != "" {
+		output["notes"] = rev.Notes
+	}
+
+	return output, nil
+}
+
+func NewRenderPromptTemplateAction() sdk.Action {
+	return &RenderPromptTemplateAction{}
+}
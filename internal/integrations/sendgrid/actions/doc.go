@@ -0,0 +1,6 @@
+package actions
+
+import _ "embed"
+
+//go:embed send_email.md
+var sendEmailDocs string
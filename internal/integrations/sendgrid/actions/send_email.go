@@ -0,0 +1,73 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/integrations/sendgrid/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type SendEmailAction struct{}
+
+func (a *SendEmailAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "send_email",
+		DisplayName:   "Send Email",
+		Description:   "Send a transactional email through SendGrid, from HTML and text bodies or a dynamic template, with attachments, CC, BCC and custom headers.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: sendEmailDocs,
+		SampleOutput: map[string]any{
+			"provider":   "sendgrid",
+			"message_id": "14c5d75ce93dfd7d8d6bdaa3",
+			"accepted":   []string{"jane.doe@example.com"},
+			"sent":       true,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *SendEmailAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("send_email", "Send Email")
+
+	email.RegisterSenderProps(form, true)
+	email.RegisterMessageProps(form)
+	email.RegisterTemplateProps(form, true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *SendEmailAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	msg, err := email.MessageFrom(ctx.Context(), ctx.Files(), ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.ClientFromAuth(authCtx.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.Send(ctx.Context(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Output(), nil
+}
+
+func (a *SendEmailAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewSendEmailAction() sdk.Action {
+	return &SendEmailAction{}
+}
@@ -0,0 +1,48 @@
+package sendgrid
+
+import (
+	_ "embed"
+
+	"github.com/wakflo/extensions/internal/integrations/sendgrid/actions"
+	"github.com/wakflo/extensions/internal/integrations/sendgrid/shared"
+	"github.com/wakflo/extensions/internal/integrations/sendgrid/triggers"
+	"github.com/wakflo/go-sdk/v2"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+//go:embed README.md
+var ReadME string
+
+//go:embed flo.toml
+var Flow string
+
+var Integration = sdk.Register(NewSendGrid())
+
+type SendGrid struct{}
+
+func (n *SendGrid) Metadata() sdk.IntegrationMetadata {
+	return sdk.LoadMetadataFromFlo(Flow, ReadME)
+}
+
+func (n *SendGrid) Auth() *core.AuthMetadata {
+	return &core.AuthMetadata{
+		Required: true,
+		Schema:   shared.SharedAuth,
+	}
+}
+
+func (n *SendGrid) Triggers() []sdk.Trigger {
+	return []sdk.Trigger{
+		triggers.NewEmailEventTrigger(),
+	}
+}
+
+func (n *SendGrid) Actions() []sdk.Action {
+	return []sdk.Action{
+		actions.NewSendEmailAction(),
+	}
+}
+
+func NewSendGrid() sdk.Integration {
+	return &SendGrid{}
+}
@@ -0,0 +1,49 @@
+package shared
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/webhook"
+)
+
+var (
+	form = smartform.NewAuthForm("sendgrid-auth", "SendGrid API Key", smartform.AuthStrategyCustom)
+
+	_ = form.TextField("api_key", "API Key").
+		Required(true).
+		HelpText("An API key from Settings > API Keys with Mail Send access, and Mail Settings access for the event trigger.")
+
+	_ = form.TextField("base_url", "API URL").
+		Required(false).
+		DefaultValue(email.SendGridURL).
+		Placeholder(email.SendGridURL).
+		HelpText("https://api.eu.sendgrid.com for EU regional subusers, or the URL of another service with a SendGrid-compatible API.")
+
+	SharedAuth = form.Build()
+)
+
+// Client calls the SendGrid API and sends messages through it.
+type Client struct {
+	email.SendGridSender
+}
+
+// ClientFromAuth returns a client for the connection.
+func ClientFromAuth(extra map[string]string) (*Client, error) {
+	apiKey := strings.TrimSpace(extra["api_key"])
+	if apiKey == "" {
+		return nil, errors.New("a SendGrid API key is required")
+	}
+	return &Client{email.SendGridSender{
+		APIKey:  apiKey,
+		BaseURL: strings.TrimSpace(extra["base_url"]),
+	}}, nil
+}
+
+// ClientFromConfig returns a client for the connection in a trigger
+// config.
+func ClientFromConfig(config map[string]interface{}) (*Client, error) {
+	return ClientFromAuth(webhook.Connection(config))
+}
@@ -0,0 +1,76 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/webhook"
+)
+
+// Headers SendGrid signs event webhook deliveries with.
+const (
+	SignatureHeader = "X-Twilio-Email-Event-Webhook-Signature"
+	TimestampHeader = "X-Twilio-Email-Event-Webhook-Timestamp"
+)
+
+// EventWebhook is an event webhook of the account.
+type EventWebhook struct {
+	ID        string `json:"id"`
+	PublicKey string `json:"public_key"`
+}
+
+// CreateEventWebhook sends the events named by the settings of
+// email.SendGridEventNames to endpoint and returns the webhook.
+func (c *Client) CreateEventWebhook(ctx context.Context, name, endpoint string, events []string) (*EventWebhook, error) {
+	body := map[string]interface{}{
+		"enabled":       true,
+		"url":           endpoint,
+		"friendly_name": name,
+	}
+	for _, event := range events {
+		body[event] = true
+	}
+
+	var webhook EventWebhook
+	if _, err := c.Request(ctx, http.MethodPost, "/v3/user/webhooks/event/settings", body, &webhook); err != nil {
+		return nil, err
+	}
+	if webhook.ID == "" {
+		return nil, errors.New("sendgrid returned no event webhook ID")
+	}
+	return &webhook, nil
+}
+
+// EnableSigning turns on signed deliveries for a webhook and returns the
+// public key that verifies them.
+func (c *Client) EnableSigning(ctx context.Context, id string) (string, error) {
+	var resp EventWebhook
+	_, err := c.Request(ctx, http.MethodPatch, "/v3/user/webhooks/event/settings/signed/"+url.PathEscape(id),
+		map[string]interface{}{"enabled": true}, &resp)
+	if err != nil {
+		return "", err
+	}
+	if resp.PublicKey == "" {
+		return "", errors.New("sendgrid returned no signing key")
+	}
+	return resp.PublicKey, nil
+}
+
+// DeleteEventWebhook removes an event webhook.
+func (c *Client) DeleteEventWebhook(ctx context.Context, id string) error {
+	_, err := c.Request(ctx, http.MethodDelete, "/v3/user/webhooks/event/settings/"+url.PathEscape(id), nil, nil)
+	var apiErr *email.SendGridError
+	if errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// VerifyDelivery checks the signature of an event webhook delivery.
+func VerifyDelivery(publicKey string, delivery webhook.Delivery) error {
+	return email.VerifySendGridSignature(publicKey,
+		delivery.Header(SignatureHeader), delivery.Header(TimestampHeader), delivery.Body)
+}
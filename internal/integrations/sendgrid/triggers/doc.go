@@ -0,0 +1,6 @@
+package triggers
+
+import _ "embed"
+
+//go:embed email_event.md
+var emailEventDocs string
@@ -0,0 +1,167 @@
+package triggers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/integrations/sendgrid/shared"
+	"github.com/wakflo/extensions/internal/webhook"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+const (
+	webhookIDKey = "webhookId"
+	publicKeyKey = "publicKey"
+)
+
+// EmailEventTrigger receives signed SendGrid event webhook deliveries as
+// delivery events in the shape every email provider's event trigger
+// uses.
+type EmailEventTrigger struct{}
+
+func (t *EmailEventTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "email_event",
+		DisplayName:   "Email Event (Instant)",
+		Description:   "Triggered instantly when SendGrid reports that an email was processed, delivered, deferred, bounced, dropped, opened, clicked or marked as spam.",
+		Type:          sdkcore.TriggerTypeWebhook,
+		Documentation: emailEventDocs,
+		SampleOutput: []map[string]any{
+			{
+				"provider":   "sendgrid",
+				"type":       "opened",
+				"email":      "jane.doe@example.com",
+				"message_id": "14c5d75ce93dfd7d8d6bdaa3",
+				"timestamp":  "2025-03-12T18:20:00Z",
+				"permanent":  false,
+				"user_agent": "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)",
+				"ip":         "203.0.113.7",
+				"tags":       []string{"welcome"},
+				"raw": map[string]any{
+					"email":         "jane.doe@example.com",
+					"event":         "open",
+					"timestamp":     1741803600,
+					"sg_event_id":   "c3BlbmRfZXZlbnQ",
+					"sg_message_id": "14c5d75ce93dfd7d8d6bdaa3.filterdrecv-p3mdw1-756b745b58-kmzbl-18-5F5FC76C-9.0",
+					"category":      []string{"welcome"},
+					"useragent":     "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)",
+					"ip":            "203.0.113.7",
+				},
+			},
+		},
+	}
+}
+
+func (t *EmailEventTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypeWebhook
+}
+
+func (t *EmailEventTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("email_event", "Email Event (Instant)")
+
+	email.RegisterEventTypeProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start creates a signed event webhook for the trigger's URL and keeps
+// its public key to verify deliveries.
+func (t *EmailEventTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	client, err := shared.ClientFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+
+	criteria, err := ctx.TriggerCriteria()
+	if err != nil {
+		return err
+	}
+	if criteria == nil || criteria.Webhook == nil || criteria.Webhook.Endpoint == "" {
+		return errors.New("the trigger has no webhook endpoint")
+	}
+
+	name := fmt.Sprintf("Wakflo %s", ctx.TriggerID())
+	events := email.SendGridEventNames(email.EventTypesFrom(ctx.Input()))
+	webhook, err := client.CreateEventWebhook(ctx.Context(), name, criteria.Webhook.Endpoint, events)
+	if err != nil {
+		return fmt.Errorf("failed to create the SendGrid event webhook: %w", err)
+	}
+
+	publicKey, err := client.EnableSigning(ctx.Context(), webhook.ID)
+	if err != nil {
+		// An unsigned webhook would only deliver rejected events.
+		_ = client.DeleteEventWebhook(ctx.Context(), webhook.ID)
+		return fmt.Errorf("failed to sign the SendGrid event webhook: %w", err)
+	}
+
+	if err := ctx.StoreMetadata(webhookIDKey, webhook.ID); err != nil {
+		return err
+	}
+	return ctx.StoreMetadata(publicKeyKey, publicKey)
+}
+
+// Stop removes the webhook created by Start.
+func (t *EmailEventTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	stored, err := ctx.GetMetadata(webhookIDKey)
+	if err != nil || stored == nil {
+		return err
+	}
+	id, ok := stored.(string)
+	if !ok {
+		return fmt.Errorf("invalid stored webhook id %v", stored)
+	}
+
+	client, err := shared.ClientFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+	return client.DeleteEventWebhook(ctx.Context(), id)
+}
+
+func (t *EmailEventTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	delivery, err := webhook.DeliveryFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := ctx.GetMetadata(publicKeyKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, _ := stored.(string)
+	if publicKey == "" {
+		return nil, errors.New("the webhook signing key is unknown; restart the trigger")
+	}
+	if err := shared.VerifyDelivery(publicKey, delivery); err != nil {
+		return nil, err
+	}
+
+	events, err := email.ParseSendGridEvents(delivery.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return email.EventsOutput(email.FilterEvents(events, email.EventTypesFrom(ctx.Input()))), nil
+}
+
+func (t *EmailEventTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	webhook := sdkcore.NewWebhookTriggerCriteria()
+	webhook.Enabled = true
+	webhook.ContentType = "application/json"
+	return sdkcore.TriggerCriteria{Webhook: webhook}
+}
+
+func (t *EmailEventTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewEmailEventTrigger() sdk.Trigger {
+	return &EmailEventTrigger{}
+}
@@ -35,6 +35,9 @@ func (s *SendOwl) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewOrderCompletedTrigger(),
 		triggers.NewProductUpdatedTrigger(),
+		triggers.NewNewOrderTrigger(),
+		triggers.NewOrderRefundedTrigger(),
+		triggers.NewNewSubscriptionTrigger(),
 	}
 }
 
@@ -0,0 +1,107 @@
+// shared/orders.go
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wakflo/extensions/internal/polling"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+const (
+	// maxOrderPages bounds the pages of orders one poll reads.
+	maxOrderPages = 10
+
+	ordersPerPage = 50
+)
+
+// ListOrdersSince lists the orders placed on or after the day of since,
+// optionally with a status, unwrapping the {"order": {...}} items.
+func ListOrdersSince(apiKey, apiSecret string, since time.Time, status string) ([]map[string]interface{}, error) {
+	params := url.Values{}
+	params.Set("per_page", strconv.Itoa(ordersPerPage))
+	params.Set("from_date", since.UTC().Format("2006-01-02"))
+	if status != "" {
+		params.Set("status", status)
+	}
+
+	var orders []map[string]interface{}
+	for page := 1; page <= maxOrderPages; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		response, err := GetSendOwlClient(AltBaseURL, apiKey, apiSecret, "/orders?"+params.Encode())
+		if err != nil {
+			return nil, fmt.Errorf("error fetching orders: %v", err)
+		}
+		if !response.IsArray {
+			return nil, errors.New("unexpected response format: expected array of orders")
+		}
+
+		for _, item := range response.Array {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if order, ok := itemMap["order"].(map[string]interface{}); ok {
+				orders = append(orders, order)
+			}
+		}
+
+		if len(response.Array) < ordersPerPage {
+			break
+		}
+	}
+	return orders, nil
+}
+
+// OrderID returns the ID of an order as a string. SendOwl sends numeric IDs.
+func OrderID(order map[string]interface{}) string {
+	switch id := order["id"].(type) {
+	case string:
+		return id
+	case float64:
+		return strconv.FormatInt(int64(id), 10)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(id)
+	}
+}
+
+// OrderState returns the state of an order, lower-cased. Older API
+// versions call it status.
+func OrderState(order map[string]interface{}) string {
+	state, _ := order["state"].(string)
+	if state == "" {
+		state, _ = order["status"].(string)
+	}
+	return strings.ToLower(state)
+}
+
+// UnseenOrders returns the orders not returned under key before, in
+// order, and remembers them so a trigger fires once per order.
+func UnseenOrders(ctx sdkcontext.ExecuteContext, key string, orders []map[string]interface{}) []map[string]interface{} {
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		if id := OrderID(order); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	unseen := polling.Unseen(ctx, key, ids)
+
+	fresh := make([]map[string]interface{}, 0)
+	for _, order := range orders {
+		id := OrderID(order)
+		if !unseen[id] {
+			continue
+		}
+		delete(unseen, id)
+		fresh = append(fresh, order)
+	}
+	return fresh
+}
@@ -8,3 +8,12 @@ var orderCompletedDocs string
 
 //go:embed product_updated.md
 var productUpdatedDocs string
+
+//go:embed new_order.md
+var newOrderDocs string
+
+//go:embed order_refunded.md
+var orderRefundedDocs string
+
+//go:embed new_subscription.md
+var newSubscriptionDocs string
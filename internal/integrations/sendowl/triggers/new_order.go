@@ -0,0 +1,85 @@
+// triggers/new_order.go
+package triggers
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/sendowl/shared"
+
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type newOrderTriggerProps struct {
+	IncludeSubscriptions bool `json:"include_subscriptions"`
+}
+
+type NewOrderTrigger struct{}
+
+func (t *NewOrderTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "new_order",
+		DisplayName:   "New Order",
+		Description:   "Triggers a workflow once for each new paid order in your SendOwl account.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: newOrderDocs,
+		SampleOutput:  orderSample("complete"),
+	}
+}
+
+func (t *NewOrderTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *NewOrderTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("new_order", "New Order")
+
+	form.CheckboxField("include_subscriptions", "Include Subscription Orders").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Also fire for the orders that start a subscription.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the NewOrderTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *NewOrderTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the NewOrderTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *NewOrderTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the paid orders placed since the last run. SendOwl
+// filters orders by day, so orders already returned are remembered.
+func (t *NewOrderTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[newOrderTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pollOrders(ctx, "seenOrders:new", newSince(ctx), "", func(order map[string]interface{}) bool {
+		if !input.IncludeSubscriptions && isSubscription(order) {
+			return false
+		}
+		return paidStates[shared.OrderState(order)]
+	}, false)
+}
+
+func (t *NewOrderTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *NewOrderTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewNewOrderTrigger() sdk.Trigger {
+	return &NewOrderTrigger{}
+}
@@ -0,0 +1,72 @@
+// triggers/new_subscription.go
+package triggers
+
+import (
+	"context"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/sendowl/shared"
+
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type NewSubscriptionTrigger struct{}
+
+func (t *NewSubscriptionTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "new_subscription",
+		DisplayName:   "New Subscription",
+		Description:   "Triggers a workflow once for each new active subscription in your SendOwl account.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: newSubscriptionDocs,
+		SampleOutput:  orderSample("subscription_active"),
+	}
+}
+
+func (t *NewSubscriptionTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *NewSubscriptionTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("new_subscription", "New Subscription")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the NewSubscriptionTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *NewSubscriptionTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the NewSubscriptionTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *NewSubscriptionTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the subscription orders that have become active. An
+// order can be activated a while after it is placed, so orders from a day
+// before the last run are read again.
+func (t *NewSubscriptionTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	since := newSince(ctx).Add(-24 * time.Hour)
+
+	return pollOrders(ctx, "seenOrders:subscription", since, "", func(order map[string]interface{}) bool {
+		return shared.OrderState(order) == "subscription_active"
+	}, false)
+}
+
+func (t *NewSubscriptionTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *NewSubscriptionTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewNewSubscriptionTrigger() sdk.Trigger {
+	return &NewSubscriptionTrigger{}
+}
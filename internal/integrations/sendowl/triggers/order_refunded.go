@@ -0,0 +1,90 @@
+// triggers/order_refunded.go
+package triggers
+
+import (
+	"context"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/sendowl/shared"
+
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type orderRefundedTriggerProps struct {
+	LookbackDays int `json:"lookback_days"`
+}
+
+type OrderRefundedTrigger struct{}
+
+func (t *OrderRefundedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "order_refunded",
+		DisplayName:   "Order Refunded",
+		Description:   "Triggers a workflow once for each order refunded in your SendOwl account.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: orderRefundedDocs,
+		SampleOutput:  orderSample("refunded"),
+	}
+}
+
+func (t *OrderRefundedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *OrderRefundedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("order_refunded", "Order Refunded")
+
+	form.NumberField("lookback_days", "Lookback Days").
+		Required(false).
+		DefaultValue(30).
+		HelpText("How old an order can be for its refund to be noticed.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the OrderRefundedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *OrderRefundedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the OrderRefundedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *OrderRefundedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the orders of the lookback window that became refunded.
+// Refunds change old orders, so the whole window is read each poll and
+// the first poll only records the orders already refunded.
+func (t *OrderRefundedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[orderRefundedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	days := input.LookbackDays
+	if days <= 0 {
+		days = 30
+	}
+	since := time.Now().AddDate(0, 0, -days)
+
+	return pollOrders(ctx, "seenOrders:refunded", since, "refunded", func(order map[string]interface{}) bool {
+		return shared.OrderState(order) == "refunded"
+	}, ctx.LastRun() == nil)
+}
+
+func (t *OrderRefundedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *OrderRefundedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewOrderRefundedTrigger() sdk.Trigger {
+	return &OrderRefundedTrigger{}
+}
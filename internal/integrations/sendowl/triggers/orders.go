@@ -0,0 +1,79 @@
+// triggers/orders.go
+package triggers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/wakflo/extensions/internal/integrations/sendowl/shared"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// paidStates are the order states of a completed purchase.
+var paidStates = map[string]bool{
+	"complete":              true,
+	"completed":             true,
+	"imported":              true,
+	"subscription_active":   true,
+	"subscription_complete": true,
+}
+
+// pollOrders lists the orders placed since the day of since that match
+// and haven't been returned under key before. With recordOnly, it only
+// remembers them, so a first poll doesn't fire for old orders.
+func pollOrders(ctx sdkcontext.ExecuteContext, key string, since time.Time, status string, matches func(map[string]interface{}) bool, recordOnly bool) ([]map[string]interface{}, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := shared.ListOrdersSince(authCtx.Extra["api_key"], authCtx.Extra["api_secret"], since, status)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []map[string]interface{}
+	for _, order := range orders {
+		if matches(order) {
+			matching = append(matching, order)
+		}
+	}
+
+	fresh := shared.UnseenOrders(ctx, key, matching)
+	if recordOnly {
+		return []map[string]interface{}{}, nil
+	}
+	return fresh, nil
+}
+
+// newSince is where polling for new orders starts: the last run, or a
+// day back on the first run.
+func newSince(ctx sdkcontext.ExecuteContext) time.Time {
+	if lastRun := ctx.LastRun(); lastRun != nil {
+		return *lastRun
+	}
+	return time.Now().Add(-24 * time.Hour)
+}
+
+func isSubscription(order map[string]interface{}) bool {
+	return strings.HasPrefix(shared.OrderState(order), "subscription_")
+}
+
+func orderSample(state string) map[string]any {
+	return map[string]any{
+		"id":               123456,
+		"state":            state,
+		"buyer_name":       "John Doe",
+		"buyer_email":      "john.doe@example.com",
+		"settled_gross":    "29.99",
+		"settled_currency": "USD",
+		"gateway":          "Stripe",
+		"created_at":       "2023-08-15T14:30:22Z",
+		"cart": map[string]any{
+			"cart_items": []map[string]any{
+				{"cart_item": map[string]any{"product_id": 789012, "quantity": 1}},
+			},
+		},
+	}
+}
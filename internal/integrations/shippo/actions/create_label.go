@@ -0,0 +1,42 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type CreateLabelAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateLabelAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_label",
+		DisplayName:   "Create Label",
+		Description:   "Quotes the shipment, picks the cheapest or fastest rate, or a given service, and buys its label.",
+		Type:          core.ActionTypeAction,
+		Documentation: createLabelDocs,
+		SampleOutput:  shippoLabelSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateLabelAction) Properties() *smartform.FormSchema {
+	return labelForm("create_label", "Create Label")
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateLabelAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateLabelAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	return buyLabel(ctx, false)
+}
+
+func NewCreateLabelAction() sdk.Action {
+	return &CreateLabelAction{}
+}
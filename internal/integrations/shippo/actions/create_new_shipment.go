@@ -1,39 +1,38 @@
 package actions
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/inputs"
 	"github.com/wakflo/extensions/internal/integrations/shippo/shared"
+	"github.com/wakflo/extensions/internal/shipping"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
 )
 
-type createNewShipmentActionProps struct {
-	SenderName      string `json:"sender-name"`
-	SenderStreet    string `json:"sender-street1"`
-	SenderPhone     string `json:"sender-phone"`
-	SenderZip       string `json:"sender-zip"`
-	SenderEmail     string `json:"sender-email"`
-	SenderCity      string `json:"sender-city"`
-	SenderState     string `json:"sender-state"`
-	SenderCountry   string `json:"sender-country"`
-	ReceiverName    string `json:"receiver-name"`
-	ReceiverStreet  string `json:"receiver-street1"`
-	ReceiverPhone   string `json:"receiver-phone"`
-	ReceiverZip     string `json:"receiver-zip"`
-	ReceiverEmail   string `json:"receiver-email"`
-	ReceiverCity    string `json:"receiver-city"`
-	ReceiverState   string `json:"receiver-state"`
-	ReceiverCountry string `json:"receiver-country"`
-	Length          string `json:"length"`
-	Weight          string `json:"weight"`
-	Width           string `json:"width"`
-	Height          string `json:"height"`
-	DistanceUnit    string `json:"distance-unit,omitempty"`
-	MassUnit        string `json:"mass-unit,omitempty"`
+// legacyShipmentFields maps the fields this action took before it used
+// the shared shipping fields.
+var legacyShipmentFields = map[string]string{
+	"sender-name":      "from_name",
+	"sender-street1":   "from_street1",
+	"sender-city":      "from_city",
+	"sender-state":     "from_state",
+	"sender-zip":       "from_zip",
+	"sender-country":   "from_country",
+	"sender-phone":     "from_phone",
+	"sender-email":     "from_email",
+	"receiver-name":    "to_name",
+	"receiver-street1": "to_street1",
+	"receiver-city":    "to_city",
+	"receiver-state":   "to_state",
+	"receiver-zip":     "to_zip",
+	"receiver-country": "to_country",
+	"receiver-phone":   "to_phone",
+	"receiver-email":   "to_email",
+	"distance-unit":    "distance_unit",
+	"mass-unit":        "mass_unit",
 }
 
 type CreateNewShipmentAction struct{}
@@ -43,11 +42,21 @@ func (a *CreateNewShipmentAction) Metadata() sdk.ActionMetadata {
 	return sdk.ActionMetadata{
 		ID:            "create_new_shipment",
 		DisplayName:   "Create A New Shipment",
-		Description:   "creates a new shipment",
+		Description:   "Creates a shipment and returns the rates carriers quote for it, cheapest first.",
 		Type:          core.ActionTypeAction,
 		Documentation: createNewShipmentDocs,
 		SampleOutput: map[string]any{
-			"shipment": map[string]any{},
+			"shipment_id": "5e40ead7cffe4cc1ad45108696162e42",
+			"status":      "SUCCESS",
+			"count":       1,
+			"rates":       []map[string]any{shippoRateSample},
+			"cheapest":    shippoRateSample,
+			"fastest":     shippoRateSample,
+			"shipment": map[string]any{
+				"object_id": "5e40ead7cffe4cc1ad45108696162e42",
+				"status":    "SUCCESS",
+				"rates":     []map[string]any{},
+			},
 		},
 		Settings: core.ActionSettings{},
 	}
@@ -57,94 +66,9 @@ func (a *CreateNewShipmentAction) Metadata() sdk.ActionMetadata {
 func (a *CreateNewShipmentAction) Properties() *smartform.FormSchema {
 	form := smartform.NewForm("create_new_shipment", "Create A New Shipment")
 
-	form.TextField("sender-name", "Sender's Name").
-		Required(true)
-
-	form.TextField("sender-street1", "Sender's Street 1").
-		Required(true).
-		HelpText("street")
-
-	form.TextField("sender-email", "Sender's Email").
-		Required(true).
-		HelpText("Sender's email")
-
-	form.TextField("sender-city", "Sender's City").
-		Required(true).
-		HelpText("city")
-
-	form.TextField("sender-state", "Sender's State").
-		Required(true).
-		HelpText("Sender's state")
-
-	form.TextField("sender-zip", "Sender's Zip").
-		Required(true).
-		HelpText("Sender's zip")
-
-	form.TextField("sender-phone", "Sender's Phone").
-		Required(true).
-		HelpText("Sender's phone number")
-
-	form.TextField("receiver-name", "Receiver's Name").
-		Required(true).
-		HelpText("receiver name")
-
-	form.TextField("receiver-street1", "Receiver's Street 1").
-		Required(true).
-		HelpText("receiver's street")
-
-	form.TextField("receiver-email", "Receiver's Email").
-		Required(true).
-		HelpText("receiver's email")
-
-	form.TextField("receiver-city", "Receiver's City").
-		Required(true).
-		HelpText("receiver's city")
-
-	form.TextField("receiver-state", "Receiver's State").
-		Required(true).
-		HelpText("Receiver's state")
-
-	form.TextField("receiver-zip", "Receiver's Zip").
-		Required(true).
-		HelpText("Receiver's zip")
-
-	form.TextField("receiver-phone", "Receiver's Phone").
-		Required(true).
-		HelpText("Receiver's phone number")
-
-	form.TextField("length", "Parcel length").
-		Required(true).
-		HelpText("parcel length")
-
-	form.TextField("width", "Parcel width").
-		Required(true).
-		HelpText("parcel width")
-
-	form.TextField("height", "Parcel height").
-		Required(true).
-		HelpText("parcel height")
-
-	form.SelectField("distance-unit", "Distance Unit").
-		Required(true).
-		AddOption("in", "Inches").
-		AddOption("cm", "Centimeters").
-		AddOption("mm", "Millimeters").
-		AddOption("yd", "Yards").
-		AddOption("m", "Meters").
-		AddOption("ft", "Feet").
-		HelpText("distance unit")
-
-	form.TextField("weight", "Parcel weight").
-		Required(true).
-		HelpText("parcel weight")
-
-	form.SelectField("mass-unit", "Mass Unit").
-		Required(true).
-		AddOption("kg", "Kilogram").
-		AddOption("lb", "Pound").
-		AddOption("oz", "Ounce").
-		AddOption("g", "Gram").
-		HelpText("mass unit")
+	shipping.RegisterAddressProps(form, "from", "Ship From")
+	shipping.RegisterAddressProps(form, "to", "Ship To")
+	shipping.RegisterParcelProps(form)
 
 	schema := form.Build()
 
@@ -158,63 +82,27 @@ func (a *CreateNewShipmentAction) Auth() *core.AuthMetadata {
 
 // Perform executes the action with the given context and input
 func (a *CreateNewShipmentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
-	// Get the auth context and API key
-	authCtx, err := ctx.AuthContext()
+	apiKey, err := shared.GetAPIKey(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if authCtx.Extra["api-key"] == "" {
-		return nil, errors.New("missing shippo api key")
-	}
-
-	endpoint := "/shipments"
-
-	// Use the InputToTypeSafely helper function to convert the input to our struct
-	input, err := sdk.InputToTypeSafely[createNewShipmentActionProps](ctx)
+	input := inputs.Rename(ctx.Input(), legacyShipmentFields)
+	parcel, err := shipping.ParcelFrom(input)
 	if err != nil {
 		return nil, err
 	}
 
-	shipmentData := map[string]interface{}{
-		"address_to": map[string]interface{}{
-			"name":    input.SenderName,
-			"street1": input.SenderStreet,
-			"city":    input.SenderCity,
-			"state":   input.SenderState,
-			"zip":     input.SenderZip,
-			"country": input.SenderCountry,
-			"phone":   input.SenderPhone,
-			"email":   input.SenderEmail,
-		},
-		"address_from": map[string]interface{}{
-			"name":    input.ReceiverName,
-			"street1": input.ReceiverStreet,
-			"city":    input.ReceiverCity,
-			"state":   input.ReceiverState,
-			"zip":     input.ReceiverZip,
-			"country": input.ReceiverCountry,
-			"phone":   input.ReceiverPhone,
-			"email":   input.ReceiverEmail,
-		},
-		"parcels": []map[string]interface{}{
-			{
-				"length":        input.Length,
-				"width":         input.Width,
-				"height":        input.Height,
-				"distance_unit": input.DistanceUnit,
-				"weight":        input.Weight,
-				"mass_unit":     input.MassUnit,
-			},
-		},
-	}
-
-	response, err := shared.CreateAShipment(endpoint, authCtx.Extra["api-key"], shipmentData)
+	shipment, err := shared.CreateShipment(apiKey, shipping.AddressFrom(input, "from"), shipping.AddressFrom(input, "to"), parcel, false)
 	if err != nil {
 		return nil, fmt.Errorf("error creating shipment: %v", err)
 	}
 
-	return response, nil
+	out := shipping.RateSummary(shipment.NormalizedRates())
+	out["shipment_id"] = shipment.ObjectID
+	out["status"] = shipment.Status
+	out["shipment"] = shipment.Raw
+	return out, nil
 }
 
 func NewCreateNewShipmentAction() sdk.Action {
@@ -23,3 +23,12 @@ var createNewShipmentDocs string
 
 //go:embed create_new_shipment_label.md
 var newShipmentLabelDocs string
+
+//go:embed create_label.md
+var createLabelDocs string
+
+//go:embed create_return_label.md
+var createReturnLabelDocs string
+
+//go:embed validate_address.md
+var validateAddressDocs string
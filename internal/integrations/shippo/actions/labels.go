@@ -0,0 +1,74 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shippo/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+var shippoRateSample = map[string]any{
+	"provider":       "shippo",
+	"rate_id":        "545ab0a1a6ea4c9f9adb2512a57d6d8b",
+	"shipment_id":    "5e40ead7cffe4cc1ad45108696162e42",
+	"carrier":        "USPS",
+	"carrier_id":     "078870331023437cb917f5187429b093",
+	"service":        "Priority Mail",
+	"service_code":   "usps_priority",
+	"amount":         7.58,
+	"currency":       "USD",
+	"estimated_days": 2,
+}
+
+var shippoLabelSample = map[string]any{
+	"provider":        "shippo",
+	"label_id":        "70ae8117ee1749e393f249d5b77c45e0",
+	"shipment_id":     "5e40ead7cffe4cc1ad45108696162e42",
+	"status":          "success",
+	"tracking_number": "9400100000000000000000",
+	"tracking_url":    "https://tools.usps.com/go/TrackConfirmAction_input?origTrackNum=9400100000000000000000",
+	"label_url":       "https://shippo-delivery.s3.amazonaws.com/70ae8117ee1749e393f249d5b77c45e0.pdf",
+	"carrier":         "USPS",
+	"service":         "Priority Mail",
+	"is_return":       false,
+	"rate":            shippoRateSample,
+}
+
+// labelForm builds the form of the label actions.
+func labelForm(id, title string) *smartform.FormSchema {
+	form := smartform.NewForm(id, title)
+
+	shipping.RegisterAddressProps(form, "from", "Ship From")
+	shipping.RegisterAddressProps(form, "to", "Ship To")
+	shipping.RegisterParcelProps(form)
+	shipping.RegisterRateProps(form)
+
+	return form.Build()
+}
+
+// buyLabel quotes the shipment, picks a rate and buys its label.
+func buyLabel(ctx sdkcontext.PerformContext, isReturn bool) (core.JSON, error) {
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := ctx.Input()
+	parcel, err := shipping.ParcelFrom(input)
+	if err != nil {
+		return nil, err
+	}
+
+	shipment, err := shared.CreateShipment(apiKey, shipping.AddressFrom(input, "from"), shipping.AddressFrom(input, "to"), parcel, isReturn)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := shipping.PickRate(input, shipment.NormalizedRates())
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.BuyLabel(apiKey, rate, isReturn)
+}
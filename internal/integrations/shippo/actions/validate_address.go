@@ -0,0 +1,71 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shippo/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type ValidateAddressAction struct{}
+
+// Metadata returns metadata about the action
+func (a *ValidateAddressAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "validate_address",
+		DisplayName:   "Validate Address",
+		Description:   "Checks that an address is deliverable and returns it as corrected by Shippo.",
+		Type:          core.ActionTypeAction,
+		Documentation: validateAddressDocs,
+		SampleOutput: map[string]any{
+			"provider": "shippo",
+			"valid":    true,
+			"address": map[string]any{
+				"name":    "Shawn Ippotle",
+				"street1": "215 CLAYTON ST",
+				"city":    "SAN FRANCISCO",
+				"state":   "CA",
+				"zip":     "94117-1913",
+				"country": "US",
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *ValidateAddressAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("validate_address", "Validate Address")
+
+	shipping.RegisterAddressProps(form, "address", "Address")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *ValidateAddressAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *ValidateAddressAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	address := shipping.AddressFrom(ctx.Input(), "address")
+	if err := address.Validate(); err != nil {
+		return nil, err
+	}
+
+	return shared.ValidateAddress(apiKey, address)
+}
+
+func NewValidateAddressAction() sdk.Action {
+	return &ValidateAddressAction{}
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/wakflo/extensions/internal/integrations/shippo/actions"
 	"github.com/wakflo/extensions/internal/integrations/shippo/shared"
+	"github.com/wakflo/extensions/internal/integrations/shippo/triggers"
 	"github.com/wakflo/go-sdk/v2"
 	"github.com/wakflo/go-sdk/v2/core"
 )
@@ -45,13 +46,18 @@ func (n *Shippo) Auth() *core.AuthMetadata {
 }
 
 func (n *Shippo) Triggers() []sdk.Trigger {
-	return []sdk.Trigger{}
+	return []sdk.Trigger{
+		triggers.NewTrackingStatusChangedTrigger(),
+	}
 }
 
 func (n *Shippo) Actions() []sdk.Action {
 	return []sdk.Action{
-		// actions.NewCreateNewShipmentAction(),
+		actions.NewCreateNewShipmentAction(),
 		actions.NewCreateShipmentLabelAction(),
+		actions.NewCreateLabelAction(),
+		actions.NewCreateReturnLabelAction(),
+		actions.NewValidateAddressAction(),
 	}
 }
 
@@ -0,0 +1,94 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// GetAPIKey returns the Shippo API key of the connection.
+func GetAPIKey(ctx sdkcontext.BaseContext) (string, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return "", err
+	}
+
+	apiKey := authCtx.Extra["api-key"]
+	if apiKey == "" {
+		return "", errors.New("missing shippo api key")
+	}
+	return apiKey, nil
+}
+
+// Request calls the Shippo API and decodes the JSON response into out.
+// Error responses are returned as errors carrying Shippo's message.
+func Request(apiKey, method, endpoint string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, baseURL+endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "ShippoToken "+apiKey)
+	req.Header.Set("Shippo-Api-Version", apiVersion)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("shippo API error (status %d): %s", resp.StatusCode, errorMessage(data))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON: %v", err)
+	}
+	return nil
+}
+
+// errorMessage flattens Shippo's error bodies, which are either a
+// detail string or a map of field names to messages.
+func errorMessage(body []byte) string {
+	var detail struct {
+		Detail string `json:"detail"`
+	}
+	if json.Unmarshal(body, &detail) == nil && detail.Detail != "" {
+		return detail.Detail
+	}
+
+	var fields map[string]interface{}
+	if json.Unmarshal(body, &fields) == nil && len(fields) > 0 {
+		var parts []string
+		for field, msg := range fields {
+			parts = append(parts, fmt.Sprintf("%s: %v", field, msg))
+		}
+		sort.Strings(parts)
+		return strings.Join(parts, "; ")
+	}
+	return string(body)
+}
@@ -15,10 +15,8 @@
 package shared
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
 	"net/http"
 
@@ -40,45 +38,20 @@ var (
 	ShippoSharedAuth = form.Build()
 )
 
-const baseURL = "https://api.goshippo.com"
+const (
+	baseURL    = "https://api.goshippo.com"
+	apiVersion = "2018-02-08"
+)
 
 func NewShippoAPIClient(baseURL, apiKey string) *http.Client {
 	return &http.Client{}
 }
 
 func CreateAShipment(endpoint, apiKey string, shipmentData map[string]interface{}) (interface{}, error) {
-	client := NewShippoAPIClient(baseURL, apiKey)
-
-	jsonData, err := json.Marshal(shipmentData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal JSON: %v", err)
-	}
-
-	req, err := http.NewRequest(http.MethodPost, baseURL+endpoint, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "ShippoToken "+apiKey)
-	req.Header.Set("Shippo-Api-Version", "2018-02-08")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %v", err)
-	}
-
 	var result interface{}
-	if newErr := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON: %v", newErr)
+	if err := Request(apiKey, http.MethodPost, endpoint, shipmentData, &result); err != nil {
+		return nil, err
 	}
-
 	return result, nil
 }
 
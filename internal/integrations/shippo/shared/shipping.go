@@ -0,0 +1,306 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/wakflo/extensions/internal/shipping"
+)
+
+const provider = "shippo"
+
+// Shipment is a Shippo shipment with its rates.
+type Shipment struct {
+	ObjectID string    `json:"object_id"`
+	Status   string    `json:"status"`
+	Rates    []Rate    `json:"rates"`
+	Messages []Message `json:"messages"`
+	// Raw is the shipment as Shippo returned it.
+	Raw map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON decodes the shipment and keeps the response in Raw.
+func (s *Shipment) UnmarshalJSON(data []byte) error {
+	type plain Shipment
+	if err := json.Unmarshal(data, (*plain)(s)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &s.Raw)
+}
+
+// Rate is a rate of a Shippo shipment.
+type Rate struct {
+	ObjectID       string `json:"object_id"`
+	Shipment       string `json:"shipment"`
+	Amount         string `json:"amount"`
+	Currency       string `json:"currency"`
+	Provider       string `json:"provider"`
+	CarrierAccount string `json:"carrier_account"`
+	EstimatedDays  int    `json:"estimated_days"`
+	DurationTerms  string `json:"duration_terms"`
+	ServiceLevel   struct {
+		Name  string `json:"name"`
+		Token string `json:"token"`
+	} `json:"servicelevel"`
+}
+
+// Message is a note Shippo attaches to a shipment or transaction.
+type Message struct {
+	Source string `json:"source"`
+	Code   string `json:"code"`
+	Text   string `json:"text"`
+}
+
+// Normalize returns the rate in the shipping package shape.
+func (r Rate) Normalize() shipping.Rate {
+	return shipping.Rate{
+		Provider:      provider,
+		ID:            r.ObjectID,
+		ShipmentID:    r.Shipment,
+		Carrier:       r.Provider,
+		CarrierID:     r.CarrierAccount,
+		Service:       r.ServiceLevel.Name,
+		ServiceCode:   r.ServiceLevel.Token,
+		Amount:        shipping.ParseFloat(r.Amount),
+		Currency:      r.Currency,
+		EstimatedDays: r.EstimatedDays,
+	}
+}
+
+// NormalizedRates returns the rates of the shipment.
+func (s *Shipment) NormalizedRates() []shipping.Rate {
+	rates := make([]shipping.Rate, 0, len(s.Rates))
+	for _, r := range s.Rates {
+		rates = append(rates, r.Normalize())
+	}
+	return rates
+}
+
+func toAddress(a shipping.Address) map[string]interface{} {
+	return map[string]interface{}{
+		"name":           a.Name,
+		"company":        a.Company,
+		"street1":        a.Street1,
+		"street2":        a.Street2,
+		"city":           a.City,
+		"state":          a.State,
+		"zip":            a.Zip,
+		"country":        a.Country,
+		"phone":          a.Phone,
+		"email":          a.Email,
+		"is_residential": a.Residential,
+	}
+}
+
+func toParcel(p shipping.Parcel) map[string]interface{} {
+	return map[string]interface{}{
+		"length":        shipping.FormatFloat(p.Length),
+		"width":         shipping.FormatFloat(p.Width),
+		"height":        shipping.FormatFloat(p.Height),
+		"distance_unit": p.DistanceUnit,
+		"weight":        shipping.FormatFloat(p.Weight),
+		"mass_unit":     p.MassUnit,
+	}
+}
+
+// CreateShipment creates a shipment and waits for its rates. For a return
+// shipment, from and to stay those of the outbound shipment and Shippo
+// swaps them on the label.
+func CreateShipment(apiKey string, from, to shipping.Address, parcel shipping.Parcel, isReturn bool) (*Shipment, error) {
+	if err := from.Validate(); err != nil {
+		return nil, fmt.Errorf("ship from: %w", err)
+	}
+	if err := to.Validate(); err != nil {
+		return nil, fmt.Errorf("ship to: %w", err)
+	}
+
+	body := map[string]interface{}{
+		"address_from": toAddress(from),
+		"address_to":   toAddress(to),
+		"parcels":      []interface{}{toParcel(parcel)},
+		"async":        false,
+	}
+	if isReturn {
+		body["extra"] = map[string]interface{}{"is_return": true}
+	}
+
+	var shipment Shipment
+	if err := Request(apiKey, http.MethodPost, "/shipments", body, &shipment); err != nil {
+		return nil, err
+	}
+	if len(shipment.Rates) == 0 && len(shipment.Messages) > 0 {
+		return nil, fmt.Errorf("shippo returned no rates: %s", messagesText(shipment.Messages))
+	}
+	return &shipment, nil
+}
+
+// transaction is a Shippo label purchase.
+type transaction struct {
+	ObjectID       string    `json:"object_id"`
+	Status         string    `json:"status"`
+	TrackingNumber string    `json:"tracking_number"`
+	TrackingURL    string    `json:"tracking_url_provider"`
+	LabelURL       string    `json:"label_url"`
+	Messages       []Message `json:"messages"`
+}
+
+// BuyLabel purchases the label of a rate.
+func BuyLabel(apiKey string, rate *shipping.Rate, isReturn bool) (*shipping.Label, error) {
+	body := map[string]interface{}{
+		"rate":            rate.ID,
+		"async":           false,
+		"label_file_type": "PDF",
+	}
+
+	var tx transaction
+	if err := Request(apiKey, http.MethodPost, "/transactions", body, &tx); err != nil {
+		return nil, err
+	}
+	if tx.Status != "SUCCESS" {
+		return nil, fmt.Errorf("shippo could not create the label (%s): %s", strings.ToLower(tx.Status), messagesText(tx.Messages))
+	}
+
+	return &shipping.Label{
+		Provider:       provider,
+		ID:             tx.ObjectID,
+		ShipmentID:     rate.ShipmentID,
+		Status:         strings.ToLower(tx.Status),
+		TrackingNumber: tx.TrackingNumber,
+		TrackingURL:    tx.TrackingURL,
+		LabelURL:       tx.LabelURL,
+		Carrier:        rate.Carrier,
+		Service:        rate.Service,
+		IsReturn:       isReturn,
+		Rate:           rate,
+	}, nil
+}
+
+// ValidateAddress asks Shippo to check an address.
+func ValidateAddress(apiKey string, a shipping.Address) (*shipping.AddressValidation, error) {
+	body := toAddress(a)
+	body["validate"] = true
+
+	var resp struct {
+		Name              string `json:"name"`
+		Company           string `json:"company"`
+		Street1           string `json:"street1"`
+		Street2           string `json:"street2"`
+		City              string `json:"city"`
+		State             string `json:"state"`
+		Zip               string `json:"zip"`
+		Country           string `json:"country"`
+		Phone             string `json:"phone"`
+		Email             string `json:"email"`
+		IsResidential     *bool  `json:"is_residential"`
+		ValidationResults struct {
+			IsValid  bool      `json:"is_valid"`
+			Messages []Message `json:"messages"`
+		} `json:"validation_results"`
+	}
+	if err := Request(apiKey, http.MethodPost, "/addresses", body, &resp); err != nil {
+		return nil, err
+	}
+
+	result := &shipping.AddressValidation{
+		Provider: provider,
+		Valid:    resp.ValidationResults.IsValid,
+		Address: shipping.Address{
+			Name:        resp.Name,
+			Company:     resp.Company,
+			Street1:     resp.Street1,
+			Street2:     resp.Street2,
+			City:        resp.City,
+			State:       resp.State,
+			Zip:         resp.Zip,
+			Country:     resp.Country,
+			Phone:       resp.Phone,
+			Email:       resp.Email,
+			Residential: resp.IsResidential != nil && *resp.IsResidential,
+		},
+	}
+	for _, m := range resp.ValidationResults.Messages {
+		result.Messages = append(result.Messages, m.Text)
+	}
+	return result, nil
+}
+
+type trackingStatus struct {
+	Status        string `json:"status"`
+	StatusDetails string `json:"status_details"`
+	StatusDate    string `json:"status_date"`
+	Location      *struct {
+		City    string `json:"city"`
+		State   string `json:"state"`
+		Zip     string `json:"zip"`
+		Country string `json:"country"`
+	} `json:"location"`
+}
+
+func (s trackingStatus) event() shipping.TrackingEvent {
+	e := shipping.TrackingEvent{
+		Status:     shipping.NormalizeStatus(s.Status),
+		RawStatus:  s.Status,
+		Message:    s.StatusDetails,
+		OccurredAt: s.StatusDate,
+	}
+	if s.Location != nil {
+		e.Location = shipping.JoinLocation(s.Location.City, s.Location.State, s.Location.Zip, s.Location.Country)
+	}
+	return e
+}
+
+// GetTracking returns the tracking of a shipment by carrier token and
+// tracking number.
+func GetTracking(apiKey, carrier, trackingNumber string) (*shipping.Tracking, error) {
+	if carrier == "" || trackingNumber == "" {
+		return nil, errors.New("a carrier and a tracking number are required")
+	}
+
+	var resp struct {
+		Carrier         string           `json:"carrier"`
+		TrackingNumber  string           `json:"tracking_number"`
+		ETA             string           `json:"eta"`
+		TrackingStatus  *trackingStatus  `json:"tracking_status"`
+		TrackingHistory []trackingStatus `json:"tracking_history"`
+	}
+	endpoint := fmt.Sprintf("/tracks/%s/%s", url.PathEscape(carrier), url.PathEscape(trackingNumber))
+	if err := Request(apiKey, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	t := &shipping.Tracking{
+		Provider:          provider,
+		TrackingNumber:    trackingNumber,
+		Carrier:           carrier,
+		Status:            shipping.StatusUnknown,
+		EstimatedDelivery: resp.ETA,
+	}
+	if resp.TrackingStatus != nil {
+		t.Status = shipping.NormalizeStatus(resp.TrackingStatus.Status)
+		t.RawStatus = resp.TrackingStatus.Status
+		t.UpdatedAt = resp.TrackingStatus.StatusDate
+	}
+	for _, h := range resp.TrackingHistory {
+		t.Events = append(t.Events, h.event())
+	}
+	return t, nil
+}
+
+func messagesText(messages []Message) string {
+	if len(messages) == 0 {
+		return "no details given"
+	}
+	texts := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if m.Source != "" {
+			texts = append(texts, m.Source+": "+m.Text)
+		} else {
+			texts = append(texts, m.Text)
+		}
+	}
+	return strings.Join(texts, "; ")
+}
@@ -0,0 +1,131 @@
+package triggers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shippo/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type trackingStatusChangedTriggerProps struct {
+	Carrier         string `json:"carrier"`
+	TrackingNumbers string `json:"tracking_numbers"`
+}
+
+type TrackingStatusChangedTrigger struct{}
+
+func (t *TrackingStatusChangedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "tracking_status_changed",
+		DisplayName:   "Tracking Status Changed",
+		Description:   "Triggers workflow when the normalized tracking status of a watched shipment changes",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: trackingStatusChangedDocs,
+		SampleOutput:  []map[string]any{trackingStatusChangedSample},
+	}
+}
+
+func (t *TrackingStatusChangedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *TrackingStatusChangedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *TrackingStatusChangedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("shippo-tracking-status-changed", "Tracking Status Changed")
+
+	form.SelectField("carrier", "Carrier").
+		Required(true).
+		AddOptions(shipping.CourierOptions()...).
+		HelpText("The carrier of the shipments.")
+
+	form.TextareaField("tracking_numbers", "Tracking Numbers").
+		Required(true).
+		HelpText("The tracking numbers to watch, one per line or separated by commas.")
+
+	shipping.RegisterStatusFilterProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the TrackingStatusChangedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *TrackingStatusChangedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the TrackingStatusChangedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *TrackingStatusChangedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the watched shipments whose status changed since the
+// last run. Shippo has no tracking list, so each number is fetched.
+func (t *TrackingStatusChangedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[trackingStatusChangedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers := shipping.SplitList(input.TrackingNumbers)
+	if len(numbers) == 0 {
+		return nil, errors.New("at least one tracking number is required")
+	}
+	carrier := shipping.FindCourier(input.Carrier).ShippoCode()
+
+	trackings := make([]shipping.Tracking, 0, len(numbers))
+	for _, number := range numbers {
+		tracking, err := shared.GetTracking(apiKey, carrier, number)
+		if err != nil {
+			return nil, err
+		}
+		trackings = append(trackings, *tracking)
+	}
+
+	return shipping.TrackingChanges(ctx, trackings), nil
+}
+
+func (t *TrackingStatusChangedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *TrackingStatusChangedTrigger) SampleData() sdkcore.JSON {
+	return trackingStatusChangedSample
+}
+
+var trackingStatusChangedSample = map[string]any{
+	"provider":           "shippo",
+	"tracking_number":    "9205590164917312751089",
+	"carrier":            "usps",
+	"status":             "delivered",
+	"raw_status":         "DELIVERED",
+	"previous_status":    "out_for_delivery",
+	"estimated_delivery": "2025-03-14T12:00:00Z",
+	"updated_at":         "2025-03-14T15:42:00Z",
+	"events": []map[string]any{
+		{
+			"status":      "delivered",
+			"raw_status":  "DELIVERED",
+			"message":     "Your item was delivered in or at the mailbox.",
+			"location":    "San Francisco, CA, 94103, US",
+			"occurred_at": "2025-03-14T15:42:00Z",
+		},
+	},
+}
+
+func NewTrackingStatusChangedTrigger() sdk.Trigger {
+	return &TrackingStatusChangedTrigger{}
+}
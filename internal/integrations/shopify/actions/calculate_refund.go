@@ -0,0 +1,89 @@
+package actions
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type CalculateRefundAction struct{}
+
+func (a *CalculateRefundAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "calculate_refund",
+		DisplayName:   "Calculate Refund",
+		Description:   "Calculate the refund for order items and shipping, including taxes and the transactions Shopify suggests, without refunding anything.",
+		Type:          core.ActionTypeAction,
+		Documentation: calculateRefundDocs,
+		SampleOutput: map[string]any{
+			"refund": map[string]any{
+				"shipping": map[string]any{"amount": "5.00", "tax": "0.00", "maximum_refundable": "5.00"},
+				"refund_line_items": []map[string]any{
+					{"line_item_id": 466157049, "quantity": 1, "restock_type": "no_restock", "subtotal": "199.00", "total_tax": "3.98"},
+				},
+			},
+			"transactions": []map[string]any{
+				{"parent_id": 801038806, "amount": "207.98", "kind": "suggested_refund", "gateway": "bogus", "maximum_refundable": "207.98"},
+			},
+			"total": 207.98,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *CalculateRefundAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("calculate_refund", "Calculate Refund")
+
+	shared.RegisterRefundProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CalculateRefundAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *CalculateRefundAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[shared.RefundProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := input.Request(context.Background(), client)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, transactions, err := shared.CalculateRefund(context.Background(), client, input.OrderID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var total float64
+	for _, t := range transactions {
+		amount, _ := strconv.ParseFloat(t.Amount, 64)
+		total += amount
+	}
+
+	return map[string]interface{}{
+		"refund":       refund,
+		"transactions": transactions,
+		"total":        total,
+	}, nil
+}
+
+func NewCalculateRefundAction() sdk.Action {
+	return &CalculateRefundAction{}
+}
@@ -0,0 +1,77 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type cancelFulfillmentActionProps struct {
+	FulfillmentID interface{} `json:"fulfillmentId"`
+}
+
+type CancelFulfillmentAction struct{}
+
+func (a *CancelFulfillmentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "cancel_fulfillment",
+		DisplayName:   "Cancel Fulfillment",
+		Description:   "Cancel a fulfillment so its items can be fulfilled again, for example after a lost or returned shipment.",
+		Type:          core.ActionTypeAction,
+		Documentation: cancelFulfillmentDocs,
+		SampleOutput: map[string]any{
+			"id":             "gid://shopify/Fulfillment/255858046",
+			"fulfillment_id": 255858046,
+			"status":         "CANCELLED",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *CancelFulfillmentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("cancel_fulfillment", "Cancel Fulfillment")
+
+	form.TextField("fulfillmentId", "Fulfillment ID").
+		Required(true).
+		HelpText("The ID of the fulfillment to cancel.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CancelFulfillmentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *CancelFulfillmentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[cancelFulfillmentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fulfillmentGID, err := shared.GID("Fulfillment", input.FulfillmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fulfillment, err := shared.CancelFulfillment(context.Background(), client, fulfillmentGID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fulfillment.Output(), nil
+}
+
+func NewCancelFulfillmentAction() sdk.Action {
+	return &CancelFulfillmentAction{}
+}
@@ -0,0 +1,80 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type completeDraftOrderActionProps struct {
+	DraftOrderID   uint64 `json:"draftOrderId"`
+	PaymentPending bool   `json:"paymentPending"`
+}
+
+type CompleteDraftOrderAction struct{}
+
+func (a *CompleteDraftOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "complete_draft_order",
+		DisplayName:   "Complete Draft Order",
+		Description:   "Turn a draft order into an order, marking it as paid or leaving the payment pending.",
+		Type:          core.ActionTypeAction,
+		Documentation: completeDraftOrderDocs,
+		SampleOutput: map[string]any{
+			"id":          994118539,
+			"name":        "#D2",
+			"status":      "completed",
+			"order_id":    450789469,
+			"total_price": "398.00",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *CompleteDraftOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("complete_draft_order", "Complete Draft Order")
+
+	form.NumberField("draftOrderId", "Draft Order ID").
+		Required(true).
+		HelpText("The ID of the draft order to complete.")
+
+	form.CheckboxField("paymentPending", "Payment Pending").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Leave the payment pending, for example for net terms, instead of marking the order as paid.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CompleteDraftOrderAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *CompleteDraftOrderAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[completeDraftOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	draftOrder, err := client.DraftOrder.Complete(context.Background(), input.DraftOrderID, input.PaymentPending)
+	if err != nil {
+		return nil, err
+	}
+
+	return draftOrder, nil
+}
+
+func NewCompleteDraftOrderAction() sdk.Action {
+	return &CompleteDraftOrderAction{}
+}
@@ -0,0 +1,147 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createFulfillmentActionProps struct {
+	OrderID         interface{} `json:"orderId"`
+	LocationID      uint64      `json:"locationId"`
+	LineItems       interface{} `json:"lineItems"`
+	TrackingNumber  string      `json:"trackingNumber"`
+	TrackingCompany string      `json:"trackingCompany"`
+	TrackingURL     string      `json:"trackingUrl"`
+	NotifyCustomer  bool        `json:"notifyCustomer"`
+}
+
+type CreateFulfillmentAction struct{}
+
+func (a *CreateFulfillmentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_fulfillment",
+		DisplayName:   "Create Fulfillment",
+		Description:   "Mark order items as fulfilled with optional tracking details, notifying the customer if requested.",
+		Type:          core.ActionTypeAction,
+		Documentation: createFulfillmentDocs,
+		SampleOutput: map[string]any{
+			"fulfillments": []map[string]any{
+				{
+					"id":             "gid://shopify/Fulfillment/255858046",
+					"fulfillment_id": 255858046,
+					"name":           "#1001.1",
+					"status":         "SUCCESS",
+					"tracking_info": []map[string]any{
+						{"company": "UPS", "number": "1Z999AA10123456784", "url": "https://www.ups.com/track?tracknum=1Z999AA10123456784"},
+					},
+					"location_id":   655441491,
+					"location_name": "Main Warehouse",
+				},
+			},
+			"count": 1,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *CreateFulfillmentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_fulfillment", "Create Fulfillment")
+
+	form.TextField("orderId", "Order ID").
+		Required(true).
+		HelpText("The ID of the order to fulfill.")
+
+	form.NumberField("locationId", "Location ID").
+		Required(false).
+		HelpText("Only fulfill items assigned to this location. Leave empty to fulfill items at every location, one fulfillment per location.")
+
+	form.TextareaField("lineItems", "Line Items").
+		Required(false).
+		Placeholder(`[{"line_item_id": 466157049, "quantity": 1}]`).
+		HelpText("Order line items and quantities to fulfill. Leave empty to fulfill everything that remains.")
+
+	form.TextField("trackingNumber", "Tracking Number").
+		Required(false).
+		HelpText("The tracking number. Separate several numbers with commas.")
+
+	form.TextField("trackingCompany", "Tracking Company").
+		Required(false).
+		Placeholder("UPS").
+		HelpText("The carrier name. Shopify builds the tracking URL for carriers it knows.")
+
+	form.TextField("trackingUrl", "Tracking URL").
+		Required(false).
+		HelpText("The tracking page URL, for carriers Shopify does not know.")
+
+	form.CheckboxField("notifyCustomer", "Notify Customer").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Send the shipping confirmation email to the customer.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateFulfillmentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *CreateFulfillmentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createFulfillmentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderGID, err := shared.GID("Order", input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	lineItems, err := shared.ParseLineItems(input.LineItems)
+	if err != nil {
+		return nil, err
+	}
+	quantities := map[uint64]int{}
+	for _, item := range lineItems {
+		quantities[item.LineItemID] += item.Quantity
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fulfillments, err := shared.CreateFulfillments(context.Background(), client, orderGID, shared.FulfillmentRequest{
+		LocationID: input.LocationID,
+		Quantities: quantities,
+		Tracking: shared.FulfillmentTracking{
+			Company: input.TrackingCompany,
+			Number:  input.TrackingNumber,
+			URL:     input.TrackingURL,
+		},
+		NotifyCustomer: input.NotifyCustomer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]map[string]interface{}, 0, len(fulfillments))
+	for _, f := range fulfillments {
+		out = append(out, f.Output())
+	}
+
+	return map[string]interface{}{
+		"fulfillments": out,
+		"count":        len(out),
+	}, nil
+}
+
+func NewCreateFulfillmentAction() sdk.Action {
+	return &CreateFulfillmentAction{}
+}
@@ -0,0 +1,120 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createRefundActionProps struct {
+	shared.RefundProps
+	Amount string `json:"amount"`
+	Notify bool   `json:"notify"`
+	Note   string `json:"note"`
+}
+
+type CreateRefundAction struct{}
+
+func (a *CreateRefundAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_refund",
+		DisplayName:   "Create Refund",
+		Description:   "Refund order items, shipping or a custom amount to the original payment method, optionally restocking the items.",
+		Type:          core.ActionTypeAction,
+		Documentation: createRefundDocs,
+		SampleOutput: map[string]any{
+			"id":         509562969,
+			"order_id":   450789469,
+			"note":       "Damaged in transit",
+			"created_at": "2024-03-01T10:15:00-05:00",
+			"refund_line_items": []map[string]any{
+				{"line_item_id": 466157049, "quantity": 1, "restock_type": "return", "subtotal": 199.0},
+			},
+			"transactions": []map[string]any{
+				{"id": 1068278589, "parent_id": 801038806, "amount": "207.98", "kind": "refund", "status": "success", "gateway": "bogus"},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *CreateRefundAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_refund", "Create Refund")
+
+	shared.RegisterRefundProps(form)
+
+	form.TextField("amount", "Refund Amount").
+		Required(false).
+		Placeholder("25.00").
+		HelpText("Refund this amount instead of the calculated total, for example a goodwill refund with no items.")
+
+	form.CheckboxField("notify", "Notify Customer").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Send the refund notification email to the customer.")
+
+	form.TextField("note", "Note").
+		Required(false).
+		HelpText("The reason for the refund.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateRefundAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *CreateRefundAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createRefundActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := input.Request(context.Background(), client)
+	if err != nil {
+		return nil, err
+	}
+	req.Notify = input.Notify
+	req.Note = input.Note
+
+	// Shopify needs the parent transaction and gateway of every refund
+	// payment, which the calculation suggests.
+	_, transactions, err := shared.CalculateRefund(context.Background(), client, input.OrderID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount := strings.TrimSpace(input.Amount); amount != "" {
+		if len(transactions) == 0 {
+			return nil, errors.New("the order has no payment to refund")
+		}
+		transactions = []shared.RefundTransaction{{
+			ParentID: transactions[0].ParentID,
+			Gateway:  transactions[0].Gateway,
+			Amount:   amount,
+		}}
+	}
+	if len(req.RefundLineItems) == 0 && req.Shipping == nil && len(transactions) == 0 {
+		return nil, errors.New("nothing to refund: choose line items, shipping or an amount")
+	}
+	req.Transactions = transactions
+
+	return shared.CreateRefund(context.Background(), client, input.OrderID, req)
+}
+
+func NewCreateRefundAction() sdk.Action {
+	return &CreateRefundAction{}
+}
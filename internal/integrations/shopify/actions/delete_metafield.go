@@ -0,0 +1,95 @@
+package actions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type deleteMetafieldActionProps struct {
+	OwnerType string      `json:"ownerType"`
+	OwnerID   interface{} `json:"ownerId"`
+	Namespace string      `json:"namespace"`
+	Key       string      `json:"key"`
+}
+
+type DeleteMetafieldAction struct{}
+
+func (a *DeleteMetafieldAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_metafield",
+		DisplayName:   "Delete Metafield",
+		Description:   "Delete a metafield from a resource by namespace and key.",
+		Type:          core.ActionTypeAction,
+		Documentation: deleteMetafieldDocs,
+		SampleOutput: map[string]any{
+			"deleted":   true,
+			"namespace": "custom",
+			"key":       "care_guide",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *DeleteMetafieldAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_metafield", "Delete Metafield")
+
+	shared.RegisterMetafieldOwnerProps(form)
+
+	form.TextField("namespace", "Namespace").
+		Required(true).
+		DefaultValue("custom").
+		HelpText("The metafield namespace.")
+
+	form.TextField("key", "Key").
+		Required(true).
+		HelpText("The metafield key.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *DeleteMetafieldAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *DeleteMetafieldAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[deleteMetafieldActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Namespace == "" || input.Key == "" {
+		return nil, errors.New("namespace and key are required")
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerGID, err := shared.MetafieldOwnerGID(context.Background(), client, input.OwnerType, input.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := shared.DeleteMetafield(context.Background(), client, ownerGID, input.Namespace, input.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"deleted":   deleted,
+		"namespace": input.Namespace,
+		"key":       input.Key,
+	}, nil
+}
+
+func NewDeleteMetafieldAction() sdk.Action {
+	return &DeleteMetafieldAction{}
+}
@@ -5,12 +5,21 @@ import _ "embed"
 //go:embed adjust_inventory_level.md
 var adjustInventoryLevelDocs string
 
+//go:embed calculate_refund.md
+var calculateRefundDocs string
+
+//go:embed cancel_fulfillment.md
+var cancelFulfillmentDocs string
+
 //go:embed cancel_order.md
 var cancelOrderDocs string
 
 //go:embed close_order.md
 var closeOrderDocs string
 
+//go:embed complete_draft_order.md
+var completeDraftOrderDocs string
+
 //go:embed create_collect.md
 var createCollectDocs string
 
@@ -20,15 +29,27 @@ var createCustomerDocs string
 //go:embed create_draft_order.md
 var createDraftOrderDocs string
 
+//go:embed create_fulfillment.md
+var createFulfillmentDocs string
+
 //go:embed create_order.md
 var createOrderDocs string
 
 //go:embed create_product.md
 var createProductDocs string
 
+//go:embed create_refund.md
+var createRefundDocs string
+
 //go:embed create_transaction.md
 var createTransactionDocs string
 
+//go:embed delete_metafield.md
+var deleteMetafieldDocs string
+
+//go:embed get_bulk_operation.md
+var getBulkOperationDocs string
+
 //go:embed get_customer.md
 var getCustomerDocs string
 
@@ -56,15 +77,33 @@ var listCustomersDocs string
 //go:embed list_draft_orders.md
 var listDraftOrdersDocs string
 
+//go:embed list_metafields.md
+var listMetafieldsDocs string
+
 //go:embed list_orders.md
 var listOrdersDocs string
 
 //go:embed list_products.md
 var listProductsDocs string
 
+//go:embed run_bulk_mutation.md
+var runBulkMutationDocs string
+
+//go:embed run_bulk_query.md
+var runBulkQueryDocs string
+
+//go:embed set_metafield.md
+var setMetafieldDocs string
+
+//go:embed tag_order.md
+var tagOrderDocs string
+
 //go:embed update_customer.md
 var updateCustomerDocs string
 
+//go:embed update_fulfillment_tracking.md
+var updateFulfillmentTrackingDocs string
+
 //go:embed update_order.md
 var updateOrderDocs string
 
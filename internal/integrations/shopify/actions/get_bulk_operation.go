@@ -0,0 +1,127 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type getBulkOperationActionProps struct {
+	OperationID   string `json:"operationId"`
+	OperationType string `json:"operationType"`
+	Download      bool   `json:"download"`
+	Limit         int    `json:"limit"`
+}
+
+type GetBulkOperationAction struct{}
+
+func (a *GetBulkOperationAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_bulk_operation",
+		DisplayName:   "Get Bulk Operation",
+		Description:   "Check the status of a bulk query or mutation and optionally download its results once it completes.",
+		Type:          core.ActionTypeAction,
+		Documentation: getBulkOperationDocs,
+		SampleOutput: map[string]any{
+			"operation": map[string]any{
+				"id":          "gid://shopify/BulkOperation/720918",
+				"type":        "QUERY",
+				"status":      "COMPLETED",
+				"objectCount": "2",
+				"url":         "https://storage.googleapis.com/shopify/bulk-result.jsonl",
+			},
+			"done": true,
+			"results": []map[string]any{
+				{"id": "gid://shopify/Product/632910392", "title": "IPod Nano - 8GB"},
+				{"id": "gid://shopify/ProductVariant/808950810", "sku": "IPOD2008PINK", "__parentId": "gid://shopify/Product/632910392"},
+			},
+			"truncated": false,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *GetBulkOperationAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_bulk_operation", "Get Bulk Operation")
+
+	form.TextField("operationId", "Operation ID").
+		Required(false).
+		HelpText("The ID of the bulk operation. Leave empty to get the shop's most recent operation of the type below.")
+
+	form.SelectField("operationType", "Operation Type").
+		Required(false).
+		DefaultValue("QUERY").
+		AddOptions([]*smartform.Option{
+			{Value: "QUERY", Label: "Query"},
+			{Value: "MUTATION", Label: "Mutation"},
+		}...).
+		HelpText("The type of the most recent operation to get when no ID is given.")
+
+	form.CheckboxField("download", "Download Results").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Download the result lines when the operation has completed.")
+
+	form.NumberField("limit", "Result Limit").
+		Required(false).
+		DefaultValue(1000).
+		HelpText("The maximum number of result lines to download.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *GetBulkOperationAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *GetBulkOperationAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getBulkOperationActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := shared.GetBulkOperation(context.Background(), client, input.OperationID, input.OperationType)
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]interface{}{
+		"operation": op,
+		"done":      op.Done(),
+	}
+
+	if input.Download && op.Status == "COMPLETED" {
+		limit := input.Limit
+		if limit <= 0 {
+			limit = 1000
+		}
+
+		results := []map[string]interface{}{}
+		truncated := false
+		if op.URL != nil && *op.URL != "" {
+			results, truncated, err = shared.DownloadBulkResults(context.Background(), *op.URL, limit)
+			if err != nil {
+				return nil, err
+			}
+		}
+		out["results"] = results
+		out["truncated"] = truncated
+	}
+
+	return out, nil
+}
+
+func NewGetBulkOperationAction() sdk.Action {
+	return &GetBulkOperationAction{}
+}
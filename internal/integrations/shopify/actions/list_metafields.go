@@ -0,0 +1,104 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type listMetafieldsActionProps struct {
+	OwnerType string      `json:"ownerType"`
+	OwnerID   interface{} `json:"ownerId"`
+	Namespace string      `json:"namespace"`
+	Limit     int         `json:"limit"`
+}
+
+type ListMetafieldsAction struct{}
+
+func (a *ListMetafieldsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_metafields",
+		DisplayName:   "List Metafields",
+		Description:   "List the metafields of a product, variant, order, customer, collection, the shop or another resource.",
+		Type:          core.ActionTypeAction,
+		Documentation: listMetafieldsDocs,
+		SampleOutput: map[string]any{
+			"metafields": []map[string]any{
+				{
+					"id":        "gid://shopify/Metafield/1069229001",
+					"namespace": "custom",
+					"key":       "care_guide",
+					"type":      "single_line_text_field",
+					"value":     "Hand wash only",
+				},
+			},
+			"values": map[string]any{"custom.care_guide": "Hand wash only"},
+			"count":  1,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *ListMetafieldsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_metafields", "List Metafields")
+
+	shared.RegisterMetafieldOwnerProps(form)
+
+	form.TextField("namespace", "Namespace").
+		Required(false).
+		HelpText("Only list metafields in this namespace.")
+
+	form.NumberField("limit", "Limit").
+		Required(false).
+		DefaultValue(50).
+		HelpText("The maximum number of metafields to return (up to 250).")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *ListMetafieldsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *ListMetafieldsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listMetafieldsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerGID, err := shared.MetafieldOwnerGID(context.Background(), client, input.OwnerType, input.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	metafields, err := shared.ListMetafields(context.Background(), client, ownerGID, input.Namespace, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(metafields))
+	for _, m := range metafields {
+		values[m.Namespace+"."+m.Key] = m.Value
+	}
+
+	return map[string]interface{}{
+		"metafields": metafields,
+		"values":     values,
+		"count":      len(metafields),
+	}, nil
+}
+
+func NewListMetafieldsAction() sdk.Action {
+	return &ListMetafieldsAction{}
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type runBulkMutationActionProps struct {
+	Mutation  string      `json:"mutation"`
+	Variables interface{} `json:"variables"`
+}
+
+type RunBulkMutationAction struct{}
+
+func (a *RunBulkMutationAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "run_bulk_mutation",
+		DisplayName:   "Run Bulk Mutation",
+		Description:   "Run a GraphQL mutation once per set of variables as a bulk operation, for importing or updating many records at once.",
+		Type:          core.ActionTypeAction,
+		Documentation: runBulkMutationDocs,
+		SampleOutput: map[string]any{
+			"id":        "gid://shopify/BulkOperation/720919",
+			"type":      "MUTATION",
+			"status":    "CREATED",
+			"createdAt": "2024-03-01T10:15:00Z",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *RunBulkMutationAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("run_bulk_mutation", "Run Bulk Mutation")
+
+	form.TextareaField("mutation", "Mutation").
+		Required(true).
+		Placeholder("mutation call($input: ProductInput!) { productUpdate(input: $input) { product { id } userErrors { message field } } }").
+		HelpText("The GraphQL mutation to run for each set of variables.")
+
+	form.TextareaField("variables", "Variables").
+		Required(true).
+		Placeholder(`[{"input": {"id": "gid://shopify/Product/1", "title": "New title"}}]`).
+		HelpText("The variables of each call, as a JSON array, one JSON object per line, or a list from a previous step.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *RunBulkMutationAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *RunBulkMutationAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[runBulkMutationActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Mutation) == "" {
+		return nil, errors.New("mutation is required")
+	}
+
+	variables, err := parseBulkVariables(input.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.RunBulkMutation(context.Background(), client, input.Mutation, variables)
+}
+
+// parseBulkVariables accepts a JSON array, JSON lines, or a list.
+func parseBulkVariables(v interface{}) ([]map[string]interface{}, error) {
+	var text string
+	switch vars := v.(type) {
+	case nil:
+		return nil, errors.New("variables are required")
+	case string:
+		text = strings.TrimSpace(vars)
+	default:
+		data, err := json.Marshal(vars)
+		if err != nil {
+			return nil, err
+		}
+		text = string(data)
+	}
+
+	var variables []map[string]interface{}
+	if strings.HasPrefix(text, "[") {
+		if err := json.Unmarshal([]byte(text), &variables); err != nil {
+			return nil, fmt.Errorf("variables must be a JSON array of objects: %w", err)
+		}
+		return variables, nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Buffer(make([]byte, 0, 64*1024), 8<<20)
+	for line := 1; scanner.Scan(); line++ {
+		row := strings.TrimSpace(scanner.Text())
+		if row == "" {
+			continue
+		}
+		var vars map[string]interface{}
+		if err := json.Unmarshal([]byte(row), &vars); err != nil {
+			return nil, fmt.Errorf("variables line %d is not a JSON object: %w", line, err)
+		}
+		variables = append(variables, vars)
+	}
+	return variables, scanner.Err()
+}
+
+func NewRunBulkMutationAction() sdk.Action {
+	return &RunBulkMutationAction{}
+}
@@ -0,0 +1,74 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type runBulkQueryActionProps struct {
+	Query string `json:"query"`
+}
+
+type RunBulkQueryAction struct{}
+
+func (a *RunBulkQueryAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "run_bulk_query",
+		DisplayName:   "Run Bulk Query",
+		Description:   "Start a GraphQL bulk query to export large sets of products, orders or other data without pagination limits.",
+		Type:          core.ActionTypeAction,
+		Documentation: runBulkQueryDocs,
+		SampleOutput: map[string]any{
+			"id":        "gid://shopify/BulkOperation/720918",
+			"type":      "QUERY",
+			"status":    "CREATED",
+			"createdAt": "2024-03-01T10:15:00Z",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *RunBulkQueryAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("run_bulk_query", "Run Bulk Query")
+
+	form.TextareaField("query", "Query").
+		Required(true).
+		Placeholder("{ products { edges { node { id title variants { edges { node { id sku inventoryQuantity } } } } } } }").
+		HelpText("The GraphQL query to run. Connections must use edges and node; Shopify paginates them for you.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *RunBulkQueryAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *RunBulkQueryAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[runBulkQueryActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Query) == "" {
+		return nil, errors.New("query is required")
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.RunBulkQuery(context.Background(), client, input.Query)
+}
+
+func NewRunBulkQueryAction() sdk.Action {
+	return &RunBulkQueryAction{}
+}
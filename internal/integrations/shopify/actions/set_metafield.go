@@ -0,0 +1,130 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type setMetafieldActionProps struct {
+	OwnerType string      `json:"ownerType"`
+	OwnerID   interface{} `json:"ownerId"`
+	Namespace string      `json:"namespace"`
+	Key       string      `json:"key"`
+	Type      string      `json:"type"`
+	Value     interface{} `json:"value"`
+}
+
+type SetMetafieldAction struct{}
+
+func (a *SetMetafieldAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "set_metafield",
+		DisplayName:   "Set Metafield",
+		Description:   "Create a metafield on a resource, or update its value if one with the same namespace and key exists.",
+		Type:          core.ActionTypeAction,
+		Documentation: setMetafieldDocs,
+		SampleOutput: map[string]any{
+			"id":        "gid://shopify/Metafield/1069229001",
+			"namespace": "custom",
+			"key":       "care_guide",
+			"type":      "single_line_text_field",
+			"value":     "Hand wash only",
+			"ownerType": "PRODUCT",
+			"createdAt": "2024-03-01T10:15:00Z",
+			"updatedAt": "2024-03-01T10:15:00Z",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *SetMetafieldAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("set_metafield", "Set Metafield")
+
+	shared.RegisterMetafieldOwnerProps(form)
+
+	form.TextField("namespace", "Namespace").
+		Required(true).
+		DefaultValue("custom").
+		HelpText("The metafield namespace.")
+
+	form.TextField("key", "Key").
+		Required(true).
+		HelpText("The metafield key.")
+
+	form.SelectField("type", "Type").
+		Required(false).
+		AddOptions([]*smartform.Option{
+			{Value: "single_line_text_field", Label: "Single line text"},
+			{Value: "multi_line_text_field", Label: "Multi-line text"},
+			{Value: "number_integer", Label: "Integer"},
+			{Value: "number_decimal", Label: "Decimal"},
+			{Value: "boolean", Label: "True or false"},
+			{Value: "date", Label: "Date"},
+			{Value: "date_time", Label: "Date and time"},
+			{Value: "url", Label: "URL"},
+			{Value: "json", Label: "JSON"},
+			{Value: "color", Label: "Color"},
+			{Value: "money", Label: "Money"},
+			{Value: "list.single_line_text_field", Label: "List of single line text"},
+		}...).
+		HelpText("The metafield type. Required unless the metafield has a definition or already exists.")
+
+	form.TextareaField("value", "Value").
+		Required(true).
+		HelpText("The value, as text. JSON and list types take JSON; lists and objects from a previous step are encoded for you.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *SetMetafieldAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *SetMetafieldAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[setMetafieldActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Namespace == "" || input.Key == "" {
+		return nil, errors.New("namespace and key are required")
+	}
+
+	var value string
+	switch v := input.Value.(type) {
+	case nil:
+		return nil, errors.New("value is required")
+	case string:
+		value = v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		value = string(data)
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerGID, err := shared.MetafieldOwnerGID(context.Background(), client, input.OwnerType, input.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.SetMetafield(context.Background(), client, ownerGID, input.Namespace, input.Key, input.Type, value)
+}
+
+func NewSetMetafieldAction() sdk.Action {
+	return &SetMetafieldAction{}
+}
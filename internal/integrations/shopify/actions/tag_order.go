@@ -0,0 +1,126 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type tagOrderActionProps struct {
+	OrderID   interface{} `json:"orderId"`
+	Operation string      `json:"operation"`
+	Tags      string      `json:"tags"`
+}
+
+type TagOrderAction struct{}
+
+func (a *TagOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "tag_order",
+		DisplayName:   "Add or Remove Order Tags",
+		Description:   "Add tags to or remove tags from an order without overwriting its other tags.",
+		Type:          core.ActionTypeAction,
+		Documentation: tagOrderDocs,
+		SampleOutput: map[string]any{
+			"id":       "gid://shopify/Order/450789469",
+			"order_id": 450789469,
+			"tags":     []string{"vip", "wholesale"},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *TagOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("tag_order", "Add or Remove Order Tags")
+
+	form.TextField("orderId", "Order ID").
+		Required(true).
+		HelpText("The ID of the order to tag.")
+
+	form.SelectField("operation", "Operation").
+		Required(true).
+		DefaultValue("add").
+		AddOptions([]*smartform.Option{
+			{Value: "add", Label: "Add tags"},
+			{Value: "remove", Label: "Remove tags"},
+		}...).
+		HelpText("Whether to add or remove the tags.")
+
+	form.TextField("tags", "Tags").
+		Required(true).
+		Placeholder("vip, wholesale").
+		HelpText("The tags, separated by commas.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *TagOrderAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *TagOrderAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[tagOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []string
+	for _, tag := range strings.Split(input.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == 0 {
+		return nil, errors.New("at least one tag is required")
+	}
+
+	orderGID, err := shared.GID("Order", input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Operation == "remove" {
+		err = shared.RemoveTags(context.Background(), client, orderGID, tags)
+	} else {
+		err = shared.AddTags(context.Background(), client, orderGID, tags)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Order *struct {
+			Tags []string `json:"tags"`
+		} `json:"order"`
+	}
+	if err := client.GraphQL.Query(context.Background(), `query OrderTags($id: ID!) { order(id: $id) { tags } }`, map[string]interface{}{"id": orderGID}, &resp); err != nil {
+		return nil, err
+	}
+	current := []string{}
+	if resp.Order != nil {
+		current = resp.Order.Tags
+	}
+
+	return map[string]interface{}{
+		"id":       orderGID,
+		"order_id": shared.LegacyID(orderGID),
+		"tags":     current,
+	}, nil
+}
+
+func NewTagOrderAction() sdk.Action {
+	return &TagOrderAction{}
+}
@@ -0,0 +1,106 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateFulfillmentTrackingActionProps struct {
+	FulfillmentID   interface{} `json:"fulfillmentId"`
+	TrackingNumber  string      `json:"trackingNumber"`
+	TrackingCompany string      `json:"trackingCompany"`
+	TrackingURL     string      `json:"trackingUrl"`
+	NotifyCustomer  bool        `json:"notifyCustomer"`
+}
+
+type UpdateFulfillmentTrackingAction struct{}
+
+func (a *UpdateFulfillmentTrackingAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_fulfillment_tracking",
+		DisplayName:   "Update Fulfillment Tracking",
+		Description:   "Replace the tracking number, carrier or tracking URL of an existing fulfillment.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateFulfillmentTrackingDocs,
+		SampleOutput: map[string]any{
+			"id":             "gid://shopify/Fulfillment/255858046",
+			"fulfillment_id": 255858046,
+			"status":         "SUCCESS",
+			"tracking_info": []map[string]any{
+				{"company": "FedEx", "number": "449044304137821", "url": "https://www.fedex.com/fedextrack/?trknbr=449044304137821"},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *UpdateFulfillmentTrackingAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_fulfillment_tracking", "Update Fulfillment Tracking")
+
+	form.TextField("fulfillmentId", "Fulfillment ID").
+		Required(true).
+		HelpText("The ID of the fulfillment to update.")
+
+	form.TextField("trackingNumber", "Tracking Number").
+		Required(false).
+		HelpText("The new tracking number. Separate several numbers with commas.")
+
+	form.TextField("trackingCompany", "Tracking Company").
+		Required(false).
+		Placeholder("FedEx").
+		HelpText("The carrier name.")
+
+	form.TextField("trackingUrl", "Tracking URL").
+		Required(false).
+		HelpText("The tracking page URL, for carriers Shopify does not know.")
+
+	form.CheckboxField("notifyCustomer", "Notify Customer").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Send the shipping update email to the customer.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *UpdateFulfillmentTrackingAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (a *UpdateFulfillmentTrackingAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateFulfillmentTrackingActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fulfillmentGID, err := shared.GID("Fulfillment", input.FulfillmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fulfillment, err := shared.UpdateFulfillmentTracking(context.Background(), client, fulfillmentGID, shared.FulfillmentTracking{
+		Company: input.TrackingCompany,
+		Number:  input.TrackingNumber,
+		URL:     input.TrackingURL,
+	}, input.NotifyCustomer)
+	if err != nil {
+		return nil, err
+	}
+
+	return fulfillment.Output(), nil
+}
+
+func NewUpdateFulfillmentTrackingAction() sdk.Action {
+	return &UpdateFulfillmentTrackingAction{}
+}
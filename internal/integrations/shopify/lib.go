@@ -36,6 +36,16 @@ func (n *Shopify) Triggers() []sdk.Trigger {
 		triggers.NewNewOrderTrigger(),
 
 		triggers.NewNewCustomerTrigger(),
+
+		triggers.NewAbandonedCheckoutTrigger(),
+
+		triggers.NewOrderPaidTrigger(),
+
+		triggers.NewOrderFulfilledTrigger(),
+
+		triggers.NewProductUpdatedTrigger(),
+
+		triggers.NewInventoryLowTrigger(),
 	}
 }
 
@@ -86,6 +96,32 @@ func (n *Shopify) Actions() []sdk.Action {
 		actions.NewCancelOrderAction(),
 
 		actions.NewAdjustInventoryLevelAction(),
+
+		actions.NewCreateFulfillmentAction(),
+
+		actions.NewUpdateFulfillmentTrackingAction(),
+
+		actions.NewCancelFulfillmentAction(),
+
+		actions.NewCalculateRefundAction(),
+
+		actions.NewCreateRefundAction(),
+
+		actions.NewListMetafieldsAction(),
+
+		actions.NewSetMetafieldAction(),
+
+		actions.NewDeleteMetafieldAction(),
+
+		actions.NewTagOrderAction(),
+
+		actions.NewCompleteDraftOrderAction(),
+
+		actions.NewRunBulkQueryAction(),
+
+		actions.NewRunBulkMutationAction(),
+
+		actions.NewGetBulkOperationAction(),
 	}
 }
 
@@ -0,0 +1,239 @@
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// BulkOperation is a Shopify bulk query or mutation.
+type BulkOperation struct {
+	ID              string  `json:"id"`
+	Type            string  `json:"type"`
+	Status          string  `json:"status"`
+	ErrorCode       *string `json:"errorCode"`
+	CreatedAt       string  `json:"createdAt"`
+	CompletedAt     *string `json:"completedAt"`
+	ObjectCount     string  `json:"objectCount"`
+	RootObjectCount string  `json:"rootObjectCount"`
+	FileSize        *string `json:"fileSize"`
+	URL             *string `json:"url"`
+	PartialDataURL  *string `json:"partialDataUrl"`
+	Query           string  `json:"query"`
+}
+
+// Done reports whether the operation has stopped running.
+func (b *BulkOperation) Done() bool {
+	switch b.Status {
+	case "COMPLETED", "FAILED", "CANCELED", "EXPIRED":
+		return true
+	default:
+		return false
+	}
+}
+
+const bulkOperationFields = `id type status errorCode createdAt completedAt objectCount rootObjectCount fileSize url partialDataUrl query`
+
+const bulkQueryMutation = `
+mutation BulkOperationRunQuery($query: String!) {
+	bulkOperationRunQuery(query: $query) {
+		bulkOperation { ` + bulkOperationFields + ` }
+		userErrors { field message code }
+	}
+}`
+
+// RunBulkQuery starts a bulk query. Shopify runs one bulk query per shop
+// at a time and writes the results to a JSONL file.
+func RunBulkQuery(ctx context.Context, client *goshopify.Client, query string) (*BulkOperation, error) {
+	var payload struct {
+		BulkOperation *BulkOperation `json:"bulkOperation"`
+	}
+	if err := Mutate(ctx, client, "bulkOperationRunQuery", bulkQueryMutation, map[string]interface{}{"query": query}, &payload); err != nil {
+		return nil, err
+	}
+	return payload.BulkOperation, nil
+}
+
+const stagedUploadMutation = `
+mutation StagedUploadsCreate($input: [StagedUploadInput!]!) {
+	stagedUploadsCreate(input: $input) {
+		stagedTargets {
+			url
+			resourceUrl
+			parameters { name value }
+		}
+		userErrors { field message }
+	}
+}`
+
+const bulkMutationMutation = `
+mutation BulkOperationRunMutation($mutation: String!, $path: String!) {
+	bulkOperationRunMutation(mutation: $mutation, stagedUploadPath: $path) {
+		bulkOperation { ` + bulkOperationFields + ` }
+		userErrors { field message code }
+	}
+}`
+
+// RunBulkMutation uploads one line of variables per mutation call and
+// starts a bulk mutation over them.
+func RunBulkMutation(ctx context.Context, client *goshopify.Client, mutation string, variables []map[string]interface{}) (*BulkOperation, error) {
+	if len(variables) == 0 {
+		return nil, errors.New("a bulk mutation needs at least one set of variables")
+	}
+
+	var jsonl bytes.Buffer
+	enc := json.NewEncoder(&jsonl)
+	for _, v := range variables {
+		if err := enc.Encode(v); err != nil {
+			return nil, err
+		}
+	}
+
+	var staged struct {
+		StagedTargets []struct {
+			URL        string `json:"url"`
+			Parameters []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"parameters"`
+		} `json:"stagedTargets"`
+	}
+	stageVars := map[string]interface{}{"input": []interface{}{map[string]interface{}{
+		"resource":   "BULK_MUTATION_VARIABLES",
+		"filename":   "bulk_op_vars.jsonl",
+		"mimeType":   "text/jsonl",
+		"httpMethod": "POST",
+	}}}
+	if err := Mutate(ctx, client, "stagedUploadsCreate", stagedUploadMutation, stageVars, &staged); err != nil {
+		return nil, err
+	}
+	if len(staged.StagedTargets) == 0 {
+		return nil, errors.New("shopify returned no upload target")
+	}
+	target := staged.StagedTargets[0]
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	var path string
+	for _, p := range target.Parameters {
+		if p.Name == "key" {
+			path = p.Value
+		}
+		if err := writer.WriteField(p.Name, p.Value); err != nil {
+			return nil, err
+		}
+	}
+	part, err := writer.CreateFormFile("file", "bulk_op_vars.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(jsonl.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not upload the bulk mutation variables: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("could not upload the bulk mutation variables (status %d): %s", resp.StatusCode, msg)
+	}
+
+	var payload struct {
+		BulkOperation *BulkOperation `json:"bulkOperation"`
+	}
+	vars := map[string]interface{}{"mutation": mutation, "path": path}
+	if err := Mutate(ctx, client, "bulkOperationRunMutation", bulkMutationMutation, vars, &payload); err != nil {
+		return nil, err
+	}
+	return payload.BulkOperation, nil
+}
+
+// GetBulkOperation returns a bulk operation by ID, or the shop's current
+// one of the given type ("QUERY" or "MUTATION") when id is empty.
+func GetBulkOperation(ctx context.Context, client *goshopify.Client, id, opType string) (*BulkOperation, error) {
+	if id != "" {
+		var resp struct {
+			Node *BulkOperation `json:"node"`
+		}
+		query := `query BulkOperation($id: ID!) { node(id: $id) { ... on BulkOperation { ` + bulkOperationFields + ` } } }`
+		if err := client.GraphQL.Query(ctx, query, map[string]interface{}{"id": id}, &resp); err != nil {
+			return nil, err
+		}
+		if resp.Node == nil {
+			return nil, fmt.Errorf("bulk operation %s not found", id)
+		}
+		return resp.Node, nil
+	}
+
+	if opType == "" {
+		opType = "QUERY"
+	}
+	var resp struct {
+		CurrentBulkOperation *BulkOperation `json:"currentBulkOperation"`
+	}
+	query := `query CurrentBulkOperation($type: BulkOperationType!) { currentBulkOperation(type: $type) { ` + bulkOperationFields + ` } }`
+	if err := client.GraphQL.Query(ctx, query, map[string]interface{}{"type": opType}, &resp); err != nil {
+		return nil, err
+	}
+	if resp.CurrentBulkOperation == nil {
+		return nil, fmt.Errorf("no %s bulk operation has run on this shop", opType)
+	}
+	return resp.CurrentBulkOperation, nil
+}
+
+// DownloadBulkResults reads up to limit lines of a bulk operation result
+// file. It reports whether the file had more lines.
+func DownloadBulkResults(ctx context.Context, url string, limit int) ([]map[string]interface{}, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("could not download bulk results: %s", resp.Status)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 8<<20)
+
+	var rows []map[string]interface{}
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if len(rows) == limit {
+			return rows, true, nil
+		}
+		var row map[string]interface{}
+		if err := json.Unmarshal(line, &row); err != nil {
+			return nil, false, fmt.Errorf("invalid line in bulk results: %w", err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, false, scanner.Err()
+}
@@ -0,0 +1,314 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// fulfillmentFields is selected on every fulfillment returned.
+const fulfillmentFields = `
+	id
+	name
+	status
+	createdAt
+	updatedAt
+	trackingInfo { company number url }
+	location { id name }
+`
+
+// Fulfillment is a fulfillment as returned by the GraphQL Admin API.
+type Fulfillment struct {
+	ID           string                `json:"id"`
+	Name         string                `json:"name"`
+	Status       string                `json:"status"`
+	CreatedAt    string                `json:"createdAt"`
+	UpdatedAt    string                `json:"updatedAt"`
+	TrackingInfo []FulfillmentTracking `json:"trackingInfo"`
+	Location     *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"location"`
+}
+
+// FulfillmentTracking is the tracking of a fulfillment.
+type FulfillmentTracking struct {
+	Company string `json:"company,omitempty"`
+	Number  string `json:"number,omitempty"`
+	URL     string `json:"url,omitempty"`
+}
+
+// Output flattens the fulfillment for action output.
+func (f *Fulfillment) Output() map[string]interface{} {
+	out := map[string]interface{}{
+		"id":             f.ID,
+		"fulfillment_id": LegacyID(f.ID),
+		"name":           f.Name,
+		"status":         f.Status,
+		"created_at":     f.CreatedAt,
+		"updated_at":     f.UpdatedAt,
+		"tracking_info":  f.TrackingInfo,
+	}
+	if f.Location != nil {
+		out["location_id"] = LegacyID(f.Location.ID)
+		out["location_name"] = f.Location.Name
+	}
+	return out
+}
+
+// FulfillmentOrder is an open group of order items assigned to one
+// location.
+type FulfillmentOrder struct {
+	ID               string `json:"id"`
+	Status           string `json:"status"`
+	AssignedLocation struct {
+		Location *struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"location"`
+	} `json:"assignedLocation"`
+	LineItems struct {
+		Nodes []struct {
+			ID                string `json:"id"`
+			RemainingQuantity int    `json:"remainingQuantity"`
+			LineItem          struct {
+				ID   string `json:"id"`
+				SKU  string `json:"sku"`
+				Name string `json:"name"`
+			} `json:"lineItem"`
+		} `json:"nodes"`
+	} `json:"lineItems"`
+}
+
+// LocationID returns the numeric ID of the assigned location.
+func (f *FulfillmentOrder) LocationID() uint64 {
+	if f.AssignedLocation.Location == nil {
+		return 0
+	}
+	return LegacyID(f.AssignedLocation.Location.ID)
+}
+
+const openFulfillmentOrdersQuery = `
+query OpenFulfillmentOrders($id: ID!) {
+	order(id: $id) {
+		id
+		fulfillmentOrders(first: 50) {
+			nodes {
+				id
+				status
+				assignedLocation { location { id name } }
+				lineItems(first: 100) {
+					nodes {
+						id
+						remainingQuantity
+						lineItem { id sku name }
+					}
+				}
+			}
+		}
+	}
+}`
+
+// OpenFulfillmentOrders returns the fulfillment orders of an order that
+// can still be fulfilled.
+func OpenFulfillmentOrders(ctx context.Context, client *goshopify.Client, orderGID string) ([]FulfillmentOrder, error) {
+	var resp struct {
+		Order *struct {
+			FulfillmentOrders struct {
+				Nodes []FulfillmentOrder `json:"nodes"`
+			} `json:"fulfillmentOrders"`
+		} `json:"order"`
+	}
+	if err := client.GraphQL.Query(ctx, openFulfillmentOrdersQuery, map[string]interface{}{"id": orderGID}, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Order == nil {
+		return nil, fmt.Errorf("order %s not found", orderGID)
+	}
+
+	var open []FulfillmentOrder
+	for _, fo := range resp.Order.FulfillmentOrders.Nodes {
+		if fo.Status == "OPEN" || fo.Status == "IN_PROGRESS" {
+			open = append(open, fo)
+		}
+	}
+	return open, nil
+}
+
+// FulfillmentRequest describes what to fulfill.
+type FulfillmentRequest struct {
+	// LocationID limits the fulfillment to items assigned to a location.
+	LocationID uint64
+
+	// Quantities maps order line item IDs to the quantity to fulfill. An
+	// empty map fulfills everything that remains.
+	Quantities map[uint64]int
+
+	Tracking       FulfillmentTracking
+	NotifyCustomer bool
+}
+
+const fulfillmentCreateMutation = `
+mutation FulfillmentCreate($fulfillment: FulfillmentV2Input!) {
+	fulfillmentCreateV2(fulfillment: $fulfillment) {
+		fulfillment {` + fulfillmentFields + `}
+		userErrors { field message }
+	}
+}`
+
+// CreateFulfillments fulfills the open items of an order. Shopify takes
+// one location per fulfillment, so items at different locations produce
+// one fulfillment each.
+func CreateFulfillments(ctx context.Context, client *goshopify.Client, orderGID string, req FulfillmentRequest) ([]*Fulfillment, error) {
+	orders, err := OpenFulfillmentOrders(ctx, client, orderGID)
+	if err != nil {
+		return nil, err
+	}
+
+	byLocation := map[uint64][]interface{}{}
+	var locations []uint64
+	remaining := map[uint64]int{}
+	for id, qty := range req.Quantities {
+		remaining[id] = qty
+	}
+
+	for _, fo := range orders {
+		location := fo.LocationID()
+		if req.LocationID != 0 && location != req.LocationID {
+			continue
+		}
+
+		var items []interface{}
+		for _, item := range fo.LineItems.Nodes {
+			qty := item.RemainingQuantity
+			if len(req.Quantities) > 0 {
+				want := remaining[LegacyID(item.LineItem.ID)]
+				qty = min(qty, want)
+				remaining[LegacyID(item.LineItem.ID)] -= qty
+			}
+			if qty <= 0 {
+				continue
+			}
+			items = append(items, map[string]interface{}{"id": item.ID, "quantity": qty})
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		if _, ok := byLocation[location]; !ok {
+			locations = append(locations, location)
+		}
+		byLocation[location] = append(byLocation[location], map[string]interface{}{
+			"fulfillmentOrderId":        fo.ID,
+			"fulfillmentOrderLineItems": items,
+		})
+	}
+
+	for id, qty := range remaining {
+		if qty > 0 {
+			return nil, fmt.Errorf("line item %d does not have %d more unfulfilled units", id, qty)
+		}
+	}
+	if len(locations) == 0 {
+		return nil, errors.New("the order has nothing left to fulfill")
+	}
+
+	fulfillments := make([]*Fulfillment, 0, len(locations))
+	for _, location := range locations {
+		input := map[string]interface{}{
+			"lineItemsByFulfillmentOrder": byLocation[location],
+			"notifyCustomer":              req.NotifyCustomer,
+		}
+		if tracking := trackingInput(req.Tracking); tracking != nil {
+			input["trackingInfo"] = tracking
+		}
+
+		var payload struct {
+			Fulfillment *Fulfillment `json:"fulfillment"`
+		}
+		if err := Mutate(ctx, client, "fulfillmentCreateV2", fulfillmentCreateMutation, map[string]interface{}{"fulfillment": input}, &payload); err != nil {
+			return fulfillments, err
+		}
+		fulfillments = append(fulfillments, payload.Fulfillment)
+	}
+
+	return fulfillments, nil
+}
+
+const trackingUpdateMutation = `
+mutation FulfillmentTrackingInfoUpdate($id: ID!, $tracking: FulfillmentTrackingInput!, $notify: Boolean) {
+	fulfillmentTrackingInfoUpdateV2(fulfillmentId: $id, trackingInfoInput: $tracking, notifyCustomer: $notify) {
+		fulfillment {` + fulfillmentFields + `}
+		userErrors { field message }
+	}
+}`
+
+// UpdateFulfillmentTracking replaces the tracking of a fulfillment.
+func UpdateFulfillmentTracking(ctx context.Context, client *goshopify.Client, fulfillmentGID string, tracking FulfillmentTracking, notify bool) (*Fulfillment, error) {
+	input := trackingInput(tracking)
+	if input == nil {
+		return nil, errors.New("a tracking number or URL is required")
+	}
+
+	var payload struct {
+		Fulfillment *Fulfillment `json:"fulfillment"`
+	}
+	vars := map[string]interface{}{"id": fulfillmentGID, "tracking": input, "notify": notify}
+	if err := Mutate(ctx, client, "fulfillmentTrackingInfoUpdateV2", trackingUpdateMutation, vars, &payload); err != nil {
+		return nil, err
+	}
+	return payload.Fulfillment, nil
+}
+
+const fulfillmentCancelMutation = `
+mutation FulfillmentCancel($id: ID!) {
+	fulfillmentCancel(id: $id) {
+		fulfillment {` + fulfillmentFields + `}
+		userErrors { field message }
+	}
+}`
+
+// CancelFulfillment cancels a fulfillment, returning its items to the
+// fulfillment orders.
+func CancelFulfillment(ctx context.Context, client *goshopify.Client, fulfillmentGID string) (*Fulfillment, error) {
+	var payload struct {
+		Fulfillment *Fulfillment `json:"fulfillment"`
+	}
+	if err := Mutate(ctx, client, "fulfillmentCancel", fulfillmentCancelMutation, map[string]interface{}{"id": fulfillmentGID}, &payload); err != nil {
+		return nil, err
+	}
+	return payload.Fulfillment, nil
+}
+
+// trackingInput builds a FulfillmentTrackingInput. Several numbers can be
+// given separated by commas.
+func trackingInput(t FulfillmentTracking) map[string]interface{} {
+	var numbers []string
+	for _, n := range strings.Split(t.Number, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			numbers = append(numbers, n)
+		}
+	}
+	if len(numbers) == 0 && t.URL == "" {
+		return nil
+	}
+
+	input := map[string]interface{}{}
+	if t.Company != "" {
+		input["company"] = t.Company
+	}
+	switch len(numbers) {
+	case 0:
+	case 1:
+		input["number"] = numbers[0]
+	default:
+		input["numbers"] = numbers
+	}
+	if t.URL != "" {
+		input["url"] = t.URL
+	}
+	return input
+}
@@ -0,0 +1,148 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// GraphQLAPIVersion pins GraphQL calls to a release that has the
+// fulfillment, metafield and bulk operation mutations used here. REST
+// calls keep the store default.
+const GraphQLAPIVersion = "2025-01"
+
+// CreateGraphQLClient returns a client for the GraphQL Admin API.
+func CreateGraphQLClient(ctx sdkcontext.BaseContext) (*goshopify.Client, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	return GraphQLClientFrom(authCtx.Extra)
+}
+
+// GraphQLClientFrom returns a GraphQL Admin API client for the values of
+// a connection, keyed by the fields of the auth form.
+func GraphQLClientFrom(values map[string]string) (*goshopify.Client, error) {
+	if values["domain"] == "" {
+		return nil, errors.New("missing shopify shop name")
+	}
+	return NewGraphQLClient(values["domain"], values["token"])
+}
+
+// NewGraphQLClient returns a GraphQL Admin API client for a shop name, as
+// in example.myshopify.com, and an admin token.
+func NewGraphQLClient(domain, token string) (*goshopify.Client, error) {
+	if token == "" {
+		return nil, errors.New("missing shopify auth token")
+	}
+
+	shopName := strings.TrimSuffix(domain, ".myshopify.com") + ".myshopify.com"
+
+	return goshopify.NewClient(app, shopName, token,
+		goshopify.WithVersion(GraphQLAPIVersion),
+		goshopify.WithRetry(3),
+	)
+}
+
+// UserError is a validation error returned in a mutation payload.
+type UserError struct {
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+	Code    string   `json:"code,omitempty"`
+}
+
+// UserErrorsToError joins mutation user errors into one error.
+func UserErrorsToError(errs []UserError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(errs))
+	for _, e := range errs {
+		if len(e.Field) > 0 {
+			messages = append(messages, strings.Join(e.Field, ".")+": "+e.Message)
+		} else {
+			messages = append(messages, e.Message)
+		}
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// Mutate runs a mutation and fails on top-level or user errors. The
+// object under data.<name>, which must select userErrors, is decoded
+// into payload when it is not nil.
+func Mutate(ctx context.Context, client *goshopify.Client, name, query string, vars map[string]interface{}, payload interface{}) error {
+	var resp map[string]json.RawMessage
+	if err := client.GraphQL.Query(ctx, query, vars, &resp); err != nil {
+		return err
+	}
+
+	raw, ok := resp[name]
+	if !ok {
+		return fmt.Errorf("%s returned no result", name)
+	}
+
+	var result struct {
+		UserErrors []UserError `json:"userErrors"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return err
+	}
+	if err := UserErrorsToError(result.UserErrors); err != nil {
+		return fmt.Errorf("%s failed: %w", name, err)
+	}
+
+	if payload == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, payload)
+}
+
+// GID returns the global ID of a resource. It accepts a numeric REST ID
+// or an existing gid://shopify/... value.
+func GID(resource string, id interface{}) (string, error) {
+	var s string
+	switch v := id.(type) {
+	case string:
+		s = strings.TrimSpace(v)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		s = strconv.Itoa(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case uint64:
+		s = strconv.FormatUint(v, 10)
+	case nil:
+	default:
+		s = fmt.Sprint(v)
+	}
+
+	if s == "" {
+		return "", fmt.Errorf("a %s ID is required", resource)
+	}
+	if strings.HasPrefix(s, "gid://") {
+		return s, nil
+	}
+	if _, err := strconv.ParseUint(s, 10, 64); err != nil {
+		return "", fmt.Errorf("invalid %s ID %q", resource, s)
+	}
+	return fmt.Sprintf("gid://shopify/%s/%s", resource, s), nil
+}
+
+// LegacyID returns the numeric REST ID of a global ID.
+func LegacyID(gid string) uint64 {
+	if i := strings.LastIndex(gid, "/"); i >= 0 {
+		gid = gid[i+1:]
+	}
+	gid, _, _ = strings.Cut(gid, "?")
+	id, _ := strconv.ParseUint(gid, 10, 64)
+	return id
+}
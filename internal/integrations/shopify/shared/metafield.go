@@ -0,0 +1,202 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/juicycleff/smartform/v1"
+)
+
+// MetafieldOwnerTypes are the resources metafield actions can target,
+// keyed by their GraphQL type.
+var MetafieldOwnerTypes = []*smartform.Option{
+	{Value: "Product", Label: "Product"},
+	{Value: "ProductVariant", Label: "Product Variant"},
+	{Value: "Order", Label: "Order"},
+	{Value: "DraftOrder", Label: "Draft Order"},
+	{Value: "Customer", Label: "Customer"},
+	{Value: "Collection", Label: "Collection"},
+	{Value: "Company", Label: "Company"},
+	{Value: "Location", Label: "Location"},
+	{Value: "Page", Label: "Page"},
+	{Value: "Blog", Label: "Blog"},
+	{Value: "Article", Label: "Article"},
+	{Value: "Shop", Label: "Shop"},
+}
+
+// RegisterMetafieldOwnerProps adds the owner type and ID fields.
+func RegisterMetafieldOwnerProps(form *smartform.FormBuilder) {
+	form.SelectField("ownerType", "Resource Type").
+		Required(true).
+		DefaultValue("Product").
+		AddOptions(MetafieldOwnerTypes...).
+		HelpText("The kind of resource the metafield belongs to.")
+
+	form.TextField("ownerId", "Resource ID").
+		Required(false).
+		HelpText("The ID of the resource, as a number or a gid://shopify/... ID. Not needed for Shop.")
+}
+
+// Metafield is a metafield as returned by the GraphQL Admin API.
+type Metafield struct {
+	ID        string `json:"id"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	OwnerType string `json:"ownerType,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+const metafieldFields = `id namespace key type value ownerType createdAt updatedAt`
+
+// MetafieldOwnerGID resolves the global ID of a metafield owner. The
+// shop is looked up since it has no ID input.
+func MetafieldOwnerGID(ctx context.Context, client *goshopify.Client, ownerType string, ownerID interface{}) (string, error) {
+	if ownerType == "" {
+		return "", errors.New("a resource type is required")
+	}
+	if ownerType != "Shop" {
+		return GID(ownerType, ownerID)
+	}
+
+	var resp struct {
+		Shop struct {
+			ID string `json:"id"`
+		} `json:"shop"`
+	}
+	if err := client.GraphQL.Query(ctx, `query { shop { id } }`, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.Shop.ID, nil
+}
+
+const listMetafieldsQuery = `
+query ListMetafields($id: ID!, $namespace: String, $first: Int!) {
+	node(id: $id) {
+		... on HasMetafields {
+			metafields(first: $first, namespace: $namespace) {
+				nodes { ` + metafieldFields + ` }
+			}
+		}
+	}
+}`
+
+// ListMetafields returns the metafields of a resource, optionally in one
+// namespace.
+func ListMetafields(ctx context.Context, client *goshopify.Client, ownerGID, namespace string, first int) ([]Metafield, error) {
+	if first <= 0 || first > 250 {
+		first = 250
+	}
+
+	vars := map[string]interface{}{"id": ownerGID, "first": first}
+	if namespace != "" {
+		vars["namespace"] = namespace
+	}
+
+	var resp struct {
+		Node *struct {
+			Metafields struct {
+				Nodes []Metafield `json:"nodes"`
+			} `json:"metafields"`
+		} `json:"node"`
+	}
+	if err := client.GraphQL.Query(ctx, listMetafieldsQuery, vars, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Node == nil {
+		return nil, fmt.Errorf("resource %s not found", ownerGID)
+	}
+	return resp.Node.Metafields.Nodes, nil
+}
+
+const metafieldsSetMutation = `
+mutation MetafieldsSet($metafields: [MetafieldsSetInput!]!) {
+	metafieldsSet(metafields: $metafields) {
+		metafields { ` + metafieldFields + ` }
+		userErrors { field message code }
+	}
+}`
+
+// SetMetafield creates a metafield or updates the value of an existing
+// one with the same namespace and key.
+func SetMetafield(ctx context.Context, client *goshopify.Client, ownerGID, namespace, key, valueType, value string) (*Metafield, error) {
+	input := map[string]interface{}{
+		"ownerId":   ownerGID,
+		"namespace": namespace,
+		"key":       key,
+		"value":     value,
+	}
+	if valueType != "" {
+		input["type"] = valueType
+	}
+
+	var payload struct {
+		Metafields []Metafield `json:"metafields"`
+	}
+	vars := map[string]interface{}{"metafields": []interface{}{input}}
+	if err := Mutate(ctx, client, "metafieldsSet", metafieldsSetMutation, vars, &payload); err != nil {
+		return nil, err
+	}
+	if len(payload.Metafields) == 0 {
+		return nil, errors.New("metafieldsSet returned no metafield")
+	}
+	return &payload.Metafields[0], nil
+}
+
+const metafieldsDeleteMutation = `
+mutation MetafieldsDelete($metafields: [MetafieldIdentifierInput!]!) {
+	metafieldsDelete(metafields: $metafields) {
+		deletedMetafields { ownerId namespace key }
+		userErrors { field message }
+	}
+}`
+
+// DeleteMetafield deletes a metafield by namespace and key. It reports
+// whether a metafield existed.
+func DeleteMetafield(ctx context.Context, client *goshopify.Client, ownerGID, namespace, key string) (bool, error) {
+	var payload struct {
+		DeletedMetafields []*struct {
+			Key string `json:"key"`
+		} `json:"deletedMetafields"`
+	}
+	vars := map[string]interface{}{"metafields": []interface{}{map[string]interface{}{
+		"ownerId":   ownerGID,
+		"namespace": namespace,
+		"key":       key,
+	}}}
+	if err := Mutate(ctx, client, "metafieldsDelete", metafieldsDeleteMutation, vars, &payload); err != nil {
+		return false, err
+	}
+	return len(payload.DeletedMetafields) > 0 && payload.DeletedMetafields[0] != nil, nil
+}
+
+const tagsAddMutation = `
+mutation TagsAdd($id: ID!, $tags: [String!]!) {
+	tagsAdd(id: $id, tags: $tags) {
+		node { id }
+		userErrors { field message }
+	}
+}`
+
+const tagsRemoveMutation = `
+mutation TagsRemove($id: ID!, $tags: [String!]!) {
+	tagsRemove(id: $id, tags: $tags) {
+		node { id }
+		userErrors { field message }
+	}
+}`
+
+// AddTags adds tags to a taggable resource without touching its other
+// tags.
+func AddTags(ctx context.Context, client *goshopify.Client, gid string, tags []string) error {
+	return Mutate(ctx, client, "tagsAdd", tagsAddMutation, map[string]interface{}{"id": gid, "tags": tags}, nil)
+}
+
+// RemoveTags removes tags from a taggable resource.
+func RemoveTags(ctx context.Context, client *goshopify.Client, gid string, tags []string) error {
+	return Mutate(ctx, client, "tagsRemove", tagsRemoveMutation, map[string]interface{}{"id": gid, "tags": tags}, nil)
+}
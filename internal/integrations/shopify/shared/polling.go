@@ -0,0 +1,141 @@
+package shared
+
+import (
+	"encoding/json"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// maxPollPages bounds a run to 2,500 records; the next runs read the rest.
+const maxPollPages = 10
+
+// pollState is where a trigger's listing left off.
+type pollState struct {
+	// PageInfo is the cursor of the next page when a run stopped before
+	// the last one.
+	PageInfo string `json:"page_info,omitempty"`
+	// Next is the time the listing after this one starts from.
+	Next time.Time `json:"next"`
+}
+
+// LastRun returns the time to poll from: the override when set, else the
+// last run of the trigger, else the zero time.
+func LastRun(ctx sdkcontext.ExecuteContext, override *time.Time) time.Time {
+	if override != nil {
+		return *override
+	}
+	if lr := ctx.LastRun(); lr != nil {
+		return *lr
+	}
+	return time.Time{}
+}
+
+// PollPages lists the records changed since the last run, following the
+// page_info cursor of each page. first returns the options of the first
+// page for the window from, until. A run reads at most maxPollPages pages
+// and stores the cursor under key, so the next run continues where it
+// stopped instead of skipping the records past the last page read.
+func PollPages[T any](ctx sdkcontext.ExecuteContext, key string, override *time.Time, first func(from, until time.Time) interface{}, list func(options interface{}) ([]T, *goshopify.Pagination, error)) ([]T, error) {
+	prev := loadPoll(ctx, key)
+
+	next := pollState{Next: time.Now().UTC()}
+	var options interface{}
+	if prev.PageInfo != "" {
+		next.Next = prev.Next
+		options = &goshopify.ListOptions{PageInfo: prev.PageInfo, Limit: 250}
+	} else {
+		from := LastRun(ctx, override)
+		if override == nil && !prev.Next.IsZero() {
+			from = prev.Next
+		}
+		options = first(from.UTC(), next.Next)
+	}
+
+	var records []T
+	for page := 0; page < maxPollPages; page++ {
+		batch, pagination, err := list(options)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, batch...)
+		if pagination == nil || pagination.NextPageOptions == nil {
+			next.PageInfo = ""
+			break
+		}
+		next.PageInfo = pagination.NextPageOptions.PageInfo
+		options = pagination.NextPageOptions
+	}
+
+	if err := ctx.SetMetadata(key, next); err != nil {
+		ctx.Logger().Warn("failed to store shopify poll state", "key", key, "error", err)
+	}
+	return records, nil
+}
+
+func loadPoll(ctx sdkcontext.ExecuteContext, key string) pollState {
+	var state pollState
+	stored, err := ctx.GetMetadata(key)
+	if err != nil || stored == nil {
+		return state
+	}
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &state)
+	}
+	if err != nil {
+		ctx.Logger().Warn("ignoring unreadable shopify poll state", "key", key, "error", err)
+		return pollState{}
+	}
+	return state
+}
+
+// Entered returns the IDs not in the set stored under key and replaces
+// the set with ids. Threshold triggers use it to fire once when a record
+// crosses the threshold, and again only after it has left and re-entered.
+func Entered(ctx sdkcontext.ExecuteContext, key string, ids []uint64) map[uint64]bool {
+	previous := map[uint64]bool{}
+	if stored, err := ctx.GetMetadata(key); err == nil {
+		for _, id := range toIDs(stored) {
+			previous[id] = true
+		}
+	}
+
+	entered := map[uint64]bool{}
+	for _, id := range ids {
+		if !previous[id] {
+			entered[id] = true
+		}
+	}
+
+	if err := ctx.SetMetadata(key, ids); err != nil {
+		ctx.Logger().Warn("failed to store shopify ids", "key", key, "error", err)
+	}
+
+	return entered
+}
+
+func toIDs(v interface{}) []uint64 {
+	switch ids := v.(type) {
+	case []uint64:
+		return ids
+	case []interface{}:
+		out := make([]uint64, 0, len(ids))
+		for _, id := range ids {
+			switch n := id.(type) {
+			case float64:
+				out = append(out, uint64(n))
+			case uint64:
+				out = append(out, n)
+			case int64:
+				out = append(out, uint64(n))
+			case int:
+				out = append(out, uint64(n))
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,146 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// RefundLineItem is a line item to refund.
+type RefundLineItem struct {
+	LineItemID  uint64 `json:"line_item_id"`
+	Quantity    int    `json:"quantity"`
+	RestockType string `json:"restock_type,omitempty"`
+	LocationID  uint64 `json:"location_id,omitempty"`
+}
+
+// RefundShipping is the shipping part of a refund.
+type RefundShipping struct {
+	FullRefund bool   `json:"full_refund,omitempty"`
+	Amount     string `json:"amount,omitempty"`
+}
+
+// RefundTransaction is a refund payment against a parent transaction.
+type RefundTransaction struct {
+	ParentID          uint64 `json:"parent_id,omitempty"`
+	Amount            string `json:"amount"`
+	Kind              string `json:"kind"`
+	Gateway           string `json:"gateway,omitempty"`
+	MaximumRefundable string `json:"maximum_refundable,omitempty"`
+}
+
+// RefundRequest is the body of the refund calculate and create calls.
+type RefundRequest struct {
+	Currency        string              `json:"currency,omitempty"`
+	Notify          bool                `json:"notify,omitempty"`
+	Note            string              `json:"note,omitempty"`
+	Shipping        *RefundShipping     `json:"shipping,omitempty"`
+	RefundLineItems []RefundLineItem    `json:"refund_line_items,omitempty"`
+	Transactions    []RefundTransaction `json:"transactions,omitempty"`
+}
+
+type refundResource struct {
+	Refund json.RawMessage `json:"refund"`
+}
+
+// CalculateRefund asks Shopify for the refund of the given items and
+// shipping. It returns the calculated refund and its suggested
+// transactions, which must be sent back as refund transactions to create
+// the refund.
+func CalculateRefund(ctx context.Context, client *goshopify.Client, orderID uint64, req RefundRequest) (map[string]interface{}, []RefundTransaction, error) {
+	body := map[string]interface{}{"refund": RefundRequest{
+		Currency:        req.Currency,
+		Shipping:        req.Shipping,
+		RefundLineItems: req.RefundLineItems,
+	}}
+
+	var resource refundResource
+	if err := client.Post(ctx, fmt.Sprintf("orders/%d/refunds/calculate.json", orderID), body, &resource); err != nil {
+		return nil, nil, err
+	}
+
+	var refund map[string]interface{}
+	if err := json.Unmarshal(resource.Refund, &refund); err != nil {
+		return nil, nil, err
+	}
+
+	var calculated struct {
+		Transactions []RefundTransaction `json:"transactions"`
+	}
+	if err := json.Unmarshal(resource.Refund, &calculated); err != nil {
+		return nil, nil, err
+	}
+
+	return refund, calculated.Transactions, nil
+}
+
+// CreateRefund creates a refund on an order.
+func CreateRefund(ctx context.Context, client *goshopify.Client, orderID uint64, req RefundRequest) (map[string]interface{}, error) {
+	for i := range req.Transactions {
+		req.Transactions[i].Kind = "refund"
+		req.Transactions[i].MaximumRefundable = ""
+	}
+
+	var resource refundResource
+	if err := client.Post(ctx, fmt.Sprintf("orders/%d/refunds.json", orderID), map[string]interface{}{"refund": req}, &resource); err != nil {
+		return nil, err
+	}
+
+	var refund map[string]interface{}
+	if err := json.Unmarshal(resource.Refund, &refund); err != nil {
+		return nil, err
+	}
+	return refund, nil
+}
+
+// RemainingLineItems returns every line item of the order with the
+// quantity not yet refunded.
+func RemainingLineItems(order *goshopify.Order) []RefundLineItem {
+	refunded := map[uint64]int{}
+	for _, refund := range order.Refunds {
+		for _, item := range refund.RefundLineItems {
+			refunded[item.LineItemId] += item.Quantity
+		}
+	}
+
+	var items []RefundLineItem
+	for _, item := range order.LineItems {
+		if remaining := item.Quantity - refunded[item.Id]; remaining > 0 {
+			items = append(items, RefundLineItem{LineItemID: item.Id, Quantity: remaining})
+		}
+	}
+	return items
+}
+
+// ParseLineItems reads line item quantities, for refunds and
+// fulfillments, given as JSON text or as a list from a previous step.
+func ParseLineItems(v interface{}) ([]RefundLineItem, error) {
+	var data []byte
+	switch items := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if items == "" {
+			return nil, nil
+		}
+		data = []byte(items)
+	default:
+		var err error
+		if data, err = json.Marshal(items); err != nil {
+			return nil, err
+		}
+	}
+
+	var items []RefundLineItem
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf(`line items must be a list like [{"line_item_id": 123, "quantity": 1}]: %w`, err)
+	}
+	for _, item := range items {
+		if item.LineItemID == 0 || item.Quantity <= 0 {
+			return nil, fmt.Errorf("each line item needs a line_item_id and a positive quantity")
+		}
+	}
+	return items, nil
+}
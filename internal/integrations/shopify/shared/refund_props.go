@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/juicycleff/smartform/v1"
+)
+
+// RefundProps are the inputs shared by the refund calculate and create
+// actions.
+type RefundProps struct {
+	OrderID        uint64      `json:"orderId"`
+	LineItems      interface{} `json:"lineItems"`
+	RefundAll      bool        `json:"refundAll"`
+	RestockType    string      `json:"restockType"`
+	LocationID     uint64      `json:"locationId"`
+	RefundShipping bool        `json:"refundShipping"`
+	ShippingAmount string      `json:"shippingAmount"`
+}
+
+// RegisterRefundProps adds the refund item and shipping fields.
+func RegisterRefundProps(form *smartform.FormBuilder) {
+	form.NumberField("orderId", "Order ID").
+		Required(true).
+		HelpText("The ID of the order to refund.")
+
+	form.TextareaField("lineItems", "Line Items").
+		Required(false).
+		Placeholder(`[{"line_item_id": 466157049, "quantity": 1}]`).
+		HelpText("Order line items and quantities to refund.")
+
+	form.CheckboxField("refundAll", "Refund All Items").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Refund every item that has not been refunded yet, instead of the line items above.")
+
+	form.SelectField("restockType", "Restock").
+		Required(false).
+		DefaultValue("no_restock").
+		AddOptions([]*smartform.Option{
+			{Value: "no_restock", Label: "Do not restock"},
+			{Value: "cancel", Label: "Restock unfulfilled items (cancel)"},
+			{Value: "return", Label: "Restock returned items (return)"},
+		}...).
+		HelpText("Whether refunded items go back into inventory.")
+
+	form.NumberField("locationId", "Restock Location ID").
+		Required(false).
+		HelpText("The location to restock at. Required by Shopify when restocking on stores with several locations.")
+
+	form.CheckboxField("refundShipping", "Refund Full Shipping").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Refund all remaining shipping costs.")
+
+	form.TextField("shippingAmount", "Shipping Amount").
+		Required(false).
+		Placeholder("5.00").
+		HelpText("Refund this much shipping instead of the full amount.")
+}
+
+// Request builds the refund request of the props, loading the order to
+// list its remaining items when everything is refunded.
+func (p RefundProps) Request(ctx context.Context, client *goshopify.Client) (RefundRequest, error) {
+	var req RefundRequest
+
+	if p.RefundAll {
+		order, err := client.Order.Get(ctx, p.OrderID, nil)
+		if err != nil {
+			return req, err
+		}
+		req.RefundLineItems = RemainingLineItems(order)
+		req.Currency = order.Currency
+	} else {
+		items, err := ParseLineItems(p.LineItems)
+		if err != nil {
+			return req, err
+		}
+		req.RefundLineItems = items
+	}
+
+	restock := p.RestockType
+	if restock == "" {
+		restock = "no_restock"
+	}
+	for i := range req.RefundLineItems {
+		if req.RefundLineItems[i].RestockType == "" {
+			req.RefundLineItems[i].RestockType = restock
+		}
+		if req.RefundLineItems[i].LocationID == 0 && req.RefundLineItems[i].RestockType != "no_restock" {
+			req.RefundLineItems[i].LocationID = p.LocationID
+		}
+	}
+
+	switch {
+	case strings.TrimSpace(p.ShippingAmount) != "":
+		if _, err := strconv.ParseFloat(strings.TrimSpace(p.ShippingAmount), 64); err != nil {
+			return req, errors.New("shipping amount must be a number")
+		}
+		req.Shipping = &RefundShipping{Amount: strings.TrimSpace(p.ShippingAmount)}
+	case p.RefundShipping:
+		req.Shipping = &RefundShipping{FullRefund: true}
+	}
+
+	return req, nil
+}
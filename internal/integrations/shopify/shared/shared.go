@@ -15,7 +15,7 @@ var markdown = `
 3. • Click on Develop apps
 4. • Create an App
 5. • Fill the app name
-6. • Click on Configure Admin API Scopes (Select the following scopes: 'read_orders', 'write_orders', 'write_customers', 'read_customers', 'write_products', 'read_products', 'write_draft_orders', 'read_draft_orders')
+6. • Click on Configure Admin API Scopes (Select the following scopes: 'read_orders', 'write_orders', 'write_customers', 'read_customers', 'write_products', 'read_products', 'write_draft_orders', 'read_draft_orders', 'read_checkouts', 'read_inventory', 'read_locations', 'write_merchant_managed_fulfillment_orders', 'write_assigned_fulfillment_orders', 'write_third_party_fulfillment_orders', 'read_content', 'read_online_store_pages')
 7. • Click on Install app
 8. • Copy the Admin Access Token`
 
@@ -37,7 +37,7 @@ var app = goshopify.App{
 	ApiKey:      "",
 	ApiSecret:   "",
 	RedirectUrl: "",
-	Scope:       "write_orders, read_orders, write_customers, read_customers, read_products, write_products, write_draft_orders, read_draft_orders",
+	Scope:       "write_orders, read_orders, write_customers, read_customers, read_products, write_products, write_draft_orders, read_draft_orders, read_checkouts, read_inventory, read_locations, write_merchant_managed_fulfillment_orders, write_assigned_fulfillment_orders, write_third_party_fulfillment_orders, read_content, read_online_store_pages",
 }
 
 var GetShopifyClient = func(shopName string, accessToken string) *goshopify.Client {
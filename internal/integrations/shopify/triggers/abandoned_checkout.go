@@ -0,0 +1,146 @@
+package triggers
+
+import (
+	"context"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type abandonedCheckoutTriggerProps struct {
+	CreatedTime  *time.Time `json:"createdTime"`
+	DelayMinutes int        `json:"delayMinutes"`
+}
+
+type abandonedCheckoutListOptions struct {
+	goshopify.ListOptions
+	Status string `url:"status,omitempty"`
+}
+
+type AbandonedCheckoutTrigger struct{}
+
+func (t *AbandonedCheckoutTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "abandoned_checkout",
+		DisplayName:   "Abandoned Checkout",
+		Description:   "Triggered when a customer leaves a checkout without completing it, for cart recovery emails and follow-ups.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: abandonedCheckoutDocs,
+		SampleOutput: map[string]any{
+			"checkouts": []map[string]any{
+				{
+					"id":                     450789469,
+					"token":                  "2a1ace52255252df566af0faaedfbfa7",
+					"email":                  "customer@example.com",
+					"created_at":             "2024-03-01T10:15:00Z",
+					"total_price":            "398.00",
+					"currency":               "USD",
+					"abandoned_checkout_url": "https://example.myshopify.com/12345/checkouts/2a1ace52255252df566af0faaedfbfa7/recover?key=a1b2",
+				},
+			},
+		},
+	}
+}
+
+func (t *AbandonedCheckoutTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *AbandonedCheckoutTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *AbandonedCheckoutTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("shopify-abandoned-checkout", "Abandoned Checkout")
+
+	form.NumberField("delayMinutes", "Wait (minutes)").
+		Required(false).
+		DefaultValue(60).
+		HelpText("How long a checkout must stay incomplete before it counts as abandoned.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the abandonedCheckoutTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *AbandonedCheckoutTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the abandonedCheckoutTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *AbandonedCheckoutTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the open checkouts that became old enough to count as
+// abandoned since the last run.
+func (t *AbandonedCheckoutTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[abandonedCheckoutTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delay := time.Duration(input.DelayMinutes) * time.Minute
+	if delay < 0 {
+		delay = 0
+	}
+
+	checkouts, err := shared.PollPages(ctx, "abandonedCheckoutPoll", input.CreatedTime, func(from, until time.Time) interface{} {
+		options := &abandonedCheckoutListOptions{
+			ListOptions: goshopify.ListOptions{
+				CreatedAtMax: until.Add(-delay),
+				Limit:        250,
+			},
+			Status: "open",
+		}
+		if !from.IsZero() {
+			options.CreatedAtMin = from.Add(-delay)
+		}
+		return options
+	}, func(options interface{}) ([]goshopify.AbandonedCheckout, *goshopify.Pagination, error) {
+		resource := new(goshopify.AbandonedCheckoutsResource)
+		pagination, err := client.ListWithPagination(context.Background(), "checkouts.json", resource, options)
+		return resource.AbandonedCheckouts, pagination, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	abandoned := make([]goshopify.AbandonedCheckout, 0, len(checkouts))
+	for _, checkout := range checkouts {
+		if checkout.CompletedAt == nil {
+			abandoned = append(abandoned, checkout)
+		}
+	}
+
+	return abandoned, nil
+}
+
+func (t *AbandonedCheckoutTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *AbandonedCheckoutTrigger) SampleData() sdkcore.JSON {
+	return map[string]any{
+		"id":                     450789469,
+		"email":                  "customer@example.com",
+		"created_at":             "2024-03-01T10:15:00Z",
+		"total_price":            "398.00",
+		"abandoned_checkout_url": "https://example.myshopify.com/12345/checkouts/2a1ace52255252df566af0faaedfbfa7/recover?key=a1b2",
+	}
+}
+
+func NewAbandonedCheckoutTrigger() sdk.Trigger {
+	return &AbandonedCheckoutTrigger{}
+}
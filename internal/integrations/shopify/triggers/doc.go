@@ -2,8 +2,23 @@ package triggers
 
 import _ "embed"
 
+//go:embed abandoned_checkout.md
+var abandonedCheckoutDocs string
+
+//go:embed inventory_low.md
+var inventoryLowDocs string
+
 //go:embed new_customer.md
 var newCustomerDocs string
 
 //go:embed new_order.md
 var newOrderDocs string
+
+//go:embed order_fulfilled.md
+var orderFulfilledDocs string
+
+//go:embed order_paid.md
+var orderPaidDocs string
+
+//go:embed product_updated.md
+var productUpdatedDocs string
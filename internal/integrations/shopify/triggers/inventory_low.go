@@ -0,0 +1,193 @@
+package triggers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type inventoryLowTriggerProps struct {
+	Threshold int    `json:"threshold"`
+	Filter    string `json:"filter"`
+}
+
+type lowStockVariant struct {
+	ID                string `json:"id"`
+	Title             string `json:"title"`
+	SKU               string `json:"sku"`
+	InventoryQuantity int    `json:"inventoryQuantity"`
+	Product           struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	} `json:"product"`
+}
+
+const lowStockVariantsQuery = `
+query LowStockVariants($query: String!, $after: String) {
+	productVariants(first: 250, after: $after, query: $query) {
+		nodes {
+			id
+			title
+			sku
+			inventoryQuantity
+			product { id title }
+		}
+		pageInfo { hasNextPage endCursor }
+	}
+}`
+
+// maxLowStockPages bounds a run to 2,500 variants.
+const maxLowStockPages = 10
+
+type InventoryLowTrigger struct{}
+
+func (t *InventoryLowTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "inventory_low",
+		DisplayName:   "Inventory Low",
+		Description:   "Triggered when the stock of a product variant drops to or below a threshold, once per drop.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: inventoryLowDocs,
+		SampleOutput: map[string]any{
+			"variants": []map[string]any{
+				{
+					"variant_id":         808950810,
+					"product_id":         632910392,
+					"product_title":      "IPod Nano - 8GB",
+					"title":              "Pink",
+					"sku":                "IPOD2008PINK",
+					"inventory_quantity": 3,
+					"threshold":          5,
+				},
+			},
+		},
+	}
+}
+
+func (t *InventoryLowTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *InventoryLowTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *InventoryLowTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("shopify-inventory-low", "Inventory Low")
+
+	form.NumberField("threshold", "Threshold").
+		Required(true).
+		DefaultValue(5).
+		HelpText("Trigger when a variant's total available quantity is at or below this number.")
+
+	form.TextField("filter", "Filter").
+		Required(false).
+		Placeholder("vendor:Acme product_status:active").
+		HelpText("An optional Shopify search query to limit which variants are watched.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the inventoryLowTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *InventoryLowTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the inventoryLowTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *InventoryLowTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the variants that dropped to or below the threshold
+// since the last run. A variant fires again only after it was restocked
+// above the threshold.
+func (t *InventoryLowTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[inventoryLowTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateGraphQLClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("inventory_quantity:<=%d", input.Threshold)
+	if filter := strings.TrimSpace(input.Filter); filter != "" {
+		query += " " + filter
+	}
+
+	var variants []lowStockVariant
+	var after interface{}
+	for page := 0; page < maxLowStockPages; page++ {
+		var resp struct {
+			ProductVariants struct {
+				Nodes    []lowStockVariant `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"productVariants"`
+		}
+		vars := map[string]interface{}{"query": query, "after": after}
+		if err := client.GraphQL.Query(context.Background(), lowStockVariantsQuery, vars, &resp); err != nil {
+			return nil, err
+		}
+		variants = append(variants, resp.ProductVariants.Nodes...)
+		if !resp.ProductVariants.PageInfo.HasNextPage {
+			break
+		}
+		after = resp.ProductVariants.PageInfo.EndCursor
+	}
+
+	ids := make([]uint64, 0, len(variants))
+	for _, v := range variants {
+		ids = append(ids, shared.LegacyID(v.ID))
+	}
+	entered := shared.Entered(ctx, fmt.Sprintf("lowStockVariants:%d", input.Threshold), ids)
+
+	low := make([]map[string]interface{}, 0, len(entered))
+	for _, v := range variants {
+		id := shared.LegacyID(v.ID)
+		if !entered[id] {
+			continue
+		}
+		low = append(low, map[string]interface{}{
+			"variant_id":         id,
+			"product_id":         shared.LegacyID(v.Product.ID),
+			"product_title":      v.Product.Title,
+			"title":              v.Title,
+			"sku":                v.SKU,
+			"inventory_quantity": v.InventoryQuantity,
+			"threshold":          input.Threshold,
+		})
+	}
+
+	return low, nil
+}
+
+func (t *InventoryLowTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *InventoryLowTrigger) SampleData() sdkcore.JSON {
+	return map[string]any{
+		"variant_id":         808950810,
+		"product_title":      "IPod Nano - 8GB",
+		"sku":                "IPOD2008PINK",
+		"inventory_quantity": 3,
+		"threshold":          5,
+	}
+}
+
+func NewInventoryLowTrigger() sdk.Trigger {
+	return &InventoryLowTrigger{}
+}
@@ -0,0 +1,132 @@
+package triggers
+
+import (
+	"context"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/extensions/internal/polling"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type orderFulfilledTriggerProps struct {
+	UpdatedTime *time.Time `json:"updatedTime"`
+}
+
+type OrderFulfilledTrigger struct{}
+
+func (t *OrderFulfilledTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "order_fulfilled",
+		DisplayName:   "Order Fulfilled",
+		Description:   "Triggered when every item of an order has been fulfilled.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: orderFulfilledDocs,
+		SampleOutput: map[string]any{
+			"orders": []map[string]any{
+				{
+					"id":                 450789469,
+					"name":               "#1001",
+					"email":              "customer@example.com",
+					"updated_at":         "2024-03-01T10:15:00Z",
+					"financial_status":   "paid",
+					"fulfillment_status": "fulfilled",
+					"total_price":        "398.00",
+				},
+			},
+		},
+	}
+}
+
+func (t *OrderFulfilledTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *OrderFulfilledTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *OrderFulfilledTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("shopify-order-fulfilled", "Order Fulfilled")
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the orderFulfilledTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *OrderFulfilledTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the orderFulfilledTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *OrderFulfilledTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the fulfilled orders updated since the last run that have not
+// been returned before.
+func (t *OrderFulfilledTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[orderFulfilledTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := shared.PollPages(ctx, "orderFulfilledPoll", input.UpdatedTime, func(from, _ time.Time) interface{} {
+		return &goshopify.OrderListOptions{
+			ListOptions: goshopify.ListOptions{
+				UpdatedAtMin: from,
+				Limit:        250,
+			},
+			Status:            goshopify.OrderStatusAny,
+			FulfillmentStatus: goshopify.OrderFulfillmentStatusShipped,
+		}
+	}, func(options interface{}) ([]goshopify.Order, *goshopify.Pagination, error) {
+		return client.Order.ListWithPagination(context.Background(), options)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.Id)
+	}
+	fresh := polling.Unseen(ctx, "orderFulfilledOrders", ids)
+
+	matched := make([]goshopify.Order, 0, len(fresh))
+	for _, order := range orders {
+		if fresh[order.Id] {
+			matched = append(matched, order)
+		}
+	}
+
+	return matched, nil
+}
+
+func (t *OrderFulfilledTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *OrderFulfilledTrigger) SampleData() sdkcore.JSON {
+	return map[string]any{
+		"id":                 450789469,
+		"name":               "#1001",
+		"email":              "customer@example.com",
+		"financial_status":   "paid",
+		"fulfillment_status": "fulfilled",
+		"total_price":        "398.00",
+	}
+}
+
+func NewOrderFulfilledTrigger() sdk.Trigger {
+	return &OrderFulfilledTrigger{}
+}
@@ -0,0 +1,113 @@
+package triggers
+
+import (
+	"context"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/shopify/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type productUpdatedTriggerProps struct {
+	UpdatedTime *time.Time `json:"updatedTime"`
+}
+
+type ProductUpdatedTrigger struct{}
+
+func (t *ProductUpdatedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "product_updated",
+		DisplayName:   "Product Updated",
+		Description:   "Triggered when a product or one of its variants is created or changed in your Shopify store.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: productUpdatedDocs,
+		SampleOutput: map[string]any{
+			"products": []map[string]any{
+				{
+					"id":           632910392,
+					"title":        "IPod Nano - 8GB",
+					"handle":       "ipod-nano",
+					"status":       "active",
+					"vendor":       "Apple",
+					"product_type": "Cult Products",
+					"updated_at":   "2024-03-01T10:15:00Z",
+				},
+			},
+		},
+	}
+}
+
+func (t *ProductUpdatedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *ProductUpdatedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *ProductUpdatedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("shopify-product-updated", "Product Updated")
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the productUpdatedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *ProductUpdatedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the productUpdatedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *ProductUpdatedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the products updated since the last run.
+func (t *ProductUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[productUpdatedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.CreateClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := shared.PollPages(ctx, "productUpdatedPoll", input.UpdatedTime, func(from, _ time.Time) interface{} {
+		return &goshopify.ProductListOptions{
+			ListOptions: goshopify.ListOptions{
+				UpdatedAtMin: from,
+				Limit:        250,
+			},
+		}
+	}, func(options interface{}) ([]goshopify.Product, *goshopify.Pagination, error) {
+		return client.Product.ListWithPagination(context.Background(), options)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (t *ProductUpdatedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *ProductUpdatedTrigger) SampleData() sdkcore.JSON {
+	return map[string]any{
+		"id":         632910392,
+		"title":      "IPod Nano - 8GB",
+		"status":     "active",
+		"updated_at": "2024-03-01T10:15:00Z",
+	}
+}
+
+func NewProductUpdatedTrigger() sdk.Trigger {
+	return &ProductUpdatedTrigger{}
+}
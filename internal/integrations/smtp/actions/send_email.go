@@ -0,0 +1,79 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/integrations/smtp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type SendEmailAction struct{}
+
+func (a *SendEmailAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "send_email",
+		DisplayName:   "Send Email",
+		Description:   "Send an email through your SMTP server, with HTML and text bodies, attachments, CC, BCC and custom headers.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: sendEmailDocs,
+		SampleOutput: map[string]any{
+			"provider":   "smtp",
+			"message_id": "<3f9c1d2e8a7b4c5d@example.com>",
+			"accepted":   []string{"jane.doe@example.com"},
+			"sent":       true,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *SendEmailAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("send_email", "Send Email")
+
+	email.RegisterSenderProps(form, false)
+	email.RegisterMessageProps(form)
+	email.RegisterTemplateProps(form, false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *SendEmailAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	sender, defaultFrom, err := shared.SenderFromAuth(authCtx.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := email.MessageFrom(ctx.Context(), ctx.Files(), ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+	if msg.From.Email == "" {
+		msg.From.Email = defaultFrom.Email
+		if msg.From.Name == "" {
+			msg.From.Name = defaultFrom.Name
+		}
+	}
+
+	result, err := sender.Send(ctx.Context(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Output(), nil
+}
+
+func (a *SendEmailAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewSendEmailAction() sdk.Action {
+	return &SendEmailAction{}
+}
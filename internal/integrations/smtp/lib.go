@@ -0,0 +1,45 @@
+package smtp
+
+import (
+	_ "embed"
+
+	"github.com/wakflo/extensions/internal/integrations/smtp/actions"
+	"github.com/wakflo/extensions/internal/integrations/smtp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+//go:embed README.md
+var ReadME string
+
+//go:embed flo.toml
+var Flow string
+
+var Integration = sdk.Register(NewSMTP())
+
+type SMTP struct{}
+
+func (n *SMTP) Metadata() sdk.IntegrationMetadata {
+	return sdk.LoadMetadataFromFlo(Flow, ReadME)
+}
+
+func (n *SMTP) Auth() *core.AuthMetadata {
+	return &core.AuthMetadata{
+		Required: true,
+		Schema:   shared.SharedAuth,
+	}
+}
+
+func (n *SMTP) Triggers() []sdk.Trigger {
+	return []sdk.Trigger{}
+}
+
+func (n *SMTP) Actions() []sdk.Action {
+	return []sdk.Action{
+		actions.NewSendEmailAction(),
+	}
+}
+
+func NewSMTP() sdk.Integration {
+	return &SMTP{}
+}
@@ -0,0 +1,93 @@
+package shared
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+)
+
+var (
+	form = smartform.NewAuthForm("smtp-auth", "SMTP Server", smartform.AuthStrategyCustom)
+
+	_ = form.TextField("host", "Host").
+		Required(true).
+		Placeholder("smtp.example.com").
+		HelpText("The SMTP server of your mail provider, such as smtp.gmail.com or smtp.office365.com.")
+
+	_ = form.TextField("port", "Port").
+		Required(false).
+		Placeholder("587").
+		HelpText("Defaults to 587 for STARTTLS, 465 for TLS and 25 without encryption.")
+
+	_ = form.SelectField("security", "Security").
+		Required(false).
+		DefaultValue(email.SecuritySTARTTLS).
+		HelpText("How the connection is encrypted. Servers that don't offer STARTTLS are refused unless encryption is off.").
+		AddOptions([]*smartform.Option{
+			{Value: email.SecuritySTARTTLS, Label: "STARTTLS (usually port 587)"},
+			{Value: email.SecurityTLS, Label: "TLS (usually port 465)"},
+			{Value: email.SecurityNone, Label: "None (trusted relays only)"},
+		}...)
+
+	_ = form.TextField("username", "Username").
+		Required(false).
+		HelpText("Leave empty for relays that don't authenticate.")
+
+	_ = form.TextField("password", "Password").
+		Required(false).
+		HelpText("The password or an app password, as providers with two-step verification require.")
+
+	_ = form.TextField("from_email", "Default From Email").
+		Required(false).
+		HelpText("The sender of emails that don't set one. Defaults to the username when it is an email address.")
+
+	SharedAuth = form.Build()
+)
+
+// SenderFromAuth returns the sender of the connection and its default
+// sender address.
+func SenderFromAuth(extra map[string]string) (*email.SMTPSender, email.Address, error) {
+	security := strings.ToLower(strings.TrimSpace(extra["security"]))
+	if security == "" {
+		security = email.SecuritySTARTTLS
+	}
+	switch security {
+	case email.SecuritySTARTTLS, email.SecurityTLS, email.SecurityNone:
+	default:
+		return nil, email.Address{}, fmt.Errorf("unknown SMTP security %q", security)
+	}
+
+	port := email.DefaultSMTPPort(security)
+	if raw := strings.TrimSpace(extra["port"]); raw != "" {
+		p, err := strconv.Atoi(raw)
+		if err != nil || p <= 0 || p > 65535 {
+			return nil, email.Address{}, fmt.Errorf("invalid SMTP port %q", raw)
+		}
+		port = p
+	}
+
+	sender := &email.SMTPSender{
+		Host:     strings.TrimSpace(extra["host"]),
+		Port:     port,
+		Username: extra["username"],
+		Password: extra["password"],
+		Security: security,
+	}
+
+	from := strings.TrimSpace(extra["from_email"])
+	if from == "" && strings.Contains(sender.Username, "@") {
+		from = sender.Username
+	}
+	var defaultFrom email.Address
+	if from != "" {
+		a, err := email.ParseAddress(from)
+		if err != nil {
+			return nil, email.Address{}, fmt.Errorf("default from email: %w", err)
+		}
+		defaultFrom = a
+	}
+	return sender, defaultFrom, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createCustomerActionProps struct {
+	shared.CustomerFields
+}
+
+type CreateCustomerAction struct{}
+
+func (a *CreateCustomerAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_customer",
+		DisplayName:   "Create Customer",
+		Description:   "Add a customer to the customer directory.",
+		Type:          core.ActionTypeAction,
+		Documentation: createCustomerDocs,
+		SampleOutput:  customerSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *CreateCustomerAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_customer", "Create Customer")
+
+	shared.RegisterCustomerProps(form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *CreateCustomerAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createCustomerActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customer := input.Body()
+	// Square requires at least one of these.
+	if customer["given_name"] == nil && customer["family_name"] == nil && customer["company_name"] == nil &&
+		customer["email_address"] == nil && customer["phone_number"] == nil {
+		return nil, errors.New("a name, company, email or phone is required")
+	}
+	customer["idempotency_key"] = shared.IdempotencyKey()
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPost, "/v2/customers", customer, &resp); err != nil {
+		return nil, err
+	}
+	return resp["customer"], nil
+}
+
+func (a *CreateCustomerAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewCreateCustomerAction() sdk.Action {
+	return &CreateCustomerAction{}
+}
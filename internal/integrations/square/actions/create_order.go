@@ -0,0 +1,145 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createOrderActionProps struct {
+	LocationID     string                 `json:"location_id"`
+	LineItems      []shared.LineItemInput `json:"line_items"`
+	Currency       string                 `json:"currency"`
+	CustomerID     string                 `json:"customer_id"`
+	ReferenceID    string                 `json:"reference_id"`
+	State          string                 `json:"state"`
+	IdempotencyKey string                 `json:"idempotency_key"`
+}
+
+type CreateOrderAction struct{}
+
+func (a *CreateOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_order",
+		DisplayName:   "Create Order",
+		Description:   "Create an order at a location from catalog variations or custom amount lines.",
+		Type:          core.ActionTypeAction,
+		Documentation: createOrderDocs,
+		SampleOutput:  orderSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *CreateOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_order", "Create Order")
+
+	shared.GetLocationProp("location_id", "Location", "The location of the order. Defaults to the main location.", false, form)
+
+	shared.RegisterLineItemsProp(form, true)
+
+	form.TextField("currency", "Currency").
+		Required(false).
+		Placeholder("USD").
+		HelpText("Currency of the custom prices. Defaults to the currency of the location.")
+
+	form.TextField("customer_id", "Customer ID").
+		Required(false)
+
+	form.TextField("reference_id", "Reference ID").
+		Required(false).
+		HelpText("Your own reference for the order, such as an order number from another system.")
+
+	form.SelectField("state", "State").
+		Required(false).
+		AddOption("OPEN", "Open").
+		AddOption("DRAFT", "Draft").
+		DefaultValue("OPEN")
+
+	form.TextField("idempotency_key", "Idempotency Key").
+		Required(false).
+		HelpText("Repeating a request with the same key returns the first order instead of creating another.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *CreateOrderAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.LineItems) == 0 {
+		return nil, errors.New("at least one line item is required")
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locationID, err := client.Location(input.LocationID)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := client.Currency(locationID, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := shared.LineItems(input.LineItems, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	order := map[string]interface{}{
+		"location_id": locationID,
+		"line_items":  lines,
+	}
+	if input.CustomerID != "" {
+		order["customer_id"] = input.CustomerID
+	}
+	if input.ReferenceID != "" {
+		order["reference_id"] = input.ReferenceID
+	}
+	if input.State != "" {
+		order["state"] = input.State
+	}
+
+	key := input.IdempotencyKey
+	if key == "" {
+		key = shared.IdempotencyKey()
+	}
+
+	var resp map[string]interface{}
+	body := map[string]interface{}{"idempotency_key": key, "order": order}
+	if err := client.Do(http.MethodPost, "/v2/orders", body, &resp); err != nil {
+		return nil, err
+	}
+	return resp["order"], nil
+}
+
+func (a *CreateOrderAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewCreateOrderAction() sdk.Action {
+	return &CreateOrderAction{}
+}
@@ -0,0 +1,167 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createPaymentLinkActionProps struct {
+	LocationID  string                 `json:"location_id"`
+	Name        string                 `json:"name"`
+	Amount      float64                `json:"amount"`
+	Currency    string                 `json:"currency"`
+	LineItems   []shared.LineItemInput `json:"line_items"`
+	RedirectURL string                 `json:"redirect_url"`
+	BuyerEmail  string                 `json:"buyer_email"`
+	Description string                 `json:"description"`
+}
+
+type CreatePaymentLinkAction struct{}
+
+func (a *CreatePaymentLinkAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_payment_link",
+		DisplayName:   "Create Payment Link",
+		Description:   "Create a Square-hosted checkout link for an amount or for a list of line items.",
+		Type:          core.ActionTypeAction,
+		Documentation: createPaymentLinkDocs,
+		SampleOutput: map[string]any{
+			"id":          "JE6RPUFXUKVFKOWB",
+			"version":     1,
+			"order_id":    "CAISENgvlJ6jLWAzERDzjyHVybY",
+			"url":         "https://square.link/u/EXAMPLE",
+			"long_url":    "https://checkout.square.site/merchant/ML7GJYMMBNJSW/order/CAISENgvlJ6jLWAzERDzjyHVybY",
+			"description": "Deposit for order 1042",
+			"created_at":  "2025-03-12T18:20:00Z",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *CreatePaymentLinkAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_payment_link", "Create Payment Link")
+
+	shared.GetLocationProp("location_id", "Location", "The location that takes the payment. Defaults to the main location.", false, form)
+
+	form.TextField("name", "Name").
+		Required(false).
+		HelpText("What the buyer pays for. Required unless line items are set.")
+
+	form.NumberField("amount", "Amount").
+		Required(false).
+		HelpText("Amount in major units. Required unless line items are set.")
+
+	form.TextField("currency", "Currency").
+		Required(false).
+		Placeholder("USD").
+		HelpText("Defaults to the currency of the location.")
+
+	shared.RegisterLineItemsProp(form, false)
+
+	form.TextField("redirect_url", "Redirect URL").
+		Required(false).
+		HelpText("Where the buyer is sent after paying.")
+
+	form.TextField("buyer_email", "Buyer Email").
+		Required(false).
+		HelpText("Fills in the buyer's email on the checkout page.")
+
+	form.TextField("description", "Description").
+		Required(false).
+		HelpText("An internal description, not shown to the buyer.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *CreatePaymentLinkAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createPaymentLinkActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locationID, err := client.Location(input.LocationID)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := client.Currency(locationID, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"idempotency_key": shared.IdempotencyKey(),
+	}
+	if len(input.LineItems) > 0 {
+		lines, err := shared.LineItems(input.LineItems, currency)
+		if err != nil {
+			return nil, err
+		}
+		body["order"] = map[string]interface{}{
+			"location_id": locationID,
+			"line_items":  lines,
+		}
+	} else {
+		if strings.TrimSpace(input.Name) == "" || input.Amount <= 0 {
+			return nil, errors.New("a name and an amount, or line items, are required")
+		}
+		price, err := shared.NewMoney(input.Amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		body["quick_pay"] = map[string]interface{}{
+			"name":        input.Name,
+			"price_money": price,
+			"location_id": locationID,
+		}
+	}
+	if input.RedirectURL != "" {
+		body["checkout_options"] = map[string]interface{}{"redirect_url": input.RedirectURL}
+	}
+	if input.BuyerEmail != "" {
+		body["pre_populated_data"] = map[string]interface{}{"buyer_email": input.BuyerEmail}
+	}
+	if input.Description != "" {
+		body["description"] = input.Description
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPost, "/v2/online-checkout/payment-links", body, &resp); err != nil {
+		return nil, err
+	}
+	return resp["payment_link"], nil
+}
+
+func (a *CreatePaymentLinkAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewCreatePaymentLinkAction() sdk.Action {
+	return &CreatePaymentLinkAction{}
+}
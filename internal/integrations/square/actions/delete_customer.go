@@ -0,0 +1,85 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type deleteCustomerActionProps struct {
+	CustomerID string `json:"customer_id"`
+}
+
+type DeleteCustomerAction struct{}
+
+func (a *DeleteCustomerAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_customer",
+		DisplayName:   "Delete Customer",
+		Description:   "Delete a customer from the customer directory.",
+		Type:          core.ActionTypeAction,
+		Documentation: deleteCustomerDocs,
+		SampleOutput: map[string]any{
+			"deleted":     true,
+			"customer_id": "JDKYHBWT1D4F8MFH63DBMEN8Y4",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *DeleteCustomerAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_customer", "Delete Customer")
+
+	form.TextField("customer_id", "Customer ID").
+		Required(true)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *DeleteCustomerAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[deleteCustomerActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Do(http.MethodDelete, "/v2/customers/"+url.PathEscape(input.CustomerID), nil, nil); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"deleted":     true,
+		"customer_id": input.CustomerID,
+	}, nil
+}
+
+func (a *DeleteCustomerAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewDeleteCustomerAction() sdk.Action {
+	return &DeleteCustomerAction{}
+}
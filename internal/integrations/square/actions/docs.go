@@ -20,3 +20,45 @@ import (
 
 //go:embed get_payment.md
 var getPaymentDocs string
+
+//go:embed create_order.md
+var createOrderDocs string
+
+//go:embed search_orders.md
+var searchOrdersDocs string
+
+//go:embed update_order.md
+var updateOrderDocs string
+
+//go:embed upsert_catalog_item.md
+var upsertCatalogItemDocs string
+
+//go:embed get_catalog_items_by_sku.md
+var getCatalogItemsBySKUDocs string
+
+//go:embed get_inventory_counts.md
+var getInventoryCountsDocs string
+
+//go:embed update_inventory_count.md
+var updateInventoryCountDocs string
+
+//go:embed create_customer.md
+var createCustomerDocs string
+
+//go:embed get_customer.md
+var getCustomerDocs string
+
+//go:embed update_customer.md
+var updateCustomerDocs string
+
+//go:embed delete_customer.md
+var deleteCustomerDocs string
+
+//go:embed search_customers.md
+var searchCustomersDocs string
+
+//go:embed refund_payment.md
+var refundPaymentDocs string
+
+//go:embed create_payment_link.md
+var createPaymentLinkDocs string
@@ -0,0 +1,118 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type getCatalogItemsBySKUActionProps struct {
+	SKUs string `json:"skus"`
+}
+
+type GetCatalogItemsBySKUAction struct{}
+
+func (a *GetCatalogItemsBySKUAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_catalog_items_by_sku",
+		DisplayName:   "Get Catalog Items by SKU",
+		Description:   "Look up many item variations by SKU at once, together with their items.",
+		Type:          core.ActionTypeAction,
+		Documentation: getCatalogItemsBySKUDocs,
+		SampleOutput: map[string]any{
+			"items": []map[string]any{
+				{
+					"sku":       "MUG-001",
+					"variation": variationSample,
+					"item": map[string]any{
+						"type":      "ITEM",
+						"id":        "LRCZKMRPZ5WRTXUAV2C5ZMKX",
+						"item_data": map[string]any{"name": "Coffee Mug"},
+					},
+				},
+			},
+			"missing": []string{"MUG-404"},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *GetCatalogItemsBySKUAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_catalog_items_by_sku", "Get Catalog Items by SKU")
+
+	form.TextareaField("skus", "SKUs").
+		Required(true).
+		HelpText("SKUs separated by commas or new lines.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *GetCatalogItemsBySKUAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getCatalogItemsBySKUActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	skus := shared.SplitList(input.SKUs)
+	if len(skus) == 0 {
+		return nil, errors.New("at least one SKU is required")
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	variations, items, err := client.VariationsBySKU(skus)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]map[string]interface{}, 0, len(variations))
+	seen := map[string]bool{}
+	for _, sku := range skus {
+		variation, ok := variations[sku]
+		if !ok || seen[sku] {
+			continue
+		}
+		seen[sku] = true
+
+		found = append(found, map[string]interface{}{
+			"sku":       sku,
+			"variation": variation,
+			"item":      items[variation.ItemID()],
+		})
+	}
+
+	return map[string]interface{}{
+		"items":   found,
+		"missing": missingSKUs(skus, variations),
+	}, nil
+}
+
+func (a *GetCatalogItemsBySKUAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewGetCatalogItemsBySKUAction() sdk.Action {
+	return &GetCatalogItemsBySKUAction{}
+}
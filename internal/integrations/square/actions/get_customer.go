@@ -0,0 +1,80 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type getCustomerActionProps struct {
+	CustomerID string `json:"customer_id"`
+}
+
+type GetCustomerAction struct{}
+
+func (a *GetCustomerAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_customer",
+		DisplayName:   "Get Customer",
+		Description:   "Retrieve a customer by ID.",
+		Type:          core.ActionTypeAction,
+		Documentation: getCustomerDocs,
+		SampleOutput:  customerSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *GetCustomerAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_customer", "Get Customer")
+
+	form.TextField("customer_id", "Customer ID").
+		Required(true)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *GetCustomerAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getCustomerActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodGet, "/v2/customers/"+url.PathEscape(input.CustomerID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp["customer"], nil
+}
+
+func (a *GetCustomerAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewGetCustomerAction() sdk.Action {
+	return &GetCustomerAction{}
+}
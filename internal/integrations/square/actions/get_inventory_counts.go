@@ -0,0 +1,152 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type getInventoryCountsActionProps struct {
+	SKUs       string `json:"skus"`
+	LocationID string `json:"location_id"`
+}
+
+type GetInventoryCountsAction struct{}
+
+func (a *GetInventoryCountsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_inventory_counts",
+		DisplayName:   "Get Inventory Counts",
+		Description:   "Get the in stock quantities of variations by SKU, per location.",
+		Type:          core.ActionTypeAction,
+		Documentation: getInventoryCountsDocs,
+		SampleOutput: map[string]any{
+			"counts": []map[string]any{
+				{
+					"sku":               "MUG-001",
+					"catalog_object_id": "W62UWFY35CWMYGVWK6TWJDNI",
+					"location_id":       "L88917AVBK2S5",
+					"state":             "IN_STOCK",
+					"quantity":          "40",
+					"calculated_at":     "2025-03-12T18:20:00.000Z",
+				},
+			},
+			"missing": []string{},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *GetInventoryCountsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_inventory_counts", "Get Inventory Counts")
+
+	form.TextareaField("skus", "SKUs").
+		Required(true).
+		HelpText("SKUs separated by commas or new lines.")
+
+	shared.GetLocationProp("location_id", "Location", "Leave empty for the counts at every location.", false, form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *GetInventoryCountsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getInventoryCountsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	skus := shared.SplitList(input.SKUs)
+	if len(skus) == 0 {
+		return nil, errors.New("at least one SKU is required")
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	variations, _, err := client.VariationsBySKU(skus)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(variations))
+	skuByID := map[string]string{}
+	for sku, variation := range variations {
+		ids = append(ids, variation.ID())
+		skuByID[variation.ID()] = sku
+	}
+
+	missing := missingSKUs(skus, variations)
+	if len(ids) == 0 {
+		return map[string]interface{}{"counts": []interface{}{}, "missing": missing}, nil
+	}
+
+	var locationIDs []string
+	if input.LocationID != "" {
+		locationIDs = []string{input.LocationID}
+	}
+	counts, err := client.InventoryCounts(ids, locationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]map[string]interface{}, 0, len(counts))
+	for _, count := range counts {
+		out = append(out, map[string]interface{}{
+			"sku":               skuByID[count.CatalogObjectID],
+			"catalog_object_id": count.CatalogObjectID,
+			"location_id":       count.LocationID,
+			"state":             count.State,
+			"quantity":          count.Quantity,
+			"calculated_at":     count.CalculatedAt,
+		})
+	}
+
+	return map[string]interface{}{
+		"counts":  out,
+		"missing": missing,
+	}, nil
+}
+
+// missingSKUs returns the SKUs no variation was found for.
+func missingSKUs(skus []string, variations map[string]shared.CatalogObject) []string {
+	missing := []string{}
+	seen := map[string]bool{}
+	for _, sku := range skus {
+		if sku == "" || seen[sku] {
+			continue
+		}
+		seen[sku] = true
+		if _, ok := variations[sku]; !ok {
+			missing = append(missing, sku)
+		}
+	}
+	return missing
+}
+
+func (a *GetInventoryCountsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewGetInventoryCountsAction() sdk.Action {
+	return &GetInventoryCountsAction{}
+}
@@ -0,0 +1,142 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type refundPaymentActionProps struct {
+	PaymentID      string   `json:"payment_id"`
+	Amount         *float64 `json:"amount"`
+	Reason         string   `json:"reason"`
+	IdempotencyKey string   `json:"idempotency_key"`
+}
+
+type RefundPaymentAction struct{}
+
+func (a *RefundPaymentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "refund_payment",
+		DisplayName:   "Refund Payment",
+		Description:   "Refund a payment in full or in part.",
+		Type:          core.ActionTypeAction,
+		Documentation: refundPaymentDocs,
+		SampleOutput: map[string]any{
+			"id":           "UNOE3kv2BZwqHlJ830RCt5YCuaB_xVteEWVFkXDvKN1ddidfJWipt8p9whmElKT5mZtJ7wZ",
+			"status":       "PENDING",
+			"payment_id":   "UNOE3kv2BZwqHlJ830RCt5YCuaB",
+			"order_id":     "CAISENgvlJ6jLWAzERDzjyHVybY",
+			"location_id":  "L88917AVBK2S5",
+			"amount_money": map[string]any{"amount": 1000, "currency": "USD"},
+			"reason":       "Damaged in transit",
+			"created_at":   "2025-03-12T18:20:00.000Z",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *RefundPaymentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("refund_payment", "Refund Payment")
+
+	form.TextField("payment_id", "Payment ID").
+		Required(true)
+
+	form.NumberField("amount", "Amount").
+		Required(false).
+		HelpText("Amount to refund in major units. Leave empty to refund what has not been refunded yet.")
+
+	form.TextField("reason", "Reason").
+		Required(false)
+
+	form.TextField("idempotency_key", "Idempotency Key").
+		Required(false).
+		HelpText("Repeating a request with the same key returns the first refund instead of refunding again.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *RefundPaymentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[refundPaymentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var payment struct {
+		Payment struct {
+			TotalMoney    shared.Money `json:"total_money"`
+			RefundedMoney shared.Money `json:"refunded_money"`
+		} `json:"payment"`
+	}
+	if err := client.Do(http.MethodGet, "/v2/payments/"+url.PathEscape(input.PaymentID), nil, &payment); err != nil {
+		return nil, err
+	}
+
+	total := payment.Payment.TotalMoney
+	amount := shared.Money{
+		Amount:   total.Amount - payment.Payment.RefundedMoney.Amount,
+		Currency: total.Currency,
+	}
+	if input.Amount != nil {
+		if amount, err = shared.NewMoney(*input.Amount, total.Currency); err != nil {
+			return nil, err
+		}
+	}
+	if amount.Amount <= 0 {
+		return nil, errors.New("the payment has nothing left to refund")
+	}
+
+	key := input.IdempotencyKey
+	if key == "" {
+		key = shared.IdempotencyKey()
+	}
+
+	body := map[string]interface{}{
+		"idempotency_key": key,
+		"payment_id":      input.PaymentID,
+		"amount_money":    amount,
+	}
+	if input.Reason != "" {
+		body["reason"] = input.Reason
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPost, "/v2/refunds", body, &resp); err != nil {
+		return nil, err
+	}
+	return resp["refund"], nil
+}
+
+func (a *RefundPaymentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewRefundPaymentAction() sdk.Action {
+	return &RefundPaymentAction{}
+}
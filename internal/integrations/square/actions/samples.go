@@ -0,0 +1,74 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+)
+
+var orderStates = []*smartform.Option{
+	{Value: "OPEN", Label: "Open"},
+	{Value: "COMPLETED", Label: "Completed"},
+	{Value: "CANCELED", Label: "Canceled"},
+	{Value: "DRAFT", Label: "Draft"},
+}
+
+var orderSample = map[string]any{
+	"id":           "CAISENgvlJ6jLWAzERDzjyHVybY",
+	"location_id":  "L88917AVBK2S5",
+	"reference_id": "1042",
+	"state":        "OPEN",
+	"version":      1,
+	"customer_id":  "JDKYHBWT1D4F8MFH63DBMEN8Y4",
+	"line_items": []map[string]any{
+		{
+			"uid":               "8uSwfzvUImn3IRrvciqlXC",
+			"name":              "Coffee Mug",
+			"quantity":          "2",
+			"catalog_object_id": "W62UWFY35CWMYGVWK6TWJDNI",
+			"base_price_money":  map[string]any{"amount": 1250, "currency": "USD"},
+			"total_money":       map[string]any{"amount": 2500, "currency": "USD"},
+		},
+	},
+	"total_money":     map[string]any{"amount": 2500, "currency": "USD"},
+	"total_tax_money": map[string]any{"amount": 0, "currency": "USD"},
+	"created_at":      "2025-03-12T18:20:00.000Z",
+	"updated_at":      "2025-03-12T18:20:00.000Z",
+}
+
+var customerSample = map[string]any{
+	"id":            "JDKYHBWT1D4F8MFH63DBMEN8Y4",
+	"given_name":    "Amelia",
+	"family_name":   "Earhart",
+	"email_address": "amelia.earhart@example.com",
+	"phone_number":  "+1-212-555-4240",
+	"reference_id":  "YOUR_REFERENCE_ID",
+	"version":       0,
+	"created_at":    "2025-03-12T18:20:00.000Z",
+	"updated_at":    "2025-03-12T18:20:00.000Z",
+}
+
+var variationSample = map[string]any{
+	"type":    "ITEM_VARIATION",
+	"id":      "W62UWFY35CWMYGVWK6TWJDNI",
+	"version": 1741803600000,
+	"item_variation_data": map[string]any{
+		"item_id":      "LRCZKMRPZ5WRTXUAV2C5ZMKX",
+		"name":         "Regular",
+		"sku":          "MUG-001",
+		"pricing_type": "FIXED_PRICING",
+		"price_money":  map[string]any{"amount": 1250, "currency": "USD"},
+	},
+}
@@ -0,0 +1,143 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type searchCustomersActionProps struct {
+	Email       string `json:"email"`
+	Phone       string `json:"phone"`
+	ReferenceID string `json:"reference_id"`
+	Limit       int    `json:"limit"`
+	Cursor      string `json:"cursor"`
+}
+
+type SearchCustomersAction struct{}
+
+func (a *SearchCustomersAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "search_customers",
+		DisplayName:   "Search Customers",
+		Description:   "Find customers by email, phone or reference ID, or list them when no filter is set.",
+		Type:          core.ActionTypeAction,
+		Documentation: searchCustomersDocs,
+		SampleOutput: map[string]any{
+			"found":     true,
+			"customer":  customerSample,
+			"customers": []map[string]any{customerSample},
+			"cursor":    "",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *SearchCustomersAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("search_customers", "Search Customers")
+
+	form.TextField("email", "Email").
+		Required(false)
+
+	form.TextField("phone", "Phone").
+		Required(false)
+
+	form.TextField("reference_id", "Reference ID").
+		Required(false)
+
+	form.NumberField("limit", "Limit").
+		Required(false).
+		DefaultValue(100).
+		HelpText("Maximum number of customers to return, up to 100.")
+
+	form.TextField("cursor", "Cursor").
+		Required(false).
+		HelpText("The cursor of a previous search, to get its next page.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *SearchCustomersAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[searchCustomersActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := map[string]interface{}{}
+	exact := func(key, value string) {
+		if value = strings.TrimSpace(value); value != "" {
+			filter[key] = map[string]interface{}{"exact": value}
+		}
+	}
+	exact("email_address", input.Email)
+	exact("phone_number", input.Phone)
+	exact("reference_id", input.ReferenceID)
+
+	limit := input.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	body := map[string]interface{}{"limit": limit}
+	if len(filter) > 0 {
+		body["query"] = map[string]interface{}{"filter": filter}
+	}
+	if input.Cursor != "" {
+		body["cursor"] = input.Cursor
+	}
+
+	var resp struct {
+		Customers []map[string]interface{} `json:"customers"`
+		Cursor    string                   `json:"cursor"`
+	}
+	if err := client.Do(http.MethodPost, "/v2/customers/search", body, &resp); err != nil {
+		return nil, err
+	}
+
+	var first map[string]interface{}
+	if len(resp.Customers) > 0 {
+		first = resp.Customers[0]
+	} else {
+		resp.Customers = []map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"found":     first != nil,
+		"customer":  first,
+		"customers": resp.Customers,
+		"cursor":    resp.Cursor,
+	}, nil
+}
+
+func (a *SearchCustomersAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewSearchCustomersAction() sdk.Action {
+	return &SearchCustomersAction{}
+}
@@ -0,0 +1,167 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// maxSearchLocations is how many locations one order search takes.
+const maxSearchLocations = 10
+
+type searchOrdersActionProps struct {
+	LocationID   string     `json:"location_id"`
+	States       []string   `json:"states"`
+	CustomerID   string     `json:"customer_id"`
+	UpdatedAfter *time.Time `json:"updated_after"`
+	Limit        int        `json:"limit"`
+	Cursor       string     `json:"cursor"`
+}
+
+type SearchOrdersAction struct{}
+
+func (a *SearchOrdersAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "search_orders",
+		DisplayName:   "Search Orders",
+		Description:   "Search the orders of one or all locations by state, customer and update date, newest first.",
+		Type:          core.ActionTypeAction,
+		Documentation: searchOrdersDocs,
+		SampleOutput: map[string]any{
+			"orders": []map[string]any{orderSample},
+			"cursor": "",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *SearchOrdersAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("search_orders", "Search Orders")
+
+	shared.GetLocationProp("location_id", "Location", "Leave empty to search all locations.", false, form)
+
+	form.MultiSelectField("states", "States").
+		Required(false).
+		AddOptions(orderStates...).
+		HelpText("Leave empty for orders in any state.")
+
+	form.TextField("customer_id", "Customer ID").
+		Required(false)
+
+	form.DateField("updated_after", "Updated After").
+		Required(false)
+
+	form.NumberField("limit", "Limit").
+		Required(false).
+		DefaultValue(100).
+		HelpText("Maximum number of orders to return, up to 1000.")
+
+	form.TextField("cursor", "Cursor").
+		Required(false).
+		HelpText("The cursor of a previous search, to get its next page.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *SearchOrdersAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[searchOrdersActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locationIDs := []string{input.LocationID}
+	if input.LocationID == "" {
+		locations, err := client.Locations()
+		if err != nil {
+			return nil, err
+		}
+		locationIDs = locationIDs[:0]
+		for _, location := range locations {
+			locationIDs = append(locationIDs, location.ID)
+		}
+		if len(locationIDs) > maxSearchLocations {
+			return nil, fmt.Errorf("square searches at most %d locations at once, select a location", maxSearchLocations)
+		}
+	}
+
+	filter := map[string]interface{}{}
+	if len(input.States) > 0 {
+		filter["state_filter"] = map[string]interface{}{"states": input.States}
+	}
+	if input.CustomerID != "" {
+		filter["customer_filter"] = map[string]interface{}{"customer_ids": []string{input.CustomerID}}
+	}
+	if input.UpdatedAfter != nil {
+		filter["date_time_filter"] = map[string]interface{}{
+			"updated_at": map[string]interface{}{"start_at": input.UpdatedAfter.UTC().Format(time.RFC3339)},
+		}
+	}
+
+	limit := input.Limit
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+
+	body := map[string]interface{}{
+		"location_ids": locationIDs,
+		"limit":        limit,
+		"query": map[string]interface{}{
+			"filter": filter,
+			// The sort field has to match the date filter.
+			"sort": map[string]interface{}{"sort_field": "UPDATED_AT", "sort_order": "DESC"},
+		},
+	}
+	if input.Cursor != "" {
+		body["cursor"] = input.Cursor
+	}
+
+	var resp struct {
+		Orders []map[string]interface{} `json:"orders"`
+		Cursor string                   `json:"cursor"`
+	}
+	if err := client.Do(http.MethodPost, "/v2/orders/search", body, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Orders == nil {
+		resp.Orders = []map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"orders": resp.Orders,
+		"cursor": resp.Cursor,
+	}, nil
+}
+
+func (a *SearchOrdersAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewSearchOrdersAction() sdk.Action {
+	return &SearchOrdersAction{}
+}
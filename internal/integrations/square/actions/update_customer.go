@@ -0,0 +1,89 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateCustomerActionProps struct {
+	CustomerID string `json:"customer_id"`
+	shared.CustomerFields
+}
+
+type UpdateCustomerAction struct{}
+
+func (a *UpdateCustomerAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_customer",
+		DisplayName:   "Update Customer",
+		Description:   "Update the details of a customer. Fields left empty keep their current value.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateCustomerDocs,
+		SampleOutput:  customerSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *UpdateCustomerAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_customer", "Update Customer")
+
+	form.TextField("customer_id", "Customer ID").
+		Required(true)
+
+	shared.RegisterCustomerProps(form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *UpdateCustomerAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateCustomerActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customer := input.Body()
+	if len(customer) == 0 {
+		return nil, errors.New("nothing to update")
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPut, "/v2/customers/"+url.PathEscape(input.CustomerID), customer, &resp); err != nil {
+		return nil, err
+	}
+	return resp["customer"], nil
+}
+
+func (a *UpdateCustomerAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewUpdateCustomerAction() sdk.Action {
+	return &UpdateCustomerAction{}
+}
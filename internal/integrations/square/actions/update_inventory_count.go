@@ -0,0 +1,144 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateInventoryCountActionProps struct {
+	SKU        string  `json:"sku"`
+	LocationID string  `json:"location_id"`
+	Mode       string  `json:"mode"`
+	Quantity   float64 `json:"quantity"`
+}
+
+type UpdateInventoryCountAction struct{}
+
+func (a *UpdateInventoryCountAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_inventory_count",
+		DisplayName:   "Update Inventory Count",
+		Description:   "Set the in stock quantity of a variation at a location, or adjust it by an amount.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateInventoryCountDocs,
+		SampleOutput: map[string]any{
+			"sku":               "MUG-001",
+			"catalog_object_id": "W62UWFY35CWMYGVWK6TWJDNI",
+			"counts": []map[string]any{
+				{
+					"catalog_object_id": "W62UWFY35CWMYGVWK6TWJDNI",
+					"location_id":       "L88917AVBK2S5",
+					"state":             "IN_STOCK",
+					"quantity":          "35",
+				},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *UpdateInventoryCountAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_inventory_count", "Update Inventory Count")
+
+	form.TextField("sku", "SKU").
+		Required(true)
+
+	shared.GetLocationProp("location_id", "Location", "Defaults to the main location.", false, form)
+
+	form.SelectField("mode", "Mode").
+		Required(true).
+		AddOption("set", "Set the quantity").
+		AddOption("adjust", "Adjust by the quantity").
+		DefaultValue("set").
+		HelpText("Adjusting adds received stock, or writes off stock when the quantity is negative.")
+
+	form.NumberField("quantity", "Quantity").
+		Required(true)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *UpdateInventoryCountAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateInventoryCountActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	sku := strings.TrimSpace(input.SKU)
+	if sku == "" {
+		return nil, errors.New("sku is required")
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locationID, err := client.Location(input.LocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	variations, _, err := client.VariationsBySKU([]string{sku})
+	if err != nil {
+		return nil, err
+	}
+	variation, ok := variations[sku]
+	if !ok {
+		return nil, fmt.Errorf("no catalog variation has the SKU %q", sku)
+	}
+
+	var change map[string]interface{}
+	switch input.Mode {
+	case "adjust":
+		if input.Quantity == 0 {
+			return nil, errors.New("the adjustment can't be zero")
+		}
+		change = shared.AdjustInventory(variation.ID(), locationID, input.Quantity)
+	default:
+		if input.Quantity < 0 {
+			return nil, errors.New("the quantity can't be negative")
+		}
+		change = shared.SetInventory(variation.ID(), locationID, input.Quantity)
+	}
+
+	counts, err := client.ApplyInventoryChanges([]map[string]interface{}{change})
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"sku":               sku,
+		"catalog_object_id": variation.ID(),
+		"counts":            counts,
+	}, nil
+}
+
+func (a *UpdateInventoryCountAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewUpdateInventoryCountAction() sdk.Action {
+	return &UpdateInventoryCountAction{}
+}
@@ -0,0 +1,148 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateOrderActionProps struct {
+	OrderID     string                 `json:"order_id"`
+	State       string                 `json:"state"`
+	ReferenceID string                 `json:"reference_id"`
+	TicketName  string                 `json:"ticket_name"`
+	LineItems   []shared.LineItemInput `json:"line_items"`
+}
+
+type UpdateOrderAction struct{}
+
+func (a *UpdateOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_order",
+		DisplayName:   "Update Order",
+		Description:   "Change the state or reference of an open order, or add line items to it.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateOrderDocs,
+		SampleOutput:  orderSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *UpdateOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_order", "Update Order")
+
+	form.TextField("order_id", "Order ID").
+		Required(true)
+
+	form.SelectField("state", "State").
+		Required(false).
+		AddOptions(orderStates...).
+		HelpText("Leave empty to keep the current state.")
+
+	form.TextField("reference_id", "Reference ID").
+		Required(false)
+
+	form.TextField("ticket_name", "Ticket Name").
+		Required(false).
+		HelpText("The name shown for the order on the point of sale.")
+
+	shared.RegisterLineItemsProp(form, false)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *UpdateOrderAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Updates must name the version they change, so concurrent edits
+	// are rejected instead of overwritten.
+	var current struct {
+		Order struct {
+			LocationID string `json:"location_id"`
+			Version    int    `json:"version"`
+			State      string `json:"state"`
+		} `json:"order"`
+	}
+	path := "/v2/orders/" + url.PathEscape(input.OrderID)
+	if err := client.Do(http.MethodGet, path, nil, &current); err != nil {
+		return nil, err
+	}
+
+	order := map[string]interface{}{
+		"location_id": current.Order.LocationID,
+		"version":     current.Order.Version,
+	}
+	if input.State != "" {
+		order["state"] = input.State
+	}
+	if input.ReferenceID != "" {
+		order["reference_id"] = input.ReferenceID
+	}
+	if input.TicketName != "" {
+		order["ticket_name"] = input.TicketName
+	}
+	if len(input.LineItems) > 0 {
+		if current.Order.State != "OPEN" && current.Order.State != "DRAFT" {
+			return nil, errors.New("line items can only be added to open or draft orders")
+		}
+		currency, err := client.Currency(current.Order.LocationID, "")
+		if err != nil {
+			return nil, err
+		}
+		lines, err := shared.LineItems(input.LineItems, currency)
+		if err != nil {
+			return nil, err
+		}
+		order["line_items"] = lines
+	}
+	if len(order) == 2 {
+		return nil, errors.New("nothing to update")
+	}
+
+	var resp map[string]interface{}
+	body := map[string]interface{}{
+		"order":           order,
+		"idempotency_key": shared.IdempotencyKey(),
+	}
+	if err := client.Do(http.MethodPut, path, body, &resp); err != nil {
+		return nil, err
+	}
+	return resp["order"], nil
+}
+
+func (a *UpdateOrderAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewUpdateOrderAction() sdk.Action {
+	return &UpdateOrderAction{}
+}
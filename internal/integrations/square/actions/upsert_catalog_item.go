@@ -0,0 +1,235 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type upsertCatalogItemActionProps struct {
+	SKU            string   `json:"sku"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	VariationName  string   `json:"variation_name"`
+	Price          *float64 `json:"price"`
+	Currency       string   `json:"currency"`
+	TrackInventory bool     `json:"track_inventory"`
+}
+
+type UpsertCatalogItemAction struct{}
+
+func (a *UpsertCatalogItemAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_catalog_item",
+		DisplayName:   "Create or Update Catalog Item",
+		Description:   "Create an item with one variation, or update the item whose variation has the same SKU.",
+		Type:          core.ActionTypeAction,
+		Documentation: upsertCatalogItemDocs,
+		SampleOutput: map[string]any{
+			"created": false,
+			"item": map[string]any{
+				"type":    "ITEM",
+				"id":      "LRCZKMRPZ5WRTXUAV2C5ZMKX",
+				"version": 1741803600000,
+				"item_data": map[string]any{
+					"name":       "Coffee Mug",
+					"variations": []map[string]any{variationSample},
+				},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *UpsertCatalogItemAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_catalog_item", "Create or Update Catalog Item")
+
+	form.TextField("sku", "SKU").
+		Required(true).
+		HelpText("The SKU of the variation. An existing variation with this SKU is updated.")
+
+	form.TextField("name", "Item Name").
+		Required(false).
+		HelpText("Required when the item is created.")
+
+	form.TextField("description", "Description").
+		Required(false)
+
+	form.TextField("variation_name", "Variation Name").
+		Required(false).
+		Placeholder("Regular")
+
+	form.NumberField("price", "Price").
+		Required(false).
+		HelpText("Price in major units. Leave empty for a variable price on new items, or to keep the current price.")
+
+	form.TextField("currency", "Currency").
+		Required(false).
+		Placeholder("USD").
+		HelpText("Defaults to the currency of the main location.")
+
+	form.CheckboxField("track_inventory", "Track Inventory").
+		Required(false).
+		DefaultValue(true)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *UpsertCatalogItemAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[upsertCatalogItemActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	sku := strings.TrimSpace(input.SKU)
+	if sku == "" {
+		return nil, errors.New("sku is required")
+	}
+
+	client, err := shared.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var price *shared.Money
+	if input.Price != nil {
+		currency, err := client.Currency("", input.Currency)
+		if err != nil {
+			return nil, err
+		}
+		money, err := shared.NewMoney(*input.Price, currency)
+		if err != nil {
+			return nil, err
+		}
+		price = &money
+	}
+
+	variations, items, err := client.VariationsBySKU([]string{sku})
+	if err != nil {
+		return nil, err
+	}
+
+	var item map[string]interface{}
+	created := false
+	if existing, ok := variations[sku]; ok && items[existing.ItemID()] != nil {
+		item = items[existing.ItemID()]
+		updateItem(item, existing.ID(), input, price)
+	} else {
+		if strings.TrimSpace(input.Name) == "" {
+			return nil, errors.New("name is required to create an item")
+		}
+		item = newItem(sku, input, price)
+		created = true
+	}
+
+	var resp struct {
+		CatalogObject map[string]interface{} `json:"catalog_object"`
+	}
+	body := map[string]interface{}{
+		"idempotency_key": shared.IdempotencyKey(),
+		"object":          item,
+	}
+	if err := client.Do(http.MethodPost, "/v2/catalog/object", body, &resp); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"created": created,
+		"item":    resp.CatalogObject,
+	}, nil
+}
+
+// newItem returns an item with one variation. The # IDs are temporary
+// and replaced by Square.
+func newItem(sku string, input *upsertCatalogItemActionProps, price *shared.Money) map[string]interface{} {
+	variationName := input.VariationName
+	if variationName == "" {
+		variationName = "Regular"
+	}
+
+	variation := map[string]interface{}{
+		"item_id":         "#item",
+		"name":            variationName,
+		"sku":             sku,
+		"pricing_type":    "VARIABLE_PRICING",
+		"track_inventory": input.TrackInventory,
+	}
+	if price != nil {
+		variation["pricing_type"] = "FIXED_PRICING"
+		variation["price_money"] = price
+	}
+
+	itemData := map[string]interface{}{
+		"name": input.Name,
+		"variations": []map[string]interface{}{
+			{"type": "ITEM_VARIATION", "id": "#variation", "item_variation_data": variation},
+		},
+	}
+	if input.Description != "" {
+		itemData["description"] = input.Description
+	}
+	return map[string]interface{}{"type": "ITEM", "id": "#item", "item_data": itemData}
+}
+
+// updateItem changes the fields that were set on an item as read from
+// the catalog, keeping its versions so Square can detect conflicts.
+func updateItem(item map[string]interface{}, variationID string, input *upsertCatalogItemActionProps, price *shared.Money) {
+	itemData, _ := item["item_data"].(map[string]interface{})
+	if itemData == nil {
+		return
+	}
+	if input.Name != "" {
+		itemData["name"] = input.Name
+	}
+	if input.Description != "" {
+		itemData["description"] = input.Description
+	}
+
+	variations, _ := itemData["variations"].([]interface{})
+	for _, v := range variations {
+		variation, _ := v.(map[string]interface{})
+		if variation == nil || variation["id"] != variationID {
+			continue
+		}
+		data, _ := variation["item_variation_data"].(map[string]interface{})
+		if data == nil {
+			continue
+		}
+		if input.VariationName != "" {
+			data["name"] = input.VariationName
+		}
+		if price != nil {
+			data["pricing_type"] = "FIXED_PRICING"
+			data["price_money"] = price
+		}
+		data["track_inventory"] = input.TrackInventory
+	}
+}
+
+func (a *UpsertCatalogItemAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewUpsertCatalogItemAction() sdk.Action {
+	return &UpsertCatalogItemAction{}
+}
@@ -48,12 +48,44 @@ func (n *Square) Auth() *core.AuthMetadata {
 func (n *Square) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewNewPaymentTrigger(),
+
+		triggers.NewPaymentEventTrigger(),
+
+		triggers.NewOrderEventTrigger(),
 	}
 }
 
 func (n *Square) Actions() []sdk.Action {
 	return []sdk.Action{
 		actions.NewGetPaymentsAction(),
+
+		actions.NewCreateOrderAction(),
+
+		actions.NewSearchOrdersAction(),
+
+		actions.NewUpdateOrderAction(),
+
+		actions.NewUpsertCatalogItemAction(),
+
+		actions.NewGetCatalogItemsBySKUAction(),
+
+		actions.NewGetInventoryCountsAction(),
+
+		actions.NewUpdateInventoryCountAction(),
+
+		actions.NewCreateCustomerAction(),
+
+		actions.NewGetCustomerAction(),
+
+		actions.NewUpdateCustomerAction(),
+
+		actions.NewDeleteCustomerAction(),
+
+		actions.NewSearchCustomersAction(),
+
+		actions.NewRefundPaymentAction(),
+
+		actions.NewCreatePaymentLinkAction(),
 	}
 }
 
@@ -0,0 +1,133 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"net/http"
+	"strings"
+)
+
+// maxSKUs is how many SKUs one catalog search takes.
+const maxSKUs = 100
+
+// CatalogObject is an object of the catalog, such as an item or an item
+// variation. Data keeps the type's fields as Square returns them.
+type CatalogObject map[string]interface{}
+
+// ID returns the object's ID.
+func (o CatalogObject) ID() string {
+	id, _ := o["id"].(string)
+	return id
+}
+
+// SKU returns the SKU of an item variation.
+func (o CatalogObject) SKU() string {
+	data, _ := o["item_variation_data"].(map[string]interface{})
+	sku, _ := data["sku"].(string)
+	return sku
+}
+
+// ItemID returns the item an item variation belongs to.
+func (o CatalogObject) ItemID() string {
+	data, _ := o["item_variation_data"].(map[string]interface{})
+	id, _ := data["item_id"].(string)
+	return id
+}
+
+// VariationsBySKU finds the item variations with the given SKUs, along
+// with their items. SKUs that match nothing are left out.
+func (c *Client) VariationsBySKU(skus []string) (map[string]CatalogObject, map[string]CatalogObject, error) {
+	variations := map[string]CatalogObject{}
+	items := map[string]CatalogObject{}
+
+	skus = cleanSKUs(skus)
+	for start := 0; start < len(skus); start += maxSKUs {
+		end := start + maxSKUs
+		if end > len(skus) {
+			end = len(skus)
+		}
+
+		cursor := ""
+		for {
+			body := map[string]interface{}{
+				"object_types":            []string{"ITEM_VARIATION"},
+				"include_related_objects": true,
+				"query": map[string]interface{}{
+					"set_query": map[string]interface{}{
+						"attribute_name":   "sku",
+						"attribute_values": skus[start:end],
+					},
+				},
+			}
+			if cursor != "" {
+				body["cursor"] = cursor
+			}
+
+			var resp struct {
+				Objects        []CatalogObject `json:"objects"`
+				RelatedObjects []CatalogObject `json:"related_objects"`
+				Cursor         string          `json:"cursor"`
+			}
+			if err := c.Do(http.MethodPost, "/v2/catalog/search", body, &resp); err != nil {
+				return nil, nil, err
+			}
+			for _, obj := range resp.Objects {
+				if sku := obj.SKU(); sku != "" {
+					variations[sku] = obj
+				}
+			}
+			for _, obj := range resp.RelatedObjects {
+				if obj["type"] == "ITEM" {
+					items[obj.ID()] = obj
+				}
+			}
+
+			if resp.Cursor == "" {
+				break
+			}
+			cursor = resp.Cursor
+		}
+	}
+	return variations, items, nil
+}
+
+// cleanSKUs trims SKUs and drops empty and repeated ones.
+func cleanSKUs(skus []string) []string {
+	seen := map[string]bool{}
+	out := make([]string, 0, len(skus))
+	for _, sku := range skus {
+		sku = strings.TrimSpace(sku)
+		if sku == "" || seen[sku] {
+			continue
+		}
+		seen[sku] = true
+		out = append(out, sku)
+	}
+	return out
+}
+
+// SplitList splits a comma or newline separated form value, dropping
+// empty entries.
+func SplitList(s string) []string {
+	var out []string
+	for _, v := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == '\n'
+	}) {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,133 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// apiVersion pins the Square-Version header, so responses keep the shape
+// the actions were written against.
+const apiVersion = "2025-01-23"
+
+// Client calls the Square API with the connection's access token.
+type Client struct {
+	token string
+}
+
+// NewClient returns a client for the connection of ctx.
+func NewClient(ctx sdkcontext.BaseContext) (*Client, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	token := authCtx.AccessToken
+	if authCtx.Token != nil && authCtx.Token.AccessToken != "" {
+		token = authCtx.Token.AccessToken
+	}
+	if token == "" {
+		return nil, errors.New("missing authentication token")
+	}
+	return &Client{token: token}, nil
+}
+
+// Do sends a JSON request to an API path such as /v2/orders and decodes
+// the response into out.
+func (c *Client) Do(method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Square-Version", apiVersion)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %v", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return apiError(resp.StatusCode, data)
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// apiError returns the errors Square lists in a failed response.
+func apiError(status int, data []byte) error {
+	var body struct {
+		Errors []struct {
+			Code   string `json:"code"`
+			Detail string `json:"detail"`
+			Field  string `json:"field"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil || len(body.Errors) == 0 {
+		return fmt.Errorf("square request failed with status code %d: %s", status, string(data))
+	}
+
+	messages := make([]string, 0, len(body.Errors))
+	for _, e := range body.Errors {
+		msg := e.Detail
+		if msg == "" {
+			msg = e.Code
+		}
+		if e.Field != "" {
+			msg = e.Field + ": " + msg
+		}
+		messages = append(messages, msg)
+	}
+	return fmt.Errorf("square request failed with status code %d: %s", status, strings.Join(messages, "; "))
+}
+
+// IdempotencyKey returns a new key for a create request. Square replays
+// the first response for a repeated key instead of creating twice.
+func IdempotencyKey() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,113 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+// CustomerFields are the customer details shared by the create and
+// update actions. Empty fields are left out, so an update keeps their
+// values.
+type CustomerFields struct {
+	GivenName    string `json:"given_name"`
+	FamilyName   string `json:"family_name"`
+	CompanyName  string `json:"company_name"`
+	Email        string `json:"email"`
+	Phone        string `json:"phone"`
+	ReferenceID  string `json:"reference_id"`
+	Note         string `json:"note"`
+	AddressLine1 string `json:"address_line1"`
+	AddressLine2 string `json:"address_line2"`
+	City         string `json:"city"`
+	State        string `json:"state"`
+	PostalCode   string `json:"postal_code"`
+	Country      string `json:"country"`
+}
+
+// RegisterCustomerProps adds the customer detail fields to a form.
+func RegisterCustomerProps(form *smartform.FormBuilder) {
+	form.TextField("given_name", "First Name").
+		Required(false)
+
+	form.TextField("family_name", "Last Name").
+		Required(false)
+
+	form.TextField("company_name", "Company Name").
+		Required(false)
+
+	form.TextField("email", "Email").
+		Required(false)
+
+	form.TextField("phone", "Phone").
+		Required(false)
+
+	form.TextField("reference_id", "Reference ID").
+		Required(false).
+		HelpText("Your own reference for the customer, such as an ID from another system.")
+
+	form.TextareaField("note", "Note").
+		Required(false)
+
+	form.TextField("address_line1", "Address Line 1").
+		Required(false)
+
+	form.TextField("address_line2", "Address Line 2").
+		Required(false)
+
+	form.TextField("city", "City").
+		Required(false)
+
+	form.TextField("state", "State or Region").
+		Required(false)
+
+	form.TextField("postal_code", "Postal Code").
+		Required(false)
+
+	form.TextField("country", "Country").
+		Required(false).
+		HelpText("Two-letter ISO country code, such as US.")
+}
+
+// Body returns the customer as Square expects it.
+func (f CustomerFields) Body() map[string]interface{} {
+	customer := map[string]interface{}{}
+	set := func(m map[string]interface{}, key, value string) {
+		if value = strings.TrimSpace(value); value != "" {
+			m[key] = value
+		}
+	}
+	set(customer, "given_name", f.GivenName)
+	set(customer, "family_name", f.FamilyName)
+	set(customer, "company_name", f.CompanyName)
+	set(customer, "email_address", f.Email)
+	set(customer, "phone_number", f.Phone)
+	set(customer, "reference_id", f.ReferenceID)
+	set(customer, "note", f.Note)
+
+	address := map[string]interface{}{}
+	set(address, "address_line_1", f.AddressLine1)
+	set(address, "address_line_2", f.AddressLine2)
+	set(address, "locality", f.City)
+	set(address, "administrative_district_level_1", f.State)
+	set(address, "postal_code", f.PostalCode)
+	set(address, "country", strings.ToUpper(f.Country))
+	if len(address) > 0 {
+		customer["address"] = address
+	}
+	return customer
+}
@@ -0,0 +1,117 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// InventoryCount is the quantity of a variation in one state at one
+// location. Square returns quantities as decimal strings.
+type InventoryCount struct {
+	CatalogObjectID string `json:"catalog_object_id"`
+	LocationID      string `json:"location_id"`
+	State           string `json:"state"`
+	Quantity        string `json:"quantity"`
+	CalculatedAt    string `json:"calculated_at"`
+}
+
+// InventoryCounts returns the in stock counts of the given variations,
+// at the given locations or at all of them.
+func (c *Client) InventoryCounts(variationIDs, locationIDs []string) ([]InventoryCount, error) {
+	counts := []InventoryCount{}
+	cursor := ""
+	for {
+		body := map[string]interface{}{
+			"catalog_object_ids": variationIDs,
+			"states":             []string{"IN_STOCK"},
+		}
+		if len(locationIDs) > 0 {
+			body["location_ids"] = locationIDs
+		}
+		if cursor != "" {
+			body["cursor"] = cursor
+		}
+
+		var resp struct {
+			Counts []InventoryCount `json:"counts"`
+			Cursor string           `json:"cursor"`
+		}
+		if err := c.Do(http.MethodPost, "/v2/inventory/counts/batch-retrieve", body, &resp); err != nil {
+			return nil, err
+		}
+		counts = append(counts, resp.Counts...)
+
+		if resp.Cursor == "" {
+			return counts, nil
+		}
+		cursor = resp.Cursor
+	}
+}
+
+// SetInventory records a physical count of quantity for a variation.
+func SetInventory(variationID, locationID string, quantity float64) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "PHYSICAL_COUNT",
+		"physical_count": map[string]interface{}{
+			"catalog_object_id": variationID,
+			"location_id":       locationID,
+			"state":             "IN_STOCK",
+			"quantity":          decimal.NewFromFloat(quantity).String(),
+			"occurred_at":       time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+// AdjustInventory records stock received, or stock written off when
+// delta is negative.
+func AdjustInventory(variationID, locationID string, delta float64) map[string]interface{} {
+	from, to := "NONE", "IN_STOCK"
+	if delta < 0 {
+		from, to = "IN_STOCK", "WASTE"
+		delta = -delta
+	}
+	return map[string]interface{}{
+		"type": "ADJUSTMENT",
+		"adjustment": map[string]interface{}{
+			"catalog_object_id": variationID,
+			"location_id":       locationID,
+			"from_state":        from,
+			"to_state":          to,
+			"quantity":          decimal.NewFromFloat(delta).String(),
+			"occurred_at":       time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+// ApplyInventoryChanges sends inventory changes and returns the counts
+// they result in.
+func (c *Client) ApplyInventoryChanges(changes []map[string]interface{}) ([]InventoryCount, error) {
+	body := map[string]interface{}{
+		"idempotency_key":         IdempotencyKey(),
+		"changes":                 changes,
+		"ignore_unchanged_counts": true,
+	}
+	var resp struct {
+		Counts []InventoryCount `json:"counts"`
+	}
+	if err := c.Do(http.MethodPost, "/v2/inventory/changes/batch-create", body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Counts, nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/shopspring/decimal"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// Location is a Square business location.
+type Location struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Currency string `json:"currency"`
+}
+
+// Locations lists the locations of the seller.
+func (c *Client) Locations() ([]Location, error) {
+	var resp struct {
+		Locations []Location `json:"locations"`
+	}
+	if err := c.Do(http.MethodGet, "/v2/locations", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Locations, nil
+}
+
+func GetLocationProp(id string, title string, desc string, required bool, form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getLocations := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		tokenSource := ctx.Auth().Token
+		if tokenSource == nil {
+			return nil, errors.New("missing authentication token")
+		}
+		client := &Client{token: tokenSource.AccessToken}
+
+		locations, err := client.Locations()
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]map[string]any, 0, len(locations))
+		for _, location := range locations {
+			if location.Status != "" && location.Status != "ACTIVE" {
+				continue
+			}
+			items = append(items, map[string]any{
+				"value": location.ID,
+				"label": location.Name,
+			})
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField(id, title).
+		Placeholder("Select Location").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getLocations)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText(desc)
+}
+
+// Location returns locationID, or the seller's main location when it is
+// empty.
+func (c *Client) Location(locationID string) (string, error) {
+	if locationID != "" {
+		return locationID, nil
+	}
+	var resp struct {
+		Location Location `json:"location"`
+	}
+	if err := c.Do(http.MethodGet, "/v2/locations/main", nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.Location.ID, nil
+}
+
+// Currency returns currency, or the currency of the location when it is
+// empty.
+func (c *Client) Currency(locationID, currency string) (string, error) {
+	if currency = strings.TrimSpace(currency); currency != "" {
+		return strings.ToUpper(currency), nil
+	}
+	if locationID == "" {
+		locationID = "main"
+	}
+	var resp struct {
+		Location Location `json:"location"`
+	}
+	if err := c.Do(http.MethodGet, "/v2/locations/"+url.PathEscape(locationID), nil, &resp); err != nil {
+		return "", err
+	}
+	if resp.Location.Currency == "" {
+		return "", errors.New("the location has no currency, set one")
+	}
+	return resp.Location.Currency, nil
+}
+
+// zeroDecimal lists the currencies Square counts in whole units.
+var zeroDecimal = map[string]bool{
+	"JPY": true, "KRW": true, "CLP": true, "ISK": true, "VND": true, "XAF": true, "XOF": true,
+}
+
+// Money is an amount in the smallest unit of a currency, as Square
+// expects it.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// NewMoney converts an amount in major units, such as 12.50 dollars, to
+// Square money.
+func NewMoney(amount float64, currency string) (Money, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if len(currency) != 3 {
+		return Money{}, fmt.Errorf("invalid currency %q", currency)
+	}
+	places := int32(2)
+	if zeroDecimal[currency] {
+		places = 0
+	}
+	minor := decimal.NewFromFloat(amount).Shift(places).Round(0)
+	return Money{Amount: minor.IntPart(), Currency: currency}, nil
+}
+
+// RegisterMoneyProps adds an amount and a currency field to a form.
+func RegisterMoneyProps(form *smartform.FormBuilder, required bool, help string) {
+	form.NumberField("amount", "Amount").
+		Required(required).
+		HelpText(help)
+
+	form.TextField("currency", "Currency").
+		Required(false).
+		Placeholder("USD").
+		HelpText("Three-letter ISO currency code. Defaults to the currency of the location.")
+}
+
+// LineItemInput is an order line item entered in a form.
+type LineItemInput struct {
+	Name            string  `json:"name"`
+	Quantity        float64 `json:"quantity"`
+	Amount          float64 `json:"amount"`
+	CatalogObjectID string  `json:"catalog_object_id"`
+	Note            string  `json:"note"`
+}
+
+// RegisterLineItemsProp adds an order line items array to a form.
+func RegisterLineItemsProp(form *smartform.FormBuilder, required bool) {
+	lines := form.ArrayField("line_items", "Line Items")
+	lines.Required(required)
+
+	line := lines.ObjectTemplate("line_item", "")
+
+	line.TextField("name", "Name").
+		Required(false).
+		HelpText("Required for custom amount lines. Catalog lines take the item's name.")
+
+	line.NumberField("quantity", "Quantity").
+		Required(false).
+		DefaultValue(1)
+
+	line.NumberField("amount", "Unit Price").
+		Required(false).
+		HelpText("Price of one unit in major units. Catalog lines take the variation's price when empty.")
+
+	line.TextField("catalog_object_id", "Catalog Variation ID").
+		Required(false).
+		HelpText("The ID of an item variation from the catalog.")
+
+	line.TextField("note", "Note").
+		Required(false)
+}
+
+// LineItems converts form line items to Square order line items.
+func LineItems(items []LineItemInput, currency string) ([]map[string]interface{}, error) {
+	lines := make([]map[string]interface{}, 0, len(items))
+	for i, item := range items {
+		quantity := item.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+
+		line := map[string]interface{}{
+			"quantity": decimal.NewFromFloat(quantity).String(),
+		}
+		if item.CatalogObjectID != "" {
+			line["catalog_object_id"] = item.CatalogObjectID
+		} else if strings.TrimSpace(item.Name) == "" {
+			return nil, fmt.Errorf("line item %d needs a name or a catalog variation", i+1)
+		} else {
+			line["name"] = item.Name
+		}
+		if item.Amount != 0 || item.CatalogObjectID == "" {
+			price, err := NewMoney(item.Amount, currency)
+			if err != nil {
+				return nil, err
+			}
+			line["base_price_money"] = price
+		}
+		if item.Note != "" {
+			line["note"] = item.Note
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
@@ -37,9 +37,12 @@ var (
 			"CUSTOMERS_WRITE",
 			"ITEMS_READ",
 			"ITEMS_WRITE",
+			"INVENTORY_READ",
+			"INVENTORY_WRITE",
 			"ORDERS_READ",
 			"ORDERS_WRITE",
 			"PAYMENTS_READ",
+			"PAYMENTS_WRITE",
 			"INVOICES_READ",
 			"APPOINTMENTS_READ",
 			"APPOINTMENTS_WRITE",
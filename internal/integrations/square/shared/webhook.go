@@ -0,0 +1,71 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/wakflo/extensions/internal/webhook"
+)
+
+// SignatureHeader is the header Square signs webhook notifications with.
+const SignatureHeader = "x-square-hmacsha256-signature"
+
+// Event is a webhook notification.
+type Event struct {
+	MerchantID string `json:"merchant_id"`
+	Type       string `json:"type"`
+	EventID    string `json:"event_id"`
+	CreatedAt  string `json:"created_at"`
+	Data       struct {
+		Type   string                 `json:"type"`
+		ID     string                 `json:"id"`
+		Object map[string]interface{} `json:"object"`
+	} `json:"data"`
+}
+
+// VerifySignature checks the base64 HMAC-SHA256 signature Square
+// computes with the subscription's signature key over the notification
+// URL followed by the raw body.
+func VerifySignature(signatureKey, notificationURL string, body []byte, signature string) error {
+	if signature == "" {
+		return errors.New("missing " + SignatureHeader + " header")
+	}
+	mac := hmac.New(sha256.New, []byte(signatureKey))
+	mac.Write([]byte(notificationURL))
+	mac.Write(body)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errors.New("invalid Square webhook signature")
+	}
+	return nil
+}
+
+// VerifiedEvent verifies a delivery and decodes its event.
+func VerifiedEvent(signatureKey, notificationURL string, d webhook.Delivery) (*Event, error) {
+	if err := VerifySignature(signatureKey, notificationURL, d.Body, d.Header(SignatureHeader)); err != nil {
+		return nil, err
+	}
+	var event Event
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %v", err)
+	}
+	return &event, nil
+}
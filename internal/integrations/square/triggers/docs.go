@@ -20,3 +20,9 @@ import (
 
 //go:embed new_payment.md
 var newPaymentDocs string
+
+//go:embed payment_event.md
+var paymentEventDocs string
+
+//go:embed order_event.md
+var orderEventDocs string
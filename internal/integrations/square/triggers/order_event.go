@@ -0,0 +1,135 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type orderEventTriggerProps struct {
+	FetchOrder bool `json:"fetch_order"`
+}
+
+type OrderEventTrigger struct {
+	webhookTrigger
+}
+
+func (t *OrderEventTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "order_event",
+		DisplayName:   "Order Event (Instant)",
+		Description:   "Triggered instantly by a Square webhook when an order is created or updated, or its fulfillment changes.",
+		Type:          core.TriggerTypeWebhook,
+		Documentation: orderEventDocs,
+		SampleOutput:  []map[string]any{orderEventSample},
+	}
+}
+
+func (t *OrderEventTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("order-event", "Order Event (Instant)")
+
+	t.registerProps(form)
+
+	form.CheckboxField("fetch_order", "Include Full Order").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Order events only carry the order's ID and state. Turn this on to retrieve the whole order.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (t *OrderEventTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	event, err := t.event(ctx)
+	if err != nil || event == nil {
+		return []map[string]interface{}{}, err
+	}
+
+	input, err := sdk.InputToTypeSafely[orderEventTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{
+		"event_id":    event.EventID,
+		"event_type":  event.Type,
+		"merchant_id": event.MerchantID,
+		"created_at":  event.CreatedAt,
+		"order_id":    event.Data.ID,
+	}
+	// The object is keyed by event, such as order_created.
+	for _, v := range event.Data.Object {
+		result["change"] = v
+	}
+
+	if input.FetchOrder && event.Data.ID != "" {
+		client, err := shared.NewClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var resp map[string]interface{}
+		if err := client.Do(http.MethodGet, "/v2/orders/"+url.PathEscape(event.Data.ID), nil, &resp); err != nil {
+			return nil, err
+		}
+		result["order"] = resp["order"]
+	}
+
+	return []map[string]interface{}{result}, nil
+}
+
+func (t *OrderEventTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return t.criteria()
+}
+
+var orderEventSample = map[string]any{
+	"event_id":    "116038d3-2948-439f-8679-fc86dbf80f69",
+	"event_type":  "order.updated",
+	"merchant_id": "6SSW7HV8K2ST5",
+	"created_at":  "2025-03-12T18:20:00.000Z",
+	"order_id":    "CAISENgvlJ6jLWAzERDzjyHVybY",
+	"change": map[string]any{
+		"order_id":    "CAISENgvlJ6jLWAzERDzjyHVybY",
+		"location_id": "L88917AVBK2S5",
+		"state":       "COMPLETED",
+		"version":     4,
+	},
+	"order": map[string]any{
+		"id":          "CAISENgvlJ6jLWAzERDzjyHVybY",
+		"location_id": "L88917AVBK2S5",
+		"state":       "COMPLETED",
+		"total_money": map[string]any{"amount": 2500, "currency": "USD"},
+	},
+}
+
+func NewOrderEventTrigger() sdk.Trigger {
+	return &OrderEventTrigger{
+		webhookTrigger: webhookTrigger{
+			prefix: "order.",
+			eventTypes: []*smartform.Option{
+				{Value: "order.created", Label: "Order Created"},
+				{Value: "order.updated", Label: "Order Updated"},
+				{Value: "order.fulfillment.updated", Label: "Order Fulfillment Updated"},
+			},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type PaymentEventTrigger struct {
+	webhookTrigger
+}
+
+func (t *PaymentEventTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "payment_event",
+		DisplayName:   "Payment Event (Instant)",
+		Description:   "Triggered instantly by a Square webhook when a payment is created or updated.",
+		Type:          core.TriggerTypeWebhook,
+		Documentation: paymentEventDocs,
+		SampleOutput:  []map[string]any{paymentEventSample},
+	}
+}
+
+func (t *PaymentEventTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("payment-event", "Payment Event (Instant)")
+
+	t.registerProps(form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (t *PaymentEventTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	event, err := t.event(ctx)
+	if err != nil || event == nil {
+		return []map[string]interface{}{}, err
+	}
+
+	return []map[string]interface{}{
+		{
+			"event_id":    event.EventID,
+			"event_type":  event.Type,
+			"merchant_id": event.MerchantID,
+			"created_at":  event.CreatedAt,
+			"payment":     event.Data.Object["payment"],
+		},
+	}, nil
+}
+
+func (t *PaymentEventTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return t.criteria()
+}
+
+var paymentEventSample = map[string]any{
+	"event_id":    "13b867cf-db3d-4b1c-90b6-2f32a9d78124",
+	"event_type":  "payment.updated",
+	"merchant_id": "6SSW7HV8K2ST5",
+	"created_at":  "2025-03-12T18:20:00.000Z",
+	"payment": map[string]any{
+		"id":           "UNOE3kv2BZwqHlJ830RCt5YCuaB",
+		"status":       "COMPLETED",
+		"amount_money": map[string]any{"amount": 2500, "currency": "USD"},
+		"total_money":  map[string]any{"amount": 2500, "currency": "USD"},
+		"order_id":     "CAISENgvlJ6jLWAzERDzjyHVybY",
+		"location_id":  "L88917AVBK2S5",
+		"source_type":  "CARD",
+		"version":      3,
+	},
+}
+
+func NewPaymentEventTrigger() sdk.Trigger {
+	return &PaymentEventTrigger{
+		webhookTrigger: webhookTrigger{
+			prefix: "payment.",
+			eventTypes: []*smartform.Option{
+				{Value: "payment.created", Label: "Payment Created"},
+				{Value: "payment.updated", Label: "Payment Updated"},
+			},
+		},
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/square/shared"
+	"github.com/wakflo/extensions/internal/webhook"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+const notificationURLKey = "notificationUrl"
+
+type webhookTriggerProps struct {
+	SignatureKey    string   `json:"signature_key"`
+	NotificationURL string   `json:"notification_url"`
+	EventTypes      []string `json:"event_types"`
+}
+
+// webhookTrigger verifies the notifications of a webhook subscription
+// and keeps the events of one kind. Square only lets OAuth apps manage
+// subscriptions from the Developer Console, so the subscription and its
+// signature key come from there.
+type webhookTrigger struct {
+	prefix     string
+	eventTypes []*smartform.Option
+}
+
+func (w webhookTrigger) GetType() core.TriggerType {
+	return core.TriggerTypeWebhook
+}
+
+func (w webhookTrigger) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (w webhookTrigger) criteria() core.TriggerCriteria {
+	webhook := core.NewWebhookTriggerCriteria()
+	webhook.Enabled = true
+	webhook.ContentType = "application/json"
+	return core.TriggerCriteria{Webhook: webhook}
+}
+
+func (w webhookTrigger) registerProps(form *smartform.FormBuilder) {
+	form.TextField("signature_key", "Signature Key").
+		Required(true).
+		HelpText("The signature key of the webhook subscription, from the Webhooks page of your app in the Square Developer Console.")
+
+	form.TextField("notification_url", "Notification URL").
+		Required(false).
+		HelpText("The URL exactly as entered in the subscription. Square signs it along with the body. Defaults to this trigger's URL.")
+
+	form.MultiSelectField("event_types", "Event Types").
+		Required(false).
+		AddOptions(w.eventTypes...).
+		HelpText("Leave empty for all of them.")
+}
+
+// Start remembers the trigger's URL, which the signatures cover.
+func (w webhookTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	criteria, err := ctx.TriggerCriteria()
+	if err != nil || criteria == nil || criteria.Webhook == nil || criteria.Webhook.Endpoint == "" {
+		return err
+	}
+	return ctx.StoreMetadata(notificationURLKey, criteria.Webhook.Endpoint)
+}
+
+func (w webhookTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// event verifies the delivery and returns its event. It returns nil for
+// events of other kinds or types than the trigger's.
+func (w webhookTrigger) event(ctx sdkcontext.ExecuteContext) (*shared.Event, error) {
+	input, err := sdk.InputToTypeSafely[webhookTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationURL := input.NotificationURL
+	if notificationURL == "" {
+		stored, _ := ctx.GetMetadata(notificationURLKey)
+		notificationURL, _ = stored.(string)
+	}
+	if notificationURL == "" {
+		return nil, errors.New("the notification URL is unknown, set it on the trigger")
+	}
+
+	delivery, err := webhook.DeliveryFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+	event, err := shared.VerifiedEvent(input.SignatureKey, notificationURL, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(event.Type, w.prefix) {
+		return nil, nil
+	}
+	if len(input.EventTypes) > 0 && !contains(input.EventTypes, event.Type) {
+		return nil, nil
+	}
+	return event, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type cancelSubscriptionActionProps struct {
+	Subscription   string `json:"subscription"`
+	When           string `json:"when"`
+	Prorate        bool   `json:"prorate"`
+	InvoiceNow     bool   `json:"invoice_now"`
+	Comment        string `json:"comment"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+type CancelSubscriptionAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CancelSubscriptionAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "cancel_subscription",
+		DisplayName:   "Cancel Subscription",
+		Description:   "Cancel a subscription immediately, optionally crediting the unused time, or at the end of the current period.",
+		Type:          core.ActionTypeAction,
+		Documentation: cancelSubscriptionDocs,
+		SampleOutput: map[string]any{
+			"id":          "sub_1MowQVLkdIwHu7ixeRlqHVzs",
+			"object":      "subscription",
+			"customer":    "cus_1234567890",
+			"status":      "canceled",
+			"canceled_at": 1680800504,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CancelSubscriptionAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("cancel_subscription", "Cancel Subscription")
+
+	form.TextField("subscription", "Subscription ID").
+		Placeholder("sub_1234567890").
+		Required(true).
+		HelpText("The subscription to cancel.")
+
+	form.SelectField("when", "When").
+		Required(true).
+		DefaultValue("now").
+		AddOptions([]*smartform.Option{
+			{Value: "now", Label: "Immediately"},
+			{Value: "period_end", Label: "At the end of the current period"},
+		}...).
+		HelpText("When the subscription ends.")
+
+	form.CheckboxField("prorate", "Prorate").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Credit the unused time of the current period. Only when cancelling immediately.")
+
+	form.CheckboxField("invoice_now", "Invoice Now").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Invoice pending usage and prorations right away. Only when cancelling immediately.")
+
+	form.TextField("comment", "Comment").
+		Required(false).
+		HelpText("The reason for the cancellation, stored on the subscription.")
+
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CancelSubscriptionAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CancelSubscriptionAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[cancelSubscriptionActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Subscription == "" {
+		return nil, errors.New("subscription ID is required")
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/v1/subscriptions/" + url.PathEscape(input.Subscription)
+
+	if input.When == "period_end" {
+		data := url.Values{}
+		data.Set("cancel_at_period_end", "true")
+		if input.Comment != "" {
+			data.Set("cancellation_details[comment]", input.Comment)
+		}
+		return shared.Post(apiKey, path, data, input.IdempotencyKey)
+	}
+
+	params := url.Values{}
+	if input.Prorate {
+		params.Set("prorate", "true")
+	}
+	if input.InvoiceNow {
+		params.Set("invoice_now", "true")
+	}
+	if input.Comment != "" {
+		params.Set("cancellation_details[comment]", input.Comment)
+	}
+	return shared.Delete(apiKey, path, params)
+}
+
+func NewCancelSubscriptionAction() sdk.Action {
+	return &CancelSubscriptionAction{}
+}
@@ -0,0 +1,93 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type capturePaymentIntentActionProps struct {
+	PaymentIntent   string `json:"payment_intent"`
+	AmountToCapture int64  `json:"amount_to_capture"`
+	IdempotencyKey  string `json:"idempotency_key"`
+}
+
+type CapturePaymentIntentAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CapturePaymentIntentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "capture_payment_intent",
+		DisplayName:   "Capture Payment Intent",
+		Description:   "Capture the funds of an authorized payment intent, in full or in part.",
+		Type:          core.ActionTypeAction,
+		Documentation: capturePaymentIntentDocs,
+		SampleOutput: map[string]any{
+			"id":              "pi_3MtwBwLkdIwHu7ix28a3tqPa",
+			"object":          "payment_intent",
+			"amount":          2000,
+			"amount_received": 2000,
+			"currency":        "usd",
+			"status":          "succeeded",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CapturePaymentIntentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("capture_payment_intent", "Capture Payment Intent")
+
+	form.TextField("payment_intent", "Payment Intent ID").
+		Placeholder("pi_1234567890").
+		Required(true).
+		HelpText("The authorized payment intent to capture.")
+
+	form.NumberField("amount_to_capture", "Amount to Capture").
+		Required(false).
+		HelpText("Capture less than the authorized amount, in the smallest currency unit. The rest is released. Leave empty to capture everything.")
+
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CapturePaymentIntentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CapturePaymentIntentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[capturePaymentIntentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.PaymentIntent == "" {
+		return nil, errors.New("payment intent ID is required")
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	if input.AmountToCapture > 0 {
+		data.Set("amount_to_capture", strconv.FormatInt(input.AmountToCapture, 10))
+	}
+
+	return shared.Post(apiKey, "/v1/payment_intents/"+url.PathEscape(input.PaymentIntent)+"/capture", data, input.IdempotencyKey)
+}
+
+func NewCapturePaymentIntentAction() sdk.Action {
+	return &CapturePaymentIntentAction{}
+}
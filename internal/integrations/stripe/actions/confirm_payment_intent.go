@@ -0,0 +1,111 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type confirmPaymentIntentActionProps struct {
+	PaymentIntent  string `json:"payment_intent"`
+	PaymentMethod  string `json:"payment_method"`
+	ReturnURL      string `json:"return_url"`
+	OffSession     bool   `json:"off_session"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+type ConfirmPaymentIntentAction struct{}
+
+// Metadata returns metadata about the action
+func (a *ConfirmPaymentIntentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "confirm_payment_intent",
+		DisplayName:   "Confirm Payment Intent",
+		Description:   "Confirm a payment intent to attempt the payment with its payment method.",
+		Type:          core.ActionTypeAction,
+		Documentation: confirmPaymentIntentDocs,
+		SampleOutput: map[string]any{
+			"id":             "pi_3MtwBwLkdIwHu7ix28a3tqPa",
+			"object":         "payment_intent",
+			"amount":         2000,
+			"currency":       "usd",
+			"status":         "succeeded",
+			"latest_charge":  "ch_3MtwBwLkdIwHu7ix0snN0B15",
+			"payment_method": "pm_1234567890",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *ConfirmPaymentIntentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("confirm_payment_intent", "Confirm Payment Intent")
+
+	form.TextField("payment_intent", "Payment Intent ID").
+		Placeholder("pi_1234567890").
+		Required(true).
+		HelpText("The payment intent to confirm.")
+
+	form.TextField("payment_method", "Payment Method ID").
+		Placeholder("pm_1234567890").
+		Required(false).
+		HelpText("The payment method to use, if the payment intent has none yet.")
+
+	form.TextField("return_url", "Return URL").
+		Required(false).
+		HelpText("Where to send the customer after an authentication step such as 3D Secure.")
+
+	form.CheckboxField("off_session", "Customer Not Present").
+		Required(false).
+		DefaultValue(false).
+		HelpText("The customer is not in the checkout flow and cannot authenticate.")
+
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *ConfirmPaymentIntentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *ConfirmPaymentIntentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[confirmPaymentIntentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.PaymentIntent == "" {
+		return nil, errors.New("payment intent ID is required")
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	if input.PaymentMethod != "" {
+		data.Set("payment_method", input.PaymentMethod)
+	}
+	if input.ReturnURL != "" {
+		data.Set("return_url", input.ReturnURL)
+	}
+	if input.OffSession {
+		data.Set("off_session", "true")
+	}
+
+	return shared.Post(apiKey, "/v1/payment_intents/"+url.PathEscape(input.PaymentIntent)+"/confirm", data, input.IdempotencyKey)
+}
+
+func NewConfirmPaymentIntentAction() sdk.Action {
+	return &ConfirmPaymentIntentAction{}
+}
@@ -0,0 +1,165 @@
+package actions
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createPaymentIntentActionProps struct {
+	Amount         int64       `json:"amount"`
+	Currency       string      `json:"currency"`
+	Customer       string      `json:"customer"`
+	PaymentMethod  string      `json:"payment_method"`
+	Description    string      `json:"description"`
+	ReceiptEmail   string      `json:"receipt_email"`
+	CaptureMethod  string      `json:"capture_method"`
+	Confirm        bool        `json:"confirm"`
+	OffSession     bool        `json:"off_session"`
+	Metadata       interface{} `json:"metadata"`
+	IdempotencyKey string      `json:"idempotency_key"`
+}
+
+type CreatePaymentIntentAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreatePaymentIntentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_payment_intent",
+		DisplayName:   "Create Payment Intent",
+		Description:   "Create a payment intent to collect a payment, optionally confirming it right away or holding the funds for a later capture.",
+		Type:          core.ActionTypeAction,
+		Documentation: createPaymentIntentDocs,
+		SampleOutput: map[string]any{
+			"id":             "pi_3MtwBwLkdIwHu7ix28a3tqPa",
+			"object":         "payment_intent",
+			"amount":         2000,
+			"currency":       "usd",
+			"customer":       "cus_1234567890",
+			"status":         "requires_payment_method",
+			"capture_method": "automatic",
+			"client_secret":  "pi_3MtwBwLkdIwHu7ix28a3tqPa_secret_YrKJUKribcBjcG8HVhfZluoGH",
+			"created":        1680800504,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreatePaymentIntentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_payment_intent", "Create Payment Intent")
+
+	form.NumberField("amount", "Amount").
+		Placeholder("2000").
+		Required(true).
+		HelpText("The amount in the smallest currency unit, for example 2000 for $20.00.")
+
+	form.TextField("currency", "Currency").
+		Placeholder("usd").
+		Required(true).
+		HelpText("Three-letter ISO currency code.")
+
+	form.TextField("customer", "Customer ID").
+		Placeholder("cus_1234567890").
+		Required(false).
+		HelpText("The Stripe customer paying.")
+
+	form.TextField("payment_method", "Payment Method ID").
+		Placeholder("pm_1234567890").
+		Required(false).
+		HelpText("The payment method to charge, such as a saved card of the customer.")
+
+	form.TextField("description", "Description").
+		Required(false).
+		HelpText("A description shown in the Stripe dashboard.")
+
+	form.TextField("receipt_email", "Receipt Email").
+		Required(false).
+		HelpText("Send the receipt to this email address.")
+
+	form.SelectField("capture_method", "Capture").
+		Required(false).
+		DefaultValue("automatic").
+		AddOptions([]*smartform.Option{
+			{Value: "automatic", Label: "Capture automatically"},
+			{Value: "manual", Label: "Authorize only, capture later"},
+		}...).
+		HelpText("Whether to charge the payment method right away or only hold the funds.")
+
+	form.CheckboxField("confirm", "Confirm Now").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Confirm the payment intent immediately. Requires a payment method.")
+
+	form.CheckboxField("off_session", "Customer Not Present").
+		Required(false).
+		DefaultValue(false).
+		HelpText("The customer is not in the checkout flow, for example for a saved card charged later. Only used when confirming now.")
+
+	shared.RegisterMetadataProps(form)
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreatePaymentIntentAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreatePaymentIntentAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createPaymentIntentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	data.Set("amount", strconv.FormatInt(input.Amount, 10))
+	data.Set("currency", strings.ToLower(input.Currency))
+	if input.Customer != "" {
+		data.Set("customer", input.Customer)
+	}
+	if input.PaymentMethod != "" {
+		data.Set("payment_method", input.PaymentMethod)
+	} else {
+		data.Set("automatic_payment_methods[enabled]", "true")
+	}
+	if input.Description != "" {
+		data.Set("description", input.Description)
+	}
+	if input.ReceiptEmail != "" {
+		data.Set("receipt_email", input.ReceiptEmail)
+	}
+	if input.CaptureMethod != "" {
+		data.Set("capture_method", input.CaptureMethod)
+	}
+	if input.Confirm {
+		data.Set("confirm", "true")
+		if input.OffSession {
+			data.Set("off_session", "true")
+		}
+	}
+	if err := shared.SetMetadata(data, input.Metadata); err != nil {
+		return nil, err
+	}
+
+	return shared.Post(apiKey, "/v1/payment_intents", data, input.IdempotencyKey)
+}
+
+func NewCreatePaymentIntentAction() sdk.Action {
+	return &CreatePaymentIntentAction{}
+}
@@ -0,0 +1,138 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createPaymentLinkActionProps struct {
+	LineItems             interface{} `json:"line_items"`
+	Quantity              int         `json:"quantity"`
+	AdjustableQuantity    bool        `json:"adjustable_quantity"`
+	RedirectURL           string      `json:"redirect_url"`
+	AllowPromotionCodes   bool        `json:"allow_promotion_codes"`
+	CollectBillingAddress bool        `json:"collect_billing_address"`
+	Metadata              interface{} `json:"metadata"`
+	IdempotencyKey        string      `json:"idempotency_key"`
+}
+
+type CreatePaymentLinkAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreatePaymentLinkAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_payment_link",
+		DisplayName:   "Create Payment Link",
+		Description:   "Create a shareable link to a Stripe-hosted checkout page for one or more prices.",
+		Type:          core.ActionTypeAction,
+		Documentation: createPaymentLinkDocs,
+		SampleOutput: map[string]any{
+			"id":     "plink_1MoC3ULkdIwHu7ixZjtGpVl2",
+			"object": "payment_link",
+			"active": true,
+			"url":    "https://buy.stripe.com/test_cN25nr0iZ7bUa7meUY",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreatePaymentLinkAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_payment_link", "Create Payment Link")
+
+	form.TextareaField("line_items", "Prices").
+		Placeholder("price_1234567890").
+		Required(true).
+		HelpText(`Price IDs separated by commas, or a list like [{"price": "price_123", "quantity": 2}].`)
+
+	form.NumberField("quantity", "Quantity").
+		Required(false).
+		DefaultValue(1).
+		HelpText("The quantity of each price given by ID.")
+
+	form.CheckboxField("adjustable_quantity", "Adjustable Quantity").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Let the customer change the quantity at checkout.")
+
+	form.TextField("redirect_url", "Redirect URL").
+		Required(false).
+		HelpText("Send the customer to this page after payment instead of the Stripe confirmation page.")
+
+	form.CheckboxField("allow_promotion_codes", "Allow Promotion Codes").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Let the customer enter a promotion code.")
+
+	form.CheckboxField("collect_billing_address", "Collect Billing Address").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Always ask for the billing address.")
+
+	shared.RegisterMetadataProps(form)
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreatePaymentLinkAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreatePaymentLinkAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createPaymentLinkActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := shared.ParseLineItems(input.LineItems, input.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("at least one price is required")
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	shared.SetLineItems(data, "line_items", items)
+	if input.AdjustableQuantity {
+		for i := range items {
+			data.Set("line_items["+strconv.Itoa(i)+"][adjustable_quantity][enabled]", "true")
+		}
+	}
+	if input.RedirectURL != "" {
+		data.Set("after_completion[type]", "redirect")
+		data.Set("after_completion[redirect][url]", input.RedirectURL)
+	}
+	if input.AllowPromotionCodes {
+		data.Set("allow_promotion_codes", "true")
+	}
+	if input.CollectBillingAddress {
+		data.Set("billing_address_collection", "required")
+	}
+	if err := shared.SetMetadata(data, input.Metadata); err != nil {
+		return nil, err
+	}
+
+	return shared.Post(apiKey, "/v1/payment_links", data, input.IdempotencyKey)
+}
+
+func NewCreatePaymentLinkAction() sdk.Action {
+	return &CreatePaymentLinkAction{}
+}
@@ -0,0 +1,138 @@
+package actions
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createPriceActionProps struct {
+	Product        string      `json:"product"`
+	UnitAmount     int64       `json:"unit_amount"`
+	Currency       string      `json:"currency"`
+	Interval       string      `json:"interval"`
+	IntervalCount  int         `json:"interval_count"`
+	Nickname       string      `json:"nickname"`
+	LookupKey      string      `json:"lookup_key"`
+	Metadata       interface{} `json:"metadata"`
+	IdempotencyKey string      `json:"idempotency_key"`
+}
+
+type CreatePriceAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreatePriceAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_price",
+		DisplayName:   "Create Price",
+		Description:   "Add a one-time or recurring price to a product.",
+		Type:          core.ActionTypeAction,
+		Documentation: createPriceDocs,
+		SampleOutput: map[string]any{
+			"id":          "price_1MoBy5LkdIwHu7ixZhnattbh",
+			"object":      "price",
+			"product":     "prod_NWjs8kKbJWmuuc",
+			"unit_amount": 1200,
+			"currency":    "usd",
+			"type":        "recurring",
+			"recurring":   map[string]any{"interval": "month", "interval_count": 1},
+			"active":      true,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreatePriceAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_price", "Create Price")
+
+	form.TextField("product", "Product ID").
+		Placeholder("prod_1234567890").
+		Required(true).
+		HelpText("The product the price belongs to.")
+
+	form.NumberField("unit_amount", "Amount").
+		Placeholder("1200").
+		Required(true).
+		HelpText("The price in the smallest currency unit.")
+
+	form.TextField("currency", "Currency").
+		Placeholder("usd").
+		Required(true).
+		HelpText("Three-letter ISO currency code.")
+
+	form.SelectField("interval", "Billing Interval").
+		Required(false).
+		AddOptions(intervalOptions...).
+		HelpText("Make the price recurring.")
+
+	form.NumberField("interval_count", "Interval Count").
+		Required(false).
+		DefaultValue(1).
+		HelpText("Bill every this many intervals, for example 3 with a monthly interval for quarterly billing.")
+
+	form.TextField("nickname", "Nickname").
+		Required(false).
+		HelpText("An internal name for the price.")
+
+	form.TextField("lookup_key", "Lookup Key").
+		Required(false).
+		HelpText("A stable key to find the price by, such as gold_monthly.")
+
+	shared.RegisterMetadataProps(form)
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreatePriceAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreatePriceAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createPriceActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	data.Set("product", input.Product)
+	data.Set("unit_amount", strconv.FormatInt(input.UnitAmount, 10))
+	data.Set("currency", strings.ToLower(input.Currency))
+	if input.Interval != "" {
+		data.Set("recurring[interval]", input.Interval)
+		if input.IntervalCount > 1 {
+			data.Set("recurring[interval_count]", strconv.Itoa(input.IntervalCount))
+		}
+	}
+	if input.Nickname != "" {
+		data.Set("nickname", input.Nickname)
+	}
+	if input.LookupKey != "" {
+		data.Set("lookup_key", input.LookupKey)
+	}
+	if err := shared.SetMetadata(data, input.Metadata); err != nil {
+		return nil, err
+	}
+
+	return shared.Post(apiKey, "/v1/prices", data, input.IdempotencyKey)
+}
+
+func NewCreatePriceAction() sdk.Action {
+	return &CreatePriceAction{}
+}
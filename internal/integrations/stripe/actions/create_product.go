@@ -0,0 +1,133 @@
+package actions
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createProductActionProps struct {
+	Name           string      `json:"name"`
+	Description    string      `json:"description"`
+	UnitAmount     int64       `json:"unit_amount"`
+	Currency       string      `json:"currency"`
+	Interval       string      `json:"interval"`
+	Metadata       interface{} `json:"metadata"`
+	IdempotencyKey string      `json:"idempotency_key"`
+}
+
+// intervalOptions are the billing intervals of a price.
+var intervalOptions = []*smartform.Option{
+	{Value: "", Label: "One-time"},
+	{Value: "day", Label: "Daily"},
+	{Value: "week", Label: "Weekly"},
+	{Value: "month", Label: "Monthly"},
+	{Value: "year", Label: "Yearly"},
+}
+
+type CreateProductAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateProductAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_product",
+		DisplayName:   "Create Product",
+		Description:   "Create a product, optionally with a default one-time or recurring price.",
+		Type:          core.ActionTypeAction,
+		Documentation: createProductDocs,
+		SampleOutput: map[string]any{
+			"id":            "prod_NWjs8kKbJWmuuc",
+			"object":        "product",
+			"name":          "Gold Plan",
+			"active":        true,
+			"default_price": "price_1MoBy5LkdIwHu7ixZhnattbh",
+			"created":       1678833149,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateProductAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_product", "Create Product")
+
+	form.TextField("name", "Name").
+		Placeholder("Gold Plan").
+		Required(true).
+		HelpText("The product name shown to customers.")
+
+	form.TextareaField("description", "Description").
+		Required(false).
+		HelpText("The product description shown to customers.")
+
+	form.NumberField("unit_amount", "Default Price").
+		Required(false).
+		HelpText("Create a default price of this amount in the smallest currency unit.")
+
+	form.TextField("currency", "Currency").
+		Placeholder("usd").
+		Required(false).
+		HelpText("The currency of the default price.")
+
+	form.SelectField("interval", "Billing Interval").
+		Required(false).
+		AddOptions(intervalOptions...).
+		HelpText("Make the default price recurring.")
+
+	shared.RegisterMetadataProps(form)
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateProductAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateProductAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createProductActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	data.Set("name", input.Name)
+	if input.Description != "" {
+		data.Set("description", input.Description)
+	}
+	if input.UnitAmount > 0 {
+		currency := strings.ToLower(input.Currency)
+		if currency == "" {
+			currency = "usd"
+		}
+		data.Set("default_price_data[unit_amount]", strconv.FormatInt(input.UnitAmount, 10))
+		data.Set("default_price_data[currency]", currency)
+		if input.Interval != "" {
+			data.Set("default_price_data[recurring][interval]", input.Interval)
+		}
+	}
+	if err := shared.SetMetadata(data, input.Metadata); err != nil {
+		return nil, err
+	}
+
+	return shared.Post(apiKey, "/v1/products", data, input.IdempotencyKey)
+}
+
+func NewCreateProductAction() sdk.Action {
+	return &CreateProductAction{}
+}
@@ -0,0 +1,124 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createRefundActionProps struct {
+	PaymentIntent  string      `json:"payment_intent"`
+	Charge         string      `json:"charge"`
+	Amount         int64       `json:"amount"`
+	Reason         string      `json:"reason"`
+	Metadata       interface{} `json:"metadata"`
+	IdempotencyKey string      `json:"idempotency_key"`
+}
+
+type CreateRefundAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateRefundAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_refund",
+		DisplayName:   "Create Refund",
+		Description:   "Refund a payment in full or in part.",
+		Type:          core.ActionTypeAction,
+		Documentation: createRefundDocs,
+		SampleOutput: map[string]any{
+			"id":             "re_1Nispe2eZvKYlo2Cd31jOCgZ",
+			"object":         "refund",
+			"amount":         1000,
+			"currency":       "usd",
+			"charge":         "ch_1NirD82eZvKYlo2CIvbtLWuY",
+			"payment_intent": "pi_3MtwBwLkdIwHu7ix28a3tqPa",
+			"reason":         "requested_by_customer",
+			"status":         "succeeded",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateRefundAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_refund", "Create Refund")
+
+	form.TextField("payment_intent", "Payment Intent ID").
+		Placeholder("pi_1234567890").
+		Required(false).
+		HelpText("The payment intent to refund. Give this or a charge ID.")
+
+	form.TextField("charge", "Charge ID").
+		Placeholder("ch_1234567890").
+		Required(false).
+		HelpText("The charge to refund, when no payment intent is given.")
+
+	form.NumberField("amount", "Amount").
+		Required(false).
+		HelpText("The amount to refund in the smallest currency unit. Leave empty to refund everything not yet refunded.")
+
+	form.SelectField("reason", "Reason").
+		Required(false).
+		AddOptions([]*smartform.Option{
+			{Value: "requested_by_customer", Label: "Requested by customer"},
+			{Value: "duplicate", Label: "Duplicate"},
+			{Value: "fraudulent", Label: "Fraudulent"},
+		}...).
+		HelpText("The reason for the refund.")
+
+	shared.RegisterMetadataProps(form)
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateRefundAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateRefundAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createRefundActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	switch {
+	case input.PaymentIntent != "":
+		data.Set("payment_intent", input.PaymentIntent)
+	case input.Charge != "":
+		data.Set("charge", input.Charge)
+	default:
+		return nil, errors.New("a payment intent ID or charge ID is required")
+	}
+	if input.Amount > 0 {
+		data.Set("amount", strconv.FormatInt(input.Amount, 10))
+	}
+	if input.Reason != "" {
+		data.Set("reason", input.Reason)
+	}
+	if err := shared.SetMetadata(data, input.Metadata); err != nil {
+		return nil, err
+	}
+
+	return shared.Post(apiKey, "/v1/refunds", data, input.IdempotencyKey)
+}
+
+func NewCreateRefundAction() sdk.Action {
+	return &CreateRefundAction{}
+}
@@ -0,0 +1,188 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createSubscriptionActionProps struct {
+	Customer             string      `json:"customer"`
+	Items                interface{} `json:"items"`
+	Quantity             int         `json:"quantity"`
+	TrialPeriodDays      int         `json:"trial_period_days"`
+	DefaultPaymentMethod string      `json:"default_payment_method"`
+	CollectionMethod     string      `json:"collection_method"`
+	DaysUntilDue         int         `json:"days_until_due"`
+	PaymentBehavior      string      `json:"payment_behavior"`
+	ProrationBehavior    string      `json:"proration_behavior"`
+	Coupon               string      `json:"coupon"`
+	Metadata             interface{} `json:"metadata"`
+	IdempotencyKey       string      `json:"idempotency_key"`
+}
+
+type CreateSubscriptionAction struct{}
+
+// Metadata returns metadata about the action
+func (a *CreateSubscriptionAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_subscription",
+		DisplayName:   "Create Subscription",
+		Description:   "Subscribe a customer to one or more recurring prices.",
+		Type:          core.ActionTypeAction,
+		Documentation: createSubscriptionDocs,
+		SampleOutput: map[string]any{
+			"id":                   "sub_1MowQVLkdIwHu7ixeRlqHVzs",
+			"object":               "subscription",
+			"customer":             "cus_1234567890",
+			"status":               "active",
+			"current_period_start": 1679609767,
+			"current_period_end":   1682288167,
+			"items": map[string]any{
+				"data": []map[string]any{
+					{"id": "si_NZVBmVnqpc2nTd", "price": map[string]any{"id": "price_1MowQULkdIwHu7ixraBm864M"}, "quantity": 1},
+				},
+			},
+			"latest_invoice": "in_1MowQWLkdIwHu7ixuzkSPfKd",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *CreateSubscriptionAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_subscription", "Create Subscription")
+
+	form.TextField("customer", "Customer ID").
+		Placeholder("cus_1234567890").
+		Required(true).
+		HelpText("The customer to subscribe.")
+
+	form.TextareaField("items", "Prices").
+		Placeholder("price_1234567890").
+		Required(true).
+		HelpText(`Recurring price IDs separated by commas, or a list like [{"price": "price_123", "quantity": 2}].`)
+
+	form.NumberField("quantity", "Quantity").
+		Required(false).
+		DefaultValue(1).
+		HelpText("The quantity of each price given by ID.")
+
+	form.NumberField("trial_period_days", "Trial Days").
+		Required(false).
+		HelpText("Start with a free trial of this many days.")
+
+	form.TextField("default_payment_method", "Default Payment Method ID").
+		Required(false).
+		HelpText("The payment method to charge. Defaults to the customer's default payment method.")
+
+	form.SelectField("collection_method", "Collection").
+		Required(false).
+		DefaultValue("charge_automatically").
+		AddOptions([]*smartform.Option{
+			{Value: "charge_automatically", Label: "Charge automatically"},
+			{Value: "send_invoice", Label: "Email invoices"},
+		}...).
+		HelpText("How to collect each payment.")
+
+	form.NumberField("days_until_due", "Days Until Due").
+		Required(false).
+		HelpText("The payment terms of emailed invoices.")
+
+	form.SelectField("payment_behavior", "Payment Behavior").
+		Required(false).
+		DefaultValue("allow_incomplete").
+		AddOptions([]*smartform.Option{
+			{Value: "allow_incomplete", Label: "Create even if the first payment fails"},
+			{Value: "error_if_incomplete", Label: "Fail if the first payment fails"},
+			{Value: "default_incomplete", Label: "Leave incomplete until the first payment is confirmed"},
+		}...).
+		HelpText("What to do when the first payment needs action or fails.")
+
+	form.SelectField("proration_behavior", "Proration").
+		Required(false).
+		DefaultValue("create_prorations").
+		AddOptions(prorationOptions...).
+		HelpText("How to prorate when the subscription is backdated or its billing cycle is anchored.")
+
+	form.TextField("coupon", "Coupon").
+		Required(false).
+		HelpText("A coupon ID to apply.")
+
+	shared.RegisterMetadataProps(form)
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *CreateSubscriptionAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *CreateSubscriptionAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createSubscriptionActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := shared.ParseLineItems(input.Items, input.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("at least one price is required")
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	data.Set("customer", input.Customer)
+	shared.SetLineItems(data, "items", items)
+	if input.TrialPeriodDays > 0 {
+		data.Set("trial_period_days", strconv.Itoa(input.TrialPeriodDays))
+	}
+	if input.DefaultPaymentMethod != "" {
+		data.Set("default_payment_method", input.DefaultPaymentMethod)
+	}
+	if input.CollectionMethod != "" {
+		data.Set("collection_method", input.CollectionMethod)
+		if input.CollectionMethod == "send_invoice" {
+			days := input.DaysUntilDue
+			if days <= 0 {
+				days = 30
+			}
+			data.Set("days_until_due", strconv.Itoa(days))
+		}
+	}
+	if input.PaymentBehavior != "" {
+		data.Set("payment_behavior", input.PaymentBehavior)
+	}
+	if input.ProrationBehavior != "" {
+		data.Set("proration_behavior", input.ProrationBehavior)
+	}
+	if input.Coupon != "" {
+		data.Set("discounts[0][coupon]", input.Coupon)
+	}
+	if err := shared.SetMetadata(data, input.Metadata); err != nil {
+		return nil, err
+	}
+
+	return shared.Post(apiKey, "/v1/subscriptions", data, input.IdempotencyKey)
+}
+
+func NewCreateSubscriptionAction() sdk.Action {
+	return &CreateSubscriptionAction{}
+}
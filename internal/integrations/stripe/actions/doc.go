@@ -13,3 +13,51 @@ var searchCustomerDocs string
 
 //go:embed retrieve_customer.md
 var retrieveCustomerDocs string
+
+//go:embed create_payment_intent.md
+var createPaymentIntentDocs string
+
+//go:embed confirm_payment_intent.md
+var confirmPaymentIntentDocs string
+
+//go:embed capture_payment_intent.md
+var capturePaymentIntentDocs string
+
+//go:embed create_refund.md
+var createRefundDocs string
+
+//go:embed create_subscription.md
+var createSubscriptionDocs string
+
+//go:embed update_subscription.md
+var updateSubscriptionDocs string
+
+//go:embed cancel_subscription.md
+var cancelSubscriptionDocs string
+
+//go:embed create_product.md
+var createProductDocs string
+
+//go:embed create_price.md
+var createPriceDocs string
+
+//go:embed list_products.md
+var listProductsDocs string
+
+//go:embed list_prices.md
+var listPricesDocs string
+
+//go:embed create_payment_link.md
+var createPaymentLinkDocs string
+
+//go:embed finalize_invoice.md
+var finalizeInvoiceDocs string
+
+//go:embed send_invoice.md
+var sendInvoiceDocs string
+
+//go:embed void_invoice.md
+var voidInvoiceDocs string
+
+//go:embed list_charges.md
+var listChargesDocs string
@@ -0,0 +1,96 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type finalizeInvoiceActionProps struct {
+	Invoice        string `json:"invoice"`
+	AutoAdvance    bool   `json:"auto_advance"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+type FinalizeInvoiceAction struct{}
+
+// Metadata returns metadata about the action
+func (a *FinalizeInvoiceAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "finalize_invoice",
+		DisplayName:   "Finalize Invoice",
+		Description:   "Finalize a draft invoice so it can be paid or sent, optionally letting Stripe collect it automatically.",
+		Type:          core.ActionTypeAction,
+		Documentation: finalizeInvoiceDocs,
+		SampleOutput: map[string]any{
+			"id":                 "in_1MtHbELkdIwHu7ixl4OzzPMv",
+			"object":             "invoice",
+			"customer":           "cus_1234567890",
+			"number":             "3B2F1C9E-0001",
+			"status":             "open",
+			"amount_due":         2000,
+			"currency":           "usd",
+			"hosted_invoice_url": "https://invoice.stripe.com/i/acct_1M2JTkLkdIwHu7ix/test_YWNjdF8xTTJKVGtMa2RJd0h1N2l4",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *FinalizeInvoiceAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("finalize_invoice", "Finalize Invoice")
+
+	form.TextField("invoice", "Invoice ID").
+		Placeholder("in_1234567890").
+		Required(true).
+		HelpText("The draft invoice to finalize.")
+
+	form.CheckboxField("auto_advance", "Collect Automatically").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Let Stripe attempt payment or send the invoice according to its collection method.")
+
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *FinalizeInvoiceAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *FinalizeInvoiceAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[finalizeInvoiceActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Invoice == "" {
+		return nil, errors.New("invoice ID is required")
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	if input.AutoAdvance {
+		data.Set("auto_advance", "true")
+	} else {
+		data.Set("auto_advance", "false")
+	}
+	return shared.Post(apiKey, "/v1/invoices/"+url.PathEscape(input.Invoice)+"/finalize", data, input.IdempotencyKey)
+}
+
+func NewFinalizeInvoiceAction() sdk.Action {
+	return &FinalizeInvoiceAction{}
+}
@@ -0,0 +1,135 @@
+package actions
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type listChargesActionProps struct {
+	Customer      string     `json:"customer"`
+	PaymentIntent string     `json:"payment_intent"`
+	CreatedAfter  *time.Time `json:"created_after"`
+	CreatedBefore *time.Time `json:"created_before"`
+	Limit         int        `json:"limit"`
+}
+
+type ListChargesAction struct{}
+
+// Metadata returns metadata about the action
+func (a *ListChargesAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_charges",
+		DisplayName:   "List Charges",
+		Description:   "List charges, newest first, fetching as many pages as needed up to the limit.",
+		Type:          core.ActionTypeAction,
+		Documentation: listChargesDocs,
+		SampleOutput: map[string]any{
+			"charges": []map[string]any{
+				{
+					"id":              "ch_3MmlLrLkdIwHu7ix0snN0B15",
+					"amount":          1099,
+					"amount_refunded": 0,
+					"currency":        "usd",
+					"customer":        "cus_1234567890",
+					"paid":            true,
+					"status":          "succeeded",
+					"created":         1679090539,
+				},
+			},
+			"count":    1,
+			"has_more": false,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *ListChargesAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_charges", "List Charges")
+
+	form.TextField("customer", "Customer ID").
+		Placeholder("cus_1234567890").
+		Required(false).
+		HelpText("Only list charges of this customer.")
+
+	form.TextField("payment_intent", "Payment Intent ID").
+		Placeholder("pi_1234567890").
+		Required(false).
+		HelpText("Only list charges of this payment intent.")
+
+	form.DateTimeField("created_after", "Created After").
+		Required(false).
+		HelpText("Only list charges created at or after this time.")
+
+	form.DateTimeField("created_before", "Created Before").
+		Required(false).
+		HelpText("Only list charges created before this time.")
+
+	form.NumberField("limit", "Limit").
+		Required(false).
+		DefaultValue(100).
+		HelpText("The maximum number of charges to return. Pages are fetched as needed.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *ListChargesAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *ListChargesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listChargesActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if input.Customer != "" {
+		params.Set("customer", input.Customer)
+	}
+	if input.PaymentIntent != "" {
+		params.Set("payment_intent", input.PaymentIntent)
+	}
+	if input.CreatedAfter != nil {
+		params.Set("created[gte]", strconv.FormatInt(input.CreatedAfter.Unix(), 10))
+	}
+	if input.CreatedBefore != nil {
+		params.Set("created[lt]", strconv.FormatInt(input.CreatedBefore.Unix(), 10))
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	charges, hasMore, err := shared.ListAll(apiKey, "/v1/charges", params, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"charges":  charges,
+		"count":    len(charges),
+		"has_more": hasMore,
+	}, nil
+}
+
+func NewListChargesAction() sdk.Action {
+	return &ListChargesAction{}
+}
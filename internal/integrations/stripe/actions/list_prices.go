@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type listPricesActionProps struct {
+	Product    string `json:"product"`
+	ActiveOnly bool   `json:"active_only"`
+	Limit      int    `json:"limit"`
+}
+
+type ListPricesAction struct{}
+
+// Metadata returns metadata about the action
+func (a *ListPricesAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_prices",
+		DisplayName:   "List Prices",
+		Description:   "List prices, optionally of one product.",
+		Type:          core.ActionTypeAction,
+		Documentation: listPricesDocs,
+		SampleOutput: map[string]any{
+			"prices": []map[string]any{
+				{"id": "price_1MoBy5LkdIwHu7ixZhnattbh", "product": "prod_NWjs8kKbJWmuuc", "unit_amount": 1200, "currency": "usd", "type": "recurring"},
+			},
+			"count":    1,
+			"has_more": false,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *ListPricesAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_prices", "List Prices")
+
+	form.TextField("product", "Product ID").
+		Placeholder("prod_1234567890").
+		Required(false).
+		HelpText("Only list prices of this product.")
+
+	form.CheckboxField("active_only", "Active Only").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Skip archived prices.")
+
+	form.NumberField("limit", "Limit").
+		Required(false).
+		DefaultValue(100).
+		HelpText("The maximum number of prices to return. Pages are fetched as needed.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *ListPricesAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *ListPricesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listPricesActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if input.Product != "" {
+		params.Set("product", input.Product)
+	}
+	if input.ActiveOnly {
+		params.Set("active", "true")
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	prices, hasMore, err := shared.ListAll(apiKey, "/v1/prices", params, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"prices":   prices,
+		"count":    len(prices),
+		"has_more": hasMore,
+	}, nil
+}
+
+func NewListPricesAction() sdk.Action {
+	return &ListPricesAction{}
+}
@@ -0,0 +1,99 @@
+package actions
+
+import (
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type listProductsActionProps struct {
+	ActiveOnly bool `json:"active_only"`
+	Limit      int  `json:"limit"`
+}
+
+type ListProductsAction struct{}
+
+// Metadata returns metadata about the action
+func (a *ListProductsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_products",
+		DisplayName:   "List Products",
+		Description:   "List the products of your Stripe account.",
+		Type:          core.ActionTypeAction,
+		Documentation: listProductsDocs,
+		SampleOutput: map[string]any{
+			"products": []map[string]any{
+				{"id": "prod_NWjs8kKbJWmuuc", "name": "Gold Plan", "active": true, "default_price": "price_1MoBy5LkdIwHu7ixZhnattbh"},
+			},
+			"count":    1,
+			"has_more": false,
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *ListProductsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_products", "List Products")
+
+	form.CheckboxField("active_only", "Active Only").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Skip archived products.")
+
+	form.NumberField("limit", "Limit").
+		Required(false).
+		DefaultValue(100).
+		HelpText("The maximum number of products to return. Pages are fetched as needed.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *ListProductsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *ListProductsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listProductsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if input.ActiveOnly {
+		params.Set("active", "true")
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	products, hasMore, err := shared.ListAll(apiKey, "/v1/products", params, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"products": products,
+		"count":    len(products),
+		"has_more": hasMore,
+	}, nil
+}
+
+func NewListProductsAction() sdk.Action {
+	return &ListProductsAction{}
+}
@@ -0,0 +1,97 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type sendInvoiceActionProps struct {
+	Invoice        string `json:"invoice"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+type SendInvoiceAction struct{}
+
+// Metadata returns metadata about the action
+func (a *SendInvoiceAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "send_invoice",
+		DisplayName:   "Send Invoice",
+		Description:   "Email an open invoice to the customer, finalizing a draft first.",
+		Type:          core.ActionTypeAction,
+		Documentation: sendInvoiceDocs,
+		SampleOutput: map[string]any{
+			"id":                 "in_1MtHbELkdIwHu7ixl4OzzPMv",
+			"object":             "invoice",
+			"customer":           "cus_1234567890",
+			"number":             "3B2F1C9E-0001",
+			"status":             "open",
+			"amount_due":         2000,
+			"currency":           "usd",
+			"hosted_invoice_url": "https://invoice.stripe.com/i/acct_1M2JTkLkdIwHu7ix/test_YWNjdF8xTTJKVGtMa2RJd0h1N2l4",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *SendInvoiceAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("send_invoice", "Send Invoice")
+
+	form.TextField("invoice", "Invoice ID").
+		Placeholder("in_1234567890").
+		Required(true).
+		HelpText("The invoice to send. Its collection method must be to email invoices.")
+
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *SendInvoiceAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *SendInvoiceAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[sendInvoiceActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Invoice == "" {
+		return nil, errors.New("invoice ID is required")
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/v1/invoices/" + url.PathEscape(input.Invoice)
+
+	invoice, err := shared.Get(apiKey, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if invoice["status"] == "draft" {
+		// Only the send is covered by the caller's idempotency key.
+		if _, err := shared.Post(apiKey, path+"/finalize", url.Values{}, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return shared.Post(apiKey, path+"/send", url.Values{}, input.IdempotencyKey)
+}
+
+func NewSendInvoiceAction() sdk.Action {
+	return &SendInvoiceAction{}
+}
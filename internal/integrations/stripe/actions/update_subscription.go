@@ -0,0 +1,169 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateSubscriptionActionProps struct {
+	Subscription      string      `json:"subscription"`
+	Price             string      `json:"price"`
+	Quantity          int         `json:"quantity"`
+	ProrationBehavior string      `json:"proration_behavior"`
+	CancelAtPeriodEnd string      `json:"cancel_at_period_end"`
+	PaymentMethod     string      `json:"default_payment_method"`
+	Metadata          interface{} `json:"metadata"`
+	IdempotencyKey    string      `json:"idempotency_key"`
+}
+
+// prorationOptions are the proration behaviors of subscription changes.
+var prorationOptions = []*smartform.Option{
+	{Value: "create_prorations", Label: "Prorate on the next invoice"},
+	{Value: "always_invoice", Label: "Prorate and invoice now"},
+	{Value: "none", Label: "Do not prorate"},
+}
+
+type UpdateSubscriptionAction struct{}
+
+// Metadata returns metadata about the action
+func (a *UpdateSubscriptionAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_subscription",
+		DisplayName:   "Update Subscription",
+		Description:   "Change the price or quantity of a subscription with proration, or schedule it to cancel at the end of the period.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateSubscriptionDocs,
+		SampleOutput: map[string]any{
+			"id":                   "sub_1MowQVLkdIwHu7ixeRlqHVzs",
+			"object":               "subscription",
+			"customer":             "cus_1234567890",
+			"status":               "active",
+			"cancel_at_period_end": false,
+			"items": map[string]any{
+				"data": []map[string]any{
+					{"id": "si_NZVBmVnqpc2nTd", "price": map[string]any{"id": "price_1MowQULkdIwHu7ixraBm864M"}, "quantity": 3},
+				},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *UpdateSubscriptionAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_subscription", "Update Subscription")
+
+	form.TextField("subscription", "Subscription ID").
+		Placeholder("sub_1234567890").
+		Required(true).
+		HelpText("The subscription to update.")
+
+	form.TextField("price", "New Price ID").
+		Placeholder("price_1234567890").
+		Required(false).
+		HelpText("Switch the subscription's plan to this price, for upgrades and downgrades.")
+
+	form.NumberField("quantity", "Quantity").
+		Required(false).
+		HelpText("The new quantity, for example the number of seats.")
+
+	form.SelectField("proration_behavior", "Proration").
+		Required(false).
+		DefaultValue("create_prorations").
+		AddOptions(prorationOptions...).
+		HelpText("How to charge or credit the change for the rest of the current period.")
+
+	form.SelectField("cancel_at_period_end", "Cancel at Period End").
+		Required(false).
+		AddOptions([]*smartform.Option{
+			{Value: "true", Label: "Cancel at the end of the period"},
+			{Value: "false", Label: "Keep renewing"},
+		}...).
+		HelpText("Schedule or undo a cancellation at the end of the current period. Leave empty to keep it as is.")
+
+	form.TextField("default_payment_method", "Default Payment Method ID").
+		Required(false).
+		HelpText("Charge this payment method from now on.")
+
+	shared.RegisterMetadataProps(form)
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *UpdateSubscriptionAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *UpdateSubscriptionAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateSubscriptionActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Subscription == "" {
+		return nil, errors.New("subscription ID is required")
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/v1/subscriptions/" + url.PathEscape(input.Subscription)
+	data := url.Values{}
+
+	if input.Price != "" || input.Quantity > 0 {
+		subscription, err := shared.Get(apiKey, path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items, _ := subscription["items"].(map[string]interface{})
+		list, _ := items["data"].([]interface{})
+		if len(list) != 1 {
+			return nil, errors.New("the price and quantity can only be changed on subscriptions with a single price")
+		}
+		item, _ := list[0].(map[string]interface{})
+		itemID, _ := item["id"].(string)
+
+		data.Set("items[0][id]", itemID)
+		if input.Price != "" {
+			data.Set("items[0][price]", input.Price)
+		}
+		if input.Quantity > 0 {
+			data.Set("items[0][quantity]", strconv.Itoa(input.Quantity))
+		}
+	}
+	if input.ProrationBehavior != "" {
+		data.Set("proration_behavior", input.ProrationBehavior)
+	}
+	if input.CancelAtPeriodEnd != "" {
+		data.Set("cancel_at_period_end", input.CancelAtPeriodEnd)
+	}
+	if input.PaymentMethod != "" {
+		data.Set("default_payment_method", input.PaymentMethod)
+	}
+	if err := shared.SetMetadata(data, input.Metadata); err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("nothing to update")
+	}
+
+	return shared.Post(apiKey, path, data, input.IdempotencyKey)
+}
+
+func NewUpdateSubscriptionAction() sdk.Action {
+	return &UpdateSubscriptionAction{}
+}
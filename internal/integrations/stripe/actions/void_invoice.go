@@ -0,0 +1,84 @@
+package actions
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type voidInvoiceActionProps struct {
+	Invoice        string `json:"invoice"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+type VoidInvoiceAction struct{}
+
+// Metadata returns metadata about the action
+func (a *VoidInvoiceAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "void_invoice",
+		DisplayName:   "Void Invoice",
+		Description:   "Void a finalized invoice that should not be paid.",
+		Type:          core.ActionTypeAction,
+		Documentation: voidInvoiceDocs,
+		SampleOutput: map[string]any{
+			"id":                 "in_1MtHbELkdIwHu7ixl4OzzPMv",
+			"object":             "invoice",
+			"customer":           "cus_1234567890",
+			"number":             "3B2F1C9E-0001",
+			"status":             "void",
+			"amount_due":         2000,
+			"currency":           "usd",
+			"hosted_invoice_url": "https://invoice.stripe.com/i/acct_1M2JTkLkdIwHu7ix/test_YWNjdF8xTTJKVGtMa2RJd0h1N2l4",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+// Properties returns the schema for the action's input configuration
+func (a *VoidInvoiceAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("void_invoice", "Void Invoice")
+
+	form.TextField("invoice", "Invoice ID").
+		Placeholder("in_1234567890").
+		Required(true).
+		HelpText("The open invoice to void.")
+
+	shared.RegisterIdempotencyProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Auth returns the authentication requirements for the action
+func (a *VoidInvoiceAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+// Perform executes the action with the given context and input
+func (a *VoidInvoiceAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[voidInvoiceActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Invoice == "" {
+		return nil, errors.New("invoice ID is required")
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.Post(apiKey, "/v1/invoices/"+url.PathEscape(input.Invoice)+"/void", url.Values{}, input.IdempotencyKey)
+}
+
+func NewVoidInvoiceAction() sdk.Action {
+	return &VoidInvoiceAction{}
+}
@@ -34,6 +34,14 @@ func (n *Stripe) Auth() *core.AuthMetadata {
 func (n *Stripe) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewNewCustomerTrigger(),
+
+		triggers.NewInvoicePaidTrigger(),
+
+		triggers.NewChargeRefundedTrigger(),
+
+		triggers.NewSubscriptionDeletedTrigger(),
+
+		triggers.NewNewEventTrigger(),
 	}
 }
 
@@ -46,6 +54,38 @@ func (n *Stripe) Actions() []sdk.Action {
 		actions.NewCreateCustomerAction(),
 
 		actions.NewRetrieveCustomerAction(),
+
+		actions.NewCreatePaymentIntentAction(),
+
+		actions.NewConfirmPaymentIntentAction(),
+
+		actions.NewCapturePaymentIntentAction(),
+
+		actions.NewCreateRefundAction(),
+
+		actions.NewCreateSubscriptionAction(),
+
+		actions.NewUpdateSubscriptionAction(),
+
+		actions.NewCancelSubscriptionAction(),
+
+		actions.NewCreateProductAction(),
+
+		actions.NewCreatePriceAction(),
+
+		actions.NewListProductsAction(),
+
+		actions.NewListPricesAction(),
+
+		actions.NewCreatePaymentLinkAction(),
+
+		actions.NewFinalizeInvoiceAction(),
+
+		actions.NewSendInvoiceAction(),
+
+		actions.NewVoidInvoiceAction(),
+
+		actions.NewListChargesAction(),
 	}
 }
 
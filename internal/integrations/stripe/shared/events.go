@@ -0,0 +1,140 @@
+package shared
+
+import (
+	"errors"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// eventCursorKey stores the ID of the newest event a trigger returned.
+const eventCursorKey = "lastEventId"
+
+// maxEventsPerPoll bounds the events a single poll returns.
+const maxEventsPerPoll = 1000
+
+// PollEvents returns the events of the given types created since the
+// previous poll, oldest first. It resumes from the newest event it
+// returned before, so events created within the same second are neither
+// missed nor repeated. The first poll starts at since, or the trigger's
+// last run; when more events than a poll returns were created since, it
+// returns the newest and logs that the older ones were skipped.
+func PollEvents(ctx sdkcontext.ExecuteContext, apiKey string, types []string, since *time.Time) ([]map[string]interface{}, error) {
+	params := url.Values{}
+	for _, t := range types {
+		params.Add("types[]", t)
+	}
+
+	var events []map[string]interface{}
+	cursor, _ := ctx.GetMetadata(eventCursorKey)
+	if id, ok := cursor.(string); ok && id != "" {
+		var err error
+		events, err = eventsAfter(apiKey, params, id)
+		var apiErr *Error
+		if errors.As(err, &apiErr) && apiErr.Code == "resource_missing" {
+			// The cursor event expired; fall back to the last run time.
+			events, err = nil, nil
+			cursor = nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if id, ok := cursor.(string); !ok || id == "" {
+		if since == nil {
+			since = ctx.LastRun()
+		}
+		if since != nil {
+			params.Set("created[gte]", strconv.FormatInt(since.Unix(), 10))
+		}
+
+		page, truncated, err := ListAll(apiKey, "/v1/events", params, maxEventsPerPoll)
+		if err != nil {
+			return nil, err
+		}
+		events = toEvents(page)
+		slices.Reverse(events)
+		if truncated {
+			// Stripe lists the newest events first, so the older ones are
+			// out of reach once the cursor moves past them.
+			ctx.Logger().Warn("more stripe events than a poll returns; older events were skipped",
+				"returned", len(events))
+		}
+	}
+
+	if len(events) > 0 {
+		newest, _ := events[len(events)-1]["id"].(string)
+		if err := ctx.SetMetadata(eventCursorKey, newest); err != nil {
+			ctx.Logger().Warn("failed to store stripe event cursor", "error", err)
+		}
+	}
+
+	return events, nil
+}
+
+// EventObjects returns the object of each event, such as the invoice of
+// an invoice.paid event, with the event ID and type added.
+func EventObjects(events []map[string]interface{}) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0, len(events))
+	for _, event := range events {
+		data, _ := event["data"].(map[string]interface{})
+		object, _ := data["object"].(map[string]interface{})
+		if object == nil {
+			continue
+		}
+		object["event_id"] = event["id"]
+		object["event_type"] = event["type"]
+		if previous, ok := data["previous_attributes"]; ok {
+			object["previous_attributes"] = previous
+		}
+		objects = append(objects, object)
+	}
+	return objects
+}
+
+// eventsAfter pages forward from the cursor event, oldest first. params
+// is copied, so the caller can fall back to a list without the cursor.
+func eventsAfter(apiKey string, params url.Values, cursor string) ([]map[string]interface{}, error) {
+	params = maps.Clone(params)
+	var events []map[string]interface{}
+	for len(events) < maxEventsPerPoll {
+		params.Set("ending_before", cursor)
+		params.Set("limit", strconv.Itoa(maxPageSize))
+
+		resp, err := Get(apiKey, "/v1/events", params)
+		if err != nil {
+			return nil, err
+		}
+
+		raw, _ := resp["data"].([]interface{})
+		page := toEvents(raw)
+		if len(page) == 0 {
+			break
+		}
+
+		// Pages list the events closest to the cursor, newest first.
+		cursor, _ = page[0]["id"].(string)
+		slices.Reverse(page)
+		events = append(events, page...)
+
+		if hasMore, _ := resp["has_more"].(bool); !hasMore || cursor == "" {
+			break
+		}
+	}
+	return events, nil
+}
+
+func toEvents(raw []interface{}) []map[string]interface{} {
+	events := make([]map[string]interface{}, 0, len(raw))
+	for _, e := range raw {
+		if event, ok := e.(map[string]interface{}); ok {
+			events = append(events, event)
+		}
+	}
+	return events
+}
@@ -0,0 +1,167 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+// RegisterIdempotencyProps adds the idempotency key field to a form that
+// creates or changes a Stripe object.
+func RegisterIdempotencyProps(form *smartform.FormBuilder) {
+	form.TextField("idempotency_key", "Idempotency Key").
+		Required(false).
+		HelpText("A unique key for this operation, such as an order ID. Stripe returns the original result instead of repeating the operation when the same key is sent again within 24 hours.")
+}
+
+// RegisterMetadataProps adds the metadata field.
+func RegisterMetadataProps(form *smartform.FormBuilder) {
+	form.TextareaField("metadata", "Metadata").
+		Required(false).
+		Placeholder(`{"order_id": "1001"}`).
+		HelpText("Key-value pairs to store on the object, as a JSON object.")
+}
+
+// SetMetadata encodes metadata given as a JSON object or a map.
+func SetMetadata(data url.Values, metadata interface{}) error {
+	var values map[string]interface{}
+	switch m := metadata.(type) {
+	case nil:
+		return nil
+	case string:
+		if strings.TrimSpace(m) == "" {
+			return nil
+		}
+		if err := json.Unmarshal([]byte(m), &values); err != nil {
+			return errors.New("metadata must be a JSON object")
+		}
+	case map[string]interface{}:
+		values = m
+	default:
+		return errors.New("metadata must be a JSON object")
+	}
+
+	for k, v := range values {
+		switch v := v.(type) {
+		case string:
+			data.Set("metadata["+k+"]", v)
+		case nil:
+			data.Set("metadata["+k+"]", "")
+		default:
+			data.Set("metadata["+k+"]", fmt.Sprint(v))
+		}
+	}
+	return nil
+}
+
+// LineItem is a price and quantity on a subscription or payment link.
+type LineItem struct {
+	Price    string `json:"price"`
+	Quantity int    `json:"quantity"`
+}
+
+// ParseLineItems reads line items given as a JSON list, a list from a
+// previous step, or comma-separated price IDs that each get quantity.
+func ParseLineItems(v interface{}, quantity int) ([]LineItem, error) {
+	if quantity <= 0 {
+		quantity = 1
+	}
+
+	var data []byte
+	switch items := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		items = strings.TrimSpace(items)
+		if items == "" {
+			return nil, nil
+		}
+		if !strings.HasPrefix(items, "[") {
+			var out []LineItem
+			for _, price := range strings.Split(items, ",") {
+				if price = strings.TrimSpace(price); price != "" {
+					out = append(out, LineItem{Price: price, Quantity: quantity})
+				}
+			}
+			return out, nil
+		}
+		data = []byte(items)
+	default:
+		var err error
+		if data, err = json.Marshal(items); err != nil {
+			return nil, err
+		}
+	}
+
+	var out []LineItem
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf(`line items must be price IDs or a list like [{"price": "price_123", "quantity": 1}]: %w`, err)
+	}
+	for i := range out {
+		if out[i].Price == "" {
+			return nil, errors.New("each line item needs a price")
+		}
+		if out[i].Quantity <= 0 {
+			out[i].Quantity = quantity
+		}
+	}
+	return out, nil
+}
+
+// SetLineItems encodes line items under key, such as "items" or
+// "line_items".
+func SetLineItems(data url.Values, key string, items []LineItem) {
+	for i, item := range items {
+		prefix := key + "[" + strconv.Itoa(i) + "]"
+		data.Set(prefix+"[price]", item.Price)
+		data.Set(prefix+"[quantity]", strconv.Itoa(item.Quantity))
+	}
+}
+
+// maxPageSize is the largest page Stripe list endpoints return.
+const maxPageSize = 100
+
+// ListAll pages through a Stripe list endpoint until limit objects were
+// read or the list ends. It reports whether more objects remain.
+func ListAll(apiKey, path string, params url.Values, limit int) ([]interface{}, bool, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+
+	var all []interface{}
+	for {
+		pageSize := maxPageSize
+		if limit > 0 {
+			pageSize = min(pageSize, limit-len(all))
+		}
+		params.Set("limit", strconv.Itoa(pageSize))
+
+		resp, err := Get(apiKey, path, params)
+		if err != nil {
+			return nil, false, err
+		}
+
+		page, _ := resp["data"].([]interface{})
+		all = append(all, page...)
+		hasMore, _ := resp["has_more"].(bool)
+
+		if !hasMore || len(page) == 0 {
+			return all, false, nil
+		}
+		if limit > 0 && len(all) >= limit {
+			return all, true, nil
+		}
+
+		last, _ := page[len(page)-1].(map[string]interface{})
+		id, _ := last["id"].(string)
+		if id == "" {
+			return all, true, nil
+		}
+		params.Set("starting_after", id)
+	}
+}
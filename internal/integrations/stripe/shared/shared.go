@@ -16,13 +16,18 @@ package shared
 
 import (
 	"bytes"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/juicycleff/smartform/v1"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 )
 
 var (
@@ -37,38 +42,146 @@ var (
 
 const baseURL = "https://api.stripe.com"
 
-func StripeClient(apiKey, url, httpType string, payload []byte, params url.Values) (map[string]interface{}, error) {
-	fullURL := baseURL + url
+// maxAttempts bounds the tries of a request Stripe asks to retry.
+const maxAttempts = 3
 
-	req, err := http.NewRequest(httpType, fullURL, bytes.NewBuffer(payload))
+// GetAPIKey returns the secret key of the connection.
+func GetAPIKey(ctx sdkcontext.BaseContext) (string, error) {
+	authCtx, err := ctx.AuthContext()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	if len(params) > 0 {
-		req.URL.RawQuery = params.Encode()
+	apiKey := authCtx.Extra["api-key"]
+	if apiKey == "" {
+		return "", errors.New("missing stripe secret api-key")
 	}
+	return apiKey, nil
+}
+
+// StripeClient calls the Stripe API. POST requests carry an
+// Idempotency-Key so a retried request is not applied twice.
+func StripeClient(apiKey, url, httpType string, payload []byte, params url.Values) (map[string]interface{}, error) {
+	return do(apiKey, url, httpType, payload, params, "")
+}
 
-	req.SetBasicAuth(apiKey, "")
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+// Get reads a Stripe resource.
+func Get(apiKey, path string, params url.Values) (map[string]interface{}, error) {
+	return do(apiKey, path, http.MethodGet, nil, params, "")
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
+// Post sends form data to Stripe. When idempotencyKey is empty a key is
+// generated for the call; pass a key from the workflow to make the call
+// safe across workflow retries too.
+func Post(apiKey, path string, data url.Values, idempotencyKey string) (map[string]interface{}, error) {
+	return do(apiKey, path, http.MethodPost, []byte(data.Encode()), nil, idempotencyKey)
+}
+
+// Delete deletes a Stripe resource.
+func Delete(apiKey, path string, params url.Values) (map[string]interface{}, error) {
+	return do(apiKey, path, http.MethodDelete, nil, params, "")
+}
+
+// Error is an error returned by the Stripe API.
+type Error struct {
+	StatusCode  int
+	Type        string `json:"type"`
+	Code        string `json:"code"`
+	DeclineCode string `json:"decline_code"`
+	Message     string `json:"message"`
+	Param       string `json:"param"`
+}
+
+func (e *Error) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	switch {
+	case e.DeclineCode != "":
+		return fmt.Sprintf("stripe: %s (%s)", msg, e.DeclineCode)
+	case e.Code != "":
+		return fmt.Sprintf("stripe: %s (%s)", msg, e.Code)
+	default:
+		return "stripe: " + msg
 	}
-	defer resp.Body.Close()
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+func do(apiKey, path, method string, payload []byte, params url.Values, idempotencyKey string) (map[string]interface{}, error) {
+	if method == http.MethodPost && idempotencyKey == "" {
+		idempotencyKey = newIdempotencyKey()
+	}
+
+	var (
+		resp *http.Response
+		body []byte
+	)
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequest(method, baseURL+path, bytes.NewBuffer(payload))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(params) > 0 {
+			req.URL.RawQuery = params.Encode()
+		}
+
+		req.SetBasicAuth(apiKey, "")
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if idempotencyKey != "" {
+			req.Header.Set("Idempotency-Key", idempotencyKey)
+		}
+
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if attempt == maxAttempts || !shouldRetry(resp) {
+			break
+		}
+		time.Sleep(time.Duration(attempt) * 500 * time.Millisecond)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr struct {
+			Error *Error `json:"error"`
+		}
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Error != nil {
+			apiErr.Error.StatusCode = resp.StatusCode
+			return nil, apiErr.Error
+		}
+		return nil, &Error{StatusCode: resp.StatusCode}
 	}
 
 	var result map[string]interface{}
-	err = json.Unmarshal(body, &result)
+	err := json.Unmarshal(body, &result)
 	if err != nil {
 		return nil, errors.New("error unmarshalling response")
 	}
 
 	return result, nil
 }
+
+// shouldRetry follows the Stripe-Should-Retry header, falling back to
+// retrying rate limits and server errors.
+func shouldRetry(resp *http.Response) bool {
+	switch resp.Header.Get("Stripe-Should-Retry") {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,100 @@
+package triggers
+
+import (
+	"context"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type chargeRefundedTriggerProps struct {
+	CreatedTime *time.Time `json:"createdTime"`
+}
+
+type ChargeRefundedTrigger struct{}
+
+func (t *ChargeRefundedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "charge_refunded",
+		DisplayName:   "Charge Refunded",
+		Description:   "Triggers workflow when a charge is refunded in full or in part in Stripe",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: chargeRefundedDocs,
+		SampleOutput:  []map[string]any{chargeRefundedSample},
+	}
+}
+
+func (t *ChargeRefundedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *ChargeRefundedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *ChargeRefundedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("stripe-charge-refunded", "Charge Refunded")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the ChargeRefundedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *ChargeRefundedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the ChargeRefundedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *ChargeRefundedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the object of each charge.refunded event since the last run.
+func (t *ChargeRefundedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[chargeRefundedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := shared.PollEvents(ctx, apiKey, []string{"charge.refunded"}, input.CreatedTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.EventObjects(events), nil
+}
+
+func (t *ChargeRefundedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *ChargeRefundedTrigger) SampleData() sdkcore.JSON {
+	return chargeRefundedSample
+}
+
+var chargeRefundedSample = map[string]any{
+	"id":              "ch_3MmlLrLkdIwHu7ix0snN0B15",
+	"object":          "charge",
+	"amount":          1099,
+	"amount_refunded": 1099,
+	"currency":        "usd",
+	"customer":        "cus_1234567890",
+	"payment_intent":  "pi_3MtwBwLkdIwHu7ix28a3tqPa",
+	"refunded":        true,
+	"event_id":        "evt_1NG8Du2eZvKYlo2CUI79vXWy",
+	"event_type":      "charge.refunded",
+}
+
+func NewChargeRefundedTrigger() sdk.Trigger {
+	return &ChargeRefundedTrigger{}
+}
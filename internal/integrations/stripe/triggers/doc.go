@@ -4,3 +4,15 @@ import _ "embed"
 
 //go:embed new_customer.md
 var newCustomerDocs string
+
+//go:embed invoice_paid.md
+var invoicePaidDocs string
+
+//go:embed charge_refunded.md
+var chargeRefundedDocs string
+
+//go:embed subscription_deleted.md
+var subscriptionDeletedDocs string
+
+//go:embed new_event.md
+var newEventDocs string
@@ -0,0 +1,100 @@
+package triggers
+
+import (
+	"context"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type invoicePaidTriggerProps struct {
+	CreatedTime *time.Time `json:"createdTime"`
+}
+
+type InvoicePaidTrigger struct{}
+
+func (t *InvoicePaidTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "invoice_paid",
+		DisplayName:   "Invoice Paid",
+		Description:   "Triggers workflow when an invoice is paid in Stripe",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: invoicePaidDocs,
+		SampleOutput:  []map[string]any{invoicePaidSample},
+	}
+}
+
+func (t *InvoicePaidTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *InvoicePaidTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *InvoicePaidTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("stripe-invoice-paid", "Invoice Paid")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the InvoicePaidTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *InvoicePaidTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the InvoicePaidTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *InvoicePaidTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the object of each invoice.paid event since the last run.
+func (t *InvoicePaidTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[invoicePaidTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := shared.PollEvents(ctx, apiKey, []string{"invoice.paid"}, input.CreatedTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.EventObjects(events), nil
+}
+
+func (t *InvoicePaidTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *InvoicePaidTrigger) SampleData() sdkcore.JSON {
+	return invoicePaidSample
+}
+
+var invoicePaidSample = map[string]any{
+	"id":                 "in_1MtHbELkdIwHu7ixl4OzzPMv",
+	"object":             "invoice",
+	"customer":           "cus_1234567890",
+	"subscription":       "sub_1MowQVLkdIwHu7ixeRlqHVzs",
+	"status":             "paid",
+	"amount_paid":        2000,
+	"currency":           "usd",
+	"hosted_invoice_url": "https://invoice.stripe.com/i/acct_1M2JTkLkdIwHu7ix/test_YWNjdF8xTTJKVGtMa2RJd0h1N2l4",
+	"event_id":           "evt_1NG8Du2eZvKYlo2CUI79vXWy",
+	"event_type":         "invoice.paid",
+}
+
+func NewInvoicePaidTrigger() sdk.Trigger {
+	return &InvoicePaidTrigger{}
+}
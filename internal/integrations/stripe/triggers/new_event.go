@@ -0,0 +1,116 @@
+package triggers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type newEventTriggerProps struct {
+	Types       string     `json:"types"`
+	CreatedTime *time.Time `json:"createdTime"`
+}
+
+type NewEventTrigger struct{}
+
+func (t *NewEventTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "new_event",
+		DisplayName:   "New Event",
+		Description:   "Triggers workflow for each new Stripe event of the chosen types, such as payment_intent.succeeded or invoice.payment_failed",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: newEventDocs,
+		SampleOutput: []map[string]any{
+			{
+				"id":      "evt_1NG8Du2eZvKYlo2CUI79vXWy",
+				"object":  "event",
+				"type":    "payment_intent.succeeded",
+				"created": 1686089970,
+				"data": map[string]any{
+					"object": map[string]any{
+						"id":     "pi_3MtwBwLkdIwHu7ix28a3tqPa",
+						"object": "payment_intent",
+						"amount": 2000,
+						"status": "succeeded",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *NewEventTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *NewEventTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *NewEventTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("stripe-new-event", "New Event")
+
+	form.TextField("types", "Event Types").
+		Placeholder("payment_intent.succeeded, invoice.payment_failed").
+		Required(false).
+		HelpText("Event types separated by commas. Wildcards such as invoice.* are supported. Leave blank for every event.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the NewEventTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *NewEventTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the NewEventTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *NewEventTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the events created since the last run, oldest first.
+func (t *NewEventTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[newEventTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var types []string
+	for _, t := range strings.Split(input.Types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, t)
+		}
+	}
+
+	return shared.PollEvents(ctx, apiKey, types, input.CreatedTime)
+}
+
+func (t *NewEventTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *NewEventTrigger) SampleData() sdkcore.JSON {
+	return map[string]any{
+		"id":      "evt_1NG8Du2eZvKYlo2CUI79vXWy",
+		"object":  "event",
+		"type":    "payment_intent.succeeded",
+		"created": 1686089970,
+	}
+}
+
+func NewNewEventTrigger() sdk.Trigger {
+	return &NewEventTrigger{}
+}
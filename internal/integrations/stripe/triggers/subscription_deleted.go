@@ -0,0 +1,98 @@
+package triggers
+
+import (
+	"context"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/stripe/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type subscriptionDeletedTriggerProps struct {
+	CreatedTime *time.Time `json:"createdTime"`
+}
+
+type SubscriptionDeletedTrigger struct{}
+
+func (t *SubscriptionDeletedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "subscription_deleted",
+		DisplayName:   "Subscription Ended",
+		Description:   "Triggers workflow when a customer's subscription ends in Stripe",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: subscriptionDeletedDocs,
+		SampleOutput:  []map[string]any{subscriptionDeletedSample},
+	}
+}
+
+func (t *SubscriptionDeletedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *SubscriptionDeletedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *SubscriptionDeletedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("stripe-subscription-deleted", "Subscription Ended")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the SubscriptionDeletedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *SubscriptionDeletedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the SubscriptionDeletedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *SubscriptionDeletedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the object of each customer.subscription.deleted event since the last run.
+func (t *SubscriptionDeletedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[subscriptionDeletedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := shared.PollEvents(ctx, apiKey, []string{"customer.subscription.deleted"}, input.CreatedTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.EventObjects(events), nil
+}
+
+func (t *SubscriptionDeletedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *SubscriptionDeletedTrigger) SampleData() sdkcore.JSON {
+	return subscriptionDeletedSample
+}
+
+var subscriptionDeletedSample = map[string]any{
+	"id":          "sub_1MowQVLkdIwHu7ixeRlqHVzs",
+	"object":      "subscription",
+	"customer":    "cus_1234567890",
+	"status":      "canceled",
+	"canceled_at": 1680800504,
+	"ended_at":    1680800504,
+	"event_id":    "evt_1NG8Du2eZvKYlo2CUI79vXWy",
+	"event_type":  "customer.subscription.deleted",
+}
+
+func NewSubscriptionDeletedTrigger() sdk.Trigger {
+	return &SubscriptionDeletedTrigger{}
+}
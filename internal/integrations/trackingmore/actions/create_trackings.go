@@ -17,6 +17,7 @@ package actions
 import (
 	"github.com/juicycleff/smartform/v1"
 	"github.com/wakflo/extensions/internal/integrations/trackingmore/shared"
+	"github.com/wakflo/extensions/internal/shipping"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -47,7 +48,7 @@ func (c *CreateTrackingsAction) Properties() *smartform.FormSchema {
 
 	form.SelectField("courier_code", "Courier Code").
 		Required(true).
-		AddOptions(shared.CourierCodes...).
+		AddOptions(shipping.CourierOptions()...).
 		Placeholder("Courier code").
 		HelpText("Courier code")
 
@@ -19,6 +19,7 @@ import (
 
 	"github.com/juicycleff/smartform/v1"
 	"github.com/wakflo/extensions/internal/integrations/trackingmore/shared"
+	"github.com/wakflo/extensions/internal/shipping"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
@@ -55,7 +56,7 @@ func (c *TrackAPackageAction) Properties() *smartform.FormSchema {
 
 	form.SelectField("courier_code", "Courier Code").
 		Required(true).
-		AddOptions(shared.CourierCodes...).
+		AddOptions(shipping.CourierOptions()...).
 		Placeholder("Courier code").
 		HelpText("Courier code")
 
@@ -47,6 +47,7 @@ func (n *TrackingMore) Auth() *core.AuthMetadata {
 
 func (n *TrackingMore) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
+		triggers.NewTrackingStatusChangedTrigger(),
 		triggers.NewNewPaymentTrigger(),
 	}
 }
@@ -0,0 +1,132 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/wakflo/extensions/internal/shipping"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// maxTrackingPages bounds the pages read by one trigger run.
+const maxTrackingPages = 20
+
+const trackingsPerPage = 200
+
+// GetAPIKey returns the TrackingMore API key of the connection.
+func GetAPIKey(ctx sdkcontext.BaseContext) (string, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return "", err
+	}
+
+	apiKey := authCtx.Extra["key"]
+	if apiKey == "" {
+		return "", errors.New("missing trackingmore api key")
+	}
+	return apiKey, nil
+}
+
+type trackInfo struct {
+	CheckpointDate           string `json:"checkpoint_date"`
+	CheckpointDeliveryStatus string `json:"checkpoint_delivery_status"`
+	TrackingDetail           string `json:"tracking_detail"`
+	Location                 string `json:"location"`
+}
+
+type tracking struct {
+	ID                    string `json:"id"`
+	TrackingNumber        string `json:"tracking_number"`
+	CourierCode           string `json:"courier_code"`
+	DeliveryStatus        string `json:"delivery_status"`
+	Substatus             string `json:"substatus"`
+	UpdateDate            string `json:"update_date"`
+	ScheduledDeliveryDate string `json:"scheduled_delivery_date"`
+	OriginInfo            struct {
+		TrackInfo []trackInfo `json:"trackinfo"`
+	} `json:"origin_info"`
+	DestinationInfo struct {
+		TrackInfo []trackInfo `json:"trackinfo"`
+	} `json:"destination_info"`
+}
+
+func (t tracking) normalize() shipping.Tracking {
+	out := shipping.Tracking{
+		Provider:          "trackingmore",
+		ID:                t.ID,
+		TrackingNumber:    t.TrackingNumber,
+		Carrier:           t.CourierCode,
+		Status:            shipping.NormalizeStatus(t.DeliveryStatus),
+		RawStatus:         t.DeliveryStatus,
+		EstimatedDelivery: t.ScheduledDeliveryDate,
+		UpdatedAt:         t.UpdateDate,
+	}
+	events := t.DestinationInfo.TrackInfo
+	if len(events) == 0 {
+		events = t.OriginInfo.TrackInfo
+	}
+	for _, e := range events {
+		out.Events = append(out.Events, shipping.TrackingEvent{
+			Status:     shipping.NormalizeStatus(e.CheckpointDeliveryStatus),
+			RawStatus:  e.CheckpointDeliveryStatus,
+			Message:    e.TrackingDetail,
+			Location:   e.Location,
+			OccurredAt: e.CheckpointDate,
+		})
+	}
+	return out
+}
+
+// UpdatedTrackings returns the trackings updated since the given time.
+func UpdatedTrackings(apiKey string, since time.Time) ([]shipping.Tracking, error) {
+	var trackings []shipping.Tracking
+	for page := 1; page <= maxTrackingPages; page++ {
+		params := url.Values{}
+		params.Set("page", strconv.Itoa(page))
+		params.Set("items_amount", strconv.Itoa(trackingsPerPage))
+		if !since.IsZero() {
+			params.Set("updated_date_min", since.UTC().Format(time.RFC3339))
+		}
+
+		req, err := http.NewRequest(http.MethodGet, baseURL+"/v4/trackings/get?"+params.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("Accept", "application/json")
+		req.Header.Add("Tracking-Api-Key", apiKey)
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp struct {
+			Meta struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+			} `json:"meta"`
+			Data []tracking `json:"data"`
+		}
+		err = json.NewDecoder(res.Body).Decode(&resp)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse response JSON: %w", err)
+		}
+		if res.StatusCode >= http.StatusBadRequest || resp.Meta.Code >= 400 {
+			return nil, fmt.Errorf("trackingmore API error (code %d): %s", resp.Meta.Code, resp.Meta.Message)
+		}
+
+		for _, t := range resp.Data {
+			trackings = append(trackings, t.normalize())
+		}
+		if len(resp.Data) < trackingsPerPage {
+			break
+		}
+	}
+	return trackings, nil
+}
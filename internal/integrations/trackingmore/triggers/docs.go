@@ -20,3 +20,6 @@ import (
 
 //go:embed new_payment.md
 var newPaymentDocs string
+
+//go:embed tracking_status_changed.md
+var trackingStatusChangedDocs string
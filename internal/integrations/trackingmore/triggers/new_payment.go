@@ -16,30 +16,27 @@ package triggers
 
 import (
 	"context"
-	"time"
+	"errors"
 
 	"github.com/juicycleff/smartform/v1"
-	"github.com/wakflo/extensions/internal/integrations/square/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	"github.com/wakflo/go-sdk/v2/core"
 )
 
+// NewPaymentTrigger is kept so workflows built on it still load. It read
+// Square payments with the TrackingMore connection, which never worked:
+// TrackingMore has no payments.
 type NewPaymentTrigger struct{}
 
 func (e *NewPaymentTrigger) Metadata() sdk.TriggerMetadata {
 	return sdk.TriggerMetadata{
 		ID:            "new_payment",
-		DisplayName:   "New Payment",
-		Description:   "triggers workflow when a new payment is created",
+		DisplayName:   "New Payment (Deprecated)",
+		Description:   "Deprecated: TrackingMore has no payments. Use the Square New Payment trigger, or Tracking Status Changed for shipments.",
 		Type:          core.TriggerTypeScheduled,
 		Documentation: newPaymentDocs,
-		SampleOutput: map[string]interface{}{
-			"kind":     "drive#file",
-			"mimeType": "image/jpeg",
-			"id":       "1dpv4-sKJfKRwI9qx1vWqQhEGEn3EpbI5",
-			"name":     "example.jpg",
-		},
+		SampleOutput:  map[string]interface{}{},
 	}
 }
 
@@ -63,39 +60,7 @@ func (e *NewPaymentTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
 }
 
 func (e *NewPaymentTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
-	var fromDate string
-
-	// Get the last run time
-	lastRun, err := ctx.GetMetadata("lastRun")
-	if err != nil {
-		return nil, err
-	}
-
-	if lastRun != nil {
-		lastRunTime, ok := lastRun.(*time.Time)
-		if ok && lastRunTime != nil {
-			fromDate = lastRunTime.UTC().Format(time.RFC3339)
-		} else {
-			defaultStartDate := time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC)
-			fromDate = defaultStartDate.UTC().Format(time.RFC3339)
-		}
-	} else {
-		defaultStartDate := time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC)
-		fromDate = defaultStartDate.UTC().Format(time.RFC3339)
-	}
-
-	authCtx, err := ctx.AuthContext()
-	if err != nil {
-		return nil, err
-	}
-
-	request := "/v2/payments?begin_time=" + fromDate
-
-	payments, err := shared.GetSquareClient(authCtx.AccessToken, request)
-	if err != nil {
-		return nil, err
-	}
-	return payments, nil
+	return nil, errors.New("the TrackingMore New Payment trigger is deprecated: TrackingMore has no payments; use the Square New Payment trigger, or Tracking Status Changed for shipments")
 }
 
 func (e *NewPaymentTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
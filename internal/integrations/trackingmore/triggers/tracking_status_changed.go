@@ -0,0 +1,118 @@
+package triggers
+
+import (
+	"context"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/trackingmore/shared"
+	"github.com/wakflo/extensions/internal/shipping"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type trackingStatusChangedTriggerProps struct {
+	UpdatedTime *time.Time `json:"updatedTime"`
+}
+
+type TrackingStatusChangedTrigger struct{}
+
+func (t *TrackingStatusChangedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "tracking_status_changed",
+		DisplayName:   "Tracking Status Changed",
+		Description:   "Triggers workflow when the normalized delivery status of a TrackingMore tracking changes",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: trackingStatusChangedDocs,
+		SampleOutput:  []map[string]any{trackingStatusChangedSample},
+	}
+}
+
+func (t *TrackingStatusChangedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *TrackingStatusChangedTrigger) GetType() sdkcore.TriggerType {
+	return sdkcore.TriggerTypePolling
+}
+
+func (t *TrackingStatusChangedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("trackingmore-tracking-status-changed", "Tracking Status Changed")
+
+	shipping.RegisterStatusFilterProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the TrackingStatusChangedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *TrackingStatusChangedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the TrackingStatusChangedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *TrackingStatusChangedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the trackings updated since the last run whose
+// status changed.
+func (t *TrackingStatusChangedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[trackingStatusChangedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := shared.GetAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Time{}
+	if input.UpdatedTime != nil {
+		since = *input.UpdatedTime
+	} else if lr := ctx.LastRun(); lr != nil {
+		since = *lr
+	}
+
+	trackings, err := shared.UpdatedTrackings(apiKey, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return shipping.TrackingChanges(ctx, trackings), nil
+}
+
+func (t *TrackingStatusChangedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *TrackingStatusChangedTrigger) SampleData() sdkcore.JSON {
+	return trackingStatusChangedSample
+}
+
+var trackingStatusChangedSample = map[string]any{
+	"provider":        "trackingmore",
+	"id":              "9a1339c2c1d2c08bcd8c3d7f8ff7fb33",
+	"tracking_number": "9400111899562537683144",
+	"carrier":         "usps",
+	"status":          "delivered",
+	"raw_status":      "delivered",
+	"previous_status": "out_for_delivery",
+	"updated_at":      "2025-03-14T16:05:27+00:00",
+	"events": []map[string]any{
+		{
+			"status":      "delivered",
+			"raw_status":  "delivered",
+			"message":     "Delivered, In/At Mailbox",
+			"location":    "DENVER, CO 80202",
+			"occurred_at": "2025-03-14T15:42:00-07:00",
+		},
+	},
+}
+
+func NewTrackingStatusChangedTrigger() sdk.Trigger {
+	return &TrackingStatusChangedTrigger{}
+}
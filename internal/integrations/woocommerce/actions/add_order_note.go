@@ -0,0 +1,102 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type addOrderNoteActionProps struct {
+	OrderID        string `json:"order_id"`
+	Note           string `json:"note"`
+	NotifyCustomer bool   `json:"notify_customer"`
+}
+
+type AddOrderNoteAction struct{}
+
+func (a *AddOrderNoteAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "add_order_note",
+		DisplayName:   "Add Order Note",
+		Description:   "Add a private note or a note to the customer on an order.",
+		Type:          core.ActionTypeAction,
+		Documentation: addOrderNoteDocs,
+		SampleOutput: map[string]any{
+			"id":            281,
+			"author":        "WooCommerce",
+			"note":          "Shipped with UPS, tracking 1Z999AA10123456784.",
+			"customer_note": true,
+			"date_created":  "2025-03-12T18:20:00",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *AddOrderNoteAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("add_order_note", "Add Order Note")
+
+	shared.GetOrdersProp("order_id", "Order", "The order to add the note to.", true, form)
+
+	form.TextareaField("note", "Note").
+		Required(true)
+
+	form.CheckboxField("notify_customer", "Send Note to Customer").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Email the note to the customer instead of keeping it private.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *AddOrderNoteAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[addOrderNoteActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := shared.ParseID("order ID", input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if input.Note == "" {
+		return nil, errors.New("note is required")
+	}
+
+	creds, err := shared.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return addOrderNote(creds, orderID, input.Note, input.NotifyCustomer)
+}
+
+// addOrderNote adds a note to an order. woocommerce-go can't send notes
+// to the customer, so the note is posted directly.
+func addOrderNote(creds *shared.Credentials, orderID int, note string, notifyCustomer bool) (map[string]interface{}, error) {
+	body := map[string]interface{}{
+		"note":          note,
+		"customer_note": notifyCustomer,
+	}
+
+	var created map[string]interface{}
+	if err := creds.Request(http.MethodPost, fmt.Sprintf("/orders/%d/notes", orderID), body, &created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (a *AddOrderNoteAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewAddOrderNoteAction() sdk.Action {
+	return &AddOrderNoteAction{}
+}
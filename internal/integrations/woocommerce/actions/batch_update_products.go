@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type batchUpdateProductsActionProps struct {
+	Updates interface{} `json:"updates"`
+}
+
+type BatchUpdateProductsAction struct{}
+
+func (a *BatchUpdateProductsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "batch_update_products",
+		DisplayName:   "Batch Update Prices and Stock",
+		Description:   "Update the prices and stock of many products and variations at once, matched by ID or SKU.",
+		Type:          core.ActionTypeAction,
+		Documentation: batchUpdateProductsDocs,
+		SampleOutput: map[string]any{
+			"updated": []map[string]any{
+				{
+					"id":             93,
+					"parent_id":      0,
+					"sku":            "MUG-001",
+					"regular_price":  "12.50",
+					"sale_price":     "",
+					"stock_quantity": 40,
+					"stock_status":   "instock",
+				},
+			},
+			"failed":    []map[string]any{},
+			"not_found": []string{"MUG-404"},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *BatchUpdateProductsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("batch_update_products", "Batch Update Prices and Stock")
+
+	form.TextareaField("updates", "Updates").
+		Placeholder(`[{"sku": "MUG-001", "stock_quantity": 40, "regular_price": 12.5}]`).
+		Required(true).
+		HelpText("A list of updates, as a JSON array, one JSON object per line, or a list from a previous step. Each update has an id or sku, and any of regular_price, sale_price, stock_quantity and stock_status. Variations are found by SKU, or by variation_id with product_id.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *BatchUpdateProductsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[batchUpdateProductsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, err := shared.ParseStockUpdates(input.Updates)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := shared.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return creds.BatchUpdateStock(updates)
+}
+
+func (a *BatchUpdateProductsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewBatchUpdateProductsAction() sdk.Action {
+	return &BatchUpdateProductsAction{}
+}
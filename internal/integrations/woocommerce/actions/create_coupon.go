@@ -0,0 +1,176 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createCouponActionProps struct {
+	Code              string  `json:"code"`
+	DiscountType      string  `json:"discount_type"`
+	Amount            float64 `json:"amount"`
+	Description       string  `json:"description"`
+	DateExpires       string  `json:"date_expires"`
+	MinimumAmount     float64 `json:"minimum_amount"`
+	UsageLimit        int     `json:"usage_limit"`
+	UsageLimitPerUser int     `json:"usage_limit_per_user"`
+	IndividualUse     bool    `json:"individual_use"`
+	ExcludeSaleItems  bool    `json:"exclude_sale_items"`
+	FreeShipping      bool    `json:"free_shipping"`
+	EmailRestrictions string  `json:"email_restrictions"`
+}
+
+type CreateCouponAction struct{}
+
+func (a *CreateCouponAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_coupon",
+		DisplayName:   "Create Coupon",
+		Description:   "Create a discount coupon in your WooCommerce store.",
+		Type:          core.ActionTypeAction,
+		Documentation: createCouponDocs,
+		SampleOutput: map[string]any{
+			"id":            720,
+			"code":          "spring10",
+			"discount_type": "percent",
+			"amount":        "10.00",
+			"usage_limit":   100,
+			"date_expires":  "2025-06-01T00:00:00",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *CreateCouponAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_coupon", "Create Coupon")
+
+	form.TextField("code", "Coupon Code").
+		Placeholder("SPRING10").
+		Required(true)
+
+	form.SelectField("discount_type", "Discount Type").
+		Required(true).
+		DefaultValue("percent").
+		AddOption("percent", "Percentage Discount").
+		AddOption("fixed_cart", "Fixed Cart Discount").
+		AddOption("fixed_product", "Fixed Product Discount")
+
+	form.NumberField("amount", "Amount").
+		Required(true).
+		HelpText("The discount, as a percentage or an amount in the store currency.")
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	form.TextField("date_expires", "Expiry Date").
+		Placeholder("2025-06-01").
+		Required(false).
+		HelpText("The date the coupon expires, in the store's timezone.")
+
+	form.NumberField("minimum_amount", "Minimum Spend").
+		Required(false)
+
+	form.NumberField("usage_limit", "Usage Limit").
+		Required(false).
+		HelpText("How many times the coupon can be used in total.")
+
+	form.NumberField("usage_limit_per_user", "Usage Limit per Customer").
+		Required(false)
+
+	form.CheckboxField("individual_use", "Individual Use Only").
+		Required(false).
+		DefaultValue(false).
+		HelpText("The coupon can't be combined with other coupons.")
+
+	form.CheckboxField("exclude_sale_items", "Exclude Sale Items").
+		Required(false).
+		DefaultValue(false)
+
+	form.CheckboxField("free_shipping", "Allow Free Shipping").
+		Required(false).
+		DefaultValue(false)
+
+	form.TextField("email_restrictions", "Allowed Emails").
+		Required(false).
+		HelpText("Only these customer emails can use the coupon, separated by commas.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateCouponAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createCouponActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	code := strings.TrimSpace(input.Code)
+	if code == "" {
+		return nil, errors.New("coupon code is required")
+	}
+	if input.Amount < 0 || (input.DiscountType == "percent" && input.Amount > 100) {
+		return nil, errors.New("invalid discount amount")
+	}
+
+	creds, err := shared.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"code":               code,
+		"discount_type":      input.DiscountType,
+		"amount":             strconv.FormatFloat(input.Amount, 'f', -1, 64),
+		"individual_use":     input.IndividualUse,
+		"exclude_sale_items": input.ExcludeSaleItems,
+		"free_shipping":      input.FreeShipping,
+	}
+	if input.Description != "" {
+		body["description"] = input.Description
+	}
+	if input.DateExpires != "" {
+		body["date_expires"] = input.DateExpires
+	}
+	if input.MinimumAmount > 0 {
+		body["minimum_amount"] = strconv.FormatFloat(input.MinimumAmount, 'f', -1, 64)
+	}
+	if input.UsageLimit > 0 {
+		body["usage_limit"] = input.UsageLimit
+	}
+	if input.UsageLimitPerUser > 0 {
+		body["usage_limit_per_user"] = input.UsageLimitPerUser
+	}
+	if input.EmailRestrictions != "" {
+		var emails []string
+		for _, email := range strings.Split(input.EmailRestrictions, ",") {
+			if email = strings.TrimSpace(email); email != "" {
+				emails = append(emails, email)
+			}
+		}
+		body["email_restrictions"] = emails
+	}
+
+	var coupon map[string]interface{}
+	if err := creds.Request(http.MethodPost, "/coupons", body, &coupon); err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
+
+func (a *CreateCouponAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewCreateCouponAction() sdk.Action {
+	return &CreateCouponAction{}
+}
@@ -0,0 +1,168 @@
+package actions
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createOrderActionProps struct {
+	CustomerID         string      `json:"customer_id"`
+	Status             string      `json:"status"`
+	LineItems          interface{} `json:"line_items"`
+	ShipToBilling      bool        `json:"ship_to_billing"`
+	CouponCode         string      `json:"coupon_code"`
+	CustomerNote       string      `json:"customer_note"`
+	PaymentMethod      string      `json:"payment_method"`
+	PaymentMethodTitle string      `json:"payment_method_title"`
+	SetPaid            bool        `json:"set_paid"`
+}
+
+type CreateOrderAction struct{}
+
+func (a *CreateOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_order",
+		DisplayName:   "Create Order",
+		Description:   "Create an order in your WooCommerce store with line items, addresses and payment details.",
+		Type:          core.ActionTypeAction,
+		Documentation: createOrderDocs,
+		SampleOutput:  orderSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *CreateOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_order", "Create Order")
+
+	shared.GetCustomersProp("customer_id", "Customer", "The customer placing the order. Leave empty for a guest order.", false, form)
+
+	form.TextareaField("line_items", "Line Items").
+		Placeholder("93:2, 94:1").
+		Required(true).
+		HelpText(`Product IDs with quantities as product_id:quantity, or a list like [{"product_id": 93, "variation_id": 101, "quantity": 2}].`)
+
+	form.SelectField("status", "Status").
+		Required(false).
+		DefaultValue("pending").
+		AddOptions(shared.OrderStatuses...).
+		HelpText("The status the order is created with.")
+
+	shared.RegisterAddressProps(form, "billing", "Billing")
+
+	form.CheckboxField("ship_to_billing", "Ship to Billing Address").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Use the billing address as the shipping address when no shipping address is given.")
+
+	shared.RegisterAddressProps(form, "shipping", "Shipping")
+
+	form.TextField("coupon_code", "Coupon Code").
+		Required(false).
+		HelpText("A coupon to apply to the order.")
+
+	form.TextareaField("customer_note", "Customer Note").
+		Required(false).
+		HelpText("A note from the customer, shown on the order.")
+
+	form.TextField("payment_method", "Payment Method ID").
+		Required(false).
+		Placeholder("bacs").
+		HelpText("The ID of the payment gateway, such as bacs, cod or stripe.")
+
+	form.TextField("payment_method_title", "Payment Method Title").
+		Required(false).
+		HelpText("The payment method name shown to the customer.")
+
+	form.CheckboxField("set_paid", "Mark as Paid").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Mark the order as paid, which moves it to processing and reduces stock.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateOrderAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := shared.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lineItems, err := shared.ParseLineItems(input.LineItems)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"line_items": lineItems,
+		"set_paid":   input.SetPaid,
+	}
+	if input.Status != "" {
+		body["status"] = input.Status
+	}
+	if input.CustomerID != "" {
+		customerID, err := shared.ParseID("customer ID", input.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		body["customer_id"] = customerID
+	}
+
+	raw := ctx.Input()
+	billing := shared.AddressFrom(raw, "billing")
+	shipping := shared.AddressFrom(raw, "shipping")
+	if billing != nil {
+		body["billing"] = billing
+		if shipping == nil && input.ShipToBilling {
+			shipping = map[string]interface{}{}
+			for k, v := range billing {
+				if k != "email" {
+					shipping[k] = v
+				}
+			}
+		}
+	}
+	if shipping != nil {
+		body["shipping"] = shipping
+	}
+
+	if code := strings.TrimSpace(input.CouponCode); code != "" {
+		body["coupon_lines"] = []map[string]string{{"code": code}}
+	}
+	if input.CustomerNote != "" {
+		body["customer_note"] = input.CustomerNote
+	}
+	if input.PaymentMethod != "" {
+		body["payment_method"] = input.PaymentMethod
+	}
+	if input.PaymentMethodTitle != "" {
+		body["payment_method_title"] = input.PaymentMethodTitle
+	}
+
+	var order map[string]interface{}
+	if err := creds.Request(http.MethodPost, "/orders", body, &order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (a *CreateOrderAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewCreateOrderAction() sdk.Action {
+	return &CreateOrderAction{}
+}
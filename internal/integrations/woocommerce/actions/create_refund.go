@@ -0,0 +1,169 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createRefundActionProps struct {
+	OrderID   string      `json:"order_id"`
+	Amount    float64     `json:"amount"`
+	Reason    string      `json:"reason"`
+	APIRefund bool        `json:"api_refund"`
+	LineItems interface{} `json:"line_items"`
+	Restock   bool        `json:"restock_items"`
+}
+
+type refundLineItem struct {
+	ID          int     `json:"id"`
+	Quantity    int     `json:"quantity"`
+	RefundTotal float64 `json:"refund_total"`
+}
+
+type CreateRefundAction struct{}
+
+func (a *CreateRefundAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_refund",
+		DisplayName:   "Create Refund",
+		Description:   "Refund an order in full or in part, optionally through the payment gateway and restocking the refunded items.",
+		Type:          core.ActionTypeAction,
+		Documentation: createRefundDocs,
+		SampleOutput: map[string]any{
+			"id":               726,
+			"amount":           "10.00",
+			"reason":           "Damaged in transit",
+			"refunded_by":      1,
+			"refunded_payment": true,
+			"date_created":     "2025-03-12T18:20:00",
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *CreateRefundAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_refund", "Create Refund")
+
+	shared.GetOrdersProp("order_id", "Order", "The order to refund.", true, form)
+
+	form.NumberField("amount", "Amount").
+		Required(false).
+		HelpText("The amount to refund. Leave empty to refund the sum of the line items.")
+
+	form.TextField("reason", "Reason").
+		Required(false)
+
+	form.CheckboxField("api_refund", "Refund Through Payment Gateway").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Send the money back with the order's payment gateway. Turn off to only record a manual refund.")
+
+	form.TextareaField("line_items", "Line Items").
+		Required(false).
+		HelpText(`Order line items to refund, as a list like [{"id": 315, "quantity": 1, "refund_total": 10.99}]. The id is the order line item ID.`)
+
+	form.CheckboxField("restock_items", "Restock Items").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Put the refunded line item quantities back in stock.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateRefundAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createRefundActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := shared.ParseID("order ID", input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	lineItems, err := parseRefundLineItems(input.LineItems)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := input.Amount
+	if amount == 0 {
+		for _, item := range lineItems {
+			amount += item.RefundTotal
+		}
+	}
+	if amount <= 0 {
+		return nil, errors.New("an amount or line items with a refund_total are required")
+	}
+
+	creds, err := shared.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"amount":     strconv.FormatFloat(amount, 'f', 2, 64),
+		"reason":     input.Reason,
+		"api_refund": input.APIRefund,
+	}
+	if len(lineItems) > 0 {
+		body["line_items"] = lineItems
+		body["api_restock"] = input.Restock
+	}
+
+	var refund map[string]interface{}
+	if err := creds.Request(http.MethodPost, fmt.Sprintf("/orders/%d/refunds", orderID), body, &refund); err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+func parseRefundLineItems(v interface{}) ([]refundLineItem, error) {
+	var data []byte
+	switch items := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if strings.TrimSpace(items) == "" {
+			return nil, nil
+		}
+		data = []byte(items)
+	default:
+		var err error
+		if data, err = json.Marshal(items); err != nil {
+			return nil, err
+		}
+	}
+
+	var lineItems []refundLineItem
+	if err := json.Unmarshal(data, &lineItems); err != nil {
+		return nil, fmt.Errorf(`line items must be a list like [{"id": 315, "quantity": 1, "refund_total": 10.99}]: %w`, err)
+	}
+	for _, item := range lineItems {
+		if item.ID <= 0 {
+			return nil, errors.New("each refunded line item needs the order line item id")
+		}
+	}
+	return lineItems, nil
+}
+
+func (a *CreateRefundAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewCreateRefundAction() sdk.Action {
+	return &CreateRefundAction{}
+}
@@ -0,0 +1,80 @@
+package actions
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type createVariationActionProps struct {
+	ProductID string `json:"productId"`
+	shared.VariationFields
+}
+
+type CreateVariationAction struct{}
+
+func (a *CreateVariationAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_variation",
+		DisplayName:   "Create Variation",
+		Description:   "Add a variation to a variable product.",
+		Type:          core.ActionTypeAction,
+		Documentation: createVariationDocs,
+		SampleOutput:  variationSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *CreateVariationAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_variation", "Create Variation")
+
+	shared.GetProductsProp("productId", "Product", "The variable product to add the variation to.", true, form)
+
+	shared.RegisterVariationProps(form, true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateVariationAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createVariationActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productID, err := shared.ParseID("product ID", input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := input.Body()
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := shared.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var variation map[string]interface{}
+	if err := creds.Request(http.MethodPost, fmt.Sprintf("/products/%d/variations", productID), body, &variation); err != nil {
+		return nil, err
+	}
+
+	return variation, nil
+}
+
+func (a *CreateVariationAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewCreateVariationAction() sdk.Action {
+	return &CreateVariationAction{}
+}
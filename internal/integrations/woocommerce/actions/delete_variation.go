@@ -0,0 +1,78 @@
+package actions
+
+import (
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type deleteVariationActionProps struct {
+	ProductID   string `json:"productId"`
+	VariationID string `json:"variation_id"`
+}
+
+type DeleteVariationAction struct{}
+
+func (a *DeleteVariationAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "delete_variation",
+		DisplayName:   "Delete Variation",
+		Description:   "Permanently delete a product variation.",
+		Type:          core.ActionTypeAction,
+		Documentation: deleteVariationDocs,
+		SampleOutput:  variationSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *DeleteVariationAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("delete_variation", "Delete Variation")
+
+	shared.GetProductsProp("productId", "Product", "The product the variation belongs to.", true, form)
+
+	form.TextField("variation_id", "Variation ID").
+		Required(true).
+		HelpText("The ID of the variation to delete.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *DeleteVariationAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[deleteVariationActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productID, err := shared.ParseID("product ID", input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	variationID, err := shared.ParseID("variation ID", input.VariationID)
+	if err != nil {
+		return nil, err
+	}
+
+	wooClient, err := shared.InitClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := wooClient.Services.ProductVariation.Delete(productID, variationID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+func (a *DeleteVariationAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewDeleteVariationAction() sdk.Action {
+	return &DeleteVariationAction{}
+}
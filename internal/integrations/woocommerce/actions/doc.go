@@ -28,3 +28,36 @@ var listProductsDocs string
 
 //go:embed update_product.md
 var updateProductDocs string
+
+//go:embed create_order.md
+var createOrderDocs string
+
+//go:embed update_order.md
+var updateOrderDocs string
+
+//go:embed add_order_note.md
+var addOrderNoteDocs string
+
+//go:embed create_refund.md
+var createRefundDocs string
+
+//go:embed batch_update_products.md
+var batchUpdateProductsDocs string
+
+//go:embed list_variations.md
+var listVariationsDocs string
+
+//go:embed create_variation.md
+var createVariationDocs string
+
+//go:embed update_variation.md
+var updateVariationDocs string
+
+//go:embed delete_variation.md
+var deleteVariationDocs string
+
+//go:embed create_coupon.md
+var createCouponDocs string
+
+//go:embed list_shipping_zones.md
+var listShippingZonesDocs string
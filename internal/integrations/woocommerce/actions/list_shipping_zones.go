@@ -0,0 +1,112 @@
+package actions
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type listShippingZonesActionProps struct {
+	IncludeDetails bool `json:"include_details"`
+}
+
+type ListShippingZonesAction struct{}
+
+func (a *ListShippingZonesAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_shipping_zones",
+		DisplayName:   "List Shipping Zones",
+		Description:   "List the shipping zones of your store with their locations and shipping methods.",
+		Type:          core.ActionTypeAction,
+		Documentation: listShippingZonesDocs,
+		SampleOutput: []map[string]any{
+			{
+				"id":    1,
+				"name":  "United States",
+				"order": 0,
+				"locations": []map[string]any{
+					{"code": "US", "type": "country"},
+				},
+				"methods": []map[string]any{
+					{
+						"instance_id":  2,
+						"method_id":    "flat_rate",
+						"method_title": "Flat rate",
+						"enabled":      true,
+					},
+				},
+			},
+		},
+		Settings: core.ActionSettings{},
+	}
+}
+
+func (a *ListShippingZonesAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_shipping_zones", "List Shipping Zones")
+
+	form.CheckboxField("include_details", "Include Locations and Methods").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Fetch the locations and shipping methods of each zone.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *ListShippingZonesAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listShippingZonesActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := shared.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []map[string]interface{}
+	if err := creds.Request(http.MethodGet, "/shipping/zones", nil, &zones); err != nil {
+		return nil, err
+	}
+	if !input.IncludeDetails {
+		return zones, nil
+	}
+
+	for _, zone := range zones {
+		delete(zone, "_links")
+		id, _ := zone["id"].(float64)
+
+		var locations []map[string]interface{}
+		if err := creds.Request(http.MethodGet, fmt.Sprintf("/shipping/zones/%d/locations", int(id)), nil, &locations); err != nil {
+			return nil, err
+		}
+		var methods []map[string]interface{}
+		if err := creds.Request(http.MethodGet, fmt.Sprintf("/shipping/zones/%d/methods", int(id)), nil, &methods); err != nil {
+			return nil, err
+		}
+		for _, l := range locations {
+			delete(l, "_links")
+		}
+		for _, m := range methods {
+			delete(m, "_links")
+		}
+		zone["locations"] = locations
+		zone["methods"] = methods
+	}
+
+	return zones, nil
+}
+
+func (a *ListShippingZonesAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewListShippingZonesAction() sdk.Action {
+	return &ListShippingZonesAction{}
+}
@@ -0,0 +1,87 @@
+package actions
+
+import (
+	"github.com/hiscaler/woocommerce-go"
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type listVariationsActionProps struct {
+	ProductID string `json:"productId"`
+	SKU       string `json:"sku"`
+	Limit     int    `json:"limit"`
+}
+
+type ListVariationsAction struct{}
+
+func (a *ListVariationsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_variations",
+		DisplayName:   "List Variations",
+		Description:   "List the variations of a variable product.",
+		Type:          core.ActionTypeAction,
+		Documentation: listVariationsDocs,
+		SampleOutput:  []map[string]any{variationSample},
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *ListVariationsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_variations", "List Variations")
+
+	shared.GetProductsProp("productId", "Product", "The variable product.", true, form)
+
+	form.TextField("sku", "SKU").
+		Required(false).
+		HelpText("Only return the variation with this SKU.")
+
+	form.NumberField("limit", "Result Limit").
+		Required(false).
+		HelpText("Maximum number of variations to return, up to 100.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *ListVariationsAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listVariationsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productID, err := shared.ParseID("product ID", input.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	wooClient, err := shared.InitClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := woocommerce.ProductVariationsQueryParams{
+		SKU: input.SKU,
+	}
+	if input.Limit > 0 {
+		params.PerPage = min(input.Limit, 100)
+	}
+
+	variations, _, _, _, err := wooClient.Services.ProductVariation.All(productID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return variations, nil
+}
+
+func (a *ListVariationsAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewListVariationsAction() sdk.Action {
+	return &ListVariationsAction{}
+}
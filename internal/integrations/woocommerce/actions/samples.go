@@ -0,0 +1,40 @@
+package actions
+
+var orderSample = map[string]any{
+	"id":          727,
+	"number":      "727",
+	"status":      "processing",
+	"currency":    "USD",
+	"total":       "29.35",
+	"customer_id": 12,
+	"billing": map[string]any{
+		"first_name": "John",
+		"last_name":  "Doe",
+		"email":      "john.doe@example.com",
+		"country":    "US",
+	},
+	"line_items": []map[string]any{
+		{
+			"id":           315,
+			"product_id":   93,
+			"variation_id": 0,
+			"quantity":     2,
+			"total":        "21.98",
+		},
+	},
+	"date_created": "2025-03-12T18:20:00",
+}
+
+var variationSample = map[string]any{
+	"id":             101,
+	"sku":            "TSHIRT-BLUE-M",
+	"regular_price":  "19.99",
+	"sale_price":     "",
+	"stock_quantity": 42,
+	"stock_status":   "instock",
+	"manage_stock":   true,
+	"attributes": []map[string]any{
+		{"name": "Size", "option": "M"},
+		{"name": "Color", "option": "Blue"},
+	},
+}
@@ -0,0 +1,144 @@
+package actions
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateOrderActionProps struct {
+	OrderID        string `json:"order_id"`
+	Status         string `json:"status"`
+	CustomerNote   string `json:"customer_note"`
+	TransactionID  string `json:"transaction_id"`
+	SetPaid        bool   `json:"set_paid"`
+	Note           string `json:"note"`
+	NotifyCustomer bool   `json:"notify_customer"`
+}
+
+type UpdateOrderAction struct{}
+
+func (a *UpdateOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_order",
+		DisplayName:   "Update Order",
+		Description:   "Change the status, addresses or payment details of an order, optionally adding an order note.",
+		Type:          core.ActionTypeAction,
+		Documentation: updateOrderDocs,
+		SampleOutput:  orderSample,
+		Settings:      core.ActionSettings{},
+	}
+}
+
+func (a *UpdateOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_order", "Update Order")
+
+	shared.GetOrdersProp("order_id", "Order", "The order to update.", true, form)
+
+	form.SelectField("status", "Status").
+		Required(false).
+		AddOptions(shared.OrderStatuses...).
+		HelpText("Move the order to this status. Leave empty to keep the current status.")
+
+	shared.RegisterAddressProps(form, "billing", "Billing")
+	shared.RegisterAddressProps(form, "shipping", "Shipping")
+
+	form.TextareaField("customer_note", "Customer Note").
+		Required(false)
+
+	form.TextField("transaction_id", "Transaction ID").
+		Required(false).
+		HelpText("The payment transaction ID.")
+
+	form.CheckboxField("set_paid", "Mark as Paid").
+		Required(false).
+		DefaultValue(false)
+
+	form.TextareaField("note", "Order Note").
+		Required(false).
+		HelpText("A note added to the order after the update, for example the reason of a status change.")
+
+	form.CheckboxField("notify_customer", "Send Note to Customer").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Email the order note to the customer instead of keeping it private.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *UpdateOrderAction) Perform(ctx sdkcontext.PerformContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := shared.ParseID("order ID", input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := shared.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{}
+	if input.Status != "" {
+		body["status"] = input.Status
+	}
+	if billing := shared.AddressFrom(ctx.Input(), "billing"); billing != nil {
+		body["billing"] = billing
+	}
+	if shipping := shared.AddressFrom(ctx.Input(), "shipping"); shipping != nil {
+		body["shipping"] = shipping
+	}
+	if input.CustomerNote != "" {
+		body["customer_note"] = input.CustomerNote
+	}
+	if input.TransactionID != "" {
+		body["transaction_id"] = input.TransactionID
+	}
+	if input.SetPaid {
+		body["set_paid"] = true
+	}
+	if len(body) == 0 && input.Note == "" {
+		return nil, fmt.Errorf("nothing to update on order %d", orderID)
+	}
+
+	var order map[string]interface{}
+	if len(body) > 0 {
+		if err := creds.Request(http.MethodPut, fmt.Sprintf("/orders/%d", orderID), body, &order); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Note != "" {
+		note, err := addOrderNote(creds, orderID, input.Note, input.NotifyCustomer)
+		if err != nil {
+			return nil, err
+		}
+		if order == nil {
+			if err := creds.Request(http.MethodGet, fmt.Sprintf("/orders/%d", orderID), nil, &order); err != nil {
+				return nil, err
+			}
+		}
+		order["added_note"] = note
+	}
+
+	return order, nil
+}
+
+func (a *UpdateOrderAction) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func NewUpdateOrderAction() sdk.Action {
+	return &UpdateOrderAction{}
+}
@@ -36,6 +36,12 @@ func (n *WooCommerce) Triggers() []sdk.Trigger {
 		triggers.NewNewProductTrigger(),
 
 		triggers.NewNewOrderTrigger(),
+
+		triggers.NewOrderCreatedTrigger(),
+
+		triggers.NewOrderUpdatedTrigger(),
+
+		triggers.NewProductUpdatedTrigger(),
 	}
 }
 
@@ -58,6 +64,28 @@ func (n *WooCommerce) Actions() []sdk.Action {
 		actions.NewCreateProductAction(),
 
 		actions.NewCreateCustomerAction(),
+
+		actions.NewCreateOrderAction(),
+
+		actions.NewUpdateOrderAction(),
+
+		actions.NewAddOrderNoteAction(),
+
+		actions.NewCreateRefundAction(),
+
+		actions.NewBatchUpdateProductsAction(),
+
+		actions.NewListVariationsAction(),
+
+		actions.NewCreateVariationAction(),
+
+		actions.NewUpdateVariationAction(),
+
+		actions.NewDeleteVariationAction(),
+
+		actions.NewCreateCouponAction(),
+
+		actions.NewListShippingZonesAction(),
 	}
 }
 
@@ -0,0 +1,395 @@
+package shared
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxBatchSize is the most objects a WooCommerce batch request accepts.
+const maxBatchSize = 100
+
+// StockUpdate is a price and stock change for a product or variation,
+// found by ID or SKU.
+type StockUpdate struct {
+	ID            int      `json:"id,omitempty"`
+	ParentID      int      `json:"parent_id,omitempty"`
+	SKU           string   `json:"sku,omitempty"`
+	RegularPrice  *float64 `json:"regular_price,omitempty"`
+	SalePrice     *float64 `json:"sale_price,omitempty"`
+	StockQuantity *int     `json:"stock_quantity,omitempty"`
+	StockStatus   string   `json:"stock_status,omitempty"`
+}
+
+// ParseStockUpdates reads updates given as a JSON array, one JSON object
+// per line, or a list from a previous step. Numbers may be strings, as
+// spreadsheets and ERPs often export them.
+func ParseStockUpdates(v interface{}) ([]StockUpdate, error) {
+	var rows []map[string]interface{}
+	switch items := v.(type) {
+	case nil:
+		return nil, errors.New("updates are required")
+	case string:
+		text := strings.TrimSpace(items)
+		if strings.HasPrefix(text, "[") {
+			if err := json.Unmarshal([]byte(text), &rows); err != nil {
+				return nil, fmt.Errorf("updates must be a JSON array of objects: %w", err)
+			}
+			break
+		}
+		scanner := bufio.NewScanner(strings.NewReader(text))
+		for line := 1; scanner.Scan(); line++ {
+			row := strings.TrimSpace(scanner.Text())
+			if row == "" {
+				continue
+			}
+			var obj map[string]interface{}
+			if err := json.Unmarshal([]byte(row), &obj); err != nil {
+				return nil, fmt.Errorf("updates line %d is not a JSON object: %w", line, err)
+			}
+			rows = append(rows, obj)
+		}
+	default:
+		data, err := json.Marshal(items)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return nil, fmt.Errorf("updates must be a list of objects: %w", err)
+		}
+	}
+
+	updates := make([]StockUpdate, 0, len(rows))
+	for i, row := range rows {
+		u, err := stockUpdateFrom(row)
+		if err != nil {
+			return nil, fmt.Errorf("update %d: %w", i+1, err)
+		}
+		updates = append(updates, u)
+	}
+	if len(updates) == 0 {
+		return nil, errors.New("updates are required")
+	}
+	return updates, nil
+}
+
+func stockUpdateFrom(row map[string]interface{}) (StockUpdate, error) {
+	var u StockUpdate
+	var err error
+
+	if u.ID, err = intField(row, "id"); err != nil {
+		return u, err
+	}
+	if u.ParentID, err = intField(row, "parent_id"); err != nil {
+		return u, err
+	}
+	if u.ID == 0 {
+		// Variations may be given by their own field name too.
+		if u.ID, err = intField(row, "variation_id"); err != nil {
+			return u, err
+		}
+		if u.ID != 0 && u.ParentID == 0 {
+			if u.ParentID, err = intField(row, "product_id"); err != nil {
+				return u, err
+			}
+		}
+	}
+	if u.ID == 0 {
+		if u.ID, err = intField(row, "product_id"); err != nil {
+			return u, err
+		}
+	}
+	u.SKU = stringField(row, "sku")
+	u.StockStatus, _ = row["stock_status"].(string)
+
+	if u.RegularPrice, err = floatPtr(row, "regular_price"); err != nil {
+		return u, err
+	}
+	if u.SalePrice, err = floatPtr(row, "sale_price"); err != nil {
+		return u, err
+	}
+	for _, key := range []string{"stock_quantity", "stock", "quantity"} {
+		if _, ok := row[key]; !ok {
+			continue
+		}
+		n, err := intField(row, key)
+		if err != nil {
+			return u, err
+		}
+		u.StockQuantity = &n
+		break
+	}
+
+	if u.ID == 0 && u.SKU == "" {
+		return u, errors.New("an id or a sku is required")
+	}
+	if u.RegularPrice == nil && u.SalePrice == nil && u.StockQuantity == nil && u.StockStatus == "" {
+		return u, errors.New("nothing to update: set a price, stock_quantity or stock_status")
+	}
+	return u, nil
+}
+
+func stringField(row map[string]interface{}, key string) string {
+	switch v := row[key].(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+func intField(row map[string]interface{}, key string) (int, error) {
+	switch n := row[key].(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return int(n), nil
+	case string:
+		if strings.TrimSpace(n) == "" {
+			return 0, nil
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s must be a number", key)
+		}
+		return int(f), nil
+	default:
+		return 0, fmt.Errorf("%s must be a number", key)
+	}
+}
+
+func floatPtr(row map[string]interface{}, key string) (*float64, error) {
+	switch n := row[key].(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		return &n, nil
+	case string:
+		if strings.TrimSpace(n) == "" {
+			return nil, nil
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a number", key)
+		}
+		return &f, nil
+	default:
+		return nil, fmt.Errorf("%s must be a number", key)
+	}
+}
+
+// body returns the batch item of the update. WooCommerce takes prices as
+// strings, and stock is only kept when stock management is on.
+func (u StockUpdate) body() map[string]interface{} {
+	item := map[string]interface{}{"id": u.ID}
+	if u.RegularPrice != nil {
+		item["regular_price"] = strconv.FormatFloat(*u.RegularPrice, 'f', -1, 64)
+	}
+	if u.SalePrice != nil {
+		if *u.SalePrice == 0 {
+			item["sale_price"] = ""
+		} else {
+			item["sale_price"] = strconv.FormatFloat(*u.SalePrice, 'f', -1, 64)
+		}
+	}
+	if u.StockQuantity != nil {
+		item["manage_stock"] = true
+		item["stock_quantity"] = *u.StockQuantity
+	}
+	if u.StockStatus != "" {
+		item["stock_status"] = u.StockStatus
+	}
+	return item
+}
+
+// BatchResult reports the outcome of a batch update.
+type BatchResult struct {
+	Updated  []map[string]interface{} `json:"updated"`
+	Failed   []map[string]interface{} `json:"failed"`
+	NotFound []string                 `json:"not_found"`
+}
+
+type skuMatch struct {
+	ID            int         `json:"id"`
+	ParentID      int         `json:"parent_id"`
+	SKU           string      `json:"sku"`
+	ManageStock   interface{} `json:"manage_stock"`
+	StockQuantity *int        `json:"stock_quantity"`
+	RegularPrice  string      `json:"regular_price"`
+	Price         string      `json:"price"`
+}
+
+// findSKUs looks products and variations up by SKU. The products
+// endpoint returns variations too when filtering by SKU.
+func (c *Credentials) findSKUs(skus []string) (map[string]skuMatch, error) {
+	found := map[string]skuMatch{}
+	for start := 0; start < len(skus); start += maxBatchSize {
+		chunk := skus[start:min(start+maxBatchSize, len(skus))]
+		params := url.Values{}
+		params.Set("sku", strings.Join(chunk, ","))
+		params.Set("per_page", strconv.Itoa(maxBatchSize))
+
+		var matches []skuMatch
+		if err := c.Request(http.MethodGet, "/products?"+params.Encode(), nil, &matches); err != nil {
+			return nil, err
+		}
+		for _, m := range matches {
+			found[m.SKU] = m
+		}
+	}
+	return found, nil
+}
+
+// resolveSKUs fills in the IDs of updates given by SKU.
+func (c *Credentials) resolveSKUs(updates []StockUpdate) ([]StockUpdate, []string, error) {
+	var skus []string
+	for _, u := range updates {
+		if u.ID == 0 {
+			skus = append(skus, u.SKU)
+		}
+	}
+
+	found, err := c.findSKUs(skus)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolved := make([]StockUpdate, 0, len(updates))
+	var notFound []string
+	for _, u := range updates {
+		if u.ID == 0 {
+			m, ok := found[u.SKU]
+			if !ok {
+				notFound = append(notFound, u.SKU)
+				continue
+			}
+			u.ID, u.ParentID = m.ID, m.ParentID
+		}
+		resolved = append(resolved, u)
+	}
+	return resolved, notFound, nil
+}
+
+// StockLevel is the stock of a product or variation found by SKU.
+type StockLevel struct {
+	ID       int
+	ParentID int
+	Quantity int
+}
+
+// StockLevels returns the stock of the products and variations with the
+// given SKUs. SKUs that aren't found, or whose stock isn't managed, are
+// left out. A variation managing stock at the product level reports
+// "parent", so it is left out too.
+func (c *Credentials) StockLevels(skus []string) (map[string]StockLevel, error) {
+	found, err := c.findSKUs(skus)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := map[string]StockLevel{}
+	for sku, m := range found {
+		if managed, _ := m.ManageStock.(bool); !managed || m.StockQuantity == nil {
+			continue
+		}
+		levels[sku] = StockLevel{ID: m.ID, ParentID: m.ParentID, Quantity: *m.StockQuantity}
+	}
+	return levels, nil
+}
+
+// PriceLevel is the price of a product or variation found by SKU.
+type PriceLevel struct {
+	ID           int
+	ParentID     int
+	RegularPrice float64
+	// Price is the price charged, the sale price while a sale is on.
+	Price float64
+}
+
+// PriceLevels returns the prices of the products and variations with the
+// given SKUs. SKUs that aren't found are left out.
+func (c *Credentials) PriceLevels(skus []string) (map[string]PriceLevel, error) {
+	found, err := c.findSKUs(skus)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := map[string]PriceLevel{}
+	for sku, m := range found {
+		level := PriceLevel{ID: m.ID, ParentID: m.ParentID}
+		level.RegularPrice, _ = strconv.ParseFloat(m.RegularPrice, 64)
+		level.Price, _ = strconv.ParseFloat(m.Price, 64)
+		levels[sku] = level
+	}
+	return levels, nil
+}
+
+// BatchUpdateStock applies price and stock updates with the products and
+// variations batch endpoints, 100 objects per request.
+func (c *Credentials) BatchUpdateStock(updates []StockUpdate) (*BatchResult, error) {
+	resolved, notFound, err := c.resolveSKUs(updates)
+	if err != nil {
+		return nil, err
+	}
+
+	// Products are batched together; variations per parent product.
+	groups := map[int][]map[string]interface{}{}
+	for _, u := range resolved {
+		groups[u.ParentID] = append(groups[u.ParentID], u.body())
+	}
+	parents := make([]int, 0, len(groups))
+	for parent := range groups {
+		parents = append(parents, parent)
+	}
+	sort.Ints(parents)
+
+	result := &BatchResult{
+		Updated:  []map[string]interface{}{},
+		Failed:   []map[string]interface{}{},
+		NotFound: notFound,
+	}
+	if result.NotFound == nil {
+		result.NotFound = []string{}
+	}
+
+	for _, parent := range parents {
+		endpoint := "/products/batch"
+		if parent != 0 {
+			endpoint = fmt.Sprintf("/products/%d/variations/batch", parent)
+		}
+		items := groups[parent]
+		for start := 0; start < len(items); start += maxBatchSize {
+			var resp struct {
+				Update []map[string]interface{} `json:"update"`
+			}
+			body := map[string]interface{}{"update": items[start:min(start+maxBatchSize, len(items))]}
+			if err := c.Request(http.MethodPost, endpoint, body, &resp); err != nil {
+				return nil, err
+			}
+			for _, item := range resp.Update {
+				if _, failed := item["error"]; failed {
+					result.Failed = append(result.Failed, item)
+					continue
+				}
+				result.Updated = append(result.Updated, map[string]interface{}{
+					"id":             item["id"],
+					"parent_id":      parent,
+					"sku":            item["sku"],
+					"regular_price":  item["regular_price"],
+					"sale_price":     item["sale_price"],
+					"stock_quantity": item["stock_quantity"],
+					"stock_status":   item["stock_status"],
+				})
+			}
+		}
+	}
+	return result, nil
+}
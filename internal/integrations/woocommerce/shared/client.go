@@ -0,0 +1,107 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// Credentials are the store URL and REST API keys of a connection.
+type Credentials struct {
+	ShopURL        string
+	ConsumerKey    string
+	ConsumerSecret string
+}
+
+// CredentialsFrom reads the credentials from the values of a connection,
+// keyed by the fields of the auth form.
+func CredentialsFrom(values map[string]string) (*Credentials, error) {
+	creds := &Credentials{
+		ShopURL:        strings.TrimRight(values["shop-url"], "/"),
+		ConsumerKey:    values["consumer-key"],
+		ConsumerSecret: values["consumer-secret"],
+	}
+	if creds.ShopURL == "" || creds.ConsumerKey == "" || creds.ConsumerSecret == "" {
+		return nil, errors.New("missing WooCommerce authentication credentials")
+	}
+	return creds, nil
+}
+
+// GetCredentials returns the WooCommerce credentials of the connection.
+func GetCredentials(ctx sdkcontext.BaseContext) (*Credentials, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	if authCtx.Extra == nil {
+		return nil, errors.New("missing WooCommerce authentication credentials")
+	}
+	return CredentialsFrom(authCtx.Extra)
+}
+
+// Request calls the WooCommerce REST API (wc/v3) and decodes the JSON
+// response into out. It covers the endpoints woocommerce-go lacks or gets
+// wrong, such as batches, variation updates and webhooks.
+func (c *Credentials) Request(method, endpoint string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	u, err := url.Parse(c.ShopURL + "/wp-json/wc/v3" + endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid shop url: %v", err)
+	}
+	query := u.Query()
+	query.Set("consumer_key", c.ConsumerKey)
+	query.Set("consumer_secret", c.ConsumerSecret)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(method, u.String(), reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("woocommerce API error (status %d, %s): %s", resp.StatusCode, apiErr.Code, apiErr.Message)
+		}
+		return fmt.Errorf("woocommerce API error (status %d): %s", resp.StatusCode, string(data))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+// LineItem is an order line for a product or variation.
+type LineItem struct {
+	ProductID   int `json:"product_id"`
+	VariationID int `json:"variation_id,omitempty"`
+	Quantity    int `json:"quantity"`
+}
+
+// ParseLineItems reads order lines given as product_id:quantity entries
+// separated by commas or new lines, or as a list like
+// [{"product_id": 93, "variation_id": 0, "quantity": 2}].
+func ParseLineItems(v interface{}) ([]LineItem, error) {
+	var text string
+	switch items := v.(type) {
+	case nil:
+		return nil, errors.New("line items are required")
+	case string:
+		text = strings.TrimSpace(items)
+	default:
+		data, err := json.Marshal(items)
+		if err != nil {
+			return nil, err
+		}
+		text = string(data)
+	}
+
+	var lines []LineItem
+	if strings.HasPrefix(text, "[") {
+		if err := json.Unmarshal([]byte(text), &lines); err != nil {
+			return nil, fmt.Errorf(`line items must be product_id:quantity entries or a list like [{"product_id": 93, "quantity": 2}]: %w`, err)
+		}
+	} else {
+		for _, entry := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == '\n' }) {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			productID, quantity, _ := strings.Cut(entry, ":")
+			line := LineItem{Quantity: 1}
+			var err error
+			if line.ProductID, err = strconv.Atoi(strings.TrimSpace(productID)); err != nil {
+				return nil, fmt.Errorf("invalid product ID in line item %q", entry)
+			}
+			if quantity != "" {
+				if line.Quantity, err = strconv.Atoi(strings.TrimSpace(quantity)); err != nil {
+					return nil, fmt.Errorf("invalid quantity in line item %q", entry)
+				}
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil, errors.New("line items are required")
+	}
+	for i := range lines {
+		if lines[i].ProductID <= 0 {
+			return nil, errors.New("each line item needs a product_id")
+		}
+		if lines[i].Quantity <= 0 {
+			lines[i].Quantity = 1
+		}
+	}
+	return lines, nil
+}
+
+var addressFields = []struct {
+	key, label string
+}{
+	{"first_name", "First Name"},
+	{"last_name", "Last Name"},
+	{"company", "Company"},
+	{"address_1", "Address Line 1"},
+	{"address_2", "Address Line 2"},
+	{"city", "City"},
+	{"state", "State"},
+	{"postcode", "Postcode"},
+	{"country", "Country"},
+	{"email", "Email"},
+	{"phone", "Phone"},
+}
+
+// RegisterAddressProps adds the fields of a billing or shipping address,
+// named prefix_first_name, prefix_city and so on.
+func RegisterAddressProps(form *smartform.FormBuilder, prefix, label string) {
+	for _, f := range addressFields {
+		if prefix == "shipping" && f.key == "email" {
+			continue
+		}
+		field := form.TextField(prefix+"_"+f.key, label+" "+f.label).
+			Required(false)
+		if f.key == "country" {
+			field.Placeholder("US").
+				HelpText("Two-letter ISO country code.")
+		}
+	}
+}
+
+// AddressFrom reads the address registered under prefix. It returns nil
+// when no field is set.
+func AddressFrom(input map[string]interface{}, prefix string) map[string]interface{} {
+	address := map[string]interface{}{}
+	for _, f := range addressFields {
+		if s, ok := input[prefix+"_"+f.key].(string); ok && strings.TrimSpace(s) != "" {
+			address[f.key] = strings.TrimSpace(s)
+		}
+	}
+	if len(address) == 0 {
+		return nil
+	}
+	return address
+}
@@ -1,8 +1,9 @@
 package shared
 
 import (
-	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hiscaler/woocommerce-go"
 	"github.com/hiscaler/woocommerce-go/config"
@@ -49,24 +50,12 @@ func InitializeWooCommerceClient(baseURL, consumerKey, consumerSecret string) *w
 }
 
 func InitClient(ctx sdkcontext.BaseContext) (*woocommerce.WooCommerce, error) {
-	// Get the token source from the auth context
-	authCtx, err := ctx.AuthContext()
+	creds, err := GetCredentials(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if authCtx.Extra == nil {
-		return nil, errors.New("missing WooCommerce authentication credentials")
-	}
-
-	baseURL := authCtx.Extra["shop-url"]
-	consumerKey := authCtx.Extra["consumer-key"]
-	consumerSecret := authCtx.Extra["consumer-secret"]
-	if baseURL == "" || consumerKey == "" || consumerSecret == "" {
-		return nil, errors.New("missing WooCommerce authentication credentials")
-	}
-
-	wooClient := InitializeWooCommerceClient(baseURL, consumerKey, consumerSecret)
+	wooClient := InitializeWooCommerceClient(creds.ShopURL, creds.ConsumerKey, creds.ConsumerSecret)
 	return wooClient, nil
 }
 
@@ -202,3 +191,64 @@ func GetCouponsProp(id string, title, desc string, required bool, form *smartfor
 		).
 		HelpText(desc)
 }
+
+func GetOrdersProp(id string, title, desc string, required bool, form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getOrders := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		wooClient, err := InitClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		params := woocommerce.OrdersQueryParams{}
+
+		orders, _, _, _, err := wooClient.Services.Order.All(params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch WooCommerce orders: %v", err)
+		}
+
+		var options []map[string]interface{}
+
+		for _, order := range orders {
+			options = append(options, map[string]interface{}{
+				"id":   fmt.Sprintf("%d", order.ID),
+				"name": fmt.Sprintf("#%s %s %s (%s)", order.Number, order.Billing.FirstName, order.Billing.LastName, order.Status),
+			})
+		}
+
+		return ctx.Respond(options, len(options))
+	}
+
+	return form.SelectField(id, title).
+		Placeholder("Select order").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getOrders)).
+				WithSearchSupport().
+				WithPagination(10).
+				End().
+				GetDynamicSource(),
+		).
+		HelpText(desc)
+}
+
+// OrderStatuses are the order statuses of a stock WooCommerce store.
+var OrderStatuses = []*smartform.Option{
+	{Value: "pending", Label: "Pending Payment"},
+	{Value: "processing", Label: "Processing"},
+	{Value: "on-hold", Label: "On Hold"},
+	{Value: "completed", Label: "Completed"},
+	{Value: "cancelled", Label: "Cancelled"},
+	{Value: "refunded", Label: "Refunded"},
+	{Value: "failed", Label: "Failed"},
+}
+
+// ParseID converts an ID picked in a form to an int.
+func ParseID(name, value string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q", name, value)
+	}
+	return id, nil
+}
@@ -0,0 +1,137 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+// VariationFields are the editable fields of a product variation.
+type VariationFields struct {
+	SKU           string   `json:"sku"`
+	RegularPrice  *float64 `json:"regular_price"`
+	SalePrice     *float64 `json:"sale_price"`
+	StockQuantity *int     `json:"stock_quantity"`
+	StockStatus   string   `json:"stock_status"`
+	Status        string   `json:"status"`
+	Description   string   `json:"description"`
+	Weight        string   `json:"weight"`
+	ImageURL      string   `json:"image_url"`
+	Attributes    string   `json:"attributes"`
+}
+
+// RegisterVariationProps adds the variation fields shared by the create
+// and update actions.
+func RegisterVariationProps(form *smartform.FormBuilder, create bool) {
+	form.TextareaField("attributes", "Attributes").
+		Placeholder("Size=M, Color=Blue").
+		Required(create).
+		HelpText(`The attribute options of the variation, as name=option pairs or a list like [{"name": "Size", "option": "M"}].`)
+
+	form.TextField("sku", "SKU").
+		Required(false)
+
+	form.NumberField("regular_price", "Regular Price").
+		Required(false)
+
+	form.NumberField("sale_price", "Sale Price").
+		Required(false).
+		HelpText("Set to 0 to remove the sale price.")
+
+	form.NumberField("stock_quantity", "Stock Quantity").
+		Required(false).
+		HelpText("Setting a quantity turns on stock management for the variation.")
+
+	form.SelectField("stock_status", "Stock Status").
+		Required(false).
+		AddOption("instock", "In Stock").
+		AddOption("outofstock", "Out of Stock").
+		AddOption("onbackorder", "On Backorder")
+
+	form.SelectField("status", "Status").
+		Required(false).
+		AddOption("publish", "Published").
+		AddOption("private", "Private").
+		AddOption("draft", "Draft")
+
+	form.TextareaField("description", "Description").
+		Required(false)
+
+	form.TextField("weight", "Weight").
+		Required(false)
+
+	form.TextField("image_url", "Image URL").
+		Required(false)
+}
+
+// Body returns the request body of the set fields.
+func (f VariationFields) Body() (map[string]interface{}, error) {
+	body := map[string]interface{}{}
+	if f.SKU != "" {
+		body["sku"] = f.SKU
+	}
+	if f.RegularPrice != nil {
+		body["regular_price"] = strconv.FormatFloat(*f.RegularPrice, 'f', -1, 64)
+	}
+	if f.SalePrice != nil {
+		if *f.SalePrice == 0 {
+			body["sale_price"] = ""
+		} else {
+			body["sale_price"] = strconv.FormatFloat(*f.SalePrice, 'f', -1, 64)
+		}
+	}
+	if f.StockQuantity != nil {
+		body["manage_stock"] = true
+		body["stock_quantity"] = *f.StockQuantity
+	}
+	if f.StockStatus != "" {
+		body["stock_status"] = f.StockStatus
+	}
+	if f.Status != "" {
+		body["status"] = f.Status
+	}
+	if f.Description != "" {
+		body["description"] = f.Description
+	}
+	if f.Weight != "" {
+		body["weight"] = f.Weight
+	}
+	if f.ImageURL != "" {
+		body["image"] = map[string]string{"src": f.ImageURL}
+	}
+	if strings.TrimSpace(f.Attributes) != "" {
+		attributes, err := ParseAttributes(f.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		body["attributes"] = attributes
+	}
+	return body, nil
+}
+
+// ParseAttributes reads variation attributes given as name=option pairs
+// separated by commas or new lines, or as a JSON list.
+func ParseAttributes(s string) ([]map[string]interface{}, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") {
+		var attributes []map[string]interface{}
+		if err := json.Unmarshal([]byte(s), &attributes); err != nil {
+			return nil, fmt.Errorf(`attributes must be name=option pairs or a list like [{"name": "Size", "option": "M"}]: %w`, err)
+		}
+		return attributes, nil
+	}
+
+	var attributes []map[string]interface{}
+	for _, pair := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '\n' }) {
+		name, option, ok := strings.Cut(pair, "=")
+		name, option = strings.TrimSpace(name), strings.TrimSpace(option)
+		if !ok || name == "" || option == "" {
+			return nil, fmt.Errorf("invalid attribute %q, expected name=option", strings.TrimSpace(pair))
+		}
+		attributes = append(attributes, map[string]interface{}{"name": name, "option": option})
+	}
+	return attributes, nil
+}
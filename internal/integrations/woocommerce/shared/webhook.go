@@ -0,0 +1,97 @@
+package shared
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/wakflo/extensions/internal/webhook"
+)
+
+// SignatureHeader is the header WooCommerce signs webhook deliveries with.
+const SignatureHeader = "X-WC-Webhook-Signature"
+
+const topicHeader = "X-WC-Webhook-Topic"
+
+// Webhook is a WooCommerce webhook subscription.
+type Webhook struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Topic       string `json:"topic"`
+	DeliveryURL string `json:"delivery_url"`
+}
+
+// CredentialsFromConfig reads the connection credentials from a trigger
+// config.
+func CredentialsFromConfig(config map[string]interface{}) (*Credentials, error) {
+	return CredentialsFrom(webhook.Connection(config))
+}
+
+// WebhookSecret derives the signing secret of a trigger's webhook from
+// the consumer secret, so deliveries can be verified without storing it.
+func (c *Credentials) WebhookSecret(triggerID string) string {
+	mac := hmac.New(sha256.New, []byte(c.ConsumerSecret))
+	mac.Write([]byte("wakflo-webhook:" + triggerID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// CreateWebhook subscribes deliveryURL to a topic such as order.created.
+func (c *Credentials) CreateWebhook(name, topic, deliveryURL, secret string) (*Webhook, error) {
+	body := map[string]interface{}{
+		"name":         name,
+		"topic":        topic,
+		"delivery_url": deliveryURL,
+		"secret":       secret,
+		"status":       "active",
+	}
+
+	var webhook Webhook
+	if err := c.Request(http.MethodPost, "/webhooks", body, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// DeleteWebhook removes a webhook subscription.
+func (c *Credentials) DeleteWebhook(id int) error {
+	return c.Request(http.MethodDelete, fmt.Sprintf("/webhooks/%d?force=true", id), nil, nil)
+}
+
+// VerifySignature checks the base64 HMAC-SHA256 signature WooCommerce
+// computes over the raw delivery body.
+func VerifySignature(secret string, body []byte, signature string) error {
+	if signature == "" {
+		return errors.New("missing " + SignatureHeader + " header")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errors.New("invalid WooCommerce webhook signature")
+	}
+	return nil
+}
+
+// IsPing reports whether the delivery is the ping WooCommerce sends when
+// a webhook is created. Pings carry no topic and no signature.
+func IsPing(d webhook.Delivery) bool {
+	return d.Header(topicHeader) == "" && strings.HasPrefix(string(d.Body), "webhook_id=")
+}
+
+// VerifiedPayload verifies a delivery and decodes its JSON body.
+func VerifiedPayload(secret string, d webhook.Delivery) (map[string]interface{}, error) {
+	if err := VerifySignature(secret, d.Body, d.Header(SignatureHeader)); err != nil {
+		return nil, err
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(d.Body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %v", err)
+	}
+	return payload, nil
+}
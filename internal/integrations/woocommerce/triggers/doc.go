@@ -7,3 +7,12 @@ var newOrderDocs string
 
 //go:embed new_product.md
 var newProductDocs string
+
+//go:embed order_created.md
+var orderCreatedDocs string
+
+//go:embed order_updated.md
+var orderUpdatedDocs string
+
+//go:embed product_updated.md
+var productUpdatedDocs string
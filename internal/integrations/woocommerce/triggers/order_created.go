@@ -0,0 +1,51 @@
+package triggers
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type OrderCreatedTrigger struct {
+	webhookTrigger
+}
+
+func (t *OrderCreatedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "order_created",
+		DisplayName:   "Order Created (Instant)",
+		Description:   "Triggered instantly by a WooCommerce webhook when an order is created.",
+		Type:          core.TriggerTypeWebhook,
+		Documentation: orderCreatedDocs,
+		SampleOutput:  []map[string]any{orderWebhookSample},
+	}
+}
+
+func (t *OrderCreatedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("order-created", "Order Created (Instant)")
+
+	schema := form.Build()
+	return schema
+}
+
+func (t *OrderCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	order, err := t.payload(ctx)
+	if err != nil || order == nil {
+		return []map[string]interface{}{}, err
+	}
+
+	return []map[string]interface{}{order}, nil
+}
+
+func (t *OrderCreatedTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return t.criteria()
+}
+
+func NewOrderCreatedTrigger() sdk.Trigger {
+	return &OrderCreatedTrigger{
+		webhookTrigger: webhookTrigger{name: "Order Created", topic: "order.created"},
+	}
+}
@@ -0,0 +1,72 @@
+package triggers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type orderUpdatedTriggerProps struct {
+	Statuses []string `json:"statuses"`
+}
+
+type OrderUpdatedTrigger struct {
+	webhookTrigger
+}
+
+func (t *OrderUpdatedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "order_updated",
+		DisplayName:   "Order Updated (Instant)",
+		Description:   "Triggered instantly by a WooCommerce webhook when an order changes, optionally only for some statuses.",
+		Type:          core.TriggerTypeWebhook,
+		Documentation: orderUpdatedDocs,
+		SampleOutput:  []map[string]any{orderWebhookSample},
+	}
+}
+
+func (t *OrderUpdatedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("order-updated", "Order Updated (Instant)")
+
+	form.MultiSelectField("statuses", "Statuses").
+		Required(false).
+		AddOptions(shared.OrderStatuses...).
+		HelpText("Only trigger for orders in one of these statuses. Leave empty for every update.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (t *OrderUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	input, err := sdk.InputToTypeSafely[orderUpdatedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := t.payload(ctx)
+	if err != nil || order == nil {
+		return []map[string]interface{}{}, err
+	}
+
+	status, _ := order["status"].(string)
+	if len(input.Statuses) > 0 && !slices.Contains(input.Statuses, status) {
+		return []map[string]interface{}{}, nil
+	}
+
+	return []map[string]interface{}{order}, nil
+}
+
+func (t *OrderUpdatedTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return t.criteria()
+}
+
+func NewOrderUpdatedTrigger() sdk.Trigger {
+	return &OrderUpdatedTrigger{
+		webhookTrigger: webhookTrigger{name: "Order Updated", topic: "order.updated"},
+	}
+}
@@ -0,0 +1,62 @@
+package triggers
+
+import (
+	"context"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type ProductUpdatedTrigger struct {
+	webhookTrigger
+}
+
+func (t *ProductUpdatedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "product_updated",
+		DisplayName:   "Product Updated (Instant)",
+		Description:   "Triggered instantly by a WooCommerce webhook when a product, its price or its stock changes.",
+		Type:          core.TriggerTypeWebhook,
+		Documentation: productUpdatedDocs,
+		SampleOutput: []map[string]any{
+			{
+				"id":             93,
+				"name":           "Ceramic Mug",
+				"sku":            "MUG-001",
+				"type":           "simple",
+				"regular_price":  "12.50",
+				"stock_quantity": 40,
+				"stock_status":   "instock",
+				"date_modified":  "2025-03-12T18:20:00",
+			},
+		},
+	}
+}
+
+func (t *ProductUpdatedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("product-updated", "Product Updated (Instant)")
+
+	schema := form.Build()
+	return schema
+}
+
+func (t *ProductUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (core.JSON, error) {
+	product, err := t.payload(ctx)
+	if err != nil || product == nil {
+		return []map[string]interface{}{}, err
+	}
+
+	return []map[string]interface{}{product}, nil
+}
+
+func (t *ProductUpdatedTrigger) Criteria(ctx context.Context) core.TriggerCriteria {
+	return t.criteria()
+}
+
+func NewProductUpdatedTrigger() sdk.Trigger {
+	return &ProductUpdatedTrigger{
+		webhookTrigger: webhookTrigger{name: "Product Updated", topic: "product.updated"},
+	}
+}
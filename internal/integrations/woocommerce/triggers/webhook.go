@@ -0,0 +1,120 @@
+package triggers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wakflo/extensions/internal/integrations/woocommerce/shared"
+	"github.com/wakflo/extensions/internal/webhook"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+const webhookIDKey = "webhookId"
+
+// webhookTrigger registers a WooCommerce webhook for a topic while the
+// trigger is active and verifies the signature of every delivery.
+type webhookTrigger struct {
+	name  string
+	topic string
+}
+
+func (w webhookTrigger) GetType() core.TriggerType {
+	return core.TriggerTypeWebhook
+}
+
+func (w webhookTrigger) Auth() *core.AuthMetadata {
+	return nil
+}
+
+func (w webhookTrigger) criteria() core.TriggerCriteria {
+	webhook := core.NewWebhookTriggerCriteria()
+	webhook.Enabled = true
+	webhook.ContentType = "application/json"
+	return core.TriggerCriteria{Webhook: webhook}
+}
+
+// Start subscribes the trigger's endpoint to the topic. The webhook
+// secret is derived from the connection, so it is never stored.
+func (w webhookTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	creds, err := shared.CredentialsFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+
+	criteria, err := ctx.TriggerCriteria()
+	if err != nil {
+		return err
+	}
+	if criteria == nil || criteria.Webhook == nil || criteria.Webhook.Endpoint == "" {
+		return errors.New("the trigger has no webhook endpoint")
+	}
+
+	name := fmt.Sprintf("Wakflo: %s (%s)", w.name, ctx.TriggerID())
+	webhook, err := creds.CreateWebhook(name, w.topic, criteria.Webhook.Endpoint, creds.WebhookSecret(ctx.TriggerID()))
+	if err != nil {
+		return fmt.Errorf("failed to register the WooCommerce webhook: %w", err)
+	}
+
+	return ctx.StoreMetadata(webhookIDKey, webhook.ID)
+}
+
+// Stop removes the webhook registered by Start.
+func (w webhookTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	stored, err := ctx.GetMetadata(webhookIDKey)
+	if err != nil || stored == nil {
+		return err
+	}
+
+	var id int
+	switch v := stored.(type) {
+	case int:
+		id = v
+	case float64:
+		id = int(v)
+	default:
+		return fmt.Errorf("invalid stored webhook id %v", stored)
+	}
+
+	creds, err := shared.CredentialsFromConfig(ctx.Config())
+	if err != nil {
+		return err
+	}
+	return creds.DeleteWebhook(id)
+}
+
+// payload verifies the delivery and returns its body. It returns nil for
+// the ping WooCommerce sends when the webhook is created.
+func (w webhookTrigger) payload(ctx sdkcontext.ExecuteContext) (map[string]interface{}, error) {
+	delivery, err := webhook.DeliveryFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+	if shared.IsPing(delivery) {
+		return nil, nil
+	}
+
+	creds, err := shared.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.VerifiedPayload(creds.WebhookSecret(ctx.TriggerID()), delivery)
+}
+
+var orderWebhookSample = map[string]any{
+	"id":       727,
+	"number":   "727",
+	"status":   "processing",
+	"currency": "USD",
+	"total":    "29.35",
+	"billing": map[string]any{
+		"first_name": "John",
+		"last_name":  "Doe",
+		"email":      "john.doe@example.com",
+	},
+	"line_items": []map[string]any{
+		{"id": 315, "product_id": 93, "quantity": 2, "total": "21.98"},
+	},
+	"date_modified": "2025-03-12T18:20:00",
+}
@@ -0,0 +1,154 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createBankTransactionActionProps struct {
+	TenantID        string                 `json:"tenant_id"`
+	Type            string                 `json:"type"`
+	ContactID       string                 `json:"contact_id"`
+	BankAccountCode string                 `json:"bank_account_code"`
+	Date            string                 `json:"date"`
+	Reference       string                 `json:"reference"`
+	Currency        string                 `json:"currency"`
+	LineAmountTypes string                 `json:"line_amount_types"`
+	Reconciled      bool                   `json:"is_reconciled"`
+	LineItems       []shared.LineItemInput `json:"line_items"`
+}
+
+type CreateBankTransactionAction struct{}
+
+func (a *CreateBankTransactionAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_bank_transaction",
+		DisplayName:   "Create Bank Transaction",
+		Description:   "Record money received into or spent from a bank account, such as payouts, fees or expenses not tied to an invoice.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createBankTransactionDocs,
+		SampleOutput: map[string]any{
+			"BankTransactionID": "d20b6c54-7f5d-4ce6-ab83-55f609719126",
+			"Type":              "SPEND",
+			"Status":            "AUTHORISED",
+			"Reference":         "Stripe fees March",
+			"Total":             "12.30",
+			"IsReconciled":      false,
+			"BankAccount":       map[string]any{"Code": "090", "Name": "Business Bank Account"},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreateBankTransactionAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_bank_transaction", "Create Bank Transaction")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	form.SelectField("type", "Type").
+		Required(true).
+		DefaultValue("RECEIVE").
+		AddOption("RECEIVE", "Receive money").
+		AddOption("SPEND", "Spend money")
+
+	shared.GetContactProp("contact_id", "Contact", "Who the money came from or went to.", true, form)
+
+	form.TextField("bank_account_code", "Bank Account Code").
+		Required(true).
+		HelpText("The code of the bank account, for example 090.")
+
+	form.DateField("date", "Date").
+		Required(false).
+		HelpText("Defaults to today.")
+
+	form.TextField("reference", "Reference").
+		Required(false)
+
+	form.TextField("currency", "Currency").
+		Required(false).
+		Placeholder("USD").
+		HelpText("Defaults to the bank account's currency.")
+
+	form.SelectField("line_amount_types", "Line Amounts Are").
+		Required(false).
+		DefaultValue("Inclusive").
+		AddOptions(shared.LineAmountTypes...)
+
+	form.CheckboxField("is_reconciled", "Mark as Reconciled").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Mark the transaction reconciled, for imports of statement lines already matched elsewhere.")
+
+	shared.RegisterLineItemsProp(form, true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateBankTransactionAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createBankTransactionActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ContactID == "" || input.BankAccountCode == "" {
+		return nil, errors.New("a contact and a bank account code are required")
+	}
+
+	lines, err := shared.LineItems(input.LineItems)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	date := input.Date
+	if date == "" {
+		date = time.Now().UTC().Format("2006-01-02")
+	}
+
+	transaction := map[string]interface{}{
+		"Type":         input.Type,
+		"Contact":      map[string]interface{}{"ContactID": input.ContactID},
+		"BankAccount":  map[string]interface{}{"Code": input.BankAccountCode},
+		"Date":         date,
+		"LineItems":    lines,
+		"IsReconciled": input.Reconciled,
+	}
+	if input.Reference != "" {
+		transaction["Reference"] = input.Reference
+	}
+	if input.Currency != "" {
+		transaction["CurrencyCode"] = input.Currency
+	}
+	if input.LineAmountTypes != "" {
+		transaction["LineAmountTypes"] = input.LineAmountTypes
+	}
+
+	var resp map[string]interface{}
+	body := map[string]interface{}{"BankTransactions": []map[string]interface{}{transaction}}
+	if err := client.Do(http.MethodPut, "/BankTransactions", body, &resp); err != nil {
+		return nil, err
+	}
+
+	return shared.First(resp, "BankTransactions"), nil
+}
+
+func (a *CreateBankTransactionAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreateBankTransactionAction() sdk.Action {
+	return &CreateBankTransactionAction{}
+}
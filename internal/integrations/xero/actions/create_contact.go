@@ -0,0 +1,79 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createContactActionProps struct {
+	TenantID string `json:"tenant_id"`
+	shared.ContactFields
+}
+
+type CreateContactAction struct{}
+
+func (a *CreateContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_contact",
+		DisplayName:   "Create Contact",
+		Description:   "Create a customer or supplier contact in a Xero organization.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createContactDocs,
+		SampleOutput:  contactSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreateContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_contact", "Create Contact")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	shared.RegisterContactProps(form, true)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateContactAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createContactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.New("contact name is required")
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"Contacts": []map[string]interface{}{input.ContactFields.Body()},
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPut, "/Contacts", body, &resp); err != nil {
+		return nil, err
+	}
+
+	return shared.First(resp, "Contacts"), nil
+}
+
+func (a *CreateContactAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreateContactAction() sdk.Action {
+	return &CreateContactAction{}
+}
@@ -0,0 +1,217 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createCreditNoteActionProps struct {
+	TenantID         string                 `json:"tenant_id"`
+	Type             string                 `json:"type"`
+	ContactID        string                 `json:"contact_id"`
+	Date             string                 `json:"date"`
+	Reference        string                 `json:"reference"`
+	Currency         string                 `json:"currency"`
+	LineAmountTypes  string                 `json:"line_amount_types"`
+	Status           string                 `json:"status"`
+	LineItems        []shared.LineItemInput `json:"line_items"`
+	AllocateInvoice  string                 `json:"allocate_invoice_id"`
+	AllocationAmount float64                `json:"allocation_amount"`
+}
+
+type CreateCreditNoteAction struct{}
+
+func (a *CreateCreditNoteAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_credit_note",
+		DisplayName:   "Create Credit Note",
+		Description:   "Create a sales or purchase credit note, and optionally allocate it to an invoice.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createCreditNoteDocs,
+		SampleOutput: map[string]any{
+			"CreditNoteID":     "aea95d78-ea48-456b-9b08-6bc012600072",
+			"CreditNoteNumber": "CN-0012",
+			"Type":             "ACCRECCREDIT",
+			"Status":           "AUTHORISED",
+			"Total":            "10.00",
+			"RemainingCredit":  "0.00",
+			"Allocations": []map[string]any{
+				{"Amount": "10.00", "Invoice": map[string]any{"InvoiceID": "243216c5-369e-4056-ac67-05388f86dc81"}},
+			},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreateCreditNoteAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_credit_note", "Create Credit Note")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	form.SelectField("type", "Type").
+		Required(true).
+		DefaultValue("ACCRECCREDIT").
+		AddOption("ACCRECCREDIT", "Sales credit note (to a customer)").
+		AddOption("ACCPAYCREDIT", "Purchase credit note (from a supplier)")
+
+	shared.GetContactProp("contact_id", "Contact", "The customer or supplier credited.", true, form)
+
+	form.DateField("date", "Date").
+		Required(false).
+		HelpText("Defaults to today.")
+
+	form.TextField("reference", "Reference").
+		Required(false)
+
+	form.TextField("currency", "Currency").
+		Required(false).
+		Placeholder("USD").
+		HelpText("Defaults to the organization's base currency.")
+
+	form.SelectField("line_amount_types", "Line Amounts Are").
+		Required(false).
+		DefaultValue("Exclusive").
+		AddOptions(shared.LineAmountTypes...)
+
+	form.SelectField("status", "Status").
+		Required(false).
+		DefaultValue("AUTHORISED").
+		AddOption("DRAFT", "Draft").
+		AddOption("SUBMITTED", "Submitted").
+		AddOption("AUTHORISED", "Authorised")
+
+	shared.RegisterLineItemsProp(form, true)
+
+	shared.GetInvoiceProp("allocate_invoice_id", "Allocate to Invoice", "Apply the credit to this invoice. The credit note must be authorised.", false, form)
+
+	form.NumberField("allocation_amount", "Allocation Amount").
+		Required(false).
+		HelpText("Leave empty to allocate as much of the credit as the invoice has due.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateCreditNoteAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createCreditNoteActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ContactID == "" {
+		return nil, errors.New("contact is required")
+	}
+	if input.AllocateInvoice != "" && input.Status != "" && input.Status != "AUTHORISED" {
+		return nil, errors.New("only authorised credit notes can be allocated to an invoice")
+	}
+
+	lines, err := shared.LineItems(input.LineItems)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	date := input.Date
+	if date == "" {
+		date = time.Now().UTC().Format("2006-01-02")
+	}
+	status := input.Status
+	if status == "" {
+		status = "AUTHORISED"
+	}
+
+	note := map[string]interface{}{
+		"Type":      input.Type,
+		"Contact":   map[string]interface{}{"ContactID": input.ContactID},
+		"Date":      date,
+		"Status":    status,
+		"LineItems": lines,
+	}
+	if input.Reference != "" {
+		note["Reference"] = input.Reference
+	}
+	if input.Currency != "" {
+		note["CurrencyCode"] = input.Currency
+	}
+	if input.LineAmountTypes != "" {
+		note["LineAmountTypes"] = input.LineAmountTypes
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPut, "/CreditNotes", map[string]interface{}{"CreditNotes": []map[string]interface{}{note}}, &resp); err != nil {
+		return nil, err
+	}
+	created := shared.First(resp, "CreditNotes")
+	if input.AllocateInvoice == "" || created == nil {
+		return created, nil
+	}
+
+	id, _ := created["CreditNoteID"].(string)
+	amount := input.AllocationAmount
+	if amount == 0 {
+		amount, err = allocatableAmount(client, created, input.AllocateInvoice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	allocation := map[string]interface{}{
+		"Allocations": []map[string]interface{}{
+			{
+				"Invoice": map[string]interface{}{"InvoiceID": input.AllocateInvoice},
+				"Amount":  amount,
+				"Date":    date,
+			},
+		},
+	}
+	if err := client.Do(http.MethodPut, "/CreditNotes/"+id+"/Allocations", allocation, nil); err != nil {
+		return nil, fmt.Errorf("the credit note %s was created but not allocated: %w", id, err)
+	}
+
+	if err := client.Do(http.MethodGet, "/CreditNotes/"+id, nil, &resp); err != nil {
+		return nil, err
+	}
+	return shared.First(resp, "CreditNotes"), nil
+}
+
+// allocatableAmount returns the part of the credit the invoice can take.
+func allocatableAmount(client *shared.Client, note map[string]interface{}, invoiceID string) (float64, error) {
+	var resp struct {
+		Invoices []struct {
+			AmountDue float64 `json:"AmountDue"`
+		} `json:"Invoices"`
+	}
+	if err := client.Do(http.MethodGet, "/Invoices/"+invoiceID, nil, &resp); err != nil {
+		return 0, err
+	}
+	if len(resp.Invoices) == 0 {
+		return 0, errors.New("invoice not found")
+	}
+
+	credit, _ := note["RemainingCredit"].(float64)
+	if total, ok := note["Total"].(float64); ok && credit == 0 {
+		credit = total
+	}
+	return min(credit, resp.Invoices[0].AmountDue), nil
+}
+
+func (a *CreateCreditNoteAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreateCreditNoteAction() sdk.Action {
+	return &CreateCreditNoteAction{}
+}
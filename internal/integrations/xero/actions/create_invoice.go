@@ -43,7 +43,7 @@ func (a *CreateInvoiceAction) Metadata() sdk.ActionMetadata {
 func (a *CreateInvoiceAction) Properties() *smartform.FormSchema {
 	form := smartform.NewForm("create_invoice", "Create Invoice")
 
-	shared.GetInvoiceProp("tenant_id", "Organization", "select organization", true, form)
+	shared.GetTenantProps("tenant_id", "Organization", "select organization", true, form)
 
 	form.TextField("contact-id", "Contact ID").
 		Placeholder("Contact ID").
@@ -0,0 +1,233 @@
+package actions
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createInvoiceFromOrderActionProps struct {
+	TenantID            string      `json:"tenant_id"`
+	Source              string      `json:"source"`
+	Order               interface{} `json:"order"`
+	ContactID           string      `json:"contact_id"`
+	AccountCode         string      `json:"account_code"`
+	ShippingAccountCode string      `json:"shipping_account_code"`
+	RoundingAccountCode string      `json:"rounding_account_code"`
+	TaxType             string      `json:"tax_type"`
+	ExemptTaxType       string      `json:"exempt_tax_type"`
+	Status              string      `json:"status"`
+	UseItemCodes        bool        `json:"use_item_codes"`
+	PaymentAccountCode  string      `json:"payment_account_code"`
+	SkipExisting        bool        `json:"skip_existing"`
+}
+
+type CreateInvoiceFromOrderAction struct{}
+
+func (a *CreateInvoiceFromOrderAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_invoice_from_order",
+		DisplayName:   "Create Invoice from Order",
+		Description:   "Book a Shopify order or a Stripe invoice or checkout session as a Xero invoice, with its tax lines, discounts and shipping, and record the payment when it was paid.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createInvoiceFromOrderDocs,
+		SampleOutput: map[string]any{
+			"invoice":  invoiceSample,
+			"payment":  map[string]any{"PaymentID": "b26fd49a-cbae-470a-a8f8-bcbc119e0379", "Amount": 44.00},
+			"existing": false,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreateInvoiceFromOrderAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_invoice_from_order", "Create Invoice from Order")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	form.SelectField("source", "Source").
+		Required(false).
+		AddOption("shopify", "Shopify order").
+		AddOption("stripe", "Stripe invoice or checkout session").
+		HelpText("Leave empty to tell from the order.")
+
+	form.TextareaField("order", "Order").
+		Required(true).
+		HelpText("The order object from a Shopify or Stripe step. Checkout sessions need their line items expanded.")
+
+	shared.GetContactProp("contact_id", "Contact", "Book the invoice to this contact. Leave empty to use the contact with the order email, or create one from the customer name.", false, form)
+
+	form.TextField("account_code", "Sales Account Code").
+		Required(true).
+		DefaultValue("200").
+		HelpText("The revenue account of the product lines.")
+
+	form.TextField("shipping_account_code", "Shipping Account Code").
+		Required(false).
+		HelpText("The account of shipping lines. Defaults to the sales account.")
+
+	form.TextField("rounding_account_code", "Rounding Account Code").
+		Required(false).
+		DefaultValue("860").
+		HelpText("Where differences of a cent or so between the order total and Xero's rounding go.")
+
+	form.TextField("tax_type", "Tax Type").
+		Required(false).
+		HelpText("The tax rate of taxed lines, for example OUTPUT2. Leave empty for the account's default.")
+
+	form.TextField("exempt_tax_type", "Tax Type for Untaxed Lines").
+		Required(false).
+		HelpText("The tax rate of lines without tax, for example NONE or EXEMPTOUTPUT.")
+
+	form.SelectField("status", "Status").
+		Required(false).
+		DefaultValue("AUTHORISED").
+		AddOption("DRAFT", "Draft").
+		AddOption("SUBMITTED", "Submitted").
+		AddOption("AUTHORISED", "Authorised")
+
+	form.CheckboxField("use_item_codes", "Use Item Codes").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Set the SKU of each line as its Xero item code. The items must exist in Xero.")
+
+	form.TextField("payment_account_code", "Payment Bank Account Code").
+		Required(false).
+		HelpText("When set and the order is paid, record the payment into this bank account, such as a Shopify Payments or Stripe clearing account.")
+
+	form.CheckboxField("skip_existing", "Skip Existing").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Return the invoice already booked with the order number as reference instead of creating another.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateInvoiceFromOrderAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createInvoiceFromOrderActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := shared.ParseOrder(input.Source, input.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.SkipExisting && order.Number != "" {
+		existing, err := findInvoiceByReference(client, order.Number)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return map[string]interface{}{"invoice": existing, "existing": true}, nil
+		}
+	}
+
+	contactID := input.ContactID
+	if contactID == "" && order.Email != "" {
+		if contactID, err = findContactByEmail(client, order.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	invoice, err := order.Invoice(shared.InvoiceOptions{
+		ContactID:           contactID,
+		AccountCode:         input.AccountCode,
+		ShippingAccountCode: input.ShippingAccountCode,
+		RoundingAccountCode: input.RoundingAccountCode,
+		TaxType:             input.TaxType,
+		ExemptTaxType:       input.ExemptTaxType,
+		Status:              input.Status,
+		UseItemCodes:        input.UseItemCodes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPut, "/Invoices", map[string]interface{}{"Invoices": []map[string]interface{}{invoice}}, &resp); err != nil {
+		return nil, err
+	}
+	created := shared.First(resp, "Invoices")
+	result := map[string]interface{}{"invoice": created, "existing": false}
+
+	if input.PaymentAccountCode == "" || !order.Paid || created == nil || created["Status"] != "AUTHORISED" {
+		return result, nil
+	}
+
+	invoiceID, _ := created["InvoiceID"].(string)
+	amountDue, _ := created["AmountDue"].(float64)
+	if amountDue <= 0 {
+		return result, nil
+	}
+	payment := map[string]interface{}{
+		"Invoice":   map[string]interface{}{"InvoiceID": invoiceID},
+		"Account":   map[string]interface{}{"Code": input.PaymentAccountCode},
+		"Date":      invoice["Date"],
+		"Amount":    amountDue,
+		"Reference": order.Number,
+	}
+	if err := client.Do(http.MethodPut, "/Payments", map[string]interface{}{"Payments": []map[string]interface{}{payment}}, &resp); err != nil {
+		return nil, fmt.Errorf("invoice %s was created but the payment failed: %w", invoiceID, err)
+	}
+	result["payment"] = shared.First(resp, "Payments")
+
+	return result, nil
+}
+
+func findInvoiceByReference(client *shared.Client, reference string) (map[string]interface{}, error) {
+	where := fmt.Sprintf(`Type=="ACCREC"&&Reference==%s&&Status!="VOIDED"&&Status!="DELETED"`, strconv.Quote(reference))
+
+	var resp struct {
+		Invoices []map[string]interface{} `json:"Invoices"`
+	}
+	if err := client.Do(http.MethodGet, "/Invoices?where="+url.QueryEscape(where), nil, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Invoices) == 0 {
+		return nil, nil
+	}
+	return resp.Invoices[0], nil
+}
+
+func findContactByEmail(client *shared.Client, email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	where := fmt.Sprintf(`EmailAddress!=null&&EmailAddress.ToLower()==%s`, strconv.Quote(email))
+
+	var resp struct {
+		Contacts []struct {
+			ContactID string `json:"ContactID"`
+		} `json:"Contacts"`
+	}
+	if err := client.Do(http.MethodGet, "/Contacts?summaryOnly=true&where="+url.QueryEscape(where), nil, &resp); err != nil {
+		return "", err
+	}
+	if len(resp.Contacts) == 0 {
+		return "", nil
+	}
+	return resp.Contacts[0].ContactID, nil
+}
+
+func (a *CreateInvoiceFromOrderAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreateInvoiceFromOrderAction() sdk.Action {
+	return &CreateInvoiceFromOrderAction{}
+}
@@ -0,0 +1,162 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createItemActionProps struct {
+	TenantID            string   `json:"tenant_id"`
+	Code                string   `json:"code"`
+	Name                string   `json:"name"`
+	Description         string   `json:"description"`
+	SalesPrice          *float64 `json:"sales_price"`
+	SalesAccountCode    string   `json:"sales_account_code"`
+	SalesTaxType        string   `json:"sales_tax_type"`
+	PurchaseDescription string   `json:"purchase_description"`
+	PurchasePrice       *float64 `json:"purchase_price"`
+	PurchaseAccountCode string   `json:"purchase_account_code"`
+	PurchaseTaxType     string   `json:"purchase_tax_type"`
+}
+
+type CreateItemAction struct{}
+
+func (a *CreateItemAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_item",
+		DisplayName:   "Create or Update Item",
+		Description:   "Create a product or service item, or update the item with the same code.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createItemDocs,
+		SampleOutput: map[string]any{
+			"ItemID":      "c8c54d65-f3f2-452a-9b1a-2e3a9f9d0e8c",
+			"Code":        "TSHIRT-M",
+			"Name":        "T-Shirt, medium",
+			"Description": "Organic cotton t-shirt",
+			"SalesDetails": map[string]any{
+				"UnitPrice":   19.99,
+				"AccountCode": "200",
+				"TaxType":     "OUTPUT",
+			},
+			"IsSold":      true,
+			"IsPurchased": false,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreateItemAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_item", "Create or Update Item")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	form.TextField("code", "Code").
+		Required(true).
+		HelpText("The item code, such as a SKU. An item with the same code is updated.")
+
+	form.TextField("name", "Name").
+		Required(false)
+
+	form.TextareaField("description", "Sales Description").
+		Required(false)
+
+	form.NumberField("sales_price", "Sales Unit Price").
+		Required(false)
+
+	form.TextField("sales_account_code", "Sales Account Code").
+		Required(false).
+		HelpText("For example 200.")
+
+	form.TextField("sales_tax_type", "Sales Tax Type").
+		Required(false)
+
+	form.TextareaField("purchase_description", "Purchase Description").
+		Required(false)
+
+	form.NumberField("purchase_price", "Purchase Unit Price").
+		Required(false)
+
+	form.TextField("purchase_account_code", "Purchase Account Code").
+		Required(false).
+		HelpText("For example 300.")
+
+	form.TextField("purchase_tax_type", "Purchase Tax Type").
+		Required(false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreateItemAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createItemActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	code := strings.TrimSpace(input.Code)
+	if code == "" {
+		return nil, errors.New("item code is required")
+	}
+
+	item := map[string]interface{}{"Code": code}
+	if input.Name != "" {
+		item["Name"] = input.Name
+	}
+	if input.Description != "" {
+		item["Description"] = input.Description
+	}
+	if input.PurchaseDescription != "" {
+		item["PurchaseDescription"] = input.PurchaseDescription
+	}
+	if details := itemDetails(input.SalesPrice, input.SalesAccountCode, input.SalesTaxType); details != nil {
+		item["SalesDetails"] = details
+	}
+	if details := itemDetails(input.PurchasePrice, input.PurchaseAccountCode, input.PurchaseTaxType); details != nil {
+		item["PurchaseDetails"] = details
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPost, "/Items", map[string]interface{}{"Items": []map[string]interface{}{item}}, &resp); err != nil {
+		return nil, err
+	}
+
+	return shared.First(resp, "Items"), nil
+}
+
+func itemDetails(price *float64, accountCode, taxType string) map[string]interface{} {
+	details := map[string]interface{}{}
+	if price != nil {
+		details["UnitPrice"] = *price
+	}
+	if accountCode != "" {
+		details["AccountCode"] = accountCode
+	}
+	if taxType != "" {
+		details["TaxType"] = taxType
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return details
+}
+
+func (a *CreateItemAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreateItemAction() sdk.Action {
+	return &CreateItemAction{}
+}
@@ -0,0 +1,146 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createPaymentActionProps struct {
+	TenantID     string  `json:"tenant_id"`
+	InvoiceID    string  `json:"invoice_id"`
+	AccountCode  string  `json:"account_code"`
+	Amount       float64 `json:"amount"`
+	Date         string  `json:"date"`
+	Reference    string  `json:"reference"`
+	CurrencyRate float64 `json:"currency_rate"`
+}
+
+type CreatePaymentAction struct{}
+
+func (a *CreatePaymentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_payment",
+		DisplayName:   "Create Payment",
+		Description:   "Record a payment against an authorised invoice, paid into a bank account.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createPaymentDocs,
+		SampleOutput: map[string]any{
+			"PaymentID": "b26fd49a-cbae-470a-a8f8-bcbc119e0379",
+			"Date":      "2025-03-12",
+			"Amount":    44.00,
+			"Reference": "Stripe ch_3Ox",
+			"Status":    "AUTHORISED",
+			"Invoice": map[string]any{
+				"InvoiceID":     "243216c5-369e-4056-ac67-05388f86dc81",
+				"InvoiceNumber": "INV-0041",
+			},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreatePaymentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_payment", "Create Payment")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	shared.GetInvoiceProp("invoice_id", "Invoice", "The invoice that was paid. It must be authorised.", true, form)
+
+	form.TextField("account_code", "Bank Account Code").
+		Required(true).
+		HelpText("The code of the bank account the payment was made to, for example 090.")
+
+	form.NumberField("amount", "Amount").
+		Required(false).
+		HelpText("The amount paid, in the invoice currency. Leave empty to pay the amount due.")
+
+	form.DateField("date", "Payment Date").
+		Required(false).
+		HelpText("Defaults to today.")
+
+	form.TextField("reference", "Reference").
+		Required(false)
+
+	form.NumberField("currency_rate", "Exchange Rate").
+		Required(false).
+		HelpText("For invoices in a foreign currency, the rate to the base currency. Leave empty for Xero's daily rate.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *CreatePaymentAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createPaymentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.InvoiceID == "" || input.AccountCode == "" {
+		return nil, errors.New("an invoice and a bank account code are required")
+	}
+	if input.Amount < 0 {
+		return nil, errors.New("amount can't be negative")
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := input.Amount
+	if amount == 0 {
+		var resp struct {
+			Invoices []struct {
+				AmountDue float64 `json:"AmountDue"`
+			} `json:"Invoices"`
+		}
+		if err := client.Do(http.MethodGet, "/Invoices/"+input.InvoiceID, nil, &resp); err != nil {
+			return nil, err
+		}
+		if len(resp.Invoices) == 0 || resp.Invoices[0].AmountDue <= 0 {
+			return nil, errors.New("the invoice has nothing left to pay")
+		}
+		amount = resp.Invoices[0].AmountDue
+	}
+
+	date := input.Date
+	if date == "" {
+		date = time.Now().UTC().Format("2006-01-02")
+	}
+
+	payment := map[string]interface{}{
+		"Invoice": map[string]interface{}{"InvoiceID": input.InvoiceID},
+		"Account": map[string]interface{}{"Code": input.AccountCode},
+		"Date":    date,
+		"Amount":  amount,
+	}
+	if input.Reference != "" {
+		payment["Reference"] = input.Reference
+	}
+	if input.CurrencyRate > 0 {
+		payment["CurrencyRate"] = input.CurrencyRate
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPut, "/Payments", map[string]interface{}{"Payments": []map[string]interface{}{payment}}, &resp); err != nil {
+		return nil, err
+	}
+
+	return shared.First(resp, "Payments"), nil
+}
+
+func (a *CreatePaymentAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreatePaymentAction() sdk.Action {
+	return &CreatePaymentAction{}
+}
@@ -13,3 +13,36 @@ var getInvoiceDocs string
 
 //go:embed list_invoices.md
 var listInvoicesDocs string
+
+//go:embed create_contact.md
+var createContactDocs string
+
+//go:embed update_contact.md
+var updateContactDocs string
+
+//go:embed find_contact.md
+var findContactDocs string
+
+//go:embed create_payment.md
+var createPaymentDocs string
+
+//go:embed create_credit_note.md
+var createCreditNoteDocs string
+
+//go:embed create_item.md
+var createItemDocs string
+
+//go:embed list_items.md
+var listItemsDocs string
+
+//go:embed create_bank_transaction.md
+var createBankTransactionDocs string
+
+//go:embed list_bank_transactions.md
+var listBankTransactionsDocs string
+
+//go:embed attach_file_to_invoice.md
+var attachFileToInvoiceDocs string
+
+//go:embed create_invoice_from_order.md
+var createInvoiceFromOrderDocs string
@@ -0,0 +1,129 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type findContactActionProps struct {
+	TenantID        string `json:"tenant_id"`
+	Email           string `json:"email"`
+	AccountNumber   string `json:"account_number"`
+	SearchTerm      string `json:"search_term"`
+	IncludeArchived bool   `json:"include_archived"`
+}
+
+type FindContactAction struct{}
+
+func (a *FindContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "find_contact",
+		DisplayName:   "Find Contact",
+		Description:   "Find Xero contacts by email, account number or a search term matching names and contact numbers.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: findContactDocs,
+		SampleOutput: map[string]any{
+			"found":    true,
+			"contact":  contactSample,
+			"contacts": []map[string]any{contactSample},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *FindContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("find_contact", "Find Contact")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	form.TextField("email", "Email").
+		Required(false).
+		HelpText("Match the exact email address.")
+
+	form.TextField("account_number", "Account Number").
+		Required(false).
+		HelpText("Match the exact account number.")
+
+	form.TextField("search_term", "Search Term").
+		Required(false).
+		HelpText("Match part of the name, first name, last name, contact number or email.")
+
+	form.CheckboxField("include_archived", "Include Archived").
+		Required(false).
+		DefaultValue(false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *FindContactAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[findContactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Email == "" && input.AccountNumber == "" && input.SearchTerm == "" {
+		return nil, errors.New("an email, account number or search term is required")
+	}
+
+	var where []string
+	if email := strings.TrimSpace(input.Email); email != "" {
+		where = append(where, fmt.Sprintf("EmailAddress!=null&&EmailAddress.ToLower()==%s", strconv.Quote(strings.ToLower(email))))
+	}
+	if number := strings.TrimSpace(input.AccountNumber); number != "" {
+		where = append(where, "AccountNumber=="+strconv.Quote(number))
+	}
+	if !input.IncludeArchived {
+		where = append(where, `ContactStatus=="ACTIVE"`)
+	}
+
+	params := url.Values{}
+	if len(where) > 0 {
+		params.Set("where", strings.Join(where, "&&"))
+	}
+	if term := strings.TrimSpace(input.SearchTerm); term != "" {
+		params.Set("searchTerm", term)
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Contacts []map[string]interface{} `json:"Contacts"`
+	}
+	if err := client.Do(http.MethodGet, "/Contacts?"+params.Encode(), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{
+		"found":    len(resp.Contacts) > 0,
+		"contacts": resp.Contacts,
+	}
+	if len(resp.Contacts) > 0 {
+		result["contact"] = resp.Contacts[0]
+	} else {
+		result["contacts"] = []map[string]interface{}{}
+	}
+	return result, nil
+}
+
+func (a *FindContactAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewFindContactAction() sdk.Action {
+	return &FindContactAction{}
+}
@@ -0,0 +1,143 @@
+package actions
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type listBankTransactionsActionProps struct {
+	TenantID        string `json:"tenant_id"`
+	BankAccountCode string `json:"bank_account_code"`
+	Type            string `json:"type"`
+	FromDate        string `json:"from_date"`
+	ToDate          string `json:"to_date"`
+	Unreconciled    bool   `json:"unreconciled_only"`
+	Page            int    `json:"page"`
+}
+
+type ListBankTransactionsAction struct{}
+
+func (a *ListBankTransactionsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_bank_transactions",
+		DisplayName:   "List Bank Transactions",
+		Description:   "List spend and receive money transactions, filtered by bank account, type, date range and reconciliation.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: listBankTransactionsDocs,
+		SampleOutput: map[string]any{
+			"bank_transactions": []map[string]any{
+				{"BankTransactionID": "d20b6c54-7f5d-4ce6-ab83-55f609719126", "Type": "SPEND", "Total": "12.30", "IsReconciled": false},
+			},
+			"page": 1,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *ListBankTransactionsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_bank_transactions", "List Bank Transactions")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	form.TextField("bank_account_code", "Bank Account Code").
+		Required(false)
+
+	form.SelectField("type", "Type").
+		Required(false).
+		AddOption("RECEIVE", "Receive money").
+		AddOption("SPEND", "Spend money")
+
+	form.DateField("from_date", "From Date").
+		Required(false)
+
+	form.DateField("to_date", "To Date").
+		Required(false)
+
+	form.CheckboxField("unreconciled_only", "Unreconciled Only").
+		Required(false).
+		DefaultValue(false)
+
+	form.NumberField("page", "Page").
+		Required(false).
+		DefaultValue(1).
+		HelpText("Xero returns 100 transactions per page.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *ListBankTransactionsAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listBankTransactionsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var where []string
+	if input.BankAccountCode != "" {
+		where = append(where, "BankAccount.Code=="+strconv.Quote(input.BankAccountCode))
+	}
+	if input.Type != "" {
+		where = append(where, "Type=="+strconv.Quote(input.Type))
+	}
+	for _, bound := range []struct {
+		value, op string
+	}{{input.FromDate, ">="}, {input.ToDate, "<="}} {
+		if bound.value == "" {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", bound.value[:min(len(bound.value), 10)])
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q", bound.value)
+		}
+		where = append(where, fmt.Sprintf("Date%sDateTime(%d,%02d,%02d)", bound.op, date.Year(), date.Month(), date.Day()))
+	}
+	if input.Unreconciled {
+		where = append(where, "IsReconciled==false")
+	}
+
+	page := max(input.Page, 1)
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	if len(where) > 0 {
+		params.Set("where", strings.Join(where, "&&"))
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		BankTransactions []map[string]interface{} `json:"BankTransactions"`
+	}
+	if err := client.Do(http.MethodGet, "/BankTransactions?"+params.Encode(), nil, &resp); err != nil {
+		return nil, err
+	}
+	if resp.BankTransactions == nil {
+		resp.BankTransactions = []map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"bank_transactions": resp.BankTransactions,
+		"page":              page,
+	}, nil
+}
+
+func (a *ListBankTransactionsAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewListBankTransactionsAction() sdk.Action {
+	return &ListBankTransactionsAction{}
+}
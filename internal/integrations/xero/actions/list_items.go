@@ -0,0 +1,88 @@
+package actions
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type listItemsActionProps struct {
+	TenantID string `json:"tenant_id"`
+	Code     string `json:"code"`
+}
+
+type ListItemsAction struct{}
+
+func (a *ListItemsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "list_items",
+		DisplayName:   "List Items",
+		Description:   "List the product and service items of a Xero organization, or find one by code.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: listItemsDocs,
+		SampleOutput: map[string]any{
+			"items": []map[string]any{
+				{"ItemID": "c8c54d65-f3f2-452a-9b1a-2e3a9f9d0e8c", "Code": "TSHIRT-M", "Name": "T-Shirt, medium"},
+			},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *ListItemsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("list_items", "List Items")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	form.TextField("code", "Code").
+		Required(false).
+		HelpText("Only return the item with this code.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *ListItemsAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[listItemsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := "/Items"
+	if code := strings.TrimSpace(input.Code); code != "" {
+		endpoint += "?where=" + url.QueryEscape("Code=="+strconv.Quote(code))
+	}
+
+	var resp struct {
+		Items []map[string]interface{} `json:"Items"`
+	}
+	if err := client.Do(http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Items == nil {
+		resp.Items = []map[string]interface{}{}
+	}
+
+	return map[string]interface{}{"items": resp.Items}, nil
+}
+
+func (a *ListItemsAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewListItemsAction() sdk.Action {
+	return &ListItemsAction{}
+}
@@ -0,0 +1,30 @@
+package actions
+
+var contactSample = map[string]any{
+	"ContactID":     "bd2270c3-8706-4c11-9cfb-000b551c3f51",
+	"ContactStatus": "ACTIVE",
+	"Name":          "ABC Limited",
+	"FirstName":     "Andrea",
+	"LastName":      "Dutchess",
+	"EmailAddress":  "a.dutchess@abclimited.com",
+	"AccountNumber": "CUST-100",
+	"IsCustomer":    true,
+	"IsSupplier":    false,
+}
+
+var invoiceSample = map[string]any{
+	"InvoiceID":     "243216c5-369e-4056-ac67-05388f86dc81",
+	"InvoiceNumber": "INV-0041",
+	"Type":          "ACCREC",
+	"Status":        "AUTHORISED",
+	"Reference":     "#1001",
+	"CurrencyCode":  "USD",
+	"SubTotal":      "40.00",
+	"TotalTax":      "4.00",
+	"Total":         "44.00",
+	"AmountDue":     "44.00",
+	"Contact": map[string]any{
+		"ContactID": "bd2270c3-8706-4c11-9cfb-000b551c3f51",
+		"Name":      "ABC Limited",
+	},
+}
@@ -0,0 +1,88 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateContactActionProps struct {
+	TenantID  string `json:"tenant_id"`
+	ContactID string `json:"contact_id"`
+	shared.ContactFields
+}
+
+type UpdateContactAction struct{}
+
+func (a *UpdateContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_contact",
+		DisplayName:   "Update Contact",
+		Description:   "Update the details of a Xero contact. Fields left empty keep their current value.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: updateContactDocs,
+		SampleOutput:  contactSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpdateContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_contact", "Update Contact")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	shared.GetContactProp("contact_id", "Contact", "The contact to update.", true, form)
+
+	shared.RegisterContactProps(form, false)
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *UpdateContactAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateContactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ContactID == "" {
+		return nil, errors.New("contact ID is required")
+	}
+
+	contact := input.ContactFields.Body()
+	if len(contact) == 0 {
+		return nil, errors.New("nothing to update")
+	}
+	contact["ContactID"] = input.ContactID
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"Contacts": []map[string]interface{}{contact},
+	}
+
+	var resp map[string]interface{}
+	if err := client.Do(http.MethodPost, "/Contacts/"+url.PathEscape(input.ContactID), body, &resp); err != nil {
+		return nil, err
+	}
+
+	return shared.First(resp, "Contacts"), nil
+}
+
+func (a *UpdateContactAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpdateContactAction() sdk.Action {
+	return &UpdateContactAction{}
+}
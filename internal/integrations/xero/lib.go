@@ -34,6 +34,10 @@ func (n *Xero) Auth() *core.AuthMetadata {
 func (n *Xero) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewNewInvoiceTrigger(),
+
+		triggers.NewInvoicePaidTrigger(),
+
+		triggers.NewContactCreatedTrigger(),
 	}
 }
 
@@ -46,6 +50,28 @@ func (n *Xero) Actions() []sdk.Action {
 		actions.NewEmailInvoiceAction(),
 
 		actions.NewCreateInvoiceAction(),
+
+		actions.NewCreateInvoiceFromOrderAction(),
+
+		actions.NewAttachFileToInvoiceAction(),
+
+		actions.NewCreateContactAction(),
+
+		actions.NewUpdateContactAction(),
+
+		actions.NewFindContactAction(),
+
+		actions.NewCreatePaymentAction(),
+
+		actions.NewCreateCreditNoteAction(),
+
+		actions.NewCreateItemAction(),
+
+		actions.NewListItemsAction(),
+
+		actions.NewCreateBankTransactionAction(),
+
+		actions.NewListBankTransactionsAction(),
 	}
 }
 
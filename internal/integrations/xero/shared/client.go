@@ -0,0 +1,228 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+const connectionsURL = "https://api.xero.com/connections"
+
+// Client calls the Accounting API for one organization.
+type Client struct {
+	token    string
+	TenantID string
+}
+
+// NewClient returns a client for the organization tenantID. When it is
+// empty and the connection has access to a single organization, that
+// one is used.
+func NewClient(ctx sdkcontext.BaseContext, tenantID string) (*Client, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+
+	token := authCtx.AccessToken
+	if authCtx.Token != nil && authCtx.Token.AccessToken != "" {
+		token = authCtx.Token.AccessToken
+	}
+	if token == "" {
+		return nil, errors.New("missing authentication token")
+	}
+
+	tenantID, err = ResolveTenant(token, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{token: token, TenantID: tenantID}, nil
+}
+
+// Tenants lists the organizations the token has access to.
+func Tenants(token string) (TenantsResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, connectionsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Xero organizations: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to list Xero organizations, status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	var tenants TenantsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tenants); err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+// ResolveTenant returns tenantID, or the only organization of the token
+// when tenantID is empty.
+func ResolveTenant(token, tenantID string) (string, error) {
+	if tenantID != "" {
+		return tenantID, nil
+	}
+
+	tenants, err := Tenants(token)
+	if err != nil {
+		return "", err
+	}
+	var orgs []Tenant
+	for _, t := range tenants {
+		if t.TenantType == "" || t.TenantType == "ORGANISATION" {
+			orgs = append(orgs, t)
+		}
+	}
+
+	switch len(orgs) {
+	case 0:
+		return "", errors.New("the connection has no Xero organization")
+	case 1:
+		return orgs[0].TenantID, nil
+	default:
+		return "", fmt.Errorf("the connection has access to %d Xero organizations, select one", len(orgs))
+	}
+}
+
+// Do sends a JSON request to an Accounting API endpoint, such as
+// /Contacts, and decodes the response into out.
+func (c *Client) Do(method, endpoint string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	return c.send(method, endpoint, "application/json", reader, nil, out)
+}
+
+// GetModifiedSince reads an endpoint, returning only records changed
+// after since when it is not zero.
+func (c *Client) GetModifiedSince(endpoint string, since time.Time, out interface{}) error {
+	var headers map[string]string
+	if !since.IsZero() {
+		headers = map[string]string{"If-Modified-Since": since.UTC().Format("2006-01-02T15:04:05")}
+	}
+	return c.send(http.MethodGet, endpoint, "", nil, headers, out)
+}
+
+// Upload sends raw content, as the attachment endpoints expect.
+func (c *Client) Upload(endpoint, contentType string, data []byte, out interface{}) error {
+	return c.send(http.MethodPut, endpoint, contentType, bytes.NewReader(data), nil, out)
+}
+
+func (c *Client) send(method, endpoint, contentType string, body io.Reader, headers map[string]string, out interface{}) error {
+	req, err := http.NewRequest(method, baseURL+endpoint, body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Xero-Tenant-Id", c.TenantID)
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %v", err)
+	}
+
+	// Not modified since the If-Modified-Since date.
+	if resp.StatusCode == http.StatusNotModified {
+		return nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return apiError(resp.StatusCode, data)
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// apiError returns the validation messages of a failed request, which
+// Xero reports per element.
+func apiError(status int, data []byte) error {
+	var body struct {
+		Message  string `json:"Message"`
+		Detail   string `json:"Detail"`
+		Elements []struct {
+			ValidationErrors []struct {
+				Message string `json:"Message"`
+			} `json:"ValidationErrors"`
+		} `json:"Elements"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return fmt.Errorf("xero request failed with status code %d: %s", status, string(data))
+	}
+
+	var messages []string
+	for _, element := range body.Elements {
+		for _, v := range element.ValidationErrors {
+			messages = append(messages, v.Message)
+		}
+	}
+	if len(messages) == 0 {
+		for _, m := range []string{body.Detail, body.Message} {
+			if m != "" {
+				messages = append(messages, m)
+				break
+			}
+		}
+	}
+	if len(messages) == 0 {
+		return fmt.Errorf("xero request failed with status code %d", status)
+	}
+	return fmt.Errorf("xero request failed with status code %d: %s", status, strings.Join(messages, "; "))
+}
+
+var msDate = regexp.MustCompile(`/Date\((-?\d+)([+-]\d{4})?\)/`)
+
+// ParseDate reads the dates Xero returns, either /Date(1573755038314+0000)/
+// or ISO 8601 without a zone, which is UTC.
+func ParseDate(s string) (time.Time, error) {
+	if m := msDate.FindStringSubmatch(s); m != nil {
+		ms, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.UnixMilli(ms).UTC(), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
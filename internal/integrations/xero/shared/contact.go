@@ -0,0 +1,123 @@
+package shared
+
+import (
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+// ContactFields are the contact details shared by the create and update
+// actions. Empty fields are left out, so an update keeps their values.
+type ContactFields struct {
+	Name          string `json:"name"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	Email         string `json:"email"`
+	Phone         string `json:"phone"`
+	AccountNumber string `json:"account_number"`
+	TaxNumber     string `json:"tax_number"`
+	AddressLine1  string `json:"address_line1"`
+	AddressLine2  string `json:"address_line2"`
+	City          string `json:"city"`
+	Region        string `json:"region"`
+	PostalCode    string `json:"postal_code"`
+	Country       string `json:"country"`
+	Currency      string `json:"currency"`
+}
+
+// RegisterContactProps adds the contact detail fields to a form.
+func RegisterContactProps(form *smartform.FormBuilder, nameRequired bool) {
+	form.TextField("name", "Name").
+		Required(nameRequired).
+		HelpText("The contact or company name. It must be unique in the organization.")
+
+	form.TextField("first_name", "First Name").
+		Required(false)
+
+	form.TextField("last_name", "Last Name").
+		Required(false)
+
+	form.TextField("email", "Email").
+		Required(false)
+
+	form.TextField("phone", "Phone").
+		Required(false)
+
+	form.TextField("account_number", "Account Number").
+		Required(false).
+		HelpText("Your own reference for the contact, such as a customer ID from another system.")
+
+	form.TextField("tax_number", "Tax Number").
+		Required(false).
+		HelpText("The contact's VAT, GST or ABN number.")
+
+	form.TextField("address_line1", "Address Line 1").
+		Required(false)
+
+	form.TextField("address_line2", "Address Line 2").
+		Required(false)
+
+	form.TextField("city", "City").
+		Required(false)
+
+	form.TextField("region", "Region").
+		Required(false)
+
+	form.TextField("postal_code", "Postal Code").
+		Required(false)
+
+	form.TextField("country", "Country").
+		Required(false)
+
+	form.TextField("currency", "Default Currency").
+		Required(false).
+		Placeholder("USD")
+}
+
+// Body returns the contact as Xero expects it.
+func (f ContactFields) Body() map[string]interface{} {
+	contact := map[string]interface{}{}
+	set := func(key, value string) {
+		if value = strings.TrimSpace(value); value != "" {
+			contact[key] = value
+		}
+	}
+	set("Name", f.Name)
+	set("FirstName", f.FirstName)
+	set("LastName", f.LastName)
+	set("EmailAddress", f.Email)
+	set("AccountNumber", f.AccountNumber)
+	set("TaxNumber", f.TaxNumber)
+	set("DefaultCurrency", strings.ToUpper(f.Currency))
+
+	if f.Phone != "" {
+		contact["Phones"] = []map[string]interface{}{
+			{"PhoneType": "DEFAULT", "PhoneNumber": f.Phone},
+		}
+	}
+	if f.AddressLine1 != "" || f.City != "" || f.PostalCode != "" || f.Country != "" {
+		contact["Addresses"] = []map[string]interface{}{
+			{
+				"AddressType":  "POBOX",
+				"AddressLine1": f.AddressLine1,
+				"AddressLine2": f.AddressLine2,
+				"City":         f.City,
+				"Region":       f.Region,
+				"PostalCode":   f.PostalCode,
+				"Country":      f.Country,
+			},
+		}
+	}
+	return contact
+}
+
+// First returns the first record of a response, such as the created
+// contact of a Contacts response.
+func First(resp map[string]interface{}, key string) map[string]interface{} {
+	records, _ := resp[key].([]interface{})
+	if len(records) == 0 {
+		return nil
+	}
+	record, _ := records[0].(map[string]interface{})
+	return record
+}
@@ -0,0 +1,465 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/wakflo/extensions/internal/currency"
+)
+
+// Order is a Shopify order or a Stripe invoice or checkout session,
+// reduced to what an invoice needs.
+type Order struct {
+	Source        string
+	Number        string
+	Currency      string
+	Date          time.Time
+	Email         string
+	Name          string
+	TaxesIncluded bool
+	Paid          bool
+	Lines         []OrderLine
+	Total         decimal.Decimal
+}
+
+// OrderLine is a product or shipping line. UnitAmount is per unit before
+// discounts; Discount and Tax are totals for the line.
+type OrderLine struct {
+	Description string
+	ItemCode    string
+	Quantity    decimal.Decimal
+	UnitAmount  decimal.Decimal
+	Discount    decimal.Decimal
+	Tax         decimal.Decimal
+	Shipping    bool
+}
+
+// ParseOrder reads an order from JSON text or an object. source is
+// "shopify", "stripe", or empty to tell from the object.
+func ParseOrder(source string, v interface{}) (*Order, error) {
+	var obj map[string]interface{}
+	switch o := v.(type) {
+	case map[string]interface{}:
+		obj = o
+	case string:
+		if err := json.Unmarshal([]byte(o), &obj); err != nil {
+			return nil, fmt.Errorf("the order must be a JSON object: %w", err)
+		}
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil, fmt.Errorf("the order must be a JSON object: %w", err)
+		}
+	}
+	if obj == nil {
+		return nil, errors.New("an order is required")
+	}
+	// Shopify webhooks and API responses wrap the order.
+	if inner, ok := obj["order"].(map[string]interface{}); ok {
+		obj = inner
+	}
+
+	if source == "" {
+		if _, ok := obj["object"].(string); ok {
+			source = "stripe"
+		} else {
+			source = "shopify"
+		}
+	}
+
+	switch source {
+	case "shopify":
+		return OrderFromShopify(obj)
+	case "stripe":
+		return OrderFromStripe(obj)
+	default:
+		return nil, fmt.Errorf("unsupported order source %q", source)
+	}
+}
+
+// OrderFromShopify reads a Shopify order. Line discounts come from the
+// discount allocations, which include order level discounts.
+func OrderFromShopify(m map[string]interface{}) (*Order, error) {
+	order := &Order{
+		Source:        "shopify",
+		Number:        str(m["name"]),
+		Currency:      strings.ToUpper(str(m["currency"])),
+		Email:         str(m["email"]),
+		TaxesIncluded: m["taxes_included"] == true,
+		Paid:          str(m["financial_status"]) == "paid",
+		Total:         dec(m["total_price"]),
+	}
+	if order.Number == "" {
+		order.Number = str(m["order_number"])
+	}
+	order.Date, _ = time.Parse(time.RFC3339, str(m["created_at"]))
+
+	if customer, ok := m["customer"].(map[string]interface{}); ok {
+		order.Name = strings.TrimSpace(str(customer["first_name"]) + " " + str(customer["last_name"]))
+		if order.Email == "" {
+			order.Email = str(customer["email"])
+		}
+	}
+	if billing, ok := m["billing_address"].(map[string]interface{}); ok {
+		if company := str(billing["company"]); company != "" {
+			order.Name = company
+		} else if order.Name == "" {
+			order.Name = str(billing["name"])
+		}
+	}
+
+	for _, raw := range list(m["line_items"]) {
+		item, _ := raw.(map[string]interface{})
+		description := str(item["title"])
+		if variant := str(item["variant_title"]); variant != "" {
+			description += " - " + variant
+		}
+
+		discount := decimal.Zero
+		allocations := list(item["discount_allocations"])
+		for _, a := range allocations {
+			allocation, _ := a.(map[string]interface{})
+			discount = discount.Add(dec(allocation["amount"]))
+		}
+		if len(allocations) == 0 {
+			discount = dec(item["total_discount"])
+		}
+
+		order.Lines = append(order.Lines, OrderLine{
+			Description: description,
+			ItemCode:    str(item["sku"]),
+			Quantity:    dec(item["quantity"]),
+			UnitAmount:  dec(item["price"]),
+			Discount:    discount,
+			Tax:         shopifyTax(item),
+		})
+	}
+
+	for _, raw := range list(m["shipping_lines"]) {
+		line, _ := raw.(map[string]interface{})
+		price := dec(line["price"])
+		discount := decimal.Zero
+		if discounted, ok := line["discounted_price"]; ok {
+			discount = price.Sub(dec(discounted))
+		}
+		order.Lines = append(order.Lines, OrderLine{
+			Description: "Shipping: " + str(line["title"]),
+			Quantity:    decimal.NewFromInt(1),
+			UnitAmount:  price,
+			Discount:    discount,
+			Tax:         shopifyTax(line),
+			Shipping:    true,
+		})
+	}
+
+	if len(order.Lines) == 0 {
+		return nil, errors.New("the Shopify order has no line items")
+	}
+	return order, nil
+}
+
+func shopifyTax(line map[string]interface{}) decimal.Decimal {
+	tax := decimal.Zero
+	for _, raw := range list(line["tax_lines"]) {
+		taxLine, _ := raw.(map[string]interface{})
+		tax = tax.Add(dec(taxLine["price"]))
+	}
+	return tax
+}
+
+// OrderFromStripe reads a Stripe invoice, or a checkout session with its
+// line items expanded. Stripe amounts are in minor units.
+func OrderFromStripe(m map[string]interface{}) (*Order, error) {
+	code := strings.ToUpper(str(m["currency"]))
+	minor := func(v interface{}) decimal.Decimal {
+		return dec(v).Shift(-currency.Places(code))
+	}
+
+	order := &Order{
+		Source:   "stripe",
+		Currency: code,
+		Date:     time.Unix(dec(m["created"]).IntPart(), 0).UTC(),
+	}
+
+	switch str(m["object"]) {
+	case "invoice":
+		order.Number = str(m["number"])
+		order.Email = str(m["customer_email"])
+		order.Name = str(m["customer_name"])
+		order.Paid = str(m["status"]) == "paid"
+		order.Total = minor(m["total"])
+
+		lines, _ := m["lines"].(map[string]interface{})
+		for _, raw := range list(lines["data"]) {
+			item, _ := raw.(map[string]interface{})
+			quantity := dec(item["quantity"])
+			if quantity.IsZero() {
+				quantity = decimal.NewFromInt(1)
+			}
+
+			discount := decimal.Zero
+			for _, d := range list(item["discount_amounts"]) {
+				amount, _ := d.(map[string]interface{})
+				discount = discount.Add(minor(amount["amount"]))
+			}
+
+			tax := decimal.Zero
+			taxes := list(item["tax_amounts"])
+			if len(taxes) == 0 {
+				taxes = list(item["taxes"])
+			}
+			for _, t := range taxes {
+				amount, _ := t.(map[string]interface{})
+				tax = tax.Add(minor(amount["amount"]))
+				if amount["inclusive"] == true || str(amount["tax_behavior"]) == "inclusive" {
+					order.TaxesIncluded = true
+				}
+			}
+
+			order.Lines = append(order.Lines, OrderLine{
+				Description: str(item["description"]),
+				Quantity:    quantity,
+				UnitAmount:  minor(item["amount"]).Div(quantity),
+				Discount:    discount,
+				Tax:         tax,
+			})
+		}
+
+	case "checkout.session":
+		order.Number = str(m["client_reference_id"])
+		if order.Number == "" {
+			order.Number = str(m["id"])
+		}
+		if details, ok := m["customer_details"].(map[string]interface{}); ok {
+			order.Email = str(details["email"])
+			order.Name = str(details["name"])
+		}
+		order.Paid = str(m["payment_status"]) == "paid"
+		order.Total = minor(m["amount_total"])
+
+		items, _ := m["line_items"].(map[string]interface{})
+		for _, raw := range list(items["data"]) {
+			item, _ := raw.(map[string]interface{})
+			quantity := dec(item["quantity"])
+			if quantity.IsZero() {
+				quantity = decimal.NewFromInt(1)
+			}
+			subtotal, discount, tax := minor(item["amount_subtotal"]), minor(item["amount_discount"]), minor(item["amount_tax"])
+			// Inclusive tax is part of the subtotal, so the total
+			// doesn't add it again.
+			if !tax.IsZero() && minor(item["amount_total"]).Equal(subtotal.Sub(discount)) {
+				order.TaxesIncluded = true
+			}
+
+			order.Lines = append(order.Lines, OrderLine{
+				Description: str(item["description"]),
+				Quantity:    quantity,
+				UnitAmount:  subtotal.Div(quantity),
+				Discount:    discount,
+				Tax:         tax,
+			})
+		}
+
+		if shipping, ok := m["shipping_cost"].(map[string]interface{}); ok && !minor(shipping["amount_subtotal"]).IsZero() {
+			order.Lines = append(order.Lines, OrderLine{
+				Description: "Shipping",
+				Quantity:    decimal.NewFromInt(1),
+				UnitAmount:  minor(shipping["amount_subtotal"]),
+				Tax:         minor(shipping["amount_tax"]),
+				Shipping:    true,
+			})
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported Stripe object %q, expected an invoice or a checkout session", str(m["object"]))
+	}
+
+	if len(order.Lines) == 0 {
+		return nil, errors.New("the Stripe order has no line items; expand line_items for checkout sessions")
+	}
+	return order, nil
+}
+
+// InvoiceOptions are the accounts and settings an order is booked with.
+type InvoiceOptions struct {
+	ContactID           string
+	AccountCode         string
+	ShippingAccountCode string
+	RoundingAccountCode string
+	TaxType             string
+	ExemptTaxType       string
+	Status              string
+	DueDate             string
+	UseItemCodes        bool
+}
+
+// Invoice returns the Xero invoice of the order. Amounts are rounded to
+// the currency the way Xero rounds them, and any difference left with the
+// order total, of at most a minor unit per line, goes to a rounding line.
+func (o *Order) Invoice(opts InvoiceOptions) (map[string]interface{}, error) {
+	if opts.AccountCode == "" {
+		return nil, errors.New("a sales account code is required")
+	}
+	places := currency.Places(o.Currency)
+
+	total := decimal.Zero
+	lines := make([]map[string]interface{}, 0, len(o.Lines)+1)
+	for _, l := range o.Lines {
+		unit := l.UnitAmount.Round(4)
+		discount := l.Discount.Round(places)
+		amount := l.Quantity.Mul(unit).Round(places).Sub(discount)
+		tax := l.Tax.Round(places)
+
+		total = total.Add(amount)
+		if !o.TaxesIncluded {
+			total = total.Add(tax)
+		}
+
+		line := map[string]interface{}{
+			"Description": l.Description,
+			"Quantity":    l.Quantity.InexactFloat64(),
+			"UnitAmount":  unit.InexactFloat64(),
+			"AccountCode": opts.AccountCode,
+			"TaxAmount":   tax.InexactFloat64(),
+		}
+		if !discount.IsZero() {
+			line["DiscountAmount"] = discount.InexactFloat64()
+		}
+		if l.Shipping && opts.ShippingAccountCode != "" {
+			line["AccountCode"] = opts.ShippingAccountCode
+		}
+		if opts.UseItemCodes && l.ItemCode != "" {
+			line["ItemCode"] = l.ItemCode
+		}
+		if taxType := opts.lineTaxType(tax); taxType != "" {
+			line["TaxType"] = taxType
+		}
+		lines = append(lines, line)
+	}
+
+	diff := o.Total.Round(places).Sub(total)
+	if !diff.IsZero() {
+		tolerance := decimal.New(int64(len(o.Lines)), -places)
+		if diff.Abs().GreaterThan(tolerance) {
+			return nil, fmt.Errorf("the order lines add up to %s but the order total is %s; the difference is more than rounding",
+				total.StringFixed(places), o.Total.StringFixed(places))
+		}
+		if opts.RoundingAccountCode == "" {
+			return nil, fmt.Errorf("the order needs a rounding adjustment of %s, set a rounding account", diff.StringFixed(places))
+		}
+		rounding := map[string]interface{}{
+			"Description": "Rounding",
+			"Quantity":    1,
+			"UnitAmount":  diff.InexactFloat64(),
+			"AccountCode": opts.RoundingAccountCode,
+			"TaxAmount":   0,
+		}
+		if taxType := opts.lineTaxType(decimal.Zero); taxType != "" {
+			rounding["TaxType"] = taxType
+		}
+		lines = append(lines, rounding)
+	}
+
+	lineAmountTypes := "Exclusive"
+	if o.TaxesIncluded {
+		lineAmountTypes = "Inclusive"
+	}
+
+	date := o.Date
+	if date.IsZero() {
+		date = time.Now().UTC()
+	}
+	dueDate := opts.DueDate
+	if dueDate == "" {
+		dueDate = date.Format("2006-01-02")
+	}
+	status := opts.Status
+	if status == "" {
+		status = "AUTHORISED"
+	}
+
+	contact := map[string]interface{}{}
+	if opts.ContactID != "" {
+		contact["ContactID"] = opts.ContactID
+	} else {
+		name := o.Name
+		if name == "" {
+			name = o.Email
+		}
+		if name == "" {
+			return nil, errors.New("the order has no customer name or email for the contact")
+		}
+		contact["Name"] = name
+		if o.Email != "" {
+			contact["EmailAddress"] = o.Email
+		}
+	}
+
+	invoice := map[string]interface{}{
+		"Type":            "ACCREC",
+		"Contact":         contact,
+		"Date":            date.Format("2006-01-02"),
+		"DueDate":         dueDate,
+		"Reference":       o.Number,
+		"Status":          status,
+		"LineAmountTypes": lineAmountTypes,
+		"LineItems":       lines,
+	}
+	if o.Currency != "" {
+		invoice["CurrencyCode"] = o.Currency
+	}
+	return invoice, nil
+}
+
+func (opts InvoiceOptions) lineTaxType(tax decimal.Decimal) string {
+	if tax.IsZero() && opts.ExemptTaxType != "" {
+		return opts.ExemptTaxType
+	}
+	return opts.TaxType
+}
+
+func str(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case float64:
+		return decimal.NewFromFloat(s).String()
+	case json.Number:
+		return s.String()
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(s)
+	}
+}
+
+func dec(v interface{}) decimal.Decimal {
+	switch n := v.(type) {
+	case float64:
+		return decimal.NewFromFloat(n)
+	case int:
+		return decimal.NewFromInt(int64(n))
+	case int64:
+		return decimal.NewFromInt(n)
+	case string, json.Number:
+		d, err := decimal.NewFromString(strings.TrimSpace(str(n)))
+		if err != nil {
+			return decimal.Zero
+		}
+		return d
+	default:
+		return decimal.Zero
+	}
+}
+
+func list(v interface{}) []interface{} {
+	l, _ := v.([]interface{})
+	return l
+}
@@ -0,0 +1,19 @@
+package shared
+
+import (
+	"time"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// firstRunLookback is how far back the first poll of a trigger looks.
+const firstRunLookback = 24 * time.Hour
+
+// PollSince returns the If-Modified-Since date of a poll: the last run,
+// or a day ago on the first run.
+func PollSince(ctx sdkcontext.ExecuteContext) time.Time {
+	if lr := ctx.LastRun(); lr != nil {
+		return *lr
+	}
+	return time.Now().Add(-firstRunLookback)
+}
@@ -0,0 +1,157 @@
+package shared
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// TenantHelp explains the optional organization field.
+const TenantHelp = "The Xero organization. Can be left empty when the connection has access to a single organization."
+
+func GetContactProp(id string, title string, desc string, required bool, form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getContacts := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		input := sdk.DynamicInputToType[struct {
+			TenantID string `json:"tenant_id,omitempty"`
+		}](ctx)
+
+		tokenSource := ctx.Auth().Token
+		if tokenSource == nil {
+			return nil, errors.New("missing authentication token")
+		}
+
+		tenantID, err := ResolveTenant(tokenSource.AccessToken, input.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		client := &Client{token: tokenSource.AccessToken, TenantID: tenantID}
+
+		params := url.Values{}
+		params.Set("summaryOnly", "true")
+		params.Set("where", `ContactStatus=="ACTIVE"`)
+		params.Set("order", "Name")
+
+		var result struct {
+			Contacts []Contact `json:"Contacts"`
+		}
+		if err := client.Do(http.MethodGet, "/Contacts?"+params.Encode(), nil, &result); err != nil {
+			return nil, err
+		}
+
+		items := make([]map[string]any, 0, len(result.Contacts))
+		for _, contact := range result.Contacts {
+			items = append(items, map[string]any{
+				"value": contact.ContactID,
+				"label": contact.Name,
+			})
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField(id, title).
+		Placeholder("Select Contact").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getContacts)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText(desc)
+}
+
+// LineItemInput is a line item entered in a form.
+type LineItemInput struct {
+	Description  string  `json:"description"`
+	Quantity     float64 `json:"quantity"`
+	UnitAmount   float64 `json:"unit_amount"`
+	AccountCode  string  `json:"account_code"`
+	TaxType      string  `json:"tax_type"`
+	ItemCode     string  `json:"item_code"`
+	DiscountRate float64 `json:"discount_rate"`
+}
+
+// RegisterLineItemsProp adds a line items array to a form.
+func RegisterLineItemsProp(form *smartform.FormBuilder, required bool) {
+	lines := form.ArrayField("line_items", "Line Items")
+	lines.Required(required)
+
+	line := lines.ObjectTemplate("line_item", "")
+
+	line.TextField("description", "Description").
+		Required(true)
+
+	line.NumberField("quantity", "Quantity").
+		Required(false).
+		DefaultValue(1)
+
+	line.NumberField("unit_amount", "Unit Amount").
+		Required(true)
+
+	line.TextField("account_code", "Account Code").
+		Required(false).
+		HelpText("For example 200 for sales. Can be left empty when an item code is set.")
+
+	line.TextField("tax_type", "Tax Type").
+		Required(false).
+		HelpText("For example OUTPUT2 or NONE. Leave empty for the account's default tax rate.")
+
+	line.TextField("item_code", "Item Code").
+		Required(false)
+
+	line.NumberField("discount_rate", "Discount %").
+		Required(false)
+}
+
+// LineItems converts form line items to Xero line items.
+func LineItems(items []LineItemInput) ([]map[string]interface{}, error) {
+	if len(items) == 0 {
+		return nil, errors.New("at least one line item is required")
+	}
+
+	lines := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if strings.TrimSpace(item.Description) == "" && item.ItemCode == "" {
+			return nil, errors.New("each line item needs a description or an item code")
+		}
+		quantity := item.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+
+		line := map[string]interface{}{
+			"Description": item.Description,
+			"Quantity":    quantity,
+			"UnitAmount":  item.UnitAmount,
+		}
+		if item.AccountCode != "" {
+			line["AccountCode"] = item.AccountCode
+		}
+		if item.TaxType != "" {
+			line["TaxType"] = item.TaxType
+		}
+		if item.ItemCode != "" {
+			line["ItemCode"] = item.ItemCode
+		}
+		if item.DiscountRate != 0 {
+			line["DiscountRate"] = item.DiscountRate
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// LineAmountTypes are the ways line amounts treat tax.
+var LineAmountTypes = []*smartform.Option{
+	{Value: "Exclusive", Label: "Tax Exclusive"},
+	{Value: "Inclusive", Label: "Tax Inclusive"},
+	{Value: "NoTax", Label: "No Tax"},
+}
@@ -171,9 +171,14 @@ func GetInvoiceProp(id string, title string, desc string, required bool, form *s
 		}
 		token := tokenSource.AccessToken
 
+		tenantID, err := ResolveTenant(token, input.TenantID)
+		if err != nil {
+			return nil, err
+		}
+
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Authorization", "Bearer "+token)
-		req.Header.Set("Xero-Tenant-Id", input.TenantID)
+		req.Header.Set("Xero-Tenant-Id", tenantID)
 
 		client := &http.Client{}
 		resp, err := client.Do(req)
@@ -0,0 +1,155 @@
+package triggers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/extensions/internal/polling"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+const createdContactsKey = "createdContacts"
+
+// maxHistoryLookups bounds the history requests of a poll.
+const maxHistoryLookups = 50
+
+type contactCreatedTriggerProps struct {
+	TenantID string `json:"tenant_id"`
+}
+
+type ContactCreatedTrigger struct{}
+
+func (t *ContactCreatedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "contact_created",
+		DisplayName:   "Contact Created",
+		Description:   "Triggered when a new customer or supplier contact is added to a Xero organization.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: contactCreatedDocs,
+		SampleOutput: []map[string]any{
+			{
+				"ContactID":     "bd2270c3-8706-4c11-9cfb-000b551c3f51",
+				"ContactStatus": "ACTIVE",
+				"Name":          "ABC Limited",
+				"EmailAddress":  "a.dutchess@abclimited.com",
+				"IsCustomer":    false,
+				"IsSupplier":    false,
+			},
+		},
+	}
+}
+
+func (t *ContactCreatedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("contact_created", "Contact Created")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the contactCreatedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *ContactCreatedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the contactCreatedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *ContactCreatedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the contacts created since the last run. Contacts carry
+// no creation date, so each contact modified since then is checked
+// against its history.
+func (t *ContactCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[contactCreatedTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	since := shared.PollSince(ctx)
+	contacts, err := modifiedSince(client, "/Contacts", "Contacts", url.Values{}, since)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(contacts))
+	for _, contact := range contacts {
+		id, _ := contact["ContactID"].(string)
+		ids = append(ids, id)
+	}
+	fresh := polling.Unseen(ctx, createdContactsKey, ids)
+
+	created := []map[string]interface{}{}
+	lookups := 0
+	for i, contact := range contacts {
+		if !fresh[ids[i]] {
+			continue
+		}
+		if lookups == maxHistoryLookups {
+			ctx.Logger().Warn("too many modified xero contacts, skipping the rest", "limit", maxHistoryLookups)
+			break
+		}
+		lookups++
+
+		createdAt, err := contactCreatedAt(client, ids[i])
+		if err != nil {
+			return nil, err
+		}
+		if !createdAt.IsZero() && !createdAt.Before(since) {
+			created = append(created, contact)
+		}
+	}
+	return created, nil
+}
+
+// contactCreatedAt returns when the contact was created, from the first
+// entry of its history.
+func contactCreatedAt(client *shared.Client, id string) (time.Time, error) {
+	var resp struct {
+		HistoryRecords []struct {
+			Changes string `json:"Changes"`
+			DateUTC string `json:"DateUTC"`
+		} `json:"HistoryRecords"`
+	}
+	if err := client.Do(http.MethodGet, "/Contacts/"+id+"/History", nil, &resp); err != nil {
+		return time.Time{}, err
+	}
+	for _, record := range resp.HistoryRecords {
+		if record.Changes == "Created" {
+			return shared.ParseDate(record.DateUTC)
+		}
+	}
+	return time.Time{}, nil
+}
+
+func (t *ContactCreatedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *ContactCreatedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *ContactCreatedTrigger) SampleData() sdkcore.JSON {
+	return map[string]any{
+		"ContactID": "bd2270c3-8706-4c11-9cfb-000b551c3f51",
+		"Name":      "ABC Limited",
+	}
+}
+
+func NewContactCreatedTrigger() sdk.Trigger {
+	return &ContactCreatedTrigger{}
+}
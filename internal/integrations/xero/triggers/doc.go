@@ -4,3 +4,9 @@ import _ "embed"
 
 //go:embed new_invoice.md
 var newInvoiceDocs string
+
+//go:embed invoice_paid.md
+var invoicePaidDocs string
+
+//go:embed contact_created.md
+var contactCreatedDocs string
@@ -0,0 +1,134 @@
+package triggers
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+	"github.com/wakflo/extensions/internal/polling"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+const paidInvoicesKey = "paidInvoices"
+
+type invoicePaidTriggerProps struct {
+	TenantID string `json:"tenant_id"`
+	Type     string `json:"type"`
+}
+
+type InvoicePaidTrigger struct{}
+
+func (t *InvoicePaidTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "invoice_paid",
+		DisplayName:   "Invoice Paid",
+		Description:   "Triggered when an invoice or bill is fully paid.",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: invoicePaidDocs,
+		SampleOutput:  []map[string]any{paidInvoiceSample},
+	}
+}
+
+func (t *InvoicePaidTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("invoice_paid", "Invoice Paid")
+
+	shared.GetTenantProps("tenant_id", "Organization", shared.TenantHelp, false, form)
+
+	form.SelectField("type", "Type").
+		Required(false).
+		DefaultValue("ACCREC").
+		AddOption("ACCREC", "Sales invoices").
+		AddOption("ACCPAY", "Bills").
+		HelpText("Leave empty for both.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the invoicePaidTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *InvoicePaidTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the invoicePaidTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *InvoicePaidTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the invoices modified since the last run that are now
+// paid. An invoice edited after it was paid doesn't fire again.
+func (t *InvoicePaidTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[invoicePaidTriggerProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClient(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("Statuses", "PAID")
+	if input.Type != "" {
+		params.Set("where", "Type=="+strconv.Quote(input.Type))
+	}
+
+	invoices, err := modifiedSince(client, "/Invoices", "Invoices", params, shared.PollSince(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(invoices))
+	for _, invoice := range invoices {
+		id, _ := invoice["InvoiceID"].(string)
+		ids = append(ids, id)
+	}
+	fresh := polling.Unseen(ctx, paidInvoicesKey, ids)
+
+	paid := make([]map[string]interface{}, 0, len(fresh))
+	for i, invoice := range invoices {
+		if fresh[ids[i]] {
+			paid = append(paid, invoice)
+		}
+	}
+	return paid, nil
+}
+
+func (t *InvoicePaidTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *InvoicePaidTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *InvoicePaidTrigger) SampleData() sdkcore.JSON {
+	return paidInvoiceSample
+}
+
+func NewInvoicePaidTrigger() sdk.Trigger {
+	return &InvoicePaidTrigger{}
+}
+
+var paidInvoiceSample = map[string]any{
+	"InvoiceID":       "243216c5-369e-4056-ac67-05388f86dc81",
+	"InvoiceNumber":   "INV-0041",
+	"Type":            "ACCREC",
+	"Status":          "PAID",
+	"Reference":       "#1001",
+	"CurrencyCode":    "USD",
+	"Total":           44.00,
+	"AmountPaid":      44.00,
+	"AmountDue":       0.00,
+	"FullyPaidOnDate": "/Date(1741737600000+0000)/",
+	"Contact": map[string]any{
+		"ContactID": "bd2270c3-8706-4c11-9cfb-000b551c3f51",
+		"Name":      "ABC Limited",
+	},
+}
@@ -0,0 +1,42 @@
+package triggers
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/wakflo/extensions/internal/integrations/xero/shared"
+)
+
+// pageSize is the number of records Xero returns per page.
+const pageSize = 100
+
+// maxPages bounds a poll, to stay within the API rate limit.
+const maxPages = 10
+
+// modifiedSince pages through the records of an endpoint changed after
+// since, which Xero filters with the If-Modified-Since header.
+func modifiedSince(client *shared.Client, endpoint, key string, params url.Values, since time.Time) ([]map[string]interface{}, error) {
+	var records []map[string]interface{}
+	for page := 1; page <= maxPages; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		var resp map[string]json.RawMessage
+		if err := client.GetModifiedSince(endpoint+"?"+params.Encode(), since, &resp); err != nil {
+			return nil, err
+		}
+
+		var batch []map[string]interface{}
+		if raw, ok := resp[key]; ok {
+			if err := json.Unmarshal(raw, &batch); err != nil {
+				return nil, err
+			}
+		}
+		records = append(records, batch...)
+		if len(batch) < pageSize {
+			break
+		}
+	}
+	return records, nil
+}
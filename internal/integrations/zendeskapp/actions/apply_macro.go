@@ -0,0 +1,113 @@
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type applyMacroActionProps struct {
+	TicketID json.Number `json:"ticket_id"`
+	MacroID  json.Number `json:"macro_id"`
+	Preview  bool        `json:"preview"`
+}
+
+type ApplyMacroAction struct{}
+
+func (a *ApplyMacroAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "apply_macro",
+		DisplayName:   "Apply Macro",
+		Description:   "Apply a Zendesk macro to a ticket, making its changes and adding its comment.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: applyMacroDocs,
+		SampleOutput:  ticketSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *ApplyMacroAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("apply_macro", "Apply Macro")
+
+	registerTicketIDProps(form)
+
+	shared.RegisterMacrosProps(form)
+
+	form.CheckboxField("preview", "Preview Only").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Return the changes the macro would make without saving them.")
+
+	schema := form.Build()
+	return schema
+}
+
+// Perform executes the action. Zendesk returns the ticket and comment a
+// macro produces without saving them, so they are saved as an update.
+func (a *ApplyMacroAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[applyMacroActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := shared.ParseID("ticket_id", input.TicketID)
+	if err != nil {
+		return nil, err
+	}
+	macroID, err := shared.ParseID("macro_id", input.MacroID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.Request(http.MethodGet, fmt.Sprintf("/tickets/%d/macros/%d/apply.json", id, macroID), nil)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := shared.Record(result, "result")
+	if err != nil {
+		return nil, err
+	}
+	if input.Preview {
+		return applied, nil
+	}
+
+	ticket, err := shared.Record(applied, "ticket")
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"id", "url", "created_at", "updated_at"} {
+		delete(ticket, key)
+	}
+	if comment, ok := applied["comment"].(map[string]interface{}); ok {
+		if body, _ := comment["html_body"].(string); body != "" {
+			ticket["comment"] = map[string]interface{}{
+				"html_body": body,
+				"public":    comment["public"],
+			}
+		}
+	}
+
+	return updateTicket(client, id, ticket)
+}
+
+func (a *ApplyMacroAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewApplyMacroAction() sdk.Action {
+	return &ApplyMacroAction{}
+}
@@ -0,0 +1,82 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type assignTicketActionProps struct {
+	TicketID   json.Number `json:"ticket_id"`
+	GroupID    json.Number `json:"group_id"`
+	AssigneeID json.Number `json:"assignee_id"`
+}
+
+type AssignTicketAction struct{}
+
+func (a *AssignTicketAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "assign_ticket",
+		DisplayName:   "Assign Ticket",
+		Description:   "Assign a Zendesk ticket to a group, an agent, or an agent within a group.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: assignTicketDocs,
+		SampleOutput:  ticketSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *AssignTicketAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("assign_ticket", "Assign Ticket")
+
+	registerTicketIDProps(form)
+
+	shared.RegisterGroupsProps(form, false)
+	shared.RegisterAgentsProps(form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *AssignTicketAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[assignTicketActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := shared.ParseID("ticket_id", input.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket := map[string]interface{}{}
+	if err := setAssignment(ticket, input.GroupID, input.AssigneeID); err != nil {
+		return nil, err
+	}
+	if len(ticket) == 0 {
+		return nil, errors.New("a group or an assignee is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateTicket(client, id, ticket)
+}
+
+func (a *AssignTicketAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewAssignTicketAction() sdk.Action {
+	return &AssignTicketAction{}
+}
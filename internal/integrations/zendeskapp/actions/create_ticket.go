@@ -0,0 +1,239 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createTicketActionProps struct {
+	Subject        string      `json:"subject"`
+	Comment        string      `json:"comment"`
+	Public         *bool       `json:"public"`
+	UploadTokens   string      `json:"upload_tokens"`
+	RequesterEmail string      `json:"requester_email"`
+	RequesterName  string      `json:"requester_name"`
+	Status         string      `json:"status"`
+	Priority       string      `json:"priority"`
+	Type           string      `json:"type"`
+	GroupID        json.Number `json:"group_id"`
+	AssigneeID     json.Number `json:"assignee_id"`
+	Tags           string      `json:"tags"`
+}
+
+var ticketSample = map[string]any{
+	"id":           35436,
+	"subject":      "Help with account setup",
+	"status":       "open",
+	"priority":     "normal",
+	"type":         "question",
+	"requester_id": 20978392,
+	"assignee_id":  235323,
+	"group_id":     98738,
+	"tags":         []string{"onboarding"},
+	"created_at":   "2024-05-01T10:00:00Z",
+	"updated_at":   "2024-05-01T10:05:00Z",
+}
+
+type CreateTicketAction struct{}
+
+func (a *CreateTicketAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_ticket",
+		DisplayName:   "Create Ticket",
+		Description:   "Create a Zendesk ticket with its first comment, requester, assignment and attachments.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createTicketDocs,
+		SampleOutput:  ticketSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreateTicketAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_ticket", "Create Ticket")
+
+	form.TextField("subject", "Subject").
+		Placeholder("Enter the ticket subject").
+		Required(true).
+		HelpText("The subject of the ticket")
+
+	registerCommentProps(form, true)
+
+	form.TextField("requester_email", "Requester Email").
+		Required(false).
+		HelpText("The email of the customer the ticket is for. A user is created when there is none. Defaults to the agent.")
+
+	form.TextField("requester_name", "Requester Name").
+		Required(false).
+		HelpText("The name of the requester when a user is created")
+
+	registerTicketProps(form)
+
+	shared.RegisterGroupsProps(form, false)
+	shared.RegisterAgentsProps(form)
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *CreateTicketAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createTicketActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Subject) == "" {
+		return nil, errors.New("subject is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := commentFrom(ctx, client, input.Comment, input.Public, input.UploadTokens)
+	if err != nil {
+		return nil, err
+	}
+	if comment == nil {
+		return nil, errors.New("comment is required")
+	}
+
+	ticket := map[string]interface{}{
+		"subject": strings.TrimSpace(input.Subject),
+		"comment": comment,
+	}
+	if requester := strings.TrimSpace(input.RequesterEmail); requester != "" {
+		ticket["requester"] = map[string]interface{}{
+			"email": requester,
+			"name":  strings.TrimSpace(input.RequesterName),
+		}
+	}
+	setTicketFields(ticket, input.Status, input.Priority, input.Type)
+	if tags := crm.SplitTags(input.Tags); len(tags) > 0 {
+		ticket["tags"] = tags
+	}
+	if err := setAssignment(ticket, input.GroupID, input.AssigneeID); err != nil {
+		return nil, err
+	}
+
+	result, err := client.Request(http.MethodPost, "/tickets.json", map[string]interface{}{"ticket": ticket})
+	if err != nil {
+		return nil, err
+	}
+	return shared.Record(result, "ticket")
+}
+
+func (a *CreateTicketAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// registerCommentProps adds the fields of a ticket comment.
+func registerCommentProps(form *smartform.FormBuilder, required bool) {
+	form.TextareaField("comment", "Comment").
+		Required(required).
+		HelpText("The text of the comment. HTML is kept.")
+
+	form.CheckboxField("public", "Public").
+		Required(false).
+		DefaultValue(true).
+		HelpText("Whether the requester sees the comment. Private comments are internal notes for agents.")
+
+	attachments := form.ArrayField("attachments", "Attachments")
+	attachment := attachments.ObjectTemplate("attachment", "")
+	attachment.FileField("file", "File").
+		Required(true).
+		HelpText("A file from a file field or a previous step, a URL, or a data URI.")
+	attachment.TextField("filename", "File Name").
+		Required(false).
+		HelpText("The name the requester sees. Defaults to the file's name.")
+
+	form.TextField("upload_tokens", "Upload Tokens").
+		Required(false).
+		HelpText("Comma-separated tokens of files uploaded with Upload Attachment.")
+}
+
+// registerTicketProps adds the status, priority and type of a ticket.
+func registerTicketProps(form *smartform.FormBuilder) {
+	form.SelectField("status", "Status").
+		Required(false).
+		AddOptions(shared.TicketStatuses...)
+
+	form.SelectField("priority", "Priority").
+		Required(false).
+		AddOptions(shared.TicketPriorities...)
+
+	form.SelectField("type", "Type").
+		Required(false).
+		AddOptions(shared.TicketTypes...)
+}
+
+// commentFrom builds a comment from its text, the attachments of the
+// input, which are uploaded first, and the tokens of earlier uploads. It is nil when there is neither.
+func commentFrom(ctx sdkcontext.PerformContext, client *shared.ClientType, body string, public *bool, tokens string) (map[string]interface{}, error) {
+	uploads, err := client.UploadAttachments(ctx.Context(), ctx.Files(), ctx.Input()["attachments"])
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range strings.Split(tokens, ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			uploads = append(uploads, token)
+		}
+	}
+
+	if strings.TrimSpace(body) == "" {
+		if len(uploads) > 0 {
+			return nil, errors.New("a comment is required with attachments")
+		}
+		return nil, nil
+	}
+
+	comment := map[string]interface{}{
+		"html_body": body,
+		"public":    public == nil || *public,
+	}
+	if len(uploads) > 0 {
+		comment["uploads"] = uploads
+	}
+	return comment, nil
+}
+
+// setTicketFields sets the status, priority and type of a ticket that
+// are given.
+func setTicketFields(ticket map[string]interface{}, status, priority, ticketType string) {
+	for key, value := range map[string]string{"status": status, "priority": priority, "type": ticketType} {
+		if value != "" {
+			ticket[key] = value
+		}
+	}
+}
+
+// setAssignment sets the group and agent of a ticket that are given.
+func setAssignment(ticket map[string]interface{}, groupID, assigneeID json.Number) error {
+	for key, value := range map[string]json.Number{"group_id": groupID, "assignee_id": assigneeID} {
+		if value == "" {
+			continue
+		}
+		id, err := shared.ParseID(key, value)
+		if err != nil {
+			return err
+		}
+		ticket[key] = id
+	}
+	return nil
+}
+
+func NewCreateTicketAction() sdk.Action {
+	return &CreateTicketAction{}
+}
@@ -0,0 +1,151 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type createUserActionProps struct {
+	Name           string      `json:"name"`
+	Email          string      `json:"email"`
+	Phone          string      `json:"phone"`
+	Role           string      `json:"role"`
+	OrganizationID json.Number `json:"organization_id"`
+	ExternalID     string      `json:"external_id"`
+	Tags           string      `json:"tags"`
+	Verified       bool        `json:"verified"`
+	Update         bool        `json:"update_existing"`
+}
+
+type CreateUserAction struct{}
+
+func (a *CreateUserAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "create_user",
+		DisplayName:   "Create User",
+		Description:   "Create a Zendesk user, such as a customer who contacted you elsewhere.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: createUserDocs,
+		SampleOutput:  userSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *CreateUserAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("create_user", "Create User")
+
+	form.TextField("name", "Name").
+		Required(true).
+		HelpText("The full name of the user")
+
+	form.TextField("email", "Email").
+		Required(false)
+
+	form.TextField("phone", "Phone").
+		Required(false)
+
+	form.SelectField("role", "Role").
+		Required(false).
+		AddOptions([]*smartform.Option{
+			{Value: "end-user", Label: "End User"},
+			{Value: "agent", Label: "Agent"},
+			{Value: "admin", Label: "Admin"},
+		}...).
+		HelpText("Defaults to end user")
+
+	form.TextField("organization_id", "Organization ID").
+		Required(false).
+		HelpText("The organization the user belongs to")
+
+	form.TextField("external_id", "External ID").
+		Required(false).
+		HelpText("The ID the user has in your other systems")
+
+	form.TextField("tags", "Tags").
+		Required(false).
+		HelpText("Comma-separated tags of the user")
+
+	form.CheckboxField("verified", "Verified").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Mark the email as verified, so no verification email is sent.")
+
+	form.CheckboxField("update_existing", "Update Existing").
+		Required(false).
+		DefaultValue(false).
+		HelpText("Update the user with the same email or external ID instead of failing.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *CreateUserAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[createUserActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+
+	user := map[string]interface{}{"name": strings.TrimSpace(input.Name)}
+	for key, value := range map[string]string{
+		"email":       input.Email,
+		"phone":       input.Phone,
+		"role":        input.Role,
+		"external_id": input.ExternalID,
+	} {
+		if value = strings.TrimSpace(value); value != "" {
+			user[key] = value
+		}
+	}
+	if input.OrganizationID != "" {
+		id, err := shared.ParseID("organization_id", input.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+		user["organization_id"] = id
+	}
+	if tags := crm.SplitTags(input.Tags); len(tags) > 0 {
+		user["tags"] = tags
+	}
+	if input.Verified {
+		user["verified"] = true
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/users.json"
+	if input.Update {
+		path = "/users/create_or_update.json"
+	}
+	result, err := client.Request(http.MethodPost, path, map[string]interface{}{"user": user})
+	if err != nil {
+		return nil, err
+	}
+	return shared.Record(result, "user")
+}
+
+func (a *CreateUserAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewCreateUserAction() sdk.Action {
+	return &CreateUserAction{}
+}
@@ -5,5 +5,29 @@ import _ "embed"
 //go:embed get_groups.md
 var getGroupsDocs string
 
-// go:embed get_tickets.md
+//go:embed get_tickets.md
 var getTicketsDocs string
+
+//go:embed create_ticket.md
+var createTicketDocs string
+
+//go:embed update_ticket.md
+var updateTicketDocs string
+
+//go:embed assign_ticket.md
+var assignTicketDocs string
+
+//go:embed apply_macro.md
+var applyMacroDocs string
+
+//go:embed search_users.md
+var searchUsersDocs string
+
+//go:embed create_user.md
+var createUserDocs string
+
+//go:embed find_organization.md
+var findOrganizationDocs string
+
+//go:embed upload_attachment.md
+var uploadAttachmentDocs string
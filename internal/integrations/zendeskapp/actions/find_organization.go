@@ -0,0 +1,124 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type findOrganizationActionProps struct {
+	OrganizationID json.Number `json:"organization_id"`
+	Name           string      `json:"name"`
+	ExternalID     string      `json:"external_id"`
+}
+
+type FindOrganizationAction struct{}
+
+func (a *FindOrganizationAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "find_organization",
+		DisplayName:   "Find Organization",
+		Description:   "Look up a Zendesk organization by its ID, name or external ID.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: findOrganizationDocs,
+		SampleOutput: map[string]any{
+			"organization": map[string]any{
+				"id":           57542,
+				"name":         "Acme Inc",
+				"domain_names": []string{"acme.com"},
+				"external_id":  "ACME-1",
+				"tags":         []string{"enterprise"},
+			},
+			"found": true,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *FindOrganizationAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("find_organization", "Find Organization")
+
+	form.TextField("organization_id", "Organization ID").
+		Required(false)
+
+	form.TextField("name", "Name").
+		Required(false).
+		HelpText("The exact name of the organization")
+
+	form.TextField("external_id", "External ID").
+		Required(false).
+		HelpText("The ID the organization has in your other systems")
+
+	schema := form.Build()
+	return schema
+}
+
+// Perform executes the action. An organization that is not found is
+// reported by found rather than an error, so workflows can branch on it.
+func (a *FindOrganizationAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[findOrganizationActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var organization map[string]interface{}
+	switch {
+	case input.OrganizationID != "":
+		id, err := shared.ParseID("organization_id", input.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+		result, err := client.Request(http.MethodGet, fmt.Sprintf("/organizations/%d.json", id), nil)
+		if err != nil {
+			return nil, err
+		}
+		organization, _ = result["organization"].(map[string]interface{})
+	case strings.TrimSpace(input.Name) != "" || strings.TrimSpace(input.ExternalID) != "":
+		query := url.Values{}
+		if externalID := strings.TrimSpace(input.ExternalID); externalID != "" {
+			query.Set("external_id", externalID)
+		} else {
+			query.Set("name", strings.TrimSpace(input.Name))
+		}
+		result, err := client.Request(http.MethodGet, "/organizations/search.json?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if organizations := shared.Records(result, "organizations"); len(organizations) > 0 {
+			organization = organizations[0]
+		}
+	default:
+		return nil, errors.New("an organization ID, name or external ID is required")
+	}
+
+	return map[string]any{
+		"organization": organization,
+		"found":        organization != nil,
+	}, nil
+}
+
+func (a *FindOrganizationAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewFindOrganizationAction() sdk.Action {
+	return &FindOrganizationAction{}
+}
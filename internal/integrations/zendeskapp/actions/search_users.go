@@ -0,0 +1,105 @@
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type searchUsersActionProps struct {
+	Query      string `json:"query"`
+	ExternalID string `json:"external_id"`
+}
+
+var userSample = map[string]any{
+	"id":              20978392,
+	"name":            "Jane Doe",
+	"email":           "jane.doe@example.com",
+	"role":            "end-user",
+	"organization_id": 57542,
+	"created_at":      "2024-05-01T10:00:00Z",
+}
+
+type SearchUsersAction struct{}
+
+func (a *SearchUsersAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "search_users",
+		DisplayName:   "Search Users",
+		Description:   "Find Zendesk users by name, email or phone, or by their external ID.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: searchUsersDocs,
+		SampleOutput: map[string]any{
+			"users": []map[string]any{userSample},
+			"count": 1,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *SearchUsersAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("search_users", "Search Users")
+
+	form.TextField("query", "Query").
+		Placeholder("jane.doe@example.com").
+		Required(false).
+		HelpText("A name, email or phone number, or a search such as role:agent")
+
+	form.TextField("external_id", "External ID").
+		Required(false).
+		HelpText("The ID the user has in your other systems. Used instead of the query.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *SearchUsersAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[searchUsersActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if externalID := strings.TrimSpace(input.ExternalID); externalID != "" {
+		query.Set("external_id", externalID)
+	} else if q := strings.TrimSpace(input.Query); q != "" {
+		query.Set("query", q)
+	} else {
+		return nil, errors.New("a query or an external ID is required")
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.Request(http.MethodGet, "/users/search.json?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	users := shared.Records(result, "users")
+
+	return map[string]any{
+		"users": users,
+		"count": len(users),
+	}, nil
+}
+
+func (a *SearchUsersAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewSearchUsersAction() sdk.Action {
+	return &SearchUsersAction{}
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type updateTicketActionProps struct {
+	TicketID     json.Number `json:"ticket_id"`
+	Subject      string      `json:"subject"`
+	Comment      string      `json:"comment"`
+	Public       *bool       `json:"public"`
+	UploadTokens string      `json:"upload_tokens"`
+	Status       string      `json:"status"`
+	Priority     string      `json:"priority"`
+	Type         string      `json:"type"`
+	Tags         string      `json:"tags"`
+}
+
+type UpdateTicketAction struct{}
+
+func (a *UpdateTicketAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "update_ticket",
+		DisplayName:   "Update Ticket",
+		Description:   "Update a Zendesk ticket and add a public reply or a private note to it. Fields left empty are kept.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: updateTicketDocs,
+		SampleOutput:  ticketSample,
+		Settings:      sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpdateTicketAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("update_ticket", "Update Ticket")
+
+	registerTicketIDProps(form)
+
+	form.TextField("subject", "Subject").
+		Required(false)
+
+	registerCommentProps(form, false)
+
+	registerTicketProps(form)
+
+	form.TextField("tags", "Tags").
+		Required(false).
+		HelpText("Comma-separated tags to add to the ticket. Existing tags are kept.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *UpdateTicketAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[updateTicketActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := shared.ParseID("ticket_id", input.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket := map[string]interface{}{}
+	if subject := strings.TrimSpace(input.Subject); subject != "" {
+		ticket["subject"] = subject
+	}
+	comment, err := commentFrom(ctx, client, input.Comment, input.Public, input.UploadTokens)
+	if err != nil {
+		return nil, err
+	}
+	if comment != nil {
+		ticket["comment"] = comment
+	}
+	setTicketFields(ticket, input.Status, input.Priority, input.Type)
+	if tags := crm.SplitTags(input.Tags); len(tags) > 0 {
+		ticket["additional_tags"] = tags
+	}
+	if len(ticket) == 0 {
+		return nil, errors.New("nothing to update: give a comment or a field to change")
+	}
+
+	return updateTicket(client, id, ticket)
+}
+
+func (a *UpdateTicketAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+// registerTicketIDProps adds the field of the ticket an action works on.
+func registerTicketIDProps(form *smartform.FormBuilder) {
+	form.TextField("ticket_id", "Ticket ID").
+		Placeholder("Enter the ticket ID").
+		Required(true).
+		HelpText("The ID of the ticket, as shown in its URL")
+}
+
+// updateTicket saves the changes of a ticket and returns it.
+func updateTicket(client *shared.ClientType, id int64, ticket map[string]interface{}) (sdkcore.JSON, error) {
+	result, err := client.Request(http.MethodPut, fmt.Sprintf("/tickets/%d.json", id), map[string]interface{}{"ticket": ticket})
+	if err != nil {
+		return nil, err
+	}
+	return shared.Record(result, "ticket")
+}
+
+func NewUpdateTicketAction() sdk.Action {
+	return &UpdateTicketAction{}
+}
@@ -0,0 +1,105 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/email"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type uploadAttachmentActionProps struct {
+	Filename string `json:"filename"`
+	Token    string `json:"token"`
+}
+
+type UploadAttachmentAction struct{}
+
+func (a *UploadAttachmentAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upload_attachment",
+		DisplayName:   "Upload Attachment",
+		Description:   "Upload a file to Zendesk and get the token that attaches it to a ticket comment.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: uploadAttachmentDocs,
+		SampleOutput: map[string]any{
+			"token": "6bk3gql82em5nmf",
+			"attachment": map[string]any{
+				"id":           498483,
+				"file_name":    "invoice.pdf",
+				"content_type": "application/pdf",
+				"size":         48213,
+				"content_url":  "https://acme.zendesk.com/attachments/token/6bk3gql82em5nmf/?name=invoice.pdf",
+			},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UploadAttachmentAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upload_attachment", "Upload Attachment")
+
+	form.FileField("file", "File").
+		Required(true).
+		HelpText("A file from a file field or a previous step, a URL, or a data URI.")
+
+	form.TextField("filename", "File Name").
+		Required(false).
+		HelpText("The name the requester sees. Defaults to the file's name.")
+
+	form.TextField("token", "Token").
+		Required(false).
+		HelpText("The token of an earlier upload, to attach this file with it.")
+
+	schema := form.Build()
+	return schema
+}
+
+func (a *UploadAttachmentAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[uploadAttachmentActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := email.LoadAttachments(ctx.Context(), ctx.Files(), []interface{}{
+		map[string]interface{}{"file": ctx.Input()["file"], "filename": strings.TrimSpace(input.Filename)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(attachments) == 0 {
+		return nil, errors.New("file is required")
+	}
+	file := attachments[0]
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := client.Upload(file.Filename, file.ContentType, file.Content, strings.TrimSpace(input.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"token":      upload["token"],
+		"attachment": upload["attachment"],
+	}, nil
+}
+
+func (a *UploadAttachmentAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUploadAttachmentAction() sdk.Action {
+	return &UploadAttachmentAction{}
+}
@@ -34,6 +34,9 @@ func (z *Zendesk) Auth() *core.AuthMetadata {
 func (z *Zendesk) Triggers() []sdk.Trigger {
 	return []sdk.Trigger{
 		triggers.NewTicketCreatedTrigger(),
+		triggers.NewTicketUpdatedTrigger(),
+		triggers.NewTicketSolvedTrigger(),
+		triggers.NewSatisfactionRatingTrigger(),
 	}
 }
 
@@ -41,6 +44,14 @@ func (z *Zendesk) Actions() []sdk.Action {
 	return []sdk.Action{
 		actions.NewGetGroupsAction(),
 		actions.NewGetTicketsAction(),
+		actions.NewCreateTicketAction(),
+		actions.NewUpdateTicketAction(),
+		actions.NewAssignTicketAction(),
+		actions.NewApplyMacroAction(),
+		actions.NewSearchUsersAction(),
+		actions.NewCreateUserAction(),
+		actions.NewFindOrganizationAction(),
+		actions.NewUploadAttachmentAction(),
 	}
 }
 
@@ -0,0 +1,186 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/wakflo/extensions/internal/email"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// NewClient returns the client of the Zendesk account of the connection.
+// The subdomain may be given as acme, acme.zendesk.com or a URL.
+func NewClient(auth *sdkcontext.AuthContext) (*ClientType, error) {
+	if auth == nil {
+		return nil, errors.New("missing authentication data")
+	}
+	agentEmail, apiToken := strings.TrimSpace(auth.Extra["email"]), strings.TrimSpace(auth.Extra["api-token"])
+	if agentEmail == "" {
+		return nil, errors.New("missing zendesk email")
+	}
+	if apiToken == "" {
+		return nil, errors.New("missing zendesk api-token")
+	}
+
+	subdomain := strings.TrimSpace(auth.Extra["subdomain"])
+	subdomain = strings.TrimPrefix(strings.TrimPrefix(subdomain, "https://"), "http://")
+	subdomain, _, _ = strings.Cut(subdomain, "/")
+	subdomain = strings.TrimSuffix(subdomain, ".zendesk.com")
+	if subdomain == "" {
+		return nil, errors.New("missing zendesk subdomain")
+	}
+
+	return &ClientType{
+		BaseURL:  "https://" + subdomain + ".zendesk.com/api/v2",
+		Email:    agentEmail,
+		APIToken: apiToken,
+	}, nil
+}
+
+// Request calls the Zendesk API at a path such as /tickets.json and
+// decodes the JSON response, returning error responses as errors.
+func (c *ClientType) Request(method, path string, payload interface{}) (map[string]interface{}, error) {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling request: %v", err)
+		}
+		body = bytes.NewReader(data)
+	}
+	return c.send(method, path, "application/json", body)
+}
+
+func (c *ClientType) send(method, path, contentType string, body io.Reader) (map[string]interface{}, error) {
+	req, err := http.NewRequest(method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Email+"/token", c.APIToken)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("API request failed with status code %d: %s", resp.StatusCode, string(data))
+	}
+
+	result := map[string]interface{}{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("error unmarshaling response: %v", err)
+		}
+	}
+	return result, nil
+}
+
+// Upload uploads a file for a ticket comment and returns the upload,
+// whose token attaches it. Files given the token of an earlier upload
+// are added to it, so one token attaches them all.
+func (c *ClientType) Upload(filename, contentType string, content []byte, token string) (map[string]interface{}, error) {
+	query := url.Values{"filename": {filename}}
+	if token != "" {
+		query.Set("token", token)
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	result, err := c.send(http.MethodPost, "/uploads.json?"+query.Encode(), contentType, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	upload, ok := result["upload"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid response format: upload not found")
+	}
+	return upload, nil
+}
+
+// UploadAttachments uploads the files of an attachments field and
+// returns the tokens a comment takes, which is none without files.
+func (c *ClientType) UploadAttachments(ctx context.Context, files sdkcontext.FileResource, v interface{}) ([]string, error) {
+	attachments, err := email.LoadAttachments(ctx, files, v)
+	if err != nil {
+		return nil, err
+	}
+
+	token := ""
+	for _, a := range attachments {
+		upload, err := c.Upload(a.Filename, a.ContentType, a.Content, token)
+		if err != nil {
+			return nil, fmt.Errorf("error uploading %s: %v", a.Filename, err)
+		}
+		token, _ = upload["token"].(string)
+	}
+	if token == "" {
+		return nil, nil
+	}
+	return []string{token}, nil
+}
+
+// Record returns the record under key of a response, such as the ticket
+// of /tickets/{id}.json.
+func Record(result map[string]interface{}, key string) (map[string]interface{}, error) {
+	record, ok := result[key].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid response format: %s not found", key)
+	}
+	return record, nil
+}
+
+// Records returns the records under key of a response.
+func Records(result map[string]interface{}, key string) []map[string]interface{} {
+	list, _ := result[key].([]interface{})
+	records := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		if record, ok := item.(map[string]interface{}); ok {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// ParseID reads the ID of a record from a field, as a number or text.
+func ParseID(field string, value json.Number) (int64, error) {
+	text := strings.TrimSpace(string(value))
+	if text == "" {
+		return 0, fmt.Errorf("%s is required", field)
+	}
+	id, err := strconv.ParseInt(text, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%s must be a positive whole number, got %q", field, text)
+	}
+	return id, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// TicketStatuses are the statuses a ticket can be set to.
+var TicketStatuses = []*smartform.Option{
+	{Value: "new", Label: "New"},
+	{Value: "open", Label: "Open"},
+	{Value: "pending", Label: "Pending"},
+	{Value: "hold", Label: "On Hold"},
+	{Value: "solved", Label: "Solved"},
+	{Value: "closed", Label: "Closed"},
+}
+
+// TicketPriorities are the priorities of a ticket.
+var TicketPriorities = []*smartform.Option{
+	{Value: "low", Label: "Low"},
+	{Value: "normal", Label: "Normal"},
+	{Value: "high", Label: "High"},
+	{Value: "urgent", Label: "Urgent"},
+}
+
+// TicketTypes are the types of a ticket.
+var TicketTypes = []*smartform.Option{
+	{Value: "question", Label: "Question"},
+	{Value: "incident", Label: "Incident"},
+	{Value: "problem", Label: "Problem"},
+	{Value: "task", Label: "Task"},
+}
+
+// RegisterGroupsProps adds a select of the groups tickets can be assigned
+// to.
+func RegisterGroupsProps(form *smartform.FormBuilder, required bool) *smartform.FieldBuilder {
+	getGroups := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		items, err := options(ctx, "/groups/assignable.json", "groups", "name")
+		if err != nil {
+			return nil, err
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("group_id", "Group").
+		Placeholder("Select a group").
+		Required(required).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getGroups)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("The group the ticket is assigned to")
+}
+
+// RegisterAgentsProps adds a select of the agents and admins tickets can
+// be assigned to.
+func RegisterAgentsProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getAgents := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		items, err := options(ctx, "/users.json?role[]=agent&role[]=admin", "users", "name")
+		if err != nil {
+			return nil, err
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("assignee_id", "Assignee").
+		Placeholder("Select an agent").
+		Required(false).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getAgents)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("The agent the ticket is assigned to, who must be in the ticket's group")
+}
+
+// RegisterMacrosProps adds a select of the active macros.
+func RegisterMacrosProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	getMacros := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
+		items, err := options(ctx, "/macros/active.json", "macros", "title")
+		if err != nil {
+			return nil, err
+		}
+		return ctx.Respond(items, len(items))
+	}
+
+	return form.SelectField("macro_id", "Macro").
+		Placeholder("Select a macro").
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(&getMacros)).
+				WithSearchSupport().
+				End().
+				GetDynamicSource(),
+		).
+		HelpText("The macro to apply")
+}
+
+// options returns the first page of the records of a list endpoint as
+// select options named by the nameKey field.
+func options(ctx sdkcontext.DynamicFieldContext, path, key, nameKey string) ([]map[string]any, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.Request(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	records := Records(result, key)
+	items := make([]map[string]any, 0, len(records))
+	for _, record := range records {
+		if name, _ := record[nameKey].(string); name != "" {
+			items = append(items, map[string]any{
+				"id":   fmt.Sprint(record["id"]),
+				"name": name,
+			})
+		}
+	}
+	return items, nil
+}
@@ -4,3 +4,12 @@ import _ "embed"
 
 //go:embed new_ticket_created.md
 var newInvoiceDocs string
+
+//go:embed ticket_updated.md
+var ticketUpdatedDocs string
+
+//go:embed ticket_solved.md
+var ticketSolvedDocs string
+
+//go:embed satisfaction_rating_received.md
+var satisfactionRatingDocs string
@@ -2,13 +2,10 @@ package triggers
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"net/http"
 	"time"
 
 	"github.com/juicycleff/smartform/v1"
-	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	sdkcore "github.com/wakflo/go-sdk/v2/core"
@@ -28,7 +25,12 @@ func (t *TicketCreatedTrigger) Metadata() sdk.TriggerMetadata {
 		Type:          sdkcore.TriggerTypePolling,
 		Documentation: newInvoiceDocs,
 		SampleOutput: map[string]interface{}{
-			"message": "New ticket created",
+			"id":           35436,
+			"subject":      "Help with account setup",
+			"status":       "new",
+			"requester_id": 20978392,
+			"created_at":   "2024-05-01T10:00:00Z",
+			"updated_at":   "2024-05-01T10:00:00Z",
 		},
 	}
 }
@@ -52,46 +54,17 @@ func (t *TicketCreatedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
 	return nil
 }
 
-// Execute performs the main action logic of TicketCreatedTrigger by processing the input context and returning a JSON response.
-// It checks for new tickets in Zendesk and returns them.
+// Execute returns the tickets created since the trigger started, as read
+// from the incremental ticket export, that were not reported yet.
 func (t *TicketCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
-	authData := ctx.Auth()
-	if authData == nil {
-		return nil, errors.New("missing authentication data")
-	}
-
-	// Check if required auth details are present
-	email, ok := authData.Extra["email"]
-	if !ok || email == "" {
-		return nil, errors.New("missing zendesk email")
-	}
-
-	apiToken, ok := authData.Extra["api-token"]
-	if !ok || apiToken == "" {
-		return nil, errors.New("missing zendesk api-token")
-	}
-
-	subdomain, ok := authData.Extra["subdomain"]
-	if !ok || subdomain == "" {
-		return nil, errors.New("missing zendesk subdomain")
-	}
-
-	baseURL := fmt.Sprintf("https://%s.zendesk.com/api/v2", subdomain)
-	fullURL := baseURL + "/search.json?query=type:ticket"
-
-	lastRunTime, err := ctx.GetMetadata("lastrun")
-	if lastRunTime != nil {
-		createdAfter := lastRunTime.(*time.Time).UTC().Format("2006-01-02T15:04:05Z")
-		fullURL = fmt.Sprintf("%s+created>=%s", fullURL, createdAfter)
-	}
-
-	// Using the existing zendeskRequest function from the original code
-	response, err := shared.ZendeskRequest(http.MethodGet, fullURL, email, apiToken, nil)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching tickets: %v", err)
-	}
-
-	return response, nil
+	return pollTickets(ctx, "", func(ticket map[string]interface{}, since int64) string {
+		createdAt, _ := ticket["created_at"].(string)
+		at, err := time.Parse(time.RFC3339, createdAt)
+		if err != nil || at.Unix() < since {
+			return ""
+		}
+		return fmt.Sprint(ticket["id"])
+	})
 }
 
 func (t *TicketCreatedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
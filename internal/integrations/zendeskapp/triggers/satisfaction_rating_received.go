@@ -0,0 +1,176 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// ratingsKey is the metadata key of the Unix time the last poll read
+// satisfaction ratings up to.
+const ratingsKey = "ratingsSince"
+
+// maxRatingPages is the most pages of satisfaction ratings read per poll.
+const maxRatingPages = 10
+
+type satisfactionRatingProps struct {
+	Score string `json:"score"`
+}
+
+type SatisfactionRatingTrigger struct{}
+
+func (t *SatisfactionRatingTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "satisfaction_rating_received",
+		DisplayName:   "Satisfaction Rating Received",
+		Description:   "Triggers workflow when a customer rates their support experience (CSAT)",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: satisfactionRatingDocs,
+		SampleOutput: map[string]interface{}{
+			"id":           35121,
+			"score":        "good",
+			"comment":      "Quick and helpful, thanks!",
+			"ticket_id":    35436,
+			"requester_id": 20978392,
+			"assignee_id":  235323,
+			"group_id":     98738,
+			"created_at":   "2024-05-02T12:40:00Z",
+		},
+	}
+}
+
+func (t *SatisfactionRatingTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("satisfaction_rating_received", "Satisfaction Rating Received")
+
+	form.SelectField("score", "Score").
+		Required(false).
+		AddOptions([]*smartform.Option{
+			{Value: "good", Label: "Good"},
+			{Value: "bad", Label: "Bad"},
+		}...).
+		HelpText("Only trigger for this score. Defaults to every rating.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the SatisfactionRatingTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *SatisfactionRatingTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the SatisfactionRatingTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *SatisfactionRatingTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the satisfaction ratings given since the last poll.
+// Each poll reads the ratings between the time the last one read up to
+// and now, so none is returned twice; the first poll only records the
+// time.
+func (t *SatisfactionRatingTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[satisfactionRatingProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	since, first := loadRatingsSince(ctx)
+	if first {
+		return []map[string]interface{}{}, ctx.SetMetadata(ratingsKey, now)
+	}
+
+	score := input.Score
+	if score == "" {
+		score = "received"
+	}
+	query := url.Values{
+		"score":      {score},
+		"start_time": {strconv.FormatInt(since, 10)},
+		"end_time":   {strconv.FormatInt(now, 10)},
+		"page[size]": {"100"},
+	}
+
+	ratings := make([]map[string]interface{}, 0)
+	path := "/satisfaction_ratings.json?" + query.Encode()
+	for page := 0; page < maxRatingPages && path != ""; page++ {
+		result, err := client.Request(http.MethodGet, path, nil)
+		if err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, shared.Records(result, "satisfaction_ratings")...)
+
+		path = ""
+		meta, _ := result["meta"].(map[string]interface{})
+		links, _ := result["links"].(map[string]interface{})
+		if more, _ := meta["has_more"].(bool); more {
+			next, _ := links["next"].(string)
+			path = strings.TrimPrefix(next, client.BaseURL)
+		}
+	}
+
+	if err := ctx.SetMetadata(ratingsKey, now); err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (t *SatisfactionRatingTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *SatisfactionRatingTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *SatisfactionRatingTrigger) SampleData() sdkcore.JSON {
+	return map[string]any{
+		"id":        35121,
+		"score":     "good",
+		"ticket_id": 35436,
+	}
+}
+
+// loadRatingsSince returns the time the last poll read ratings up to,
+// and whether there was none.
+func loadRatingsSince(ctx sdkcontext.ExecuteContext) (int64, bool) {
+	stored, err := ctx.GetMetadata(ratingsKey)
+	if err != nil || stored == nil {
+		return 0, true
+	}
+
+	var since int64
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &since)
+	}
+	if err != nil || since <= 0 {
+		ctx.Logger().Warn("ignoring unreadable satisfaction rating time", "error", err)
+		return 0, true
+	}
+	return since, false
+}
+
+func NewSatisfactionRatingTrigger() sdk.Trigger {
+	return &SatisfactionRatingTrigger{}
+}
@@ -0,0 +1,103 @@
+package triggers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type TicketSolvedTrigger struct{}
+
+func (t *TicketSolvedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "ticket_solved",
+		DisplayName:   "Ticket Solved",
+		Description:   "Triggers workflow when a ticket is solved",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: ticketSolvedDocs,
+		SampleOutput: map[string]interface{}{
+			"id":          35436,
+			"subject":     "Help with account setup",
+			"status":      "solved",
+			"assignee_id": 235323,
+			"updated_at":  "2024-05-02T09:15:00Z",
+			"metric_set": map[string]interface{}{
+				"solved_at": "2024-05-02T09:15:00Z",
+				"reopens":   0,
+				"replies":   2,
+				"full_resolution_time_in_minutes": map[string]interface{}{
+					"calendar": 1395,
+					"business": 420,
+				},
+			},
+		},
+	}
+}
+
+func (t *TicketSolvedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("ticket_solved", "Ticket Solved")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the TicketSolvedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *TicketSolvedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the TicketSolvedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *TicketSolvedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the tickets solved since the trigger started that were
+// not reported yet. The metric set of each ticket tells when it was
+// solved, so solved tickets that are only updated, or closed, are not
+// reported again, while a ticket reopened and solved again is.
+func (t *TicketSolvedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	return pollTickets(ctx, "metric_sets", func(ticket map[string]interface{}, since int64) string {
+		if status := ticket["status"]; status != "solved" && status != "closed" {
+			return ""
+		}
+		set, ok := ticket["metric_set"].(map[string]interface{})
+		if !ok {
+			if ticket["status"] != "solved" {
+				return ""
+			}
+			return fmt.Sprint(ticket["id"])
+		}
+		solvedAt, _ := set["solved_at"].(string)
+		at, err := time.Parse(time.RFC3339, solvedAt)
+		if err != nil || at.Unix() < since {
+			return ""
+		}
+		return fmt.Sprint(ticket["id"], "@", solvedAt)
+	})
+}
+
+func (t *TicketSolvedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *TicketSolvedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *TicketSolvedTrigger) SampleData() sdkcore.JSON {
+	return map[string]any{
+		"id":      35436,
+		"subject": "Help with account setup",
+		"status":  "solved",
+	}
+}
+
+func NewTicketSolvedTrigger() sdk.Trigger {
+	return &TicketSolvedTrigger{}
+}
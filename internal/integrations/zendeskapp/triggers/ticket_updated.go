@@ -0,0 +1,178 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/zendeskapp/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// exportKey is the metadata key of where the ticket export of a trigger
+// left off.
+const exportKey = "ticketExport"
+
+type ticketUpdatedProps struct {
+	Status string `json:"status"`
+}
+
+type TicketUpdatedTrigger struct{}
+
+func (t *TicketUpdatedTrigger) Metadata() sdk.TriggerMetadata {
+	return sdk.TriggerMetadata{
+		ID:            "ticket_updated",
+		DisplayName:   "Ticket Updated",
+		Description:   "Triggers workflow when a ticket is updated, such as a new comment or a change of status or assignee",
+		Type:          sdkcore.TriggerTypePolling,
+		Documentation: ticketUpdatedDocs,
+		SampleOutput: map[string]interface{}{
+			"id":          35436,
+			"subject":     "Help with account setup",
+			"status":      "pending",
+			"priority":    "normal",
+			"assignee_id": 235323,
+			"group_id":    98738,
+			"created_at":  "2024-05-01T10:00:00Z",
+			"updated_at":  "2024-05-01T11:30:00Z",
+		},
+	}
+}
+
+func (t *TicketUpdatedTrigger) Props() *smartform.FormSchema {
+	form := smartform.NewForm("ticket_updated", "Ticket Updated")
+
+	form.SelectField("status", "Status").
+		Required(false).
+		AddOptions(shared.TicketStatuses...).
+		HelpText("Only trigger for tickets with this status after the update")
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Start initializes the TicketUpdatedTrigger, required for event and webhook triggers in a lifecycle context.
+func (t *TicketUpdatedTrigger) Start(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Stop shuts down the TicketUpdatedTrigger, cleaning up resources and performing necessary teardown operations.
+func (t *TicketUpdatedTrigger) Stop(ctx sdkcontext.LifecycleContext) error {
+	return nil
+}
+
+// Execute returns the tickets updated since the last poll, as read from
+// the incremental ticket export. Tickets created and not changed since
+// are left to the New Ticket Added trigger.
+func (t *TicketUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[ticketUpdatedProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets, err := pollTickets(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := make([]map[string]interface{}, 0, len(tickets))
+	for _, ticket := range tickets {
+		if ticket["created_at"] == ticket["updated_at"] {
+			continue
+		}
+		if input.Status != "" && ticket["status"] != input.Status {
+			continue
+		}
+		updated = append(updated, ticket)
+	}
+	return updated, nil
+}
+
+func (t *TicketUpdatedTrigger) Criteria(ctx context.Context) sdkcore.TriggerCriteria {
+	return sdkcore.TriggerCriteria{}
+}
+
+func (t *TicketUpdatedTrigger) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func (t *TicketUpdatedTrigger) SampleData() sdkcore.JSON {
+	return map[string]any{
+		"id":         35436,
+		"subject":    "Help with account setup",
+		"status":     "pending",
+		"updated_at": "2024-05-01T11:30:00Z",
+	}
+}
+
+// pollTickets reads the incremental ticket export from where the last
+// poll left off and stores where this one does. With event, it returns
+// only the tickets whose event, as named by event, was not reported yet;
+// event returns "" for tickets to skip. The first poll only records where
+// to start, and returns none.
+func pollTickets(ctx sdkcontext.ExecuteContext, include string, event func(ticket map[string]interface{}, since int64) string) ([]map[string]interface{}, error) {
+	authCtx, err := ctx.AuthContext()
+	if err != nil {
+		return nil, err
+	}
+	client, err := shared.NewClient(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now().Add(-time.Minute)
+	prev, first := loadExport(ctx)
+	tickets, next, err := shared.ExportTickets(client, prev, start, include)
+	if err != nil {
+		return nil, err
+	}
+	if first {
+		next.Since = start.Unix()
+	}
+
+	if event != nil && !first {
+		reported := make([]map[string]interface{}, 0, len(tickets))
+		for _, ticket := range tickets {
+			if key := event(ticket, next.Since); key != "" && next.Report(key) {
+				reported = append(reported, ticket)
+			}
+		}
+		tickets = reported
+	}
+
+	if err := ctx.SetMetadata(exportKey, next); err != nil {
+		return nil, err
+	}
+	if first {
+		return []map[string]interface{}{}, nil
+	}
+	return tickets, nil
+}
+
+// loadExport returns where the ticket export of the last poll left off,
+// and whether there was none.
+func loadExport(ctx sdkcontext.ExecuteContext) (shared.ExportState, bool) {
+	stored, err := ctx.GetMetadata(exportKey)
+	if err != nil || stored == nil {
+		return shared.ExportState{}, true
+	}
+
+	var state shared.ExportState
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &state)
+	}
+	if err != nil || state.Cursor == "" {
+		ctx.Logger().Warn("ignoring unreadable ticket export state", "error", err)
+		return shared.ExportState{}, true
+	}
+	return state, false
+}
+
+func NewTicketUpdatedTrigger() sdk.Trigger {
+	return &TicketUpdatedTrigger{}
+}
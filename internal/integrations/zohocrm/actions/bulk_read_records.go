@@ -0,0 +1,116 @@
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/integrations/zohocrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+type bulkReadRecordsActionProps struct {
+	Module   string `json:"module"`
+	Fields   string `json:"fields"`
+	Criteria string `json:"criteria"`
+	Page     int    `json:"page"`
+}
+
+type BulkReadRecordsAction struct{}
+
+func (a *BulkReadRecordsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "bulk_read_records",
+		DisplayName:   "Bulk Export Records",
+		Description:   "Start a Zoho CRM bulk read job exporting up to 200,000 records of a module per page.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: bulkReadRecordsDocs,
+		SampleOutput: map[string]any{
+			"job_id": "5725767000001245001",
+			"module": "Leads",
+			"page":   1,
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *BulkReadRecordsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("bulk_read_records", "Bulk Export Records")
+
+	form.SelectField("module", "Module").
+		Placeholder("Select a module").
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(shared.GetModulesFunction())).
+				WithSearchSupport().
+				End().GetDynamicSource(),
+		)
+
+	form.TextField("fields", "Fields").
+		Required(false).
+		HelpText("Comma-separated API names of the fields to export, such as Email,Last_Name. Leave empty for all fields.")
+
+	form.TextareaField("criteria", "Criteria").
+		Required(false).
+		HelpText(`A criteria object of the bulk read API, such as {"field": {"api_name": "Lead_Status"}, "comparator": "equal", "value": "Contacted"}.`)
+
+	form.NumberField("page", "Page").
+		Required(false).
+		DefaultValue(1).
+		HelpText("The page of 200,000 records to export.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *BulkReadRecordsAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[bulkReadRecordsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Module == "" {
+		return nil, fmt.Errorf("module is required")
+	}
+	if input.Page < 1 {
+		input.Page = 1
+	}
+
+	var fields []string
+	for _, field := range strings.Split(input.Fields, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+
+	var criteria map[string]interface{}
+	if strings.TrimSpace(input.Criteria) != "" {
+		if err := json.Unmarshal([]byte(input.Criteria), &criteria); err != nil {
+			return nil, fmt.Errorf("criteria must be a JSON object: %v", err)
+		}
+	}
+
+	jobID, err := shared.StartBulkRead(ctx.Auth(), input.Module, fields, criteria, input.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"job_id": jobID,
+		"module": input.Module,
+		"page":   input.Page,
+	}, nil
+}
+
+func (a *BulkReadRecordsAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewBulkReadRecordsAction() sdk.Action {
+	return &BulkReadRecordsAction{}
+}
@@ -0,0 +1,186 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/zohocrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// rowColumn numbers the records of an uploaded file. It isn't mapped to
+// a field, but comes back in the job result, so get_bulk_job reports
+// results by input row.
+const rowColumn = "_row"
+
+type bulkWriteRecordsActionProps struct {
+	Module    string `json:"module"`
+	Operation string `json:"operation"`
+	FindBy    string `json:"find_by"`
+}
+
+type BulkWriteRecordsAction struct{}
+
+func (a *BulkWriteRecordsAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "bulk_write_records",
+		DisplayName:   "Bulk Write Records",
+		Description:   "Insert, update or upsert many Zoho CRM records from a list or a CSV file with a bulk write job.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: bulkWriteRecordsDocs,
+		SampleOutput: map[string]any{
+			"total":     2,
+			"succeeded": 1,
+			"failed":    1,
+			"results": []map[string]any{
+				{"row": 1, "key": "jane.doe@example.com", "id": "5725767000001234001", "status": "queued"},
+				{"row": 2, "status": "failed", "error": "no value for Email"},
+			},
+			"job_ids": []string{"5725767000001234001"},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *BulkWriteRecordsAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("bulk_write_records", "Bulk Write Records")
+
+	form.SelectField("module", "Module").
+		Placeholder("Select a module").
+		Required(true).
+		WithDynamicOptions(
+			smartform.NewOptionsBuilder().
+				Dynamic().
+				WithFunctionOptions(sdk.WithDynamicFunctionCalling(shared.GetModulesFunction())).
+				WithSearchSupport().
+				End().GetDynamicSource(),
+		)
+
+	form.SelectField("operation", "Operation").
+		Required(true).
+		DefaultValue("upsert").
+		AddOption("upsert", "Create or update").
+		AddOption("insert", "Create").
+		AddOption("update", "Update").
+		HelpText("Whether records are created, updated, or created when no record matches.")
+
+	form.TextField("find_by", "Find By").
+		Required(false).
+		DefaultValue("Email").
+		HelpText("The unique field records are updated by, such as Email or id. Not used when creating.")
+
+	crm.RegisterBulkProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Perform uploads the records as CSV files of the most records a job
+// takes, and starts a job per file. Columns are mapped to the fields of
+// the same API name.
+func (a *BulkWriteRecordsAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[bulkWriteRecordsActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Module == "" {
+		return nil, fmt.Errorf("module is required")
+	}
+	if input.Operation == "" {
+		input.Operation = "upsert"
+	}
+	findBy := strings.TrimSpace(input.FindBy)
+	if input.Operation == "insert" {
+		findBy = ""
+	} else if findBy == "" {
+		return nil, fmt.Errorf("find by field is required to %s records", input.Operation)
+	}
+
+	records, err := crm.LoadRecords(ctx.Context(), ctx.Files(), ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]crm.RowResult, len(records))
+	var rows []map[string]interface{}
+	for i, record := range records {
+		results[i] = crm.RowResult{Row: i + 1}
+		if findBy != "" {
+			key := strings.TrimSpace(fmt.Sprint(record[findBy]))
+			if record[findBy] == nil || key == "" {
+				results[i].Status, results[i].Error = crm.RowFailed, "no value for "+findBy
+				continue
+			}
+			results[i].Key = key
+		}
+		row := map[string]interface{}{rowColumn: float64(i + 1)}
+		for name, value := range record {
+			row[name] = value
+		}
+		rows = append(rows, row)
+	}
+
+	auth := ctx.Auth()
+	jobIDs := []string{}
+	if len(rows) > 0 {
+		orgID, err := shared.OrgID(auth)
+		if err != nil {
+			return nil, err
+		}
+		for n, chunk := range crm.Chunk(rows, shared.MaxBulkWriteRecords) {
+			jobID, err := startBulkWrite(auth, orgID, input.Module, input.Operation, findBy, chunk, n+1)
+			for _, row := range chunk {
+				r := &results[int(row[rowColumn].(float64))-1]
+				if err != nil {
+					r.Status, r.Error = crm.RowFailed, err.Error()
+				} else {
+					r.Status, r.ID = crm.RowQueued, jobID
+				}
+			}
+			if err == nil {
+				jobIDs = append(jobIDs, jobID)
+			}
+		}
+	}
+
+	out := crm.BulkOutput(results)
+	out["job_ids"] = jobIDs
+	return out, nil
+}
+
+// startBulkWrite uploads a chunk of rows and starts its job.
+func startBulkWrite(auth *sdkcontext.AuthContext, orgID, module, operation, findBy string, rows []map[string]interface{}, part int) (string, error) {
+	first := []string{rowColumn}
+	if findBy != "" {
+		first = append(first, findBy)
+	}
+	csv, header, err := crm.WriteCSV(rows, first...)
+	if err != nil {
+		return "", err
+	}
+	mappings := make([]shared.FieldMapping, 0, len(header)-1)
+	for i, name := range header {
+		if name != rowColumn {
+			mappings = append(mappings, shared.FieldMapping{APIName: name, Index: i})
+		}
+	}
+
+	fileID, err := shared.UploadBulkFile(auth, orgID, fmt.Sprintf("%s_%d", module, part), csv)
+	if err != nil {
+		return "", err
+	}
+	return shared.StartBulkWrite(auth, operation, module, fileID, findBy, mappings)
+}
+
+func (a *BulkWriteRecordsAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewBulkWriteRecordsAction() sdk.Action {
+	return &BulkWriteRecordsAction{}
+}
@@ -64,12 +64,6 @@ func (a *CreateRecordAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSO
 		return nil, err
 	}
 
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	var dataMap map[string]interface{}
 	if err := json.Unmarshal([]byte(input.Data), &dataMap); err != nil {
 		return nil, fmt.Errorf("invalid JSON data: %v", err)
@@ -80,7 +74,7 @@ func (a *CreateRecordAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSO
 	}
 
 	endpoint := input.Module
-	result, err := shared.GetZohoCRMClient(token, http.MethodPost, endpoint, requestData)
+	result, err := shared.GetZohoCRMClient(ctx.Auth(), http.MethodPost, endpoint, requestData)
 	if err != nil {
 		if strings.Contains(err.Error(), "201") {
 			return map[string]interface{}{
@@ -72,14 +72,8 @@ func (a *DeleteRecordAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSO
 		return nil, err
 	}
 
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	endpoint := fmt.Sprintf("%s/%s", input.Module, input.RecordID)
-	result, err := shared.GetZohoCRMClient(token, http.MethodDelete, endpoint, nil)
+	result, err := shared.GetZohoCRMClient(ctx.Auth(), http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error calling Zoho CRM API: %v", err)
 	}
@@ -19,3 +19,15 @@ var getRecordDocs string
 
 //go:embed list_records.md
 var listRecordsDocs string
+
+//go:embed upsert_contact.md
+var upsertContactDocs string
+
+//go:embed bulk_write_records.md
+var bulkWriteRecordsDocs string
+
+//go:embed bulk_read_records.md
+var bulkReadRecordsDocs string
+
+//go:embed get_bulk_job.md
+var getBulkJobDocs string
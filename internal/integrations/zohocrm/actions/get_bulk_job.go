@@ -0,0 +1,196 @@
+package actions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/zohocrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// bulkPollInterval is how often a job is checked while waiting.
+const bulkPollInterval = 10 * time.Second
+
+// maxBulkWait bounds the wait of a step, which must finish within the
+// action timeout.
+const maxBulkWait = 10 * time.Minute
+
+type getBulkJobActionProps struct {
+	JobID       string  `json:"job_id"`
+	JobType     string  `json:"job_type"`
+	WaitSeconds float64 `json:"wait_seconds"`
+	Limit       int     `json:"limit"`
+}
+
+type GetBulkJobAction struct{}
+
+func (a *GetBulkJobAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "get_bulk_job",
+		DisplayName:   "Get Bulk Job",
+		Description:   "Check a Zoho CRM bulk write or export job, optionally waiting until it completes, and read its results.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: getBulkJobDocs,
+		SampleOutput: map[string]any{
+			"job_id":    "5725767000001234001",
+			"job_type":  "write",
+			"state":     "completed",
+			"done":      true,
+			"total":     2,
+			"succeeded": 1,
+			"failed":    1,
+			"results": []map[string]any{
+				{"row": 1, "key": "jane.doe@example.com", "id": "5725767000001240011", "status": "created"},
+				{"row": 2, "key": "john@example", "status": "failed", "error": "INVALID_DATA"},
+			},
+		},
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *GetBulkJobAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("get_bulk_job", "Get Bulk Job")
+
+	form.TextField("job_id", "Job ID").
+		Required(true).
+		HelpText("A job ID returned by Bulk Write Records or Bulk Export Records.")
+
+	form.SelectField("job_type", "Job Type").
+		Required(true).
+		DefaultValue(shared.BulkWrite).
+		AddOption(shared.BulkWrite, "Bulk write").
+		AddOption(shared.BulkRead, "Bulk export")
+
+	form.NumberField("wait_seconds", "Wait (seconds)").
+		Required(false).
+		DefaultValue(0).
+		HelpText("Check the job every few seconds until it completes or this time is up, at most 600. Leave at 0 to check once.")
+
+	form.NumberField("limit", "Record Limit").
+		Required(false).
+		DefaultValue(1000).
+		HelpText("The most exported records to return from a completed export.")
+
+	schema := form.Build()
+
+	return schema
+}
+
+func (a *GetBulkJobAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[getBulkJobActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.JobType != shared.BulkRead {
+		input.JobType = shared.BulkWrite
+	}
+	jobID := strings.TrimSpace(input.JobID)
+	auth := ctx.Auth()
+
+	downloadURL := ""
+	wait := min(time.Duration(input.WaitSeconds)*time.Second, maxBulkWait)
+	job, err := crm.WaitForJob(ctx.Context(), wait, bulkPollInterval, func() (crm.Job, error) {
+		job, url, err := shared.GetBulkJob(auth, input.JobType, jobID)
+		downloadURL = url
+		return job, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]interface{}{}
+	if job.State == crm.JobCompleted && downloadURL != "" {
+		rows, err := shared.DownloadBulkResult(auth, downloadURL)
+		if err != nil {
+			return nil, err
+		}
+		if input.JobType == shared.BulkWrite {
+			out = crm.BulkOutput(writeResults(rows, findByOf(job)))
+		} else {
+			limit := input.Limit
+			if limit <= 0 {
+				limit = 1000
+			}
+			truncated := len(rows) > limit
+			if truncated {
+				rows = rows[:limit]
+			}
+			detail, _ := job.Detail.(map[string]interface{})
+			result, _ := detail["result"].(map[string]interface{})
+			out["records"] = rows
+			out["count"] = result["count"]
+			out["more_records"] = result["more_records"]
+			out["truncated"] = truncated
+		}
+	}
+
+	out["job_id"] = jobID
+	out["job_type"] = input.JobType
+	out["state"] = job.State
+	out["done"] = job.Done()
+	out["job"] = job.Detail
+	return out, nil
+}
+
+// writeResults reads the rows of a bulk write result. Zoho adds the
+// status, record ID and errors of each row to the uploaded columns.
+func writeResults(rows []map[string]interface{}, findBy string) []crm.RowResult {
+	results := make([]crm.RowResult, 0, len(rows))
+	for i, row := range rows {
+		r := crm.RowResult{Row: i + 1}
+		if n, err := strconv.Atoi(fmt.Sprint(row[rowColumn])); err == nil {
+			r.Row = n
+		}
+		if key, ok := row[findBy].(string); ok {
+			r.Key = key
+		}
+		r.ID, _ = row["RECORD_ID"].(string)
+
+		switch status, _ := row["STATUS"].(string); strings.ToUpper(status) {
+		case "ADDED":
+			r.Status = crm.RowCreated
+		case "UPDATED":
+			r.Status = crm.RowUpdated
+		default:
+			r.Status = crm.RowFailed
+			r.Error, _ = row["ERRORS"].(string)
+			if r.Error == "" {
+				r.Error = status
+			}
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+// findByOf returns the field a write job found records by.
+func findByOf(job crm.Job) string {
+	detail, _ := job.Detail.(map[string]interface{})
+	resources, _ := detail["resource"].([]interface{})
+	if len(resources) == 0 {
+		return ""
+	}
+	resource, _ := resources[0].(map[string]interface{})
+	switch findBy := resource["find_by"].(type) {
+	case string:
+		return findBy
+	case map[string]interface{}:
+		name, _ := findBy["api_name"].(string)
+		return name
+	}
+	return ""
+}
+
+func (a *GetBulkJobAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewGetBulkJobAction() sdk.Action {
+	return &GetBulkJobAction{}
+}
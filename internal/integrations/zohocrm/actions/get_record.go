@@ -78,14 +78,8 @@ func (a *GetRecordAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON,
 		return nil, err
 	}
 
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	endpoint := fmt.Sprintf("%s/%s", input.Module, input.RecordID)
-	result, err := shared.GetZohoCRMClient(token, http.MethodGet, endpoint, nil)
+	result, err := shared.GetZohoCRMClient(ctx.Auth(), http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error calling Zoho CRM API: %v", err)
 	}
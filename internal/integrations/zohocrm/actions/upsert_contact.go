@@ -0,0 +1,155 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/integrations/zohocrm/shared"
+	"github.com/wakflo/go-sdk/v2"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	sdkcore "github.com/wakflo/go-sdk/v2/core"
+)
+
+// upsertFieldMaps name the Zoho CRM fields of the standard contact
+// fields per module. A contact's company is a lookup to an account, so
+// only leads take it by name.
+var upsertFieldMaps = map[string]crm.FieldMap{
+	"Contacts": {
+		crm.FieldEmail:     "Email",
+		crm.FieldPhone:     "Phone",
+		crm.FieldFirstName: "First_Name",
+		crm.FieldLastName:  "Last_Name",
+	},
+	"Leads": {
+		crm.FieldEmail:     "Email",
+		crm.FieldPhone:     "Phone",
+		crm.FieldFirstName: "First_Name",
+		crm.FieldLastName:  "Last_Name",
+		crm.FieldCompany:   "Company",
+	},
+}
+
+type upsertContactActionProps struct {
+	Module string `json:"module"`
+}
+
+type UpsertContactAction struct{}
+
+func (a *UpsertContactAction) Metadata() sdk.ActionMetadata {
+	return sdk.ActionMetadata{
+		ID:            "upsert_contact",
+		DisplayName:   "Create or Update Contact",
+		Description:   "Find a Zoho CRM contact or lead by email and update it, or create it when there is none.",
+		Type:          sdkcore.ActionTypeAction,
+		Documentation: upsertContactDocs,
+		SampleOutput: crm.SampleOutput(map[string]any{
+			"id":         "5725767000000649013",
+			"Email":      "jane.doe@example.com",
+			"First_Name": "Jane",
+			"Last_Name":  "Doe",
+			"Phone":      "+1234567890",
+			"Tag":        []map[string]any{{"name": "lead"}},
+		}),
+		Settings: sdkcore.ActionSettings{},
+	}
+}
+
+func (a *UpsertContactAction) Properties() *smartform.FormSchema {
+	form := smartform.NewForm("upsert_contact", "Create or Update Contact")
+
+	form.SelectField("module", "Module").
+		Required(false).
+		AddOption("Contacts", "Contacts").
+		AddOption("Leads", "Leads").
+		DefaultValue("Contacts").
+		HelpText("Whether the person is kept as a contact or as a lead.")
+
+	crm.RegisterContactProps(form)
+
+	schema := form.Build()
+
+	return schema
+}
+
+// Perform upserts the record with Email as the duplicate check, so Zoho
+// reports whether it inserted or updated it, then adds the tags. Custom
+// fields are given by API name, such as Lead_Source.
+func (a *UpsertContactAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, error) {
+	input, err := sdk.InputToTypeSafely[upsertContactActionProps](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Module == "" {
+		input.Module = "Contacts"
+	}
+	fieldMap, ok := upsertFieldMaps[input.Module]
+	if !ok {
+		return nil, fmt.Errorf("unsupported module %q, expected Contacts or Leads", input.Module)
+	}
+
+	contact, err := crm.ContactFrom(ctx.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	record, ignored := fieldMap.Map(contact)
+	result, err := shared.GetZohoCRMClient(ctx.Auth(), http.MethodPost, input.Module+"/upsert", map[string]interface{}{
+		"data":                   []interface{}{record},
+		"duplicate_check_fields": []string{"Email"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		return nil, errors.New("no result in the Zoho CRM response")
+	}
+	outcome, _ := data[0].(map[string]interface{})
+	if outcome["status"] != "success" {
+		return nil, fmt.Errorf("zoho CRM rejected the record: %v %v", outcome["message"], outcome["details"])
+	}
+	details, _ := outcome["details"].(map[string]interface{})
+	id := fmt.Sprint(details["id"])
+
+	if len(contact.Tags) > 0 {
+		tags := make([]map[string]interface{}, 0, len(contact.Tags))
+		for _, tag := range contact.Tags {
+			tags = append(tags, map[string]interface{}{"name": tag})
+		}
+		_, err := shared.GetZohoCRMClient(ctx.Auth(), http.MethodPost, input.Module+"/actions/add_tags", map[string]interface{}{
+			"tags": tags,
+			"ids":  []string{id},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("adding tags: %w", err)
+		}
+	}
+
+	saved, err := shared.GetZohoCRMClient(ctx.Auth(), http.MethodGet, input.Module+"/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+	var savedRecord interface{} = details
+	if records, ok := saved["data"].([]interface{}); ok && len(records) > 0 {
+		savedRecord = records[0]
+	}
+
+	return crm.Result{
+		ID:      id,
+		Created: outcome["action"] == "insert",
+		Contact: savedRecord,
+		Ignored: ignored,
+	}.Output(), nil
+}
+
+func (a *UpsertContactAction) Auth() *sdkcore.AuthMetadata {
+	return nil
+}
+
+func NewUpsertContactAction() sdk.Action {
+	return &UpsertContactAction{}
+}
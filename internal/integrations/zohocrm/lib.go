@@ -47,6 +47,10 @@ func (n *ZohoCrm) Actions() []sdk.Action {
 		actions.NewSearchRecordsAction(),
 		actions.NewDeleteRecordAction(),
 		actions.NewListRecordsAction(),
+		actions.NewUpsertContactAction(),
+		actions.NewBulkWriteRecordsAction(),
+		actions.NewBulkReadRecordsAction(),
+		actions.NewGetBulkJobAction(),
 	}
 }
 
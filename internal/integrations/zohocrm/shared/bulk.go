@@ -0,0 +1,318 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/wakflo/extensions/internal/crm"
+	"github.com/wakflo/extensions/internal/zoho"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// MaxBulkWriteRecords is the most records a bulk write job takes.
+const MaxBulkWriteRecords = 25000
+
+// The types of bulk jobs.
+const (
+	BulkWrite = "write"
+	BulkRead  = "read"
+)
+
+// bulkURL returns the URL of a bulk API endpoint, which lives outside
+// the CRM API base.
+func bulkURL(auth *sdkcontext.AuthContext, endpoint string) string {
+	return zoho.APIDomain(auth) + "/crm/bulk/v7/" + endpoint
+}
+
+// bulkRequest calls the bulk API and decodes its JSON response.
+func bulkRequest(auth *sdkcontext.AuthContext, method, endpoint string, body interface{}) (map[string]interface{}, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, bulkURL(auth, endpoint), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	data, err := doRequest(auth, req)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return result, nil
+}
+
+// doRequest sends an authorized request and returns the response body,
+// or an error carrying it for a status other than 2xx.
+func doRequest(auth *sdkcontext.AuthContext, req *http.Request) ([]byte, error) {
+	accessToken, err := zoho.AccessToken(auth)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Zoho-oauthtoken "+accessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("API request failed with status code %d: %s", resp.StatusCode, string(data))
+	}
+	return data, nil
+}
+
+// OrgID returns the zgid of the connection's organization, which file
+// uploads are addressed to.
+func OrgID(auth *sdkcontext.AuthContext) (string, error) {
+	result, err := GetZohoCRMClient(auth, http.MethodGet, "org", nil)
+	if err != nil {
+		return "", err
+	}
+	orgs, _ := result["org"].([]interface{})
+	if len(orgs) == 0 {
+		return "", errors.New("invalid response format: org field not found")
+	}
+	org, _ := orgs[0].(map[string]interface{})
+	zgid := fmt.Sprint(org["zgid"])
+	if org["zgid"] == nil || zgid == "" {
+		return "", errors.New("invalid response format: zgid not found")
+	}
+	return zgid, nil
+}
+
+// UploadBulkFile zips a CSV file, uploads it for a bulk write and
+// returns the ID of the uploaded file.
+func UploadBulkFile(auth *sdkcontext.AuthContext, orgID, name string, csv []byte) (string, error) {
+	var zipped bytes.Buffer
+	zw := zip.NewWriter(&zipped)
+	f, err := zw.Create(name + ".csv")
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Write(csv); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("file", name+".zip")
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(zipped.Bytes()); err != nil {
+		return "", err
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, zoho.ContentDomain(auth)+"/crm/v7/upload", &body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("feature", "bulk-write")
+	req.Header.Set("X-CRM-ORG", orgID)
+
+	data, err := doRequest(auth, req)
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		Message string `json:"message"`
+		Details struct {
+			FileID string `json:"file_id"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return "", fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	if resp.Details.FileID == "" {
+		return "", fmt.Errorf("file not uploaded: %s", resp.Message)
+	}
+	return resp.Details.FileID, nil
+}
+
+// FieldMapping maps a column of an uploaded file to a field.
+type FieldMapping struct {
+	APIName string `json:"api_name"`
+	Index   int    `json:"index"`
+}
+
+// StartBulkWrite starts a bulk write job of an uploaded file and returns
+// its ID. The operation is insert, update or upsert; updates and upserts
+// find records by the findBy field.
+func StartBulkWrite(auth *sdkcontext.AuthContext, operation, module, fileID, findBy string, mappings []FieldMapping) (string, error) {
+	resource := map[string]interface{}{
+		"type":           "data",
+		"module":         map[string]string{"api_name": module},
+		"file_id":        fileID,
+		"field_mappings": mappings,
+	}
+	if operation != "insert" {
+		resource["find_by"] = findBy
+	}
+	result, err := bulkRequest(auth, http.MethodPost, "write", map[string]interface{}{
+		"operation":    operation,
+		"ignore_empty": true,
+		"resource":     []interface{}{resource},
+	})
+	if err != nil {
+		return "", err
+	}
+	details, _ := result["details"].(map[string]interface{})
+	if details == nil || details["id"] == nil {
+		return "", fmt.Errorf("bulk write not started: %v", result["message"])
+	}
+	return fmt.Sprint(details["id"]), nil
+}
+
+// StartBulkRead starts a bulk read job exporting the fields of a
+// module's records, all of them when none are given, and returns its
+// ID.
+func StartBulkRead(auth *sdkcontext.AuthContext, module string, fields []string, criteria map[string]interface{}, page int) (string, error) {
+	query := map[string]interface{}{
+		"module": map[string]string{"api_name": module},
+		"page":   page,
+	}
+	if len(fields) > 0 {
+		query["fields"] = fields
+	}
+	if criteria != nil {
+		query["criteria"] = criteria
+	}
+	result, err := bulkRequest(auth, http.MethodPost, "read", map[string]interface{}{"query": query})
+	if err != nil {
+		return "", err
+	}
+	jobs, _ := result["data"].([]interface{})
+	if len(jobs) == 0 {
+		return "", errors.New("invalid response format: data field not found")
+	}
+	job, _ := jobs[0].(map[string]interface{})
+	details, _ := job["details"].(map[string]interface{})
+	if details == nil || details["id"] == nil {
+		return "", fmt.Errorf("bulk read not started: %v", job["message"])
+	}
+	return fmt.Sprint(details["id"]), nil
+}
+
+// GetBulkJob returns the status of a bulk write or read job, and the URL
+// of its result once it has completed.
+func GetBulkJob(auth *sdkcontext.AuthContext, jobType, id string) (crm.Job, string, error) {
+	if id == "" {
+		return crm.Job{}, "", errors.New("job ID is required")
+	}
+	result, err := bulkRequest(auth, http.MethodGet, jobType+"/"+id, nil)
+	if err != nil {
+		return crm.Job{}, "", err
+	}
+
+	// Read jobs are wrapped in a data list, write jobs are not.
+	job := result
+	if jobs, ok := result["data"].([]interface{}); ok && len(jobs) > 0 {
+		job, _ = jobs[0].(map[string]interface{})
+	}
+	state, _ := job["state"].(string)
+	if state == "" {
+		state, _ = job["status"].(string)
+	}
+	downloadURL := ""
+	if r, ok := job["result"].(map[string]interface{}); ok {
+		downloadURL, _ = r["download_url"].(string)
+	}
+	return crm.Job{ID: id, State: jobState(state), Detail: job}, downloadURL, nil
+}
+
+// jobState maps the state of a bulk job to a job state.
+func jobState(state string) string {
+	switch strings.ToUpper(strings.ReplaceAll(state, " ", "")) {
+	case "COMPLETED":
+		return crm.JobCompleted
+	case "FAILED":
+		return crm.JobFailed
+	case "ADDED", "":
+		return crm.JobQueued
+	default:
+		return crm.JobRunning
+	}
+}
+
+// DownloadBulkResult downloads the zipped CSV result of a bulk job and
+// returns its rows.
+func DownloadBulkResult(auth *sdkcontext.AuthContext, downloadURL string) ([]map[string]interface{}, error) {
+	if !strings.HasPrefix(downloadURL, "https://") {
+		downloadURL = zoho.APIDomain(auth) + downloadURL
+	}
+	if !zoho.IsZohoDomain(downloadURL) {
+		return nil, fmt.Errorf("refusing to download the result from %s", downloadURL)
+	}
+	req, err := http.NewRequest(http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	data, err := doRequest(auth, req)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("reading the bulk result: %w", err)
+	}
+	for _, f := range zr.File {
+		if !strings.HasSuffix(strings.ToLower(f.Name), ".csv") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		csv, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		return crm.ParseRecords(csv)
+	}
+	return nil, errors.New("the bulk result has no CSV file")
+}
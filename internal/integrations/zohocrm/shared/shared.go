@@ -23,35 +23,37 @@ import (
 	"net/http"
 
 	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/zoho"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	sdkcore "github.com/wakflo/go-sdk/v2/core"
 )
 
-var (
-	// #nosec
-	tokenURL = "https://accounts.zoho.com/oauth/v2/token"
-	authURL  = "https://accounts.zoho.com/oauth/v2/auth"
-)
-
 var (
 	form = smartform.NewAuthForm("zoho-crm-auth", "Zoho CRM Oauth", smartform.AuthStrategyOAuth2)
 	_    = form.OAuthField("oauth", "Zoho CRM Oauth").
-		AuthorizationURL(authURL).
-		TokenURL(tokenURL).
-		Scopes([]string{"ZohoCRM.modules.ALL", "ZohoCRM.settings.ALL"}).
+		AuthorizationURL(zoho.SignInEndpoint.AuthURL).
+		TokenURL(zoho.SignInEndpoint.TokenURL).
+		Scopes([]string{"ZohoCRM.modules.ALL", "ZohoCRM.settings.ALL", "ZohoCRM.org.READ", "ZohoCRM.bulk.ALL", "ZohoFiles.files.ALL"}).
 		Build()
+	_ = zoho.RegisterRegionProps(form)
 )
 
 var SharedAuth = form.Build()
 
-const BaseURL = "https://www.zohoapis.com/crm/v7/"
+// BaseURL returns the CRM API URL of the connection's data center.
+func BaseURL(auth *sdkcontext.AuthContext) string {
+	return zoho.APIDomain(auth) + "/crm/v7/"
+}
 
-func GetZohoCRMClient(accessToken, method, endpoint string, body interface{}) (map[string]interface{}, error) {
-	fullURL := BaseURL + endpoint
+func GetZohoCRMClient(auth *sdkcontext.AuthContext, method, endpoint string, body interface{}) (map[string]interface{}, error) {
+	accessToken, err := zoho.AccessToken(auth)
+	if err != nil {
+		return nil, err
+	}
+	fullURL := BaseURL(auth) + endpoint
 
 	var req *http.Request
-	var err error
 
 	if body != nil {
 		bodyJSON, err := json.Marshal(body)
@@ -85,11 +87,14 @@ func GetZohoCRMClient(accessToken, method, endpoint string, body interface{}) (m
 		return nil, fmt.Errorf("error reading response body: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
 		return nil, fmt.Errorf("API request failed with status code %d: %s", resp.StatusCode, string(responseBody))
 	}
 
-	var result map[string]interface{}
+	result := map[string]interface{}{}
+	if len(responseBody) == 0 {
+		return result, nil
+	}
 	err = json.Unmarshal(responseBody, &result)
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshaling response: %v", err)
@@ -100,13 +105,7 @@ func GetZohoCRMClient(accessToken, method, endpoint string, body interface{}) (m
 
 func GetModulesFunction() *sdk.DynamicOptionsFn {
 	getModules := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
-		tokenSource := ctx.Auth().Token
-		if tokenSource == nil {
-			return nil, errors.New("missing authentication token")
-		}
-		token := tokenSource.AccessToken
-
-		result, err := GetZohoCRMClient(token, http.MethodGet, "settings/modules", nil)
+		result, err := GetZohoCRMClient(ctx.Auth(), http.MethodGet, "settings/modules", nil)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching modules: %v", err)
 		}
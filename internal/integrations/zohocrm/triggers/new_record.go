@@ -78,30 +78,24 @@ func (t *RecordUpdatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.J
 		return nil, err
 	}
 
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	var endpoint string
 
 	lr, err := ctx.GetMetadata("lastrun")
 
 	if lr == nil {
-		endpoint = fmt.Sprintf("https://www.zohoapis.com/crm/v7/%s/search", input.Module)
+		endpoint = fmt.Sprintf("%s/search", input.Module)
 	} else {
 		lastRunTime := lr.(*time.Time)
 		lastRunFormatted := lastRunTime.UTC().Format("2006-01-02T15:04:05+00:00")
 
 		encodedCriteria := url.QueryEscape(fmt.Sprintf("(Modified_Time:greater_than:%s)", lastRunFormatted))
 
-		endpoint = fmt.Sprintf("https://www.zohoapis.com/crm/v7/%s/search?criteria=%s",
+		endpoint = fmt.Sprintf("%s/search?criteria=%s",
 			input.Module,
 			encodedCriteria)
 	}
 
-	result, err := shared.GetZohoCRMClient(token, http.MethodGet, endpoint, nil)
+	result, err := shared.GetZohoCRMClient(ctx.Auth(), http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error calling Zoho CRM API: %v", err)
 	}
@@ -82,12 +82,6 @@ func (t *RecordCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.J
 		return nil, err
 	}
 
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	// Construct the search endpoint
 	var endpoint string
 
@@ -95,7 +89,7 @@ func (t *RecordCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.J
 
 	if lr == nil {
 		// If no last run time, fetch all records
-		endpoint = fmt.Sprintf("https://www.zohoapis.com/crm/v7/%s/search", input.Module)
+		endpoint = fmt.Sprintf("%s/search", input.Module)
 	} else {
 		// If last run time exists, use it in the criteria
 		lastRunTime := lr.(*time.Time)
@@ -104,13 +98,13 @@ func (t *RecordCreatedTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.J
 		// URL encode the criteria to handle special characters
 		encodedCriteria := url.QueryEscape(fmt.Sprintf("(Created_Time:greater_than:%s)", lastRunFormatted))
 
-		endpoint = fmt.Sprintf("https://www.zohoapis.com/crm/v7/%s/search?criteria=%s",
+		endpoint = fmt.Sprintf("%s/search?criteria=%s",
 			input.Module,
 			encodedCriteria)
 	}
 
 	// Make API call
-	result, err := shared.GetZohoCRMClient(token, http.MethodGet, endpoint, nil)
+	result, err := shared.GetZohoCRMClient(ctx.Auth(), http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error calling Zoho CRM API: %v", err)
 	}
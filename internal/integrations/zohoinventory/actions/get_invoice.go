@@ -2,7 +2,6 @@ package actions
 
 import (
 	// "errors"
-	"errors"
 	"fmt"
 
 	"github.com/juicycleff/smartform/v1"
@@ -56,16 +55,10 @@ func (a *GetInvoiceAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON,
 	}
 
 	// Get the token source from the auth context
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	url := fmt.Sprintf("/v1/invoices/%s?organization_id=%s",
 		input.InvoiceID, input.OrganizationID)
 
-	invoice, err := shared.GetZohoClient(token, url)
+	invoice, err := shared.GetZohoClient(ctx.Auth(), url)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,6 @@
 package actions
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/juicycleff/smartform/v1"
@@ -55,19 +54,13 @@ func (a *GetPaymentAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON,
 	}
 
 	// Get the token source from the auth context
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	url := fmt.Sprintf(
 		"/v1/customerpayments/%s?organization_id=%s",
 		input.PaymentID,
 		input.OrganizationID,
 	)
 
-	payments, err := shared.GetZohoClient(token, url)
+	payments, err := shared.GetZohoClient(ctx.Auth(), url)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,6 @@
 package actions
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/juicycleff/smartform/v1"
@@ -48,14 +47,8 @@ func (a *ListInvoicesAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSO
 	}
 
 	// Get the token source from the auth context
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	endpoint := "/v1/invoices/?organization_id=" + input.OrganizationID
-	result, err := shared.GetZohoClient(token, endpoint)
+	result, err := shared.GetZohoClient(ctx.Auth(), endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("error getting invoice list: %v", err)
 	}
@@ -1,8 +1,6 @@
 package actions
 
 import (
-	"errors"
-
 	"github.com/juicycleff/smartform/v1"
 	"github.com/wakflo/extensions/internal/integrations/zohoinventory/shared"
 	"github.com/wakflo/go-sdk/v2"
@@ -47,15 +45,9 @@ func (a *ListPaymentsAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSO
 	}
 
 	// Get the token source from the auth context
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	url := "/v1/customerpayments/?organization_id=" + input.OrganizationID
 
-	paymentList, err := shared.GetZohoClient(token, url)
+	paymentList, err := shared.GetZohoClient(ctx.Auth(), url)
 	if err != nil {
 		return nil, err
 	}
@@ -15,42 +15,47 @@
 package shared
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/gookit/goutil/arrutil"
 	"github.com/juicycleff/smartform/v1"
 	fastshot "github.com/opus-domini/fast-shot"
+	"github.com/wakflo/extensions/internal/zoho"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 
 	sdkcore "github.com/wakflo/go-sdk/v2/core"
 )
 
-var (
-	// #nosec
-	tokenURL = "https://accounts.zoho.com/oauth/v2/token"
-	authURL  = "https://accounts.zoho.com/oauth/v2/auth"
-)
-
 var (
 	form = smartform.NewAuthForm("zoho-auth", "Zoho Inventory Oauth", smartform.AuthStrategyOAuth2)
 	_    = form.OAuthField("oauth", "Zoho Inventory Oauth").
-		AuthorizationURL(authURL).
-		TokenURL(tokenURL).
+		AuthorizationURL(zoho.SignInEndpoint.AuthURL).
+		TokenURL(zoho.SignInEndpoint.TokenURL).
 		Scopes([]string{"ZohoInventory.FullAccess.all"}).
 		Build()
+	_ = zoho.RegisterRegionProps(form)
 )
 
 var SharedAuth = form.Build()
 
-const BaseURL = "https://www.zohoapis.com/inventory"
+// BaseURL returns the Inventory API URL of the connection's data center.
+func BaseURL(auth *sdkcontext.AuthContext) string {
+	return zoho.APIDomain(auth) + "/inventory"
+}
 
-func GetZohoClient(accessToken, endpoint string) (map[string]interface{}, error) {
-	fullURL := BaseURL + endpoint
+func GetZohoClient(auth *sdkcontext.AuthContext, endpoint string) (map[string]interface{}, error) {
+	accessToken, err := zoho.AccessToken(auth)
+	if err != nil {
+		return nil, err
+	}
+	fullURL := BaseURL(auth) + endpoint
 	req, err := http.NewRequest(http.MethodGet, fullURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %v", err)
@@ -84,15 +89,66 @@ func GetZohoClient(accessToken, endpoint string) (map[string]interface{}, error)
 	return result, nil
 }
 
+// Request calls the Inventory API of the connection's data center with
+// the organization's parameters and decodes the response into out. Zoho
+// reports failures with a non-zero code.
+func Request(auth *sdkcontext.AuthContext, method, endpoint string, params url.Values, body interface{}, out interface{}) error {
+	accessToken, err := zoho.AccessToken(auth)
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, BaseURL(auth)+endpoint+"?"+params.Encode(), reader)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Authorization", "Zoho-oauthtoken "+accessToken)
+	req.Header.Set("Content-Type", "application/json;charset=UTF-8")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %v", err)
+	}
+
+	var status struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return fmt.Errorf("API request failed with status code %d: %s", resp.StatusCode, string(data))
+	}
+	if status.Code != 0 {
+		return fmt.Errorf("zoho: %s", status.Message)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
 func GetOrganizationsProp(form *smartform.FormBuilder) *smartform.FieldBuilder {
 	getOrganizations := func(ctx sdkcontext.DynamicFieldContext) (*sdkcore.DynamicOptionsResponse, error) {
-		tokenSource := ctx.Auth().Token
-		if tokenSource == nil {
-			return nil, errors.New("missing authentication token")
+		token, err := zoho.AccessToken(ctx.Auth())
+		if err != nil {
+			return nil, err
 		}
-		token := tokenSource.AccessToken
 
-		client := fastshot.NewClient(BaseURL).
+		client := fastshot.NewClient(BaseURL(ctx.Auth())).
 			Auth().BearerToken(token).
 			Header().
 			AddAccept("application/json").
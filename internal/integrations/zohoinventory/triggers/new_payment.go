@@ -3,7 +3,6 @@ package triggers
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +11,7 @@ import (
 
 	"github.com/juicycleff/smartform/v1"
 	"github.com/wakflo/extensions/internal/integrations/zohoinventory/shared"
+	"github.com/wakflo/extensions/internal/zoho"
 	"github.com/wakflo/go-sdk/v2"
 	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 	sdkcore "github.com/wakflo/go-sdk/v2/core"
@@ -70,11 +70,10 @@ func (t *NewPaymentTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON
 		return nil, err
 	}
 
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
+	token, err := zoho.AccessToken(ctx.Auth())
+	if err != nil {
+		return nil, err
 	}
-	token := tokenSource.AccessToken
 
 	var fromDate string
 	// lastRunTime := ctx.Metadata().LastRun
@@ -85,7 +84,7 @@ func (t *NewPaymentTrigger) Execute(ctx sdkcontext.ExecuteContext) (sdkcore.JSON
 		fromDate = lastRunTime.(*time.Time).UTC().Format(time.RFC3339)
 	}
 
-	endpoint := shared.BaseURL + "/v1/customerpayments"
+	endpoint := shared.BaseURL(ctx.Auth()) + "/v1/customerpayments"
 
 	u, err := url.Parse(endpoint)
 	if err != nil {
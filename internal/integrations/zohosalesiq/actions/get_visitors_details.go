@@ -1,7 +1,6 @@
 package actions
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/juicycleff/smartform/v1"
@@ -61,12 +60,6 @@ func (c GetVisitorsDetailsAction) Perform(ctx sdkcontext.PerformContext) (sdkcor
 		return nil, err
 	}
 
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	viewID := input.ViewID
 
 	if viewID == "" {
@@ -75,7 +68,7 @@ func (c GetVisitorsDetailsAction) Perform(ctx sdkcontext.PerformContext) (sdkcor
 
 	url := fmt.Sprintf("/%s/visitorsview/%s/visitors", input.ScreenName, viewID)
 
-	visitors, err := shared.GetZohoClient(token, url)
+	visitors, err := shared.GetZohoClient(ctx.Auth(), url)
 	if err != nil {
 		return nil, err
 	}
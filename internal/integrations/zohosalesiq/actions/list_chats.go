@@ -1,7 +1,6 @@
 package actions
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/juicycleff/smartform/v1"
@@ -54,15 +53,9 @@ func (c ListChatsAction) Perform(ctx sdkcontext.PerformContext) (sdkcore.JSON, e
 		return nil, err
 	}
 
-	tokenSource := ctx.Auth().Token
-	if tokenSource == nil {
-		return nil, errors.New("missing authentication token")
-	}
-	token := tokenSource.AccessToken
-
 	url := fmt.Sprintf("/%s/chats", input.ScreenName)
 
-	chats, err := shared.GetZohoClient(token, url)
+	chats, err := shared.GetZohoClient(ctx.Auth(), url)
 	if err != nil {
 		return nil, err
 	}
@@ -21,31 +21,35 @@ import (
 	"net/http"
 
 	"github.com/juicycleff/smartform/v1"
-)
-
-var (
-	// #nosec
-	tokenURL = "https://accounts.zoho.com/oauth/v2/token"
-	authURL  = "https://accounts.zoho.com/oauth/v2/auth"
+	"github.com/wakflo/extensions/internal/zoho"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
 )
 
 var (
 	form = smartform.NewAuthForm("zoho-auth", "Zoho Oauth", smartform.AuthStrategyOAuth2)
 	_    = form.OAuthField("oauth", "Zoho Oauth").
-		AuthorizationURL(authURL).
-		TokenURL(tokenURL).
+		AuthorizationURL(zoho.SignInEndpoint.AuthURL).
+		TokenURL(zoho.SignInEndpoint.TokenURL).
 		Scopes([]string{
 			"SalesIQ.chatdetails.UPDATE SalesIQ.chatdetails.READ SalesIQ.visitordetails.UPDATE SalesIQ.visitordetails.READ",
 		}).
 		Build()
+	_ = zoho.RegisterRegionProps(form)
 )
 
 var ZohoSalesSharedAuth = form.Build()
 
-const baseURL = "https://salesiq.zoho.com/api/v1"
+// baseURL returns the SalesIQ API URL of the connection's data center.
+func baseURL(auth *sdkcontext.AuthContext) string {
+	return zoho.Resolve(auth).SalesIQURL() + "/api/v1"
+}
 
-func GetZohoClient(accessToken, url string) (map[string]interface{}, error) {
-	fullURL := baseURL + url
+func GetZohoClient(auth *sdkcontext.AuthContext, url string) (map[string]interface{}, error) {
+	accessToken, err := zoho.AccessToken(auth)
+	if err != nil {
+		return nil, err
+	}
+	fullURL := baseURL(auth) + url
 	req, err := http.NewRequest(http.MethodGet, fullURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %v", err)
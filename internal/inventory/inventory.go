@@ -0,0 +1,399 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inventory reconciles stock levels across systems. It works on
+// canonical SKUs: connectors translate them with a Mapping, read the
+// levels of each system, and apply the adjustments of the Report that
+// Reconcile returns. The Snapshot of the last sync is what lets changes
+// made in each system since then be told apart.
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+)
+
+// Rule decides the level every system is brought to.
+type Rule string
+
+const (
+	// SourceOfTruth copies the level of one system to the others.
+	SourceOfTruth Rule = "source_of_truth"
+	// Min brings every system down to the lowest level. It never raises
+	// stock, so restocks have to reach every system another way.
+	Min Rule = "min"
+	// Sum adds the changes each system saw since the last sync to the
+	// level agreed then, so sales on every channel are counted once.
+	Sum Rule = "sum"
+)
+
+// Line statuses.
+const (
+	StatusInSync   = "in_sync"
+	StatusAdjusted = "adjusted"
+	StatusConflict = "conflict"
+	StatusOversold = "oversold"
+	StatusMissing  = "missing"
+	StatusFailed   = "failed"
+)
+
+// Levels are the quantities of one system by canonical SKU.
+type Levels map[string]int
+
+// Snapshot is the state left by the last sync: the level every system
+// was brought to, and what each system held afterwards.
+type Snapshot struct {
+	Agreed   map[string]int            `json:"agreed"`
+	Seen     map[string]map[string]int `json:"seen"`
+	SyncedAt string                    `json:"synced_at,omitempty"`
+}
+
+func (s Snapshot) seen(system, sku string) (int, bool) {
+	q, ok := s.Seen[system][sku]
+	return q, ok
+}
+
+// Options configure a reconciliation.
+type Options struct {
+	Rule Rule
+	// Source is the system of record. It is required by SourceOfTruth
+	// and seeds Sum on the first sync of a SKU; afterwards Sum adjusts it
+	// like any other system. Add it to ReadOnly to never adjust it.
+	Source string
+	// ReadOnly systems are read and reported but never adjusted.
+	ReadOnly []string
+	// DryRun marks the report as a preview.
+	DryRun bool
+}
+
+// Adjustment moves one system's level of a SKU.
+type Adjustment struct {
+	System   string `json:"system"`
+	SKU      string `json:"sku"`
+	LocalSKU string `json:"local_sku"`
+	From     int    `json:"from"`
+	To       int    `json:"to"`
+	Delta    int    `json:"delta"`
+	Applied  bool   `json:"applied"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Line is the reconciliation of one SKU.
+type Line struct {
+	SKU         string         `json:"sku"`
+	Status      string         `json:"status"`
+	Target      int            `json:"target"`
+	Levels      map[string]int `json:"levels"`
+	Changes     map[string]int `json:"changes,omitempty"`
+	Missing     []string       `json:"missing,omitempty"`
+	Adjustments []*Adjustment  `json:"adjustments,omitempty"`
+	Note        string         `json:"note,omitempty"`
+}
+
+// Summary counts the lines of a report by outcome.
+type Summary struct {
+	SKUs        int `json:"skus"`
+	InSync      int `json:"in_sync"`
+	Adjusted    int `json:"adjusted"`
+	Conflicts   int `json:"conflicts"`
+	Oversold    int `json:"oversold"`
+	Missing     int `json:"missing"`
+	Failed      int `json:"failed"`
+	Adjustments int `json:"adjustments"`
+}
+
+// Report is the outcome of a reconciliation.
+type Report struct {
+	Rule    Rule      `json:"rule"`
+	Source  string    `json:"source,omitempty"`
+	DryRun  bool      `json:"dry_run"`
+	Systems []string  `json:"systems"`
+	Summary Summary   `json:"summary"`
+	Lines   []*Line   `json:"lines"`
+	RanAt   time.Time `json:"ran_at"`
+}
+
+// Reconcile computes the target level of every SKU seen in any system and
+// the adjustments that bring the systems there. levels holds the current
+// levels by system; a SKU absent from a system is reported as missing
+// there and left alone.
+func Reconcile(levels map[string]Levels, prev Snapshot, opts Options) (*Report, error) {
+	systems := make([]string, 0, len(levels))
+	for system := range levels {
+		systems = append(systems, system)
+	}
+	sort.Strings(systems)
+
+	switch opts.Rule {
+	case SourceOfTruth:
+		if opts.Source == "" {
+			return nil, errors.New("the source of truth rule needs a source system")
+		}
+		if _, ok := levels[opts.Source]; !ok {
+			return nil, fmt.Errorf("source system %q has no levels", opts.Source)
+		}
+	case Min, Sum:
+	default:
+		return nil, fmt.Errorf("unknown rule %q", opts.Rule)
+	}
+	if len(systems) < 2 {
+		return nil, errors.New("at least two systems are needed to sync")
+	}
+
+	skuSet := map[string]bool{}
+	for _, l := range levels {
+		for sku := range l {
+			skuSet[sku] = true
+		}
+	}
+	skus := make([]string, 0, len(skuSet))
+	for sku := range skuSet {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	report := &Report{
+		Rule:    opts.Rule,
+		Source:  opts.Source,
+		DryRun:  opts.DryRun,
+		Systems: systems,
+		Lines:   make([]*Line, 0, len(skus)),
+		RanAt:   time.Now().UTC(),
+	}
+
+	for _, sku := range skus {
+		line := &Line{SKU: sku, Levels: map[string]int{}}
+		for _, system := range systems {
+			q, ok := levels[system][sku]
+			if !ok {
+				line.Missing = append(line.Missing, system)
+				continue
+			}
+			line.Levels[system] = q
+			if seen, ok := prev.seen(system, sku); ok && seen != q {
+				if line.Changes == nil {
+					line.Changes = map[string]int{}
+				}
+				line.Changes[system] = q - seen
+			}
+		}
+
+		reconcileLine(line, prev, opts)
+		report.Lines = append(report.Lines, line)
+	}
+
+	report.summarize()
+	return report, nil
+}
+
+func reconcileLine(line *Line, prev Snapshot, opts Options) {
+	var target int
+	switch opts.Rule {
+	case SourceOfTruth:
+		q, ok := line.Levels[opts.Source]
+		if !ok {
+			line.Status = StatusMissing
+			line.Note = "not found in the source system"
+			return
+		}
+		target = q
+
+	case Min:
+		target = minLevel(line.Levels)
+
+	case Sum:
+		agreed, ok := prev.Agreed[line.SKU]
+		if !ok {
+			// First sync of the SKU: nothing to add changes to yet.
+			if q, inSource := line.Levels[opts.Source]; inSource {
+				target = q
+				line.Note = "first sync, seeded from the source system"
+			} else {
+				target = minLevel(line.Levels)
+				line.Note = "first sync, seeded with the lowest level"
+			}
+			break
+		}
+		target = agreed
+		for _, delta := range line.Changes {
+			target += delta
+		}
+	}
+
+	line.Target = target
+	to := max(target, 0)
+
+	for _, system := range sortedKeys(line.Levels) {
+		current := line.Levels[system]
+		if current == to || slices.Contains(opts.ReadOnly, system) {
+			continue
+		}
+		line.Adjustments = append(line.Adjustments, &Adjustment{
+			System: system,
+			SKU:    line.SKU,
+			From:   current,
+			To:     to,
+			Delta:  to - current,
+		})
+	}
+
+	switch {
+	case target < 0:
+		line.Status = StatusOversold
+		line.Note = fmt.Sprintf("%d more sold than in stock", -target)
+	case opts.Rule == SourceOfTruth && hasOverwrittenChanges(line, opts.Source):
+		line.Status = StatusConflict
+		line.Note = "changes made outside the source system since the last sync are overwritten"
+	case len(line.Adjustments) > 0:
+		line.Status = StatusAdjusted
+	case len(line.Missing) > 0:
+		line.Status = StatusMissing
+	default:
+		line.Status = StatusInSync
+	}
+}
+
+func hasOverwrittenChanges(line *Line, source string) bool {
+	for system := range line.Changes {
+		if system == source {
+			continue
+		}
+		for _, adj := range line.Adjustments {
+			if adj.System == system {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func minLevel(levels map[string]int) int {
+	first := true
+	var m int
+	for _, q := range levels {
+		if first || q < m {
+			m, first = q, false
+		}
+	}
+	return m
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Adjustments returns every adjustment of the report, grouped by system.
+func (r *Report) Adjustments() map[string][]*Adjustment {
+	out := map[string][]*Adjustment{}
+	for _, line := range r.Lines {
+		for _, adj := range line.Adjustments {
+			out[adj.System] = append(out[adj.System], adj)
+		}
+	}
+	return out
+}
+
+// Fail records that an adjustment could not be applied.
+func (r *Report) Fail(adj *Adjustment, err error) {
+	adj.Applied = false
+	adj.Error = err.Error()
+}
+
+// Finish marks the adjustments not failed as applied, unless the report
+// is a dry run, and recounts the summary.
+func (r *Report) Finish() {
+	for _, line := range r.Lines {
+		for _, adj := range line.Adjustments {
+			if adj.Error == "" && !r.DryRun {
+				adj.Applied = true
+			}
+			if adj.Error != "" {
+				line.Status = StatusFailed
+			}
+		}
+	}
+	r.summarize()
+}
+
+func (r *Report) summarize() {
+	s := Summary{SKUs: len(r.Lines)}
+	for _, line := range r.Lines {
+		switch line.Status {
+		case StatusInSync:
+			s.InSync++
+		case StatusAdjusted:
+			s.Adjusted++
+		case StatusConflict:
+			s.Conflicts++
+		case StatusOversold:
+			s.Oversold++
+		case StatusMissing:
+			s.Missing++
+		case StatusFailed:
+			s.Failed++
+		}
+		s.Adjustments += len(line.Adjustments)
+	}
+	r.Summary = s
+}
+
+// Snapshot returns the snapshot to keep for the next sync. SKUs the
+// report didn't cover keep their previous state. Levels that failed to
+// update are kept as read, so the next sync doesn't count the failed
+// push as a change.
+func (r *Report) Snapshot(prev Snapshot) Snapshot {
+	next := Snapshot{
+		Agreed:   map[string]int{},
+		Seen:     map[string]map[string]int{},
+		SyncedAt: r.RanAt.Format(time.RFC3339),
+	}
+	for sku, q := range prev.Agreed {
+		next.Agreed[sku] = q
+	}
+	for system, levels := range prev.Seen {
+		next.Seen[system] = map[string]int{}
+		for sku, q := range levels {
+			next.Seen[system][sku] = q
+		}
+	}
+
+	for _, line := range r.Lines {
+		if line.Status == StatusMissing && len(line.Levels) == 0 {
+			continue
+		}
+		if !(r.Rule == SourceOfTruth && line.Status == StatusMissing) {
+			next.Agreed[line.SKU] = line.Target
+		}
+		for system, q := range line.Levels {
+			if next.Seen[system] == nil {
+				next.Seen[system] = map[string]int{}
+			}
+			next.Seen[system][line.SKU] = q
+		}
+		for _, adj := range line.Adjustments {
+			if adj.Applied {
+				next.Seen[adj.System][line.SKU] = adj.To
+			}
+		}
+	}
+	return next
+}
@@ -0,0 +1,174 @@
+package inventory
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseMapping(t *testing.T) {
+	m, err := ParseMapping("TSHIRT-M\n# mugs\nMUG-01, shopify=MUG-WHITE, Cin7=100234\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.SKUs(); len(got) != 2 || got[1] != "MUG-01" {
+		t.Fatalf("SKUs = %v", got)
+	}
+	if got := m.Local("cin7", "MUG-01"); got != "100234" {
+		t.Errorf("Local(cin7) = %q", got)
+	}
+	if got := m.Local("zoho", "MUG-01"); got != "MUG-01" {
+		t.Errorf("Local(zoho) = %q", got)
+	}
+	if sku, ok := m.Canonical("shopify", "MUG-WHITE"); !ok || sku != "MUG-01" {
+		t.Errorf("Canonical = %q, %v", sku, ok)
+	}
+	if _, ok := m.Canonical("shopify", "MUG-01"); ok {
+		t.Error("a renamed SKU shouldn't match its canonical name")
+	}
+
+	levels := m.Translate("shopify", map[string]int{"MUG-WHITE": 4, "TSHIRT-M": 2, "OTHER": 9})
+	if len(levels) != 2 || levels["MUG-01"] != 4 || levels["TSHIRT-M"] != 2 {
+		t.Errorf("Translate = %v", levels)
+	}
+
+	j, err := ParseMapping(`[{"sku": "A", "woocommerce": "A-1"}]`)
+	if err != nil || j.Local("woocommerce", "A") != "A-1" {
+		t.Fatalf("JSON mapping = %v, %v", j, err)
+	}
+
+	for _, bad := range []string{"A\nA", "A, shopify", "shopify=A", "A, shopify=X\nB, shopify=X"} {
+		if _, err := ParseMapping(bad); err == nil {
+			t.Errorf("ParseMapping(%q) expected an error", bad)
+		}
+	}
+}
+
+func TestReconcileSourceOfTruth(t *testing.T) {
+	levels := map[string]Levels{
+		"cin7":    {"A": 10, "B": 5},
+		"shopify": {"A": 8, "B": 5, "C": 1},
+	}
+	prev := Snapshot{Seen: map[string]map[string]int{"shopify": {"A": 10}}}
+
+	r, err := Reconcile(levels, prev, Options{Rule: SourceOfTruth, Source: "cin7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := linesBySKU(r)
+	if l := lines["A"]; l.Status != StatusConflict || len(l.Adjustments) != 1 || l.Adjustments[0].To != 10 {
+		t.Errorf("A = %+v", l)
+	}
+	if l := lines["B"]; l.Status != StatusInSync {
+		t.Errorf("B = %+v", l)
+	}
+	if l := lines["C"]; l.Status != StatusMissing || len(l.Adjustments) != 0 {
+		t.Errorf("C = %+v", l)
+	}
+	if r.Summary.Conflicts != 1 || r.Summary.InSync != 1 || r.Summary.Missing != 1 {
+		t.Errorf("summary = %+v", r.Summary)
+	}
+
+	if _, err := Reconcile(levels, prev, Options{Rule: SourceOfTruth}); err == nil {
+		t.Error("expected an error without a source")
+	}
+}
+
+func TestReconcileMin(t *testing.T) {
+	levels := map[string]Levels{
+		"shopify":     {"A": 7},
+		"woocommerce": {"A": 3},
+		"zoho":        {"A": 5},
+	}
+	r, err := Reconcile(levels, Snapshot{}, Options{Rule: Min, ReadOnly: []string{"zoho"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := r.Lines[0]
+	if l.Target != 3 || len(l.Adjustments) != 1 || l.Adjustments[0].System != "shopify" || l.Adjustments[0].Delta != -4 {
+		t.Errorf("line = %+v", l)
+	}
+}
+
+func TestReconcileSumAcrossSyncs(t *testing.T) {
+	// First sync seeds from the source.
+	levels := map[string]Levels{
+		"cin7":    {"A": 10},
+		"shopify": {"A": 12},
+	}
+	opts := Options{Rule: Sum, Source: "cin7"}
+	r, err := Reconcile(levels, Snapshot{}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := r.Lines[0]; l.Target != 10 || len(l.Adjustments) != 1 {
+		t.Fatalf("first sync = %+v", l)
+	}
+	r.Finish()
+	snap := r.Snapshot(Snapshot{})
+	if snap.Agreed["A"] != 10 || snap.Seen["shopify"]["A"] != 10 {
+		t.Fatalf("snapshot = %+v", snap)
+	}
+
+	// Shopify sold 2 and cin7 sold 3: both sales count.
+	levels = map[string]Levels{
+		"cin7":    {"A": 7},
+		"shopify": {"A": 8},
+	}
+	r, err = Reconcile(levels, snap, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := r.Lines[0]
+	if l.Target != 5 || l.Changes["cin7"] != -3 || l.Changes["shopify"] != -2 {
+		t.Fatalf("second sync = %+v", l)
+	}
+	// After the first sync, sum adjusts the source like any other system.
+	if len(l.Adjustments) != 2 || l.Adjustments[0].System != "cin7" || l.Adjustments[0].To != 5 || l.Adjustments[1].System != "shopify" {
+		t.Fatalf("adjustments = %+v", l.Adjustments)
+	}
+
+	// A failed push keeps the level as read.
+	r.Fail(l.Adjustments[1], errors.New("boom"))
+	r.Finish()
+	if r.Summary.Failed != 1 || l.Adjustments[1].Applied || !l.Adjustments[0].Applied {
+		t.Errorf("summary = %+v", r.Summary)
+	}
+	snap = r.Snapshot(snap)
+	if snap.Agreed["A"] != 5 || snap.Seen["shopify"]["A"] != 8 || snap.Seen["cin7"]["A"] != 5 {
+		t.Errorf("snapshot after failure = %+v", snap)
+	}
+}
+
+func TestReconcileOversold(t *testing.T) {
+	levels := map[string]Levels{
+		"shopify":     {"A": 0},
+		"woocommerce": {"A": 1},
+	}
+	prev := Snapshot{
+		Agreed: map[string]int{"A": 2},
+		Seen:   map[string]map[string]int{"shopify": {"A": 2}, "woocommerce": {"A": 2}},
+	}
+	r, err := Reconcile(levels, prev, Options{Rule: Sum, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := r.Lines[0]
+	if l.Status != StatusOversold || l.Target != -1 {
+		t.Fatalf("line = %+v", l)
+	}
+	if len(l.Adjustments) != 1 || l.Adjustments[0].To != 0 {
+		t.Errorf("adjustments = %+v", l.Adjustments)
+	}
+	r.Finish()
+	if l.Adjustments[0].Applied {
+		t.Error("a dry run shouldn't apply adjustments")
+	}
+}
+
+func linesBySKU(r *Report) map[string]*Line {
+	out := map[string]*Line{}
+	for _, l := range r.Lines {
+		out[l.SKU] = l
+	}
+	return out
+}
@@ -0,0 +1,161 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Mapping translates canonical SKUs to the SKU each system uses. A SKU
+// with no entry for a system is the same there.
+type Mapping struct {
+	local     map[string]map[string]string
+	canonical map[string]map[string]string
+	skus      []string
+}
+
+// ParseMapping reads one SKU per line, optionally followed by the SKU
+// used by other systems:
+//
+//	TSHIRT-M
+//	MUG-01, shopify=MUG-WHITE, cin7=100234
+//
+// A JSON list of objects keyed by "sku" and system names is accepted too.
+func ParseMapping(text string) (*Mapping, error) {
+	m := &Mapping{
+		local:     map[string]map[string]string{},
+		canonical: map[string]map[string]string{},
+	}
+
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "[") {
+		var rows []map[string]string
+		if err := json.Unmarshal([]byte(text), &rows); err != nil {
+			return nil, fmt.Errorf(`SKU mapping must be a list like [{"sku": "MUG-01", "shopify": "MUG-WHITE"}]: %w`, err)
+		}
+		for i, row := range rows {
+			sku := strings.TrimSpace(row["sku"])
+			if sku == "" {
+				return nil, fmt.Errorf("SKU mapping entry %d has no sku", i+1)
+			}
+			delete(row, "sku")
+			if err := m.add(sku, row); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	}
+
+	for n, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		sku := strings.TrimSpace(fields[0])
+		if sku == "" || strings.Contains(sku, "=") {
+			return nil, fmt.Errorf("line %d of the SKU mapping must start with a SKU", n+1)
+		}
+		locals := map[string]string{}
+		for _, field := range fields[1:] {
+			system, local, ok := strings.Cut(field, "=")
+			system, local = strings.ToLower(strings.TrimSpace(system)), strings.TrimSpace(local)
+			if !ok || system == "" || local == "" {
+				return nil, fmt.Errorf("line %d of the SKU mapping has %q, expected system=SKU", n+1, strings.TrimSpace(field))
+			}
+			locals[system] = local
+		}
+		if err := m.add(sku, locals); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *Mapping) add(sku string, locals map[string]string) error {
+	if _, ok := m.local[sku]; ok {
+		return fmt.Errorf("SKU %q is mapped twice", sku)
+	}
+	m.local[sku] = map[string]string{}
+	m.skus = append(m.skus, sku)
+	for system, local := range locals {
+		system = strings.ToLower(system)
+		if m.canonical[system] == nil {
+			m.canonical[system] = map[string]string{}
+		}
+		if other, ok := m.canonical[system][local]; ok {
+			return fmt.Errorf("%s SKU %q is mapped to both %q and %q", system, local, other, sku)
+		}
+		m.local[sku][system] = local
+		m.canonical[system][local] = sku
+	}
+	return nil
+}
+
+// SKUs returns the canonical SKUs in the order they were listed.
+func (m *Mapping) SKUs() []string {
+	return m.skus
+}
+
+// Local returns the SKU a system uses for a canonical SKU.
+func (m *Mapping) Local(system, sku string) string {
+	if local, ok := m.local[sku][system]; ok {
+		return local
+	}
+	return sku
+}
+
+// LocalSKUs returns the SKUs a system uses for every mapped SKU.
+func (m *Mapping) LocalSKUs(system string) []string {
+	out := make([]string, 0, len(m.skus))
+	for _, sku := range m.skus {
+		out = append(out, m.Local(system, sku))
+	}
+	return out
+}
+
+// Canonical returns the canonical SKU of a system's SKU, and whether the
+// SKU is part of the mapping at all.
+func (m *Mapping) Canonical(system, local string) (string, bool) {
+	if sku, ok := m.canonical[system][local]; ok {
+		return sku, true
+	}
+	if _, ok := m.local[local]; ok {
+		if _, renamed := m.local[local][system]; !renamed {
+			return local, true
+		}
+	}
+	return "", false
+}
+
+// Translate converts levels keyed by a system's SKUs to canonical SKUs,
+// dropping SKUs outside the mapping.
+func (m *Mapping) Translate(system string, local map[string]int) Levels {
+	levels := Levels{}
+	keys := make([]string, 0, len(local))
+	for k := range local {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if sku, ok := m.Canonical(system, k); ok {
+			levels[sku] += local[k]
+		}
+	}
+	return levels
+}
@@ -0,0 +1,243 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package media loads binary inputs such as voice notes from the shapes
+// workflows pass around (URLs, data URIs, base64 and stored files) and
+// stores generated media as file objects other actions can consume.
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/rs/xid"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// MaxSize is the largest input Load accepts.
+const MaxSize = 50 << 20
+
+// Media is a loaded binary input.
+type Media struct {
+	Name     string
+	MimeType string
+	Data     []byte
+}
+
+// Load reads media from a URL, a data URI, base64 text, the ID of a file
+// stored in the workflow, or a file object produced by a file field or a
+// previous step.
+func Load(ctx context.Context, files sdkcontext.FileResource, source interface{}) (*Media, error) {
+	switch v := source.(type) {
+	case string:
+		return loadString(ctx, files, strings.TrimSpace(v), "")
+	case map[string]interface{}:
+		return loadObject(ctx, files, v)
+	case nil:
+		return nil, errors.New("no media provided")
+	default:
+		return nil, fmt.Errorf("unsupported media input of type %T", source)
+	}
+}
+
+func loadObject(ctx context.Context, files sdkcontext.FileResource, obj map[string]interface{}) (*Media, error) {
+	name := firstString(obj, "fileName", "name")
+
+	for _, key := range []string{"src", "downloadUrl", "url", "contentUrl", "path", "id"} {
+		if value := firstString(obj, key); value != "" {
+			m, err := loadString(ctx, files, value, name)
+			if err != nil {
+				return nil, err
+			}
+			if mimeType := firstString(obj, "mimeType"); mimeType != "" {
+				m.MimeType = mimeType
+			}
+			return m, nil
+		}
+	}
+
+	return nil, errors.New("the file object has no URL, data or ID")
+}
+
+func loadString(ctx context.Context, files sdkcontext.FileResource, source, name string) (*Media, error) {
+	switch {
+	case source == "":
+		return nil, errors.New("no media provided")
+	case strings.HasPrefix(source, "http://"), strings.HasPrefix(source, "https://"):
+		return download(ctx, source, name)
+	case strings.HasPrefix(source, "data:"):
+		return decodeDataURI(source, name)
+	}
+
+	// Stored file IDs are xids, whose 20 characters are valid base64 too,
+	// so they are fetched before base64 is tried.
+	if _, err := xid.FromString(source); err == nil && files != nil {
+		data, err := files.GetFileAsBytes(ctx, source)
+		if err != nil {
+			return nil, fmt.Errorf("could not read file %s: %w", source, err)
+		}
+		return newMedia(name, "", data), nil
+	}
+
+	if data, err := base64.StdEncoding.DecodeString(source); err == nil {
+		return newMedia(name, "", data), nil
+	}
+
+	if files == nil {
+		return nil, errors.New("media must be a URL, data URI, base64 string or file object")
+	}
+
+	data, err := files.GetFileAsBytes(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("media must be a URL, data URI, base64 string or file object: %w", err)
+	}
+
+	return newMedia(name, "", data), nil
+}
+
+func download(ctx context.Context, rawURL, name string) (*Media, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download media: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download media: HTTP status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media: %w", err)
+	}
+	if len(data) > MaxSize {
+		return nil, fmt.Errorf("media is larger than %d MB", MaxSize>>20)
+	}
+
+	if name == "" {
+		if u, err := url.Parse(rawURL); err == nil && path.Ext(u.Path) != "" {
+			name = path.Base(u.Path)
+		}
+	}
+
+	mimeType, _, _ := strings.Cut(resp.Header.Get("Content-Type"), ";")
+	if mimeType == "application/octet-stream" {
+		mimeType = ""
+	}
+
+	return newMedia(name, mimeType, data), nil
+}
+
+func decodeDataURI(uri, name string) (*Media, error) {
+	prefix, payload, ok := strings.Cut(uri, ",")
+	if !ok {
+		return nil, errors.New("invalid data URI format")
+	}
+
+	mimeType, _, _ := strings.Cut(strings.TrimPrefix(prefix, "data:"), ";")
+
+	var data []byte
+	if strings.HasSuffix(prefix, ";base64") {
+		decoded, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 data: %w", err)
+		}
+		data = decoded
+	} else {
+		decoded, err := url.PathUnescape(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode data URI: %w", err)
+		}
+		data = []byte(decoded)
+	}
+
+	return newMedia(name, mimeType, data), nil
+}
+
+// newMedia fills in the MIME type and a file name with a matching extension,
+// which APIs such as OpenAI's use to pick a decoder.
+func newMedia(name, mimeType string, data []byte) *Media {
+	detected := mimetype.Detect(data)
+	if mimeType == "" {
+		mimeType = detected.String()
+	}
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+
+	if path.Ext(name) == "" {
+		if name == "" {
+			name = "media"
+		}
+		name += detected.Extension()
+	}
+
+	return &Media{Name: name, MimeType: mimeType, Data: data}
+}
+
+// Reader returns a reader over the media content.
+func (m *Media) Reader() io.Reader {
+	return bytes.NewReader(m.Data)
+}
+
+// Store uploads generated content to the workflow file storage and returns
+// a file object. Its url can be passed to actions that take a media URL, such
+// as WhatsApp Send Media, Telegram or Google Drive Upload File.
+func Store(ctx context.Context, files sdkcontext.FileResource, name, mimeType string, data []byte) (map[string]interface{}, error) {
+	if files == nil {
+		return nil, errors.New("file storage is not available")
+	}
+
+	stored, err := files.UploadFile(ctx, name, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to store %s: %w", name, err)
+	}
+
+	size := stored.Size
+	if size == 0 {
+		size = int64(len(data))
+	}
+
+	return map[string]interface{}{
+		"id":          stored.ID.String(),
+		"fileName":    name,
+		"ext":         path.Ext(name),
+		"mimeType":    mimeType,
+		"url":         stored.ContentURL,
+		"downloadUrl": stored.ContentURL,
+		"size":        size,
+		"sizeBytes":   size,
+	}, nil
+}
+
+func firstString(obj map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if s, ok := obj[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
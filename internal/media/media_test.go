@@ -0,0 +1,101 @@
+package media
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// memFiles is a file store holding files by ID.
+type memFiles map[string][]byte
+
+func (f memFiles) GetFileAsBytes(_ context.Context, id string) ([]byte, error) {
+	data, ok := f[id]
+	if !ok {
+		return nil, errors.New("file not found")
+	}
+	return data, nil
+}
+
+func (f memFiles) GetFile(ctx context.Context, id string) (io.ReadCloser, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f memFiles) UploadFile(context.Context, string, io.Reader) (*sdkcontext.FileOutput, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestSubtitles(t *testing.T) {
+	segments := []Segment{
+		{Start: 0, End: 2.5, Text: " Hello there. "},
+		{Start: 2.5, End: 3725.042, Text: "Goodbye."},
+		{Start: 3726, End: 3727, Text: "  "},
+	}
+
+	wantSRT := "1\n00:00:00,000 --> 00:00:02,500\nHello there.\n\n2\n00:00:02,500 --> 01:02:05,042\nGoodbye.\n\n"
+	if got := SRT(segments); got != wantSRT {
+		t.Errorf("SRT() = %q, want %q", got, wantSRT)
+	}
+
+	wantVTT := "WEBVTT\n\n00:00:00.000 --> 00:00:02.500\nHello there.\n\n00:00:02.500 --> 01:02:05.042\nGoodbye.\n\n"
+	if got := VTT(segments); got != wantVTT {
+		t.Errorf("VTT() = %q, want %q", got, wantVTT)
+	}
+}
+
+func TestLoadDataURIAndBase64(t *testing.T) {
+	wav := WAV(make([]byte, 64), 24000, 1, 16)
+	encoded := base64.StdEncoding.EncodeToString(wav)
+
+	m, err := Load(context.Background(), nil, "data:audio/wav;base64,"+encoded)
+	if err != nil {
+		t.Fatalf("Load(data URI) error = %v", err)
+	}
+	if m.MimeType != "audio/wav" || len(m.Data) != len(wav) || m.Name != "media.wav" {
+		t.Errorf("unexpected media %q %q %d", m.Name, m.MimeType, len(m.Data))
+	}
+
+	m, err = Load(context.Background(), nil, map[string]interface{}{"src": encoded, "fileName": "note.wav"})
+	if err != nil {
+		t.Fatalf("Load(file object) error = %v", err)
+	}
+	if m.Name != "note.wav" || m.MimeType == "" {
+		t.Errorf("unexpected media %q %q", m.Name, m.MimeType)
+	}
+
+	if _, err := Load(context.Background(), nil, "not a file"); err == nil {
+		t.Error("expected an error for unreadable input")
+	}
+}
+
+func TestLoadFileID(t *testing.T) {
+	// A stored file ID, which is also valid base64.
+	const id = "dbb0t0vh7ojvc9ccr7t0"
+	wav := WAV(make([]byte, 64), 24000, 1, 16)
+	files := memFiles{id: wav}
+
+	for _, source := range []interface{}{id, map[string]interface{}{"id": id, "name": "note.wav"}} {
+		m, err := Load(context.Background(), files, source)
+		if err != nil {
+			t.Fatalf("Load(%v) error = %v", source, err)
+		}
+		if len(m.Data) != len(wav) || m.MimeType != "audio/wav" {
+			t.Errorf("Load(%v) = %d bytes of %q, want the stored file", source, len(m.Data), m.MimeType)
+		}
+	}
+
+	if _, err := Load(context.Background(), memFiles{}, id); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestWAVHeader(t *testing.T) {
+	wav := WAV(make([]byte, 100), 24000, 1, 16)
+	if len(wav) != 144 || string(wav[0:4]) != "RIFF" || string(wav[8:12]) != "WAVE" || string(wav[36:40]) != "data" {
+		t.Errorf("unexpected WAV header % x", wav[:44])
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"strings"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// Segment is a timed piece of a transcript. Start and End are in seconds.
+type Segment struct {
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+	Text  string  `json:"text"`
+}
+
+// SRT renders segments as SubRip subtitles.
+func SRT(segments []Segment) string {
+	var b strings.Builder
+	n := 0
+	for _, segment := range segments {
+		text := strings.TrimSpace(segment.Text)
+		if text == "" {
+			continue
+		}
+		n++
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n", n, timestamp(segment.Start, ","), timestamp(segment.End, ","), text)
+	}
+	return b.String()
+}
+
+// VTT renders segments as WebVTT subtitles.
+func VTT(segments []Segment) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n\n")
+	for _, segment := range segments {
+		text := strings.TrimSpace(segment.Text)
+		if text == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "%s --> %s\n%s\n\n", timestamp(segment.Start, "."), timestamp(segment.End, "."), text)
+	}
+	return b.String()
+}
+
+func timestamp(seconds float64, sep string) string {
+	ms := int64(math.Round(math.Max(seconds, 0) * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", ms/3_600_000, ms/60_000%60, ms/1000%60, sep, ms%1000)
+}
+
+// Transcript is the normalized result of a transcription or translation.
+type Transcript struct {
+	Text     string
+	Language string
+	Duration float64
+	Segments []Segment
+}
+
+// Output renders the transcript as action output. Subtitles are included
+// when the provider returned timed segments, and stored as a file as well
+// when subtitleFormat is "srt" or "vtt".
+func (t Transcript) Output(ctx context.Context, files sdkcontext.FileResource, subtitleFormat, name string) (map[string]interface{}, error) {
+	segments := t.Segments
+	if segments == nil {
+		segments = []Segment{}
+	}
+
+	out := map[string]interface{}{
+		"text":     strings.TrimSpace(t.Text),
+		"language": t.Language,
+		"duration": t.Duration,
+		"segments": segments,
+	}
+
+	if len(t.Segments) == 0 {
+		if subtitleFormat == "srt" || subtitleFormat == "vtt" {
+			return nil, errors.New("subtitles need timestamps, which the selected model did not return")
+		}
+		return out, nil
+	}
+
+	srt, vtt := SRT(t.Segments), VTT(t.Segments)
+	out["srt"] = srt
+	out["vtt"] = vtt
+
+	var (
+		content  string
+		mimeType string
+	)
+	switch subtitleFormat {
+	case "srt":
+		content, mimeType = srt, "application/x-subrip"
+	case "vtt":
+		content, mimeType = vtt, "text/vtt"
+	default:
+		return out, nil
+	}
+
+	file, err := Store(ctx, files, strings.TrimSuffix(name, path.Ext(name))+"."+subtitleFormat, mimeType, []byte(content))
+	if err != nil {
+		return nil, err
+	}
+	out["subtitle_file"] = file
+
+	return out, nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pii finds personal data in free text with deterministic patterns
+// and checksums and redacts it, keeping a map of what was replaced where.
+package pii
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Kind identifies a category of personal data.
+type Kind string
+
+const (
+	KindEmail      Kind = "email"
+	KindPhone      Kind = "phone"
+	KindCreditCard Kind = "credit_card"
+	KindIBAN       Kind = "iban"
+)
+
+// PatternKinds are the kinds the deterministic engine detects. Other kinds,
+// such as names or addresses, can only come from LLM assistance.
+var PatternKinds = []Kind{KindEmail, KindPhone, KindCreditCard, KindIBAN}
+
+const (
+	SourcePattern = "pattern"
+	SourceLLM     = "llm"
+)
+
+// Span is a piece of personal data found in a text. Start and End are
+// character (rune) offsets into the original text, End exclusive.
+type Span struct {
+	Kind        Kind   `json:"type"`
+	Start       int    `json:"start"`
+	End         int    `json:"end"`
+	Value       string `json:"value,omitempty"`
+	Replacement string `json:"replacement"`
+	Source      string `json:"source"`
+
+	byteStart, byteEnd int
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`)
+	cardPattern  = regexp.MustCompile(`\b\d(?:[ \-]?\d){12,18}\b`)
+	ibanPattern  = regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]){11,30}\b`)
+	// phonePattern takes a leading # so numbers such as "#12345678" match
+	// whole and are rejected, rather than matching without it.
+	phonePattern    = regexp.MustCompile(`#?(?:\+\d{1,3}[ .\-]?(?:\(\d{1,4}\)[ .\-]?)?|\(\d{1,4}\)[ .\-]?|\b)\d{2,4}(?:[ .\-]?\d{2,4}){1,3}\b`)
+	datePattern     = regexp.MustCompile(`^\d{4}[\-./]\d{1,2}[\-./]\d{1,2}$|^\d{1,2}[\-./]\d{1,2}[\-./]\d{2,4}$`)
+	notPhonePattern = regexp.MustCompile(`^\d{1,3}(?:\.\d{1,3}){3}$|^\d{5}-\d{4}$|^\d+\.\d+$`)
+)
+
+// Detect returns the non-overlapping spans of the requested kinds, ordered by
+// position. With no kinds every pattern kind is detected. Card numbers and
+// IBANs are only reported when their checksum is valid, which keeps order
+// numbers and other long digit runs out of the result.
+func Detect(text string, kinds ...Kind) []Span {
+	if len(kinds) == 0 {
+		kinds = PatternKinds
+	}
+
+	wanted := map[Kind]bool{}
+	for _, kind := range kinds {
+		wanted[kind] = true
+	}
+
+	// Checksummed kinds go first so a card number is never mistaken for a
+	// phone number when both are requested.
+	var found []Span
+	if wanted[KindEmail] {
+		found = append(found, match(text, emailPattern, KindEmail, nil)...)
+	}
+	if wanted[KindCreditCard] {
+		found = append(found, matchChecked(text, cardPattern, KindCreditCard, validCard)...)
+	}
+	if wanted[KindIBAN] {
+		found = append(found, matchChecked(text, ibanPattern, KindIBAN, validIBAN)...)
+	}
+	if wanted[KindPhone] {
+		found = append(found, match(text, phonePattern, KindPhone, validPhone)...)
+	}
+
+	return Merge(found)
+}
+
+// Locate finds every occurrence of value in text, for turning entities
+// reported by an LLM into spans.
+func Locate(text string, kind Kind, value, source string) []Span {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	var spans []Span
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], value)
+		if i < 0 {
+			break
+		}
+		start := offset + i
+		spans = append(spans, newSpan(text, kind, start, start+len(value), source))
+		offset = start + len(value)
+	}
+
+	return spans
+}
+
+// Merge orders spans by position and drops any span that overlaps one kept
+// earlier in the input, so pattern matches passed first win over LLM ones.
+func Merge(spans []Span) []Span {
+	var kept []Span
+	for _, candidate := range spans {
+		overlaps := false
+		for _, existing := range kept {
+			if candidate.byteStart < existing.byteEnd && existing.byteStart < candidate.byteEnd {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			kept = append(kept, candidate)
+		}
+	}
+
+	sort.Slice(kept, func(i, j int) bool { return kept[i].byteStart < kept[j].byteStart })
+
+	return kept
+}
+
+func match(text string, pattern *regexp.Regexp, kind Kind, valid func(string) bool) []Span {
+	var spans []Span
+	for _, loc := range pattern.FindAllStringIndex(text, -1) {
+		if valid != nil && !valid(text[loc[0]:loc[1]]) {
+			continue
+		}
+		spans = append(spans, newSpan(text, kind, loc[0], loc[1], SourcePattern))
+	}
+	return spans
+}
+
+// matchChecked is match for checksummed kinds. The patterns are greedy, so a
+// number followed by more digits or capitals, such as "4111 1111 1111 1111
+// 123", matches too long; when the whole match fails the check, its prefixes
+// ending at a space or dash are tried, longest first.
+func matchChecked(text string, pattern *regexp.Regexp, kind Kind, valid func(string) bool) []Span {
+	var spans []Span
+	for _, loc := range pattern.FindAllStringIndex(text, -1) {
+		if n := validPrefix(text[loc[0]:loc[1]], valid); n > 0 {
+			spans = append(spans, newSpan(text, kind, loc[0], loc[0]+n, SourcePattern))
+		}
+	}
+	return spans
+}
+
+// validPrefix returns the length of the longest prefix of s that is valid
+// and is s or ends before a separator, or 0 when there is none.
+func validPrefix(s string, valid func(string) bool) int {
+	if valid(s) {
+		return len(s)
+	}
+	for i := len(s) - 1; i > 0; i-- {
+		if s[i] != ' ' && s[i] != '-' {
+			continue
+		}
+		prefix := strings.TrimRight(s[:i], " -")
+		if prefix != "" && valid(prefix) {
+			return len(prefix)
+		}
+	}
+	return 0
+}
+
+func newSpan(text string, kind Kind, byteStart, byteEnd int, source string) Span {
+	start := utf8.RuneCountInString(text[:byteStart])
+	return Span{
+		Kind:      kind,
+		Start:     start,
+		End:       start + utf8.RuneCountInString(text[byteStart:byteEnd]),
+		Value:     text[byteStart:byteEnd],
+		Source:    source,
+		byteStart: byteStart,
+		byteEnd:   byteEnd,
+	}
+}
+
+func digitsOnly(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// validCard applies the Luhn checksum.
+func validCard(s string) bool {
+	digits := digitsOnly(s)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// validIBAN applies the ISO 13616 mod-97 check.
+func validIBAN(s string) bool {
+	iban := strings.ReplaceAll(s, " ", "")
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+
+	rearranged := iban[4:] + iban[:4]
+
+	remainder := 0
+	for _, r := range rearranged {
+		switch {
+		case r >= '0' && r <= '9':
+			remainder = (remainder*10 + int(r-'0')) % 97
+		case r >= 'A' && r <= 'Z':
+			remainder = (remainder*100 + int(r-'A'+10)) % 97
+		default:
+			return false
+		}
+	}
+
+	return remainder == 1
+}
+
+// validPhone keeps matches with an E.164-plausible number of digits that do
+// not read as a date, an IP address, a ZIP+4 code, a decimal number or a
+// "#" reference. A bare run of digits needs the ten of a full number.
+func validPhone(s string) bool {
+	if strings.HasPrefix(s, "#") || datePattern.MatchString(s) || notPhonePattern.MatchString(s) {
+		return false
+	}
+	digits := digitsOnly(s)
+	n := len(digits)
+	if n == len(s) && n < 10 {
+		return false
+	}
+	return n >= 7 && n <= 15
+}
@@ -0,0 +1,121 @@
+package pii
+
+import (
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Kind
+	}{
+		{"email", "Contact jane.doe@example.co.uk for details.", []Kind{KindEmail}},
+		{"phone", "Call me on +44 20 7946 0958 tomorrow.", []Kind{KindPhone}},
+		{"valid card", "Card 4111 1111 1111 1111 was charged.", []Kind{KindCreditCard}},
+		{"invalid card is ignored", "Order 4111 1111 1111 1112 shipped.", nil},
+		{"iban", "Pay to GB82 WEST 1234 5698 7654 32 please.", []Kind{KindIBAN}},
+		{"card followed by digits", "card 4111 1111 1111 1111 123", []Kind{KindCreditCard}},
+		{"iban followed by capitals", "IBAN DE89 3704 0044 0532 0130 00 AT BANK", []Kind{KindIBAN}},
+		{"date is not a phone", "Due on 2024-05-17.", nil},
+		{"mixed", "Email a@b.io or call 555-123-4567.", []Kind{KindEmail, KindPhone}},
+		{"area code in parentheses", "call (555) 123-4567", []Kind{KindPhone}},
+		{"order number is not a phone", "Order #12345678", nil},
+		{"decimal is not a phone", "Total 1234.5678", nil},
+		{"zip+4 is not a phone", "ZIP 94105-1234", nil},
+		{"ip address is not a phone", "IP 192.168.100.200", nil},
+		{"bare full number", "Call 5551234567 now.", []Kind{KindPhone}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Detect(tt.text)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Detect(%q) = %+v, want kinds %v", tt.text, got, tt.want)
+			}
+			for i, span := range got {
+				if span.Kind != tt.want[i] {
+					t.Errorf("span %d kind = %s, want %s", i, span.Kind, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDetectTrimsChecksummedMatches(t *testing.T) {
+	tests := map[string]string{
+		"card 4111 1111 1111 1111 123":             "4111 1111 1111 1111",
+		"IBAN DE89 3704 0044 0532 0130 00 AT BANK": "DE89 3704 0044 0532 0130 00",
+		"ref 4111-1111-1111-1111-9":                "4111-1111-1111-1111",
+	}
+	for text, want := range tests {
+		got := Detect(text)
+		if len(got) != 1 || got[0].Value != want {
+			t.Errorf("Detect(%q) = %+v, want %q", text, got, want)
+		}
+	}
+}
+
+func TestDetectKinds(t *testing.T) {
+	if got := Detect("call (555) 123-4567", KindPhone); len(got) != 1 || got[0].Value != "(555) 123-4567" {
+		t.Fatalf("expected the area code to be part of the phone number, got %+v", got)
+	}
+
+	got := Detect("Email a@b.io or call 555-123-4567.", KindPhone)
+	if len(got) != 1 || got[0].Kind != KindPhone {
+		t.Fatalf("expected only the phone number, got %+v", got)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	text := "Ünïcode: a@b.io, then b@c.io, then a@b.io again."
+	spans := Detect(text)
+
+	redacted, out := Redact(text, spans, StyleLabel, false)
+
+	want := "Ünïcode: [EMAIL_1], then [EMAIL_2], then [EMAIL_1] again."
+	if redacted != want {
+		t.Errorf("Redact() = %q, want %q", redacted, want)
+	}
+	if len(out) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(out))
+	}
+	if out[0].Start != 9 || out[0].End != 15 {
+		t.Errorf("expected rune offsets 9-15, got %d-%d", out[0].Start, out[0].End)
+	}
+	if out[0].Value != "" {
+		t.Errorf("expected original value to be dropped, got %q", out[0].Value)
+	}
+}
+
+func TestRedactStyles(t *testing.T) {
+	tests := []struct {
+		style Style
+		text  string
+		want  string
+	}{
+		{StyleMask, "a@b.io", "******"},
+		{StylePartial, "jane@example.com", "j***@example.com"},
+		{StylePartial, "4111 1111 1111 1111", "**** **** **** 1111"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.style), func(t *testing.T) {
+			got, _ := Redact(tt.text, Detect(tt.text), tt.style, true)
+			if got != tt.want {
+				t.Errorf("Redact(%q, %s) = %q, want %q", tt.text, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergePrefersEarlierSpans(t *testing.T) {
+	text := "Reach jane at jane@example.com"
+	spans := Merge(append(Detect(text), Locate(text, "name", "jane", SourceLLM)...))
+
+	// Both "jane" occurrences are located, but the one inside the email
+	// overlaps the pattern match and is dropped.
+	if len(spans) != 2 || spans[0].Kind != "name" || spans[1].Kind != KindEmail {
+		t.Fatalf("unexpected spans %+v", spans)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pii
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Style selects how redacted values are rendered.
+type Style string
+
+const (
+	// StyleLabel replaces values with numbered placeholders such as
+	// [EMAIL_1]. Repeated values share a placeholder.
+	StyleLabel Style = "label"
+	// StyleMask replaces every character with an asterisk.
+	StyleMask Style = "mask"
+	// StylePartial keeps the last four characters of numbers and the domain
+	// of email addresses.
+	StylePartial Style = "partial"
+)
+
+// Redact replaces the spans in text and returns the redacted text together
+// with the spans, their Replacement filled in. Spans must not overlap, as
+// returned by Detect or Merge. Original values are dropped from the returned
+// spans unless keepValues is set.
+func Redact(text string, spans []Span, style Style, keepValues bool) (string, []Span) {
+	labels := map[string]string{}
+	counters := map[Kind]int{}
+
+	var b strings.Builder
+	out := make([]Span, 0, len(spans))
+	last := 0
+
+	for _, span := range spans {
+		if span.byteStart < last {
+			continue
+		}
+
+		switch style {
+		case StyleMask:
+			span.Replacement = strings.Repeat("*", utf8.RuneCountInString(span.Value))
+		case StylePartial:
+			span.Replacement = partial(span)
+		default:
+			key := string(span.Kind) + "\x00" + span.Value
+			label, ok := labels[key]
+			if !ok {
+				counters[span.Kind]++
+				label = fmt.Sprintf("[%s_%d]", strings.ToUpper(string(span.Kind)), counters[span.Kind])
+				labels[key] = label
+			}
+			span.Replacement = label
+		}
+
+		b.WriteString(text[last:span.byteStart])
+		b.WriteString(span.Replacement)
+		last = span.byteEnd
+
+		if !keepValues {
+			span.Value = ""
+		}
+		out = append(out, span)
+	}
+
+	b.WriteString(text[last:])
+
+	return b.String(), out
+}
+
+func partial(span Span) string {
+	value := span.Value
+
+	if span.Kind == KindEmail {
+		if at := strings.LastIndex(value, "@"); at > 0 {
+			first, _ := utf8.DecodeRuneInString(value)
+			return string(first) + strings.Repeat("*", utf8.RuneCountInString(value[:at])-1) + value[at:]
+		}
+	}
+
+	runes := []rune(value)
+	keep := 4
+	if len(runes) <= keep*2 {
+		keep = len(runes) / 4
+	}
+
+	masked := make([]rune, len(runes))
+	for i, r := range runes {
+		switch {
+		case i >= len(runes)-keep:
+			masked[i] = r
+		case r == ' ' || r == '-' || r == '.':
+			masked[i] = r
+		default:
+			masked[i] = '*'
+		}
+	}
+
+	return string(masked)
+}
@@ -0,0 +1,88 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package polling holds the state polling triggers keep between runs.
+package polling
+
+import (
+	"encoding/json"
+
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+// MaxSeenIDs bounds the IDs Unseen keeps to avoid firing twice for a
+// record.
+const MaxSeenIDs = 1000
+
+// Context is the part of a trigger execution context the state is kept
+// in; sdkcontext.ExecuteContext satisfies it.
+type Context interface {
+	Logger() core.Logger
+	GetMetadata(key string) (interface{}, error)
+	SetMetadata(key string, value interface{}) error
+}
+
+// Unseen filters out IDs already returned under key and remembers the new
+// ones, the latest MaxSeenIDs of them. Triggers whose polls return a
+// record again on every change use it to fire once per record.
+func Unseen[T comparable](ctx Context, key string, ids []T) map[T]bool {
+	seen := map[T]bool{}
+	history := load[T](ctx, key)
+	for _, id := range history {
+		seen[id] = true
+	}
+
+	fresh := map[T]bool{}
+	for _, id := range ids {
+		if seen[id] || fresh[id] {
+			continue
+		}
+		fresh[id] = true
+		history = append(history, id)
+	}
+
+	if len(fresh) > 0 {
+		if len(history) > MaxSeenIDs {
+			history = history[len(history)-MaxSeenIDs:]
+		}
+		if err := ctx.SetMetadata(key, history); err != nil {
+			ctx.Logger().Warn("failed to store seen ids", "key", key, "error", err)
+		}
+	}
+
+	return fresh
+}
+
+// load reads the IDs stored under key, which come back as stored or
+// decoded from JSON depending on the runtime.
+func load[T comparable](ctx Context, key string) []T {
+	stored, err := ctx.GetMetadata(key)
+	if err != nil || stored == nil {
+		return nil
+	}
+	if ids, ok := stored.([]T); ok {
+		return ids
+	}
+
+	var ids []T
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &ids)
+	}
+	if err != nil {
+		ctx.Logger().Warn("ignoring unreadable seen ids", "key", key, "error", err)
+		return nil
+	}
+	return ids
+}
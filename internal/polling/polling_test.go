@@ -0,0 +1,63 @@
+package polling
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+type fakeContext struct {
+	metadata map[string]interface{}
+}
+
+func (f *fakeContext) Logger() core.Logger { return core.NewNoopLogger() }
+
+func (f *fakeContext) GetMetadata(key string) (interface{}, error) {
+	return f.metadata[key], nil
+}
+
+func (f *fakeContext) SetMetadata(key string, value interface{}) error {
+	if f.metadata == nil {
+		f.metadata = map[string]interface{}{}
+	}
+	f.metadata[key] = value
+	return nil
+}
+
+func TestUnseen(t *testing.T) {
+	ctx := &fakeContext{}
+	if got := Unseen(ctx, "seen", []string{"a", "b", "a"}); len(got) != 2 || !got["a"] || !got["b"] {
+		t.Fatalf("first poll = %v", got)
+	}
+	if got := Unseen(ctx, "seen", []string{"b", "c"}); len(got) != 1 || !got["c"] {
+		t.Errorf("second poll = %v", got)
+	}
+}
+
+func TestUnseenDecodedState(t *testing.T) {
+	// Runtimes that keep metadata as JSON hand numbers back as float64.
+	var stored interface{}
+	if err := json.Unmarshal([]byte(`[450789469, 450789470]`), &stored); err != nil {
+		t.Fatal(err)
+	}
+	ctx := &fakeContext{metadata: map[string]interface{}{"seen": stored}}
+	if got := Unseen(ctx, "seen", []uint64{450789469, 450789471}); len(got) != 1 || !got[450789471] {
+		t.Errorf("Unseen = %v", got)
+	}
+}
+
+func TestUnseenBound(t *testing.T) {
+	ids := make([]int, MaxSeenIDs+10)
+	for i := range ids {
+		ids[i] = i
+	}
+	ctx := &fakeContext{}
+	Unseen(ctx, "seen", ids)
+	if history := ctx.metadata["seen"].([]int); len(history) != MaxSeenIDs || history[0] != 10 {
+		t.Errorf("kept %d ids starting at %d", len(history), history[0])
+	}
+	if got := Unseen(ctx, "seen", []int{0}); !got[0] {
+		t.Error("an ID dropped from the history is new again")
+	}
+}
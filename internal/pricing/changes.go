@@ -0,0 +1,126 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pricing
+
+import (
+	"math"
+	"sort"
+)
+
+// Snapshot holds competitor prices by product, then by competitor.
+type Snapshot map[string]map[string]float64
+
+// Change is a competitor price that moved.
+type Change struct {
+	Competitor    string  `json:"competitor"`
+	OldPrice      float64 `json:"old_price"`
+	NewPrice      float64 `json:"new_price"`
+	Change        float64 `json:"change"`
+	ChangePercent float64 `json:"change_percent"`
+}
+
+// Threshold is how much a price must move to count as changed. A change
+// counts when it reaches either bound that is set; with neither set,
+// every change counts.
+type Threshold struct {
+	Amount  float64
+	Percent float64
+}
+
+func (t Threshold) reached(oldPrice, newPrice float64) bool {
+	diff := math.Abs(newPrice - oldPrice)
+	if diff < 0.005 {
+		return false
+	}
+	if t.Amount <= 0 && t.Percent <= 0 {
+		return true
+	}
+	if t.Amount > 0 && diff >= t.Amount {
+		return true
+	}
+	return t.Percent > 0 && oldPrice > 0 && diff/oldPrice*100 >= t.Percent
+}
+
+// Diff returns the competitor prices of a product that moved past the
+// threshold between prev and next, sorted by competitor. Competitors
+// that appear or disappear, or go to or from 0, are reported as changes
+// from or to 0, since that is what a stock-out looks like.
+func Diff(prev, next map[string]float64, t Threshold) []Change {
+	keys := map[string]bool{}
+	for k := range prev {
+		keys[k] = true
+	}
+	for k := range next {
+		keys[k] = true
+	}
+
+	var changes []Change
+	for k := range keys {
+		oldPrice, newPrice := prev[k], next[k]
+		if (oldPrice == 0) != (newPrice == 0) {
+			changes = append(changes, change(k, oldPrice, newPrice))
+			continue
+		}
+		if t.reached(oldPrice, newPrice) {
+			changes = append(changes, change(k, oldPrice, newPrice))
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Competitor < changes[j].Competitor })
+	return changes
+}
+
+// Advance returns the prices to compare the next poll with: the new price
+// of the competitors whose change was reported, and the previous price of
+// the others, so moves below the threshold add up until they reach it.
+func Advance(prev, next map[string]float64, changes []Change) map[string]float64 {
+	reported := map[string]bool{}
+	for _, c := range changes {
+		reported[c.Competitor] = true
+	}
+
+	out := make(map[string]float64, len(next))
+	for k, newPrice := range next {
+		if oldPrice, ok := prev[k]; ok && !reported[k] {
+			out[k] = oldPrice
+			continue
+		}
+		out[k] = newPrice
+	}
+	return out
+}
+
+func change(competitor string, oldPrice, newPrice float64) Change {
+	c := Change{
+		Competitor: competitor,
+		OldPrice:   oldPrice,
+		NewPrice:   newPrice,
+		Change:     round(newPrice - oldPrice),
+	}
+	if oldPrice > 0 {
+		c.ChangePercent = round((newPrice - oldPrice) / oldPrice * 100)
+	}
+	return c
+}
+
+// Lowest returns the lowest positive price, or 0.
+func Lowest(prices map[string]float64) float64 {
+	lowest := 0.0
+	for _, p := range prices {
+		if p > 0 && (lowest == 0 || p < lowest) {
+			lowest = p
+		}
+	}
+	return lowest
+}
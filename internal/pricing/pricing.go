@@ -0,0 +1,160 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pricing decides prices from competitor prices. Reprice applies
+// repricing rules to the competitor prices of a product, and Diff finds
+// the competitor prices that moved between two snapshots.
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Decision reasons.
+const (
+	ReasonUndercut      = "undercut"
+	ReasonMatched       = "matched"
+	ReasonFloor         = "floor"
+	ReasonCeiling       = "ceiling"
+	ReasonMargin        = "margin"
+	ReasonNoCompetitors = "no_competitor_prices"
+	ReasonUnchanged     = "unchanged"
+)
+
+// Rules configure a repricing. Zero values turn a rule off.
+type Rules struct {
+	// UndercutBy is the amount to price below the lowest competitor.
+	UndercutBy float64 `json:"undercut_by"`
+	// UndercutPercent is the percentage to price below the lowest
+	// competitor, applied after UndercutBy.
+	UndercutPercent float64 `json:"undercut_percent"`
+	// Floor and Ceiling bound the price.
+	Floor   float64 `json:"floor"`
+	Ceiling float64 `json:"ceiling"`
+	// Cost and MinMarginPercent raise the floor to cost plus a margin,
+	// as a percentage of cost.
+	Cost             float64 `json:"cost"`
+	MinMarginPercent float64 `json:"min_margin_percent"`
+	// MinChange is the smallest difference from the current price worth
+	// applying, to avoid churning prices by cents.
+	MinChange float64 `json:"min_change"`
+}
+
+// Validate checks that the rules can be satisfied together.
+func (r Rules) Validate() error {
+	for name, v := range map[string]float64{
+		"undercut_by":        r.UndercutBy,
+		"undercut_percent":   r.UndercutPercent,
+		"floor":              r.Floor,
+		"ceiling":            r.Ceiling,
+		"cost":               r.Cost,
+		"min_margin_percent": r.MinMarginPercent,
+		"min_change":         r.MinChange,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%s can't be negative", name)
+		}
+	}
+	if r.UndercutPercent >= 100 {
+		return errors.New("undercut_percent must be below 100")
+	}
+	if r.MinMarginPercent > 0 && r.Cost == 0 {
+		return errors.New("a minimum margin needs the product's cost")
+	}
+	if floor := r.floor(); r.Ceiling > 0 && floor > r.Ceiling {
+		return fmt.Errorf("the floor of %.2f is above the ceiling of %.2f", floor, r.Ceiling)
+	}
+	return nil
+}
+
+// floor is the lowest allowed price: the floor or cost plus the minimum
+// margin, whichever is higher.
+func (r Rules) floor() float64 {
+	floor := r.Floor
+	if r.Cost > 0 {
+		floor = math.Max(floor, r.Cost*(1+r.MinMarginPercent/100))
+	}
+	return round(floor)
+}
+
+// Decision is the outcome of a repricing.
+type Decision struct {
+	Current float64 `json:"current_price"`
+	Price   float64 `json:"new_price"`
+	Lowest  float64 `json:"lowest_competitor_price,omitempty"`
+	Target  float64 `json:"target_price,omitempty"`
+	Changed bool    `json:"changed"`
+	Reason  string  `json:"reason"`
+}
+
+// Reprice applies the rules to the competitor prices of a product priced
+// at current. Prices that aren't positive, such as out of stock listings
+// reported as 0, are ignored. Without competitor prices the price stays,
+// unless it breaks the floor or ceiling.
+func Reprice(current float64, competitors []float64, r Rules) (Decision, error) {
+	if err := r.Validate(); err != nil {
+		return Decision{}, err
+	}
+
+	d := Decision{Current: round(current), Price: round(current)}
+
+	lowest := 0.0
+	for _, p := range competitors {
+		if p > 0 && (lowest == 0 || p < lowest) {
+			lowest = p
+		}
+	}
+
+	if lowest == 0 {
+		d.Reason = ReasonNoCompetitors
+	} else {
+		d.Lowest = round(lowest)
+		d.Target = round((lowest - r.UndercutBy) * (1 - r.UndercutPercent/100))
+		d.Price = d.Target
+		d.Reason = ReasonMatched
+		if d.Target < d.Lowest {
+			d.Reason = ReasonUndercut
+		}
+	}
+
+	if floor := r.floor(); floor > 0 && d.Price < floor {
+		d.Price = floor
+		d.Reason = ReasonFloor
+		if r.Cost > 0 && floor > r.Floor {
+			d.Reason = ReasonMargin
+		}
+	}
+	if r.Ceiling > 0 && d.Price > r.Ceiling {
+		d.Price = round(r.Ceiling)
+		d.Reason = ReasonCeiling
+	}
+
+	diff := math.Abs(d.Price - d.Current)
+	if diff < 0.005 || diff < r.MinChange {
+		d.Price = d.Current
+		d.Changed = false
+		if lowest > 0 {
+			d.Reason = ReasonUnchanged
+		}
+		return d, nil
+	}
+	d.Changed = true
+	return d, nil
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,111 @@
+package pricing
+
+import "testing"
+
+func TestReprice(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     float64
+		competitors []float64
+		rules       Rules
+		price       float64
+		reason      string
+		changed     bool
+	}{
+		{"undercut by amount", 25, []float64{24.99, 0, 27}, Rules{UndercutBy: 0.5}, 24.49, ReasonUndercut, true},
+		{"undercut by percent", 25, []float64{20}, Rules{UndercutPercent: 10}, 18, ReasonUndercut, true},
+		{"match", 25, []float64{22}, Rules{}, 22, ReasonMatched, true},
+		{"floor", 25, []float64{10}, Rules{UndercutBy: 1, Floor: 15}, 15, ReasonFloor, true},
+		{"margin over cost", 25, []float64{10}, Rules{Floor: 5, Cost: 10, MinMarginPercent: 20}, 12, ReasonMargin, true},
+		{"ceiling", 25, []float64{60}, Rules{Ceiling: 40}, 40, ReasonCeiling, true},
+		{"no competitors", 25, []float64{0}, Rules{UndercutBy: 1}, 25, ReasonNoCompetitors, false},
+		{"no competitors below floor", 9, nil, Rules{Floor: 10}, 10, ReasonFloor, true},
+		{"below min change", 25, []float64{24.9}, Rules{MinChange: 0.25}, 25, ReasonUnchanged, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := Reprice(tt.current, tt.competitors, tt.rules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Price != tt.price || d.Reason != tt.reason || d.Changed != tt.changed {
+				t.Errorf("Reprice = %+v, want price %v, reason %s, changed %v", d, tt.price, tt.reason, tt.changed)
+			}
+		})
+	}
+}
+
+func TestRulesValidate(t *testing.T) {
+	for _, r := range []Rules{
+		{UndercutBy: -1},
+		{UndercutPercent: 100},
+		{MinMarginPercent: 10},
+		{Floor: 20, Ceiling: 10},
+		{Cost: 10, MinMarginPercent: 50, Ceiling: 12},
+	} {
+		if err := r.Validate(); err == nil {
+			t.Errorf("Validate(%+v) expected an error", r)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	prev := map[string]float64{"a.com": 10, "b.com": 20, "c.com": 30, "d.com": 5}
+	next := map[string]float64{"a.com": 10.2, "b.com": 18, "c.com": 0, "e.com": 7}
+
+	changes := Diff(prev, next, Threshold{Percent: 5})
+	got := map[string]Change{}
+	for _, c := range changes {
+		got[c.Competitor] = c
+	}
+	if len(changes) != 4 {
+		t.Fatalf("Diff = %+v", changes)
+	}
+	if _, ok := got["a.com"]; ok {
+		t.Error("a 2% move shouldn't pass a 5% threshold")
+	}
+	if c := got["b.com"]; c.Change != -2 || c.ChangePercent != -10 {
+		t.Errorf("b.com = %+v", c)
+	}
+	if c := got["c.com"]; c.NewPrice != 0 {
+		t.Errorf("c.com = %+v", c)
+	}
+	if _, ok := got["d.com"]; !ok {
+		t.Error("a competitor that disappears should be reported")
+	}
+	if c := got["e.com"]; c.OldPrice != 0 || c.ChangePercent != 0 {
+		t.Errorf("e.com = %+v", c)
+	}
+
+	if all := Diff(prev, next, Threshold{}); len(all) != 5 {
+		t.Errorf("Diff without a threshold = %+v", all)
+	}
+	if Lowest(next) != 7 {
+		t.Errorf("Lowest = %v", Lowest(next))
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	threshold := Threshold{Percent: 5}
+	base := map[string]float64{"a.com": 10, "b.com": 20}
+
+	// Two 3% drops of a.com only count once they add up.
+	for i, price := range []float64{9.7, 9.4} {
+		next := map[string]float64{"a.com": price, "b.com": 20}
+		changes := Diff(base, next, threshold)
+		if i == 0 && len(changes) != 0 {
+			t.Fatalf("first drop = %+v", changes)
+		}
+		if i == 1 && (len(changes) != 1 || changes[0].OldPrice != 10) {
+			t.Fatalf("second drop = %+v", changes)
+		}
+		base = Advance(base, next, changes)
+	}
+	if base["a.com"] != 9.4 || base["b.com"] != 20 {
+		t.Errorf("Advance = %v", base)
+	}
+
+	if got := Advance(base, map[string]float64{"c.com": 5}, []Change{{Competitor: "c.com"}}); len(got) != 1 || got["c.com"] != 5 {
+		t.Errorf("Advance with a new competitor = %v", got)
+	}
+}
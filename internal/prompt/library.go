@@ -0,0 +1,299 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prompt implements reusable prompt templates: named templates
+// with versioned revisions, variables bound from step data and few-shot
+// examples, kept in a JSON library that AI actions load by reference.
+package prompt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrTemplateNotFound is returned when a reference names no template or
+// revision of the library.
+var ErrTemplateNotFound = errors.New("prompt template not found")
+
+// Library is a collection of prompt templates.
+type Library struct {
+	Templates []*Template `json:"templates"`
+}
+
+// Template is a named prompt with its revisions.
+type Template struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// Published is the version a reference without a version resolves to.
+	// Zero means the highest version, so pinning it lets a new revision be
+	// added and previewed before it rolls out.
+	Published int         `json:"published,omitempty"`
+	Versions  []*Revision `json:"versions"`
+}
+
+// Revision is one version of a template.
+type Revision struct {
+	Version int    `json:"version"`
+	System  string `json:"system,omitempty"`
+	Prompt  string `json:"prompt"`
+
+	// Syntax is "mustache" (the default) or "go" for text/template.
+	Syntax    string     `json:"syntax,omitempty"`
+	Variables []Variable `json:"variables,omitempty"`
+	Examples  []Example  `json:"examples,omitempty"`
+	Notes     string     `json:"notes,omitempty"`
+	CreatedAt string     `json:"created_at,omitempty"`
+}
+
+// Variable declares a template input.
+type Variable struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Required    bool        `json:"required,omitempty"`
+	Default     interface{} `json:"default,omitempty"`
+}
+
+// Example is a few-shot input/output pair.
+type Example struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+}
+
+// ParseLibrary reads a library document. Besides {"templates": [...]} it
+// accepts a bare list of templates, a single template, or a single
+// revision, which becomes version 1 of an unnamed template.
+func ParseLibrary(data []byte) (*Library, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, errors.New("prompt library is empty")
+	}
+
+	var lib Library
+	switch {
+	case data[0] == '[':
+		if err := json.Unmarshal(data, &lib.Templates); err != nil {
+			return nil, fmt.Errorf("invalid prompt library: %w", err)
+		}
+	default:
+		var probe map[string]json.RawMessage
+		if err := json.Unmarshal(data, &probe); err != nil {
+			return nil, fmt.Errorf("invalid prompt library: %w", err)
+		}
+
+		var err error
+		switch {
+		case probe["templates"] != nil:
+			err = json.Unmarshal(data, &lib)
+		case probe["versions"] != nil:
+			var tmpl Template
+			err = json.Unmarshal(data, &tmpl)
+			lib.Templates = []*Template{&tmpl}
+		case probe["prompt"] != nil:
+			var rev Revision
+			err = json.Unmarshal(data, &rev)
+			if rev.Version == 0 {
+				rev.Version = 1
+			}
+			lib.Templates = []*Template{{Versions: []*Revision{&rev}}}
+		default:
+			err = errors.New(`expected "templates", "versions" or "prompt"`)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid prompt library: %w", err)
+		}
+	}
+
+	if err := lib.validate(); err != nil {
+		return nil, err
+	}
+
+	return &lib, nil
+}
+
+func (l *Library) validate() error {
+	names := map[string]bool{}
+	for _, tmpl := range l.Templates {
+		if tmpl == nil {
+			return errors.New("invalid prompt library: empty template entry")
+		}
+		if names[tmpl.Name] {
+			return fmt.Errorf("invalid prompt library: template %q is defined twice", tmpl.Name)
+		}
+		names[tmpl.Name] = true
+
+		if len(tmpl.Versions) == 0 {
+			return fmt.Errorf("invalid prompt library: template %q has no versions", tmpl.Name)
+		}
+
+		versions := map[int]bool{}
+		for _, rev := range tmpl.Versions {
+			if rev == nil || rev.Version <= 0 {
+				return fmt.Errorf("invalid prompt library: template %q has a revision without a positive version", tmpl.Name)
+			}
+			if versions[rev.Version] {
+				return fmt.Errorf("invalid prompt library: template %q has version %d twice", tmpl.Name, rev.Version)
+			}
+			versions[rev.Version] = true
+
+			switch rev.Syntax {
+			case "", SyntaxMustache, SyntaxGo:
+			default:
+				return fmt.Errorf("invalid prompt library: template %q version %d has unknown syntax %q", tmpl.Name, rev.Version, rev.Syntax)
+			}
+		}
+
+		if tmpl.Published != 0 && !versions[tmpl.Published] {
+			return fmt.Errorf("invalid prompt library: template %q publishes missing version %d", tmpl.Name, tmpl.Published)
+		}
+	}
+	return nil
+}
+
+// Ref identifies a template revision. A zero Version means the published
+// one.
+type Ref struct {
+	Name    string
+	Version int
+}
+
+// ParseRef reads a reference of the form "name", "name@3" or
+// "name@latest".
+func ParseRef(s string) (Ref, error) {
+	s = strings.TrimSpace(s)
+	name, version, found := strings.Cut(s, "@")
+	ref := Ref{Name: strings.TrimSpace(name)}
+	if !found {
+		return ref, nil
+	}
+
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if version == "" || version == "latest" {
+		ref.Version = -1
+		return ref, nil
+	}
+
+	n, err := strconv.Atoi(version)
+	if err != nil || n <= 0 {
+		return Ref{}, fmt.Errorf("invalid prompt template reference %q: version must be a positive number or \"latest\"", s)
+	}
+	ref.Version = n
+
+	return ref, nil
+}
+
+// String formats the reference back to its text form.
+func (r Ref) String() string {
+	switch {
+	case r.Version > 0:
+		return fmt.Sprintf("%s@%d", r.Name, r.Version)
+	case r.Version < 0:
+		return r.Name + "@latest"
+	default:
+		return r.Name
+	}
+}
+
+// Lookup finds a template by name. An empty name matches the only
+// template of a single-template library.
+func (l *Library) Lookup(name string) (*Template, error) {
+	if name == "" && len(l.Templates) == 1 {
+		return l.Templates[0], nil
+	}
+	for _, tmpl := range l.Templates {
+		if tmpl.Name == name {
+			return tmpl, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrTemplateNotFound, name)
+}
+
+// Resolve returns the template and revision a reference points to.
+func (l *Library) Resolve(ref Ref) (*Template, *Revision, error) {
+	tmpl, err := l.Lookup(ref.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := ref.Version
+	switch {
+	case version < 0:
+		version = tmpl.Latest()
+	case version == 0:
+		version = tmpl.PublishedVersion()
+	}
+
+	for _, rev := range tmpl.Versions {
+		if rev.Version == version {
+			return tmpl, rev, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("%w: %q has no version %d", ErrTemplateNotFound, tmpl.Name, version)
+}
+
+// Latest returns the highest version of the template.
+func (t *Template) Latest() int {
+	latest := 0
+	for _, rev := range t.Versions {
+		if rev.Version > latest {
+			latest = rev.Version
+		}
+	}
+	return latest
+}
+
+// PublishedVersion returns the version a bare reference resolves to.
+func (t *Template) PublishedVersion() int {
+	if t.Published > 0 {
+		return t.Published
+	}
+	return t.Latest()
+}
+
+// VersionNumbers returns the template's versions in ascending order.
+func (t *Template) VersionNumbers() []int {
+	versions := make([]int, 0, len(t.Versions))
+	for _, rev := range t.Versions {
+		versions = append(versions, rev.Version)
+	}
+	sort.Ints(versions)
+	return versions
+}
+
+// Summary describes the template for listing.
+func (t *Template) Summary() map[string]interface{} {
+	summary := map[string]interface{}{
+		"name":              t.Name,
+		"description":       t.Description,
+		"versions":          t.VersionNumbers(),
+		"latest_version":    t.Latest(),
+		"published_version": t.PublishedVersion(),
+	}
+
+	if _, rev, err := (&Library{Templates: []*Template{t}}).Resolve(Ref{Name: t.Name}); err == nil {
+		variables := make([]string, 0, len(rev.Variables))
+		for _, v := range rev.Variables {
+			variables = append(variables, v.Name)
+		}
+		summary["variables"] = variables
+		summary["examples"] = len(rev.Examples)
+	}
+
+	return summary
+}
@@ -0,0 +1,206 @@
+package prompt
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+const testLibrary = `{
+  "templates": [
+    {
+      "name": "support-reply",
+      "published": 1,
+      "versions": [
+        {
+          "version": 1,
+          "system": "You are a support agent for {{company}}.",
+          "prompt": "Reply to {{customer.name}} about order {{order_id}}.",
+          "variables": [
+            {"name": "company", "default": "Acme"},
+            {"name": "customer", "required": true},
+            {"name": "order_id", "required": true}
+          ]
+        },
+        {
+          "version": 2,
+          "prompt": "{{examples}}\n\nClassify: {{text}}",
+          "examples": [
+            {"input": "Where is my parcel?", "output": "shipping"},
+            {"input": "Refund {{currency}} 20", "output": "billing"}
+          ]
+        }
+      ]
+    },
+    {
+      "name": "go-style",
+      "versions": [{"version": 1, "syntax": "go", "prompt": "Hi {{.name}}"}]
+    }
+  ]
+}`
+
+func TestResolve(t *testing.T) {
+	lib, err := ParseLibrary([]byte(testLibrary))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		ref     string
+		version int
+	}{
+		{"support-reply", 1},
+		{"support-reply@latest", 2},
+		{"support-reply@2", 2},
+		{"go-style", 1},
+	}
+	for _, tt := range tests {
+		ref, err := ParseRef(tt.ref)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.ref, err)
+		}
+		_, rev, err := lib.Resolve(ref)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.ref, err)
+		}
+		if rev.Version != tt.version {
+			t.Errorf("%s: got version %d, want %d", tt.ref, rev.Version, tt.version)
+		}
+	}
+
+	if _, _, err := lib.Resolve(Ref{Name: "support-reply", Version: 9}); !errors.Is(err, ErrTemplateNotFound) {
+		t.Errorf("missing version: got %v", err)
+	}
+	if _, err := ParseRef("x@0"); err == nil {
+		t.Error("version 0 should be rejected")
+	}
+}
+
+func TestRender(t *testing.T) {
+	lib, err := ParseLibrary([]byte(testLibrary))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, rev, _ := lib.Resolve(Ref{Name: "support-reply", Version: 1})
+	out, err := Render(tmpl.Name, rev, map[string]interface{}{
+		"customer": map[string]interface{}{"name": "Ada"},
+		"order_id": float64(1042),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.System != "You are a support agent for Acme." {
+		t.Errorf("system: %q", out.System)
+	}
+	if out.Prompt != "Reply to Ada about order 1042." {
+		t.Errorf("prompt: %q", out.Prompt)
+	}
+
+	_, err = Render(tmpl.Name, rev, map[string]interface{}{"order_id": "1"})
+	if !errors.Is(err, ErrMissingVariables) || !strings.Contains(err.Error(), "customer") {
+		t.Errorf("required variable: got %v", err)
+	}
+
+	_, rev, _ = lib.Resolve(Ref{Name: "support-reply", Version: 2})
+	out, err = Render(tmpl.Name, rev, map[string]interface{}{"text": "I was charged twice", "currency": "EUR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Input: Where is my parcel?\nOutput: shipping\n\nInput: Refund EUR 20\nOutput: billing\n\nClassify: I was charged twice"
+	if out.Prompt != want {
+		t.Errorf("examples:\ngot  %q\nwant %q", out.Prompt, want)
+	}
+
+	_, err = Render(tmpl.Name, rev, map[string]interface{}{"currency": "EUR"})
+	if !errors.Is(err, ErrMissingVariables) || !strings.Contains(err.Error(), "text") {
+		t.Errorf("undeclared variable: got %v", err)
+	}
+
+	_, rev, _ = lib.Resolve(Ref{Name: "go-style"})
+	out, err = Render("go-style", rev, map[string]interface{}{"name": "Ada"})
+	if err != nil || out.Prompt != "Hi Ada" {
+		t.Errorf("go syntax: %q, %v", out.Prompt, err)
+	}
+}
+
+func TestMustacheSections(t *testing.T) {
+	rev := &Revision{
+		Version: 1,
+		Prompt:  "{{! comment }}Items:{{#items}} {{name}}={{qty}}{{/items}}{{^notes}} (no notes){{/notes}}{{#vip}} VIP{{/vip}}",
+		Variables: []Variable{
+			{Name: "notes"},
+			{Name: "vip"},
+		},
+	}
+
+	out, err := Render("t", rev, map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"name": "shirt", "qty": float64(2)},
+			map[string]interface{}{"name": "hat", "qty": float64(1)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Items: shirt=2 hat=1 (no notes)"; out.Prompt != want {
+		t.Errorf("got %q, want %q", out.Prompt, want)
+	}
+
+	if _, err := parseMustache("{{#a}}x"); err == nil {
+		t.Error("unclosed section should fail")
+	}
+}
+
+func TestParseLibraryShapes(t *testing.T) {
+	lib, err := ParseLibrary([]byte(`{"prompt": "Summarize {{text}}"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, rev, err := lib.Resolve(Ref{}); err != nil || rev.Version != 1 {
+		t.Errorf("single revision: %v", err)
+	}
+
+	if _, err := ParseLibrary([]byte(`[{"name": "a", "versions": [{"version": 1, "prompt": "x"}, {"version": 1, "prompt": "y"}]}]`)); err == nil {
+		t.Error("duplicate versions should fail")
+	}
+}
+
+// memFiles is a file store holding files by ID.
+type memFiles map[string][]byte
+
+func (f memFiles) GetFileAsBytes(_ context.Context, id string) ([]byte, error) {
+	data, ok := f[id]
+	if !ok {
+		return nil, errors.New("file not found")
+	}
+	return data, nil
+}
+
+func (f memFiles) GetFile(context.Context, string) (io.ReadCloser, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f memFiles) UploadFile(context.Context, string, io.Reader) (*sdkcontext.FileOutput, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestLoadLibraryFromFileID(t *testing.T) {
+	// Stored file IDs are xids, which are also valid base64.
+	const id = "dbb0t0vh7ojvc9ccr7t0"
+	files := memFiles{id: []byte(testLibrary)}
+
+	for _, source := range []interface{}{id, map[string]interface{}{"id": id}} {
+		lib, err := LoadLibrary(context.Background(), files, source)
+		if err != nil {
+			t.Fatalf("LoadLibrary(%v) error = %v", source, err)
+		}
+		if _, _, err := lib.Resolve(Ref{Name: "support-reply"}); err != nil {
+			t.Errorf("LoadLibrary(%v) resolve: %v", source, err)
+		}
+	}
+}
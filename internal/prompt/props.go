@@ -0,0 +1,170 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prompt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	"github.com/wakflo/extensions/internal/media"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"github.com/wakflo/go-sdk/v2/core"
+)
+
+const (
+	libraryField   = "prompt_library"
+	templateField  = "prompt_template"
+	variablesField = "template_variables"
+)
+
+// Context is the subset of the action perform context templates need.
+type Context interface {
+	Context() context.Context
+	Files() sdkcontext.FileResource
+	Input() core.JSONObject
+}
+
+// RegisterLibraryProps adds the library field.
+func RegisterLibraryProps(form *smartform.FormBuilder, required bool) {
+	form.TextareaField(libraryField, "Prompt Library").
+		Required(required).
+		HelpText("The JSON template library: a URL, a stored file, or the JSON itself. Keep it in one place, such as a file in a Git repository, so edits reach every workflow that uses it.")
+}
+
+// RegisterTemplateProps adds the template reference fields to an AI
+// action form. When a template is set it replaces the literal prompt.
+func RegisterTemplateProps(form *smartform.FormBuilder) {
+	registerReferenceProps(form, false, "Template to use instead of the prompt text, as name, name@version or name@latest. Without a version the library's published version is used.")
+}
+
+// RegisterPreviewProps adds the same fields, all required, for actions
+// that render a template without calling a model.
+func RegisterPreviewProps(form *smartform.FormBuilder) {
+	registerReferenceProps(form, true, "Template to render, as name, name@version or name@latest. Without a version the library's published version is used.")
+}
+
+func registerReferenceProps(form *smartform.FormBuilder, required bool, help string) {
+	RegisterLibraryProps(form, required)
+
+	form.TextField(templateField, "Prompt Template").
+		Required(required).
+		Placeholder("support-reply@2").
+		HelpText(help)
+
+	form.TextareaField(variablesField, "Template Variables").
+		Required(false).
+		Placeholder(`{"customer": {"name": "Ada"}, "order_id": "1042"}`).
+		HelpText("Values for the template variables, as a JSON object or an object from a previous step.")
+}
+
+// Input is the raw template input of an action.
+type Input struct {
+	Library   interface{}
+	Template  string
+	Variables interface{}
+}
+
+// InputFrom reads the template fields from raw action input.
+func InputFrom(input map[string]interface{}) Input {
+	ref, _ := input[templateField].(string)
+	return Input{
+		Library:   input[libraryField],
+		Template:  strings.TrimSpace(ref),
+		Variables: input[variablesField],
+	}
+}
+
+// FromInput renders the template an action references. It returns nil
+// when the action uses literal prompt text.
+func FromInput(ctx Context) (*Rendered, error) {
+	in := InputFrom(ctx.Input())
+	if in.Template == "" {
+		return nil, nil
+	}
+	return in.Render(ctx.Context(), ctx.Files())
+}
+
+// Render loads the library and renders the referenced template.
+func (in Input) Render(ctx context.Context, files sdkcontext.FileResource) (*Rendered, error) {
+	ref, err := ParseRef(in.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	lib, err := LoadLibrary(ctx, files, in.Library)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, rev, err := lib.Resolve(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := Variables(in.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return Render(tmpl.Name, rev, vars)
+}
+
+// LoadLibrary reads a library given as JSON text or as anything
+// media.Load accepts.
+func LoadLibrary(ctx context.Context, files sdkcontext.FileResource, source interface{}) (*Library, error) {
+	if s, ok := source.(string); ok {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return nil, errors.New("a prompt library is required")
+		}
+		if s[0] == '{' || s[0] == '[' {
+			return ParseLibrary([]byte(s))
+		}
+	}
+	if source == nil {
+		return nil, errors.New("a prompt library is required")
+	}
+
+	doc, err := media.Load(ctx, files, source)
+	if err != nil {
+		return nil, fmt.Errorf("could not load the prompt library: %w", err)
+	}
+	return ParseLibrary(doc.Data)
+}
+
+// Variables reads template variables given as an object or JSON text.
+func Variables(v interface{}) (map[string]interface{}, error) {
+	switch vars := v.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return vars, nil
+	case string:
+		if strings.TrimSpace(vars) == "" {
+			return nil, nil
+		}
+		var out map[string]interface{}
+		if err := json.Unmarshal([]byte(vars), &out); err != nil {
+			return nil, fmt.Errorf("template variables must be a JSON object: %w", err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("template variables must be an object, got %T", v)
+	}
+}
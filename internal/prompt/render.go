@@ -0,0 +1,369 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prompt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Template syntaxes.
+const (
+	SyntaxMustache = "mustache"
+	SyntaxGo       = "go"
+)
+
+// examplesVar is the variable that places the few-shot block.
+const examplesVar = "examples"
+
+// ErrMissingVariables is returned when a template uses variables that
+// have no value and no default.
+var ErrMissingVariables = errors.New("missing prompt template variables")
+
+var examplesTag = regexp.MustCompile(`\{\{\s*\.?` + examplesVar + `\s*\}\}`)
+
+// Rendered is a template revision with its variables filled in.
+type Rendered struct {
+	Template string
+	Version  int
+	System   string
+	Prompt   string
+	Examples int
+}
+
+// Output describes the rendered template for action output.
+func (r *Rendered) Output() map[string]interface{} {
+	return map[string]interface{}{
+		"name":     r.Template,
+		"version":  r.Version,
+		"ref":      Ref{Name: r.Template, Version: r.Version}.String(),
+		"examples": r.Examples,
+	}
+}
+
+// Render fills in a revision. Declared defaults apply to variables the
+// caller does not set. The few-shot examples go where the prompt
+// references {{examples}}, or before the prompt otherwise.
+func Render(name string, rev *Revision, vars map[string]interface{}) (*Rendered, error) {
+	data := make(map[string]interface{}, len(vars)+len(rev.Variables))
+	for k, v := range vars {
+		data[k] = v
+	}
+
+	optional := map[string]bool{}
+	var missing []string
+	for _, v := range rev.Variables {
+		if _, ok := data[v.Name]; ok {
+			continue
+		}
+		switch {
+		case v.Default != nil:
+			data[v.Name] = v.Default
+		case v.Required:
+			missing = append(missing, v.Name)
+		default:
+			optional[v.Name] = true
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingVariables, strings.Join(missing, ", "))
+	}
+
+	r := renderer{syntax: rev.Syntax, optional: optional, missing: map[string]bool{}}
+
+	examples, err := r.examples(rev.Examples, data)
+	if err != nil {
+		return nil, err
+	}
+
+	promptText := rev.Prompt
+	if examples != "" {
+		if examplesTag.MatchString(promptText) {
+			if _, ok := data[examplesVar]; !ok {
+				data[examplesVar] = examples
+			}
+		} else {
+			promptText = "Examples:\n\n" + examples + "\n\n" + promptText
+		}
+	}
+
+	system, err := r.render("system", rev.System, data)
+	if err != nil {
+		return nil, err
+	}
+	promptOut, err := r.render("prompt", promptText, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.missing) > 0 {
+		names := make([]string, 0, len(r.missing))
+		for n := range r.missing {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("%w: %s", ErrMissingVariables, strings.Join(names, ", "))
+	}
+
+	return &Rendered{
+		Template: name,
+		Version:  rev.Version,
+		System:   strings.TrimSpace(system),
+		Prompt:   strings.TrimSpace(promptOut),
+		Examples: len(rev.Examples),
+	}, nil
+}
+
+type renderer struct {
+	syntax   string
+	optional map[string]bool
+	missing  map[string]bool
+}
+
+func (r *renderer) examples(examples []Example, data map[string]interface{}) (string, error) {
+	blocks := make([]string, 0, len(examples))
+	for i, ex := range examples {
+		in, err := r.render(fmt.Sprintf("example %d input", i+1), ex.Input, data)
+		if err != nil {
+			return "", err
+		}
+		out, err := r.render(fmt.Sprintf("example %d output", i+1), ex.Output, data)
+		if err != nil {
+			return "", err
+		}
+		blocks = append(blocks, "Input: "+strings.TrimSpace(in)+"\nOutput: "+strings.TrimSpace(out))
+	}
+	return strings.Join(blocks, "\n\n"), nil
+}
+
+func (r *renderer) render(part, text string, data map[string]interface{}) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	if r.syntax == SyntaxGo {
+		tmpl, err := template.New(part).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s template: %w", part, err)
+		}
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, data); err != nil {
+			return "", fmt.Errorf("could not render %s: %w", part, err)
+		}
+		return sb.String(), nil
+	}
+
+	nodes, err := parseMustache(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s template: %w", part, err)
+	}
+	var sb strings.Builder
+	r.renderNodes(&sb, nodes, []interface{}{data})
+	return sb.String(), nil
+}
+
+type nodeKind int
+
+const (
+	textNode nodeKind = iota
+	varNode
+	sectionNode
+	invertedNode
+)
+
+type node struct {
+	kind     nodeKind
+	text     string
+	children []*node
+}
+
+// parseMustache parses the Mustache subset prompts need: variables with
+// dotted paths, sections, inverted sections and comments. Values are not
+// HTML-escaped, so {{x}}, {{{x}}} and {{&x}} are the same.
+func parseMustache(text string) ([]*node, error) {
+	root := &node{}
+	stack := []*node{root}
+
+	for len(text) > 0 {
+		start := strings.Index(text, "{{")
+		if start < 0 {
+			break
+		}
+		parent := stack[len(stack)-1]
+		if start > 0 {
+			parent.children = append(parent.children, &node{kind: textNode, text: text[:start]})
+		}
+		text = text[start+2:]
+
+		closer := "}}"
+		if strings.HasPrefix(text, "{") {
+			closer = "}}}"
+		}
+		end := strings.Index(text, closer)
+		if end < 0 {
+			return nil, errors.New("unclosed tag")
+		}
+		tag := strings.TrimSpace(text[:end])
+		text = text[end+len(closer):]
+
+		switch {
+		case strings.HasPrefix(tag, "!"):
+		case strings.HasPrefix(tag, "#"), strings.HasPrefix(tag, "^"):
+			kind := sectionNode
+			if tag[0] == '^' {
+				kind = invertedNode
+			}
+			n := &node{kind: kind, text: strings.TrimSpace(tag[1:])}
+			parent.children = append(parent.children, n)
+			stack = append(stack, n)
+		case strings.HasPrefix(tag, "/"):
+			name := strings.TrimSpace(tag[1:])
+			if len(stack) == 1 || parent.text != name {
+				return nil, fmt.Errorf("unexpected closing tag %q", name)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			name := strings.TrimSpace(strings.TrimLeft(tag, "{&"))
+			if name == "" {
+				return nil, errors.New("empty tag")
+			}
+			parent.children = append(parent.children, &node{kind: varNode, text: name})
+		}
+	}
+
+	if len(stack) > 1 {
+		return nil, fmt.Errorf("section %q is not closed", stack[len(stack)-1].text)
+	}
+	if text != "" {
+		root.children = append(root.children, &node{kind: textNode, text: text})
+	}
+
+	return root.children, nil
+}
+
+func (r *renderer) renderNodes(sb *strings.Builder, nodes []*node, scopes []interface{}) {
+	for _, n := range nodes {
+		switch n.kind {
+		case textNode:
+			sb.WriteString(n.text)
+		case varNode:
+			value, ok := lookup(n.text, scopes)
+			if !ok {
+				root, _, _ := strings.Cut(n.text, ".")
+				if !r.optional[root] {
+					r.missing[n.text] = true
+				}
+				continue
+			}
+			sb.WriteString(format(value))
+		case sectionNode:
+			value, _ := lookup(n.text, scopes)
+			if !truthy(value) {
+				continue
+			}
+			if list, ok := value.([]interface{}); ok {
+				for _, item := range list {
+					r.renderNodes(sb, n.children, append(scopes, item))
+				}
+				continue
+			}
+			r.renderNodes(sb, n.children, append(scopes, value))
+		case invertedNode:
+			value, _ := lookup(n.text, scopes)
+			if !truthy(value) {
+				r.renderNodes(sb, n.children, scopes)
+			}
+		}
+	}
+}
+
+// lookup resolves a dotted name against the innermost scope that has its
+// first segment.
+func lookup(name string, scopes []interface{}) (interface{}, bool) {
+	if name == "." {
+		return scopes[len(scopes)-1], true
+	}
+
+	parts := strings.Split(name, ".")
+	for i := len(scopes) - 1; i >= 0; i-- {
+		value, ok := field(scopes[i], parts[0])
+		if !ok {
+			continue
+		}
+		for _, part := range parts[1:] {
+			if value, ok = field(value, part); !ok {
+				return nil, false
+			}
+		}
+		return value, true
+	}
+	return nil, false
+}
+
+func field(scope interface{}, name string) (interface{}, bool) {
+	switch v := scope.(type) {
+	case map[string]interface{}:
+		value, ok := v[name]
+		return value, ok
+	case []interface{}:
+		i, err := strconv.Atoi(name)
+		if err != nil || i < 0 || i >= len(v) {
+			return nil, false
+		}
+		return v[i], true
+	default:
+		return nil, false
+	}
+}
+
+func truthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+func format(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool, int, int64:
+		return fmt.Sprint(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
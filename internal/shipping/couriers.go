@@ -0,0 +1,181 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shipping
+
+import (
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+// Courier is a carrier known to the shipping connectors. Code is the
+// courier code used by AfterShip and TrackingMore; carriers that name it
+// differently have their own code set.
+type Courier struct {
+	Code   string
+	Name   string
+	Shippo string
+	// Aliases are other codes the courier is known by.
+	Aliases []string
+}
+
+// ShippoCode returns the Shippo carrier token of the courier.
+func (c Courier) ShippoCode() string {
+	if c.Shippo != "" {
+		return c.Shippo
+	}
+	return strings.ReplaceAll(c.Code, "-", "_")
+}
+
+// Couriers is the merged courier table of the shipping connectors.
+var Couriers = []Courier{
+	{Code: "fedex", Name: "FedEx"},
+	{Code: "ups", Name: "UPS"},
+	{Code: "usps", Name: "USPS"},
+	{Code: "dhl", Name: "DHL", Shippo: "dhl_express"},
+	{Code: "speedaf", Name: "Speedaf"},
+	{Code: "ontrac", Name: "OnTrac"},
+	{Code: "lasership", Name: "LaserShip"},
+	{Code: "tforce", Name: "TForce"},
+	{Code: "rrdonnelley", Name: "RR Donnelley"},
+	{Code: "estes", Name: "Estes"},
+	{Code: "old_dominion", Name: "Old Dominion Freight Line"},
+	{Code: "saia", Name: "Saia"},
+	{Code: "xpo", Name: "XPO Logistics", Aliases: []string{"xpo_logistics"}},
+	{Code: "forward_air", Name: "Forward Air", Aliases: []string{"forwardair"}},
+	{Code: "speedee", Name: "SpeeDee Delivery"},
+	{Code: "gso", Name: "GSO (Golden State Overnight)"},
+	{Code: "tnt", Name: "TNT"},
+	{Code: "pitney_bowes", Name: "Pitney Bowes"},
+	{Code: "purolator", Name: "Purolator"},
+	{Code: "newgistics", Name: "Newgistics"},
+	{Code: "yrc_freight", Name: "YRC Freight"},
+	{Code: "land_air", Name: "Land Air Express"},
+	{Code: "u_ship", Name: "uShip"},
+	{Code: "xpress_global", Name: "Xpress Global Systems"},
+	{Code: "daylight_transport", Name: "Daylight Transport"},
+	{Code: "r_l_carriers", Name: "R+L Carriers"},
+	{Code: "central_transport", Name: "Central Transport"},
+	{Code: "southeastern_freight", Name: "Southeastern Freight Lines"},
+	{Code: "ward_trucking", Name: "Ward Trucking"},
+	{Code: "cross_country", Name: "Cross Country Courier"},
+	{Code: "gls", Name: "GLS"},
+	{Code: "roadrunner", Name: "Roadrunner Transportation"},
+	{Code: "aaa_cooper", Name: "AAA Cooper Transportation"},
+	{Code: "dohrn_transfer", Name: "Dohrn Transfer"},
+	{Code: "rwc", Name: "RWC (Regional West)"},
+	{Code: "brown_integrated", Name: "Brown Integrated Logistics"},
+	{Code: "r_l_freight", Name: "R+L Global Logistics"},
+	{Code: "sudden_valley", Name: "Sudden Valley"},
+	{Code: "best_overland", Name: "Best Overland Freight"},
+	{Code: "western_freight", Name: "Western Freight"},
+	{Code: "americold", Name: "Americold"},
+	{Code: "allied_express", Name: "Allied Express"},
+	{Code: "yellow_corp", Name: "Yellow Corporation"},
+	{Code: "safelite", Name: "Safelite"},
+	{Code: "mainfreight", Name: "Mainfreight"},
+	{Code: "dynamex", Name: "Dynamex"},
+	{Code: "hermes", Name: "Hermes", Shippo: "hermes_uk"},
+	{Code: "ups_freight", Name: "UPS Freight", Shippo: "ups"},
+	{Code: "fedex_ground", Name: "FedEx Ground", Shippo: "fedex"},
+	{Code: "usps_priority", Name: "USPS Priority Mail", Shippo: "usps"},
+	{Code: "china-post", Name: "China Post"},
+	{Code: "china-ems", Name: "China EMS"},
+	{Code: "postnord", Name: "PostNord"},
+	{Code: "fastway_uk", Name: "Fastway UK"},
+	{Code: "dpd_canada", Name: "DPD Canada"},
+	{Code: "intime_express", Name: "InTime Express"},
+	{Code: "p4d", Name: "P4D (UK)"},
+	{Code: "frakt24", Name: "Frakt24"},
+	{Code: "bring_express", Name: "Bring Express"},
+	{Code: "mbe_canada", Name: "MBE Canada"},
+	{Code: "chit_chat_express", Name: "Chit Chat Express"},
+	{Code: "gophr", Name: "Gophr"},
+	{Code: "city_sprint", Name: "City Sprint"},
+	{Code: "purolator_courier", Name: "Purolator Courier"},
+	{Code: "logistics_xpress", Name: "Logistics Xpress"},
+	{Code: "ceva_logistics", Name: "CEVA Logistics"},
+	{Code: "cool_express", Name: "Cool Express"},
+	{Code: "hong-kong-post", Name: "Hong Kong Post"},
+	{Code: "singapore-post", Name: "Singapore Post"},
+	{Code: "swiss-post", Name: "Swiss Post"},
+	{Code: "royal-mail", Name: "Royal Mail", Shippo: "royal_mail"},
+	{Code: "postnl-parcels", Name: "PostNL International"},
+	{Code: "canada-post", Name: "Canada Post", Shippo: "canada_post"},
+	{Code: "australia-post", Name: "Australia Post", Shippo: "australia_post"},
+	{Code: "new-zealand-post", Name: "New Zealand Post"},
+	{Code: "parcel-force", Name: "Parcelforce", Shippo: "parcelforce"},
+	{Code: "belgium-post", Name: "Bpost"},
+	{Code: "brazil-correios", Name: "Brazil Correios"},
+	{Code: "russian-post", Name: "Russian Post"},
+	{Code: "malaysia-post", Name: "Malaysia Post"},
+	{Code: "maldives-post", Name: "Maldives Post"},
+	{Code: "malta-post", Name: "Malta Post"},
+	{Code: "mauritius-post", Name: "Mauritius Post"},
+	{Code: "correos-mexico", Name: "Mexico Post"},
+	{Code: "moldova-post", Name: "Moldova Post"},
+	{Code: "la-poste-monaco", Name: "Monaco Post"},
+	{Code: "monaco-ems", Name: "Monaco EMS"},
+	{Code: "mongol-post", Name: "Mongol Post"},
+	{Code: "posta-crne-gore", Name: "Montenegro Post"},
+}
+
+// CourierOptions returns the couriers as select options.
+func CourierOptions() []*smartform.Option {
+	options := make([]*smartform.Option, 0, len(Couriers))
+	for _, c := range Couriers {
+		options = append(options, &smartform.Option{Value: c.Code, Label: c.Name})
+	}
+	return options
+}
+
+// FindCourier looks a courier up by code or alias, case-insensitively. Unknown
+// codes return a courier with only the code set, so carriers missing
+// from the table can still be used.
+func FindCourier(code string) Courier {
+	code = strings.TrimSpace(code)
+	for _, c := range Couriers {
+		if strings.EqualFold(c.Code, code) {
+			return c
+		}
+		for _, alias := range c.Aliases {
+			if strings.EqualFold(alias, code) {
+				return c
+			}
+		}
+	}
+	return Courier{Code: code, Name: code}
+}
+
+// MatchCourier finds the courier a carrier name refers to, as carriers
+// report it on rates and labels: a code, a Shippo token or a name such
+// as "DHL Express", ignoring case, spaces and dashes.
+func MatchCourier(name string) (Courier, bool) {
+	key := courierKey(name)
+	if key == "" {
+		return Courier{}, false
+	}
+	for _, c := range Couriers {
+		if courierKey(c.Code) == key || courierKey(c.ShippoCode()) == key || courierKey(c.Name) == key {
+			return c, true
+		}
+	}
+	return Courier{}, false
+}
+
+func courierKey(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(s)
+}
@@ -0,0 +1,128 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shipping
+
+import "fmt"
+
+// Fulfillment steps, in the order they run.
+const (
+	StepOrder     = "get_order"
+	StepRates     = "get_rates"
+	StepLabel     = "buy_label"
+	StepTracking  = "register_tracking"
+	StepWriteBack = "update_store"
+)
+
+// Step and fulfillment statuses.
+const (
+	StepDone    = "done"
+	StepSkipped = "skipped"
+	StepFailed  = "failed"
+
+	Fulfilled          = "fulfilled"
+	PartiallyFulfilled = "partially_fulfilled"
+	NotFulfilled       = "not_fulfilled"
+)
+
+// StepResult is the outcome of one fulfillment step.
+type StepResult struct {
+	Step   string `json:"step"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// FulfillmentResult is the normalized outcome of fulfilling an order:
+// the label bought, the tracking registered, what each step did, and
+// what to undo by hand when a step failed after money was spent.
+type FulfillmentResult struct {
+	Status      string       `json:"status"`
+	Store       string       `json:"store"`
+	OrderID     string       `json:"order_id"`
+	OrderName   string       `json:"order_name,omitempty"`
+	ShipTo      *Address     `json:"ship_to,omitempty"`
+	Rate        *Rate        `json:"rate,omitempty"`
+	Label       *Label       `json:"label,omitempty"`
+	Tracking    *Tracking    `json:"tracking,omitempty"`
+	StoreUpdate interface{}  `json:"store_update,omitempty"`
+	Steps       []StepResult `json:"steps"`
+	Rollback    []string     `json:"rollback"`
+	Error       string       `json:"error,omitempty"`
+}
+
+// NewFulfillmentResult starts the result of fulfilling an order.
+func NewFulfillmentResult(store, orderID string) *FulfillmentResult {
+	return &FulfillmentResult{
+		Status:   NotFulfilled,
+		Store:    store,
+		OrderID:  orderID,
+		Steps:    []StepResult{},
+		Rollback: []string{},
+	}
+}
+
+// Done records a step that succeeded.
+func (r *FulfillmentResult) Done(step, detail string) {
+	r.Steps = append(r.Steps, StepResult{Step: step, Status: StepDone, Detail: detail})
+}
+
+// Skip records a step that didn't run and why.
+func (r *FulfillmentResult) Skip(step, reason string) {
+	r.Steps = append(r.Steps, StepResult{Step: step, Status: StepSkipped, Detail: reason})
+}
+
+// Warn records a failed step the fulfillment can go on without.
+func (r *FulfillmentResult) Warn(step string, err error) {
+	r.Steps = append(r.Steps, StepResult{Step: step, Status: StepFailed, Error: err.Error()})
+}
+
+// Fail records a failed step and lists what the steps done before it
+// left behind. Once a label is bought the order is partially fulfilled,
+// since the label can still be used or voided.
+func (r *FulfillmentResult) Fail(step string, err error) {
+	r.Steps = append(r.Steps, StepResult{Step: step, Status: StepFailed, Error: err.Error()})
+	r.Error = fmt.Sprintf("%s: %v", step, err)
+
+	if r.Label == nil {
+		r.Status = NotFulfilled
+		r.Rollback = append(r.Rollback, "Nothing was bought or changed; the step can be retried as is.")
+		return
+	}
+
+	r.Status = PartiallyFulfilled
+	r.Rollback = append(r.Rollback, fmt.Sprintf(
+		"Label %s (tracking %s) was bought from %s for %s. Void it with %s for a refund if the order won't ship with it; retrying buys a second label.",
+		r.Label.ID, r.Label.TrackingNumber, r.Label.Provider, r.labelCost(), r.Label.Provider))
+	if r.Tracking != nil {
+		r.Rollback = append(r.Rollback, fmt.Sprintf(
+			"Tracking %s was registered with %s. Delete it there if you void the label.",
+			r.Tracking.TrackingNumber, r.Tracking.Provider))
+	}
+	r.Rollback = append(r.Rollback, fmt.Sprintf(
+		"The %s order was not updated. Fulfill it by hand with tracking %s, or void the label.",
+		r.Store, r.Label.TrackingNumber))
+}
+
+// Complete marks the order fulfilled.
+func (r *FulfillmentResult) Complete() {
+	r.Status = Fulfilled
+}
+
+func (r *FulfillmentResult) labelCost() string {
+	if r.Label.Rate == nil {
+		return "an unknown amount"
+	}
+	return fmt.Sprintf("%.2f %s", r.Label.Rate.Amount, r.Label.Rate.Currency)
+}
@@ -0,0 +1,327 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shipping
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+const (
+	lengthField       = "length"
+	widthField        = "width"
+	heightField       = "height"
+	distanceUnitField = "distance_unit"
+	weightField       = "weight"
+	massUnitField     = "mass_unit"
+	strategyField     = "rate_strategy"
+	serviceField      = "service"
+	statusesField     = "statuses"
+	contentsField     = "contents_description"
+	valueField        = "declared_value"
+	currencyField     = "declared_currency"
+	hsCodeField       = "hs_code"
+)
+
+// RegisterAddressProps adds the fields of an address, named after prefix
+// (for example from_street1), so every connector takes addresses the
+// same way.
+func RegisterAddressProps(form *smartform.FormBuilder, prefix, label string) {
+	form.TextField(prefix+"_name", label+" Name").
+		Required(true).
+		HelpText("Full name of the contact.")
+
+	form.TextField(prefix+"_company", label+" Company").
+		Required(false)
+
+	form.TextField(prefix+"_street1", label+" Street").
+		Required(true).
+		HelpText("Street address, first line.")
+
+	form.TextField(prefix+"_street2", label+" Street Line 2").
+		Required(false)
+
+	form.TextField(prefix+"_city", label+" City").
+		Required(true)
+
+	form.TextField(prefix+"_state", label+" State").
+		Required(false).
+		HelpText("State or province code, where the country uses them.")
+
+	form.TextField(prefix+"_zip", label+" Postal Code").
+		Required(false)
+
+	form.TextField(prefix+"_country", label+" Country").
+		Required(true).
+		Placeholder("US").
+		HelpText("Two-letter ISO country code.")
+
+	form.TextField(prefix+"_phone", label+" Phone").
+		Required(false)
+
+	form.TextField(prefix+"_email", label+" Email").
+		Required(false)
+
+	form.CheckboxField(prefix+"_residential", label+" Is Residential").
+		Required(false).
+		DefaultValue(false)
+}
+
+// AddressFrom reads the address registered under prefix from raw action
+// input.
+func AddressFrom(input map[string]interface{}, prefix string) Address {
+	get := func(name string) string {
+		return stringValue(input[prefix+"_"+name])
+	}
+	residential, _ := input[prefix+"_residential"].(bool)
+
+	return Address{
+		Name:        get("name"),
+		Company:     get("company"),
+		Street1:     get("street1"),
+		Street2:     get("street2"),
+		City:        get("city"),
+		State:       get("state"),
+		Zip:         get("zip"),
+		Country:     strings.ToUpper(get("country")),
+		Phone:       get("phone"),
+		Email:       get("email"),
+		Residential: residential,
+	}
+}
+
+// RegisterParcelProps adds the parcel dimension and weight fields.
+func RegisterParcelProps(form *smartform.FormBuilder) {
+	form.NumberField(lengthField, "Parcel Length").
+		Required(true)
+
+	form.NumberField(widthField, "Parcel Width").
+		Required(true)
+
+	form.NumberField(heightField, "Parcel Height").
+		Required(true)
+
+	form.SelectField(distanceUnitField, "Distance Unit").
+		Required(true).
+		DefaultValue("in").
+		AddOption("in", "Inches").
+		AddOption("cm", "Centimeters").
+		AddOption("mm", "Millimeters").
+		AddOption("m", "Meters").
+		AddOption("ft", "Feet").
+		AddOption("yd", "Yards")
+
+	form.NumberField(weightField, "Parcel Weight").
+		Required(true)
+
+	form.SelectField(massUnitField, "Mass Unit").
+		Required(true).
+		DefaultValue("lb").
+		AddOption("lb", "Pounds").
+		AddOption("oz", "Ounces").
+		AddOption("kg", "Kilograms").
+		AddOption("g", "Grams")
+}
+
+// ParcelFrom reads and validates the parcel from raw action input.
+func ParcelFrom(input map[string]interface{}) (Parcel, error) {
+	var p Parcel
+	var err error
+	for _, f := range []struct {
+		name string
+		dst  *float64
+	}{
+		{lengthField, &p.Length},
+		{widthField, &p.Width},
+		{heightField, &p.Height},
+		{weightField, &p.Weight},
+	} {
+		if *f.dst, err = floatValue(input[f.name]); err != nil {
+			return p, fmt.Errorf("parcel %s: %w", f.name, err)
+		}
+	}
+
+	p.DistanceUnit = stringValue(input[distanceUnitField])
+	if p.DistanceUnit == "" {
+		p.DistanceUnit = "in"
+	}
+	p.MassUnit = stringValue(input[massUnitField])
+	if p.MassUnit == "" {
+		p.MassUnit = "lb"
+	}
+
+	return p, p.Validate()
+}
+
+// Contents describes what a parcel holds, for carriers that need it to
+// quote duties and print customs forms.
+type Contents struct {
+	Description string  `json:"description"`
+	Value       float64 `json:"value"`
+	Currency    string  `json:"currency"`
+	HSCode      string  `json:"hs_code,omitempty"`
+}
+
+// RegisterContentsProps adds the parcel contents fields.
+func RegisterContentsProps(form *smartform.FormBuilder) {
+	form.TextField(contentsField, "Contents Description").
+		Required(true).
+		Placeholder("Cotton t-shirts").
+		HelpText("What the parcel contains.")
+
+	form.NumberField(valueField, "Declared Value").
+		Required(true).
+		HelpText("Total customs value of the contents.")
+
+	form.TextField(currencyField, "Declared Currency").
+		Required(false).
+		DefaultValue("USD").
+		HelpText("Three-letter currency code of the declared value.")
+
+	form.TextField(hsCodeField, "HS Code").
+		Required(false).
+		HelpText("Harmonized System code of the contents, for international shipments.")
+}
+
+// ContentsFrom reads the parcel contents from raw action input.
+func ContentsFrom(input map[string]interface{}) Contents {
+	currency := strings.ToUpper(stringValue(input[currencyField]))
+	if currency == "" {
+		currency = "USD"
+	}
+	return Contents{
+		Description: stringValue(input[contentsField]),
+		Value:       ParseFloat(input[valueField]),
+		Currency:    currency,
+		HSCode:      stringValue(input[hsCodeField]),
+	}
+}
+
+// RegisterRateProps adds the fields that pick a rate when buying a label:
+// an explicit service, or else a strategy.
+func RegisterRateProps(form *smartform.FormBuilder) {
+	form.SelectField(strategyField, "Rate Strategy").
+		Required(false).
+		DefaultValue(Cheapest).
+		AddOption(Cheapest, "Cheapest").
+		AddOption(Fastest, "Fastest").
+		HelpText("How to pick the rate when no service is given.")
+
+	form.TextField(serviceField, "Service").
+		Required(false).
+		HelpText("Buy this service, by code or name, instead of using the strategy.")
+}
+
+// PickRate selects the rate chosen by the fields of RegisterRateProps.
+func PickRate(input map[string]interface{}, rates []Rate) (*Rate, error) {
+	if service := stringValue(input[serviceField]); service != "" {
+		return FindService(rates, service)
+	}
+	return SelectRate(rates, stringValue(input[strategyField]))
+}
+
+// RegisterStatusFilterProps adds the status filter of the tracking
+// status triggers.
+func RegisterStatusFilterProps(form *smartform.FormBuilder) {
+	form.MultiSelectField(statusesField, "Statuses").
+		Required(false).
+		AddOptions(StatusOptions...).
+		HelpText("Only trigger when a shipment enters one of these statuses. Leave empty for every change.")
+}
+
+// StatusFilterFrom reads the status filter from raw trigger input.
+func StatusFilterFrom(input map[string]interface{}) []string {
+	switch v := input[statusesField].(type) {
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, s := range v {
+			if str := stringValue(s); str != "" {
+				out = append(out, str)
+			}
+		}
+		return out
+	case string:
+		return SplitList(v)
+	default:
+		return nil
+	}
+}
+
+// SplitList splits a list given one per line or separated by commas.
+func SplitList(s string) []string {
+	var out []string
+	for _, item := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '\n' || r == '\r' }) {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func stringValue(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(s)
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	case json.Number:
+		return s.String()
+	default:
+		return strings.TrimSpace(fmt.Sprint(s))
+	}
+}
+
+func floatValue(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return n, nil
+	case int:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case json.Number:
+		return n.Float64()
+	case string:
+		if strings.TrimSpace(n) == "" {
+			return 0, nil
+		}
+		return strconv.ParseFloat(strings.TrimSpace(n), 64)
+	default:
+		return 0, fmt.Errorf("expected a number, got %T", v)
+	}
+}
+
+// FormatFloat formats a number without trailing zeros, for carriers that
+// take numbers as strings.
+func FormatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// ParseFloat reads a number a carrier returned as a string or a number.
+// Invalid values read as zero.
+func ParseFloat(v interface{}) float64 {
+	f, _ := floatValue(v)
+	return f
+}
@@ -0,0 +1,292 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package shipping holds the carrier-neutral shapes shared by the
+// shipping connectors: addresses, parcels, rates, labels and tracking
+// with normalized statuses, so a workflow can switch carrier without
+// being rewritten.
+package shipping
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Address is a postal address. Country is an ISO 3166-1 alpha-2 code.
+type Address struct {
+	Name        string `json:"name"`
+	Company     string `json:"company,omitempty"`
+	Street1     string `json:"street1"`
+	Street2     string `json:"street2,omitempty"`
+	City        string `json:"city"`
+	State       string `json:"state,omitempty"`
+	Zip         string `json:"zip"`
+	Country     string `json:"country"`
+	Phone       string `json:"phone,omitempty"`
+	Email       string `json:"email,omitempty"`
+	Residential bool   `json:"residential,omitempty"`
+}
+
+// Validate checks the fields every carrier needs.
+func (a Address) Validate() error {
+	var missing []string
+	if a.Name == "" && a.Company == "" {
+		missing = append(missing, "name")
+	}
+	if a.Street1 == "" {
+		missing = append(missing, "street1")
+	}
+	if a.City == "" {
+		missing = append(missing, "city")
+	}
+	if a.Country == "" {
+		missing = append(missing, "country")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("address is missing %s", strings.Join(missing, ", "))
+	}
+	if len(a.Country) != 2 {
+		return fmt.Errorf("address country must be a 2-letter ISO code, got %q", a.Country)
+	}
+	return nil
+}
+
+// Country3 returns the ISO 3166-1 alpha-3 code of the address country.
+func (a Address) Country3() string {
+	return CountryAlpha3(a.Country)
+}
+
+// Parcel is a box with its dimensions and weight.
+type Parcel struct {
+	Length       float64 `json:"length"`
+	Width        float64 `json:"width"`
+	Height       float64 `json:"height"`
+	DistanceUnit string  `json:"distance_unit"`
+	Weight       float64 `json:"weight"`
+	MassUnit     string  `json:"mass_unit"`
+}
+
+var distanceUnits = map[string]float64{"cm": 1, "in": 2.54, "mm": 0.1, "m": 100, "ft": 30.48, "yd": 91.44}
+
+var massUnits = map[string]float64{"kg": 1, "lb": 0.45359237, "oz": 0.028349523125, "g": 0.001}
+
+// Validate checks the parcel has a weight and known units.
+func (p Parcel) Validate() error {
+	if p.Weight <= 0 {
+		return errors.New("parcel weight must be positive")
+	}
+	if p.Length < 0 || p.Width < 0 || p.Height < 0 {
+		return errors.New("parcel dimensions cannot be negative")
+	}
+	if _, ok := distanceUnits[p.DistanceUnit]; !ok {
+		return fmt.Errorf("unknown distance unit %q", p.DistanceUnit)
+	}
+	if _, ok := massUnits[p.MassUnit]; !ok {
+		return fmt.Errorf("unknown mass unit %q", p.MassUnit)
+	}
+	return nil
+}
+
+// In returns the parcel converted to the given units, for carriers that
+// accept only some of them.
+func (p Parcel) In(distanceUnit, massUnit string) (Parcel, error) {
+	from, ok := distanceUnits[p.DistanceUnit]
+	if !ok {
+		return p, fmt.Errorf("unknown distance unit %q", p.DistanceUnit)
+	}
+	to, ok := distanceUnits[distanceUnit]
+	if !ok {
+		return p, fmt.Errorf("unknown distance unit %q", distanceUnit)
+	}
+	fromMass, ok := massUnits[p.MassUnit]
+	if !ok {
+		return p, fmt.Errorf("unknown mass unit %q", p.MassUnit)
+	}
+	toMass, ok := massUnits[massUnit]
+	if !ok {
+		return p, fmt.Errorf("unknown mass unit %q", massUnit)
+	}
+
+	scale := from / to
+	return Parcel{
+		Length:       round(p.Length*scale, 2),
+		Width:        round(p.Width*scale, 2),
+		Height:       round(p.Height*scale, 2),
+		DistanceUnit: distanceUnit,
+		Weight:       round(p.Weight*fromMass/toMass, 3),
+		MassUnit:     massUnit,
+	}, nil
+}
+
+func round(v float64, places int) float64 {
+	p := math.Pow(10, float64(places))
+	return math.Round(v*p) / p
+}
+
+// Rate is a price quoted by a carrier for a service.
+type Rate struct {
+	Provider      string  `json:"provider"`
+	ID            string  `json:"rate_id,omitempty"`
+	ShipmentID    string  `json:"shipment_id,omitempty"`
+	Carrier       string  `json:"carrier"`
+	CarrierID     string  `json:"carrier_id,omitempty"`
+	Service       string  `json:"service"`
+	ServiceCode   string  `json:"service_code,omitempty"`
+	Amount        float64 `json:"amount"`
+	Currency      string  `json:"currency"`
+	EstimatedDays int     `json:"estimated_days,omitempty"`
+}
+
+// Rate shopping strategies.
+const (
+	Cheapest = "cheapest"
+	Fastest  = "fastest"
+)
+
+// SortRates orders rates from cheapest to most expensive.
+func SortRates(rates []Rate) {
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].Amount < rates[j].Amount
+	})
+}
+
+// SelectRate picks a rate by strategy: the cheapest, or the fastest with
+// price breaking ties. Rates without a delivery estimate never count as
+// fastest; when no rate has one the cheapest is returned.
+func SelectRate(rates []Rate, strategy string) (*Rate, error) {
+	if len(rates) == 0 {
+		return nil, errors.New("no rates are available for this shipment")
+	}
+
+	var best *Rate
+	for i := range rates {
+		r := &rates[i]
+		switch strategy {
+		case Fastest:
+			if r.EstimatedDays <= 0 {
+				continue
+			}
+			if best == nil || r.EstimatedDays < best.EstimatedDays ||
+				(r.EstimatedDays == best.EstimatedDays && r.Amount < best.Amount) {
+				best = r
+			}
+		case Cheapest, "":
+			if best == nil || r.Amount < best.Amount {
+				best = r
+			}
+		default:
+			return nil, fmt.Errorf("unknown rate strategy %q", strategy)
+		}
+	}
+	if best == nil {
+		return SelectRate(rates, Cheapest)
+	}
+	return best, nil
+}
+
+// FindService returns the rate of a service, matched on its code or
+// name, case-insensitively.
+func FindService(rates []Rate, service string) (*Rate, error) {
+	for i := range rates {
+		if strings.EqualFold(rates[i].ServiceCode, service) || strings.EqualFold(rates[i].Service, service) {
+			return &rates[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no rate for service %q", service)
+}
+
+// RateSummary is the output of the rate shopping actions: every rate,
+// cheapest first, with the cheapest and fastest picked out.
+func RateSummary(rates []Rate) map[string]interface{} {
+	SortRates(rates)
+	out := map[string]interface{}{
+		"rates": rates,
+		"count": len(rates),
+	}
+	if cheapest, err := SelectRate(rates, Cheapest); err == nil {
+		out["cheapest"] = cheapest
+	}
+	if fastest, err := SelectRate(rates, Fastest); err == nil {
+		out["fastest"] = fastest
+	}
+	return out
+}
+
+// Label is a purchased shipping label.
+type Label struct {
+	Provider       string `json:"provider"`
+	ID             string `json:"label_id"`
+	ShipmentID     string `json:"shipment_id,omitempty"`
+	Status         string `json:"status,omitempty"`
+	TrackingNumber string `json:"tracking_number"`
+	TrackingURL    string `json:"tracking_url,omitempty"`
+	LabelURL       string `json:"label_url"`
+	Carrier        string `json:"carrier,omitempty"`
+	Service        string `json:"service,omitempty"`
+	IsReturn       bool   `json:"is_return"`
+	Rate           *Rate  `json:"rate,omitempty"`
+}
+
+// AddressValidation is the result of checking an address with a carrier.
+type AddressValidation struct {
+	Provider string   `json:"provider"`
+	Valid    bool     `json:"valid"`
+	Address  Address  `json:"address"`
+	Messages []string `json:"messages,omitempty"`
+}
+
+// TrackingEvent is one checkpoint of a shipment.
+type TrackingEvent struct {
+	Status     Status `json:"status"`
+	RawStatus  string `json:"raw_status,omitempty"`
+	Message    string `json:"message,omitempty"`
+	Location   string `json:"location,omitempty"`
+	OccurredAt string `json:"occurred_at,omitempty"`
+}
+
+// Tracking is the tracking state of a shipment.
+type Tracking struct {
+	Provider          string          `json:"provider"`
+	ID                string          `json:"id,omitempty"`
+	TrackingNumber    string          `json:"tracking_number"`
+	Carrier           string          `json:"carrier,omitempty"`
+	Status            Status          `json:"status"`
+	RawStatus         string          `json:"raw_status,omitempty"`
+	PreviousStatus    Status          `json:"previous_status,omitempty"`
+	EstimatedDelivery string          `json:"estimated_delivery,omitempty"`
+	UpdatedAt         string          `json:"updated_at,omitempty"`
+	Events            []TrackingEvent `json:"events,omitempty"`
+}
+
+// Key identifies the tracking across polls.
+func (t Tracking) Key() string {
+	if t.ID != "" {
+		return t.ID
+	}
+	return t.Carrier + ":" + t.TrackingNumber
+}
+
+// JoinLocation joins the non-empty parts of a location.
+func JoinLocation(parts ...string) string {
+	var kept []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, ", ")
+}
@@ -0,0 +1,191 @@
+package shipping
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeStatus(t *testing.T) {
+	cases := map[string]Status{
+		"InTransit":              StatusInTransit,
+		"OutForDelivery":         StatusOutForDelivery,
+		"AttemptFail":            StatusFailedAttempt,
+		"transit":                StatusInTransit,
+		"pickup":                 StatusOutForDelivery,
+		"PRE_TRANSIT":            StatusPending,
+		"FAILURE":                StatusException,
+		"in_transit_to_customer": StatusInTransit,
+		"Returned to Sender":     StatusReturned,
+		"CANCELLED":              StatusCancelled,
+		"Delivery failed":        StatusFailedAttempt,
+		"delivered":              StatusDelivered,
+		"":                       StatusUnknown,
+		"zzz":                    StatusUnknown,
+	}
+	for raw, want := range cases {
+		if got := NormalizeStatus(raw); got != want {
+			t.Errorf("NormalizeStatus(%q) = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+func TestSelectRate(t *testing.T) {
+	rates := []Rate{
+		{Service: "Ground", Amount: 8.5, EstimatedDays: 5},
+		{Service: "Express", Amount: 30, EstimatedDays: 1},
+		{Service: "Saver", Amount: 12, EstimatedDays: 1},
+		{Service: "Economy", Amount: 6},
+	}
+
+	cheapest, err := SelectRate(rates, Cheapest)
+	if err != nil || cheapest.Service != "Economy" {
+		t.Fatalf("cheapest = %+v, %v", cheapest, err)
+	}
+	fastest, err := SelectRate(rates, Fastest)
+	if err != nil || fastest.Service != "Saver" {
+		t.Fatalf("fastest = %+v, %v", fastest, err)
+	}
+
+	noEstimate := []Rate{{Service: "A", Amount: 3}, {Service: "B", Amount: 2}}
+	if r, _ := SelectRate(noEstimate, Fastest); r.Service != "B" {
+		t.Errorf("fastest without estimates = %q, want cheapest", r.Service)
+	}
+	if _, err := SelectRate(nil, Cheapest); err == nil {
+		t.Error("expected an error without rates")
+	}
+	if r, err := FindService(rates, "express"); err != nil || r.Amount != 30 {
+		t.Errorf("FindService = %+v, %v", r, err)
+	}
+}
+
+func TestParcelIn(t *testing.T) {
+	p := Parcel{Length: 10, Width: 5, Height: 2, DistanceUnit: "in", Weight: 2, MassUnit: "lb"}
+	got, err := p.In("cm", "kg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Length != 25.4 || got.Width != 12.7 || got.Height != 5.08 || got.Weight != 0.907 {
+		t.Errorf("converted parcel = %+v", got)
+	}
+	if _, err := p.In("cubit", "kg"); err == nil {
+		t.Error("expected an error for an unknown unit")
+	}
+}
+
+func TestChanges(t *testing.T) {
+	previous := map[string]Status{"a": StatusInTransit, "b": StatusInTransit}
+	trackings := []Tracking{
+		{ID: "a", Status: StatusInTransit},
+		{ID: "b", Status: StatusDelivered},
+		{ID: "c", Status: StatusPending},
+	}
+
+	changed, next := Changes(previous, trackings)
+	if len(changed) != 2 || changed[0].ID != "b" || changed[1].ID != "c" {
+		t.Fatalf("changed = %+v", changed)
+	}
+	if changed[0].PreviousStatus != StatusInTransit || changed[1].PreviousStatus != "" {
+		t.Errorf("previous statuses = %q, %q", changed[0].PreviousStatus, changed[1].PreviousStatus)
+	}
+	if next["b"] != StatusDelivered || next["c"] != StatusPending || len(next) != 3 {
+		t.Errorf("next = %v", next)
+	}
+
+	stored := StatusesFrom(map[string]interface{}{"a": "delivered"})
+	if stored["a"] != StatusDelivered {
+		t.Errorf("StatusesFrom = %v", stored)
+	}
+}
+
+func TestAddressFrom(t *testing.T) {
+	input := map[string]interface{}{
+		"to_name":    "Ada Lovelace",
+		"to_street1": "1 Main St",
+		"to_city":    "London",
+		"to_zip":     "N1 9GU",
+		"to_country": "gb",
+	}
+	a := AddressFrom(input, "to")
+	if err := a.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if a.Country != "GB" || a.Country3() != "GBR" {
+		t.Errorf("country = %q / %q", a.Country, a.Country3())
+	}
+	if CountryAlpha2("USA") != "US" {
+		t.Errorf("CountryAlpha2(USA) = %q", CountryAlpha2("USA"))
+	}
+	if err := (Address{Name: "x"}).Validate(); err == nil {
+		t.Error("expected an error for an incomplete address")
+	}
+}
+
+func TestParcelFrom(t *testing.T) {
+	p, err := ParcelFrom(map[string]interface{}{"length": "10", "width": 4.0, "height": 2.0, "weight": 1.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Length != 10 || p.DistanceUnit != "in" || p.MassUnit != "lb" {
+		t.Errorf("parcel = %+v", p)
+	}
+	if _, err := ParcelFrom(map[string]interface{}{"length": 1.0}); err == nil {
+		t.Error("expected an error without a weight")
+	}
+}
+
+func TestFindCourier(t *testing.T) {
+	if c := FindCourier("DHL"); c.Name != "DHL" || c.ShippoCode() != "dhl_express" {
+		t.Errorf("FindCourier(DHL) = %+v", c)
+	}
+	if c := FindCourier("canada-post"); c.ShippoCode() != "canada_post" {
+		t.Errorf("ShippoCode = %q", c.ShippoCode())
+	}
+	if c := FindCourier("acme"); c.Code != "acme" {
+		t.Errorf("unknown courier = %+v", c)
+	}
+	if c := FindCourier("forwardair"); c.Code != "forward_air" {
+		t.Errorf("FindCourier(forwardair) = %+v", c)
+	}
+	if len(CourierOptions()) != len(Couriers) {
+		t.Error("every courier should be an option")
+	}
+
+	names := map[string]bool{}
+	for _, c := range Couriers {
+		if names[c.Name] {
+			t.Errorf("courier %q is listed twice", c.Name)
+		}
+		names[c.Name] = true
+	}
+}
+
+func TestMatchCourier(t *testing.T) {
+	for name, code := range map[string]string{"DHL Express": "dhl", "USPS": "usps", "fedex": "fedex", "canada_post": "canada-post"} {
+		if c, ok := MatchCourier(name); !ok || c.Code != code {
+			t.Errorf("MatchCourier(%q) = %+v, %v", name, c, ok)
+		}
+	}
+	if _, ok := MatchCourier("Acme Freight"); ok {
+		t.Error("an unknown carrier shouldn't match")
+	}
+}
+
+func TestFulfillmentResultFail(t *testing.T) {
+	r := NewFulfillmentResult("shopify", "1001")
+	r.Done(StepOrder, "#1001")
+	r.Fail(StepRates, errors.New("no rates"))
+	if r.Status != NotFulfilled || len(r.Rollback) != 1 || r.Error != "get_rates: no rates" {
+		t.Errorf("failure before the label = %+v", r)
+	}
+
+	r = NewFulfillmentResult("shopify", "1001")
+	r.Label = &Label{Provider: "shippo", ID: "tx_1", TrackingNumber: "9400", Rate: &Rate{Amount: 7.5, Currency: "USD"}}
+	r.Tracking = &Tracking{Provider: "aftership", TrackingNumber: "9400"}
+	r.Fail(StepWriteBack, errors.New("forbidden"))
+	if r.Status != PartiallyFulfilled || len(r.Rollback) != 3 {
+		t.Errorf("failure after the label = %+v", r)
+	}
+	if len(r.Steps) != 1 || r.Steps[0].Status != StepFailed {
+		t.Errorf("steps = %+v", r.Steps)
+	}
+}
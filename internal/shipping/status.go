@@ -0,0 +1,236 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shipping
+
+import (
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+)
+
+// Status is a carrier-neutral tracking status.
+type Status string
+
+const (
+	StatusPending            Status = "pending"
+	StatusInTransit          Status = "in_transit"
+	StatusOutForDelivery     Status = "out_for_delivery"
+	StatusAvailableForPickup Status = "available_for_pickup"
+	StatusDelivered          Status = "delivered"
+	StatusFailedAttempt      Status = "failed_attempt"
+	StatusException          Status = "exception"
+	StatusReturned           Status = "returned"
+	StatusExpired            Status = "expired"
+	StatusCancelled          Status = "cancelled"
+	StatusUnknown            Status = "unknown"
+)
+
+// StatusOptions lists the statuses for trigger filters.
+var StatusOptions = []*smartform.Option{
+	{Value: string(StatusPending), Label: "Pending"},
+	{Value: string(StatusInTransit), Label: "In Transit"},
+	{Value: string(StatusOutForDelivery), Label: "Out for Delivery"},
+	{Value: string(StatusAvailableForPickup), Label: "Available for Pickup"},
+	{Value: string(StatusDelivered), Label: "Delivered"},
+	{Value: string(StatusFailedAttempt), Label: "Failed Attempt"},
+	{Value: string(StatusException), Label: "Exception"},
+	{Value: string(StatusReturned), Label: "Returned"},
+	{Value: string(StatusExpired), Label: "Expired"},
+	{Value: string(StatusCancelled), Label: "Cancelled"},
+}
+
+// Final reports whether a shipment in this status will not move again.
+func (s Status) Final() bool {
+	switch s {
+	case StatusDelivered, StatusReturned, StatusExpired, StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// knownStatuses maps the statuses of AfterShip (tags), TrackingMore
+// (delivery_status), Shippo (tracking_status), Easyship (delivery_state)
+// and Flexport (order status), squashed to lower case letters.
+var knownStatuses = map[string]Status{
+	// AfterShip
+	"pending":            StatusPending,
+	"inforeceived":       StatusPending,
+	"intransit":          StatusInTransit,
+	"outfordelivery":     StatusOutForDelivery,
+	"attemptfail":        StatusFailedAttempt,
+	"availableforpickup": StatusAvailableForPickup,
+	"delivered":          StatusDelivered,
+	"exception":          StatusException,
+	"expired":            StatusExpired,
+
+	// TrackingMore
+	"notfound":    StatusPending,
+	"transit":     StatusInTransit,
+	"pickup":      StatusOutForDelivery,
+	"undelivered": StatusFailedAttempt,
+
+	// Shippo
+	"pretransit": StatusPending,
+	"returned":   StatusReturned,
+	"failure":    StatusException,
+	"unknown":    StatusUnknown,
+
+	// Easyship
+	"notcreated":          StatusPending,
+	"created":             StatusPending,
+	"labelready":          StatusPending,
+	"intransittocustomer": StatusInTransit,
+	"lost":                StatusException,
+	"returnedtosender":    StatusReturned,
+
+	// Flexport
+	"processing":       StatusPending,
+	"shipped":          StatusInTransit,
+	"partiallyshipped": StatusInTransit,
+	"cancelled":        StatusCancelled,
+	"canceled":         StatusCancelled,
+	"onhold":           StatusException,
+}
+
+// statusHints classify statuses missing from knownStatuses by the words
+// they contain, first match wins.
+var statusHints = []struct {
+	word   string
+	status Status
+}{
+	{"outfordelivery", StatusOutForDelivery},
+	{"return", StatusReturned},
+	{"fail", StatusFailedAttempt},
+	{"undeliver", StatusFailedAttempt},
+	{"exception", StatusException},
+	{"lost", StatusException},
+	{"damage", StatusException},
+	{"cancel", StatusCancelled},
+	{"deliver", StatusDelivered},
+	{"pickup", StatusAvailableForPickup},
+	{"transit", StatusInTransit},
+	{"ship", StatusInTransit},
+	{"expire", StatusExpired},
+	{"pending", StatusPending},
+	{"created", StatusPending},
+}
+
+// NormalizeStatus maps a carrier status to a Status. Case, spaces,
+// dashes and underscores are ignored.
+func NormalizeStatus(raw string) Status {
+	key := squash(raw)
+	if key == "" {
+		return StatusUnknown
+	}
+	if s, ok := knownStatuses[key]; ok {
+		return s
+	}
+	for _, hint := range statusHints {
+		if strings.Contains(key, hint.word) {
+			return hint.status
+		}
+	}
+	return StatusUnknown
+}
+
+func squash(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if r >= 'a' && r <= 'z' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// maxTracked bounds the statuses remembered between polls.
+const maxTracked = 5000
+
+// Changes returns the trackings whose status differs from the one in
+// previous, with PreviousStatus set, and the statuses to remember for the
+// next poll. A tracking seen for the first time counts as changed.
+// Trackings that reached a final status are forgotten first when the
+// history grows too large.
+func Changes(previous map[string]Status, trackings []Tracking) ([]Tracking, map[string]Status) {
+	next := make(map[string]Status, len(previous)+len(trackings))
+	for k, v := range previous {
+		next[k] = v
+	}
+
+	var changed []Tracking
+	for _, t := range trackings {
+		key := t.Key()
+		if prev, ok := previous[key]; ok && prev == t.Status {
+			continue
+		}
+		t.PreviousStatus = previous[key]
+		next[key] = t.Status
+		changed = append(changed, t)
+	}
+
+	if len(next) > maxTracked {
+		for k, s := range next {
+			if len(next) <= maxTracked {
+				break
+			}
+			if s.Final() {
+				delete(next, k)
+			}
+		}
+	}
+
+	return changed, next
+}
+
+// StatusesFrom reads statuses stored by a trigger, which come back from
+// the metadata store as a generic map.
+func StatusesFrom(v interface{}) map[string]Status {
+	out := map[string]Status{}
+	switch m := v.(type) {
+	case map[string]Status:
+		return m
+	case map[string]string:
+		for k, s := range m {
+			out[k] = Status(s)
+		}
+	case map[string]interface{}:
+		for k, s := range m {
+			if str, ok := s.(string); ok {
+				out[k] = Status(str)
+			}
+		}
+	}
+	return out
+}
+
+// FilterStatuses keeps the trackings in one of the given statuses. No
+// statuses keeps them all.
+func FilterStatuses(trackings []Tracking, statuses []string) []Tracking {
+	if len(statuses) == 0 {
+		return trackings
+	}
+	want := map[Status]bool{}
+	for _, s := range statuses {
+		want[Status(s)] = true
+	}
+	var kept []Tracking
+	for _, t := range trackings {
+		if want[t.Status] {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
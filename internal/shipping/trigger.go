@@ -0,0 +1,46 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shipping
+
+import (
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// statusesKey is the trigger metadata holding the last status of every
+// tracking seen.
+const statusesKey = "trackingStatuses"
+
+// TrackingChanges returns the trackings whose status changed since the
+// trigger last saw them, filtered by the trigger's status filter, and
+// remembers the new statuses.
+func TrackingChanges(ctx sdkcontext.ExecuteContext, trackings []Tracking) []Tracking {
+	var previous map[string]Status
+	if stored, err := ctx.GetMetadata(statusesKey); err == nil {
+		previous = StatusesFrom(stored)
+	}
+
+	changed, next := Changes(previous, trackings)
+	if len(changed) > 0 {
+		if err := ctx.SetMetadata(statusesKey, next); err != nil {
+			ctx.Logger().Warn("failed to store tracking statuses", "error", err)
+		}
+	}
+
+	changed = FilterStatuses(changed, StatusFilterFrom(ctx.Input()))
+	if changed == nil {
+		changed = []Tracking{}
+	}
+	return changed
+}
@@ -0,0 +1,155 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook reads what webhook triggers are handed. A delivery
+// carries the request headers under "headers" and the body under "body";
+// DeliveryFrom keeps the raw body for providers that sign it, and
+// DecodeBody decodes the body of providers that don't. Connection reads
+// the connection of the lifecycle hooks that register the webhooks.
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+)
+
+// Delivery is a webhook request as handed to a trigger.
+type Delivery struct {
+	Headers map[string]string
+	Body    []byte
+}
+
+// Header returns a header value, ignoring case.
+func (d Delivery) Header(name string) string {
+	for k, v := range d.Headers {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return ""
+}
+
+// DeliveryFrom reads the webhook request from trigger input: the request
+// headers under "headers" and the raw body under "body".
+func DeliveryFrom(input map[string]interface{}) (Delivery, error) {
+	d := Delivery{Headers: map[string]string{}}
+
+	switch headers := input["headers"].(type) {
+	case map[string]interface{}:
+		for k, v := range headers {
+			switch val := v.(type) {
+			case string:
+				d.Headers[k] = val
+			case []interface{}:
+				if len(val) > 0 {
+					d.Headers[k], _ = val[0].(string)
+				}
+			}
+		}
+	case map[string]string:
+		d.Headers = headers
+	}
+
+	switch body := input["body"].(type) {
+	case string:
+		d.Body = []byte(body)
+	case []byte:
+		d.Body = body
+	case nil:
+		return d, errors.New("webhook delivery has no body")
+	default:
+		// A decoded body can't be verified byte for byte.
+		return d, errors.New("webhook delivery body must be the raw request body")
+	}
+	return d, nil
+}
+
+// DecodeBody decodes the JSON body of a delivery into out. The body may
+// be raw or already decoded, as unsigned deliveries aren't verified.
+func DecodeBody(input map[string]interface{}, out interface{}) error {
+	var raw []byte
+	switch v := input["body"].(type) {
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	case map[string]interface{}:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		raw = b
+	default:
+		return errors.New("webhook delivery has no body")
+	}
+	return json.Unmarshal(raw, out)
+}
+
+// Connection returns the connection fields of a trigger config. Trigger
+// lifecycle hooks get no auth context, so the runtime passes the
+// connection in the config: as fields of its own, or as the auth context
+// under "auth", with the fields of custom auth under "extra" and OAuth
+// tokens as "access_token". Fields of the config win over those of the
+// auth context.
+func Connection(config map[string]interface{}) map[string]string {
+	values := map[string]string{}
+
+	var auth sdkcontext.AuthContext
+	switch a := config["auth"].(type) {
+	case *sdkcontext.AuthContext:
+		if a != nil {
+			auth = *a
+		}
+	case sdkcontext.AuthContext:
+		auth = a
+	case map[string]interface{}:
+		// Older runtimes pass the connection fields flat.
+		for k, v := range a {
+			if s, ok := v.(string); ok && s != "" {
+				values[k] = s
+			}
+		}
+		// The token source can't be decoded, the other fields still are.
+		if data, err := json.Marshal(a); err == nil {
+			_ = json.Unmarshal(data, &auth)
+		}
+	}
+
+	for k, v := range auth.Extra {
+		if v != "" {
+			values[k] = v
+		}
+	}
+	if auth.Token != nil && auth.Token.AccessToken != "" {
+		values["access_token"] = auth.Token.AccessToken
+	}
+	if auth.AccessToken != "" {
+		values["access_token"] = auth.AccessToken
+	}
+	for k, v := range map[string]string{"key": auth.Key, "secret": auth.Secret, "username": auth.Username, "password": auth.Password} {
+		if v != "" {
+			values[k] = v
+		}
+	}
+
+	for k, v := range config {
+		if s, ok := v.(string); ok && s != "" {
+			values[k] = s
+		}
+	}
+	return values
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"golang.org/x/oauth2"
+)
+
+func TestDeliveryFrom(t *testing.T) {
+	d, err := DeliveryFrom(map[string]interface{}{
+		"headers": map[string]interface{}{"X-Signature": []interface{}{"abc"}, "Content-Type": "application/json"},
+		"body":    `{"id":1}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Header("x-signature") != "abc" || d.Header("content-type") != "application/json" {
+		t.Errorf("headers = %v", d.Headers)
+	}
+	if string(d.Body) != `{"id":1}` {
+		t.Errorf("body = %q", d.Body)
+	}
+
+	if _, err := DeliveryFrom(map[string]interface{}{"body": map[string]interface{}{"id": 1.0}}); err == nil {
+		t.Error("a decoded body should be rejected")
+	}
+	if _, err := DeliveryFrom(map[string]interface{}{}); err == nil {
+		t.Error("expected an error without a body")
+	}
+}
+
+func TestDecodeBody(t *testing.T) {
+	for _, body := range []interface{}{`{"id":"1"}`, []byte(`{"id":"1"}`), map[string]interface{}{"id": "1"}} {
+		var out struct {
+			ID string `json:"id"`
+		}
+		if err := DecodeBody(map[string]interface{}{"body": body}, &out); err != nil || out.ID != "1" {
+			t.Errorf("DecodeBody(%T) = %+v, %v", body, out, err)
+		}
+	}
+	var out map[string]interface{}
+	if err := DecodeBody(map[string]interface{}{}, &out); err == nil {
+		t.Error("expected an error without a body")
+	}
+}
+
+func TestConnection(t *testing.T) {
+	// The auth context as the runtime serializes it into the config.
+	auth := &sdkcontext.AuthContext{
+		Token: &oauth2.Token{AccessToken: "oauth-token"},
+		Extra: map[string]string{"api_key": "key-1", "secret_key": "secret-1"},
+	}
+	data, err := json.Marshal(map[string]interface{}{"auth": auth})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		t.Fatal(err)
+	}
+
+	values := Connection(config)
+	if values["api_key"] != "key-1" || values["secret_key"] != "secret-1" || values["access_token"] != "oauth-token" {
+		t.Errorf("Connection(serialized auth) = %v", values)
+	}
+
+	values = Connection(map[string]interface{}{"auth": auth})
+	if values["api_key"] != "key-1" || values["access_token"] != "oauth-token" {
+		t.Errorf("Connection(auth context) = %v", values)
+	}
+
+	values = Connection(map[string]interface{}{
+		"api_key": "top",
+		"auth":    map[string]interface{}{"api_key": "flat", "base_url": "https://api.example.com"},
+	})
+	if values["api_key"] != "top" || values["base_url"] != "https://api.example.com" {
+		t.Errorf("Connection(flat fields) = %v", values)
+	}
+
+	if len(Connection(map[string]interface{}{})) != 0 {
+		t.Error("an empty config has no connection")
+	}
+}
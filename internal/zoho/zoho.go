@@ -0,0 +1,260 @@
+// Copyright 2022-present Wakflo
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package zoho routes Zoho API requests to the data center of the
+// account. Zoho runs separate data centers per region, and an account
+// only exists in one: its API domain comes with the OAuth token as
+// api_domain, or from the region picked on the connection.
+package zoho
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/juicycleff/smartform/v1"
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"golang.org/x/oauth2"
+)
+
+// RegionField is the auth form field holding the region code.
+const RegionField = "region"
+
+// AccountsServerField is where Zoho names the accounts server of the
+// account, as accounts-server, when it redirects back with the
+// authorization code.
+const AccountsServerField = "accounts-server"
+
+// Region is a Zoho data center.
+type Region struct {
+	Code string
+	Name string
+	// Host is the base host of the region, such as zoho.eu; accounts and
+	// SalesIQ live under it.
+	Host string
+	// APIDomain is the base URL of the region's zohoapis domain.
+	APIDomain string
+}
+
+// US is the default region, where accounts created on zoho.com live.
+var US = Region{Code: "us", Name: "United States", Host: "zoho.com", APIDomain: "https://www.zohoapis.com"}
+
+var Regions = []Region{
+	US,
+	{Code: "eu", Name: "Europe", Host: "zoho.eu", APIDomain: "https://www.zohoapis.eu"},
+	{Code: "in", Name: "India", Host: "zoho.in", APIDomain: "https://www.zohoapis.in"},
+	{Code: "au", Name: "Australia", Host: "zoho.com.au", APIDomain: "https://www.zohoapis.com.au"},
+	{Code: "jp", Name: "Japan", Host: "zoho.jp", APIDomain: "https://www.zohoapis.jp"},
+	{Code: "ca", Name: "Canada", Host: "zohocloud.ca", APIDomain: "https://www.zohoapis.ca"},
+	{Code: "cn", Name: "China", Host: "zoho.com.cn", APIDomain: "https://www.zohoapis.com.cn"},
+	{Code: "sa", Name: "Saudi Arabia", Host: "zoho.sa", APIDomain: "https://www.zohoapis.sa"},
+}
+
+// RegionOptions lists the regions for a select field.
+func RegionOptions() []*smartform.Option {
+	options := make([]*smartform.Option, 0, len(Regions))
+	for _, r := range Regions {
+		options = append(options, &smartform.Option{Value: r.Code, Label: r.Name + " (" + r.Host + ")"})
+	}
+	return options
+}
+
+// RegisterRegionProps adds the region field to an auth form.
+func RegisterRegionProps(form *smartform.FormBuilder) *smartform.FieldBuilder {
+	return form.SelectField(RegionField, "Data Center").
+		Required(false).
+		DefaultValue(US.Code).
+		AddOptions(RegionOptions()...).
+		HelpText("The Zoho data center of your account, as in the domain you sign in to, such as zoho.eu. Used when Zoho doesn't return it with the token.")
+}
+
+// FindRegion returns the region of a code, host or domain suffix, such
+// as "eu", "zoho.eu" or "com.au", case-insensitively.
+func FindRegion(s string) (Region, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return Region{}, false
+	}
+	for _, r := range Regions {
+		if s == r.Code || s == r.Host || s == strings.TrimPrefix(r.Host, "zoho.") {
+			return r, true
+		}
+	}
+	return Region{}, false
+}
+
+// RegionOfAPIDomain returns the region serving an API domain such as
+// https://www.zohoapis.eu.
+func RegionOfAPIDomain(apiDomain string) (Region, bool) {
+	apiDomain = strings.TrimRight(strings.ToLower(strings.TrimSpace(apiDomain)), "/")
+	for _, r := range Regions {
+		if apiDomain == r.APIDomain {
+			return r, true
+		}
+	}
+	return Region{}, false
+}
+
+// AccountsURL is the base URL of the region's accounts server, which
+// issues and refreshes tokens.
+func (r Region) AccountsURL() string {
+	return "https://accounts." + r.Host
+}
+
+// SignInEndpoint is where OAuth connections start. Zoho's global
+// accounts server signs in users of every data center and sends them
+// back with the accounts-server of their own, which AccessToken then
+// refreshes tokens against.
+var SignInEndpoint = US.Endpoint()
+
+// Endpoint returns the OAuth endpoints of the region's accounts server.
+func (r Region) Endpoint() oauth2.Endpoint {
+	return oauth2.Endpoint{
+		AuthURL:  r.AccountsURL() + "/oauth/v2/auth",
+		TokenURL: r.AccountsURL() + "/oauth/v2/token",
+	}
+}
+
+// SalesIQURL is the base URL of the region's SalesIQ API.
+func (r Region) SalesIQURL() string {
+	return "https://salesiq." + r.Host
+}
+
+// Resolve returns the region of a connection: the one of the api_domain
+// returned with the token, else the one picked on the connection, else
+// US.
+func Resolve(auth *sdkcontext.AuthContext) Region {
+	if auth == nil {
+		return US
+	}
+	if r, ok := RegionOfAPIDomain(tokenAPIDomain(auth)); ok {
+		return r
+	}
+	if r, ok := FindRegion(auth.Extra[RegionField]); ok {
+		return r
+	}
+	return US
+}
+
+// AccountsServer returns the base URL of the accounts server of the
+// connection: the accounts-server Zoho returned when it is one of a
+// known region, else the one of the connection's region.
+func AccountsServer(auth *sdkcontext.AuthContext) string {
+	if auth != nil {
+		server := auth.Extra[AccountsServerField]
+		if auth.Token != nil {
+			if s, ok := auth.Token.Extra(AccountsServerField).(string); ok && s != "" {
+				server = s
+			}
+		}
+		server = strings.TrimRight(strings.ToLower(strings.TrimSpace(server)), "/")
+		for _, r := range Regions {
+			if server == r.AccountsURL() {
+				return server
+			}
+		}
+	}
+	return Resolve(auth).AccountsURL()
+}
+
+// Endpoint returns the OAuth endpoints of the connection's accounts
+// server, which refreshes its tokens.
+func Endpoint(auth *sdkcontext.AuthContext) oauth2.Endpoint {
+	server := AccountsServer(auth)
+	return oauth2.Endpoint{
+		AuthURL:  server + "/oauth/v2/auth",
+		TokenURL: server + "/oauth/v2/token",
+	}
+}
+
+// AccessToken returns the access token of a connection. An expired token
+// is refreshed against the accounts server of the account's data center,
+// as the ones of other data centers reject it, when the connection holds
+// the client ID and secret as its key and secret.
+func AccessToken(auth *sdkcontext.AuthContext) (string, error) {
+	return accessToken(context.Background(), auth)
+}
+
+func accessToken(ctx context.Context, auth *sdkcontext.AuthContext) (string, error) {
+	if auth == nil || auth.Token == nil {
+		return "", errors.New("missing authentication token")
+	}
+	if auth.Token.Valid() || auth.Token.RefreshToken == "" || auth.Key == "" || auth.Secret == "" {
+		return auth.Token.AccessToken, nil
+	}
+
+	conf := &oauth2.Config{
+		ClientID:     auth.Key,
+		ClientSecret: auth.Secret,
+		Endpoint:     Endpoint(auth),
+	}
+	token, err := conf.TokenSource(ctx, auth.Token).Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh the Zoho token: %w", err)
+	}
+	auth.Token = token
+	return token.AccessToken, nil
+}
+
+// APIDomain returns the base URL of the connection's zohoapis domain. An
+// api_domain returned with the token is used as is when it is a Zoho
+// domain, so data centers added later work without a release.
+func APIDomain(auth *sdkcontext.AuthContext) string {
+	if auth != nil {
+		if d := tokenAPIDomain(auth); IsZohoDomain(d) {
+			return strings.TrimRight(d, "/")
+		}
+	}
+	return Resolve(auth).APIDomain
+}
+
+// ContentDomain returns the base URL of the connection's content
+// domain, which takes file uploads such as those of bulk jobs.
+func ContentDomain(auth *sdkcontext.AuthContext) string {
+	return strings.Replace(APIDomain(auth), "://www.", "://content.", 1)
+}
+
+// IsZohoDomain reports whether a URL is an https URL on a host of a
+// known Zoho data center, such as www.zohoapis.eu or accounts.zoho.in,
+// so tokens are never sent elsewhere.
+func IsZohoDomain(raw string) bool {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, r := range Regions {
+		apiHost := strings.TrimPrefix(strings.TrimPrefix(r.APIDomain, "https://"), "www.")
+		for _, base := range []string{r.Host, apiHost} {
+			if host == base || strings.HasSuffix(host, "."+base) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// tokenAPIDomain reads the api_domain Zoho returns with its tokens. The
+// raw token response is lost once a token is stored, so a copy kept in
+// the connection's extra values is read too.
+func tokenAPIDomain(auth *sdkcontext.AuthContext) string {
+	if auth.Token != nil {
+		if d, ok := auth.Token.Extra("api_domain").(string); ok && d != "" {
+			return d
+		}
+	}
+	return auth.Extra["api_domain"]
+}
@@ -0,0 +1,133 @@
+package zoho
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	sdkcontext "github.com/wakflo/go-sdk/v2/context"
+	"golang.org/x/oauth2"
+)
+
+func TestFindRegion(t *testing.T) {
+	for in, want := range map[string]string{"EU": "eu", "zoho.in": "in", "com.au": "au", "zohocloud.ca": "ca", "com": "us"} {
+		if r, ok := FindRegion(in); !ok || r.Code != want {
+			t.Errorf("FindRegion(%q) = %q, %v, want %q", in, r.Code, ok, want)
+		}
+	}
+	if _, ok := FindRegion("mars"); ok {
+		t.Error("FindRegion(mars) should not match")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		auth      *sdkcontext.AuthContext
+		region    string
+		apiDomain string
+	}{
+		{"default", &sdkcontext.AuthContext{}, "us", "https://www.zohoapis.com"},
+		{"picked region", &sdkcontext.AuthContext{Extra: map[string]string{"region": "eu"}}, "eu", "https://www.zohoapis.eu"},
+		{"api domain wins", &sdkcontext.AuthContext{Extra: map[string]string{"api_domain": "https://www.zohoapis.com.au/", "region": "eu"}}, "au", "https://www.zohoapis.com.au"},
+		{"foreign api domain ignored", &sdkcontext.AuthContext{Extra: map[string]string{"api_domain": "https://evil.example.com", "region": "jp"}}, "jp", "https://www.zohoapis.jp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Resolve(tt.auth); got.Code != tt.region {
+				t.Errorf("Resolve = %q, want %q", got.Code, tt.region)
+			}
+			if got := APIDomain(tt.auth); got != tt.apiDomain {
+				t.Errorf("APIDomain = %q, want %q", got, tt.apiDomain)
+			}
+			if got, want := ContentDomain(tt.auth), strings.Replace(tt.apiDomain, "www.", "content.", 1); got != want {
+				t.Errorf("ContentDomain = %q, want %q", got, want)
+			}
+		})
+	}
+
+	if got := Resolve(nil).SalesIQURL(); got != "https://salesiq.zoho.com" {
+		t.Errorf("SalesIQURL = %q", got)
+	}
+}
+
+func TestIsZohoDomain(t *testing.T) {
+	tests := map[string]bool{
+		"https://www.zohoapis.com":              true,
+		"https://content.zohoapis.eu/crm/v7":    true,
+		"https://www.zohoapis.com.au/":          true,
+		"https://accounts.zohocloud.ca":         true,
+		"https://download.zoho.in/file":         true,
+		"http://www.zohoapis.com":               false,
+		"https://zoho.evil.com":                 false,
+		"https://www.zohoapis.attacker.net":     false,
+		"https://evilzohoapis.com":              false,
+		"https://www.zohoapis.com.attacker.net": false,
+		"https://zohoapis.mars":                 false,
+		"":                                      false,
+	}
+	for raw, want := range tests {
+		if got := IsZohoDomain(raw); got != want {
+			t.Errorf("IsZohoDomain(%q) = %v, want %v", raw, got, want)
+		}
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestAccessTokenRefreshesInRegion(t *testing.T) {
+	tests := []struct {
+		name  string
+		extra map[string]string
+		want  string
+	}{
+		{"picked region", map[string]string{"region": "eu"}, "https://accounts.zoho.eu/oauth/v2/token"},
+		{"accounts server", map[string]string{"accounts-server": "https://accounts.zoho.in"}, "https://accounts.zoho.in/oauth/v2/token"},
+		{"foreign accounts server ignored", map[string]string{"accounts-server": "https://accounts.evil.com", "region": "eu"}, "https://accounts.zoho.eu/oauth/v2/token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				got = req.URL.String()
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": {"application/json"}},
+					Body:       io.NopCloser(strings.NewReader(`{"access_token":"fresh","token_type":"Bearer","expires_in":3600}`)),
+				}, nil
+			})}
+			ctx := context.WithValue(context.Background(), oauth2.HTTPClient, client)
+
+			auth := &sdkcontext.AuthContext{
+				Token:  &oauth2.Token{AccessToken: "stale", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Hour)},
+				Key:    "client-id",
+				Secret: "client-secret",
+				Extra:  tt.extra,
+			}
+			token, err := accessToken(ctx, auth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token != "fresh" || got != tt.want {
+				t.Errorf("accessToken = %q from %q, want fresh from %q", token, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessTokenValid(t *testing.T) {
+	auth := &sdkcontext.AuthContext{Token: &oauth2.Token{AccessToken: "current", Expiry: time.Now().Add(time.Hour)}}
+	if token, err := AccessToken(auth); err != nil || token != "current" {
+		t.Errorf("AccessToken = %q, %v, want current", token, err)
+	}
+	if _, err := AccessToken(&sdkcontext.AuthContext{}); err == nil {
+		t.Error("AccessToken without a token should fail")
+	}
+}